@@ -1,11 +1,26 @@
 package main
 
 import (
+	"context"
+	command "github.com/carlosclavijo/Nutricenter-Contracting/internal/application/contract/handlers"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/contract"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/invoice"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/pricing"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/slot"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/infrastructure/auth"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/infrastructure/billing"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/infrastructure/jobs"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/infrastructure/messaging"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/infrastructure/notification"
 	"github.com/carlosclavijo/Nutricenter-Contracting/internal/infrastructure/persistence"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/infrastructure/persistence/repositories"
 	"github.com/carlosclavijo/Nutricenter-Contracting/internal/web"
 	"github.com/joho/godotenv"
 	"log"
 	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
 )
 
 const connection = ":8080"
@@ -19,7 +34,30 @@ func main() {
 		return
 	}
 
-	routes := web.NewRoutes(db)
+	keys, err := auth.LoadKeySet()
+	if err != nil {
+		log.Fatalf("[web:main] signing keys error: %v", err)
+		return
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	dispatcher := messaging.NewDispatcher()
+	dispatcher.SubscribeAll(messaging.LogSubscriber)
+	relay := messaging.NewRelay(repositories.NewOutboxRepository(db), persistence.NewUnitOfWork(db), dispatcher, messaging.LoadRelayConfig())
+	go relay.Run(ctx)
+
+	pricer := pricing.NewPricer(repositories.NewZoneRepository(db), repositories.NewPromoCodeRepository(db), billing.LoadTaxRates()...)
+	booker := slots.NewBooker(repositories.NewSlotRepository(db), repositories.NewZoneRepository(db))
+	invoicer := invoices.NewInvoicer(repositories.NewInvoiceRepository(db), invoices.NewInvoiceFactory(), billing.LoadInvoiceSeries(), billing.LoadRequirePayment(), billing.LoadCancellationFee())
+	contractHandler := command.NewContractHandler(repositories.NewContractRepository(db), repositories.NewPlanRepository(db), repositories.NewHolidayRepository(db), booker, pricer, invoicer, contracts.NewContractFactory(), persistence.NewUnitOfWork(db))
+	scheduled := append(jobs.NewContractTransitionJobs(contractHandler, jobs.LoadSchedulerInterval()), jobs.NewAutoRenewJob(contractHandler, jobs.LoadAutoRenewInterval()))
+	scheduler := jobs.NewScheduler(persistence.NewAdvisoryLocker(db), repositories.NewJobRunRepository(db), scheduled...)
+	go scheduler.Run(ctx)
+
+	authService := auth.NewService(keys, repositories.NewTokenRepository(db), persistence.NewUnitOfWork(db), auth.LoadConfig())
+	routes := web.NewRoutes(db, authService, notification.LoadNotifier())
 
 	err = http.ListenAndServe(connection, routes.Router())
 	if err != nil {
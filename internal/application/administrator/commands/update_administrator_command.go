@@ -10,7 +10,6 @@ type UpdateAdministratorCommand struct {
 	FirstName string
 	LastName  string
 	Email     string
-	Password  string
 	Gender    string
 	Birth     time.Time
 	Phone     *string
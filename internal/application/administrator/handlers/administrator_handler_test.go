@@ -3,6 +3,7 @@ package handlers
 import (
 	"context"
 	"errors"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/abstractions"
 	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/administrator"
 	vo "github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
 	"github.com/google/uuid"
@@ -41,11 +42,11 @@ func (m *MockFactory) Create(firstName string, lastName string, email vo.Email,
 	return result, args.Error(1)
 }
 
-func (m *MockRepository) GetAll(ctx context.Context) ([]*administrators.Administrator, error) {
+func (m *MockRepository) GetAll(ctx context.Context, filter administrators.AdministratorFilter, pagination abstractions.Pagination) (*abstractions.Page[*administrators.Administrator], error) {
 	return nil, nil
 }
 
-func (m *MockRepository) GetList(ctx context.Context) ([]*administrators.Administrator, error) {
+func (m *MockRepository) GetList(ctx context.Context, filter administrators.AdministratorFilter, pagination abstractions.Pagination) (*abstractions.Page[*administrators.Administrator], error) {
 	return nil, nil
 }
 
@@ -82,6 +83,15 @@ func (m *MockRepository) ExistByEmail(ctx context.Context, email string) (bool,
 	return v.(bool), args.Error(1)
 }
 
+func (m *MockRepository) IsSuper(ctx context.Context, id uuid.UUID) (bool, error) {
+	args := m.Called(ctx, id)
+	v := args.Get(0)
+	if v == nil {
+		return false, args.Error(1)
+	}
+	return v.(bool), args.Error(1)
+}
+
 func (m *MockRepository) Create(ctx context.Context, admin *administrators.Administrator) (*administrators.Administrator, error) {
 	args := m.Called(ctx, admin)
 
@@ -7,7 +7,6 @@ import (
 	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/administrator/mappers"
 	administrators "github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/administrator"
 	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
-	"golang.org/x/crypto/bcrypt"
 	"log"
 )
 
@@ -24,13 +23,8 @@ func (h *AdministratorHandler) HandleLogin(ctx context.Context, cmd commands.Log
 		return nil, err
 	} else if !exist {
 		log.Printf("[handler:administrator][HandleLogin] the Administrator doesn't exist '%v'", cmd.Email)
-		return nil, administrators.ErrNotFoundAdministrator
-	}
-
-	password, err := valueobjects.NewPassword(cmd.Password)
-	if err != nil {
-		log.Printf("[handler:administrator][HandleUpdate] error parsing password: %v", err)
-		return nil, err
+		valueobjects.SimulatePasswordMatch(cmd.Password)
+		return nil, administrators.ErrInvalidCredentialsAdministrator
 	}
 
 	admin, err := h.repository.GetByEmail(ctx, email.Value())
@@ -38,7 +32,7 @@ func (h *AdministratorHandler) HandleLogin(ctx context.Context, cmd commands.Log
 		return nil, err
 	}
 
-	if err = bcrypt.CompareHashAndPassword([]byte(admin.Password().String()), []byte(password.String())); err != nil {
+	if !admin.Password().Matches(cmd.Password) {
 		log.Printf("[handler:administrator][HandleLogin] invalid credentials for email=%s", cmd.Email)
 		return nil, administrators.ErrInvalidCredentialsAdministrator
 	}
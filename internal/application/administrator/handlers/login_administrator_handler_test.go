@@ -117,7 +117,7 @@ func TestAdministratorHandler_HandleLogin_ExistenceCheck(t *testing.T) {
 		wantErr     error
 	}{
 		{"fail when repository returns error", false, ErrDbFailureAdministrator, ErrDbFailureAdministrator},
-		{"fail when administrator not found", false, nil, administrators.ErrNotFoundAdministrator},
+		{"fail when administrator not found", false, nil, administrators.ErrInvalidCredentialsAdministrator},
 		{"success when administrator exists", true, nil, nil},
 	}
 
@@ -171,31 +171,21 @@ func TestAdministratorHandler_HandleLogin_PasswordError(t *testing.T) {
 
 	assert.NotNil(t, handler)
 
-	cmd := commands.LoginAdministratorCommand{
-		Email:    "jane@doe.com",
-		Password: "",
-	}
-
-	mockRepo.On("ExistByEmail", mock.Anything, cmd.Email).Return(true, nil)
-
-	resp, err := handler.HandleLogin(ctx, cmd)
-	assert.ErrorIs(t, err, vo.ErrEmptyPassword)
-	assert.Nil(t, resp)
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte("Correct!Pass123"), bcrypt.DefaultCost)
+	assert.NoError(t, err)
 
-	cmd.Password = "Abcdef1!Abcdef1!Abcdef1!Abcdef1!Abcdef1!Abcdef1!Abcdef1!Abcdef1!Abcdef1!"
-	resp, err = handler.HandleLogin(ctx, cmd)
-	assert.ErrorIs(t, err, vo.ErrLongPassword)
-	assert.Nil(t, resp)
+	admin, err := administrators.NewAdministratorFromDB(uuid.New(), "Jane", "Doe", "jane@doe.com", string(hashedPassword), "female", time.Now().AddDate(-25, 0, 0), nil, time.Now(), time.Now(), time.Now(), nil)
+	assert.NoError(t, err)
 
-	cmd.Password = "short"
-	resp, err = handler.HandleLogin(ctx, cmd)
-	assert.ErrorIs(t, err, vo.ErrShortPassword)
-	assert.Nil(t, resp)
+	mockRepo.On("ExistByEmail", mock.Anything, "jane@doe.com").Return(true, nil)
+	mockRepo.On("GetByEmail", mock.Anything, "jane@doe.com").Return(admin, nil)
 
-	cmd.Password = "Abc123SSS"
-	resp, err = handler.HandleLogin(ctx, cmd)
-	assert.ErrorIs(t, err, vo.ErrSoftPassword)
-	assert.Nil(t, resp)
+	// Password rules are not checked on login, a malformed password is just wrong.
+	for _, password := range []string{"", "Abcdef1!Abcdef1!Abcdef1!Abcdef1!Abcdef1!Abcdef1!Abcdef1!Abcdef1!Abcdef1!", "short", "Abc123SSS"} {
+		resp, err := handler.HandleLogin(ctx, commands.LoginAdministratorCommand{Email: "jane@doe.com", Password: password})
+		assert.ErrorIs(t, err, administrators.ErrInvalidCredentialsAdministrator)
+		assert.Nil(t, resp)
+	}
 }
 
 func TestAdministratorHandler_HandleLogin_GetEmailError(t *testing.T) {
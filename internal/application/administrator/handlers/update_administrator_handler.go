@@ -35,12 +35,6 @@ func (h *AdministratorHandler) HandleUpdate(ctx context.Context, cmd commands.Up
 		return nil, administrators.ErrNotFoundAdministrator
 	}
 
-	password, err := valueobjects.NewPassword(cmd.Password)
-	if err != nil {
-		log.Printf("[handler:administrator][HandleUpdate] error parsing password: %v", err)
-		return nil, err
-	}
-
 	gender, err := valueobjects.ParseGender(cmd.Gender)
 	if err != nil {
 		log.Printf("[handler:administrator][HandleUpdate] error parsing gender: %v", err)
@@ -59,7 +53,13 @@ func (h *AdministratorHandler) HandleUpdate(ctx context.Context, cmd commands.Up
 		return nil, err
 	}
 
-	admin := administrators.NewAdministrator(cmd.FirstName, cmd.LastName, email, password, gender, birth, phone)
+	current, err := h.repository.GetById(ctx, cmd.Id)
+	if err != nil {
+		log.Printf("[handler:administrator][HandleUpdate] error getting current Administrator '%v': %v", cmd.Id, err)
+		return nil, err
+	}
+
+	admin := administrators.NewAdministrator(cmd.FirstName, cmd.LastName, email, current.Password(), gender, birth, phone)
 	admin.AggregateRoot = abstractions.NewAggregateRoot(cmd.Id)
 
 	admin, err = h.repository.Update(ctx, admin)
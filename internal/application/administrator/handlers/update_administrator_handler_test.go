@@ -13,6 +13,8 @@ import (
 	"time"
 )
 
+const storedPassword = "$2a$10$3J9wq7F0s8G2bXHkzQvFqO5tLh8mY2nP4rZxN1uVY3sTq6aKbL1Pa"
+
 func TestHandleUpdate(t *testing.T) {
 	ctx := context.Background()
 
@@ -27,13 +29,12 @@ func TestHandleUpdate(t *testing.T) {
 		FirstName: "Jane",
 		LastName:  "Doe",
 		Email:     "jane@example.com",
-		Password:  "Strong1!",
 		Gender:    "female",
 		Birth:     time.Now().AddDate(-25, 0, 0),
 		Phone:     nil,
 	}
 
-	email, password, gender, birth := valueObjects(t, cmd.Email, cmd.Password, cmd.Gender, cmd.Birth)
+	email, password, gender, birth := valueObjects(t, cmd.Email, storedPassword, cmd.Gender, cmd.Birth)
 
 	var phone *vo.Phone
 	if cmd.Phone != nil {
@@ -45,6 +46,7 @@ func TestHandleUpdate(t *testing.T) {
 	admin.AggregateRoot = abstractions.NewAggregateRoot(cmd.Id)
 
 	mockRepo.On("ExistById", mock.Anything, cmd.Id).Return(true, nil)
+	mockRepo.On("GetById", mock.Anything, cmd.Id).Return(admin, nil)
 	mockRepo.On("Update", mock.Anything, admin).Return(admin, nil)
 
 	resp, err := handler.HandleUpdate(ctx, cmd)
@@ -76,17 +78,17 @@ func TestHandleUpdate_RepositoryError(t *testing.T) {
 		FirstName: "Jane",
 		LastName:  "Doe",
 		Email:     "jane@example.com",
-		Password:  "Strong1!",
 		Gender:    "female",
 		Birth:     time.Now().AddDate(-25, 0, 0),
 	}
 
-	email, password, gender, birth := valueObjects(t, cmd.Email, cmd.Password, cmd.Gender, cmd.Birth)
+	email, password, gender, birth := valueObjects(t, cmd.Email, storedPassword, cmd.Gender, cmd.Birth)
 
 	admin := administrators.NewAdministrator(cmd.FirstName, cmd.LastName, email, password, gender, birth, nil)
 	admin.AggregateRoot = abstractions.NewAggregateRoot(cmd.Id)
 
 	mockRepo.On("ExistById", mock.Anything, cmd.Id).Return(true, nil)
+	mockRepo.On("GetById", mock.Anything, cmd.Id).Return(admin, nil)
 	mockRepo.On("Update", mock.Anything, admin).Return(nil, ErrDbFailureAdministrator)
 
 	resp, err := handler.HandleUpdate(ctx, cmd)
@@ -98,6 +100,33 @@ func TestHandleUpdate_RepositoryError(t *testing.T) {
 	mockRepo.AssertExpectations(t)
 }
 
+func TestHandleUpdate_CurrentError(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(MockRepository)
+	mockFactory := new(MockFactory)
+	handler := NewAdministratorHandler(mockRepo, mockFactory)
+
+	cmd := commands.UpdateAdministratorCommand{
+		Id:        uuid.New(),
+		FirstName: "Jane",
+		LastName:  "Doe",
+		Email:     "jane@example.com",
+		Gender:    "female",
+		Birth:     time.Now().AddDate(-25, 0, 0),
+	}
+
+	mockRepo.On("ExistById", mock.Anything, cmd.Id).Return(true, nil)
+	mockRepo.On("GetById", mock.Anything, cmd.Id).Return((*administrators.Administrator)(nil), ErrDbFailureAdministrator)
+
+	resp, err := handler.HandleUpdate(ctx, cmd)
+
+	assert.ErrorIs(t, err, ErrDbFailureAdministrator)
+	assert.Nil(t, resp)
+
+	mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	mockRepo.AssertExpectations(t)
+}
+
 func TestHandleUpdate_IdError(t *testing.T) {
 	ctx := context.Background()
 	mockRepo := new(MockRepository)
@@ -111,7 +140,6 @@ func TestHandleUpdate_IdError(t *testing.T) {
 		FirstName: "Jane",
 		LastName:  "Doe",
 		Email:     "jane@example.com",
-		Password:  "Strong1!",
 		Gender:    "female",
 		Birth:     time.Now().AddDate(-25, 0, 0),
 	}
@@ -137,7 +165,6 @@ func TestHandleUpdate_EmailError(t *testing.T) {
 		FirstName: "Jane",
 		LastName:  "Doe",
 		Email:     "",
-		Password:  "Strong1!",
 		Gender:    "female",
 		Birth:     time.Now().AddDate(-25, 0, 0),
 	}
@@ -184,7 +211,6 @@ func TestHandleUpdate_ExistenceCheck(t *testing.T) {
 				FirstName: "Jane",
 				LastName:  "Doe",
 				Email:     "jane@example.com",
-				Password:  "Strong1!",
 				Gender:    "female",
 				Birth:     time.Now().AddDate(-25, 0, 0),
 				Phone:     nil,
@@ -193,9 +219,10 @@ func TestHandleUpdate_ExistenceCheck(t *testing.T) {
 			mockRepo.On("ExistById", mock.Anything, cmd.Id).Return(tc.idExists, tc.repoError)
 
 			if tc.idExists && tc.repoError == nil {
-				email, password, gender, birth := valueObjects(t, cmd.Email, cmd.Password, cmd.Gender, cmd.Birth)
+				email, password, gender, birth := valueObjects(t, cmd.Email, storedPassword, cmd.Gender, cmd.Birth)
 				admin := administrators.NewAdministrator(cmd.FirstName, cmd.LastName, email, password, gender, birth, nil)
 				admin.AggregateRoot = abstractions.NewAggregateRoot(cmd.Id)
+				mockRepo.On("GetById", mock.Anything, cmd.Id).Return(admin, nil)
 
 				mockRepo.On("Update", mock.Anything, mock.AnythingOfType("*administrators.Administrator")).Return(admin, nil)
 			}
@@ -222,47 +249,6 @@ func TestHandleUpdate_ExistenceCheck(t *testing.T) {
 	}
 }
 
-func TestHandleUpdate_PasswordError(t *testing.T) {
-	ctx := context.Background()
-	mockRepo := new(MockRepository)
-	mockFactory := new(MockFactory)
-	handler := NewAdministratorHandler(mockRepo, mockFactory)
-
-	assert.NotNil(t, handler)
-
-	cmd := commands.UpdateAdministratorCommand{
-		Id:        uuid.New(),
-		FirstName: "Jane",
-		LastName:  "Doe",
-		Email:     "valid@email.com",
-		Password:  "",
-		Gender:    "female",
-		Birth:     time.Now().AddDate(-25, 0, 0),
-	}
-
-	mockRepo.On("ExistById", mock.Anything, cmd.Id).Return(true, nil)
-
-	resp, err := handler.HandleUpdate(ctx, cmd)
-
-	assert.ErrorIs(t, err, vo.ErrEmptyPassword)
-	assert.Nil(t, resp)
-
-	cmd.Password = "Abcdef1!Abcdef1!Abcdef1!Abcdef1!Abcdef1!Abcdef1!Abcdef1!Abcdef1!Abcdef1!"
-	resp, err = handler.HandleUpdate(ctx, cmd)
-	assert.ErrorIs(t, err, vo.ErrLongPassword)
-	assert.Nil(t, resp)
-
-	cmd.Password = "short"
-	resp, err = handler.HandleUpdate(ctx, cmd)
-	assert.ErrorIs(t, err, vo.ErrShortPassword)
-	assert.Nil(t, resp)
-
-	cmd.Password = "softpassword"
-	resp, err = handler.HandleUpdate(ctx, cmd)
-	assert.ErrorIs(t, err, vo.ErrSoftPassword)
-	assert.Nil(t, resp)
-}
-
 func TestHandleUpdate_GenderError(t *testing.T) {
 	ctx := context.Background()
 	mockRepo := new(MockRepository)
@@ -276,7 +262,6 @@ func TestHandleUpdate_GenderError(t *testing.T) {
 		FirstName: "Jane",
 		LastName:  "Doe",
 		Email:     "valid@email.com",
-		Password:  "strong!1S",
 		Gender:    "X",
 		Birth:     time.Now().AddDate(-25, 0, 0),
 	}
@@ -302,7 +287,6 @@ func TestHandleUpdate_BirthError(t *testing.T) {
 		FirstName: "Jane",
 		LastName:  "Doe",
 		Email:     "valid@email.com",
-		Password:  "strong!1S",
 		Gender:    "F",
 		Birth:     time.Now().AddDate(5, 0, 0),
 	}
@@ -335,7 +319,6 @@ func TestHandleUpdate_PhoneError(t *testing.T) {
 		FirstName: "Jane",
 		LastName:  "Doe",
 		Email:     "valid@email.com",
-		Password:  "strong!1S",
 		Gender:    "F",
 		Birth:     time.Now().AddDate(-25, 0, 0),
 		Phone:     &phone,
@@ -1,3 +1,10 @@
 package queries
 
-type GetAllAdministratorsQuery struct{}
+import "github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/abstractions"
+
+type GetAllAdministratorsQuery struct {
+	Name       string
+	Gender     string
+	Deleted    *bool
+	Pagination abstractions.Pagination
+}
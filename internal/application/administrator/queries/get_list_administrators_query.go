@@ -1,3 +1,9 @@
 package queries
 
-type GetListAdministratorsQuery struct{}
+import "github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/abstractions"
+
+type GetListAdministratorsQuery struct {
+	Name       string
+	Gender     string
+	Pagination abstractions.Pagination
+}
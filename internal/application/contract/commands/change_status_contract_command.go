@@ -5,4 +5,5 @@ import "github.com/google/uuid"
 type ChangeStatusContractCommand struct {
 	Id     uuid.UUID
 	Status string
+	Reason string
 }
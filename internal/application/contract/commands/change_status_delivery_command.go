@@ -0,0 +1,9 @@
+package commands
+
+import "github.com/google/uuid"
+
+type ChangeStatusDeliveryCommand struct {
+	ContractId    uuid.UUID
+	DeliveryDayId uuid.UUID
+	Status        string
+}
@@ -0,0 +1,9 @@
+package commands
+
+import "github.com/google/uuid"
+
+type ChooseDeliverySlotCommand struct {
+	ContractId uuid.UUID
+	DeliveryId uuid.UUID
+	SlotId     uuid.UUID
+}
@@ -10,9 +10,14 @@ type CreateContractCommand struct {
 	PatientId       uuid.UUID
 	ContractType    string
 	StartDate       time.Time
+	Weekdays        []string
+	ExcludedDates   []time.Time
 	Cost            int
+	Currency        string
+	PromoCode       string
 	Street          string
 	Number          int
 	Latitude        float64
 	Longitude       float64
+	SlotId          *uuid.UUID
 }
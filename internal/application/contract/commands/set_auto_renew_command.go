@@ -0,0 +1,8 @@
+package commands
+
+import "github.com/google/uuid"
+
+type SetAutoRenewCommand struct {
+	ContractId uuid.UUID
+	Enabled    bool
+}
@@ -10,9 +10,16 @@ type ContractDTO struct {
 	PatientId       string         `json:"patientId"`
 	ContractType    string         `json:"contractType"`
 	ContractStatus  string         `json:"contractStatus"`
+	StatusReason    string         `json:"statusReason,omitempty"`
+	SuspendedAt     *time.Time     `json:"suspendedAt,omitempty"`
+	AutoRenew       bool           `json:"autoRenew"`
+	RenewedFrom     string         `json:"renewedFrom,omitempty"`
 	CreationDate    time.Time      `json:"creationDate"`
 	StartDate       time.Time      `json:"startDate"`
 	EndDate         time.Time      `json:"endDate,omitempty"`
-	CostValue       int            `json:"costValue"`
+	CostValue       MoneyDTO       `json:"costValue"`
+	Price           *PriceDTO      `json:"price,omitempty"`
+	Weekdays        []string       `json:"weekdays"`
+	SlotId          string         `json:"slotId,omitempty"`
 	Deliveries      []*DeliveryDTO `json:"deliveries"`
 }
@@ -13,4 +13,6 @@ type DeliveryDTO struct {
 	Latitude   float64   `json:"latitude"`
 	Longitude  float64   `json:"longitude"`
 	Status     string    `json:"status"`
+	CourierId  string    `json:"courierId,omitempty"`
+	SlotId     string    `json:"slotId,omitempty"`
 }
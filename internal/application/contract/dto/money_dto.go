@@ -0,0 +1,6 @@
+package dto
+
+type MoneyDTO struct {
+	Amount   int    `json:"amount"`
+	Currency string `json:"currency"`
+}
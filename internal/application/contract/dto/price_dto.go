@@ -0,0 +1,19 @@
+package dto
+
+type PriceDTO struct {
+	Base       MoneyDTO  `json:"base"`
+	Deliveries int       `json:"deliveries"`
+	Zone       string    `json:"zone,omitempty"`
+	Surcharge  MoneyDTO  `json:"surcharge"`
+	Subtotal   MoneyDTO  `json:"subtotal"`
+	PromoCode  string    `json:"promoCode,omitempty"`
+	Discount   MoneyDTO  `json:"discount"`
+	Taxes      []*TaxDTO `json:"taxes"`
+	Total      MoneyDTO  `json:"total"`
+}
+
+type TaxDTO struct {
+	Name   string   `json:"name"`
+	Rate   int      `json:"rate"`
+	Amount MoneyDTO `json:"amount"`
+}
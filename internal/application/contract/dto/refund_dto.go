@@ -0,0 +1,14 @@
+package dto
+
+type RefundDTO struct {
+	ContractId string   `json:"contractId"`
+	Deliveries int      `json:"deliveries"`
+	Delivered  int      `json:"delivered"`
+	Pending    int      `json:"pending"`
+	Cancelled  int      `json:"cancelled"`
+	UnitPrice  MoneyDTO `json:"unitPrice"`
+	Consumed   MoneyDTO `json:"consumed"`
+	Refundable MoneyDTO `json:"refundable"`
+	Fee        MoneyDTO `json:"fee"`
+	Total      MoneyDTO `json:"total"`
+}
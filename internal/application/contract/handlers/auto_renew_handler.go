@@ -0,0 +1,55 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/contract"
+	"log"
+	"time"
+)
+
+var errSkipRenewal = errors.New("renewal skipped")
+
+func (h *ContractHandler) HandleAutoRenew(ctx context.Context, now time.Time) (int, error) {
+	// A renewal starts the day after the contract ends and never in the past,
+	// so contracts that ended before yesterday are no longer renewable.
+	since := today(now).AddDate(0, 0, -1)
+	until := today(now).AddDate(0, 0, contracts.AutoRenewOptOutDays+1)
+
+	ids, err := h.repository.GetAutoRenewable(ctx, since, until)
+	if err != nil {
+		log.Printf("[handler:contract][HandleAutoRenew] error getting renewable contracts: %v", err)
+		return 0, err
+	}
+
+	renewed := 0
+	var errs []error
+	for _, id := range ids {
+		err = h.uow.Do(ctx, func(ctx context.Context) error {
+			contract, err := h.repository.GetById(ctx, id)
+			if err != nil {
+				return err
+			}
+
+			// The patient may have opted out since the contract was listed.
+			if !contract.AutoRenewDue(now) {
+				return errSkipRenewal
+			}
+
+			_, err = h.renew(ctx, contract, now)
+			return err
+		})
+		if errors.Is(err, errSkipRenewal) {
+			continue
+		} else if err != nil {
+			log.Printf("[handler:contract][HandleAutoRenew] error renewing contract '%s': %v", id, err)
+			errs = append(errs, fmt.Errorf("contract %s: %w", id, err))
+			continue
+		}
+		renewed++
+	}
+
+	log.Printf("[handler:contract][HandleAutoRenew] renewed %d of %d contracts", renewed, len(ids))
+	return renewed, errors.Join(errs...)
+}
@@ -2,16 +2,33 @@ package handlers
 
 import (
 	"context"
-	"errors"
 	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/contract/commands"
 	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/contract"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/delivery"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/holiday"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/invoice"
 	"log"
+	"time"
 )
 
 func (h *ContractHandler) HandleChangeStatus(ctx context.Context, cmd commands.ChangeStatusContractCommand) (*contracts.Contract, error) {
+	var newContract *contracts.Contract
+	err := h.uow.Do(ctx, func(ctx context.Context) error {
+		var err error
+		newContract, err = h.changeStatus(ctx, cmd)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return newContract, nil
+}
+
+func (h *ContractHandler) changeStatus(ctx context.Context, cmd commands.ChangeStatusContractCommand) (*contracts.Contract, error) {
 	contract, err := h.repository.GetById(ctx, cmd.Id)
 	if err != nil {
-		log.Printf("[handler:contract][HandleChangeStatus] error getting contrac: %v", err)
+		log.Printf("[handler:contract][HandleChangeStatus] error getting contract: %v", err)
 		return nil, err
 	}
 
@@ -21,26 +38,72 @@ func (h *ContractHandler) HandleChangeStatus(ctx context.Context, cmd commands.C
 		return nil, err
 	}
 
-	s := contract.ContractStatus()
-	if s == status {
-		log.Printf("[handler:contract][HandleChangeStatus] contract status is already set to %s", status.String())
-		return nil, errors.New("contract status is already set to " + status.String())
-	} else if s == "created" && status.String() != "active" {
-		log.Printf("[handler:contract][HandleChangeStatus] contract '%s' cannot change from created to completed", status.String())
-		return nil, errors.New("contract cannot change from created to completed")
-	} else if s == "active" && status.String() != "completed" {
-		log.Printf("[handler:contract][HandleChangeStatus] contract '%s' can change from active to created", status.String())
-		return nil, errors.New("contract cannot change from active to created")
-	} else if s == "completed" {
-		log.Printf("[handler:contract][HandleChangeStatus] contract '%s' cannot change completed status", status.String())
-		return nil, errors.New("contract cannot change completed status")
+	if status == contracts.Active && contract.ContractStatus() == contracts.Created {
+		if err = h.invoicer.CheckPaid(ctx, contract.Id()); err != nil {
+			log.Printf("[handler:contract][HandleChangeStatus] contract '%s' cannot be activated: %v", cmd.Id, err)
+			return nil, err
+		}
+	}
+
+	// The refund is worked out before the cancellation turns the pending
+	// deliveries into cancelled ones.
+	var refund *invoices.CancellationRefund
+	if status == contracts.Cancelled {
+		if refund, err = h.quoteRefund(ctx, contract); err != nil {
+			log.Printf("[handler:contract][HandleChangeStatus] error working out refund of contract '%s': %v", cmd.Id, err)
+			return nil, err
+		}
+	}
+
+	now := time.Now()
+	calendar, err := h.holidayCalendar(ctx, now)
+	if err != nil {
+		log.Printf("[handler:contract][HandleChangeStatus] error getting holidays: %v", err)
+		return nil, err
 	}
 
-	newContract, err := h.repository.ChangeStatus(ctx, cmd.Id, cmd.Status)
+	changed, err := transition(contract, status, cmd.Reason, calendar, now)
 	if err != nil {
-		log.Printf("[handler:contract][HandleChangeStatus] error changing status in Contract exists: %v", err)
+		log.Printf("[handler:contract][HandleChangeStatus] contract '%s' cannot change from %s to %s: %v", cmd.Id, contract.ContractStatus().String(), status.String(), err)
 		return nil, err
 	}
 
+	newContract, err := h.repository.ChangeStatus(ctx, contract)
+	if err != nil {
+		log.Printf("[handler:contract][HandleChangeStatus] error changing status of contract '%s': %v", cmd.Id, err)
+		return nil, err
+	}
+
+	if err = h.repository.RescheduleDeliveries(ctx, contract.Id(), changed); err != nil {
+		log.Printf("[handler:contract][HandleChangeStatus] error saving deliveries of contract '%s': %v", cmd.Id, err)
+		return nil, err
+	}
+
+	if refund != nil {
+		if _, err = h.invoicer.Credit(ctx, *refund, cmd.Reason, now); err != nil {
+			log.Printf("[handler:contract][HandleChangeStatus] error crediting refund of contract '%s': %v", cmd.Id, err)
+			return nil, err
+		}
+	}
+
+	log.Printf("[handler:contract][HandleChangeStatus] contract '%s' changed to %s, %d deliveries updated", cmd.Id, status.String(), len(changed))
 	return newContract, nil
 }
+
+func transition(contract *contracts.Contract, status contracts.ContractStatus, reason string, calendar *holidays.Calendar, now time.Time) ([]*deliveries.Delivery, error) {
+	switch status {
+	case contracts.Active:
+		if contract.ContractStatus() == contracts.Suspended {
+			return contract.Resume(now, calendar)
+		}
+		return nil, contract.Active()
+	case contracts.Finished:
+		return nil, contract.Completed()
+	case contracts.Suspended:
+		return nil, contract.Suspend(reason, now)
+	case contracts.Cancelled:
+		return contract.Cancel(reason)
+	default:
+		return nil, contracts.ErrChangeStatusContract
+	}
+}
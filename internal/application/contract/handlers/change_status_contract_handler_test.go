@@ -0,0 +1,246 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/contract/commands"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/contract"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/delivery"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/holiday"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/invoice"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+func newActiveContract(t *testing.T) *contracts.Contract {
+	coords, err := valueobjects.NewCoordinates(-17.7863, -63.1812)
+	assert.NoError(t, err)
+
+	contract := contracts.NewContract(uuid.New(), uuid.New(), halfMonthPlan, time.Now().AddDate(0, 0, 3), tenBolivianos, "Sesame Street", 30, coords)
+	assert.NoError(t, contract.Active())
+	contract.ClearDomainEvents()
+	return contract
+}
+
+func TestContractHandler_HandleChangeStatus(t *testing.T) {
+	cases := []struct {
+		name     string
+		status   string
+		reason   string
+		expected contracts.ContractStatus
+		changed  int
+	}{
+		{"complete", "finished", "", contracts.Finished, 0},
+		{"suspend", "suspended", "travel", contracts.Suspended, 0},
+		{"cancel", "cancelled", "moved abroad", contracts.Cancelled, 15},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			mockRepo := new(MockRepository)
+			uow := new(MockUnitOfWork)
+			handler := NewContractHandler(mockRepo, newMockPlans(), newMockHolidays(), newBooker(), newPricer(), newInvoicer(), new(MockFactory), uow)
+			contract := newActiveContract(t)
+
+			mockRepo.On("GetById", mock.Anything, contract.Id()).Return(contract, nil)
+			mockRepo.On("ChangeStatus", mock.Anything, contract).Return(contract, nil)
+			mockRepo.On("RescheduleDeliveries", mock.Anything, contract.Id(), mock.MatchedBy(func(d []*deliveries.Delivery) bool {
+				return len(d) == tc.changed
+			})).Return(nil)
+
+			resp, err := handler.HandleChangeStatus(ctx, commands.ChangeStatusContractCommand{Id: contract.Id(), Status: tc.status, Reason: tc.reason})
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, resp.ContractStatus())
+			assert.Equal(t, tc.reason, resp.StatusReason())
+			assert.Equal(t, 1, uow.committed)
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestContractHandler_HandleChangeStatus_Resume(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(MockRepository)
+	handler := NewContractHandler(mockRepo, newMockPlans(), newMockHolidays(), newBooker(), newPricer(), newInvoicer(), new(MockFactory), new(MockUnitOfWork))
+
+	contract := newActiveContract(t)
+	end := contract.EndDate()
+	assert.NoError(t, contract.Suspend("travel", time.Now().AddDate(0, 0, -2)))
+
+	mockRepo.On("GetById", mock.Anything, contract.Id()).Return(contract, nil)
+	mockRepo.On("ChangeStatus", mock.Anything, contract).Return(contract, nil)
+	mockRepo.On("RescheduleDeliveries", mock.Anything, contract.Id(), mock.MatchedBy(func(d []*deliveries.Delivery) bool {
+		return len(d) == 15
+	})).Return(nil)
+
+	resp, err := handler.HandleChangeStatus(ctx, commands.ChangeStatusContractCommand{Id: contract.Id(), Status: "active"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, contracts.Active, resp.ContractStatus())
+	assert.Equal(t, end.AddDate(0, 0, 2), resp.EndDate())
+	mockRepo.AssertExpectations(t)
+}
+
+func TestContractHandler_HandleChangeStatus_Errors(t *testing.T) {
+	cases := []struct {
+		name   string
+		status string
+		reason string
+		err    error
+	}{
+		{"unknown status", "paused", "", contracts.ErrStatusContract},
+		{"same status", "active", "", contracts.ErrChangeStatusContract},
+		{"back to created", "created", "", contracts.ErrChangeStatusContract},
+		{"missing reason", "cancelled", "", contracts.ErrReasonContract},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			mockRepo := new(MockRepository)
+			uow := new(MockUnitOfWork)
+			handler := NewContractHandler(mockRepo, newMockPlans(), newMockHolidays(), newBooker(), newPricer(), newInvoicer(), new(MockFactory), uow)
+			contract := newActiveContract(t)
+
+			mockRepo.On("GetById", mock.Anything, contract.Id()).Return(contract, nil)
+
+			resp, err := handler.HandleChangeStatus(ctx, commands.ChangeStatusContractCommand{Id: contract.Id(), Status: tc.status, Reason: tc.reason})
+
+			assert.Nil(t, resp)
+			assert.ErrorIs(t, err, tc.err)
+			assert.Equal(t, 1, uow.rolledBack)
+			mockRepo.AssertNotCalled(t, "ChangeStatus", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestContractHandler_HandleChangeStatus_RepositoryError(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(MockRepository)
+	uow := new(MockUnitOfWork)
+	handler := NewContractHandler(mockRepo, newMockPlans(), newMockHolidays(), newBooker(), newPricer(), newInvoicer(), new(MockFactory), uow)
+	contract := newActiveContract(t)
+
+	mockRepo.On("GetById", mock.Anything, contract.Id()).Return(contract, nil)
+	mockRepo.On("ChangeStatus", mock.Anything, contract).Return(contract, nil)
+	mockRepo.On("RescheduleDeliveries", mock.Anything, contract.Id(), mock.Anything).Return(ErrDbFailureContract)
+
+	resp, err := handler.HandleChangeStatus(ctx, commands.ChangeStatusContractCommand{Id: contract.Id(), Status: "cancelled", Reason: "moved abroad"})
+
+	assert.Nil(t, resp)
+	assert.ErrorIs(t, err, ErrDbFailureContract)
+	assert.Equal(t, 1, uow.rolledBack)
+}
+
+func TestContractHandler_HandleChangeStatus_ResumeHoliday(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(MockRepository)
+	mockHolidays := new(MockHolidayRepository)
+	uow := new(MockUnitOfWork)
+	handler := NewContractHandler(mockRepo, newMockPlans(), mockHolidays, newBooker(), newPricer(), newInvoicer(), new(MockFactory), uow)
+
+	contract := newActiveContract(t)
+	assert.NoError(t, contract.Suspend("travel", time.Now().AddDate(0, 0, -2)))
+	closure := holidays.NewHoliday(contract.StartDate().AddDate(0, 0, 4), "Closure", nil, holidays.Refuse)
+
+	mockRepo.On("GetById", mock.Anything, contract.Id()).Return(contract, nil)
+	mockHolidays.On("GetBetween", mock.Anything, mock.Anything, mock.Anything).Return([]*holidays.Holiday{closure}, nil)
+
+	resp, err := handler.HandleChangeStatus(ctx, commands.ChangeStatusContractCommand{Id: contract.Id(), Status: "active"})
+
+	assert.Nil(t, resp)
+	assert.ErrorIs(t, err, contracts.ErrHolidayContract)
+	assert.Equal(t, contracts.Suspended, contract.ContractStatus())
+	assert.Equal(t, 1, uow.rolledBack)
+	mockRepo.AssertNotCalled(t, "ChangeStatus", mock.Anything, mock.Anything)
+}
+
+func TestContractHandler_HandleChangeStatus_HolidayError(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(MockRepository)
+	mockHolidays := new(MockHolidayRepository)
+	handler := NewContractHandler(mockRepo, newMockPlans(), mockHolidays, newBooker(), newPricer(), newInvoicer(), new(MockFactory), new(MockUnitOfWork))
+	contract := newActiveContract(t)
+
+	mockRepo.On("GetById", mock.Anything, contract.Id()).Return(contract, nil)
+	mockHolidays.On("GetBetween", mock.Anything, mock.Anything, mock.Anything).Return(nil, ErrDbFailureContract)
+
+	resp, err := handler.HandleChangeStatus(ctx, commands.ChangeStatusContractCommand{Id: contract.Id(), Status: "suspended", Reason: "travel"})
+
+	assert.Nil(t, resp)
+	assert.ErrorIs(t, err, ErrDbFailureContract)
+	assert.Equal(t, contracts.Active, contract.ContractStatus())
+}
+
+func TestContractHandler_HandleChangeStatus_Unpaid(t *testing.T) {
+	mockRepo := new(MockRepository)
+	mockInvoices := new(MockInvoiceRepository)
+	uow := new(MockUnitOfWork)
+	handler := NewContractHandler(mockRepo, newMockPlans(), newMockHolidays(), newBooker(), newPricer(), invoices.NewInvoicer(mockInvoices, invoices.NewInvoiceFactory(), "NC", true, invoices.CancellationFee{}), new(MockFactory), uow)
+	contract := newStartedContract(t, time.Now().AddDate(0, 0, 3), false)
+	invoice := invoices.NewInvoice("NC", 1, contract.Id(), contract.PatientId(), contract.CostValue(), time.Now())
+
+	mockRepo.On("GetById", mock.Anything, contract.Id()).Return(contract, nil)
+	mockInvoices.On("GetByContract", mock.Anything, contract.Id()).Return(invoice, nil)
+
+	resp, err := handler.HandleChangeStatus(context.Background(), commands.ChangeStatusContractCommand{Id: contract.Id(), Status: "active"})
+
+	assert.Nil(t, resp)
+	assert.ErrorIs(t, err, invoices.ErrUnpaidInvoice)
+	assert.Equal(t, contracts.Created, contract.ContractStatus())
+	assert.Equal(t, 1, uow.rolledBack)
+	mockRepo.AssertNotCalled(t, "ChangeStatus", mock.Anything, mock.Anything)
+}
+
+func TestContractHandler_HandleChangeStatus_CancelCredits(t *testing.T) {
+	mockRepo := new(MockRepository)
+	mockInvoices := new(MockInvoiceRepository)
+	uow := new(MockUnitOfWork)
+	fee, err := invoices.NewCancellationFee(1000, 0)
+	assert.NoError(t, err)
+	handler := NewContractHandler(mockRepo, newMockPlans(), newMockHolidays(), newBooker(), newPricer(), invoices.NewInvoicer(mockInvoices, invoices.NewInvoiceFactory(), "NC", false, fee), new(MockFactory), uow)
+	contract := newActiveContract(t)
+	invoice := invoices.NewInvoice("NC", 1, contract.Id(), contract.PatientId(), contract.CostValue(), time.Now())
+
+	mockRepo.On("GetById", mock.Anything, contract.Id()).Return(contract, nil)
+	mockRepo.On("ChangeStatus", mock.Anything, contract).Return(contract, nil)
+	mockRepo.On("RescheduleDeliveries", mock.Anything, contract.Id(), mock.Anything).Return(nil)
+	mockInvoices.On("GetByContract", mock.Anything, contract.Id()).Return(invoice, nil)
+	mockInvoices.On("AddCreditNote", mock.Anything, mock.MatchedBy(func(n *invoices.CreditNote) bool {
+		return n.InvoiceId() == invoice.Id() && n.Amount() == bolivianos(900) && n.Reason() == "moved abroad"
+	})).Return(nil, nil)
+
+	resp, err := handler.HandleChangeStatus(context.Background(), commands.ChangeStatusContractCommand{Id: contract.Id(), Status: "cancelled", Reason: "moved abroad"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, contracts.Cancelled, resp.ContractStatus())
+	assert.Equal(t, bolivianos(100), invoice.Balance())
+	assert.Equal(t, 1, uow.committed)
+	mockInvoices.AssertExpectations(t)
+}
+
+func TestContractHandler_HandleChangeStatus_CancelCreditError(t *testing.T) {
+	mockRepo := new(MockRepository)
+	mockInvoices := new(MockInvoiceRepository)
+	uow := new(MockUnitOfWork)
+	handler := NewContractHandler(mockRepo, newMockPlans(), newMockHolidays(), newBooker(), newPricer(), invoices.NewInvoicer(mockInvoices, invoices.NewInvoiceFactory(), "NC", false, invoices.CancellationFee{}), new(MockFactory), uow)
+	contract := newActiveContract(t)
+	invoice := invoices.NewInvoice("NC", 1, contract.Id(), contract.PatientId(), contract.CostValue(), time.Now())
+
+	mockRepo.On("GetById", mock.Anything, contract.Id()).Return(contract, nil)
+	mockRepo.On("ChangeStatus", mock.Anything, contract).Return(contract, nil)
+	mockRepo.On("RescheduleDeliveries", mock.Anything, contract.Id(), mock.Anything).Return(nil)
+	mockInvoices.On("GetByContract", mock.Anything, contract.Id()).Return(invoice, nil)
+	mockInvoices.On("AddCreditNote", mock.Anything, mock.Anything).Return(nil, ErrDbFailureContract)
+
+	resp, err := handler.HandleChangeStatus(context.Background(), commands.ChangeStatusContractCommand{Id: contract.Id(), Status: "cancelled", Reason: "moved abroad"})
+
+	assert.Nil(t, resp)
+	assert.ErrorIs(t, err, ErrDbFailureContract)
+	assert.Equal(t, 1, uow.rolledBack)
+}
@@ -0,0 +1,69 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/contract/commands"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/delivery"
+	"github.com/google/uuid"
+	"log"
+)
+
+func (h *ContractHandler) HandleChangeStatusDelivery(ctx context.Context, cmd commands.ChangeStatusDeliveryCommand) (*deliveries.Delivery, error) {
+	status, err := deliveries.ParseDeliveryStatus(cmd.Status)
+	if err != nil {
+		log.Printf("[handler:contract][HandleChangeStatusDelivery] error parsing delivery status: %v", err)
+		return nil, err
+	}
+
+	// Deliveries are delivered by recording their proof, this only cancels them.
+	if status == deliveries.Delivered {
+		log.Printf("[handler:contract][HandleChangeStatusDelivery] delivery '%s' cannot be delivered without a proof", cmd.DeliveryDayId)
+		return nil, deliveries.ErrRequiredProof
+	}
+
+	return h.changeStatusDelivery(ctx, cmd.ContractId, cmd.DeliveryDayId, status)
+}
+
+func (h *ContractHandler) changeStatusDelivery(ctx context.Context, contractId, deliveryId uuid.UUID, status deliveries.DeliveryStatus) (*deliveries.Delivery, error) {
+	var delivery *deliveries.Delivery
+	err := h.uow.Do(ctx, func(ctx context.Context) error {
+		var err error
+		delivery, err = h.contractDelivery(ctx, contractId, deliveryId)
+		if err != nil {
+			log.Printf("[handler:contract][changeStatusDelivery] error getting delivery '%s': %v", deliveryId, err)
+			return err
+		}
+
+		if err = delivery.ChangeStatus(status); err != nil {
+			log.Printf("[handler:contract][changeStatusDelivery] delivery '%s' cannot change to %s: %v", deliveryId, status.String(), err)
+			return err
+		}
+
+		delivery, err = h.repository.ChangeStatusDelivery(ctx, delivery)
+		if err != nil {
+			log.Printf("[handler:contract][changeStatusDelivery] error saving delivery '%s' status: %v", deliveryId, err)
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("[handler:contract][changeStatusDelivery] delivery '%s' changed to %s", deliveryId, status.String())
+	return delivery, nil
+}
+
+func (h *ContractHandler) contractDelivery(ctx context.Context, contractId, deliveryId uuid.UUID) (*deliveries.Delivery, error) {
+	delivery, err := h.repository.GetDeliveriesById(ctx, deliveryId)
+	if err != nil {
+		return nil, err
+	}
+
+	if delivery.ContractId() != contractId {
+		return nil, deliveries.ErrContractMismatchDelivery
+	}
+
+	return delivery, nil
+}
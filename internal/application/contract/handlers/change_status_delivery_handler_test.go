@@ -0,0 +1,122 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/contract/commands"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/delivery"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+)
+
+func TestContractHandler_HandleChangeStatusDelivery(t *testing.T) {
+	ctx := context.Background()
+	contractId := uuid.New()
+
+	cases := []struct {
+		name, status string
+		expected     deliveries.DeliveryStatus
+	}{
+		{"Cancelled", "C", deliveries.Cancelled},
+		{"Cancelled by name", "cancelled", deliveries.Cancelled},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockRepo := new(MockRepository)
+			handler := NewContractHandler(mockRepo, newMockPlans(), newMockHolidays(), newBooker(), newPricer(), newInvoicer(), new(MockFactory), new(MockUnitOfWork))
+			delivery := newDelivery(t, contractId, "P")
+
+			cmd := commands.ChangeStatusDeliveryCommand{ContractId: contractId, DeliveryDayId: delivery.Id(), Status: tc.status}
+			mockRepo.On("GetDeliveriesById", mock.Anything, delivery.Id()).Return(delivery, nil)
+			mockRepo.On("ChangeStatusDelivery", mock.Anything, delivery).Return(delivery, nil)
+
+			resp, err := handler.HandleChangeStatusDelivery(ctx, cmd)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, resp.Status())
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestContractHandler_HandleChangeStatusDelivery_Errors(t *testing.T) {
+	ctx := context.Background()
+	contractId := uuid.New()
+
+	t.Run("Delivered without proof", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		handler := NewContractHandler(mockRepo, newMockPlans(), newMockHolidays(), newBooker(), newPricer(), newInvoicer(), new(MockFactory), new(MockUnitOfWork))
+
+		cmd := commands.ChangeStatusDeliveryCommand{ContractId: contractId, DeliveryDayId: uuid.New(), Status: "delivered"}
+
+		resp, err := handler.HandleChangeStatusDelivery(ctx, cmd)
+
+		assert.ErrorIs(t, err, deliveries.ErrRequiredProof)
+		assert.Nil(t, resp)
+		mockRepo.AssertNotCalled(t, "GetDeliveriesById", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Invalid status", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		handler := NewContractHandler(mockRepo, newMockPlans(), newMockHolidays(), newBooker(), newPricer(), newInvoicer(), new(MockFactory), new(MockUnitOfWork))
+
+		cmd := commands.ChangeStatusDeliveryCommand{ContractId: contractId, DeliveryDayId: uuid.New(), Status: "X"}
+
+		resp, err := handler.HandleChangeStatusDelivery(ctx, cmd)
+
+		assert.ErrorIs(t, err, deliveries.ErrNotADeliveryStatus)
+		assert.Nil(t, resp)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Already delivered", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		handler := NewContractHandler(mockRepo, newMockPlans(), newMockHolidays(), newBooker(), newPricer(), newInvoicer(), new(MockFactory), new(MockUnitOfWork))
+		delivery := newDelivery(t, contractId, "D")
+
+		cmd := commands.ChangeStatusDeliveryCommand{ContractId: contractId, DeliveryDayId: delivery.Id(), Status: "cancelled"}
+		mockRepo.On("GetDeliveriesById", mock.Anything, delivery.Id()).Return(delivery, nil)
+
+		resp, err := handler.HandleChangeStatusDelivery(ctx, cmd)
+
+		assert.ErrorIs(t, err, deliveries.ErrCannotChangeDeliveryStatus)
+		assert.Nil(t, resp)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Not found", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		handler := NewContractHandler(mockRepo, newMockPlans(), newMockHolidays(), newBooker(), newPricer(), newInvoicer(), new(MockFactory), new(MockUnitOfWork))
+		id := uuid.New()
+
+		cmd := commands.ChangeStatusDeliveryCommand{ContractId: contractId, DeliveryDayId: id, Status: "cancelled"}
+		mockRepo.On("GetDeliveriesById", mock.Anything, id).Return(nil, deliveries.ErrNotFoundDelivery)
+
+		resp, err := handler.HandleChangeStatusDelivery(ctx, cmd)
+
+		assert.ErrorIs(t, err, deliveries.ErrNotFoundDelivery)
+		assert.Nil(t, resp)
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestContractHandler_HandleDeleteDelivery(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(MockRepository)
+	handler := NewContractHandler(mockRepo, newMockPlans(), newMockHolidays(), newBooker(), newPricer(), newInvoicer(), new(MockFactory), new(MockUnitOfWork))
+
+	contractId := uuid.New()
+	delivery := newDelivery(t, contractId, "P")
+
+	cmd := commands.DeleteDeliveryCommand{ContractId: contractId, DeliveryDayId: delivery.Id()}
+	mockRepo.On("GetDeliveriesById", mock.Anything, delivery.Id()).Return(delivery, nil)
+	mockRepo.On("ChangeStatusDelivery", mock.Anything, delivery).Return(delivery, nil)
+
+	resp, err := handler.HandleDeleteDelivery(ctx, cmd)
+
+	assert.NoError(t, err)
+	assert.Equal(t, deliveries.Cancelled, resp.Status())
+	mockRepo.AssertExpectations(t)
+}
@@ -0,0 +1,54 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/contract/commands"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/delivery"
+	"log"
+	"time"
+)
+
+func (h *ContractHandler) HandleChooseDeliverySlot(ctx context.Context, cmd commands.ChooseDeliverySlotCommand) (*deliveries.Delivery, error) {
+	var delivery *deliveries.Delivery
+	err := h.uow.Do(ctx, func(ctx context.Context) error {
+		var err error
+		delivery, err = h.contractDelivery(ctx, cmd.ContractId, cmd.DeliveryId)
+		if err != nil {
+			log.Printf("[handler:contract][HandleChooseDeliverySlot] error getting delivery '%s': %v", cmd.DeliveryId, err)
+			return err
+		}
+
+		if current := delivery.SlotId(); current != nil && *current == cmd.SlotId {
+			return nil
+		}
+
+		slot, err := h.booker.Slot(ctx, cmd.SlotId, delivery.Coordinates())
+		if err != nil {
+			log.Printf("[handler:contract][HandleChooseDeliverySlot] error getting slot '%s': %v", cmd.SlotId, err)
+			return err
+		}
+
+		if err = delivery.Book(slot); err != nil {
+			log.Printf("[handler:contract][HandleChooseDeliverySlot] error booking delivery '%s': %v", cmd.DeliveryId, err)
+			return err
+		}
+
+		if err = h.booker.Reserve(ctx, slot, []time.Time{delivery.Date()}); err != nil {
+			log.Printf("[handler:contract][HandleChooseDeliverySlot] slot '%s' has no room: %v", cmd.SlotId, err)
+			return err
+		}
+
+		delivery, err = h.repository.BookDelivery(ctx, delivery)
+		if err != nil {
+			log.Printf("[handler:contract][HandleChooseDeliverySlot] error saving delivery '%s': %v", cmd.DeliveryId, err)
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("[handler:contract][HandleChooseDeliverySlot] delivery '%s' booked into slot '%s'", cmd.DeliveryId, cmd.SlotId)
+	return delivery, nil
+}
@@ -0,0 +1,124 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/contract/commands"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/delivery"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/pricing"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/slot"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+func TestContractHandler_HandleChooseDeliverySlot(t *testing.T) {
+	mockRepo := new(MockRepository)
+	mockSlots := new(MockSlotRepository)
+	mockZones := new(MockZoneRepository)
+	uow := new(MockUnitOfWork)
+	handler := NewContractHandler(mockRepo, newMockPlans(), newMockHolidays(), slots.NewBooker(mockSlots, mockZones), newPricer(), newInvoicer(), new(MockFactory), uow)
+
+	contractId := uuid.New()
+	delivery := newDelivery(t, contractId, "P")
+	zone := pricing.NewZone("Downtown", delivery.Coordinates(), 1000, bolivianos(100))
+	slot := slots.NewSlot(zone.Id(), "Dinner", 19*time.Hour, 21*time.Hour, 20)
+
+	mockRepo.On("GetDeliveriesById", mock.Anything, delivery.Id()).Return(delivery, nil)
+	mockRepo.On("BookDelivery", mock.Anything, delivery).Return(delivery, nil)
+	mockSlots.On("GetById", mock.Anything, slot.Id()).Return(slot, nil)
+	mockSlots.On("Lock", mock.Anything, slot.Id()).Return(nil)
+	mockSlots.On("CountBooked", mock.Anything, slot.Id(), mock.Anything, mock.Anything).Return(map[string]int{delivery.Date().Format(time.DateOnly): 19}, nil)
+	mockZones.On("GetAll", mock.Anything).Return([]*pricing.Zone{zone}, nil)
+
+	resp, err := handler.HandleChooseDeliverySlot(context.Background(), commands.ChooseDeliverySlotCommand{ContractId: contractId, DeliveryId: delivery.Id(), SlotId: slot.Id()})
+
+	assert.NoError(t, err)
+	assert.Equal(t, slot.Id(), *resp.SlotId())
+	assert.Equal(t, 19, resp.Date().Hour())
+	assert.Equal(t, 1, uow.committed)
+
+	mockRepo.AssertExpectations(t)
+	mockSlots.AssertExpectations(t)
+}
+
+func TestContractHandler_HandleChooseDeliverySlot_SameSlot(t *testing.T) {
+	mockRepo := new(MockRepository)
+	mockSlots := new(MockSlotRepository)
+	handler := NewContractHandler(mockRepo, newMockPlans(), newMockHolidays(), slots.NewBooker(mockSlots, newPricerZones()), newPricer(), newInvoicer(), new(MockFactory), new(MockUnitOfWork))
+
+	contractId := uuid.New()
+	delivery := newDelivery(t, contractId, "P")
+	slot := slots.NewSlot(uuid.New(), "Dinner", 19*time.Hour, 21*time.Hour, 20)
+	assert.NoError(t, delivery.Book(slot))
+
+	mockRepo.On("GetDeliveriesById", mock.Anything, delivery.Id()).Return(delivery, nil)
+
+	resp, err := handler.HandleChooseDeliverySlot(context.Background(), commands.ChooseDeliverySlotCommand{ContractId: contractId, DeliveryId: delivery.Id(), SlotId: slot.Id()})
+
+	assert.NoError(t, err)
+	assert.Equal(t, delivery, resp)
+	mockSlots.AssertNotCalled(t, "GetById", mock.Anything, mock.Anything)
+	mockRepo.AssertNotCalled(t, "BookDelivery", mock.Anything, mock.Anything)
+}
+
+func TestContractHandler_HandleChooseDeliverySlot_Errors(t *testing.T) {
+	contractId := uuid.New()
+
+	cases := []struct {
+		name   string
+		status string
+		owner  uuid.UUID
+		zone   bool
+		booked int
+		slot   error
+		save   error
+		err    error
+	}{
+		{"Delivery of another contract", "P", uuid.New(), true, 0, nil, nil, deliveries.ErrContractMismatchDelivery},
+		{"Slot not found", "P", contractId, true, 0, slots.ErrNotFoundSlot, nil, slots.ErrNotFoundSlot},
+		{"Slot of another zone", "P", contractId, false, 0, nil, nil, slots.ErrZoneSlot},
+		{"Delivery not pending", "D", contractId, true, 0, nil, nil, deliveries.ErrNotPendingDelivery},
+		{"Slot full", "P", contractId, true, 20, nil, nil, slots.ErrFullSlot},
+		{"Error saving delivery", "P", contractId, true, 0, nil, ErrDbFailureContract, ErrDbFailureContract},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockRepo := new(MockRepository)
+			mockSlots := new(MockSlotRepository)
+			mockZones := new(MockZoneRepository)
+			uow := new(MockUnitOfWork)
+			handler := NewContractHandler(mockRepo, newMockPlans(), newMockHolidays(), slots.NewBooker(mockSlots, mockZones), newPricer(), newInvoicer(), new(MockFactory), uow)
+
+			delivery := newDelivery(t, tc.owner, tc.status)
+			zone := pricing.NewZone("Downtown", delivery.Coordinates(), 1000, bolivianos(100))
+			zoneId := uuid.New()
+			if tc.zone {
+				zoneId = zone.Id()
+			}
+			slot := slots.NewSlot(zoneId, "Dinner", 19*time.Hour, 21*time.Hour, 20)
+
+			mockRepo.On("GetDeliveriesById", mock.Anything, delivery.Id()).Return(delivery, nil)
+			mockRepo.On("BookDelivery", mock.Anything, delivery).Return(nil, tc.save).Maybe()
+			if tc.slot != nil {
+				mockSlots.On("GetById", mock.Anything, slot.Id()).Return(nil, tc.slot).Maybe()
+			} else {
+				mockSlots.On("GetById", mock.Anything, slot.Id()).Return(slot, nil).Maybe()
+			}
+			mockSlots.On("Lock", mock.Anything, slot.Id()).Return(nil).Maybe()
+			mockSlots.On("CountBooked", mock.Anything, slot.Id(), mock.Anything, mock.Anything).Return(map[string]int{delivery.Date().Format(time.DateOnly): tc.booked}, nil).Maybe()
+			mockZones.On("GetAll", mock.Anything).Return([]*pricing.Zone{zone}, nil).Maybe()
+
+			resp, err := handler.HandleChooseDeliverySlot(context.Background(), commands.ChooseDeliverySlotCommand{ContractId: contractId, DeliveryId: delivery.Id(), SlotId: slot.Id()})
+
+			assert.Nil(t, resp)
+			assert.ErrorIs(t, err, tc.err)
+			assert.Equal(t, 1, uow.rolledBack)
+			if tc.save == nil {
+				mockRepo.AssertNotCalled(t, "BookDelivery", mock.Anything, mock.Anything)
+			}
+		})
+	}
+}
@@ -1,15 +1,72 @@
 package handlers
 
-import "github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/contract"
+import (
+	"context"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/abstractions"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/contract"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/delivery"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/holiday"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/invoice"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/plan"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/pricing"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/slot"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	"github.com/google/uuid"
+	"time"
+)
 
 type ContractHandler struct {
 	repository contracts.ContractRepository
+	plans      plans.PlanRepository
+	holidays   holidays.HolidayRepository
+	booker     *slots.Booker
+	pricer     *pricing.Pricer
+	invoicer   *invoices.Invoicer
 	factory    contracts.ContractFactory
+	uow        abstractions.UnitOfWork
 }
 
-func NewContractHandler(r contracts.ContractRepository, f contracts.ContractFactory) *ContractHandler {
+func NewContractHandler(r contracts.ContractRepository, p plans.PlanRepository, hol holidays.HolidayRepository, b *slots.Booker, pr *pricing.Pricer, inv *invoices.Invoicer, f contracts.ContractFactory, u abstractions.UnitOfWork) *ContractHandler {
 	return &ContractHandler{
 		repository: r,
+		plans:      p,
+		holidays:   hol,
+		booker:     b,
+		pricer:     pr,
+		invoicer:   inv,
 		factory:    f,
+		uow:        u,
 	}
 }
+
+func (h *ContractHandler) holidayCalendar(ctx context.Context, from time.Time) (*holidays.Calendar, error) {
+	hs, err := h.holidays.GetBetween(ctx, from, from.AddDate(0, 0, contracts.MaxCalendarDays))
+	if err != nil {
+		return nil, err
+	}
+	return holidays.NewCalendar(hs...), nil
+}
+
+func (h *ContractHandler) issue(ctx context.Context, contract *contracts.Contract, at time.Time) error {
+	_, err := h.invoicer.Issue(ctx, contract.Id(), contract.PatientId(), contract.CostValue(), at)
+	return err
+}
+
+func (h *ContractHandler) book(ctx context.Context, contract *contracts.Contract, slotId uuid.UUID, at valueobjects.Coordinates) error {
+	slot, err := h.booker.Slot(ctx, slotId, at)
+	if err != nil {
+		return err
+	}
+
+	var days []time.Time
+	for _, d := range contract.Deliveries() {
+		if d.Status() == deliveries.Pending {
+			days = append(days, d.Date())
+		}
+	}
+
+	if err = h.booker.Reserve(ctx, slot, days); err != nil {
+		return err
+	}
+	return contract.Book(slot)
+}
@@ -0,0 +1,432 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/abstractions"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/contract"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/delivery"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/holiday"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/invoice"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/plan"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/pricing"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/slot"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+var ErrDbFailureContract = errors.New("db failure")
+
+var (
+	halfMonthPlan = plans.NewPlan("H", "Half-month", 15, plans.Daily, 1000)
+	monthlyPlan   = plans.NewPlan("M", "Monthly", 30, plans.Daily, 1800)
+	tenBolivianos = bolivianos(1000)
+)
+
+func bolivianos(amount int) valueobjects.Money {
+	m, _ := valueobjects.NewMoney(amount, valueobjects.BOB)
+	return m
+}
+
+type MockRepository struct {
+	mock.Mock
+}
+
+type MockPlanRepository struct {
+	plans.PlanRepository
+	mock.Mock
+}
+
+type MockHolidayRepository struct {
+	holidays.HolidayRepository
+	mock.Mock
+}
+
+type MockZoneRepository struct {
+	pricing.ZoneRepository
+	mock.Mock
+}
+
+type MockSlotRepository struct {
+	slots.SlotRepository
+	mock.Mock
+}
+
+type MockPromoCodeRepository struct {
+	pricing.PromoCodeRepository
+	mock.Mock
+}
+
+type MockInvoiceRepository struct {
+	invoices.InvoiceRepository
+	mock.Mock
+}
+
+type MockFactory struct {
+	mock.Mock
+}
+
+type MockUnitOfWork struct {
+	committed  int
+	rolledBack int
+}
+
+func TestNewContractHandler(t *testing.T) {
+	r := new(MockRepository)
+	f := new(MockFactory)
+	u := new(MockUnitOfWork)
+	h := NewContractHandler(r, new(MockPlanRepository), new(MockHolidayRepository), newBooker(), newPricer(), newInvoicer(), f, u)
+
+	assert.NotEmpty(t, h)
+}
+
+func (u *MockUnitOfWork) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	if err := fn(ctx); err != nil {
+		u.rolledBack++
+		return err
+	}
+	u.committed++
+	return nil
+}
+
+func newMockPlans() *MockPlanRepository {
+	m := new(MockPlanRepository)
+	m.On("GetByCode", mock.Anything, "H").Return(halfMonthPlan, nil).Maybe()
+	m.On("GetByCode", mock.Anything, "M").Return(monthlyPlan, nil).Maybe()
+	return m
+}
+
+func (m *MockPlanRepository) GetByCode(ctx context.Context, code string) (*plans.Plan, error) {
+	args := m.Called(ctx, code)
+
+	var result *plans.Plan
+	if v := args.Get(0); v != nil {
+		result = v.(*plans.Plan)
+	}
+
+	return result, args.Error(1)
+}
+
+func newMockHolidays() *MockHolidayRepository {
+	m := new(MockHolidayRepository)
+	m.On("GetBetween", mock.Anything, mock.Anything, mock.Anything).Return([]*holidays.Holiday{}, nil).Maybe()
+	return m
+}
+
+func (m *MockHolidayRepository) GetBetween(ctx context.Context, from, to time.Time) ([]*holidays.Holiday, error) {
+	args := m.Called(ctx, from, to)
+
+	var result []*holidays.Holiday
+	if v := args.Get(0); v != nil {
+		result = v.([]*holidays.Holiday)
+	}
+
+	return result, args.Error(1)
+}
+
+func newBooker() *slots.Booker {
+	return slots.NewBooker(new(MockSlotRepository), newPricerZones())
+}
+
+func (m *MockSlotRepository) GetById(ctx context.Context, id uuid.UUID) (*slots.Slot, error) {
+	args := m.Called(ctx, id)
+
+	var result *slots.Slot
+	if v := args.Get(0); v != nil {
+		result = v.(*slots.Slot)
+	}
+
+	return result, args.Error(1)
+}
+
+func (m *MockSlotRepository) Lock(ctx context.Context, id uuid.UUID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockSlotRepository) CountBooked(ctx context.Context, id uuid.UUID, from, to time.Time) (map[string]int, error) {
+	args := m.Called(ctx, id, from, to)
+
+	var result map[string]int
+	if v := args.Get(0); v != nil {
+		result = v.(map[string]int)
+	}
+
+	return result, args.Error(1)
+}
+
+func newPricer() *pricing.Pricer {
+	return pricing.NewPricer(newPricerZones(), new(MockPromoCodeRepository))
+}
+
+func newServingPricer(t *testing.T, latitude, longitude float64, radius int) *pricing.Pricer {
+	center, err := valueobjects.NewCoordinates(latitude, longitude)
+	assert.NoError(t, err)
+
+	m := new(MockZoneRepository)
+	m.On("GetAll", mock.Anything).Return([]*pricing.Zone{pricing.NewZone("Downtown", center, radius, bolivianos(0))}, nil).Maybe()
+	return pricing.NewPricer(m, new(MockPromoCodeRepository))
+}
+
+func newInvoicer() *invoices.Invoicer {
+	return invoices.NewInvoicer(newMockInvoices(), invoices.NewInvoiceFactory(), "NC", false, invoices.CancellationFee{})
+}
+
+func newMockInvoices() *MockInvoiceRepository {
+	m := new(MockInvoiceRepository)
+	m.On("NextSequence", mock.Anything, "NC").Return(1, nil).Maybe()
+	m.On("Create", mock.Anything, mock.Anything).Return(nil, nil).Maybe()
+	m.On("GetByContract", mock.Anything, mock.Anything).Return(nil, invoices.ErrNotFoundInvoice).Maybe()
+	return m
+}
+
+func newPricerZones() *MockZoneRepository {
+	m := new(MockZoneRepository)
+	m.On("GetAll", mock.Anything).Return([]*pricing.Zone{}, nil).Maybe()
+	return m
+}
+
+func (m *MockZoneRepository) GetAll(ctx context.Context) ([]*pricing.Zone, error) {
+	args := m.Called(ctx)
+
+	var result []*pricing.Zone
+	if v := args.Get(0); v != nil {
+		result = v.([]*pricing.Zone)
+	}
+
+	return result, args.Error(1)
+}
+
+func (m *MockInvoiceRepository) NextSequence(ctx context.Context, series string) (int, error) {
+	args := m.Called(ctx, series)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockInvoiceRepository) Create(ctx context.Context, invoice *invoices.Invoice) (*invoices.Invoice, error) {
+	args := m.Called(ctx, invoice)
+	if v := args.Get(0); v != nil {
+		return v.(*invoices.Invoice), args.Error(1)
+	}
+	return invoice, args.Error(1)
+}
+
+func (m *MockInvoiceRepository) GetByContract(ctx context.Context, contractId uuid.UUID) (*invoices.Invoice, error) {
+	args := m.Called(ctx, contractId)
+
+	var result *invoices.Invoice
+	if v := args.Get(0); v != nil {
+		result = v.(*invoices.Invoice)
+	}
+
+	return result, args.Error(1)
+}
+
+func (m *MockInvoiceRepository) AddCreditNote(ctx context.Context, note *invoices.CreditNote) (*invoices.CreditNote, error) {
+	args := m.Called(ctx, note)
+	if v := args.Get(0); v != nil {
+		return v.(*invoices.CreditNote), args.Error(1)
+	}
+	return note, args.Error(1)
+}
+
+func (m *MockPromoCodeRepository) GetByCode(ctx context.Context, code string) (*pricing.PromoCode, error) {
+	args := m.Called(ctx, code)
+
+	var result *pricing.PromoCode
+	if v := args.Get(0); v != nil {
+		result = v.(*pricing.PromoCode)
+	}
+
+	return result, args.Error(1)
+}
+
+func (m *MockPromoCodeRepository) Redeem(ctx context.Context, code string) error {
+	args := m.Called(ctx, code)
+	return args.Error(0)
+}
+
+func (m *MockFactory) Create(administratorId, patientId uuid.UUID, plan *plans.Plan, start time.Time, rules contracts.DeliveryRules, cost valueobjects.Money, street string, number int, coordinates valueobjects.Coordinates) (*contracts.Contract, error) {
+	args := m.Called(administratorId, patientId, plan, start, rules, cost, street, number, coordinates)
+
+	var result *contracts.Contract
+	if v := args.Get(0); v != nil {
+		result = v.(*contracts.Contract)
+	}
+
+	return result, args.Error(1)
+}
+
+func (m *MockRepository) GetAll(ctx context.Context, filter contracts.ContractFilter, pagination abstractions.Pagination) (*abstractions.Page[*contracts.Contract], error) {
+	args := m.Called(ctx, filter, pagination)
+	return args.Get(0).(*abstractions.Page[*contracts.Contract]), args.Error(1)
+}
+
+func (m *MockRepository) GetById(ctx context.Context, id uuid.UUID) (*contracts.Contract, error) {
+	args := m.Called(ctx, id)
+
+	var result *contracts.Contract
+	if v := args.Get(0); v != nil {
+		result = v.(*contracts.Contract)
+	}
+
+	return result, args.Error(1)
+}
+
+func (m *MockRepository) Create(ctx context.Context, contract *contracts.Contract) (*contracts.Contract, error) {
+	args := m.Called(ctx, contract)
+
+	var result *contracts.Contract
+	if v := args.Get(0); v != nil {
+		result = v.(*contracts.Contract)
+	}
+
+	return result, args.Error(1)
+}
+
+func (m *MockRepository) ChangeStatus(ctx context.Context, contract *contracts.Contract) (*contracts.Contract, error) {
+	args := m.Called(ctx, contract)
+
+	var result *contracts.Contract
+	if v := args.Get(0); v != nil {
+		result = v.(*contracts.Contract)
+	}
+
+	return result, args.Error(1)
+}
+
+func (m *MockRepository) ExistById(ctx context.Context, id uuid.UUID) (bool, error) {
+	args := m.Called(ctx, id)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockRepository) Count(ctx context.Context) (int, error) {
+	args := m.Called(ctx)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockRepository) GetAllDeliveries(ctx context.Context, contractId uuid.UUID) ([]*deliveries.Delivery, error) {
+	args := m.Called(ctx, contractId)
+	return args.Get(0).([]*deliveries.Delivery), args.Error(1)
+}
+
+func (m *MockRepository) GetDeliveriesById(ctx context.Context, id uuid.UUID) (*deliveries.Delivery, error) {
+	args := m.Called(ctx, id)
+
+	var result *deliveries.Delivery
+	if v := args.Get(0); v != nil {
+		result = v.(*deliveries.Delivery)
+	}
+
+	return result, args.Error(1)
+}
+
+func (m *MockRepository) GetPendingDeliveriesOn(ctx context.Context, day time.Time) ([]*deliveries.Delivery, error) {
+	args := m.Called(ctx, day)
+
+	var result []*deliveries.Delivery
+	if v := args.Get(0); v != nil {
+		result = v.([]*deliveries.Delivery)
+	}
+
+	return result, args.Error(1)
+}
+
+func (m *MockRepository) UpdateDelivery(ctx context.Context, id uuid.UUID, delivery *deliveries.Delivery) (*deliveries.Delivery, error) {
+	args := m.Called(ctx, id, delivery)
+
+	var result *deliveries.Delivery
+	if v := args.Get(0); v != nil {
+		result = v.(*deliveries.Delivery)
+	}
+
+	return result, args.Error(1)
+}
+
+func (m *MockRepository) UpdateDeliveries(ctx context.Context, contractId uuid.UUID, dlvrs []*deliveries.Delivery) ([]*deliveries.Delivery, error) {
+	args := m.Called(ctx, contractId, dlvrs)
+
+	var result []*deliveries.Delivery
+	if v := args.Get(0); v != nil {
+		result = v.([]*deliveries.Delivery)
+	}
+
+	return result, args.Error(1)
+}
+
+func (m *MockRepository) SetAutoRenew(ctx context.Context, contract *contracts.Contract) error {
+	args := m.Called(ctx, contract)
+	return args.Error(0)
+}
+
+func (m *MockRepository) ExistsRenewal(ctx context.Context, id uuid.UUID) (bool, error) {
+	args := m.Called(ctx, id)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockRepository) GetAutoRenewable(ctx context.Context, since, until time.Time) ([]uuid.UUID, error) {
+	return m.ids(m.Called(ctx, since, until))
+}
+
+func (m *MockRepository) GetToActivate(ctx context.Context, before time.Time) ([]uuid.UUID, error) {
+	return m.ids(m.Called(ctx, before))
+}
+
+func (m *MockRepository) GetToComplete(ctx context.Context, before time.Time) ([]uuid.UUID, error) {
+	return m.ids(m.Called(ctx, before))
+}
+
+func (m *MockRepository) GetWithPastDeliveries(ctx context.Context, before time.Time) ([]uuid.UUID, error) {
+	return m.ids(m.Called(ctx, before))
+}
+
+func (m *MockRepository) ids(args mock.Arguments) ([]uuid.UUID, error) {
+	var result []uuid.UUID
+	if v := args.Get(0); v != nil {
+		result = v.([]uuid.UUID)
+	}
+
+	return result, args.Error(1)
+}
+
+func (m *MockRepository) RescheduleDeliveries(ctx context.Context, contractId uuid.UUID, dlvrs []*deliveries.Delivery) error {
+	args := m.Called(ctx, contractId, dlvrs)
+	return args.Error(0)
+}
+
+func (m *MockRepository) AssignDeliveries(ctx context.Context, dlvrs []*deliveries.Delivery) error {
+	args := m.Called(ctx, dlvrs)
+	return args.Error(0)
+}
+
+func (m *MockRepository) ChangeStatusDelivery(ctx context.Context, delivery *deliveries.Delivery) (*deliveries.Delivery, error) {
+	args := m.Called(ctx, delivery)
+
+	var result *deliveries.Delivery
+	if v := args.Get(0); v != nil {
+		result = v.(*deliveries.Delivery)
+	}
+
+	return result, args.Error(1)
+}
+
+func (m *MockRepository) BookDelivery(ctx context.Context, delivery *deliveries.Delivery) (*deliveries.Delivery, error) {
+	args := m.Called(ctx, delivery)
+
+	var result *deliveries.Delivery
+	if v := args.Get(0); v != nil {
+		result = v.(*deliveries.Delivery)
+	}
+
+	return result, args.Error(1)
+}
+
+func newDelivery(t *testing.T, contractId uuid.UUID, status string) *deliveries.Delivery {
+	now := time.Now()
+	d, err := deliveries.NewDeliveryFromDB(uuid.New(), contractId, now.AddDate(0, 0, 3), "Sesame Street", 30, -17.7863, -63.1812, status, nil, nil, now, now, nil)
+	assert.NoError(t, err)
+	return d
+}
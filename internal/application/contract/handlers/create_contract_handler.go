@@ -2,10 +2,13 @@ package handlers
 
 import (
 	"context"
+	"fmt"
 	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/contract/commands"
 	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/contract"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/pricing"
 	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
 	"log"
+	"time"
 )
 
 func (h *ContractHandler) HandleCreate(ctx context.Context, cmd commands.CreateContractCommand) (*contracts.Contract, error) {
@@ -15,19 +18,100 @@ func (h *ContractHandler) HandleCreate(ctx context.Context, cmd commands.CreateC
 		return nil, err
 	}
 
-	coordinates, err := valueobjects.NewCoordinates(cmd.Longitude, cmd.Latitude)
+	plan, err := h.plans.GetByCode(ctx, string(cType))
+	if err != nil {
+		log.Printf("[handler:contract][HandleCreate] error getting plan '%s': %v", cType, err)
+		return nil, err
+	}
+
+	currency, err := valueobjects.ParseCurrency(cmd.Currency)
+	if err != nil {
+		log.Printf("[handler:contract][HandleCreate] error parsing currency: %v", err)
+		return nil, err
+	}
+
+	cost, err := valueobjects.NewMoney(cmd.Cost, currency)
+	if err != nil {
+		log.Printf("[handler:contract][HandleCreate] error creating cost: %v", err)
+		return nil, err
+	}
+
+	weekdays, err := contracts.ParseWeekdayMask(cmd.Weekdays)
+	if err != nil {
+		log.Printf("[handler:contract][HandleCreate] error parsing weekdays: %v", err)
+		return nil, err
+	}
+
+	rules, err := contracts.NewDeliveryRules(weekdays, cmd.ExcludedDates)
+	if err != nil {
+		log.Printf("[handler:contract][HandleCreate] error creating delivery rules: %v", err)
+		return nil, err
+	}
+
+	coordinates, err := valueobjects.NewCoordinates(cmd.Latitude, cmd.Longitude)
 	if err != nil {
 		log.Printf("[handler:contract][HandleCreate] error creating coordinates: %v", err)
 		return nil, err
 	}
 
-	contractFactory, err := h.factory.Create(cmd.AdministratorId, cmd.PatientId, cType, cmd.StartDate, cmd.Cost, cmd.Street, cmd.Number, coordinates)
+	if _, err = h.pricer.Serve(ctx, coordinates); err != nil {
+		log.Printf("[handler:contract][HandleCreate] error checking address is served: %v", err)
+		return nil, err
+	}
+
+	// Without an explicit cost the contract is priced from the plan, which is
+	// only set in the default currency. A cost set by hand is final.
+	var price *pricing.Breakdown
+	if cost.IsZero() {
+		if plan.Price().Currency() != currency {
+			log.Printf("[handler:contract][HandleCreate] plan '%s' has no price in %s", plan.Code(), currency)
+			return nil, fmt.Errorf("%w: plan %s is priced in %s, not %s", valueobjects.ErrCurrencyMismatchMoney, plan.Code(), plan.Price().Currency(), currency)
+		}
+
+		price, err = h.pricer.Quote(ctx, plan, plan.DeliveryCount(), coordinates, cmd.PromoCode, time.Now())
+		if err != nil {
+			log.Printf("[handler:contract][HandleCreate] error pricing contract: %v", err)
+			return nil, err
+		}
+		cost = price.Total()
+	} else if cmd.PromoCode != "" {
+		log.Printf("[handler:contract][HandleCreate] promo code '%s' given with a cost set by hand", cmd.PromoCode)
+		return nil, fmt.Errorf("%w: a promo code cannot discount a cost set by hand", pricing.ErrCodePromoCode)
+	}
+
+	calendar, err := h.holidayCalendar(ctx, cmd.StartDate)
+	if err != nil {
+		log.Printf("[handler:contract][HandleCreate] error getting holidays: %v", err)
+		return nil, err
+	}
+	rules = rules.WithHolidays(calendar)
+
+	contractFactory, err := h.factory.Create(cmd.AdministratorId, cmd.PatientId, plan, cmd.StartDate, rules, cost, cmd.Street, cmd.Number, coordinates)
 	if err != nil {
 		log.Printf("[handler:contract][HandleCreate] error creating contract factory: %v", err)
 		return nil, err
 	}
 
-	contract, err := h.repository.Create(ctx, contractFactory)
+	if err = contractFactory.Priced(price); err != nil {
+		log.Printf("[handler:contract][HandleCreate] error pricing contract: %v", err)
+		return nil, err
+	}
+
+	var contract *contracts.Contract
+	err = h.uow.Do(ctx, func(ctx context.Context) error {
+		if cmd.SlotId != nil {
+			if err = h.book(ctx, contractFactory, *cmd.SlotId, coordinates); err != nil {
+				return err
+			}
+		}
+		if contract, err = h.repository.Create(ctx, contractFactory); err != nil {
+			return err
+		}
+		if err = h.pricer.Redeem(ctx, price); err != nil {
+			return err
+		}
+		return h.issue(ctx, contract, time.Now())
+	})
 	if err != nil {
 		log.Printf("[handler:contract][HandleCreate] error creating contract: %v", err)
 		return nil, err
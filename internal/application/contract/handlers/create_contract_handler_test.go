@@ -0,0 +1,539 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/contract/commands"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/contract"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/invoice"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/plan"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/pricing"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/slot"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+func TestContractHandler_HandleCreate(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(MockRepository)
+	mockFactory := new(MockFactory)
+	uow := new(MockUnitOfWork)
+	handler := NewContractHandler(mockRepo, newMockPlans(), newMockHolidays(), newBooker(), newPricer(), newInvoicer(), mockFactory, uow)
+
+	cmd := commands.CreateContractCommand{
+		AdministratorId: uuid.New(),
+		PatientId:       uuid.New(),
+		ContractType:    "monthly",
+		StartDate:       time.Now().AddDate(0, 0, 3),
+		Cost:            1000,
+		Street:          "Sesame Street",
+		Number:          30,
+		Latitude:        -17.7863,
+		Longitude:       -63.1812,
+	}
+
+	coordinates, err := valueobjects.NewCoordinates(cmd.Latitude, cmd.Longitude)
+	assert.NoError(t, err)
+
+	contract := contracts.NewContract(cmd.AdministratorId, cmd.PatientId, monthlyPlan, cmd.StartDate, bolivianos(cmd.Cost), cmd.Street, cmd.Number, coordinates)
+
+	mockFactory.On("Create", cmd.AdministratorId, cmd.PatientId, monthlyPlan, cmd.StartDate, mock.Anything, bolivianos(cmd.Cost), cmd.Street, cmd.Number, coordinates).Return(contract, nil)
+	mockRepo.On("Create", mock.Anything, contract).Return(contract, nil)
+
+	resp, err := handler.HandleCreate(ctx, cmd)
+
+	assert.NoError(t, err)
+	assert.Equal(t, contract, resp)
+	assert.Equal(t, 1, uow.committed)
+	assert.Equal(t, 0, uow.rolledBack)
+
+	mockRepo.AssertExpectations(t)
+	mockFactory.AssertExpectations(t)
+}
+
+func TestContractHandler_HandleCreate_Slot(t *testing.T) {
+	coordinates, err := valueobjects.NewCoordinates(-17.7863, -63.1812)
+	assert.NoError(t, err)
+
+	zone := pricing.NewZone("Downtown", coordinates, 1000, bolivianos(100))
+	lunch := slots.NewSlot(zone.Id(), "Lunch", 11*time.Hour+30*time.Minute, 14*time.Hour, 2)
+	dinner := slots.NewSlot(uuid.New(), "Dinner", 19*time.Hour, 21*time.Hour, 20)
+
+	cases := []struct {
+		name string
+		slot *slots.Slot
+		full bool
+		err  error
+	}{
+		{"Books every delivery", lunch, false, nil},
+		{"Slot of another zone", dinner, false, slots.ErrZoneSlot},
+		{"Slot full on a day", lunch, true, slots.ErrFullSlot},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockRepo := new(MockRepository)
+			mockFactory := new(MockFactory)
+			mockSlots := new(MockSlotRepository)
+			mockZones := new(MockZoneRepository)
+			uow := new(MockUnitOfWork)
+			handler := NewContractHandler(mockRepo, newMockPlans(), newMockHolidays(), slots.NewBooker(mockSlots, mockZones), newPricer(), newInvoicer(), mockFactory, uow)
+
+			slotId := tc.slot.Id()
+			cmd := commands.CreateContractCommand{
+				AdministratorId: uuid.New(),
+				PatientId:       uuid.New(),
+				ContractType:    "H",
+				StartDate:       time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC),
+				Cost:            1000,
+				Street:          "Sesame Street",
+				Number:          30,
+				Latitude:        coordinates.Latitude(),
+				Longitude:       coordinates.Longitude(),
+				SlotId:          &slotId,
+			}
+
+			contract := contracts.NewContract(cmd.AdministratorId, cmd.PatientId, halfMonthPlan, cmd.StartDate, bolivianos(cmd.Cost), cmd.Street, cmd.Number, coordinates)
+			booked := map[string]int{}
+			if tc.full {
+				booked[contract.Deliveries()[3].Date().Format(time.DateOnly)] = 2
+			}
+
+			mockFactory.On("Create", cmd.AdministratorId, cmd.PatientId, halfMonthPlan, cmd.StartDate, mock.Anything, bolivianos(cmd.Cost), cmd.Street, cmd.Number, coordinates).Return(contract, nil)
+			mockSlots.On("GetById", mock.Anything, slotId).Return(tc.slot, nil)
+			mockZones.On("GetAll", mock.Anything).Return([]*pricing.Zone{zone}, nil)
+			mockSlots.On("Lock", mock.Anything, slotId).Return(nil).Maybe()
+			mockSlots.On("CountBooked", mock.Anything, slotId, mock.Anything, mock.Anything).Return(booked, nil).Maybe()
+			if tc.err == nil {
+				mockRepo.On("Create", mock.Anything, contract).Return(contract, nil)
+			}
+
+			resp, err := handler.HandleCreate(context.Background(), cmd)
+
+			if tc.err != nil {
+				assert.Nil(t, resp)
+				assert.ErrorIs(t, err, tc.err)
+				assert.Nil(t, contract.SlotId())
+				assert.Equal(t, 1, uow.rolledBack)
+				mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, &slotId, resp.SlotId())
+			for _, d := range resp.Deliveries() {
+				assert.Equal(t, &slotId, d.SlotId())
+				assert.Equal(t, 11, d.Date().Hour())
+				assert.Equal(t, 30, d.Date().Minute())
+			}
+			assert.Equal(t, 1, uow.committed)
+
+			mockRepo.AssertExpectations(t)
+			mockSlots.AssertExpectations(t)
+		})
+	}
+}
+
+func TestContractHandler_HandleCreate_RepositoryError(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(MockRepository)
+	mockFactory := new(MockFactory)
+	uow := new(MockUnitOfWork)
+	handler := NewContractHandler(mockRepo, newMockPlans(), newMockHolidays(), newBooker(), newPricer(), newInvoicer(), mockFactory, uow)
+
+	cmd := commands.CreateContractCommand{
+		AdministratorId: uuid.New(),
+		PatientId:       uuid.New(),
+		ContractType:    "H",
+		StartDate:       time.Now().AddDate(0, 0, 3),
+		Cost:            1000,
+		Street:          "Sesame Street",
+		Number:          30,
+	}
+
+	contract := contracts.NewContract(cmd.AdministratorId, cmd.PatientId, halfMonthPlan, cmd.StartDate, bolivianos(cmd.Cost), cmd.Street, cmd.Number, valueobjects.Coordinates{})
+
+	mockFactory.On("Create", cmd.AdministratorId, cmd.PatientId, halfMonthPlan, cmd.StartDate, mock.Anything, bolivianos(cmd.Cost), cmd.Street, cmd.Number, valueobjects.Coordinates{}).Return(contract, nil)
+	mockRepo.On("Create", mock.Anything, contract).Return(nil, ErrDbFailureContract)
+
+	resp, err := handler.HandleCreate(ctx, cmd)
+
+	assert.ErrorIs(t, err, ErrDbFailureContract)
+	assert.Nil(t, resp)
+	assert.Equal(t, 0, uow.committed)
+	assert.Equal(t, 1, uow.rolledBack)
+
+	mockRepo.AssertExpectations(t)
+	mockFactory.AssertExpectations(t)
+}
+
+func TestContractHandler_HandleCreate_PlanPrice(t *testing.T) {
+	mockRepo := new(MockRepository)
+	mockFactory := new(MockFactory)
+	handler := NewContractHandler(mockRepo, newMockPlans(), newMockHolidays(), newBooker(), newPricer(), newInvoicer(), mockFactory, new(MockUnitOfWork))
+
+	cmd := commands.CreateContractCommand{
+		AdministratorId: uuid.New(),
+		PatientId:       uuid.New(),
+		ContractType:    "half-month",
+		StartDate:       time.Now().AddDate(0, 0, 3),
+		Street:          "Sesame Street",
+		Number:          30,
+	}
+
+	contract := contracts.NewContract(cmd.AdministratorId, cmd.PatientId, halfMonthPlan, cmd.StartDate, halfMonthPlan.Price(), cmd.Street, cmd.Number, valueobjects.Coordinates{})
+
+	mockFactory.On("Create", cmd.AdministratorId, cmd.PatientId, halfMonthPlan, cmd.StartDate, mock.Anything, halfMonthPlan.Price(), cmd.Street, cmd.Number, valueobjects.Coordinates{}).Return(contract, nil)
+	mockRepo.On("Create", mock.Anything, contract).Return(contract, nil)
+
+	resp, err := handler.HandleCreate(context.Background(), cmd)
+
+	assert.NoError(t, err)
+	assert.Equal(t, halfMonthPlan.Price(), resp.CostValue())
+	mockFactory.AssertExpectations(t)
+}
+
+func TestContractHandler_HandleCreate_Pricing(t *testing.T) {
+	mockRepo := new(MockRepository)
+	mockFactory := new(MockFactory)
+	mockZones := new(MockZoneRepository)
+	mockPromos := new(MockPromoCodeRepository)
+	uow := new(MockUnitOfWork)
+	iva, err := pricing.NewTaxRate("IVA", 1300)
+	assert.NoError(t, err)
+	handler := NewContractHandler(mockRepo, newMockPlans(), newMockHolidays(), newBooker(), pricing.NewPricer(mockZones, mockPromos, iva), newInvoicer(), mockFactory, uow)
+
+	cmd := commands.CreateContractCommand{
+		AdministratorId: uuid.New(),
+		PatientId:       uuid.New(),
+		ContractType:    "H",
+		StartDate:       time.Now().AddDate(0, 0, 3),
+		PromoCode:       " welcome10 ",
+		Street:          "Sesame Street",
+		Number:          30,
+		Latitude:        -17.7863,
+		Longitude:       -63.1812,
+	}
+
+	coordinates, err := valueobjects.NewCoordinates(cmd.Latitude, cmd.Longitude)
+	assert.NoError(t, err)
+	zone := pricing.NewZone("Downtown", coordinates, 1000, bolivianos(100))
+	promo := pricing.NewPromoCode("WELCOME10", pricing.Percentage, 1000, valueobjects.BOB, time.Now().AddDate(0, 0, -1), nil, 10)
+
+	// 1000 for the plan and 15 x 100 for the zone, less 10%, plus 13% of IVA.
+	total := bolivianos(2543)
+	contract := contracts.NewContract(cmd.AdministratorId, cmd.PatientId, halfMonthPlan, cmd.StartDate, total, cmd.Street, cmd.Number, coordinates)
+
+	mockZones.On("GetAll", mock.Anything).Return([]*pricing.Zone{zone}, nil)
+	mockPromos.On("GetByCode", mock.Anything, "WELCOME10").Return(promo, nil)
+	mockPromos.On("Redeem", mock.Anything, "WELCOME10").Return(nil)
+	mockFactory.On("Create", cmd.AdministratorId, cmd.PatientId, halfMonthPlan, cmd.StartDate, mock.Anything, total, cmd.Street, cmd.Number, coordinates).Return(contract, nil)
+	mockRepo.On("Create", mock.Anything, contract).Return(contract, nil)
+
+	resp, err := handler.HandleCreate(context.Background(), cmd)
+
+	assert.NoError(t, err)
+	assert.Equal(t, total, resp.CostValue())
+	assert.Equal(t, "Downtown", resp.Price().Zone())
+	assert.Equal(t, bolivianos(1500), resp.Price().Surcharge())
+	assert.Equal(t, "WELCOME10", resp.Price().PromoCode())
+	assert.Equal(t, bolivianos(250), resp.Price().Discount())
+	assert.Equal(t, bolivianos(293), resp.Price().Taxes()[0].Amount())
+	assert.Equal(t, 1, uow.committed)
+	mockPromos.AssertExpectations(t)
+	mockFactory.AssertExpectations(t)
+}
+
+func TestContractHandler_HandleCreate_PromoCodeErrors(t *testing.T) {
+	ended := time.Now().AddDate(0, -2, 0)
+	expired := pricing.NewPromoCode("SUMMER", pricing.Percentage, 1000, valueobjects.BOB, time.Now().AddDate(0, -3, 0), &ended, 0)
+
+	t.Run("expired", func(t *testing.T) {
+		mockPromos := new(MockPromoCodeRepository)
+		mockFactory := new(MockFactory)
+		handler := NewContractHandler(new(MockRepository), newMockPlans(), newMockHolidays(), newBooker(), pricing.NewPricer(newPricerZones(), mockPromos), newInvoicer(), mockFactory, new(MockUnitOfWork))
+
+		mockPromos.On("GetByCode", mock.Anything, "SUMMER").Return(expired, nil)
+
+		resp, err := handler.HandleCreate(context.Background(), commands.CreateContractCommand{
+			AdministratorId: uuid.New(),
+			PatientId:       uuid.New(),
+			ContractType:    "H",
+			StartDate:       time.Now().AddDate(0, 0, 3),
+			PromoCode:       "SUMMER",
+			Street:          "Sesame Street",
+			Number:          30,
+		})
+
+		assert.Nil(t, resp)
+		assert.ErrorIs(t, err, pricing.ErrExpiredPromoCode)
+		mockFactory.AssertNotCalled(t, "Create")
+	})
+
+	t.Run("cost set by hand", func(t *testing.T) {
+		mockFactory := new(MockFactory)
+		handler := NewContractHandler(new(MockRepository), newMockPlans(), newMockHolidays(), newBooker(), newPricer(), newInvoicer(), mockFactory, new(MockUnitOfWork))
+
+		resp, err := handler.HandleCreate(context.Background(), commands.CreateContractCommand{
+			AdministratorId: uuid.New(),
+			PatientId:       uuid.New(),
+			ContractType:    "H",
+			StartDate:       time.Now().AddDate(0, 0, 3),
+			Cost:            1000,
+			PromoCode:       "WELCOME10",
+			Street:          "Sesame Street",
+			Number:          30,
+		})
+
+		assert.Nil(t, resp)
+		assert.ErrorIs(t, err, pricing.ErrCodePromoCode)
+		mockFactory.AssertNotCalled(t, "Create")
+	})
+
+	t.Run("used up while creating", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockPromos := new(MockPromoCodeRepository)
+		mockFactory := new(MockFactory)
+		uow := new(MockUnitOfWork)
+		handler := NewContractHandler(mockRepo, newMockPlans(), newMockHolidays(), newBooker(), pricing.NewPricer(newPricerZones(), mockPromos), newInvoicer(), mockFactory, uow)
+
+		promo := pricing.NewPromoCode("LAST", pricing.Fixed, 100, valueobjects.BOB, time.Now().AddDate(0, 0, -1), nil, 1)
+		cmd := commands.CreateContractCommand{
+			AdministratorId: uuid.New(),
+			PatientId:       uuid.New(),
+			ContractType:    "H",
+			StartDate:       time.Now().AddDate(0, 0, 3),
+			PromoCode:       "LAST",
+			Street:          "Sesame Street",
+			Number:          30,
+		}
+		contract := contracts.NewContract(cmd.AdministratorId, cmd.PatientId, halfMonthPlan, cmd.StartDate, bolivianos(900), cmd.Street, cmd.Number, valueobjects.Coordinates{})
+
+		mockPromos.On("GetByCode", mock.Anything, "LAST").Return(promo, nil)
+		mockPromos.On("Redeem", mock.Anything, "LAST").Return(pricing.ErrUsedUpPromoCode)
+		mockFactory.On("Create", cmd.AdministratorId, cmd.PatientId, halfMonthPlan, cmd.StartDate, mock.Anything, bolivianos(900), cmd.Street, cmd.Number, valueobjects.Coordinates{}).Return(contract, nil)
+		mockRepo.On("Create", mock.Anything, contract).Return(contract, nil)
+
+		resp, err := handler.HandleCreate(context.Background(), cmd)
+
+		assert.Nil(t, resp)
+		assert.ErrorIs(t, err, pricing.ErrUsedUpPromoCode)
+		assert.Equal(t, 0, uow.committed)
+		assert.Equal(t, 1, uow.rolledBack)
+	})
+}
+
+func TestContractHandler_HandleCreate_Currency(t *testing.T) {
+	mockRepo := new(MockRepository)
+	mockFactory := new(MockFactory)
+	handler := NewContractHandler(mockRepo, newMockPlans(), newMockHolidays(), newBooker(), newPricer(), newInvoicer(), mockFactory, new(MockUnitOfWork))
+
+	cmd := commands.CreateContractCommand{
+		AdministratorId: uuid.New(),
+		PatientId:       uuid.New(),
+		ContractType:    "H",
+		StartDate:       time.Now().AddDate(0, 0, 3),
+		Cost:            45000,
+		Currency:        "usd",
+		Street:          "Sesame Street",
+		Number:          30,
+	}
+
+	cost, err := valueobjects.NewMoney(45000, valueobjects.USD)
+	assert.NoError(t, err)
+	contract := contracts.NewContract(cmd.AdministratorId, cmd.PatientId, halfMonthPlan, cmd.StartDate, cost, cmd.Street, cmd.Number, valueobjects.Coordinates{})
+
+	mockFactory.On("Create", cmd.AdministratorId, cmd.PatientId, halfMonthPlan, cmd.StartDate, mock.Anything, cost, cmd.Street, cmd.Number, valueobjects.Coordinates{}).Return(contract, nil)
+	mockRepo.On("Create", mock.Anything, contract).Return(contract, nil)
+
+	resp, err := handler.HandleCreate(context.Background(), cmd)
+
+	assert.NoError(t, err)
+	assert.Equal(t, cost, resp.CostValue())
+	mockFactory.AssertExpectations(t)
+}
+
+func TestContractHandler_HandleCreate_CurrencyErrors(t *testing.T) {
+	cases := []struct {
+		name     string
+		cost     int
+		currency string
+		err      error
+	}{
+		{"unsupported", 1000, "EUR", valueobjects.ErrCurrencyMoney},
+		{"plan price in another currency", 0, "USD", valueobjects.ErrCurrencyMismatchMoney},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockFactory := new(MockFactory)
+			handler := NewContractHandler(new(MockRepository), newMockPlans(), newMockHolidays(), newBooker(), newPricer(), newInvoicer(), mockFactory, new(MockUnitOfWork))
+
+			resp, err := handler.HandleCreate(context.Background(), commands.CreateContractCommand{
+				AdministratorId: uuid.New(),
+				PatientId:       uuid.New(),
+				ContractType:    "H",
+				StartDate:       time.Now().AddDate(0, 0, 3),
+				Cost:            tc.cost,
+				Currency:        tc.currency,
+				Street:          "Sesame Street",
+				Number:          30,
+			})
+
+			assert.Nil(t, resp)
+			assert.ErrorIs(t, err, tc.err)
+			mockFactory.AssertNotCalled(t, "Create")
+		})
+	}
+}
+
+func TestContractHandler_HandleCreate_NotCovered(t *testing.T) {
+	mockRepo := new(MockRepository)
+	mockFactory := new(MockFactory)
+	uow := new(MockUnitOfWork)
+	handler := NewContractHandler(mockRepo, newMockPlans(), newMockHolidays(), newBooker(), newServingPricer(t, -17.7863, -63.1812, 5000), newInvoicer(), mockFactory, uow)
+
+	resp, err := handler.HandleCreate(context.Background(), commands.CreateContractCommand{
+		AdministratorId: uuid.New(),
+		PatientId:       uuid.New(),
+		ContractType:    "H",
+		StartDate:       time.Now().AddDate(0, 0, 3),
+		Cost:            1000,
+		Street:          "Avenida Arce",
+		Number:          2631,
+		Latitude:        -16.5000,
+		Longitude:       -68.1500,
+	})
+
+	assert.Nil(t, resp)
+	assert.ErrorIs(t, err, pricing.ErrNotCoveredZone)
+	assert.Equal(t, 0, uow.committed+uow.rolledBack)
+	mockFactory.AssertNotCalled(t, "Create")
+	mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestContractHandler_HandleCreate_UnknownPlan(t *testing.T) {
+	mockRepo := new(MockRepository)
+	mockPlans := new(MockPlanRepository)
+	mockFactory := new(MockFactory)
+	handler := NewContractHandler(mockRepo, mockPlans, newMockHolidays(), newBooker(), newPricer(), newInvoicer(), mockFactory, new(MockUnitOfWork))
+
+	mockPlans.On("GetByCode", mock.Anything, "WEEKLY").Return(nil, plans.ErrNotFoundPlan)
+
+	resp, err := handler.HandleCreate(context.Background(), commands.CreateContractCommand{
+		AdministratorId: uuid.New(),
+		PatientId:       uuid.New(),
+		ContractType:    "WEEKLY",
+		StartDate:       time.Now().AddDate(0, 0, 3),
+	})
+
+	assert.Nil(t, resp)
+	assert.ErrorIs(t, err, plans.ErrNotFoundPlan)
+	mockFactory.AssertNotCalled(t, "Create")
+	mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestContractHandler_HandleCreate_DeliveryRules(t *testing.T) {
+	mockRepo := new(MockRepository)
+	mockFactory := new(MockFactory)
+	handler := NewContractHandler(mockRepo, newMockPlans(), newMockHolidays(), newBooker(), newPricer(), newInvoicer(), mockFactory, new(MockUnitOfWork))
+
+	cmd := commands.CreateContractCommand{
+		AdministratorId: uuid.New(),
+		PatientId:       uuid.New(),
+		ContractType:    "M",
+		StartDate:       time.Now().AddDate(0, 0, 3),
+		Weekdays:        []string{"mon", "wed", "fri"},
+		ExcludedDates:   []time.Time{time.Now().AddDate(0, 0, 5)},
+		Cost:            1000,
+		Street:          "Sesame Street",
+		Number:          30,
+	}
+
+	contract := contracts.NewContract(cmd.AdministratorId, cmd.PatientId, monthlyPlan, cmd.StartDate, bolivianos(cmd.Cost), cmd.Street, cmd.Number, valueobjects.Coordinates{})
+
+	var rules contracts.DeliveryRules
+	mockFactory.On("Create", cmd.AdministratorId, cmd.PatientId, monthlyPlan, cmd.StartDate, mock.Anything, bolivianos(cmd.Cost), cmd.Street, cmd.Number, valueobjects.Coordinates{}).Run(func(args mock.Arguments) {
+		rules = args.Get(4).(contracts.DeliveryRules)
+	}).Return(contract, nil)
+	mockRepo.On("Create", mock.Anything, contract).Return(contract, nil)
+
+	_, err := handler.HandleCreate(context.Background(), cmd)
+
+	assert.NoError(t, err)
+	assert.Equal(t, contracts.NewWeekdayMask(time.Monday, time.Wednesday, time.Friday), rules.Weekdays())
+	assert.False(t, rules.Allows(cmd.ExcludedDates[0]))
+
+	cmd.Weekdays = []string{"someday"}
+	resp, err := handler.HandleCreate(context.Background(), cmd)
+
+	assert.Nil(t, resp)
+	assert.ErrorIs(t, err, contracts.ErrWeekdaysContract)
+	mockFactory.AssertNumberOfCalls(t, "Create", 1)
+}
+
+func TestContractHandler_HandleCreate_Invoice(t *testing.T) {
+	mockRepo := new(MockRepository)
+	mockFactory := new(MockFactory)
+	mockInvoices := new(MockInvoiceRepository)
+	uow := new(MockUnitOfWork)
+	handler := NewContractHandler(mockRepo, newMockPlans(), newMockHolidays(), newBooker(), newPricer(), invoices.NewInvoicer(mockInvoices, invoices.NewInvoiceFactory(), "NC", false, invoices.CancellationFee{}), mockFactory, uow)
+
+	cmd := commands.CreateContractCommand{
+		AdministratorId: uuid.New(),
+		PatientId:       uuid.New(),
+		ContractType:    "H",
+		StartDate:       time.Now().AddDate(0, 0, 3),
+		Street:          "Sesame Street",
+		Number:          30,
+	}
+
+	contract := contracts.NewContract(cmd.AdministratorId, cmd.PatientId, halfMonthPlan, cmd.StartDate, halfMonthPlan.Price(), cmd.Street, cmd.Number, valueobjects.Coordinates{})
+
+	mockFactory.On("Create", cmd.AdministratorId, cmd.PatientId, halfMonthPlan, cmd.StartDate, mock.Anything, halfMonthPlan.Price(), cmd.Street, cmd.Number, valueobjects.Coordinates{}).Return(contract, nil)
+	mockRepo.On("Create", mock.Anything, contract).Return(contract, nil)
+	mockInvoices.On("NextSequence", mock.Anything, "NC").Return(42, nil)
+	mockInvoices.On("Create", mock.Anything, mock.MatchedBy(func(i *invoices.Invoice) bool {
+		return i.Number() == "NC-000042" && i.ContractId() == contract.Id() && i.PatientId() == cmd.PatientId && i.Amount() == halfMonthPlan.Price()
+	})).Return(nil, nil)
+
+	_, err := handler.HandleCreate(context.Background(), cmd)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, uow.committed)
+	mockInvoices.AssertExpectations(t)
+}
+
+func TestContractHandler_HandleCreate_InvoiceError(t *testing.T) {
+	mockRepo := new(MockRepository)
+	mockFactory := new(MockFactory)
+	mockInvoices := new(MockInvoiceRepository)
+	uow := new(MockUnitOfWork)
+	handler := NewContractHandler(mockRepo, newMockPlans(), newMockHolidays(), newBooker(), newPricer(), invoices.NewInvoicer(mockInvoices, invoices.NewInvoiceFactory(), "NC", false, invoices.CancellationFee{}), mockFactory, uow)
+
+	cmd := commands.CreateContractCommand{
+		AdministratorId: uuid.New(),
+		PatientId:       uuid.New(),
+		ContractType:    "H",
+		StartDate:       time.Now().AddDate(0, 0, 3),
+		Street:          "Sesame Street",
+		Number:          30,
+	}
+
+	contract := contracts.NewContract(cmd.AdministratorId, cmd.PatientId, halfMonthPlan, cmd.StartDate, halfMonthPlan.Price(), cmd.Street, cmd.Number, valueobjects.Coordinates{})
+
+	mockFactory.On("Create", cmd.AdministratorId, cmd.PatientId, halfMonthPlan, cmd.StartDate, mock.Anything, halfMonthPlan.Price(), cmd.Street, cmd.Number, valueobjects.Coordinates{}).Return(contract, nil)
+	mockRepo.On("Create", mock.Anything, contract).Return(contract, nil)
+	mockInvoices.On("NextSequence", mock.Anything, "NC").Return(0, ErrDbFailureContract)
+
+	resp, err := handler.HandleCreate(context.Background(), cmd)
+
+	assert.ErrorIs(t, err, ErrDbFailureContract)
+	assert.Nil(t, resp)
+	assert.Equal(t, 0, uow.committed)
+	assert.Equal(t, 1, uow.rolledBack)
+	mockInvoices.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
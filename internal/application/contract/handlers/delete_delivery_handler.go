@@ -0,0 +1,11 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/contract/commands"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/delivery"
+)
+
+func (h *ContractHandler) HandleDeleteDelivery(ctx context.Context, cmd commands.DeleteDeliveryCommand) (*deliveries.Delivery, error) {
+	return h.changeStatusDelivery(ctx, cmd.ContractId, cmd.DeliveryDayId, deliveries.Cancelled)
+}
@@ -0,0 +1,47 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/contract/queries"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/contract"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/plan"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/pricing"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	"log"
+	"time"
+)
+
+func (h *ContractHandler) HandleQuote(ctx context.Context, qry queries.QuoteContractQuery) (*pricing.Breakdown, error) {
+	cType, err := contracts.ParseContractType(qry.ContractType)
+	if err != nil {
+		log.Printf("[handler:contract][HandleQuote] error parsing contract type: %v", err)
+		return nil, err
+	}
+
+	plan, err := h.plans.GetByCode(ctx, string(cType))
+	if err != nil {
+		log.Printf("[handler:contract][HandleQuote] error getting plan '%s': %v", cType, err)
+		return nil, err
+	}
+
+	if !plan.Active() {
+		log.Printf("[handler:contract][HandleQuote] plan '%s' is not active", plan.Code())
+		return nil, fmt.Errorf("%w: got %s", plans.ErrInactivePlan, plan.Code())
+	}
+
+	coordinates, err := valueobjects.NewCoordinates(qry.Latitude, qry.Longitude)
+	if err != nil {
+		log.Printf("[handler:contract][HandleQuote] error creating coordinates: %v", err)
+		return nil, err
+	}
+
+	price, err := h.pricer.Quote(ctx, plan, plan.DeliveryCount(), coordinates, qry.PromoCode, time.Now())
+	if err != nil {
+		log.Printf("[handler:contract][HandleQuote] error pricing plan '%s': %v", plan.Code(), err)
+		return nil, err
+	}
+
+	log.Printf("[handler:contract][HandleQuote] plan '%s' quoted at %s", plan.Code(), price.Total())
+	return price, nil
+}
@@ -0,0 +1,71 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/contract/queries"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/contract"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/plan"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/pricing"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+func TestContractHandler_HandleQuote(t *testing.T) {
+	mockPromos := new(MockPromoCodeRepository)
+	handler := NewContractHandler(new(MockRepository), newMockPlans(), newMockHolidays(), newBooker(), pricing.NewPricer(newPricerZones(), mockPromos), newInvoicer(), new(MockFactory), new(MockUnitOfWork))
+
+	promo := pricing.NewPromoCode("FIFTY", pricing.Fixed, 500, valueobjects.BOB, time.Now().AddDate(0, 0, -1), nil, 0)
+	mockPromos.On("GetByCode", mock.Anything, "FIFTY").Return(promo, nil)
+
+	price, err := handler.HandleQuote(context.Background(), queries.QuoteContractQuery{ContractType: "monthly", PromoCode: "fifty"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, monthlyPlan.Price(), price.Base())
+	assert.Equal(t, 30, price.Deliveries())
+	assert.Equal(t, bolivianos(500), price.Discount())
+	assert.Equal(t, bolivianos(1300), price.Total())
+	mockPromos.AssertNotCalled(t, "Redeem", mock.Anything, mock.Anything)
+}
+
+func TestContractHandler_HandleQuote_Errors(t *testing.T) {
+	inactive := plans.NewPlan("TRIAL", "Trial", 3, plans.Daily, 300)
+	inactive.Deactivate()
+
+	mockPlans := newMockPlans()
+	mockPlans.On("GetByCode", mock.Anything, "TRIAL").Return(inactive, nil)
+	mockPromos := new(MockPromoCodeRepository)
+	mockPromos.On("GetByCode", mock.Anything, "MISSING").Return(nil, pricing.ErrNotFoundPromoCode)
+	handler := NewContractHandler(new(MockRepository), mockPlans, newMockHolidays(), newBooker(), pricing.NewPricer(newPricerZones(), mockPromos), newInvoicer(), new(MockFactory), new(MockUnitOfWork))
+
+	cases := []struct {
+		name  string
+		query queries.QuoteContractQuery
+		err   error
+	}{
+		{"unknown type", queries.QuoteContractQuery{ContractType: ""}, contracts.ErrTypeContract},
+		{"inactive plan", queries.QuoteContractQuery{ContractType: "TRIAL"}, plans.ErrInactivePlan},
+		{"invalid coordinates", queries.QuoteContractQuery{ContractType: "H", Latitude: 91}, valueobjects.ErrOutOfBoundariesLatitude},
+		{"unknown promo code", queries.QuoteContractQuery{ContractType: "H", PromoCode: "missing"}, pricing.ErrNotFoundPromoCode},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			price, err := handler.HandleQuote(context.Background(), tc.query)
+
+			assert.Nil(t, price)
+			assert.ErrorIs(t, err, tc.err)
+		})
+	}
+
+	t.Run("address not served", func(t *testing.T) {
+		handler := NewContractHandler(new(MockRepository), newMockPlans(), newMockHolidays(), newBooker(), newServingPricer(t, -16.5, -68.15, 1000), newInvoicer(), new(MockFactory), new(MockUnitOfWork))
+
+		price, err := handler.HandleQuote(context.Background(), queries.QuoteContractQuery{ContractType: "H", Latitude: -17.7863, Longitude: -63.1812})
+
+		assert.Nil(t, price)
+		assert.ErrorIs(t, err, pricing.ErrNotCoveredZone)
+	})
+}
@@ -0,0 +1,38 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/contract/queries"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/contract"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/invoice"
+	"log"
+)
+
+func (h *ContractHandler) HandleRefundPreview(ctx context.Context, qry queries.PreviewRefundQuery) (*invoices.CancellationRefund, error) {
+	contract, err := h.repository.GetById(ctx, qry.Id)
+	if err != nil {
+		log.Printf("[handler:contract][HandleRefundPreview] error getting contract '%s': %v", qry.Id, err)
+		return nil, err
+	}
+
+	refund, err := h.quoteRefund(ctx, contract)
+	if err != nil {
+		log.Printf("[handler:contract][HandleRefundPreview] error working out refund of contract '%s': %v", qry.Id, err)
+		return nil, err
+	}
+
+	return refund, nil
+}
+
+func (h *ContractHandler) quoteRefund(ctx context.Context, contract *contracts.Contract) (*invoices.CancellationRefund, error) {
+	plan, err := h.plans.GetByCode(ctx, string(contract.ContractType()))
+	if err != nil {
+		return nil, err
+	}
+
+	refund, err := h.invoicer.QuoteRefund(contract, plan.DeliveryCount())
+	if err != nil {
+		return nil, err
+	}
+	return &refund, nil
+}
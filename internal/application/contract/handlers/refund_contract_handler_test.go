@@ -0,0 +1,79 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/contract/queries"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/contract"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/invoice"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/plan"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+)
+
+func TestContractHandler_HandleRefundPreview(t *testing.T) {
+	mockRepo := new(MockRepository)
+	fee, err := invoices.NewCancellationFee(1000, 0)
+	assert.NoError(t, err)
+	invoicer := invoices.NewInvoicer(newMockInvoices(), invoices.NewInvoiceFactory(), "NC", false, fee)
+	handler := NewContractHandler(mockRepo, newMockPlans(), newMockHolidays(), newBooker(), newPricer(), invoicer, new(MockFactory), new(MockUnitOfWork))
+	contract := newActiveContract(t)
+
+	mockRepo.On("GetById", mock.Anything, contract.Id()).Return(contract, nil)
+
+	refund, err := handler.HandleRefundPreview(context.Background(), queries.PreviewRefundQuery{Id: contract.Id()})
+
+	assert.NoError(t, err)
+	assert.Equal(t, contract.Id(), refund.ContractId)
+	assert.Equal(t, 15, refund.Deliveries)
+	assert.Equal(t, 15, refund.Pending)
+	assert.Equal(t, bolivianos(1000), refund.Refundable)
+	assert.Equal(t, bolivianos(100), refund.Fee)
+	assert.Equal(t, bolivianos(900), refund.Total)
+	assert.Equal(t, contracts.Active, contract.ContractStatus())
+	mockRepo.AssertNotCalled(t, "ChangeStatus", mock.Anything, mock.Anything)
+}
+
+func TestContractHandler_HandleRefundPreview_Errors(t *testing.T) {
+	t.Run("contract not found", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		handler := NewContractHandler(mockRepo, newMockPlans(), newMockHolidays(), newBooker(), newPricer(), newInvoicer(), new(MockFactory), new(MockUnitOfWork))
+		contract := newActiveContract(t)
+
+		mockRepo.On("GetById", mock.Anything, contract.Id()).Return(nil, contracts.ErrNotFoundContract)
+
+		refund, err := handler.HandleRefundPreview(context.Background(), queries.PreviewRefundQuery{Id: contract.Id()})
+
+		assert.Nil(t, refund)
+		assert.ErrorIs(t, err, contracts.ErrNotFoundContract)
+	})
+
+	t.Run("plan not found", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockPlans := new(MockPlanRepository)
+		handler := NewContractHandler(mockRepo, mockPlans, newMockHolidays(), newBooker(), newPricer(), newInvoicer(), new(MockFactory), new(MockUnitOfWork))
+		contract := newActiveContract(t)
+
+		mockRepo.On("GetById", mock.Anything, contract.Id()).Return(contract, nil)
+		mockPlans.On("GetByCode", mock.Anything, "H").Return(nil, plans.ErrNotFoundPlan)
+
+		refund, err := handler.HandleRefundPreview(context.Background(), queries.PreviewRefundQuery{Id: contract.Id()})
+
+		assert.Nil(t, refund)
+		assert.ErrorIs(t, err, plans.ErrNotFoundPlan)
+	})
+
+	t.Run("contract finished", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		handler := NewContractHandler(mockRepo, newMockPlans(), newMockHolidays(), newBooker(), newPricer(), newInvoicer(), new(MockFactory), new(MockUnitOfWork))
+		contract := newActiveContract(t)
+		assert.NoError(t, contract.Completed())
+
+		mockRepo.On("GetById", mock.Anything, contract.Id()).Return(contract, nil)
+
+		refund, err := handler.HandleRefundPreview(context.Background(), queries.PreviewRefundQuery{Id: contract.Id()})
+
+		assert.Nil(t, refund)
+		assert.ErrorIs(t, err, contracts.ErrChangeStatusContract)
+	})
+}
@@ -0,0 +1,86 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/contract/commands"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/contract"
+	"log"
+	"time"
+)
+
+func (h *ContractHandler) HandleRenew(ctx context.Context, cmd commands.RenewContractCommand) (*contracts.Contract, error) {
+	var renewal *contracts.Contract
+	err := h.uow.Do(ctx, func(ctx context.Context) error {
+		contract, err := h.repository.GetById(ctx, cmd.ContractId)
+		if err != nil {
+			log.Printf("[handler:contract][HandleRenew] error getting contract '%s': %v", cmd.ContractId, err)
+			return err
+		}
+
+		renewal, err = h.renew(ctx, contract, time.Now())
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("[handler:contract][HandleRenew] contract '%s' renewed as '%s'", cmd.ContractId, renewal.Id())
+	return renewal, nil
+}
+
+func (h *ContractHandler) renew(ctx context.Context, contract *contracts.Contract, now time.Time) (*contracts.Contract, error) {
+	renewed, err := h.repository.ExistsRenewal(ctx, contract.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	if renewed {
+		return nil, fmt.Errorf("%w: %s", contracts.ErrAlreadyRenewedContract, contract.Id())
+	}
+
+	plan, err := h.plans.GetByCode(ctx, string(contract.ContractType()))
+	if err != nil {
+		log.Printf("[handler:contract][renew] error getting plan of contract '%s': %v", contract.Id(), err)
+		return nil, err
+	}
+
+	calendar, err := h.holidayCalendar(ctx, contract.EndDate().AddDate(0, 0, 1))
+	if err != nil {
+		log.Printf("[handler:contract][renew] error getting holidays after contract '%s': %v", contract.Id(), err)
+		return nil, err
+	}
+
+	renewal, err := contract.Renew(plan, calendar, now)
+	if err != nil {
+		log.Printf("[handler:contract][renew] contract '%s' cannot be renewed: %v", contract.Id(), err)
+		return nil, err
+	}
+
+	dlvrs := renewal.Deliveries()
+	if len(dlvrs) > 0 {
+		if _, err = h.pricer.Serve(ctx, dlvrs[0].Coordinates()); err != nil {
+			log.Printf("[handler:contract][renew] address of contract '%s' is no longer served: %v", contract.Id(), err)
+			return nil, err
+		}
+	}
+
+	// The renewal keeps the slot of the contract while the slot still serves
+	// the address and has room; otherwise it is left for the patient to pick.
+	if slotId := contract.SlotId(); slotId != nil && len(dlvrs) > 0 {
+		if err = h.book(ctx, renewal, *slotId, dlvrs[0].Coordinates()); err != nil {
+			log.Printf("[handler:contract][renew] renewal of contract '%s' keeps no slot: %v", contract.Id(), err)
+		}
+	}
+
+	created, err := h.repository.Create(ctx, renewal)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = h.issue(ctx, created, now); err != nil {
+		log.Printf("[handler:contract][renew] error invoicing renewal of contract '%s': %v", contract.Id(), err)
+		return nil, err
+	}
+	return created, nil
+}
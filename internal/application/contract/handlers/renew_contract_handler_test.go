@@ -0,0 +1,221 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/contract/commands"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/contract"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/pricing"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/slot"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+func newEndingContract(t *testing.T, autoRenew bool) *contracts.Contract {
+	coords, err := valueobjects.NewCoordinates(-17.7863, -63.1812)
+	assert.NoError(t, err)
+
+	contract := contracts.NewContract(uuid.New(), uuid.New(), halfMonthPlan, time.Now().AddDate(0, 0, -12), tenBolivianos, "Sesame Street", 30, coords)
+	assert.NoError(t, contract.Active())
+	assert.NoError(t, contract.SetAutoRenew(autoRenew))
+	contract.ClearDomainEvents()
+	return contract
+}
+
+func TestContractHandler_HandleRenew(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(MockRepository)
+	handler := NewContractHandler(mockRepo, newMockPlans(), newMockHolidays(), newBooker(), newPricer(), newInvoicer(), new(MockFactory), new(MockUnitOfWork))
+	contract := newEndingContract(t, false)
+
+	stored := newActiveContract(t)
+	var created *contracts.Contract
+
+	mockRepo.On("GetById", mock.Anything, contract.Id()).Return(contract, nil)
+	mockRepo.On("ExistsRenewal", mock.Anything, contract.Id()).Return(false, nil)
+	mockRepo.On("Create", mock.Anything, mock.MatchedBy(func(c *contracts.Contract) bool {
+		return c.RenewedFrom() != nil && *c.RenewedFrom() == contract.Id()
+	})).Run(func(args mock.Arguments) {
+		created = args.Get(1).(*contracts.Contract)
+	}).Return(stored, nil)
+
+	renewal, err := handler.HandleRenew(ctx, commands.RenewContractCommand{ContractId: contract.Id()})
+
+	assert.NoError(t, err)
+	assert.Equal(t, stored, renewal)
+	assert.Equal(t, contract.EndDate().AddDate(0, 0, 1).Format(time.DateOnly), created.StartDate().Format(time.DateOnly))
+	mockRepo.AssertExpectations(t)
+}
+
+func TestContractHandler_HandleRenew_Slot(t *testing.T) {
+	cases := []struct {
+		name   string
+		booked int
+		kept   bool
+	}{
+		{"Keeps the slot", 0, true},
+		{"Drops a full slot", 20, false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockRepo := new(MockRepository)
+			mockSlots := new(MockSlotRepository)
+			mockZones := new(MockZoneRepository)
+			handler := NewContractHandler(mockRepo, newMockPlans(), newMockHolidays(), slots.NewBooker(mockSlots, mockZones), newPricer(), newInvoicer(), new(MockFactory), new(MockUnitOfWork))
+			contract := newEndingContract(t, false)
+
+			at := contract.Deliveries()[0].Coordinates()
+			zone := pricing.NewZone("Downtown", at, 1000, bolivianos(100))
+			slot := slots.NewSlot(zone.Id(), "Dinner", 19*time.Hour, 21*time.Hour, 20)
+			assert.NoError(t, contract.Book(slot))
+
+			var created *contracts.Contract
+			mockRepo.On("GetById", mock.Anything, contract.Id()).Return(contract, nil)
+			mockRepo.On("ExistsRenewal", mock.Anything, contract.Id()).Return(false, nil)
+			mockRepo.On("Create", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+				created = args.Get(1).(*contracts.Contract)
+			}).Return(newActiveContract(t), nil)
+			mockSlots.On("GetById", mock.Anything, slot.Id()).Return(slot, nil)
+			mockSlots.On("Lock", mock.Anything, slot.Id()).Return(nil)
+			mockSlots.On("CountBooked", mock.Anything, slot.Id(), mock.Anything, mock.Anything).Return(map[string]int{
+				contract.EndDate().AddDate(0, 0, 1).Format(time.DateOnly): tc.booked,
+			}, nil)
+			mockZones.On("GetAll", mock.Anything).Return([]*pricing.Zone{zone}, nil)
+
+			_, err := handler.HandleRenew(context.Background(), commands.RenewContractCommand{ContractId: contract.Id()})
+
+			assert.NoError(t, err)
+			if !tc.kept {
+				assert.Nil(t, created.SlotId())
+				assert.Nil(t, created.Deliveries()[0].SlotId())
+				return
+			}
+
+			assert.Equal(t, slot.Id(), *created.SlotId())
+			for _, d := range created.Deliveries() {
+				assert.Equal(t, slot.Id(), *d.SlotId())
+				assert.Equal(t, 19, d.Date().Hour())
+			}
+		})
+	}
+}
+
+func TestContractHandler_HandleRenew_Errors(t *testing.T) {
+	t.Run("already renewed", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		handler := NewContractHandler(mockRepo, newMockPlans(), newMockHolidays(), newBooker(), newPricer(), newInvoicer(), new(MockFactory), new(MockUnitOfWork))
+		contract := newEndingContract(t, false)
+
+		mockRepo.On("GetById", mock.Anything, contract.Id()).Return(contract, nil)
+		mockRepo.On("ExistsRenewal", mock.Anything, contract.Id()).Return(true, nil)
+
+		renewal, err := handler.HandleRenew(context.Background(), commands.RenewContractCommand{ContractId: contract.Id()})
+
+		assert.Nil(t, renewal)
+		assert.ErrorIs(t, err, contracts.ErrAlreadyRenewedContract)
+		mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("too early", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		handler := NewContractHandler(mockRepo, newMockPlans(), newMockHolidays(), newBooker(), newPricer(), newInvoicer(), new(MockFactory), new(MockUnitOfWork))
+		contract := newActiveContract(t)
+
+		mockRepo.On("GetById", mock.Anything, contract.Id()).Return(contract, nil)
+		mockRepo.On("ExistsRenewal", mock.Anything, contract.Id()).Return(false, nil)
+
+		renewal, err := handler.HandleRenew(context.Background(), commands.RenewContractCommand{ContractId: contract.Id()})
+
+		assert.Nil(t, renewal)
+		assert.ErrorIs(t, err, contracts.ErrNotRenewableContract)
+	})
+
+	t.Run("address not served", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		handler := NewContractHandler(mockRepo, newMockPlans(), newMockHolidays(), newBooker(), newServingPricer(t, -16.5, -68.15, 1000), newInvoicer(), new(MockFactory), new(MockUnitOfWork))
+		contract := newEndingContract(t, false)
+
+		mockRepo.On("GetById", mock.Anything, contract.Id()).Return(contract, nil)
+		mockRepo.On("ExistsRenewal", mock.Anything, contract.Id()).Return(false, nil)
+
+		renewal, err := handler.HandleRenew(context.Background(), commands.RenewContractCommand{ContractId: contract.Id()})
+
+		assert.Nil(t, renewal)
+		assert.ErrorIs(t, err, pricing.ErrNotCoveredZone)
+		mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		handler := NewContractHandler(mockRepo, newMockPlans(), newMockHolidays(), newBooker(), newPricer(), newInvoicer(), new(MockFactory), new(MockUnitOfWork))
+		id := uuid.New()
+
+		mockRepo.On("GetById", mock.Anything, id).Return((*contracts.Contract)(nil), contracts.ErrNotFoundContract)
+
+		renewal, err := handler.HandleRenew(context.Background(), commands.RenewContractCommand{ContractId: id})
+
+		assert.Nil(t, renewal)
+		assert.ErrorIs(t, err, contracts.ErrNotFoundContract)
+	})
+}
+
+func TestContractHandler_HandleAutoRenew(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(MockRepository)
+	uow := new(MockUnitOfWork)
+	handler := NewContractHandler(mockRepo, newMockPlans(), newMockHolidays(), newBooker(), newPricer(), newInvoicer(), new(MockFactory), uow)
+
+	now := time.Now()
+	due := newEndingContract(t, true)
+	optedOut := newEndingContract(t, false)
+	failing := newEndingContract(t, true)
+
+	mockRepo.On("GetAutoRenewable", mock.Anything, today(now).AddDate(0, 0, -1), mock.MatchedBy(func(until time.Time) bool {
+		return until.Format(time.DateOnly) == now.AddDate(0, 0, contracts.AutoRenewOptOutDays+1).Format(time.DateOnly)
+	})).Return([]uuid.UUID{due.Id(), optedOut.Id(), failing.Id()}, nil)
+	mockRepo.On("GetById", mock.Anything, due.Id()).Return(due, nil)
+	mockRepo.On("GetById", mock.Anything, optedOut.Id()).Return(optedOut, nil)
+	mockRepo.On("GetById", mock.Anything, failing.Id()).Return(failing, nil)
+	mockRepo.On("ExistsRenewal", mock.Anything, due.Id()).Return(false, nil)
+	mockRepo.On("ExistsRenewal", mock.Anything, failing.Id()).Return(false, nil)
+	mockRepo.On("Create", mock.Anything, mock.MatchedBy(func(c *contracts.Contract) bool {
+		return *c.RenewedFrom() == due.Id()
+	})).Return(due, nil)
+	mockRepo.On("Create", mock.Anything, mock.MatchedBy(func(c *contracts.Contract) bool {
+		return *c.RenewedFrom() == failing.Id()
+	})).Return((*contracts.Contract)(nil), ErrDbFailureContract)
+
+	n, err := handler.HandleAutoRenew(ctx, now)
+
+	assert.Equal(t, 1, n)
+	assert.ErrorIs(t, err, ErrDbFailureContract)
+	assert.Equal(t, 1, uow.committed)
+	assert.Equal(t, 2, uow.rolledBack)
+	mockRepo.AssertNotCalled(t, "ExistsRenewal", mock.Anything, optedOut.Id())
+}
+
+func TestContractHandler_HandleSetAutoRenew(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(MockRepository)
+	handler := NewContractHandler(mockRepo, newMockPlans(), newMockHolidays(), newBooker(), newPricer(), newInvoicer(), new(MockFactory), new(MockUnitOfWork))
+	contract := newActiveContract(t)
+
+	mockRepo.On("GetById", mock.Anything, contract.Id()).Return(contract, nil)
+	mockRepo.On("SetAutoRenew", mock.Anything, contract).Return(nil)
+
+	resp, err := handler.HandleSetAutoRenew(ctx, commands.SetAutoRenewCommand{ContractId: contract.Id(), Enabled: true})
+
+	assert.NoError(t, err)
+	assert.True(t, resp.AutoRenew())
+	mockRepo.AssertExpectations(t)
+
+	assert.NoError(t, contract.Completed())
+	resp, err = handler.HandleSetAutoRenew(ctx, commands.SetAutoRenewCommand{ContractId: contract.Id(), Enabled: false})
+
+	assert.Nil(t, resp)
+	assert.ErrorIs(t, err, contracts.ErrAutoRenewContract)
+}
@@ -0,0 +1,135 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/contract"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/delivery"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/invoice"
+	"github.com/google/uuid"
+	"log"
+	"time"
+)
+
+var errNothingDue = errors.New("nothing due")
+
+func (h *ContractHandler) HandleActivateDue(ctx context.Context, now time.Time) (int, error) {
+	ids, err := h.repository.GetToActivate(ctx, today(now).AddDate(0, 0, 1))
+	if err != nil {
+		log.Printf("[handler:contract][HandleActivateDue] error getting contracts to activate: %v", err)
+		return 0, err
+	}
+
+	return h.processDue(ctx, "HandleActivateDue", ids, func(ctx context.Context, contract *contracts.Contract) error {
+		if !contract.ActivationDue(now) {
+			return errNothingDue
+		}
+
+		// An unpaid contract waits for its payment, the next run checks again.
+		if err := h.invoicer.CheckPaid(ctx, contract.Id()); errors.Is(err, invoices.ErrUnpaidInvoice) {
+			log.Printf("[handler:contract][HandleActivateDue] contract '%s' is due but %v", contract.Id(), err)
+			return errNothingDue
+		} else if err != nil {
+			return err
+		}
+
+		if err := contract.Active(); err != nil {
+			return err
+		}
+
+		_, err := h.repository.ChangeStatus(ctx, contract)
+		return err
+	})
+}
+
+func (h *ContractHandler) HandleCompleteDue(ctx context.Context, now time.Time) (int, error) {
+	ids, err := h.repository.GetToComplete(ctx, today(now))
+	if err != nil {
+		log.Printf("[handler:contract][HandleCompleteDue] error getting contracts to complete: %v", err)
+		return 0, err
+	}
+
+	return h.processDue(ctx, "HandleCompleteDue", ids, func(ctx context.Context, contract *contracts.Contract) error {
+		if !contract.CompletionDue(now) {
+			return errNothingDue
+		}
+
+		closed, err := contract.ClosePastDeliveries(now)
+		if err != nil {
+			return err
+		}
+
+		if err = contract.Completed(); err != nil {
+			return err
+		}
+
+		if _, err = h.repository.ChangeStatus(ctx, contract); err != nil {
+			return err
+		}
+
+		return h.saveDeliveries(ctx, contract, closed)
+	})
+}
+
+func (h *ContractHandler) HandleClosePastDeliveries(ctx context.Context, now time.Time) (int, error) {
+	ids, err := h.repository.GetWithPastDeliveries(ctx, today(now))
+	if err != nil {
+		log.Printf("[handler:contract][HandleClosePastDeliveries] error getting contracts with past deliveries: %v", err)
+		return 0, err
+	}
+
+	return h.processDue(ctx, "HandleClosePastDeliveries", ids, func(ctx context.Context, contract *contracts.Contract) error {
+		closed, err := contract.ClosePastDeliveries(now)
+		if err != nil {
+			return err
+		}
+
+		if len(closed) == 0 {
+			return errNothingDue
+		}
+
+		return h.saveDeliveries(ctx, contract, closed)
+	})
+}
+
+// processDue applies fn to every contract in its own unit of work. The
+// contract is reloaded inside it, so fn re-checks the condition that listed it
+// and runs that already moved it, or ran on another instance, are skipped.
+func (h *ContractHandler) processDue(ctx context.Context, method string, ids []uuid.UUID, fn func(ctx context.Context, contract *contracts.Contract) error) (int, error) {
+	processed := 0
+	var errs []error
+	for _, id := range ids {
+		err := h.uow.Do(ctx, func(ctx context.Context) error {
+			contract, err := h.repository.GetById(ctx, id)
+			if err != nil {
+				return err
+			}
+
+			return fn(ctx, contract)
+		})
+		if errors.Is(err, errNothingDue) {
+			continue
+		} else if err != nil {
+			log.Printf("[handler:contract][%s] error processing contract '%s': %v", method, id, err)
+			errs = append(errs, fmt.Errorf("contract %s: %w", id, err))
+			continue
+		}
+		processed++
+	}
+
+	log.Printf("[handler:contract][%s] processed %d of %d contracts", method, processed, len(ids))
+	return processed, errors.Join(errs...)
+}
+
+func (h *ContractHandler) saveDeliveries(ctx context.Context, contract *contracts.Contract, dlvrs []*deliveries.Delivery) error {
+	if len(dlvrs) == 0 {
+		return nil
+	}
+
+	return h.repository.RescheduleDeliveries(ctx, contract.Id(), dlvrs)
+}
+
+func today(now time.Time) time.Time {
+	return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+}
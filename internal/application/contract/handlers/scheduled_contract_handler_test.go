@@ -0,0 +1,172 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/contract"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/delivery"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/invoice"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+func newStartedContract(t *testing.T, start time.Time, active bool) *contracts.Contract {
+	coords, err := valueobjects.NewCoordinates(-17.7863, -63.1812)
+	assert.NoError(t, err)
+
+	contract := contracts.NewContract(uuid.New(), uuid.New(), halfMonthPlan, start, tenBolivianos, "Sesame Street", 30, coords)
+	if active {
+		assert.NoError(t, contract.Active())
+	}
+	contract.ClearDomainEvents()
+	return contract
+}
+
+func TestContractHandler_HandleActivateDue(t *testing.T) {
+	now := time.Now()
+	mockRepo := new(MockRepository)
+	uow := new(MockUnitOfWork)
+	handler := NewContractHandler(mockRepo, newMockPlans(), newMockHolidays(), newBooker(), newPricer(), newInvoicer(), new(MockFactory), uow)
+
+	due := newStartedContract(t, now, false)
+	done := newStartedContract(t, now, true)
+
+	mockRepo.On("GetToActivate", mock.Anything, today(now).AddDate(0, 0, 1)).Return([]uuid.UUID{due.Id(), done.Id()}, nil)
+	mockRepo.On("GetById", mock.Anything, due.Id()).Return(due, nil)
+	mockRepo.On("GetById", mock.Anything, done.Id()).Return(done, nil)
+	mockRepo.On("ChangeStatus", mock.Anything, due).Return(due, nil)
+
+	processed, err := handler.HandleActivateDue(context.Background(), now)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, processed)
+	assert.Equal(t, contracts.Active, due.ContractStatus())
+	assert.Equal(t, 1, uow.committed)
+	mockRepo.AssertNumberOfCalls(t, "ChangeStatus", 1)
+}
+
+func TestContractHandler_HandleActivateDue_Unpaid(t *testing.T) {
+	now := time.Now()
+	mockRepo := new(MockRepository)
+	mockInvoices := new(MockInvoiceRepository)
+	handler := NewContractHandler(mockRepo, newMockPlans(), newMockHolidays(), newBooker(), newPricer(), invoices.NewInvoicer(mockInvoices, invoices.NewInvoiceFactory(), "NC", true, invoices.CancellationFee{}), new(MockFactory), new(MockUnitOfWork))
+
+	unpaid := newStartedContract(t, now, false)
+	paid := newStartedContract(t, now, false)
+	paidInvoice := invoices.NewInvoice("NC", 2, paid.Id(), paid.PatientId(), paid.CostValue(), now)
+	_, err := paidInvoice.Record(invoices.Full, paid.CostValue(), "fake", "fake_1", now)
+	assert.NoError(t, err)
+
+	mockRepo.On("GetToActivate", mock.Anything, mock.Anything).Return([]uuid.UUID{unpaid.Id(), paid.Id()}, nil)
+	mockRepo.On("GetById", mock.Anything, unpaid.Id()).Return(unpaid, nil)
+	mockRepo.On("GetById", mock.Anything, paid.Id()).Return(paid, nil)
+	mockRepo.On("ChangeStatus", mock.Anything, paid).Return(paid, nil)
+	mockInvoices.On("GetByContract", mock.Anything, unpaid.Id()).Return(invoices.NewInvoice("NC", 1, unpaid.Id(), unpaid.PatientId(), unpaid.CostValue(), now), nil)
+	mockInvoices.On("GetByContract", mock.Anything, paid.Id()).Return(paidInvoice, nil)
+
+	processed, err := handler.HandleActivateDue(context.Background(), now)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, processed)
+	assert.Equal(t, contracts.Created, unpaid.ContractStatus())
+	assert.Equal(t, contracts.Active, paid.ContractStatus())
+	mockRepo.AssertNumberOfCalls(t, "ChangeStatus", 1)
+}
+
+func TestContractHandler_HandleCompleteDue(t *testing.T) {
+	now := time.Now()
+	mockRepo := new(MockRepository)
+	handler := NewContractHandler(mockRepo, newMockPlans(), newMockHolidays(), newBooker(), newPricer(), newInvoicer(), new(MockFactory), new(MockUnitOfWork))
+	contract := newStartedContract(t, now.AddDate(0, 0, -20), true)
+
+	var closed []*deliveries.Delivery
+	mockRepo.On("GetToComplete", mock.Anything, today(now)).Return([]uuid.UUID{contract.Id()}, nil)
+	mockRepo.On("GetById", mock.Anything, contract.Id()).Return(contract, nil)
+	mockRepo.On("ChangeStatus", mock.Anything, contract).Return(contract, nil)
+	mockRepo.On("RescheduleDeliveries", mock.Anything, contract.Id(), mock.Anything).Run(func(args mock.Arguments) {
+		closed = args.Get(2).([]*deliveries.Delivery)
+	}).Return(nil)
+
+	processed, err := handler.HandleCompleteDue(context.Background(), now)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, processed)
+	assert.Equal(t, contracts.Finished, contract.ContractStatus())
+	assert.Len(t, closed, len(contract.Deliveries()))
+	for _, d := range closed {
+		assert.Equal(t, deliveries.Cancelled, d.Status())
+	}
+}
+
+func TestContractHandler_HandleClosePastDeliveries(t *testing.T) {
+	now := time.Now()
+	mockRepo := new(MockRepository)
+	handler := NewContractHandler(mockRepo, newMockPlans(), newMockHolidays(), newBooker(), newPricer(), newInvoicer(), new(MockFactory), new(MockUnitOfWork))
+	contract := newEndingContract(t, false)
+
+	var closed []*deliveries.Delivery
+	mockRepo.On("GetWithPastDeliveries", mock.Anything, today(now)).Return([]uuid.UUID{contract.Id()}, nil)
+	mockRepo.On("GetById", mock.Anything, contract.Id()).Return(contract, nil)
+	mockRepo.On("RescheduleDeliveries", mock.Anything, contract.Id(), mock.Anything).Run(func(args mock.Arguments) {
+		closed = args.Get(2).([]*deliveries.Delivery)
+	}).Return(nil)
+
+	processed, err := handler.HandleClosePastDeliveries(context.Background(), now)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, processed)
+	assert.NotEmpty(t, closed)
+	for _, d := range closed {
+		assert.True(t, d.Date().Before(today(now)))
+		assert.Equal(t, deliveries.Cancelled, d.Status())
+	}
+	assert.Equal(t, contracts.Active, contract.ContractStatus())
+
+	processed, err = handler.HandleClosePastDeliveries(context.Background(), now)
+
+	assert.NoError(t, err)
+	assert.Zero(t, processed)
+	mockRepo.AssertNumberOfCalls(t, "RescheduleDeliveries", 1)
+}
+
+func TestContractHandler_HandleScheduled_Errors(t *testing.T) {
+	now := time.Now()
+
+	t.Run("listing fails", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		handler := NewContractHandler(mockRepo, newMockPlans(), newMockHolidays(), newBooker(), newPricer(), newInvoicer(), new(MockFactory), new(MockUnitOfWork))
+
+		mockRepo.On("GetToComplete", mock.Anything, mock.Anything).Return(nil, ErrDbFailureContract)
+
+		processed, err := handler.HandleCompleteDue(context.Background(), now)
+
+		assert.Zero(t, processed)
+		assert.ErrorIs(t, err, ErrDbFailureContract)
+	})
+
+	t.Run("one contract fails", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		uow := new(MockUnitOfWork)
+		handler := NewContractHandler(mockRepo, newMockPlans(), newMockHolidays(), newBooker(), newPricer(), newInvoicer(), new(MockFactory), uow)
+
+		failing := newStartedContract(t, now, false)
+		due := newStartedContract(t, now, false)
+
+		mockRepo.On("GetToActivate", mock.Anything, mock.Anything).Return([]uuid.UUID{failing.Id(), due.Id()}, nil)
+		mockRepo.On("GetById", mock.Anything, failing.Id()).Return(failing, nil)
+		mockRepo.On("GetById", mock.Anything, due.Id()).Return(due, nil)
+		mockRepo.On("ChangeStatus", mock.Anything, failing).Return((*contracts.Contract)(nil), ErrDbFailureContract)
+		mockRepo.On("ChangeStatus", mock.Anything, due).Return(due, nil)
+
+		processed, err := handler.HandleActivateDue(context.Background(), now)
+
+		assert.Equal(t, 1, processed)
+		assert.ErrorIs(t, err, ErrDbFailureContract)
+		assert.ErrorContains(t, err, failing.Id().String())
+		assert.Equal(t, 1, uow.committed)
+		assert.Equal(t, 1, uow.rolledBack)
+	})
+}
@@ -0,0 +1,31 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/contract/commands"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/contract"
+	"log"
+)
+
+func (h *ContractHandler) HandleSetAutoRenew(ctx context.Context, cmd commands.SetAutoRenewCommand) (*contracts.Contract, error) {
+	var contract *contracts.Contract
+	err := h.uow.Do(ctx, func(ctx context.Context) error {
+		var err error
+		contract, err = h.repository.GetById(ctx, cmd.ContractId)
+		if err != nil {
+			return err
+		}
+
+		if err = contract.SetAutoRenew(cmd.Enabled); err != nil {
+			return err
+		}
+
+		return h.repository.SetAutoRenew(ctx, contract)
+	})
+	if err != nil {
+		log.Printf("[handler:contract][HandleSetAutoRenew] error setting auto-renew of contract '%s': %v", cmd.ContractId, err)
+		return nil, err
+	}
+
+	return contract, nil
+}
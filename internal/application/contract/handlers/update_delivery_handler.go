@@ -0,0 +1,50 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/contract/commands"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/delivery"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	"log"
+)
+
+func (h *ContractHandler) HandleUpdateDelivery(ctx context.Context, cmd commands.UpdateDeliveryDayCommand) (*deliveries.Delivery, error) {
+	coordinates, err := valueobjects.NewCoordinates(cmd.Latitude, cmd.Longitude)
+	if err != nil {
+		log.Printf("[handler:contract][HandleUpdateDelivery] error creating coordinates: %v", err)
+		return nil, err
+	}
+
+	if _, err = h.pricer.Serve(ctx, coordinates); err != nil {
+		log.Printf("[handler:contract][HandleUpdateDelivery] error checking address is served: %v", err)
+		return nil, err
+	}
+
+	var delivery *deliveries.Delivery
+	err = h.uow.Do(ctx, func(ctx context.Context) error {
+		delivery, err = h.contractDelivery(ctx, cmd.ContractId, cmd.DeliveryDayId)
+		if err != nil {
+			log.Printf("[handler:contract][HandleUpdateDelivery] error getting delivery '%s': %v", cmd.DeliveryDayId, err)
+			return err
+		}
+
+		if err = delivery.Update(cmd.Street, cmd.Number, coordinates); err != nil {
+			log.Printf("[handler:contract][HandleUpdateDelivery] error updating delivery '%s': %v", cmd.DeliveryDayId, err)
+			return err
+		}
+
+		delivery, err = h.repository.UpdateDelivery(ctx, delivery.Id(), delivery)
+		if err != nil {
+			log.Printf("[handler:contract][HandleUpdateDelivery] error saving delivery '%s': %v", cmd.DeliveryDayId, err)
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("[handler:contract][HandleUpdateDelivery] delivery updated")
+	return delivery, nil
+}
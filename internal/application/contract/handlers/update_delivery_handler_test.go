@@ -0,0 +1,124 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/contract/commands"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/delivery"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/pricing"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+)
+
+func TestContractHandler_HandleUpdateDelivery(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(MockRepository)
+	mockFactory := new(MockFactory)
+	handler := NewContractHandler(mockRepo, newMockPlans(), newMockHolidays(), newBooker(), newPricer(), newInvoicer(), mockFactory, new(MockUnitOfWork))
+
+	contractId := uuid.New()
+	delivery := newDelivery(t, contractId, "P")
+
+	cmd := commands.UpdateDeliveryDayCommand{
+		ContractId:    contractId,
+		DeliveryDayId: delivery.Id(),
+		Street:        "Baker Street",
+		Number:        221,
+		Latitude:      51.5237,
+		Longitude:     -0.1585,
+	}
+
+	mockRepo.On("GetDeliveriesById", mock.Anything, delivery.Id()).Return(delivery, nil)
+	mockRepo.On("UpdateDelivery", mock.Anything, delivery.Id(), delivery).Return(delivery, nil)
+
+	resp, err := handler.HandleUpdateDelivery(ctx, cmd)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, cmd.Street, resp.Street())
+	assert.Equal(t, cmd.Number, resp.Number())
+	assert.Equal(t, cmd.Latitude, resp.Coordinates().Latitude())
+	assert.Equal(t, cmd.Longitude, resp.Coordinates().Longitude())
+
+	mockRepo.AssertExpectations(t)
+	mockFactory.AssertExpectations(t)
+}
+
+func TestContractHandler_HandleUpdateDelivery_Errors(t *testing.T) {
+	ctx := context.Background()
+	contractId := uuid.New()
+
+	t.Run("Invalid coordinates", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		handler := NewContractHandler(mockRepo, newMockPlans(), newMockHolidays(), newBooker(), newPricer(), newInvoicer(), new(MockFactory), new(MockUnitOfWork))
+
+		cmd := commands.UpdateDeliveryDayCommand{ContractId: contractId, DeliveryDayId: uuid.New(), Street: "Elm Street", Number: 1, Latitude: 91}
+
+		resp, err := handler.HandleUpdateDelivery(ctx, cmd)
+
+		assert.ErrorIs(t, err, valueobjects.ErrOutOfBoundariesLatitude)
+		assert.Nil(t, resp)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Address not served", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		handler := NewContractHandler(mockRepo, newMockPlans(), newMockHolidays(), newBooker(), newServingPricer(t, -17.7863, -63.1812, 5000), newInvoicer(), new(MockFactory), new(MockUnitOfWork))
+
+		cmd := commands.UpdateDeliveryDayCommand{ContractId: contractId, DeliveryDayId: uuid.New(), Street: "Avenida Arce", Number: 2631, Latitude: -16.5000, Longitude: -68.1500}
+
+		resp, err := handler.HandleUpdateDelivery(ctx, cmd)
+
+		assert.ErrorIs(t, err, pricing.ErrNotCoveredZone)
+		assert.Nil(t, resp)
+		mockRepo.AssertNotCalled(t, "GetDeliveriesById", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Delivery from another contract", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		handler := NewContractHandler(mockRepo, newMockPlans(), newMockHolidays(), newBooker(), newPricer(), newInvoicer(), new(MockFactory), new(MockUnitOfWork))
+		delivery := newDelivery(t, uuid.New(), "P")
+
+		cmd := commands.UpdateDeliveryDayCommand{ContractId: contractId, DeliveryDayId: delivery.Id(), Street: "Elm Street", Number: 1}
+		mockRepo.On("GetDeliveriesById", mock.Anything, delivery.Id()).Return(delivery, nil)
+
+		resp, err := handler.HandleUpdateDelivery(ctx, cmd)
+
+		assert.ErrorIs(t, err, deliveries.ErrContractMismatchDelivery)
+		assert.Nil(t, resp)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Delivery not pending", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		handler := NewContractHandler(mockRepo, newMockPlans(), newMockHolidays(), newBooker(), newPricer(), newInvoicer(), new(MockFactory), new(MockUnitOfWork))
+		delivery := newDelivery(t, contractId, "D")
+
+		cmd := commands.UpdateDeliveryDayCommand{ContractId: contractId, DeliveryDayId: delivery.Id(), Street: "Elm Street", Number: 1}
+		mockRepo.On("GetDeliveriesById", mock.Anything, delivery.Id()).Return(delivery, nil)
+
+		resp, err := handler.HandleUpdateDelivery(ctx, cmd)
+
+		assert.ErrorIs(t, err, deliveries.ErrNotPendingDelivery)
+		assert.Nil(t, resp)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Repository error", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		handler := NewContractHandler(mockRepo, newMockPlans(), newMockHolidays(), newBooker(), newPricer(), newInvoicer(), new(MockFactory), new(MockUnitOfWork))
+		delivery := newDelivery(t, contractId, "P")
+
+		cmd := commands.UpdateDeliveryDayCommand{ContractId: contractId, DeliveryDayId: delivery.Id(), Street: "Elm Street", Number: 1}
+		mockRepo.On("GetDeliveriesById", mock.Anything, delivery.Id()).Return(delivery, nil)
+		mockRepo.On("UpdateDelivery", mock.Anything, delivery.Id(), delivery).Return(nil, ErrDbFailureContract)
+
+		resp, err := handler.HandleUpdateDelivery(ctx, cmd)
+
+		assert.ErrorIs(t, err, ErrDbFailureContract)
+		assert.Nil(t, resp)
+		mockRepo.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,51 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/contract/commands"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/delivery"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	"log"
+)
+
+func (h *ContractHandler) HandleUpdateDeliveryList(ctx context.Context, cmd commands.UpdateDeliveryDayListCommand) ([]*deliveries.Delivery, error) {
+	coordinates, err := valueobjects.NewCoordinates(cmd.Latitude, cmd.Longitude)
+	if err != nil {
+		log.Printf("[handler:contract][HandleUpdateDeliveryList] error creating coordinates: %v", err)
+		return nil, err
+	}
+
+	if _, err = h.pricer.Serve(ctx, coordinates); err != nil {
+		log.Printf("[handler:contract][HandleUpdateDeliveryList] error checking address is served: %v", err)
+		return nil, err
+	}
+
+	var dlvrs []*deliveries.Delivery
+	err = h.uow.Do(ctx, func(ctx context.Context) error {
+		contract, err := h.repository.GetById(ctx, cmd.ContractId)
+		if err != nil {
+			log.Printf("[handler:contract][HandleUpdateDeliveryList] error getting contract '%s': %v", cmd.ContractId, err)
+			return err
+		}
+
+		updated, err := contract.UpdateDeliveries(cmd.FirstDate, cmd.LastDate, cmd.Street, cmd.Number, coordinates)
+		if err != nil {
+			log.Printf("[handler:contract][HandleUpdateDeliveryList] error updating deliveries of contract '%s': %v", cmd.ContractId, err)
+			return err
+		}
+
+		dlvrs, err = h.repository.UpdateDeliveries(ctx, contract.Id(), updated)
+		if err != nil {
+			log.Printf("[handler:contract][HandleUpdateDeliveryList] error saving deliveries of contract '%s': %v", cmd.ContractId, err)
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("[handler:contract][HandleUpdateDeliveryList] %d deliveries updated", len(dlvrs))
+	return dlvrs, nil
+}
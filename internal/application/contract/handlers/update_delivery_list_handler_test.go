@@ -0,0 +1,99 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/contract/commands"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/contract"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/delivery"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/pricing"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+func TestContractHandler_HandleUpdateDeliveryList(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(MockRepository)
+	handler := NewContractHandler(mockRepo, newMockPlans(), newMockHolidays(), newBooker(), newPricer(), newInvoicer(), new(MockFactory), new(MockUnitOfWork))
+
+	start := time.Now().AddDate(0, 0, 3)
+	coords, err := valueobjects.NewCoordinates(-17.7863, -63.1812)
+	assert.NoError(t, err)
+	contract := contracts.NewContract(uuid.New(), uuid.New(), halfMonthPlan, start, tenBolivianos, "Sesame Street", 30, coords)
+
+	cmd := commands.UpdateDeliveryDayListCommand{
+		ContractId: contract.Id(),
+		FirstDate:  start.AddDate(0, 0, 2),
+		LastDate:   start.AddDate(0, 0, 4),
+		Street:     "Baker Street",
+		Number:     221,
+		Latitude:   51.5237,
+		Longitude:  -0.1585,
+	}
+
+	mockRepo.On("GetById", mock.Anything, contract.Id()).Return(contract, nil)
+	mockRepo.On("UpdateDeliveries", mock.Anything, contract.Id(), mock.MatchedBy(func(d []*deliveries.Delivery) bool {
+		return len(d) == 3
+	})).Return([]*deliveries.Delivery{}, nil)
+
+	resp, err := handler.HandleUpdateDeliveryList(ctx, cmd)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestContractHandler_HandleUpdateDeliveryList_NotPending(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(MockRepository)
+	handler := NewContractHandler(mockRepo, newMockPlans(), newMockHolidays(), newBooker(), newPricer(), newInvoicer(), new(MockFactory), new(MockUnitOfWork))
+
+	now := time.Now()
+	dlvrs := []deliveries.Delivery{
+		*newDelivery(t, uuid.Nil, "P"),
+		*newDelivery(t, uuid.Nil, "C"),
+	}
+	contract, err := contracts.NewContractFromDb(uuid.New(), uuid.New(), uuid.New(), "H", "A", nil, nil, false, nil, now, now, now.AddDate(0, 0, 14), 1000, "BOB", int(contracts.EveryDay), nil, dlvrs, now, now, nil)
+	assert.NoError(t, err)
+
+	cmd := commands.UpdateDeliveryDayListCommand{
+		ContractId: contract.Id(),
+		FirstDate:  now,
+		LastDate:   now.AddDate(0, 0, 14),
+		Street:     "Baker Street",
+		Number:     221,
+	}
+
+	mockRepo.On("GetById", mock.Anything, contract.Id()).Return(contract, nil)
+
+	resp, err := handler.HandleUpdateDeliveryList(ctx, cmd)
+
+	assert.ErrorIs(t, err, deliveries.ErrNotPendingDelivery)
+	assert.Nil(t, resp)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestContractHandler_HandleUpdateDeliveryList_NotCovered(t *testing.T) {
+	mockRepo := new(MockRepository)
+	handler := NewContractHandler(mockRepo, newMockPlans(), newMockHolidays(), newBooker(), newServingPricer(t, -17.7863, -63.1812, 5000), newInvoicer(), new(MockFactory), new(MockUnitOfWork))
+	start := time.Now().AddDate(0, 0, 3)
+
+	cmd := commands.UpdateDeliveryDayListCommand{
+		ContractId: uuid.New(),
+		FirstDate:  start,
+		LastDate:   start.AddDate(0, 0, 4),
+		Street:     "Baker Street",
+		Number:     221,
+		Latitude:   51.5237,
+		Longitude:  -0.1585,
+	}
+
+	resp, err := handler.HandleUpdateDeliveryList(context.Background(), cmd)
+
+	assert.ErrorIs(t, err, pricing.ErrNotCoveredZone)
+	assert.Nil(t, resp)
+	mockRepo.AssertNotCalled(t, "GetById", mock.Anything, mock.Anything)
+}
@@ -15,16 +15,33 @@ func MapToContractDTO(contract *contracts.Contract) *dto.ContractDTO {
 		deliveriesDTO = append(deliveriesDTO, d)
 	}
 
+	var renewedFrom string
+	if contract.RenewedFrom() != nil {
+		renewedFrom = contract.RenewedFrom().String()
+	}
+
+	var slotId string
+	if contract.SlotId() != nil {
+		slotId = contract.SlotId().String()
+	}
+
 	return &dto.ContractDTO{
 		Id:              contract.Id().String(),
 		AdministratorId: contract.AdministratorId().String(),
 		PatientId:       contract.PatientId().String(),
 		ContractType:    contract.ContractType().String(),
 		ContractStatus:  contract.ContractStatus().String(),
+		StatusReason:    contract.StatusReason(),
+		SuspendedAt:     contract.SuspendedAt(),
+		AutoRenew:       contract.AutoRenew(),
+		RenewedFrom:     renewedFrom,
 		CreationDate:    contract.CreationDate(),
 		StartDate:       contract.StartDate(),
 		EndDate:         contract.EndDate(),
-		CostValue:       contract.CostValue(),
+		CostValue:       MapToMoneyDTO(contract.CostValue()),
+		Price:           MapToPriceDTO(contract.Price()),
+		Weekdays:        contract.Weekdays().Weekdays(),
+		SlotId:          slotId,
 		Deliveries:      deliveriesDTO,
 	}
 }
@@ -7,6 +7,7 @@ import (
 	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/administrator"
 	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/contract"
 	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/patient"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/plan"
 	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -20,7 +21,8 @@ func TestMapToContract_DTO_And_Response(t *testing.T) {
 	contractType := contracts.HalfMonth
 	startDate := time.Now().AddDate(0, 0, 3)
 
-	costValue := 1000
+	costValue, err := valueobjects.NewMoney(1000, valueobjects.USD)
+	assert.NoError(t, err)
 	street := "Elm Street"
 	number := 30
 	lat := -40.23
@@ -47,7 +49,7 @@ func TestMapToContract_DTO_And_Response(t *testing.T) {
 	phone, err := valueobjects.NewPhone(&phoneStr)
 	assert.NoError(t, err)
 
-	contract := contracts.NewContract(administratorId, patientId, contractType, startDate, costValue, street, number, coordinates)
+	contract := contracts.NewContract(administratorId, patientId, plans.NewPlan(string(contractType), "Half-month", 15, plans.Daily, 0), startDate, costValue, street, number, coordinates)
 	admin := administrators.NewAdministrator(firstName, lastName, email, password, gender, birth, phone)
 	patient := patients.NewPatient(firstName, lastName, email, password, gender, birth, phone)
 
@@ -67,7 +69,10 @@ func TestMapToContract_DTO_And_Response(t *testing.T) {
 	assert.Equal(t, contract.CreationDate().Format(time.RFC3339), contractDto.CreationDate.Format(time.RFC3339))
 	assert.Equal(t, contract.StartDate().Format(time.RFC3339), contractDto.StartDate.Format(time.RFC3339))
 	assert.Equal(t, contract.EndDate().Format(time.RFC3339), contractDto.EndDate.Format(time.RFC3339))
-	assert.Equal(t, contract.CostValue(), contractDto.CostValue)
+	assert.Equal(t, dto.MoneyDTO{Amount: 1000, Currency: "USD"}, contractDto.CostValue)
+	assert.Nil(t, contractDto.Price)
+	assert.Equal(t, []string{"sunday", "monday", "tuesday", "wednesday", "thursday", "friday", "saturday"}, contractDto.Weekdays)
+	assert.Empty(t, contractDto.SlotId)
 
 	var deliveryDtos []*dto.DeliveryDTO
 	for _, d := range contract.Deliveries() {
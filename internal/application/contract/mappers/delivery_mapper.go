@@ -8,7 +8,7 @@ import (
 
 func MapToDeliveryDTO(delivery *deliveries.Delivery) *dto.DeliveryDTO {
 	c := delivery.Coordinates()
-	return &dto.DeliveryDTO{
+	d := &dto.DeliveryDTO{
 		Id:         delivery.Id().String(),
 		ContractId: delivery.ContractId().String(),
 		Date:       delivery.Date(),
@@ -18,6 +18,16 @@ func MapToDeliveryDTO(delivery *deliveries.Delivery) *dto.DeliveryDTO {
 		Longitude:  c.Longitude(),
 		Status:     delivery.Status().String(),
 	}
+
+	if courierId := delivery.CourierId(); courierId != nil {
+		d.CourierId = courierId.String()
+	}
+
+	if slotId := delivery.SlotId(); slotId != nil {
+		d.SlotId = slotId.String()
+	}
+
+	return d
 }
 
 func MapToDeliveryResposnse(delivery *dto.DeliveryDTO, created, updated time.Time, deleted *time.Time) *dto.DeliveryResponse {
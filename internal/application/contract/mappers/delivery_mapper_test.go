@@ -2,6 +2,7 @@ package mappers
 
 import (
 	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/delivery"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/slot"
 	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -33,6 +34,8 @@ func TestMapToDelivery_DTO_And_Response(t *testing.T) {
 	assert.Equal(t, dtoCoord.Latitude(), dto.Latitude)
 	assert.Equal(t, dtoCoord.Longitude(), dto.Longitude)
 	assert.Equal(t, delivery.Status().String(), dto.Status)
+	assert.Empty(t, dto.CourierId)
+	assert.Empty(t, dto.SlotId)
 
 	response := MapToDeliveryResposnse(dto, delivery.CreatedAt(), delivery.UpdatedAt(), delivery.DeletedAt())
 
@@ -44,3 +47,23 @@ func TestMapToDelivery_DTO_And_Response(t *testing.T) {
 	assert.Equal(t, delivery.DeletedAt(), response.DeletedAt)
 
 }
+
+func TestMapToDeliveryDTO_Courier(t *testing.T) {
+	courierId := uuid.New()
+	delivery := deliveries.NewDelivery(uuid.New(), time.Now(), "Elm Street", 30, valueobjects.Coordinates{})
+	assert.NoError(t, delivery.Assign(courierId))
+
+	dto := MapToDeliveryDTO(delivery)
+
+	assert.Equal(t, courierId.String(), dto.CourierId)
+}
+
+func TestMapToDeliveryDTO_Slot(t *testing.T) {
+	slot := slots.NewSlot(uuid.New(), "Lunch", 11*time.Hour+30*time.Minute, 14*time.Hour, 30)
+	delivery := deliveries.NewDelivery(uuid.New(), time.Now(), "Elm Street", 30, valueobjects.Coordinates{})
+	assert.NoError(t, delivery.Book(slot))
+
+	dto := MapToDeliveryDTO(delivery)
+
+	assert.Equal(t, slot.Id().String(), dto.SlotId)
+}
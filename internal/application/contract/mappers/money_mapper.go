@@ -0,0 +1,13 @@
+package mappers
+
+import (
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/contract/dto"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+)
+
+func MapToMoneyDTO(money valueobjects.Money) dto.MoneyDTO {
+	return dto.MoneyDTO{
+		Amount:   money.Amount(),
+		Currency: string(money.Currency()),
+	}
+}
@@ -0,0 +1,33 @@
+package mappers
+
+import (
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/contract/dto"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/pricing"
+)
+
+func MapToPriceDTO(price *pricing.Breakdown) *dto.PriceDTO {
+	if price == nil {
+		return nil
+	}
+
+	taxes := make([]*dto.TaxDTO, 0, len(price.Taxes()))
+	for _, t := range price.Taxes() {
+		taxes = append(taxes, &dto.TaxDTO{
+			Name:   t.Name(),
+			Rate:   t.Rate(),
+			Amount: MapToMoneyDTO(t.Amount()),
+		})
+	}
+
+	return &dto.PriceDTO{
+		Base:       MapToMoneyDTO(price.Base()),
+		Deliveries: price.Deliveries(),
+		Zone:       price.Zone(),
+		Surcharge:  MapToMoneyDTO(price.Surcharge()),
+		Subtotal:   MapToMoneyDTO(price.Subtotal()),
+		PromoCode:  price.PromoCode(),
+		Discount:   MapToMoneyDTO(price.Discount()),
+		Taxes:      taxes,
+		Total:      MapToMoneyDTO(price.Total()),
+	}
+}
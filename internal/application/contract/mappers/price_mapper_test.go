@@ -0,0 +1,42 @@
+package mappers
+
+import (
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/contract/dto"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/plan"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/pricing"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestMapToPriceDTO(t *testing.T) {
+	plan := plans.NewPlan("H", "Half-month", 15, plans.Daily, 45000)
+	center, err := valueobjects.NewCoordinates(-17.7863, -63.1812)
+	assert.NoError(t, err)
+	surcharge, err := valueobjects.NewMoney(200, valueobjects.BOB)
+	assert.NoError(t, err)
+	zone := pricing.NewZone("Downtown", center, 1000, surcharge)
+	promo := pricing.NewPromoCode("WELCOME10", pricing.Percentage, 1000, valueobjects.BOB, time.Now().AddDate(0, 0, -1), nil, 0)
+	iva, err := pricing.NewTaxRate("IVA", 1300)
+	assert.NoError(t, err)
+
+	price, err := pricing.Price(plan, 15, zone, promo, []pricing.TaxRate{iva}, time.Now())
+	assert.NoError(t, err)
+
+	priceDto := MapToPriceDTO(price)
+
+	assert.Equal(t, &dto.PriceDTO{
+		Base:       dto.MoneyDTO{Amount: 45000, Currency: "BOB"},
+		Deliveries: 15,
+		Zone:       "Downtown",
+		Surcharge:  dto.MoneyDTO{Amount: 3000, Currency: "BOB"},
+		Subtotal:   dto.MoneyDTO{Amount: 48000, Currency: "BOB"},
+		PromoCode:  "WELCOME10",
+		Discount:   dto.MoneyDTO{Amount: 4800, Currency: "BOB"},
+		Taxes:      []*dto.TaxDTO{{Name: "IVA", Rate: 1300, Amount: dto.MoneyDTO{Amount: 5616, Currency: "BOB"}}},
+		Total:      dto.MoneyDTO{Amount: 48816, Currency: "BOB"},
+	}, priceDto)
+
+	assert.Nil(t, MapToPriceDTO(nil))
+}
@@ -0,0 +1,25 @@
+package mappers
+
+import (
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/contract/dto"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/invoice"
+)
+
+func MapToRefundDTO(refund *invoices.CancellationRefund) *dto.RefundDTO {
+	if refund == nil {
+		return nil
+	}
+
+	return &dto.RefundDTO{
+		ContractId: refund.ContractId.String(),
+		Deliveries: refund.Deliveries,
+		Delivered:  refund.Delivered,
+		Pending:    refund.Pending,
+		Cancelled:  refund.Cancelled,
+		UnitPrice:  MapToMoneyDTO(refund.UnitPrice),
+		Consumed:   MapToMoneyDTO(refund.Consumed),
+		Refundable: MapToMoneyDTO(refund.Refundable),
+		Fee:        MapToMoneyDTO(refund.Fee),
+		Total:      MapToMoneyDTO(refund.Total),
+	}
+}
@@ -0,0 +1,48 @@
+package mappers
+
+import (
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/contract/dto"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/invoice"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestMapToRefundDTO(t *testing.T) {
+	money := func(amount int) valueobjects.Money {
+		m, err := valueobjects.NewMoney(amount, valueobjects.BOB)
+		assert.NoError(t, err)
+		return m
+	}
+
+	refund := &invoices.CancellationRefund{
+		ContractId: uuid.New(),
+		Deliveries: 15,
+		Delivered:  5,
+		Pending:    9,
+		Cancelled:  1,
+		UnitPrice:  money(3000),
+		Consumed:   money(15000),
+		Refundable: money(30000),
+		Fee:        money(3000),
+		Total:      money(27000),
+	}
+
+	refundDto := MapToRefundDTO(refund)
+
+	assert.Equal(t, &dto.RefundDTO{
+		ContractId: refund.ContractId.String(),
+		Deliveries: 15,
+		Delivered:  5,
+		Pending:    9,
+		Cancelled:  1,
+		UnitPrice:  dto.MoneyDTO{Amount: 3000, Currency: "BOB"},
+		Consumed:   dto.MoneyDTO{Amount: 15000, Currency: "BOB"},
+		Refundable: dto.MoneyDTO{Amount: 30000, Currency: "BOB"},
+		Fee:        dto.MoneyDTO{Amount: 3000, Currency: "BOB"},
+		Total:      dto.MoneyDTO{Amount: 27000, Currency: "BOB"},
+	}, refundDto)
+
+	assert.Nil(t, MapToRefundDTO(nil))
+}
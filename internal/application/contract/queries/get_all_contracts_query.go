@@ -1,3 +1,17 @@
 package queries
 
-type GetAllContractsQuery struct{}
+import (
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/abstractions"
+	"github.com/google/uuid"
+	"time"
+)
+
+type GetAllContractsQuery struct {
+	Status          string
+	Type            string
+	PatientId       *uuid.UUID
+	AdministratorId *uuid.UUID
+	From            *time.Time
+	To              *time.Time
+	Pagination      abstractions.Pagination
+}
@@ -3,5 +3,6 @@ package queries
 import "github.com/google/uuid"
 
 type GetDeliveryByIdQuery struct {
-	Id uuid.UUID
+	ContractId uuid.UUID
+	Id         uuid.UUID
 }
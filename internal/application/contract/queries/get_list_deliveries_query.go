@@ -1,3 +1,7 @@
 package queries
 
-type GetListDeliveriesQuery struct{}
+import "github.com/google/uuid"
+
+type GetListDeliveriesQuery struct {
+	ContractId uuid.UUID
+}
@@ -0,0 +1,7 @@
+package queries
+
+import "github.com/google/uuid"
+
+type PreviewRefundQuery struct {
+	Id uuid.UUID
+}
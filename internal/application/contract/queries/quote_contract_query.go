@@ -0,0 +1,8 @@
+package queries
+
+type QuoteContractQuery struct {
+	ContractType string
+	Latitude     float64
+	Longitude    float64
+	PromoCode    string
+}
@@ -0,0 +1,8 @@
+package commands
+
+import "github.com/google/uuid"
+
+type AssignDeliveryCommand struct {
+	DeliveryId uuid.UUID
+	CourierId  *uuid.UUID
+}
@@ -0,0 +1,7 @@
+package commands
+
+import "time"
+
+type AutoAssignCommand struct {
+	Date time.Time
+}
@@ -0,0 +1,8 @@
+package commands
+
+type CreateCourierCommand struct {
+	Name          string
+	Phone         *string
+	Weekdays      []string
+	MaxDeliveries int
+}
@@ -0,0 +1,7 @@
+package commands
+
+import "github.com/google/uuid"
+
+type DeleteCourierCommand struct {
+	Id uuid.UUID
+}
@@ -0,0 +1,11 @@
+package commands
+
+import "github.com/google/uuid"
+
+type UpdateCourierCommand struct {
+	Id            uuid.UUID
+	Name          string
+	Phone         *string
+	Weekdays      []string
+	MaxDeliveries int
+}
@@ -0,0 +1,22 @@
+package dto
+
+import (
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/contract/dto"
+	"time"
+)
+
+type CourierDTO struct {
+	Id            string    `json:"id"`
+	Name          string    `json:"name"`
+	Phone         *string   `json:"phone,omitempty"`
+	Weekdays      []string  `json:"weekdays"`
+	MaxDeliveries int       `json:"maxDeliveries"`
+	CreatedAt     time.Time `json:"createdAt"`
+	UpdatedAt     time.Time `json:"updatedAt"`
+}
+
+type AssignmentDTO struct {
+	Date       string             `json:"date"`
+	Assigned   []*dto.DeliveryDTO `json:"assigned"`
+	Unassigned []*dto.DeliveryDTO `json:"unassigned"`
+}
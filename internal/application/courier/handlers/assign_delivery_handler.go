@@ -0,0 +1,65 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/courier/commands"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/delivery"
+	"github.com/google/uuid"
+	"log"
+)
+
+func (h *CourierHandler) HandleAssign(ctx context.Context, cmd commands.AssignDeliveryCommand) (*deliveries.Delivery, error) {
+	var delivery *deliveries.Delivery
+	err := h.uow.Do(ctx, func(ctx context.Context) error {
+		var err error
+		delivery, err = h.contracts.GetDeliveriesById(ctx, cmd.DeliveryId)
+		if err != nil {
+			return err
+		}
+
+		if cmd.CourierId == nil {
+			if err = delivery.Unassign(); err != nil {
+				return err
+			}
+			return h.contracts.AssignDeliveries(ctx, []*deliveries.Delivery{delivery})
+		}
+
+		if current := delivery.CourierId(); current != nil && *current == *cmd.CourierId {
+			return nil
+		}
+
+		courier, err := h.repository.GetById(ctx, *cmd.CourierId)
+		if err != nil {
+			return err
+		}
+
+		if err = h.repository.Lock(ctx, []uuid.UUID{courier.Id()}); err != nil {
+			return err
+		}
+
+		assigned, err := h.repository.CountAssignedOn(ctx, delivery.Date())
+		if err != nil {
+			return err
+		}
+
+		if err = courier.CanTake(delivery.Date(), assigned[courier.Id()]); err != nil {
+			return err
+		}
+
+		if err = delivery.Assign(courier.Id()); err != nil {
+			return err
+		}
+		return h.contracts.AssignDeliveries(ctx, []*deliveries.Delivery{delivery})
+	})
+	if err != nil {
+		log.Printf("[handler:courier][HandleAssign] error assigning delivery '%s': %v", cmd.DeliveryId, err)
+		return nil, err
+	}
+
+	if cmd.CourierId == nil {
+		log.Printf("[handler:courier][HandleAssign] delivery '%s' unassigned", cmd.DeliveryId)
+	} else {
+		log.Printf("[handler:courier][HandleAssign] delivery '%s' assigned to courier '%s'", cmd.DeliveryId, *cmd.CourierId)
+	}
+	return delivery, nil
+}
@@ -0,0 +1,131 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/courier/commands"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/contract"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/courier"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/delivery"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+var monday = time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC)
+
+func newPendingDelivery() *deliveries.Delivery {
+	return deliveries.NewDelivery(uuid.New(), monday, "Sesame Street", 30, valueobjects.Coordinates{})
+}
+
+func TestCourierHandler_HandleAssign(t *testing.T) {
+	mockRepo := new(MockRepository)
+	contractRepo := new(MockContractRepository)
+	uow := new(MockUnitOfWork)
+	handler := NewCourierHandler(mockRepo, contractRepo, couriers.NewCourierFactory(), uow)
+	courier := couriers.NewCourier("Ana", nil, contracts.MondayToFriday, 2)
+	delivery := newPendingDelivery()
+	courierId := courier.Id()
+
+	contractRepo.On("GetDeliveriesById", mock.Anything, delivery.Id()).Return(delivery, nil)
+	mockRepo.On("GetById", mock.Anything, courierId).Return(courier, nil)
+	mockRepo.On("Lock", mock.Anything, []uuid.UUID{courierId}).Return(nil)
+	mockRepo.On("CountAssignedOn", mock.Anything, monday).Return(map[uuid.UUID]int{courierId: 1}, nil)
+	contractRepo.On("AssignDeliveries", mock.Anything, []*deliveries.Delivery{delivery}).Return(nil)
+
+	assigned, err := handler.HandleAssign(context.Background(), commands.AssignDeliveryCommand{DeliveryId: delivery.Id(), CourierId: &courierId})
+
+	assert.NoError(t, err)
+	assert.Equal(t, courierId, *assigned.CourierId())
+	assert.Equal(t, 1, uow.committed)
+	contractRepo.AssertExpectations(t)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestCourierHandler_HandleAssign_Unassign(t *testing.T) {
+	mockRepo := new(MockRepository)
+	contractRepo := new(MockContractRepository)
+	handler := NewCourierHandler(mockRepo, contractRepo, couriers.NewCourierFactory(), new(MockUnitOfWork))
+	delivery := newPendingDelivery()
+	assert.NoError(t, delivery.Assign(uuid.New()))
+
+	contractRepo.On("GetDeliveriesById", mock.Anything, delivery.Id()).Return(delivery, nil)
+	contractRepo.On("AssignDeliveries", mock.Anything, []*deliveries.Delivery{delivery}).Return(nil)
+
+	unassigned, err := handler.HandleAssign(context.Background(), commands.AssignDeliveryCommand{DeliveryId: delivery.Id()})
+
+	assert.NoError(t, err)
+	assert.Nil(t, unassigned.CourierId())
+	mockRepo.AssertNotCalled(t, "GetById", mock.Anything, mock.Anything)
+}
+
+func TestCourierHandler_HandleAssign_Errors(t *testing.T) {
+	courier := couriers.NewCourier("Ana", nil, contracts.NewWeekdayMask(time.Saturday), 2)
+	full := couriers.NewCourier("Luis", nil, contracts.EveryDay, 2)
+
+	cases := []struct {
+		name    string
+		courier *couriers.Courier
+		counts  map[uuid.UUID]int
+		err     error
+	}{
+		{"unavailable", courier, map[uuid.UUID]int{}, couriers.ErrUnavailableCourier},
+		{"full", full, map[uuid.UUID]int{full.Id(): 2}, couriers.ErrFullCourier},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockRepo := new(MockRepository)
+			contractRepo := new(MockContractRepository)
+			uow := new(MockUnitOfWork)
+			handler := NewCourierHandler(mockRepo, contractRepo, couriers.NewCourierFactory(), uow)
+			delivery := newPendingDelivery()
+			courierId := tc.courier.Id()
+
+			contractRepo.On("GetDeliveriesById", mock.Anything, delivery.Id()).Return(delivery, nil)
+			mockRepo.On("GetById", mock.Anything, courierId).Return(tc.courier, nil)
+			mockRepo.On("Lock", mock.Anything, []uuid.UUID{courierId}).Return(nil)
+			mockRepo.On("CountAssignedOn", mock.Anything, monday).Return(tc.counts, nil)
+
+			assigned, err := handler.HandleAssign(context.Background(), commands.AssignDeliveryCommand{DeliveryId: delivery.Id(), CourierId: &courierId})
+
+			assert.Nil(t, assigned)
+			assert.ErrorIs(t, err, tc.err)
+			assert.Nil(t, delivery.CourierId())
+			assert.Equal(t, 1, uow.rolledBack)
+			contractRepo.AssertNotCalled(t, "AssignDeliveries", mock.Anything, mock.Anything)
+		})
+	}
+
+	t.Run("not pending", func(t *testing.T) {
+		contractRepo := new(MockContractRepository)
+		handler := NewCourierHandler(new(MockRepository), contractRepo, couriers.NewCourierFactory(), new(MockUnitOfWork))
+		delivery := newPendingDelivery()
+		assert.NoError(t, delivery.ChangeStatus(deliveries.Delivered))
+
+		contractRepo.On("GetDeliveriesById", mock.Anything, delivery.Id()).Return(delivery, nil)
+
+		assigned, err := handler.HandleAssign(context.Background(), commands.AssignDeliveryCommand{DeliveryId: delivery.Id()})
+
+		assert.Nil(t, assigned)
+		assert.ErrorIs(t, err, deliveries.ErrNotPendingDelivery)
+	})
+
+	t.Run("courier not found", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		contractRepo := new(MockContractRepository)
+		handler := NewCourierHandler(mockRepo, contractRepo, couriers.NewCourierFactory(), new(MockUnitOfWork))
+		delivery := newPendingDelivery()
+		courierId := uuid.New()
+
+		contractRepo.On("GetDeliveriesById", mock.Anything, delivery.Id()).Return(delivery, nil)
+		mockRepo.On("GetById", mock.Anything, courierId).Return(nil, couriers.ErrNotFoundCourier)
+
+		assigned, err := handler.HandleAssign(context.Background(), commands.AssignDeliveryCommand{DeliveryId: delivery.Id(), CourierId: &courierId})
+
+		assert.Nil(t, assigned)
+		assert.ErrorIs(t, err, couriers.ErrNotFoundCourier)
+	})
+}
@@ -0,0 +1,51 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/courier/commands"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/courier"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/delivery"
+	"github.com/google/uuid"
+	"log"
+	"time"
+)
+
+func (h *CourierHandler) HandleAutoAssign(ctx context.Context, cmd commands.AutoAssignCommand) ([]*deliveries.Delivery, []*deliveries.Delivery, error) {
+	var assigned, left []*deliveries.Delivery
+	err := h.uow.Do(ctx, func(ctx context.Context) error {
+		cs, err := h.repository.GetAll(ctx)
+		if err != nil {
+			return err
+		}
+
+		// The deliveries are read once the couriers are locked, so a run
+		// taking place at the same time cannot hand them out twice.
+		ids := make([]uuid.UUID, len(cs))
+		for i, c := range cs {
+			ids[i] = c.Id()
+		}
+		if err = h.repository.Lock(ctx, ids); err != nil {
+			return err
+		}
+
+		dlvrs, err := h.contracts.GetPendingDeliveriesOn(ctx, cmd.Date)
+		if err != nil {
+			return err
+		}
+
+		counts, err := h.repository.CountAssignedOn(ctx, cmd.Date)
+		if err != nil {
+			return err
+		}
+
+		assigned, left = couriers.AutoAssign(cmd.Date, dlvrs, cs, counts)
+		return h.contracts.AssignDeliveries(ctx, assigned)
+	})
+	if err != nil {
+		log.Printf("[handler:courier][HandleAutoAssign] error assigning deliveries on %s: %v", cmd.Date.Format(time.DateOnly), err)
+		return nil, nil, err
+	}
+
+	log.Printf("[handler:courier][HandleAutoAssign] %d deliveries assigned on %s, %d left unassigned", len(assigned), cmd.Date.Format(time.DateOnly), len(left))
+	return assigned, left, nil
+}
@@ -0,0 +1,73 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/courier/commands"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/contract"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/courier"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/delivery"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+)
+
+func TestCourierHandler_HandleAutoAssign(t *testing.T) {
+	mockRepo := new(MockRepository)
+	contractRepo := new(MockContractRepository)
+	uow := new(MockUnitOfWork)
+	handler := NewCourierHandler(mockRepo, contractRepo, couriers.NewCourierFactory(), uow)
+	courier := couriers.NewCourier("Ana", nil, contracts.EveryDay, 3)
+	dlvrs := []*deliveries.Delivery{newPendingDelivery(), newPendingDelivery(), newPendingDelivery()}
+
+	contractRepo.On("GetPendingDeliveriesOn", mock.Anything, monday).Return(dlvrs, nil)
+	mockRepo.On("GetAll", mock.Anything).Return([]*couriers.Courier{courier}, nil)
+	mockRepo.On("Lock", mock.Anything, []uuid.UUID{courier.Id()}).Return(nil)
+	mockRepo.On("CountAssignedOn", mock.Anything, monday).Return(map[uuid.UUID]int{courier.Id(): 1}, nil)
+	contractRepo.On("AssignDeliveries", mock.Anything, dlvrs[:2]).Return(nil)
+
+	assigned, left, err := handler.HandleAutoAssign(context.Background(), commands.AutoAssignCommand{Date: monday})
+
+	assert.NoError(t, err)
+	assert.Equal(t, dlvrs[:2], assigned)
+	assert.Equal(t, dlvrs[2:], left)
+	assert.Equal(t, 1, uow.committed)
+	contractRepo.AssertExpectations(t)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestCourierHandler_HandleAutoAssign_Errors(t *testing.T) {
+	mockRepo := new(MockRepository)
+	contractRepo := new(MockContractRepository)
+	uow := new(MockUnitOfWork)
+	handler := NewCourierHandler(mockRepo, contractRepo, couriers.NewCourierFactory(), uow)
+
+	contractRepo.On("GetPendingDeliveriesOn", mock.Anything, monday).Return([]*deliveries.Delivery{newPendingDelivery()}, nil)
+	mockRepo.On("GetAll", mock.Anything).Return(nil, ErrDbFailureCourier)
+
+	assigned, left, err := handler.HandleAutoAssign(context.Background(), commands.AutoAssignCommand{Date: monday})
+
+	assert.Nil(t, assigned)
+	assert.Nil(t, left)
+	assert.ErrorIs(t, err, ErrDbFailureCourier)
+	assert.Equal(t, 1, uow.rolledBack)
+	contractRepo.AssertNotCalled(t, "AssignDeliveries", mock.Anything, mock.Anything)
+}
+
+func TestCourierHandler_HandleAutoAssign_LockError(t *testing.T) {
+	mockRepo := new(MockRepository)
+	contractRepo := new(MockContractRepository)
+	uow := new(MockUnitOfWork)
+	handler := NewCourierHandler(mockRepo, contractRepo, couriers.NewCourierFactory(), uow)
+	courier := couriers.NewCourier("Ana", nil, contracts.EveryDay, 3)
+
+	mockRepo.On("GetAll", mock.Anything).Return([]*couriers.Courier{courier}, nil)
+	mockRepo.On("Lock", mock.Anything, []uuid.UUID{courier.Id()}).Return(couriers.ErrNotFoundCourier)
+
+	_, _, err := handler.HandleAutoAssign(context.Background(), commands.AutoAssignCommand{Date: monday})
+
+	assert.ErrorIs(t, err, couriers.ErrNotFoundCourier)
+	assert.Equal(t, 1, uow.rolledBack)
+	contractRepo.AssertNotCalled(t, "GetPendingDeliveriesOn", mock.Anything, mock.Anything)
+	contractRepo.AssertNotCalled(t, "AssignDeliveries", mock.Anything, mock.Anything)
+}
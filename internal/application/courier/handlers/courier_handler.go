@@ -0,0 +1,23 @@
+package handlers
+
+import (
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/abstractions"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/contract"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/courier"
+)
+
+type CourierHandler struct {
+	repository couriers.CourierRepository
+	contracts  contracts.ContractRepository
+	factory    couriers.CourierFactory
+	uow        abstractions.UnitOfWork
+}
+
+func NewCourierHandler(r couriers.CourierRepository, c contracts.ContractRepository, f couriers.CourierFactory, u abstractions.UnitOfWork) *CourierHandler {
+	return &CourierHandler{
+		repository: r,
+		contracts:  c,
+		factory:    f,
+		uow:        u,
+	}
+}
@@ -0,0 +1,122 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/contract"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/courier"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/delivery"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+var ErrDbFailureCourier = errors.New("db failure")
+
+type MockRepository struct {
+	mock.Mock
+	couriers.CourierRepository
+}
+
+type MockContractRepository struct {
+	mock.Mock
+	contracts.ContractRepository
+}
+
+type MockUnitOfWork struct {
+	committed  int
+	rolledBack int
+}
+
+func TestNewCourierHandler(t *testing.T) {
+	repo := new(MockRepository)
+	contractRepo := new(MockContractRepository)
+
+	handler := NewCourierHandler(repo, contractRepo, couriers.NewCourierFactory(), new(MockUnitOfWork))
+
+	assert.NotNil(t, handler)
+	assert.Equal(t, repo, handler.repository)
+	assert.Equal(t, contractRepo, handler.contracts)
+}
+
+func (u *MockUnitOfWork) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	if err := fn(ctx); err != nil {
+		u.rolledBack++
+		return err
+	}
+	u.committed++
+	return nil
+}
+
+func (m *MockRepository) GetAll(ctx context.Context) ([]*couriers.Courier, error) {
+	args := m.Called(ctx)
+	if v := args.Get(0); v != nil {
+		return v.([]*couriers.Courier), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockRepository) GetById(ctx context.Context, id uuid.UUID) (*couriers.Courier, error) {
+	args := m.Called(ctx, id)
+	if v := args.Get(0); v != nil {
+		return v.(*couriers.Courier), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockRepository) Lock(ctx context.Context, ids []uuid.UUID) error {
+	args := m.Called(ctx, ids)
+	return args.Error(0)
+}
+
+func (m *MockRepository) CountAssignedOn(ctx context.Context, day time.Time) (map[uuid.UUID]int, error) {
+	args := m.Called(ctx, day)
+	if v := args.Get(0); v != nil {
+		return v.(map[uuid.UUID]int), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockRepository) Create(ctx context.Context, courier *couriers.Courier) (*couriers.Courier, error) {
+	args := m.Called(ctx, courier)
+	if v := args.Get(0); v != nil {
+		return v.(*couriers.Courier), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockRepository) Update(ctx context.Context, courier *couriers.Courier) (*couriers.Courier, error) {
+	args := m.Called(ctx, courier)
+	if v := args.Get(0); v != nil {
+		return v.(*couriers.Courier), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockContractRepository) GetDeliveriesById(ctx context.Context, id uuid.UUID) (*deliveries.Delivery, error) {
+	args := m.Called(ctx, id)
+	if v := args.Get(0); v != nil {
+		return v.(*deliveries.Delivery), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockContractRepository) GetPendingDeliveriesOn(ctx context.Context, day time.Time) ([]*deliveries.Delivery, error) {
+	args := m.Called(ctx, day)
+	if v := args.Get(0); v != nil {
+		return v.([]*deliveries.Delivery), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockContractRepository) AssignDeliveries(ctx context.Context, dlvrs []*deliveries.Delivery) error {
+	args := m.Called(ctx, dlvrs)
+	return args.Error(0)
+}
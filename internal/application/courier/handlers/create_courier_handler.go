@@ -0,0 +1,43 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/courier/commands"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/contract"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/courier"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	"log"
+)
+
+func (h *CourierHandler) HandleCreate(ctx context.Context, cmd commands.CreateCourierCommand) (*couriers.Courier, error) {
+	phone, err := valueobjects.NewPhone(cmd.Phone)
+	if err != nil {
+		log.Printf("[handler:courier][HandleCreate] error creating phone object: %v", err)
+		return nil, err
+	}
+
+	weekdays, err := contracts.ParseWeekdayMask(cmd.Weekdays)
+	if err != nil {
+		log.Printf("[handler:courier][HandleCreate] error parsing weekdays: %v", err)
+		return nil, err
+	}
+
+	courier, err := h.factory.Create(cmd.Name, phone, weekdays, cmd.MaxDeliveries)
+	if err != nil {
+		log.Printf("[handler:courier][HandleCreate] error creating courier factory: %v", err)
+		return nil, err
+	}
+
+	var created *couriers.Courier
+	err = h.uow.Do(ctx, func(ctx context.Context) error {
+		created, err = h.repository.Create(ctx, courier)
+		return err
+	})
+	if err != nil {
+		log.Printf("[handler:courier][HandleCreate] error creating courier '%s': %v", cmd.Name, err)
+		return nil, err
+	}
+
+	log.Printf("[handler:courier][HandleCreate] courier '%s' created", created.Id())
+	return created, nil
+}
@@ -0,0 +1,75 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/courier/commands"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/contract"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/courier"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+func TestCourierHandler_HandleCreate(t *testing.T) {
+	mockRepo := new(MockRepository)
+	uow := new(MockUnitOfWork)
+	handler := NewCourierHandler(mockRepo, new(MockContractRepository), couriers.NewCourierFactory(), uow)
+	phone := "70000000"
+	cmd := commands.CreateCourierCommand{Name: "Ana", Phone: &phone, Weekdays: []string{"mon", "tue"}, MaxDeliveries: 20}
+
+	mockRepo.On("Create", mock.Anything, mock.MatchedBy(func(c *couriers.Courier) bool {
+		return c.Name() == "Ana" && *c.Phone().String() == phone && c.MaxDeliveries() == 20 &&
+			c.Availability() == contracts.NewWeekdayMask(time.Monday, time.Tuesday)
+	})).Return(couriers.NewCourier("Ana", nil, contracts.EveryDay, 20), nil)
+
+	courier, err := handler.HandleCreate(context.Background(), cmd)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Ana", courier.Name())
+	assert.Equal(t, 1, uow.committed)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestCourierHandler_HandleCreate_Errors(t *testing.T) {
+	bad := "phone"
+
+	cases := []struct {
+		name string
+		cmd  commands.CreateCourierCommand
+		err  error
+	}{
+		{"phone", commands.CreateCourierCommand{Name: "Ana", Phone: &bad, MaxDeliveries: 20}, valueobjects.ErrNotNumericPhoneNumber},
+		{"weekdays", commands.CreateCourierCommand{Name: "Ana", Weekdays: []string{"funday"}, MaxDeliveries: 20}, contracts.ErrWeekdaysContract},
+		{"name", commands.CreateCourierCommand{MaxDeliveries: 20}, couriers.ErrEmptyNameCourier},
+		{"capacity", commands.CreateCourierCommand{Name: "Ana"}, couriers.ErrMaxDeliveriesCourier},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockRepo := new(MockRepository)
+			handler := NewCourierHandler(mockRepo, new(MockContractRepository), couriers.NewCourierFactory(), new(MockUnitOfWork))
+
+			courier, err := handler.HandleCreate(context.Background(), tc.cmd)
+
+			assert.Nil(t, courier)
+			assert.ErrorIs(t, err, tc.err)
+			mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+		})
+	}
+
+	t.Run("database", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		uow := new(MockUnitOfWork)
+		handler := NewCourierHandler(mockRepo, new(MockContractRepository), couriers.NewCourierFactory(), uow)
+
+		mockRepo.On("Create", mock.Anything, mock.Anything).Return(nil, ErrDbFailureCourier)
+
+		courier, err := handler.HandleCreate(context.Background(), commands.CreateCourierCommand{Name: "Ana", MaxDeliveries: 20})
+
+		assert.Nil(t, courier)
+		assert.ErrorIs(t, err, ErrDbFailureCourier)
+		assert.Equal(t, 1, uow.rolledBack)
+	})
+}
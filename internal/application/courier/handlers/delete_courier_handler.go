@@ -0,0 +1,20 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/courier/commands"
+	"log"
+)
+
+func (h *CourierHandler) HandleDelete(ctx context.Context, cmd commands.DeleteCourierCommand) error {
+	err := h.uow.Do(ctx, func(ctx context.Context) error {
+		return h.repository.Delete(ctx, cmd.Id)
+	})
+	if err != nil {
+		log.Printf("[handler:courier][HandleDelete] error deleting courier '%s': %v", cmd.Id, err)
+		return err
+	}
+
+	log.Printf("[handler:courier][HandleDelete] courier '%s' deleted", cmd.Id)
+	return nil
+}
@@ -0,0 +1,26 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/courier/commands"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/courier"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+)
+
+func TestCourierHandler_HandleDelete(t *testing.T) {
+	mockRepo := new(MockRepository)
+	uow := new(MockUnitOfWork)
+	handler := NewCourierHandler(mockRepo, new(MockContractRepository), couriers.NewCourierFactory(), uow)
+	id, missing := uuid.New(), uuid.New()
+
+	mockRepo.On("Delete", mock.Anything, id).Return(nil)
+	mockRepo.On("Delete", mock.Anything, missing).Return(couriers.ErrNotFoundCourier)
+
+	assert.NoError(t, handler.HandleDelete(context.Background(), commands.DeleteCourierCommand{Id: id}))
+	assert.ErrorIs(t, handler.HandleDelete(context.Background(), commands.DeleteCourierCommand{Id: missing}), couriers.ErrNotFoundCourier)
+	assert.Equal(t, 1, uow.committed)
+	assert.Equal(t, 1, uow.rolledBack)
+}
@@ -0,0 +1,46 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/courier/commands"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/contract"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/courier"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	"log"
+)
+
+func (h *CourierHandler) HandleUpdate(ctx context.Context, cmd commands.UpdateCourierCommand) (*couriers.Courier, error) {
+	phone, err := valueobjects.NewPhone(cmd.Phone)
+	if err != nil {
+		log.Printf("[handler:courier][HandleUpdate] error creating phone object: %v", err)
+		return nil, err
+	}
+
+	weekdays, err := contracts.ParseWeekdayMask(cmd.Weekdays)
+	if err != nil {
+		log.Printf("[handler:courier][HandleUpdate] error parsing weekdays: %v", err)
+		return nil, err
+	}
+
+	var updated *couriers.Courier
+	err = h.uow.Do(ctx, func(ctx context.Context) error {
+		courier, err := h.repository.GetById(ctx, cmd.Id)
+		if err != nil {
+			return err
+		}
+
+		if err = courier.Update(cmd.Name, phone, weekdays, cmd.MaxDeliveries); err != nil {
+			return err
+		}
+
+		updated, err = h.repository.Update(ctx, courier)
+		return err
+	})
+	if err != nil {
+		log.Printf("[handler:courier][HandleUpdate] error updating courier '%s': %v", cmd.Id, err)
+		return nil, err
+	}
+
+	log.Printf("[handler:courier][HandleUpdate] courier '%s' updated", cmd.Id)
+	return updated, nil
+}
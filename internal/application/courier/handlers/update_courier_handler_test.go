@@ -0,0 +1,74 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/courier/commands"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/contract"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/courier"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+)
+
+func TestCourierHandler_HandleUpdate(t *testing.T) {
+	mockRepo := new(MockRepository)
+	uow := new(MockUnitOfWork)
+	handler := NewCourierHandler(mockRepo, new(MockContractRepository), couriers.NewCourierFactory(), uow)
+	courier := couriers.NewCourier("Ana", nil, contracts.EveryDay, 20)
+
+	mockRepo.On("GetById", mock.Anything, courier.Id()).Return(courier, nil)
+	mockRepo.On("Update", mock.Anything, courier).Return(courier, nil)
+
+	updated, err := handler.HandleUpdate(context.Background(), commands.UpdateCourierCommand{
+		Id: courier.Id(), Name: "Ana Vaca", Weekdays: []string{"saturday", "sunday"}, MaxDeliveries: 12,
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Ana Vaca", updated.Name())
+	assert.Equal(t, []string{"sunday", "saturday"}, updated.Availability().Weekdays())
+	assert.Equal(t, 12, updated.MaxDeliveries())
+	assert.Equal(t, 1, uow.committed)
+}
+
+func TestCourierHandler_HandleUpdate_Errors(t *testing.T) {
+	t.Run("weekdays", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		handler := NewCourierHandler(mockRepo, new(MockContractRepository), couriers.NewCourierFactory(), new(MockUnitOfWork))
+
+		courier, err := handler.HandleUpdate(context.Background(), commands.UpdateCourierCommand{Id: uuid.New(), Name: "Ana", Weekdays: []string{"x"}, MaxDeliveries: 1})
+
+		assert.Nil(t, courier)
+		assert.ErrorIs(t, err, contracts.ErrWeekdaysContract)
+		mockRepo.AssertNotCalled(t, "GetById", mock.Anything, mock.Anything)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		uow := new(MockUnitOfWork)
+		handler := NewCourierHandler(mockRepo, new(MockContractRepository), couriers.NewCourierFactory(), uow)
+		id := uuid.New()
+
+		mockRepo.On("GetById", mock.Anything, id).Return(nil, couriers.ErrNotFoundCourier)
+
+		courier, err := handler.HandleUpdate(context.Background(), commands.UpdateCourierCommand{Id: id, Name: "Ana", MaxDeliveries: 1})
+
+		assert.Nil(t, courier)
+		assert.ErrorIs(t, err, couriers.ErrNotFoundCourier)
+		assert.Equal(t, 1, uow.rolledBack)
+	})
+
+	t.Run("details", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		handler := NewCourierHandler(mockRepo, new(MockContractRepository), couriers.NewCourierFactory(), new(MockUnitOfWork))
+		existing := couriers.NewCourier("Ana", nil, contracts.EveryDay, 20)
+
+		mockRepo.On("GetById", mock.Anything, existing.Id()).Return(existing, nil)
+
+		courier, err := handler.HandleUpdate(context.Background(), commands.UpdateCourierCommand{Id: existing.Id(), Name: "Ana", MaxDeliveries: 0})
+
+		assert.Nil(t, courier)
+		assert.ErrorIs(t, err, couriers.ErrMaxDeliveriesCourier)
+		mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,43 @@
+package mappers
+
+import (
+	contractDto "github.com/carlosclavijo/Nutricenter-Contracting/internal/application/contract/dto"
+	contractMappers "github.com/carlosclavijo/Nutricenter-Contracting/internal/application/contract/mappers"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/courier/dto"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/courier"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/delivery"
+	"time"
+)
+
+func MapToCourierDTO(courier *couriers.Courier) *dto.CourierDTO {
+	var phone *string
+	if courier.Phone() != nil {
+		phone = courier.Phone().String()
+	}
+
+	return &dto.CourierDTO{
+		Id:            courier.Id().String(),
+		Name:          courier.Name(),
+		Phone:         phone,
+		Weekdays:      courier.Availability().Weekdays(),
+		MaxDeliveries: courier.MaxDeliveries(),
+		CreatedAt:     courier.CreatedAt(),
+		UpdatedAt:     courier.UpdatedAt(),
+	}
+}
+
+func MapToAssignmentDTO(date time.Time, assigned, unassigned []*deliveries.Delivery) *dto.AssignmentDTO {
+	return &dto.AssignmentDTO{
+		Date:       date.Format(time.DateOnly),
+		Assigned:   mapDeliveries(assigned),
+		Unassigned: mapDeliveries(unassigned),
+	}
+}
+
+func mapDeliveries(dlvrs []*deliveries.Delivery) []*contractDto.DeliveryDTO {
+	list := make([]*contractDto.DeliveryDTO, len(dlvrs))
+	for i, d := range dlvrs {
+		list[i] = contractMappers.MapToDeliveryDTO(d)
+	}
+	return list
+}
@@ -0,0 +1,48 @@
+package mappers
+
+import (
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/contract"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/courier"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/delivery"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestMapToCourierDTO(t *testing.T) {
+	phone := "70000000"
+	p, _ := valueobjects.NewPhone(&phone)
+	courier := couriers.NewCourier("Ana", p, contracts.NewWeekdayMask(time.Monday, time.Wednesday), 20)
+
+	d := MapToCourierDTO(courier)
+
+	assert.Equal(t, courier.Id().String(), d.Id)
+	assert.Equal(t, "Ana", d.Name)
+	assert.Equal(t, "70000000", *d.Phone)
+	assert.Equal(t, []string{"monday", "wednesday"}, d.Weekdays)
+	assert.Equal(t, 20, d.MaxDeliveries)
+
+	assert.Nil(t, MapToCourierDTO(couriers.NewCourier("Luis", nil, contracts.EveryDay, 5)).Phone)
+}
+
+func TestMapToAssignmentDTO(t *testing.T) {
+	date := time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC)
+	courierId := uuid.New()
+	assigned := deliveries.NewDelivery(uuid.New(), date, "Sesame Street", 30, valueobjects.Coordinates{})
+	assert.NoError(t, assigned.Assign(courierId))
+	left := deliveries.NewDelivery(uuid.New(), date, "Sesame Street", 31, valueobjects.Coordinates{})
+
+	d := MapToAssignmentDTO(date, []*deliveries.Delivery{assigned}, []*deliveries.Delivery{left})
+
+	assert.Equal(t, "2025-03-03", d.Date)
+	assert.Len(t, d.Assigned, 1)
+	assert.Equal(t, courierId.String(), d.Assigned[0].CourierId)
+	assert.Len(t, d.Unassigned, 1)
+	assert.Empty(t, d.Unassigned[0].CourierId)
+
+	empty := MapToAssignmentDTO(date, nil, nil)
+	assert.NotNil(t, empty.Assigned)
+	assert.NotNil(t, empty.Unassigned)
+}
@@ -0,0 +1,23 @@
+package commands
+
+import (
+	"github.com/google/uuid"
+	"io"
+	"time"
+)
+
+type DeliverCommand struct {
+	ContractId uuid.UUID
+	DeliveryId uuid.UUID
+	Recipient  string
+	ReceivedAt time.Time
+	Latitude   float64
+	Longitude  float64
+	Photo      *Image
+	Signature  *Image
+}
+
+type Image struct {
+	ContentType string
+	Content     io.Reader
+}
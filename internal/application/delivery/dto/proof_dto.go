@@ -0,0 +1,17 @@
+package dto
+
+import "time"
+
+type ProofDTO struct {
+	Id           string    `json:"id"`
+	DeliveryId   string    `json:"deliveryId"`
+	Recipient    string    `json:"recipient"`
+	ReceivedAt   time.Time `json:"receivedAt"`
+	Latitude     float64   `json:"latitude"`
+	Longitude    float64   `json:"longitude"`
+	Distance     int       `json:"distance"`
+	Flagged      bool      `json:"flagged"`
+	HasPhoto     bool      `json:"hasPhoto"`
+	HasSignature bool      `json:"hasSignature"`
+	CreatedAt    time.Time `json:"createdAt"`
+}
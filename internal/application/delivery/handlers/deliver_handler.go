@@ -0,0 +1,113 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/delivery/commands"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/delivery"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	"github.com/google/uuid"
+	"log"
+	"time"
+)
+
+var imageExtensions = map[string]string{
+	"image/jpeg": ".jpg",
+	"image/png":  ".png",
+}
+
+func (h *ProofHandler) HandleDeliver(ctx context.Context, cmd commands.DeliverCommand) (*deliveries.Proof, error) {
+	location, err := valueobjects.NewCoordinates(cmd.Latitude, cmd.Longitude)
+	if err != nil {
+		log.Printf("[handler:proof][HandleDeliver] error creating coordinates: %v", err)
+		return nil, err
+	}
+
+	for _, img := range []*commands.Image{cmd.Photo, cmd.Signature} {
+		if img == nil {
+			continue
+		}
+		if _, ok := imageExtensions[img.ContentType]; !ok {
+			log.Printf("[handler:proof][HandleDeliver] image of delivery '%s' is %s", cmd.DeliveryId, img.ContentType)
+			return nil, fmt.Errorf("%w: got %s", deliveries.ErrImageProof, img.ContentType)
+		}
+	}
+
+	receivedAt := cmd.ReceivedAt
+	if receivedAt.IsZero() {
+		receivedAt = time.Now()
+	}
+
+	var (
+		proof  *deliveries.Proof
+		stored []string
+	)
+	err = h.uow.Do(ctx, func(ctx context.Context) error {
+		delivery, err := h.contracts.GetDeliveriesById(ctx, cmd.DeliveryId)
+		if err != nil {
+			return err
+		}
+
+		if delivery.ContractId() != cmd.ContractId {
+			return deliveries.ErrContractMismatchDelivery
+		}
+
+		proof, err = delivery.Deliver(cmd.Recipient, receivedAt, location, h.tolerance)
+		if err != nil {
+			return err
+		}
+
+		if cmd.Photo != nil {
+			key, err := h.store(ctx, delivery.Id(), "photo", cmd.Photo)
+			if err != nil {
+				return err
+			}
+			stored = append(stored, key)
+			proof.AttachPhoto(key)
+		}
+
+		if cmd.Signature != nil {
+			key, err := h.store(ctx, delivery.Id(), "signature", cmd.Signature)
+			if err != nil {
+				return err
+			}
+			stored = append(stored, key)
+			proof.AttachSignature(key)
+		}
+
+		if _, err = h.contracts.ChangeStatusDelivery(ctx, delivery); err != nil {
+			return err
+		}
+
+		proof, err = h.proofs.Create(ctx, proof)
+		return err
+	})
+	if err != nil {
+		log.Printf("[handler:proof][HandleDeliver] error delivering '%s': %v", cmd.DeliveryId, err)
+		h.discard(ctx, stored)
+		return nil, err
+	}
+
+	if proof.Flagged() {
+		log.Printf("[handler:proof][HandleDeliver] delivery '%s' handed off %d metres away from its address", cmd.DeliveryId, proof.Distance())
+	}
+
+	log.Printf("[handler:proof][HandleDeliver] delivery '%s' delivered to '%s'", cmd.DeliveryId, proof.Recipient())
+	return proof, nil
+}
+
+func (h *ProofHandler) store(ctx context.Context, deliveryId uuid.UUID, name string, img *commands.Image) (string, error) {
+	key := fmt.Sprintf("deliveries/%s/%s%s", deliveryId, name, imageExtensions[img.ContentType])
+	if err := h.blobs.Put(ctx, key, img.Content); err != nil {
+		return "", err
+	}
+	return key, nil
+}
+
+func (h *ProofHandler) discard(ctx context.Context, keys []string) {
+	for _, key := range keys {
+		if err := h.blobs.Delete(ctx, key); err != nil {
+			log.Printf("[handler:proof][discard] error removing '%s': %v", key, err)
+		}
+	}
+}
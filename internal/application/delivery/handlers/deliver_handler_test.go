@@ -0,0 +1,195 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/delivery/commands"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/delivery"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"strings"
+	"testing"
+	"time"
+)
+
+func newDelivery() *deliveries.Delivery {
+	address, _ := valueobjects.NewCoordinates(-17.7863, -63.1812)
+	return deliveries.NewDelivery(uuid.New(), time.Now().AddDate(0, 0, 1), "Sesame Street", 30, address)
+}
+
+func deliverCommand(d *deliveries.Delivery) commands.DeliverCommand {
+	return commands.DeliverCommand{
+		ContractId: d.ContractId(),
+		DeliveryId: d.Id(),
+		Recipient:  "Ana",
+		Latitude:   -17.7864,
+		Longitude:  -63.1812,
+		Photo:      &commands.Image{ContentType: "image/jpeg", Content: strings.NewReader("jpeg")},
+		Signature:  &commands.Image{ContentType: "image/png", Content: strings.NewReader("png")},
+	}
+}
+
+func TestProofHandler_HandleDeliver(t *testing.T) {
+	contractRepo := new(MockContractRepository)
+	proofRepo := new(MockProofRepository)
+	blobs := new(MockBlobStore)
+	uow := new(MockUnitOfWork)
+	handler := NewProofHandler(contractRepo, proofRepo, blobs, 150, uow)
+
+	delivery := newDelivery()
+	cmd := deliverCommand(delivery)
+	photoKey := "deliveries/" + delivery.Id().String() + "/photo.jpg"
+	signatureKey := "deliveries/" + delivery.Id().String() + "/signature.png"
+
+	contractRepo.On("GetDeliveriesById", mock.Anything, delivery.Id()).Return(delivery, nil)
+	blobs.On("Put", mock.Anything, photoKey, cmd.Photo.Content).Return(nil)
+	blobs.On("Put", mock.Anything, signatureKey, cmd.Signature.Content).Return(nil)
+	contractRepo.On("ChangeStatusDelivery", mock.Anything, delivery).Return(delivery, nil)
+	proofRepo.On("Create", mock.Anything, mock.MatchedBy(func(p *deliveries.Proof) bool {
+		return p.DeliveryId() == delivery.Id() && *p.PhotoKey() == photoKey && *p.SignatureKey() == signatureKey
+	})).Return(nil, nil).Run(func(args mock.Arguments) {
+		proofRepo.ExpectedCalls[0].ReturnArguments = mock.Arguments{args.Get(1), nil}
+	})
+
+	proof, err := handler.HandleDeliver(context.Background(), cmd)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, proof)
+	assert.Equal(t, "Ana", proof.Recipient())
+	assert.False(t, proof.Flagged())
+	assert.WithinDuration(t, time.Now(), proof.ReceivedAt(), time.Minute)
+	assert.Equal(t, deliveries.Delivered, delivery.Status())
+	assert.Equal(t, 1, uow.committed)
+
+	blobs.AssertExpectations(t)
+	blobs.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+	contractRepo.AssertExpectations(t)
+	proofRepo.AssertExpectations(t)
+}
+
+func TestProofHandler_HandleDeliver_Flagged(t *testing.T) {
+	contractRepo := new(MockContractRepository)
+	proofRepo := new(MockProofRepository)
+	blobs := new(MockBlobStore)
+	uow := new(MockUnitOfWork)
+	handler := NewProofHandler(contractRepo, proofRepo, blobs, 150, uow)
+
+	delivery := newDelivery()
+	cmd := deliverCommand(delivery)
+	cmd.Latitude, cmd.Photo, cmd.Signature = -17.7963, nil, nil
+
+	contractRepo.On("GetDeliveriesById", mock.Anything, delivery.Id()).Return(delivery, nil)
+	contractRepo.On("ChangeStatusDelivery", mock.Anything, delivery).Return(delivery, nil)
+	proofRepo.On("Create", mock.Anything, mock.Anything).Return(nil, nil).Run(func(args mock.Arguments) {
+		proofRepo.ExpectedCalls[0].ReturnArguments = mock.Arguments{args.Get(1), nil}
+	})
+
+	proof, err := handler.HandleDeliver(context.Background(), cmd)
+
+	assert.NoError(t, err)
+	assert.True(t, proof.Flagged())
+	assert.Greater(t, proof.Distance(), 150)
+	assert.Nil(t, proof.PhotoKey())
+	assert.Nil(t, proof.SignatureKey())
+	blobs.AssertNotCalled(t, "Put", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestProofHandler_HandleDeliver_Err(t *testing.T) {
+	cases := []struct {
+		name    string
+		mutate  func(cmd *commands.DeliverCommand)
+		setup   func(d *deliveries.Delivery, c *MockContractRepository, p *MockProofRepository, b *MockBlobStore)
+		err     error
+		deleted []string
+	}{
+		{
+			name:   "Invalid Coordinates",
+			mutate: func(cmd *commands.DeliverCommand) { cmd.Latitude = 91 },
+			setup:  func(*deliveries.Delivery, *MockContractRepository, *MockProofRepository, *MockBlobStore) {},
+			err:    valueobjects.ErrOutOfBoundariesLatitude,
+		},
+		{
+			name:   "Invalid Image",
+			mutate: func(cmd *commands.DeliverCommand) { cmd.Photo.ContentType = "image/gif" },
+			setup:  func(*deliveries.Delivery, *MockContractRepository, *MockProofRepository, *MockBlobStore) {},
+			err:    deliveries.ErrImageProof,
+		},
+		{
+			name:   "Delivery Not Found",
+			mutate: func(*commands.DeliverCommand) {},
+			setup: func(d *deliveries.Delivery, c *MockContractRepository, _ *MockProofRepository, _ *MockBlobStore) {
+				c.On("GetDeliveriesById", mock.Anything, d.Id()).Return(nil, deliveries.ErrNotFoundDelivery)
+			},
+			err: deliveries.ErrNotFoundDelivery,
+		},
+		{
+			name:   "Contract Mismatch",
+			mutate: func(cmd *commands.DeliverCommand) { cmd.ContractId = uuid.New() },
+			setup: func(d *deliveries.Delivery, c *MockContractRepository, _ *MockProofRepository, _ *MockBlobStore) {
+				c.On("GetDeliveriesById", mock.Anything, d.Id()).Return(d, nil)
+			},
+			err: deliveries.ErrContractMismatchDelivery,
+		},
+		{
+			name:   "Empty Recipient",
+			mutate: func(cmd *commands.DeliverCommand) { cmd.Recipient = "" },
+			setup: func(d *deliveries.Delivery, c *MockContractRepository, _ *MockProofRepository, _ *MockBlobStore) {
+				c.On("GetDeliveriesById", mock.Anything, d.Id()).Return(d, nil)
+			},
+			err: deliveries.ErrEmptyRecipientProof,
+		},
+		{
+			name:   "Signature Upload Failure",
+			mutate: func(*commands.DeliverCommand) {},
+			setup: func(d *deliveries.Delivery, c *MockContractRepository, _ *MockProofRepository, b *MockBlobStore) {
+				c.On("GetDeliveriesById", mock.Anything, d.Id()).Return(d, nil)
+				b.On("Put", mock.Anything, "deliveries/"+d.Id().String()+"/photo.jpg", mock.Anything).Return(nil)
+				b.On("Put", mock.Anything, "deliveries/"+d.Id().String()+"/signature.png", mock.Anything).Return(ErrDbFailureProof)
+				b.On("Delete", mock.Anything, mock.Anything).Return(nil)
+			},
+			err:     ErrDbFailureProof,
+			deleted: []string{"photo.jpg"},
+		},
+		{
+			name:   "Proof Not Saved",
+			mutate: func(*commands.DeliverCommand) {},
+			setup: func(d *deliveries.Delivery, c *MockContractRepository, p *MockProofRepository, b *MockBlobStore) {
+				c.On("GetDeliveriesById", mock.Anything, d.Id()).Return(d, nil)
+				c.On("ChangeStatusDelivery", mock.Anything, d).Return(d, nil)
+				p.On("Create", mock.Anything, mock.Anything).Return(nil, ErrDbFailureProof)
+				b.On("Put", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				b.On("Delete", mock.Anything, mock.Anything).Return(nil)
+			},
+			err:     ErrDbFailureProof,
+			deleted: []string{"photo.jpg", "signature.png"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			contractRepo := new(MockContractRepository)
+			proofRepo := new(MockProofRepository)
+			blobs := new(MockBlobStore)
+			uow := new(MockUnitOfWork)
+			handler := NewProofHandler(contractRepo, proofRepo, blobs, 150, uow)
+
+			delivery := newDelivery()
+			cmd := deliverCommand(delivery)
+			tc.mutate(&cmd)
+			tc.setup(delivery, contractRepo, proofRepo, blobs)
+
+			proof, err := handler.HandleDeliver(context.Background(), cmd)
+
+			assert.Nil(t, proof)
+			assert.ErrorIs(t, err, tc.err)
+			assert.Equal(t, 0, uow.committed)
+			for _, name := range tc.deleted {
+				blobs.AssertCalled(t, "Delete", mock.Anything, "deliveries/"+delivery.Id().String()+"/"+name)
+			}
+			if len(tc.deleted) == 0 {
+				blobs.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+			}
+		})
+	}
+}
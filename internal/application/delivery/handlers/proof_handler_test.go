@@ -0,0 +1,107 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/contract"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/delivery"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"io"
+	"testing"
+)
+
+var ErrDbFailureProof = errors.New("db failure")
+
+type MockContractRepository struct {
+	mock.Mock
+	contracts.ContractRepository
+}
+
+type MockProofRepository struct {
+	mock.Mock
+	deliveries.ProofRepository
+}
+
+type MockBlobStore struct {
+	mock.Mock
+}
+
+type MockUnitOfWork struct {
+	committed  int
+	rolledBack int
+}
+
+func TestNewProofHandler(t *testing.T) {
+	contractRepo := new(MockContractRepository)
+	proofRepo := new(MockProofRepository)
+	blobs := new(MockBlobStore)
+
+	handler := NewProofHandler(contractRepo, proofRepo, blobs, 150, new(MockUnitOfWork))
+
+	assert.NotNil(t, handler)
+	assert.Equal(t, contractRepo, handler.contracts)
+	assert.Equal(t, proofRepo, handler.proofs)
+	assert.Equal(t, blobs, handler.blobs)
+	assert.Equal(t, 150, handler.tolerance)
+}
+
+func (u *MockUnitOfWork) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	if err := fn(ctx); err != nil {
+		u.rolledBack++
+		return err
+	}
+	u.committed++
+	return nil
+}
+
+func (m *MockContractRepository) GetDeliveriesById(ctx context.Context, id uuid.UUID) (*deliveries.Delivery, error) {
+	args := m.Called(ctx, id)
+	if v := args.Get(0); v != nil {
+		return v.(*deliveries.Delivery), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockContractRepository) ChangeStatusDelivery(ctx context.Context, delivery *deliveries.Delivery) (*deliveries.Delivery, error) {
+	args := m.Called(ctx, delivery)
+	if v := args.Get(0); v != nil {
+		return v.(*deliveries.Delivery), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockProofRepository) GetByDelivery(ctx context.Context, deliveryId uuid.UUID) (*deliveries.Proof, error) {
+	args := m.Called(ctx, deliveryId)
+	if v := args.Get(0); v != nil {
+		return v.(*deliveries.Proof), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockProofRepository) Create(ctx context.Context, proof *deliveries.Proof) (*deliveries.Proof, error) {
+	args := m.Called(ctx, proof)
+	if v := args.Get(0); v != nil {
+		return v.(*deliveries.Proof), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockBlobStore) Put(ctx context.Context, key string, r io.Reader) error {
+	args := m.Called(ctx, key, r)
+	return args.Error(0)
+}
+
+func (m *MockBlobStore) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	args := m.Called(ctx, key)
+	if v := args.Get(0); v != nil {
+		return v.(io.ReadCloser), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockBlobStore) Delete(ctx context.Context, key string) error {
+	args := m.Called(ctx, key)
+	return args.Error(0)
+}
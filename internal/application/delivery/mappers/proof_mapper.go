@@ -0,0 +1,23 @@
+package mappers
+
+import (
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/delivery/dto"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/delivery"
+)
+
+func MapToProofDTO(proof *deliveries.Proof) *dto.ProofDTO {
+	location := proof.Location()
+	return &dto.ProofDTO{
+		Id:           proof.Id().String(),
+		DeliveryId:   proof.DeliveryId().String(),
+		Recipient:    proof.Recipient(),
+		ReceivedAt:   proof.ReceivedAt(),
+		Latitude:     location.Latitude(),
+		Longitude:    location.Longitude(),
+		Distance:     proof.Distance(),
+		Flagged:      proof.Flagged(),
+		HasPhoto:     proof.PhotoKey() != nil,
+		HasSignature: proof.SignatureKey() != nil,
+		CreatedAt:    proof.CreatedAt(),
+	}
+}
@@ -0,0 +1,29 @@
+package mappers
+
+import (
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/delivery"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestMapToProofDTO(t *testing.T) {
+	id, deliveryId, now := uuid.New(), uuid.New(), time.Now()
+	key := "deliveries/signature.png"
+	proof, err := deliveries.NewProofFromDb(id, deliveryId, "Ana", now, -17.7863, -63.1812, 420, true, nil, &key, now)
+	assert.NoError(t, err)
+
+	d := MapToProofDTO(proof)
+
+	assert.Equal(t, id.String(), d.Id)
+	assert.Equal(t, deliveryId.String(), d.DeliveryId)
+	assert.Equal(t, "Ana", d.Recipient)
+	assert.Equal(t, now, d.ReceivedAt)
+	assert.Equal(t, -17.7863, d.Latitude)
+	assert.Equal(t, -63.1812, d.Longitude)
+	assert.Equal(t, 420, d.Distance)
+	assert.True(t, d.Flagged)
+	assert.False(t, d.HasPhoto)
+	assert.True(t, d.HasSignature)
+}
@@ -0,0 +1,14 @@
+package queries
+
+import "github.com/google/uuid"
+
+const (
+	PhotoImage     = "photo"
+	SignatureImage = "signature"
+)
+
+type GetProofImageQuery struct {
+	ContractId uuid.UUID
+	DeliveryId uuid.UUID
+	Image      string
+}
@@ -0,0 +1,8 @@
+package queries
+
+import "github.com/google/uuid"
+
+type GetProofQuery struct {
+	ContractId uuid.UUID
+	DeliveryId uuid.UUID
+}
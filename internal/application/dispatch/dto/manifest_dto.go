@@ -0,0 +1,31 @@
+package dto
+
+type ManifestDTO struct {
+	Driver   int         `json:"driver"`
+	Date     string      `json:"date"`
+	Stops    int         `json:"stops"`
+	Distance int         `json:"distance"`
+	Routes   []*RouteDTO `json:"routes"`
+}
+
+type RouteDTO struct {
+	Trip     int        `json:"trip"`
+	Zone     string     `json:"zone,omitempty"`
+	SlotId   string     `json:"slotId,omitempty"`
+	Slot     string     `json:"slot,omitempty"`
+	Start    string     `json:"start,omitempty"`
+	End      string     `json:"end,omitempty"`
+	Distance int        `json:"distance"`
+	Stops    []*StopDTO `json:"stops"`
+}
+
+type StopDTO struct {
+	Sequence   int     `json:"sequence"`
+	DeliveryId string  `json:"deliveryId"`
+	ContractId string  `json:"contractId"`
+	Street     string  `json:"street"`
+	Number     int     `json:"number"`
+	Latitude   float64 `json:"latitude"`
+	Longitude  float64 `json:"longitude"`
+	Distance   int     `json:"distance"`
+}
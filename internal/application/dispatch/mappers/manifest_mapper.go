@@ -0,0 +1,68 @@
+package mappers
+
+import (
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/dispatch/dto"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/route"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/slot"
+	"math"
+	"time"
+)
+
+func MapToManifestDTO(manifest *routes.Manifest) *dto.ManifestDTO {
+	routesDTO := make([]*dto.RouteDTO, 0, len(manifest.Routes))
+	for _, r := range manifest.Routes {
+		routesDTO = append(routesDTO, MapToRouteDTO(r))
+	}
+
+	return &dto.ManifestDTO{
+		Driver:   manifest.Driver,
+		Date:     manifest.Date.Format(time.DateOnly),
+		Stops:    manifest.Stops(),
+		Distance: metres(manifest.Distance()),
+		Routes:   routesDTO,
+	}
+}
+
+func MapToManifestsDTO(manifests []*routes.Manifest) []*dto.ManifestDTO {
+	manifestsDTO := make([]*dto.ManifestDTO, 0, len(manifests))
+	for _, m := range manifests {
+		manifestsDTO = append(manifestsDTO, MapToManifestDTO(m))
+	}
+	return manifestsDTO
+}
+
+func MapToRouteDTO(route *routes.Route) *dto.RouteDTO {
+	stops := make([]*dto.StopDTO, 0, len(route.Stops))
+	for _, s := range route.Stops {
+		stops = append(stops, &dto.StopDTO{
+			Sequence:   s.Sequence,
+			DeliveryId: s.Delivery.Id().String(),
+			ContractId: s.Delivery.ContractId().String(),
+			Street:     s.Delivery.Street(),
+			Number:     s.Delivery.Number(),
+			Latitude:   s.Delivery.Coordinates().Latitude(),
+			Longitude:  s.Delivery.Coordinates().Longitude(),
+			Distance:   metres(s.Distance),
+		})
+	}
+
+	r := &dto.RouteDTO{
+		Trip:     route.Trip,
+		Zone:     route.Zone,
+		Distance: metres(route.Distance),
+		Stops:    stops,
+	}
+
+	if s := route.Slot; s != nil {
+		r.SlotId = s.Id().String()
+		r.Slot = s.Name()
+		r.Start = slots.FormatClock(s.Start())
+		r.End = slots.FormatClock(s.End())
+	}
+
+	return r
+}
+
+func metres(distance float64) int {
+	return int(math.Round(distance))
+}
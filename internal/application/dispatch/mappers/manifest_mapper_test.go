@@ -0,0 +1,70 @@
+package mappers
+
+import (
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/dispatch/dto"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/delivery"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/route"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/slot"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestMapToManifestDTO(t *testing.T) {
+	day := time.Date(2025, 10, 20, 0, 0, 0, 0, time.UTC)
+	delivery, err := deliveries.NewDeliveryFromDB(uuid.New(), uuid.New(), day, "Sesame Street", 30, -17.7850, -63.1800, "P", nil, nil, day, day, nil)
+	assert.NoError(t, err)
+	manifest := &routes.Manifest{Driver: 2, Date: day, Routes: []*routes.Route{
+		{Trip: 1, Zone: "Downtown", Distance: 402.6, Stops: []routes.Stop{{Sequence: 1, Delivery: delivery, Distance: 201.3}}},
+	}}
+
+	manifestDto := MapToManifestDTO(manifest)
+
+	assert.Equal(t, &dto.ManifestDTO{
+		Driver:   2,
+		Date:     "2025-10-20",
+		Stops:    1,
+		Distance: 403,
+		Routes: []*dto.RouteDTO{{
+			Trip:     1,
+			Zone:     "Downtown",
+			Distance: 403,
+			Stops: []*dto.StopDTO{{
+				Sequence:   1,
+				DeliveryId: delivery.Id().String(),
+				ContractId: delivery.ContractId().String(),
+				Street:     "Sesame Street",
+				Number:     30,
+				Latitude:   -17.7850,
+				Longitude:  -63.1800,
+				Distance:   201,
+			}},
+		}},
+	}, manifestDto)
+}
+
+func TestMapToManifestsDTO(t *testing.T) {
+	day := time.Date(2025, 10, 20, 0, 0, 0, 0, time.UTC)
+	manifests := []*routes.Manifest{{Driver: 1, Date: day, Routes: []*routes.Route{}}, {Driver: 2, Date: day, Routes: []*routes.Route{}}}
+
+	manifestsDto := MapToManifestsDTO(manifests)
+
+	assert.Len(t, manifestsDto, 2)
+	assert.Equal(t, 1, manifestsDto[0].Driver)
+	assert.Equal(t, 2, manifestsDto[1].Driver)
+	assert.Empty(t, manifestsDto[1].Routes)
+	assert.NotNil(t, MapToManifestsDTO(nil))
+}
+
+func TestMapToRouteDTO_Slot(t *testing.T) {
+	slot := slots.NewSlot(uuid.New(), "Lunch", 11*time.Hour+30*time.Minute, 14*time.Hour, 30)
+	route := &routes.Route{Trip: 1, Zone: "Downtown", Slot: slot, Stops: []routes.Stop{}}
+
+	routeDto := MapToRouteDTO(route)
+
+	assert.Equal(t, slot.Id().String(), routeDto.SlotId)
+	assert.Equal(t, "Lunch", routeDto.Slot)
+	assert.Equal(t, "11:30", routeDto.Start)
+	assert.Equal(t, "14:00", routeDto.End)
+}
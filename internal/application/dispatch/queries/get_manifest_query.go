@@ -0,0 +1,10 @@
+package queries
+
+import "time"
+
+type GetManifestQuery struct {
+	Date     time.Time
+	Drivers  int
+	Capacity int
+	Driver   int
+}
@@ -0,0 +1,9 @@
+package queries
+
+import "time"
+
+type GetManifestsQuery struct {
+	Date     time.Time
+	Drivers  int
+	Capacity int
+}
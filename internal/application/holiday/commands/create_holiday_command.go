@@ -0,0 +1,13 @@
+package commands
+
+import "time"
+
+type CreateHolidayCommand struct {
+	Date      time.Time
+	Name      string
+	AreaName  string
+	Latitude  float64
+	Longitude float64
+	Radius    int
+	Policy    string
+}
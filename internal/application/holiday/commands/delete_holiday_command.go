@@ -0,0 +1,7 @@
+package commands
+
+import "github.com/google/uuid"
+
+type DeleteHolidayCommand struct {
+	Id uuid.UUID
+}
@@ -0,0 +1,20 @@
+package dto
+
+import "time"
+
+type HolidayDTO struct {
+	Id        string    `json:"id"`
+	Date      string    `json:"date"`
+	Name      string    `json:"name"`
+	Area      *AreaDTO  `json:"area,omitempty"`
+	Policy    string    `json:"policy"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+type AreaDTO struct {
+	Name      string  `json:"name"`
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+	Radius    int     `json:"radius"`
+}
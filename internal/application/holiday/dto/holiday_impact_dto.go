@@ -0,0 +1,14 @@
+package dto
+
+import "github.com/carlosclavijo/Nutricenter-Contracting/internal/application/contract/dto"
+
+type HolidayImpactDTO struct {
+	Holiday    *HolidayDTO            `json:"holiday"`
+	Deliveries int                    `json:"deliveries"`
+	Contracts  []*ImpactedContractDTO `json:"contracts"`
+}
+
+type ImpactedContractDTO struct {
+	ContractId string             `json:"contractId"`
+	Deliveries []*dto.DeliveryDTO `json:"deliveries"`
+}
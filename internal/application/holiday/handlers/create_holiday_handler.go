@@ -0,0 +1,50 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/holiday/commands"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/holiday"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	"log"
+)
+
+func (h *HolidayHandler) HandleCreate(ctx context.Context, cmd commands.CreateHolidayCommand) (*holidays.Holiday, error) {
+	policy, err := holidays.ParsePolicy(cmd.Policy)
+	if err != nil {
+		log.Printf("[handler:holiday][HandleCreate] error parsing policy: %v", err)
+		return nil, err
+	}
+
+	var area *holidays.Area
+	if cmd.AreaName != "" {
+		center, err := valueobjects.NewCoordinates(cmd.Latitude, cmd.Longitude)
+		if err != nil {
+			log.Printf("[handler:holiday][HandleCreate] error creating coordinates: %v", err)
+			return nil, err
+		}
+
+		if area, err = holidays.NewArea(cmd.AreaName, center, cmd.Radius); err != nil {
+			log.Printf("[handler:holiday][HandleCreate] error creating area: %v", err)
+			return nil, err
+		}
+	}
+
+	holiday, err := h.factory.Create(cmd.Date, cmd.Name, area, policy)
+	if err != nil {
+		log.Printf("[handler:holiday][HandleCreate] error creating holiday factory: %v", err)
+		return nil, err
+	}
+
+	var created *holidays.Holiday
+	err = h.uow.Do(ctx, func(ctx context.Context) error {
+		created, err = h.repository.Create(ctx, holiday)
+		return err
+	})
+	if err != nil {
+		log.Printf("[handler:holiday][HandleCreate] error creating holiday '%s': %v", cmd.Name, err)
+		return nil, err
+	}
+
+	log.Printf("[handler:holiday][HandleCreate] holiday '%s' created", cmd.Name)
+	return created, nil
+}
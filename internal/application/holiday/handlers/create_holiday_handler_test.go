@@ -0,0 +1,88 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/holiday/commands"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/holiday"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+func TestHolidayHandler_HandleCreate(t *testing.T) {
+	mockRepo := new(MockRepository)
+	uow := new(MockUnitOfWork)
+	handler := NewHolidayHandler(mockRepo, holidays.NewHolidayFactory(), uow)
+	date := time.Date(2025, 9, 24, 0, 0, 0, 0, time.UTC)
+	cmd := commands.CreateHolidayCommand{
+		Date:      date,
+		Name:      "Anniversary",
+		AreaName:  "Santa Cruz",
+		Latitude:  -17.7863,
+		Longitude: -63.1812,
+		Radius:    5000,
+		Policy:    "refuse",
+	}
+
+	center, err := valueobjects.NewCoordinates(cmd.Latitude, cmd.Longitude)
+	assert.NoError(t, err)
+	area, err := holidays.NewArea("Santa Cruz", center, 5000)
+	assert.NoError(t, err)
+	created := holidays.NewHoliday(date, "Anniversary", area, holidays.Refuse)
+
+	mockRepo.On("Create", mock.Anything, mock.MatchedBy(func(h *holidays.Holiday) bool {
+		return h.Date().Equal(date) && h.Policy() == holidays.Refuse && h.Area() != nil && h.Area().Radius() == 5000
+	})).Return(created, nil)
+
+	holiday, err := handler.HandleCreate(context.Background(), cmd)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Anniversary", holiday.Name())
+	assert.Equal(t, "Santa Cruz", holiday.Area().Name())
+	assert.Equal(t, 1, uow.committed)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestHolidayHandler_HandleCreate_Errors(t *testing.T) {
+	date := time.Date(2025, 8, 6, 0, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		name string
+		cmd  commands.CreateHolidayCommand
+		err  error
+	}{
+		{"policy", commands.CreateHolidayCommand{Date: date, Name: "Independence Day", Policy: "skip"}, holidays.ErrPolicyHoliday},
+		{"coordinates", commands.CreateHolidayCommand{Date: date, Name: "Anniversary", AreaName: "Santa Cruz", Latitude: 91, Radius: 5000}, valueobjects.ErrOutOfBoundariesLatitude},
+		{"radius", commands.CreateHolidayCommand{Date: date, Name: "Anniversary", AreaName: "Santa Cruz", Latitude: -17.7863, Longitude: -63.1812}, holidays.ErrRadiusHoliday},
+		{"name", commands.CreateHolidayCommand{Date: date}, holidays.ErrEmptyNameHoliday},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockRepo := new(MockRepository)
+			handler := NewHolidayHandler(mockRepo, holidays.NewHolidayFactory(), new(MockUnitOfWork))
+
+			holiday, err := handler.HandleCreate(context.Background(), tc.cmd)
+
+			assert.Nil(t, holiday)
+			assert.ErrorIs(t, err, tc.err)
+			mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+		})
+	}
+
+	t.Run("database", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		uow := new(MockUnitOfWork)
+		handler := NewHolidayHandler(mockRepo, holidays.NewHolidayFactory(), uow)
+
+		mockRepo.On("Create", mock.Anything, mock.Anything).Return((*holidays.Holiday)(nil), ErrDbFailureHoliday)
+
+		holiday, err := handler.HandleCreate(context.Background(), commands.CreateHolidayCommand{Date: date, Name: "Independence Day"})
+
+		assert.Nil(t, holiday)
+		assert.ErrorIs(t, err, ErrDbFailureHoliday)
+		assert.Equal(t, 1, uow.rolledBack)
+	})
+}
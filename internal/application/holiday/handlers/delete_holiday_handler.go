@@ -0,0 +1,20 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/holiday/commands"
+	"log"
+)
+
+func (h *HolidayHandler) HandleDelete(ctx context.Context, cmd commands.DeleteHolidayCommand) error {
+	err := h.uow.Do(ctx, func(ctx context.Context) error {
+		return h.repository.Delete(ctx, cmd.Id)
+	})
+	if err != nil {
+		log.Printf("[handler:holiday][HandleDelete] error deleting holiday '%s': %v", cmd.Id, err)
+		return err
+	}
+
+	log.Printf("[handler:holiday][HandleDelete] holiday '%s' deleted", cmd.Id)
+	return nil
+}
@@ -0,0 +1,26 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/holiday/commands"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/holiday"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+)
+
+func TestHolidayHandler_HandleDelete(t *testing.T) {
+	mockRepo := new(MockRepository)
+	uow := new(MockUnitOfWork)
+	handler := NewHolidayHandler(mockRepo, holidays.NewHolidayFactory(), uow)
+	id, missing := uuid.New(), uuid.New()
+
+	mockRepo.On("Delete", mock.Anything, id).Return(nil)
+	mockRepo.On("Delete", mock.Anything, missing).Return(holidays.ErrNotFoundHoliday)
+
+	assert.NoError(t, handler.HandleDelete(context.Background(), commands.DeleteHolidayCommand{Id: id}))
+	assert.ErrorIs(t, handler.HandleDelete(context.Background(), commands.DeleteHolidayCommand{Id: missing}), holidays.ErrNotFoundHoliday)
+	assert.Equal(t, 1, uow.committed)
+	assert.Equal(t, 1, uow.rolledBack)
+}
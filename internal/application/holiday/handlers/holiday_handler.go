@@ -0,0 +1,20 @@
+package handlers
+
+import (
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/abstractions"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/holiday"
+)
+
+type HolidayHandler struct {
+	repository holidays.HolidayRepository
+	factory    holidays.HolidayFactory
+	uow        abstractions.UnitOfWork
+}
+
+func NewHolidayHandler(r holidays.HolidayRepository, f holidays.HolidayFactory, u abstractions.UnitOfWork) *HolidayHandler {
+	return &HolidayHandler{
+		repository: r,
+		factory:    f,
+		uow:        u,
+	}
+}
@@ -0,0 +1,54 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/holiday"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+)
+
+var ErrDbFailureHoliday = errors.New("db failure")
+
+type MockRepository struct {
+	mock.Mock
+	holidays.HolidayRepository
+}
+
+type MockUnitOfWork struct {
+	committed  int
+	rolledBack int
+}
+
+func TestNewHolidayHandler(t *testing.T) {
+	repo := new(MockRepository)
+
+	handler := NewHolidayHandler(repo, holidays.NewHolidayFactory(), new(MockUnitOfWork))
+
+	assert.NotNil(t, handler)
+	assert.Equal(t, repo, handler.repository)
+}
+
+func (u *MockUnitOfWork) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	if err := fn(ctx); err != nil {
+		u.rolledBack++
+		return err
+	}
+	u.committed++
+	return nil
+}
+
+func (m *MockRepository) Create(ctx context.Context, holiday *holidays.Holiday) (*holidays.Holiday, error) {
+	args := m.Called(ctx, holiday)
+	if v := args.Get(0); v != nil {
+		return v.(*holidays.Holiday), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
@@ -0,0 +1,54 @@
+package mappers
+
+import (
+	contractDto "github.com/carlosclavijo/Nutricenter-Contracting/internal/application/contract/dto"
+	contractMappers "github.com/carlosclavijo/Nutricenter-Contracting/internal/application/contract/mappers"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/holiday/dto"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/delivery"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/holiday"
+	"time"
+)
+
+func MapToHolidayDTO(holiday *holidays.Holiday) *dto.HolidayDTO {
+	d := &dto.HolidayDTO{
+		Id:        holiday.Id().String(),
+		Date:      holiday.Date().Format(time.DateOnly),
+		Name:      holiday.Name(),
+		Policy:    holiday.Policy().String(),
+		CreatedAt: holiday.CreatedAt(),
+		UpdatedAt: holiday.UpdatedAt(),
+	}
+
+	if a := holiday.Area(); a != nil {
+		d.Area = &dto.AreaDTO{
+			Name:      a.Name(),
+			Latitude:  a.Center().Latitude(),
+			Longitude: a.Center().Longitude(),
+			Radius:    a.Radius(),
+		}
+	}
+
+	return d
+}
+
+func MapToHolidayImpactDTO(holiday *holidays.Holiday, impacted []*deliveries.Delivery) *dto.HolidayImpactDTO {
+	report := &dto.HolidayImpactDTO{
+		Holiday:    MapToHolidayDTO(holiday),
+		Deliveries: len(impacted),
+		Contracts:  []*dto.ImpactedContractDTO{},
+	}
+
+	byContract := make(map[string]*dto.ImpactedContractDTO)
+	for _, d := range impacted {
+		id := d.ContractId().String()
+		c, ok := byContract[id]
+		if !ok {
+			c = &dto.ImpactedContractDTO{ContractId: id, Deliveries: []*contractDto.DeliveryDTO{}}
+			byContract[id] = c
+			report.Contracts = append(report.Contracts, c)
+		}
+		c.Deliveries = append(c.Deliveries, contractMappers.MapToDeliveryDTO(d))
+	}
+
+	return report
+}
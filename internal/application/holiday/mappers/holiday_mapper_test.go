@@ -0,0 +1,60 @@
+package mappers
+
+import (
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/delivery"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/holiday"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestMapToHolidayDTO(t *testing.T) {
+	date := time.Date(2025, 9, 24, 0, 0, 0, 0, time.UTC)
+	center, err := valueobjects.NewCoordinates(-17.7863, -63.1812)
+	assert.NoError(t, err)
+	area, err := holidays.NewArea("Santa Cruz", center, 5000)
+	assert.NoError(t, err)
+	holiday := holidays.NewHoliday(date, "Anniversary", area, holidays.Refuse)
+
+	d := MapToHolidayDTO(holiday)
+
+	assert.Equal(t, holiday.Id().String(), d.Id)
+	assert.Equal(t, "2025-09-24", d.Date)
+	assert.Equal(t, "Anniversary", d.Name)
+	assert.Equal(t, "refuse", d.Policy)
+	assert.Equal(t, "Santa Cruz", d.Area.Name)
+	assert.Equal(t, -17.7863, d.Area.Latitude)
+	assert.Equal(t, 5000, d.Area.Radius)
+
+	d = MapToHolidayDTO(holidays.NewHoliday(date, "National", nil, holidays.Shift))
+	assert.Nil(t, d.Area)
+	assert.Equal(t, "shift", d.Policy)
+}
+
+func TestMapToHolidayImpactDTO(t *testing.T) {
+	date := time.Date(2025, 8, 6, 0, 0, 0, 0, time.UTC)
+	holiday := holidays.NewHoliday(date, "Independence Day", nil, holidays.Shift)
+	first, second := uuid.New(), uuid.New()
+
+	newDelivery := func(contractId uuid.UUID) *deliveries.Delivery {
+		d, err := deliveries.NewDeliveryFromDB(uuid.New(), contractId, date, "Sesame Street", 30, -17.7863, -63.1812, "P", nil, nil, date, date, nil)
+		assert.NoError(t, err)
+		return d
+	}
+
+	report := MapToHolidayImpactDTO(holiday, []*deliveries.Delivery{newDelivery(first), newDelivery(second), newDelivery(first)})
+
+	assert.Equal(t, holiday.Id().String(), report.Holiday.Id)
+	assert.Equal(t, 3, report.Deliveries)
+	assert.Len(t, report.Contracts, 2)
+	assert.Equal(t, first.String(), report.Contracts[0].ContractId)
+	assert.Len(t, report.Contracts[0].Deliveries, 2)
+	assert.Equal(t, second.String(), report.Contracts[1].ContractId)
+	assert.Len(t, report.Contracts[1].Deliveries, 1)
+
+	report = MapToHolidayImpactDTO(holiday, nil)
+	assert.Zero(t, report.Deliveries)
+	assert.NotNil(t, report.Contracts)
+}
@@ -0,0 +1,8 @@
+package queries
+
+import "time"
+
+type GetHolidaysQuery struct {
+	From time.Time
+	To   time.Time
+}
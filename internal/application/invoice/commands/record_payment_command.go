@@ -0,0 +1,11 @@
+package commands
+
+import "github.com/google/uuid"
+
+type RecordPaymentCommand struct {
+	InvoiceId      uuid.UUID
+	Kind           string
+	Amount         int
+	Currency       string
+	IdempotencyKey string
+}
@@ -0,0 +1,39 @@
+package dto
+
+import (
+	contractDto "github.com/carlosclavijo/Nutricenter-Contracting/internal/application/contract/dto"
+	"time"
+)
+
+type InvoiceDTO struct {
+	Id          string               `json:"id"`
+	Number      string               `json:"number"`
+	ContractId  string               `json:"contractId"`
+	PatientId   string               `json:"patientId"`
+	Status      string               `json:"status"`
+	Amount      contractDto.MoneyDTO `json:"amount"`
+	Paid        contractDto.MoneyDTO `json:"paid"`
+	Credited    contractDto.MoneyDTO `json:"credited"`
+	Balance     contractDto.MoneyDTO `json:"balance"`
+	IssuedAt    time.Time            `json:"issuedAt"`
+	Payments    []*PaymentDTO        `json:"payments"`
+	CreditNotes []*CreditNoteDTO     `json:"creditNotes"`
+	CreatedAt   time.Time            `json:"createdAt"`
+	UpdatedAt   time.Time            `json:"updatedAt"`
+}
+
+type PaymentDTO struct {
+	Id        string               `json:"id"`
+	Kind      string               `json:"kind"`
+	Amount    contractDto.MoneyDTO `json:"amount"`
+	Provider  string               `json:"provider"`
+	Reference string               `json:"reference"`
+	PaidAt    time.Time            `json:"paidAt"`
+}
+
+type CreditNoteDTO struct {
+	Id       string               `json:"id"`
+	Amount   contractDto.MoneyDTO `json:"amount"`
+	Reason   string               `json:"reason"`
+	IssuedAt time.Time            `json:"issuedAt"`
+}
@@ -0,0 +1,23 @@
+package dto
+
+import (
+	contractDto "github.com/carlosclavijo/Nutricenter-Contracting/internal/application/contract/dto"
+	"time"
+)
+
+type LedgerDTO struct {
+	PatientId string                 `json:"patientId"`
+	Entries   []*LedgerEntryDTO      `json:"entries"`
+	Balances  []contractDto.MoneyDTO `json:"balances"`
+}
+
+type LedgerEntryDTO struct {
+	Date       time.Time            `json:"date"`
+	Kind       string               `json:"kind"`
+	InvoiceId  string               `json:"invoiceId"`
+	Number     string               `json:"number"`
+	ContractId string               `json:"contractId"`
+	Reference  string               `json:"reference,omitempty"`
+	Amount     contractDto.MoneyDTO `json:"amount"`
+	Balance    contractDto.MoneyDTO `json:"balance"`
+}
@@ -0,0 +1,20 @@
+package handlers
+
+import (
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/abstractions"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/invoice"
+)
+
+type InvoiceHandler struct {
+	repository invoices.InvoiceRepository
+	provider   invoices.PaymentProvider
+	uow        abstractions.UnitOfWork
+}
+
+func NewInvoiceHandler(r invoices.InvoiceRepository, p invoices.PaymentProvider, u abstractions.UnitOfWork) *InvoiceHandler {
+	return &InvoiceHandler{
+		repository: r,
+		provider:   p,
+		uow:        u,
+	}
+}
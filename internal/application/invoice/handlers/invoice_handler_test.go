@@ -0,0 +1,97 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/invoice"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+var ErrDbFailureInvoice = errors.New("db failure")
+
+type MockInvoiceRepository struct {
+	mock.Mock
+	invoices.InvoiceRepository
+}
+
+type MockPaymentProvider struct {
+	mock.Mock
+}
+
+type MockUnitOfWork struct {
+	committed  int
+	rolledBack int
+}
+
+func TestNewInvoiceHandler(t *testing.T) {
+	r, p, u := new(MockInvoiceRepository), new(MockPaymentProvider), new(MockUnitOfWork)
+
+	handler := NewInvoiceHandler(r, p, u)
+
+	assert.NotNil(t, handler)
+	assert.Equal(t, r, handler.repository)
+	assert.Equal(t, p, handler.provider)
+	assert.Equal(t, u, handler.uow)
+}
+
+func bolivianos(t *testing.T, amount int) valueobjects.Money {
+	m, err := valueobjects.NewMoney(amount, valueobjects.BOB)
+	assert.NoError(t, err)
+	return m
+}
+
+func newInvoice(t *testing.T) *invoices.Invoice {
+	return invoices.NewInvoice("NC", 1, uuid.New(), uuid.New(), bolivianos(t, 45000), time.Now())
+}
+
+func (u *MockUnitOfWork) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	if err := fn(ctx); err != nil {
+		u.rolledBack++
+		return err
+	}
+	u.committed++
+	return nil
+}
+
+func (m *MockInvoiceRepository) GetById(ctx context.Context, id uuid.UUID) (*invoices.Invoice, error) {
+	args := m.Called(ctx, id)
+	if v := args.Get(0); v != nil {
+		return v.(*invoices.Invoice), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockInvoiceRepository) GetByIdForUpdate(ctx context.Context, id uuid.UUID) (*invoices.Invoice, error) {
+	args := m.Called(ctx, id)
+	if v := args.Get(0); v != nil {
+		return v.(*invoices.Invoice), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockInvoiceRepository) AddPayment(ctx context.Context, payment *invoices.Payment) (*invoices.Payment, error) {
+	args := m.Called(ctx, payment)
+	if v := args.Get(0); v != nil {
+		return v.(*invoices.Payment), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockPaymentProvider) Name() string {
+	return "mock"
+}
+
+func (m *MockPaymentProvider) Charge(ctx context.Context, invoice *invoices.Invoice, amount valueobjects.Money, idempotencyKey string) (string, error) {
+	args := m.Called(ctx, invoice, amount, idempotencyKey)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockPaymentProvider) Refund(ctx context.Context, invoice *invoices.Invoice, amount valueobjects.Money, idempotencyKey string) (string, error) {
+	args := m.Called(ctx, invoice, amount, idempotencyKey)
+	return args.String(0), args.Error(1)
+}
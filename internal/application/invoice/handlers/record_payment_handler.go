@@ -0,0 +1,81 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/invoice/commands"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/invoice"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	"github.com/google/uuid"
+	"log"
+	"time"
+)
+
+func (h *InvoiceHandler) HandleRecordPayment(ctx context.Context, cmd commands.RecordPaymentCommand) (*invoices.Invoice, error) {
+	kind, err := invoices.ParsePaymentKind(cmd.Kind)
+	if err != nil {
+		log.Printf("[handler:invoice][HandleRecordPayment] error parsing payment kind: %v", err)
+		return nil, err
+	}
+
+	key := cmd.IdempotencyKey
+	if key == "" {
+		key = uuid.NewString()
+	}
+
+	var (
+		invoice *invoices.Invoice
+		amount  valueobjects.Money
+	)
+	err = h.uow.Do(ctx, func(ctx context.Context) error {
+		var err error
+		if invoice, err = h.repository.GetByIdForUpdate(ctx, cmd.InvoiceId); err != nil {
+			return err
+		}
+
+		amount = invoice.Balance()
+		if kind != invoices.Full || cmd.Amount != 0 {
+			currency, err := valueobjects.ParseCurrency(cmd.Currency)
+			if err != nil {
+				return err
+			}
+
+			if amount, err = valueobjects.NewMoney(cmd.Amount, currency); err != nil {
+				return err
+			}
+		}
+
+		if err = invoice.Check(kind, amount); err != nil {
+			return err
+		}
+
+		var reference string
+		if kind == invoices.Refund {
+			reference, err = h.provider.Refund(ctx, invoice, amount, key)
+		} else {
+			reference, err = h.provider.Charge(ctx, invoice, amount, key)
+		}
+		if err != nil {
+			log.Printf("[handler:invoice][HandleRecordPayment] provider '%s' failed on invoice %s: %v", h.provider.Name(), invoice.Number(), err)
+			return err
+		}
+
+		if invoice.HasPayment(h.provider.Name(), reference) {
+			return nil
+		}
+
+		payment, err := invoice.Record(kind, amount, h.provider.Name(), reference, time.Now())
+		if err != nil {
+			return err
+		}
+
+		_, err = h.repository.AddPayment(ctx, payment)
+		return err
+	})
+	if err != nil {
+		log.Printf("[handler:invoice][HandleRecordPayment] error recording a %s payment of invoice '%s': %v", kind.String(), cmd.InvoiceId, err)
+		return nil, err
+	}
+
+	log.Printf("[handler:invoice][HandleRecordPayment] %s payment of %s recorded on invoice %s", kind.String(), amount, invoice.Number())
+	return invoice, nil
+}
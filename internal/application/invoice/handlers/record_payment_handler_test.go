@@ -0,0 +1,167 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/invoice/commands"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/invoice"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+func TestInvoiceHandler_HandleRecordPayment(t *testing.T) {
+	cases := []struct {
+		name    string
+		cmd     commands.RecordPaymentCommand
+		charged int
+		status  invoices.InvoiceStatus
+	}{
+		{"full without amount", commands.RecordPaymentCommand{Kind: "full"}, 45000, invoices.Paid},
+		{"full", commands.RecordPaymentCommand{Kind: "F", Amount: 45000, Currency: "BOB"}, 45000, invoices.Paid},
+		{"partial", commands.RecordPaymentCommand{Kind: "partial", Amount: 10000}, 10000, invoices.PartiallyPaid},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			repo, provider, uow := new(MockInvoiceRepository), new(MockPaymentProvider), new(MockUnitOfWork)
+			handler := NewInvoiceHandler(repo, provider, uow)
+			invoice := newInvoice(t)
+			tc.cmd.InvoiceId = invoice.Id()
+
+			repo.On("GetByIdForUpdate", mock.Anything, invoice.Id()).Return(invoice, nil)
+			provider.On("Charge", mock.Anything, invoice, bolivianos(t, tc.charged), mock.Anything).Return("ref_1", nil)
+			repo.On("AddPayment", mock.Anything, mock.MatchedBy(func(p *invoices.Payment) bool {
+				return p.InvoiceId() == invoice.Id() && p.Reference() == "ref_1" && p.Provider() == "mock"
+			})).Return(nil, nil)
+
+			resp, err := handler.HandleRecordPayment(context.Background(), tc.cmd)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.status, resp.Status())
+			assert.Len(t, resp.Payments(), 1)
+			assert.Equal(t, 1, uow.committed)
+			repo.AssertExpectations(t)
+			provider.AssertExpectations(t)
+		})
+	}
+}
+
+func TestInvoiceHandler_HandleRecordPayment_IdempotencyKey(t *testing.T) {
+	repo, provider, uow := new(MockInvoiceRepository), new(MockPaymentProvider), new(MockUnitOfWork)
+	handler := NewInvoiceHandler(repo, provider, uow)
+	invoice := newInvoice(t)
+	cmd := commands.RecordPaymentCommand{InvoiceId: invoice.Id(), Kind: "partial", Amount: 10000, IdempotencyKey: "key_1"}
+
+	repo.On("GetByIdForUpdate", mock.Anything, invoice.Id()).Return(invoice, nil)
+	provider.On("Charge", mock.Anything, invoice, bolivianos(t, 10000), "key_1").Return("ref_1", nil)
+	repo.On("AddPayment", mock.Anything, mock.Anything).Return(nil, nil).Once()
+
+	_, err := handler.HandleRecordPayment(context.Background(), cmd)
+	assert.NoError(t, err)
+
+	resp, err := handler.HandleRecordPayment(context.Background(), cmd)
+
+	assert.NoError(t, err)
+	assert.Len(t, resp.Payments(), 1)
+	assert.Equal(t, bolivianos(t, 35000), resp.Balance())
+	assert.Equal(t, 2, uow.committed)
+	repo.AssertNumberOfCalls(t, "AddPayment", 1)
+	provider.AssertExpectations(t)
+}
+
+func TestInvoiceHandler_HandleRecordPayment_Refund(t *testing.T) {
+	repo, provider, uow := new(MockInvoiceRepository), new(MockPaymentProvider), new(MockUnitOfWork)
+	handler := NewInvoiceHandler(repo, provider, uow)
+	invoice := newInvoice(t)
+	_, err := invoice.Record(invoices.Full, bolivianos(t, 45000), "mock", "ref_1", time.Now())
+	assert.NoError(t, err)
+
+	repo.On("GetByIdForUpdate", mock.Anything, invoice.Id()).Return(invoice, nil)
+	provider.On("Refund", mock.Anything, invoice, bolivianos(t, 5000), mock.Anything).Return("ref_2", nil)
+	repo.On("AddPayment", mock.Anything, mock.Anything).Return(nil, nil)
+
+	resp, err := handler.HandleRecordPayment(context.Background(), commands.RecordPaymentCommand{InvoiceId: invoice.Id(), Kind: "refund", Amount: 5000})
+
+	assert.NoError(t, err)
+	assert.Equal(t, bolivianos(t, 5000), resp.Balance())
+	provider.AssertNotCalled(t, "Charge", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestInvoiceHandler_HandleRecordPayment_Errors(t *testing.T) {
+	cases := []struct {
+		name     string
+		cmd      commands.RecordPaymentCommand
+		expected error
+	}{
+		{"unknown kind", commands.RecordPaymentCommand{Kind: "gift", Amount: 1000}, invoices.ErrKindPayment},
+		{"full below balance", commands.RecordPaymentCommand{Kind: "full", Amount: 1000}, invoices.ErrFullPayment},
+		{"partial above balance", commands.RecordPaymentCommand{Kind: "partial", Amount: 50000}, invoices.ErrPartialPayment},
+		{"refund of nothing", commands.RecordPaymentCommand{Kind: "refund", Amount: 1000}, invoices.ErrRefundPayment},
+		{"no amount", commands.RecordPaymentCommand{Kind: "partial"}, invoices.ErrAmountPayment},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			repo, provider := new(MockInvoiceRepository), new(MockPaymentProvider)
+			handler := NewInvoiceHandler(repo, provider, new(MockUnitOfWork))
+			invoice := newInvoice(t)
+			tc.cmd.InvoiceId = invoice.Id()
+
+			repo.On("GetByIdForUpdate", mock.Anything, invoice.Id()).Return(invoice, nil).Maybe()
+
+			resp, err := handler.HandleRecordPayment(context.Background(), tc.cmd)
+
+			assert.Nil(t, resp)
+			assert.ErrorIs(t, err, tc.expected)
+			assert.Empty(t, invoice.Payments())
+			provider.AssertNotCalled(t, "Charge", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+
+	t.Run("not found", func(t *testing.T) {
+		repo := new(MockInvoiceRepository)
+		handler := NewInvoiceHandler(repo, new(MockPaymentProvider), new(MockUnitOfWork))
+		invoice := newInvoice(t)
+
+		repo.On("GetByIdForUpdate", mock.Anything, invoice.Id()).Return(nil, invoices.ErrNotFoundInvoice)
+
+		resp, err := handler.HandleRecordPayment(context.Background(), commands.RecordPaymentCommand{InvoiceId: invoice.Id(), Kind: "full"})
+
+		assert.Nil(t, resp)
+		assert.ErrorIs(t, err, invoices.ErrNotFoundInvoice)
+	})
+
+	t.Run("declined", func(t *testing.T) {
+		repo, provider, uow := new(MockInvoiceRepository), new(MockPaymentProvider), new(MockUnitOfWork)
+		handler := NewInvoiceHandler(repo, provider, uow)
+		invoice := newInvoice(t)
+
+		repo.On("GetByIdForUpdate", mock.Anything, invoice.Id()).Return(invoice, nil)
+		provider.On("Charge", mock.Anything, invoice, mock.Anything, mock.Anything).Return("", invoices.ErrDeclinedPayment)
+
+		resp, err := handler.HandleRecordPayment(context.Background(), commands.RecordPaymentCommand{InvoiceId: invoice.Id(), Kind: "full"})
+
+		assert.Nil(t, resp)
+		assert.ErrorIs(t, err, invoices.ErrDeclinedPayment)
+		assert.Empty(t, invoice.Payments())
+		assert.Equal(t, 1, uow.rolledBack)
+		repo.AssertNotCalled(t, "AddPayment", mock.Anything, mock.Anything)
+	})
+
+	t.Run("repository fails", func(t *testing.T) {
+		repo, provider, uow := new(MockInvoiceRepository), new(MockPaymentProvider), new(MockUnitOfWork)
+		handler := NewInvoiceHandler(repo, provider, uow)
+		invoice := newInvoice(t)
+
+		repo.On("GetByIdForUpdate", mock.Anything, invoice.Id()).Return(invoice, nil)
+		provider.On("Charge", mock.Anything, invoice, mock.Anything, mock.Anything).Return("ref_1", nil)
+		repo.On("AddPayment", mock.Anything, mock.Anything).Return(nil, ErrDbFailureInvoice)
+
+		resp, err := handler.HandleRecordPayment(context.Background(), commands.RecordPaymentCommand{InvoiceId: invoice.Id(), Kind: "full"})
+
+		assert.Nil(t, resp)
+		assert.ErrorIs(t, err, ErrDbFailureInvoice)
+		assert.Equal(t, 1, uow.rolledBack)
+	})
+}
@@ -0,0 +1,85 @@
+package mappers
+
+import (
+	contractDto "github.com/carlosclavijo/Nutricenter-Contracting/internal/application/contract/dto"
+	contractMappers "github.com/carlosclavijo/Nutricenter-Contracting/internal/application/contract/mappers"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/invoice/dto"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/invoice"
+	"github.com/google/uuid"
+)
+
+func MapToInvoiceDTO(invoice *invoices.Invoice) *dto.InvoiceDTO {
+	payments := make([]*dto.PaymentDTO, 0, len(invoice.Payments()))
+	for _, p := range invoice.Payments() {
+		payments = append(payments, MapToPaymentDTO(p))
+	}
+
+	credits := make([]*dto.CreditNoteDTO, 0, len(invoice.CreditNotes()))
+	for _, c := range invoice.CreditNotes() {
+		credits = append(credits, MapToCreditNoteDTO(c))
+	}
+
+	return &dto.InvoiceDTO{
+		Id:          invoice.Id().String(),
+		Number:      invoice.Number(),
+		ContractId:  invoice.ContractId().String(),
+		PatientId:   invoice.PatientId().String(),
+		Status:      invoice.Status().String(),
+		Amount:      contractMappers.MapToMoneyDTO(invoice.Amount()),
+		Paid:        contractMappers.MapToMoneyDTO(invoice.Paid()),
+		Credited:    contractMappers.MapToMoneyDTO(invoice.Credited()),
+		Balance:     contractMappers.MapToMoneyDTO(invoice.Balance()),
+		IssuedAt:    invoice.IssuedAt(),
+		Payments:    payments,
+		CreditNotes: credits,
+		CreatedAt:   invoice.CreatedAt(),
+		UpdatedAt:   invoice.UpdatedAt(),
+	}
+}
+
+func MapToPaymentDTO(payment *invoices.Payment) *dto.PaymentDTO {
+	return &dto.PaymentDTO{
+		Id:        payment.Id().String(),
+		Kind:      payment.Kind().String(),
+		Amount:    contractMappers.MapToMoneyDTO(payment.Amount()),
+		Provider:  payment.Provider(),
+		Reference: payment.Reference(),
+		PaidAt:    payment.PaidAt(),
+	}
+}
+
+func MapToCreditNoteDTO(note *invoices.CreditNote) *dto.CreditNoteDTO {
+	return &dto.CreditNoteDTO{
+		Id:       note.Id().String(),
+		Amount:   contractMappers.MapToMoneyDTO(note.Amount()),
+		Reason:   note.Reason(),
+		IssuedAt: note.IssuedAt(),
+	}
+}
+
+func MapToLedgerDTO(patientId uuid.UUID, entries []invoices.LedgerEntry) *dto.LedgerDTO {
+	entriesDTO := make([]*dto.LedgerEntryDTO, 0, len(entries))
+	for _, e := range entries {
+		entriesDTO = append(entriesDTO, &dto.LedgerEntryDTO{
+			Date:       e.Date,
+			Kind:       string(e.Kind),
+			InvoiceId:  e.InvoiceId.String(),
+			Number:     e.Number,
+			ContractId: e.ContractId.String(),
+			Reference:  e.Reference,
+			Amount:     contractMappers.MapToMoneyDTO(e.Amount),
+			Balance:    contractMappers.MapToMoneyDTO(e.Balance),
+		})
+	}
+
+	balances := make([]contractDto.MoneyDTO, 0)
+	for _, b := range invoices.Balances(entries) {
+		balances = append(balances, contractMappers.MapToMoneyDTO(b))
+	}
+
+	return &dto.LedgerDTO{
+		PatientId: patientId.String(),
+		Entries:   entriesDTO,
+		Balances:  balances,
+	}
+}
@@ -0,0 +1,83 @@
+package mappers
+
+import (
+	contractDto "github.com/carlosclavijo/Nutricenter-Contracting/internal/application/contract/dto"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/invoice"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func newPaidInvoice(t *testing.T, issuedAt time.Time) *invoices.Invoice {
+	amount, err := valueobjects.NewMoney(45000, valueobjects.BOB)
+	assert.NoError(t, err)
+	part, err := valueobjects.NewMoney(20000, valueobjects.BOB)
+	assert.NoError(t, err)
+
+	invoice := invoices.NewInvoice("NC", 7, uuid.New(), uuid.New(), amount, issuedAt)
+	_, err = invoice.Record(invoices.Partial, part, "fake", "fake_1", issuedAt.Add(time.Hour))
+	assert.NoError(t, err)
+	return invoice
+}
+
+func TestMapToInvoiceDTO(t *testing.T) {
+	issuedAt := time.Date(2025, 10, 1, 9, 0, 0, 0, time.UTC)
+	invoice := newPaidInvoice(t, issuedAt)
+
+	d := MapToInvoiceDTO(invoice)
+
+	assert.Equal(t, invoice.Id().String(), d.Id)
+	assert.Equal(t, "NC-000007", d.Number)
+	assert.Equal(t, invoice.ContractId().String(), d.ContractId)
+	assert.Equal(t, invoice.PatientId().String(), d.PatientId)
+	assert.Equal(t, "partially paid", d.Status)
+	assert.Equal(t, contractDto.MoneyDTO{Amount: 45000, Currency: "BOB"}, d.Amount)
+	assert.Equal(t, contractDto.MoneyDTO{Amount: 20000, Currency: "BOB"}, d.Paid)
+	assert.Equal(t, contractDto.MoneyDTO{Amount: 25000, Currency: "BOB"}, d.Balance)
+	assert.Equal(t, issuedAt, d.IssuedAt)
+	assert.Len(t, d.Payments, 1)
+	assert.Equal(t, "partial", d.Payments[0].Kind)
+	assert.Equal(t, "fake", d.Payments[0].Provider)
+	assert.Equal(t, "fake_1", d.Payments[0].Reference)
+	assert.Equal(t, contractDto.MoneyDTO{Amount: 0, Currency: "BOB"}, d.Credited)
+	assert.Empty(t, d.CreditNotes)
+}
+
+func TestMapToInvoiceDTO_CreditNotes(t *testing.T) {
+	issuedAt := time.Date(2025, 10, 1, 9, 0, 0, 0, time.UTC)
+	invoice := newPaidInvoice(t, issuedAt)
+	credit, err := valueobjects.NewMoney(15000, valueobjects.BOB)
+	assert.NoError(t, err)
+	note, err := invoice.Credit(credit, "cancelled", issuedAt.Add(48*time.Hour))
+	assert.NoError(t, err)
+
+	d := MapToInvoiceDTO(invoice)
+
+	assert.Equal(t, contractDto.MoneyDTO{Amount: 15000, Currency: "BOB"}, d.Credited)
+	assert.Equal(t, contractDto.MoneyDTO{Amount: 10000, Currency: "BOB"}, d.Balance)
+	assert.Len(t, d.CreditNotes, 1)
+	assert.Equal(t, note.Id().String(), d.CreditNotes[0].Id)
+	assert.Equal(t, "cancelled", d.CreditNotes[0].Reason)
+	assert.Equal(t, issuedAt.Add(48*time.Hour), d.CreditNotes[0].IssuedAt)
+}
+
+func TestMapToLedgerDTO(t *testing.T) {
+	patientId := uuid.New()
+	invoice := newPaidInvoice(t, time.Date(2025, 10, 1, 9, 0, 0, 0, time.UTC))
+
+	d := MapToLedgerDTO(patientId, invoices.Ledger([]*invoices.Invoice{invoice}))
+
+	assert.Equal(t, patientId.String(), d.PatientId)
+	assert.Len(t, d.Entries, 2)
+	assert.Equal(t, "invoice", d.Entries[0].Kind)
+	assert.Equal(t, "NC-000007", d.Entries[0].Number)
+	assert.Equal(t, "payment", d.Entries[1].Kind)
+	assert.Equal(t, contractDto.MoneyDTO{Amount: -20000, Currency: "BOB"}, d.Entries[1].Amount)
+	assert.Equal(t, []contractDto.MoneyDTO{{Amount: 25000, Currency: "BOB"}}, d.Balances)
+
+	d = MapToLedgerDTO(patientId, nil)
+	assert.Empty(t, d.Entries)
+	assert.NotNil(t, d.Balances)
+}
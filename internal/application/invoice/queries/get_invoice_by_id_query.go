@@ -0,0 +1,7 @@
+package queries
+
+import "github.com/google/uuid"
+
+type GetInvoiceByIdQuery struct {
+	Id uuid.UUID
+}
@@ -0,0 +1,9 @@
+package commands
+
+import "github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/token"
+
+type LoginAttemptCommand struct {
+	Role  tokens.Role
+	Email string
+	IP    string
+}
@@ -0,0 +1,9 @@
+package commands
+
+import "github.com/google/uuid"
+
+type UnlockCommand struct {
+	Scope   string
+	Key     string
+	ActorId uuid.UUID
+}
@@ -0,0 +1,14 @@
+package dto
+
+import "time"
+
+type LockoutEventDTO struct {
+	Id          string     `json:"id"`
+	Scope       string     `json:"scope"`
+	Key         string     `json:"key"`
+	Type        string     `json:"type"`
+	Failures    int        `json:"failures"`
+	LockedUntil *time.Time `json:"lockedUntil,omitempty"`
+	ActorId     *string    `json:"actorId,omitempty"`
+	CreatedAt   time.Time  `json:"createdAt"`
+}
@@ -0,0 +1,42 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/lockout/commands"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/lockout"
+	"log"
+	"time"
+)
+
+func (h *LockoutHandler) HandleCheck(ctx context.Context, cmd commands.LoginAttemptCommand) (time.Duration, error) {
+	now := h.now()
+
+	var wait time.Duration
+	for scope, key := range attemptKeys(cmd) {
+		t, err := h.repository.GetThrottle(ctx, scope, key)
+		if err != nil {
+			log.Printf("[handler:lockout][HandleCheck] error getting %s throttle: %v", scope, err)
+			return 0, err
+		}
+		wait = max(wait, t.RetryAfter(now))
+	}
+
+	if wait > 0 {
+		log.Printf("[handler:lockout][HandleCheck] login of '%s' from '%s' locked for %s", cmd.Email, cmd.IP, wait)
+		return wait, fmt.Errorf("%w: retry after %s", lockouts.ErrLockedOut, wait)
+	}
+
+	return 0, nil
+}
+
+func attemptKeys(cmd commands.LoginAttemptCommand) func(yield func(lockouts.Scope, string) bool) {
+	return func(yield func(lockouts.Scope, string) bool) {
+		if !yield(lockouts.Account, AccountKey(cmd.Role, cmd.Email)) {
+			return
+		}
+		if cmd.IP != "" {
+			yield(lockouts.Address, cmd.IP)
+		}
+	}
+}
@@ -0,0 +1,78 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/lockout/commands"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/lockout"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/token"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+func TestHandleCheck(t *testing.T) {
+	now := time.Now()
+	h, repo, _ := newHandler(now)
+
+	repo.On("GetThrottle", mock.Anything, lockouts.Account, accountKey).Return(throttleWith(lockouts.Account, accountKey, 2, now), nil)
+	repo.On("GetThrottle", mock.Anything, lockouts.Address, "10.0.0.1").Return(lockouts.NewThrottle(lockouts.Address, "10.0.0.1"), nil)
+
+	wait, err := h.HandleCheck(context.Background(), attempt)
+
+	assert.NoError(t, err)
+	assert.Zero(t, wait)
+	repo.AssertExpectations(t)
+}
+
+func TestHandleCheck_Locked(t *testing.T) {
+	now := time.Now()
+	account := throttleWith(lockouts.Account, accountKey, lockouts.AccountPolicy.Threshold, now.Add(-10*time.Second))
+	address := throttleWith(lockouts.Address, "10.0.0.1", lockouts.AddressPolicy.Threshold, now)
+
+	cases := []struct {
+		name             string
+		account, address *lockouts.Throttle
+		want             time.Duration
+	}{
+		{"Account", account, lockouts.NewThrottle(lockouts.Address, "10.0.0.1"), 20 * time.Second},
+		{"Address", lockouts.NewThrottle(lockouts.Account, accountKey), address, time.Minute},
+		{"Both", account, address, time.Minute},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			h, repo, _ := newHandler(now)
+			repo.On("GetThrottle", mock.Anything, lockouts.Account, accountKey).Return(tc.account, nil)
+			repo.On("GetThrottle", mock.Anything, lockouts.Address, "10.0.0.1").Return(tc.address, nil)
+
+			wait, err := h.HandleCheck(context.Background(), attempt)
+
+			assert.ErrorIs(t, err, lockouts.ErrLockedOut)
+			assert.Equal(t, tc.want, wait)
+		})
+	}
+}
+
+func TestHandleCheck_WithoutAddress(t *testing.T) {
+	now := time.Now()
+	h, repo, _ := newHandler(now)
+
+	repo.On("GetThrottle", mock.Anything, lockouts.Account, "P:jane@doe.com").Return(lockouts.NewThrottle(lockouts.Account, "P:jane@doe.com"), nil)
+
+	_, err := h.HandleCheck(context.Background(), commands.LoginAttemptCommand{Role: tokens.Patient, Email: "jane@doe.com"})
+
+	assert.NoError(t, err)
+	repo.AssertExpectations(t)
+}
+
+func TestHandleCheck_RepositoryError(t *testing.T) {
+	h, repo, _ := newHandler(time.Now())
+
+	repo.On("GetThrottle", mock.Anything, lockouts.Account, accountKey).Return(nil, ErrDbFailureLockout)
+
+	wait, err := h.HandleCheck(context.Background(), attempt)
+
+	assert.ErrorIs(t, err, ErrDbFailureLockout)
+	assert.Zero(t, wait)
+}
@@ -0,0 +1,31 @@
+package handlers
+
+import (
+	"fmt"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/abstractions"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/lockout"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/token"
+	"strings"
+	"time"
+)
+
+type LockoutHandler struct {
+	repository lockouts.LockoutRepository
+	uow        abstractions.UnitOfWork
+	now        func() time.Time
+}
+
+func NewLockoutHandler(r lockouts.LockoutRepository, u abstractions.UnitOfWork) *LockoutHandler {
+	return &LockoutHandler{
+		repository: r,
+		uow:        u,
+		now:        time.Now,
+	}
+}
+
+func AccountKey(role tokens.Role, email string) string {
+	if role.IsAdministrator() {
+		role = tokens.Administrator
+	}
+	return fmt.Sprintf("%s:%s", string(role), strings.ToLower(strings.TrimSpace(email)))
+}
@@ -0,0 +1,98 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/lockout/commands"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/abstractions"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/lockout"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/token"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+var ErrDbFailureLockout = errors.New("database failure")
+
+var attempt = commands.LoginAttemptCommand{Role: tokens.Administrator, Email: "John@Doe.com", IP: "10.0.0.1"}
+
+const accountKey = "A:john@doe.com"
+
+type MockLockoutRepository struct {
+	mock.Mock
+}
+
+type MockUnitOfWork struct {
+	committed  int
+	rolledBack int
+}
+
+func (m *MockLockoutRepository) GetThrottle(ctx context.Context, scope lockouts.Scope, key string) (*lockouts.Throttle, error) {
+	args := m.Called(ctx, scope, key)
+	if v := args.Get(0); v != nil {
+		return v.(*lockouts.Throttle), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockLockoutRepository) GetEvents(ctx context.Context, filter lockouts.EventFilter, pagination abstractions.Pagination) (*abstractions.Page[*lockouts.Event], error) {
+	args := m.Called(ctx, filter, pagination)
+	if v := args.Get(0); v != nil {
+		return v.(*abstractions.Page[*lockouts.Event]), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockLockoutRepository) SaveThrottle(ctx context.Context, t *lockouts.Throttle) error {
+	return m.Called(ctx, t).Error(0)
+}
+
+func (m *MockLockoutRepository) DeleteThrottle(ctx context.Context, scope lockouts.Scope, key string) error {
+	return m.Called(ctx, scope, key).Error(0)
+}
+
+func (m *MockLockoutRepository) CreateEvent(ctx context.Context, e *lockouts.Event) error {
+	return m.Called(ctx, e).Error(0)
+}
+
+func (u *MockUnitOfWork) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	if err := fn(ctx); err != nil {
+		u.rolledBack++
+		return err
+	}
+	u.committed++
+	return nil
+}
+
+func newHandler(now time.Time) (*LockoutHandler, *MockLockoutRepository, *MockUnitOfWork) {
+	repo, uow := new(MockLockoutRepository), new(MockUnitOfWork)
+	h := NewLockoutHandler(repo, uow)
+	h.now = func() time.Time { return now }
+	return h, repo, uow
+}
+
+func throttleWith(scope lockouts.Scope, key string, failures int, at time.Time) *lockouts.Throttle {
+	t := lockouts.NewThrottle(scope, key)
+	for range failures {
+		t.Fail(at, lockouts.PolicyFor(scope))
+	}
+	return t
+}
+
+func TestAccountKey(t *testing.T) {
+	assert.Equal(t, accountKey, AccountKey(tokens.Administrator, " John@Doe.com"))
+	assert.Equal(t, accountKey, AccountKey(tokens.SuperAdministrator, "john@doe.com"))
+	assert.Equal(t, "P:john@doe.com", AccountKey(tokens.Patient, "john@doe.com"))
+}
+
+func TestNewLockoutHandler(t *testing.T) {
+	repo, uow := new(MockLockoutRepository), new(MockUnitOfWork)
+
+	h := NewLockoutHandler(repo, uow)
+
+	assert.NotNil(t, h)
+	assert.Equal(t, repo, h.repository)
+	assert.Equal(t, uow, h.uow)
+	assert.NotNil(t, h.now)
+}
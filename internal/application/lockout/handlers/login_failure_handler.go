@@ -0,0 +1,40 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/lockout/commands"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/lockout"
+	"log"
+)
+
+func (h *LockoutHandler) HandleFailure(ctx context.Context, cmd commands.LoginAttemptCommand) error {
+	now := h.now()
+
+	err := h.uow.Do(ctx, func(ctx context.Context) error {
+		for scope, key := range attemptKeys(cmd) {
+			t, err := h.repository.GetThrottle(ctx, scope, key)
+			if err != nil {
+				return err
+			}
+
+			locked := t.Fail(now, lockouts.PolicyFor(scope))
+			if err = h.repository.SaveThrottle(ctx, t); err != nil {
+				return err
+			}
+
+			if locked {
+				log.Printf("[handler:lockout][HandleFailure] %s '%s' locked until %s after %d failures", scope, key, t.LockedUntil(), t.Failures())
+				if err = h.repository.CreateEvent(ctx, lockouts.NewLockedEvent(t)); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		log.Printf("[handler:lockout][HandleFailure] error recording failed login of '%s': %v", cmd.Email, err)
+		return err
+	}
+
+	return nil
+}
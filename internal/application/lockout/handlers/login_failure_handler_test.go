@@ -0,0 +1,66 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/lockout"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+func TestHandleFailure(t *testing.T) {
+	now := time.Now()
+	h, repo, uow := newHandler(now)
+	account := lockouts.NewThrottle(lockouts.Account, accountKey)
+	address := lockouts.NewThrottle(lockouts.Address, "10.0.0.1")
+
+	repo.On("GetThrottle", mock.Anything, lockouts.Account, accountKey).Return(account, nil)
+	repo.On("GetThrottle", mock.Anything, lockouts.Address, "10.0.0.1").Return(address, nil)
+	repo.On("SaveThrottle", mock.Anything, account).Return(nil)
+	repo.On("SaveThrottle", mock.Anything, address).Return(nil)
+
+	err := h.HandleFailure(context.Background(), attempt)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, account.Failures())
+	assert.Equal(t, 1, address.Failures())
+	assert.Equal(t, 1, uow.committed)
+	repo.AssertNotCalled(t, "CreateEvent", mock.Anything, mock.Anything)
+	repo.AssertExpectations(t)
+}
+
+func TestHandleFailure_Locks(t *testing.T) {
+	now := time.Now()
+	h, repo, _ := newHandler(now)
+	account := throttleWith(lockouts.Account, accountKey, lockouts.AccountPolicy.Threshold-1, now)
+	address := lockouts.NewThrottle(lockouts.Address, "10.0.0.1")
+
+	repo.On("GetThrottle", mock.Anything, lockouts.Account, accountKey).Return(account, nil)
+	repo.On("GetThrottle", mock.Anything, lockouts.Address, "10.0.0.1").Return(address, nil)
+	repo.On("SaveThrottle", mock.Anything, mock.Anything).Return(nil)
+	repo.On("CreateEvent", mock.Anything, mock.MatchedBy(func(e *lockouts.Event) bool {
+		return e.Type() == lockouts.Locked && e.Key() == accountKey
+	})).Return(nil).Once()
+
+	err := h.HandleFailure(context.Background(), attempt)
+
+	assert.NoError(t, err)
+	assert.True(t, account.Locked(now))
+	assert.Equal(t, lockouts.AccountPolicy.Base, account.RetryAfter(now))
+	repo.AssertExpectations(t)
+}
+
+func TestHandleFailure_RollsBack(t *testing.T) {
+	now := time.Now()
+	h, repo, uow := newHandler(now)
+
+	repo.On("GetThrottle", mock.Anything, lockouts.Account, accountKey).Return(lockouts.NewThrottle(lockouts.Account, accountKey), nil)
+	repo.On("SaveThrottle", mock.Anything, mock.Anything).Return(ErrDbFailureLockout)
+
+	err := h.HandleFailure(context.Background(), attempt)
+
+	assert.ErrorIs(t, err, ErrDbFailureLockout)
+	assert.Equal(t, 1, uow.rolledBack)
+	assert.Zero(t, uow.committed)
+}
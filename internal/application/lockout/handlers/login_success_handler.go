@@ -0,0 +1,40 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/lockout/commands"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/lockout"
+	"log"
+)
+
+// HandleSuccess clears the failures of the account after a successful login.
+// The address keeps its count so that owning one account does not reset the
+// budget for guessing others.
+func (h *LockoutHandler) HandleSuccess(ctx context.Context, cmd commands.LoginAttemptCommand) error {
+	key := AccountKey(cmd.Role, cmd.Email)
+
+	err := h.uow.Do(ctx, func(ctx context.Context) error {
+		t, err := h.repository.GetThrottle(ctx, lockouts.Account, key)
+		if err != nil {
+			return err
+		}
+
+		if t.Failures() == 0 {
+			return nil
+		}
+
+		if t.WasLocked() {
+			if err = h.repository.CreateEvent(ctx, lockouts.NewUnlockedEvent(t, nil)); err != nil {
+				return err
+			}
+		}
+
+		return h.repository.DeleteThrottle(ctx, lockouts.Account, key)
+	})
+	if err != nil {
+		log.Printf("[handler:lockout][HandleSuccess] error clearing failures of '%s': %v", key, err)
+		return err
+	}
+
+	return nil
+}
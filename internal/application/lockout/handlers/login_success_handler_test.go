@@ -0,0 +1,64 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/lockout"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+func TestHandleSuccess(t *testing.T) {
+	now := time.Now()
+
+	cases := []struct {
+		name     string
+		throttle *lockouts.Throttle
+		deleted  bool
+		event    bool
+	}{
+		{"No failures", lockouts.NewThrottle(lockouts.Account, accountKey), false, false},
+		{"Some failures", throttleWith(lockouts.Account, accountKey, 2, now), true, false},
+		{"Lock expired", throttleWith(lockouts.Account, accountKey, lockouts.AccountPolicy.Threshold, now.Add(-time.Hour)), true, true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			h, repo, _ := newHandler(now)
+			repo.On("GetThrottle", mock.Anything, lockouts.Account, accountKey).Return(tc.throttle, nil)
+			repo.On("DeleteThrottle", mock.Anything, lockouts.Account, accountKey).Return(nil).Maybe()
+			repo.On("CreateEvent", mock.Anything, mock.MatchedBy(func(e *lockouts.Event) bool {
+				return e.Type() == lockouts.Unlocked && e.ActorId() == nil
+			})).Return(nil).Maybe()
+
+			err := h.HandleSuccess(context.Background(), attempt)
+
+			assert.NoError(t, err)
+			if tc.deleted {
+				repo.AssertCalled(t, "DeleteThrottle", mock.Anything, lockouts.Account, accountKey)
+			} else {
+				repo.AssertNotCalled(t, "DeleteThrottle", mock.Anything, mock.Anything, mock.Anything)
+			}
+			if tc.event {
+				repo.AssertNumberOfCalls(t, "CreateEvent", 1)
+			} else {
+				repo.AssertNotCalled(t, "CreateEvent", mock.Anything, mock.Anything)
+			}
+			repo.AssertNotCalled(t, "GetThrottle", mock.Anything, lockouts.Address, mock.Anything)
+		})
+	}
+}
+
+func TestHandleSuccess_RepositoryError(t *testing.T) {
+	now := time.Now()
+	h, repo, uow := newHandler(now)
+
+	repo.On("GetThrottle", mock.Anything, lockouts.Account, accountKey).Return(throttleWith(lockouts.Account, accountKey, 1, now), nil)
+	repo.On("DeleteThrottle", mock.Anything, lockouts.Account, accountKey).Return(ErrDbFailureLockout)
+
+	err := h.HandleSuccess(context.Background(), attempt)
+
+	assert.ErrorIs(t, err, ErrDbFailureLockout)
+	assert.Equal(t, 1, uow.rolledBack)
+}
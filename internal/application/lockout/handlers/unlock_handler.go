@@ -0,0 +1,46 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/lockout/commands"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/lockout"
+	"log"
+)
+
+func (h *LockoutHandler) HandleUnlock(ctx context.Context, cmd commands.UnlockCommand) error {
+	scope, err := lockouts.ParseScope(cmd.Scope)
+	if err != nil {
+		log.Printf("[handler:lockout][HandleUnlock] invalid scope: %v", err)
+		return err
+	}
+
+	if cmd.Key == "" {
+		log.Printf("[handler:lockout][HandleUnlock] empty key")
+		return lockouts.ErrEmptyKeyLockout
+	}
+
+	err = h.uow.Do(ctx, func(ctx context.Context) error {
+		t, err := h.repository.GetThrottle(ctx, scope, cmd.Key)
+		if err != nil {
+			return err
+		}
+
+		if t.Failures() == 0 {
+			return fmt.Errorf("%w: got %s", lockouts.ErrNotLockedLockout, cmd.Key)
+		}
+
+		if err = h.repository.CreateEvent(ctx, lockouts.NewUnlockedEvent(t, &cmd.ActorId)); err != nil {
+			return err
+		}
+
+		return h.repository.DeleteThrottle(ctx, scope, cmd.Key)
+	})
+	if err != nil {
+		log.Printf("[handler:lockout][HandleUnlock] error unlocking %s '%s': %v", scope, cmd.Key, err)
+		return err
+	}
+
+	log.Printf("[handler:lockout][HandleUnlock] %s '%s' unlocked by '%s'", scope, cmd.Key, cmd.ActorId)
+	return nil
+}
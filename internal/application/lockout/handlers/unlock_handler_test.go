@@ -0,0 +1,54 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/lockout/commands"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/lockout"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+func TestHandleUnlock(t *testing.T) {
+	now := time.Now()
+	h, repo, uow := newHandler(now)
+	actorId := uuid.New()
+
+	repo.On("GetThrottle", mock.Anything, lockouts.Address, "10.0.0.1").Return(throttleWith(lockouts.Address, "10.0.0.1", lockouts.AddressPolicy.Threshold, now), nil)
+	repo.On("CreateEvent", mock.Anything, mock.MatchedBy(func(e *lockouts.Event) bool {
+		return e.Type() == lockouts.Unlocked && e.ActorId() != nil && *e.ActorId() == actorId
+	})).Return(nil)
+	repo.On("DeleteThrottle", mock.Anything, lockouts.Address, "10.0.0.1").Return(nil)
+
+	err := h.HandleUnlock(context.Background(), commands.UnlockCommand{Scope: "address", Key: "10.0.0.1", ActorId: actorId})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, uow.committed)
+	repo.AssertExpectations(t)
+}
+
+func TestHandleUnlock_Invalid(t *testing.T) {
+	cases := []struct {
+		name string
+		cmd  commands.UnlockCommand
+		want error
+	}{
+		{"Unknown scope", commands.UnlockCommand{Scope: "device", Key: "key"}, lockouts.ErrNotAScopeLockout},
+		{"Empty key", commands.UnlockCommand{Scope: "account"}, lockouts.ErrEmptyKeyLockout},
+		{"Not locked", commands.UnlockCommand{Scope: "account", Key: accountKey}, lockouts.ErrNotLockedLockout},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			h, repo, _ := newHandler(time.Now())
+			repo.On("GetThrottle", mock.Anything, lockouts.Account, accountKey).Return(lockouts.NewThrottle(lockouts.Account, accountKey), nil).Maybe()
+
+			err := h.HandleUnlock(context.Background(), tc.cmd)
+
+			assert.ErrorIs(t, err, tc.want)
+			repo.AssertNotCalled(t, "DeleteThrottle", mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
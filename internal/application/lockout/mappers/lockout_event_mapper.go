@@ -0,0 +1,25 @@
+package mappers
+
+import (
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/lockout/dto"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/lockout"
+)
+
+func MapToLockoutEventDTO(event *lockouts.Event) *dto.LockoutEventDTO {
+	var actorId *string
+	if id := event.ActorId(); id != nil {
+		s := id.String()
+		actorId = &s
+	}
+
+	return &dto.LockoutEventDTO{
+		Id:          event.Id().String(),
+		Scope:       event.Scope().String(),
+		Key:         event.Key(),
+		Type:        event.Type().String(),
+		Failures:    event.Failures(),
+		LockedUntil: event.LockedUntil(),
+		ActorId:     actorId,
+		CreatedAt:   event.CreatedAt(),
+	}
+}
@@ -0,0 +1,36 @@
+package mappers
+
+import (
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/lockout"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestMapToLockoutEventDTO(t *testing.T) {
+	th := lockouts.NewThrottle(lockouts.Account, "A:john@doe.com")
+	now := time.Now()
+	for range lockouts.AccountPolicy.Threshold {
+		th.Fail(now, lockouts.AccountPolicy)
+	}
+
+	locked := MapToLockoutEventDTO(lockouts.NewLockedEvent(th))
+
+	assert.Equal(t, "account", locked.Scope)
+	assert.Equal(t, "A:john@doe.com", locked.Key)
+	assert.Equal(t, "locked", locked.Type)
+	assert.Equal(t, lockouts.AccountPolicy.Threshold, locked.Failures)
+	assert.Equal(t, th.LockedUntil(), locked.LockedUntil)
+	assert.Nil(t, locked.ActorId)
+
+	actorId := uuid.New()
+	e := lockouts.NewUnlockedEvent(th, &actorId)
+	unlocked := MapToLockoutEventDTO(e)
+
+	assert.Equal(t, e.Id().String(), unlocked.Id)
+	assert.Equal(t, "unlocked", unlocked.Type)
+	assert.Nil(t, unlocked.LockedUntil)
+	assert.Equal(t, actorId.String(), *unlocked.ActorId)
+	assert.Equal(t, e.CreatedAt(), unlocked.CreatedAt)
+}
@@ -0,0 +1,15 @@
+package queries
+
+import (
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/abstractions"
+	"time"
+)
+
+type GetLockoutEventsQuery struct {
+	Scope      string
+	Key        string
+	Type       string
+	From       *time.Time
+	To         *time.Time
+	Pagination abstractions.Pagination
+}
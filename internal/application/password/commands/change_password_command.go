@@ -0,0 +1,13 @@
+package commands
+
+import (
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/token"
+	"github.com/google/uuid"
+)
+
+type ChangePasswordCommand struct {
+	SubjectId   uuid.UUID
+	Role        tokens.Role
+	OldPassword string
+	NewPassword string
+}
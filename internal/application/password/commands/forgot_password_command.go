@@ -0,0 +1,8 @@
+package commands
+
+import "github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/token"
+
+type ForgotPasswordCommand struct {
+	Role  tokens.Role
+	Email string
+}
@@ -0,0 +1,6 @@
+package commands
+
+type ResetPasswordCommand struct {
+	Token       string
+	NewPassword string
+}
@@ -0,0 +1,52 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/password/commands"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/token"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	"github.com/google/uuid"
+	"log"
+)
+
+func (h *PasswordHandler) HandleChangePassword(ctx context.Context, cmd commands.ChangePasswordCommand) error {
+	if cmd.SubjectId == uuid.Nil {
+		log.Printf("[handler:password][HandleChangePassword] subject id is nil")
+		return tokens.ErrEmptySubjectIdToken
+	}
+
+	password, err := valueobjects.NewPassword(cmd.NewPassword)
+	if err != nil {
+		log.Printf("[handler:password][HandleChangePassword] error parsing new password: %v", err)
+		return err
+	}
+
+	acc, err := h.accountById(ctx, cmd.Role, cmd.SubjectId)
+	if err != nil {
+		log.Printf("[handler:password][HandleChangePassword] error getting %s '%s': %v", cmd.Role, cmd.SubjectId, err)
+		return err
+	}
+
+	if !acc.password.Matches(cmd.OldPassword) {
+		log.Printf("[handler:password][HandleChangePassword] wrong current password for %s '%s'", cmd.Role, cmd.SubjectId)
+		return ErrWrongPassword
+	}
+
+	if acc.password.Matches(cmd.NewPassword) {
+		log.Printf("[handler:password][HandleChangePassword] new password equals the current one for %s '%s'", cmd.Role, cmd.SubjectId)
+		return ErrSamePassword
+	}
+
+	hashed, err := password.Hash()
+	if err != nil {
+		log.Printf("[handler:password][HandleChangePassword] error hashing password: %v", err)
+		return err
+	}
+
+	if err = acc.save(ctx, hashed); err != nil {
+		log.Printf("[handler:password][HandleChangePassword] error saving password of %s '%s': %v", cmd.Role, cmd.SubjectId, err)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,108 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/password/commands"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/administrator"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/patient"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/token"
+	vo "github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+)
+
+func TestHandleChangePassword_Administrator(t *testing.T) {
+	f := newFixture()
+	admin := newAdministrator(t)
+
+	f.admins.On("GetById", mock.Anything, admin.Id()).Return(admin, nil)
+	f.admins.On("Update", mock.Anything, admin).Return(admin, nil)
+
+	err := f.handler.HandleChangePassword(context.Background(), commands.ChangePasswordCommand{
+		SubjectId:   admin.Id(),
+		Role:        tokens.SuperAdministrator,
+		OldPassword: currentPassword,
+		NewPassword: "Renewed2@",
+	})
+
+	assert.NoError(t, err)
+	assert.True(t, admin.Password().Matches("Renewed2@"))
+	f.admins.AssertExpectations(t)
+}
+
+func TestHandleChangePassword_Patient(t *testing.T) {
+	f := newFixture()
+	ptn := newPatient(t)
+
+	f.patients.On("GetById", mock.Anything, ptn.Id()).Return(ptn, nil)
+	f.patients.On("Update", mock.Anything, ptn).Return(ptn, nil)
+
+	err := f.handler.HandleChangePassword(context.Background(), commands.ChangePasswordCommand{
+		SubjectId:   ptn.Id(),
+		Role:        tokens.Patient,
+		OldPassword: currentPassword,
+		NewPassword: "Renewed2@",
+	})
+
+	assert.NoError(t, err)
+	assert.True(t, ptn.Password().Matches("Renewed2@"))
+	f.patients.AssertExpectations(t)
+}
+
+func TestHandleChangePassword_Errors(t *testing.T) {
+	admin := newAdministrator(t)
+
+	cases := []struct {
+		name          string
+		subjectId     uuid.UUID
+		old, new      string
+		getErr, upErr error
+		want          error
+	}{
+		{"Nil subject", uuid.Nil, currentPassword, "Renewed2@", nil, nil, tokens.ErrEmptySubjectIdToken},
+		{"Weak password", admin.Id(), currentPassword, "weak", nil, nil, vo.ErrShortPassword},
+		{"Not found", admin.Id(), currentPassword, "Renewed2@", administrators.ErrNotFoundAdministrator, nil, administrators.ErrNotFoundAdministrator},
+		{"Wrong password", admin.Id(), "Wrong123!", "Renewed2@", nil, nil, ErrWrongPassword},
+		{"Same password", admin.Id(), currentPassword, currentPassword, nil, nil, ErrSamePassword},
+		{"Update failure", admin.Id(), currentPassword, "Renewed2@", nil, ErrDbFailurePassword, ErrDbFailurePassword},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			f := newFixture()
+			if tc.getErr != nil {
+				f.admins.On("GetById", mock.Anything, tc.subjectId).Return(nil, tc.getErr)
+			} else {
+				f.admins.On("GetById", mock.Anything, tc.subjectId).Return(newAdministrator(t), nil).Maybe()
+			}
+			f.admins.On("Update", mock.Anything, mock.Anything).Return(nil, tc.upErr).Maybe()
+
+			err := f.handler.HandleChangePassword(context.Background(), commands.ChangePasswordCommand{
+				SubjectId:   tc.subjectId,
+				Role:        tokens.Administrator,
+				OldPassword: tc.old,
+				NewPassword: tc.new,
+			})
+
+			assert.ErrorIs(t, err, tc.want)
+		})
+	}
+}
+
+func TestHandleChangePassword_PatientNotFound(t *testing.T) {
+	f := newFixture()
+	id := uuid.New()
+
+	f.patients.On("GetById", mock.Anything, id).Return(nil, patients.ErrNotFoundPatient)
+
+	err := f.handler.HandleChangePassword(context.Background(), commands.ChangePasswordCommand{
+		SubjectId:   id,
+		Role:        tokens.Patient,
+		OldPassword: currentPassword,
+		NewPassword: "Renewed2@",
+	})
+
+	assert.ErrorIs(t, err, patients.ErrNotFoundPatient)
+}
@@ -0,0 +1,63 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/password/commands"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/abstractions"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/token"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	"log"
+	"time"
+)
+
+// HandleForgotPassword sends a reset token to the owner of the email. Unknown
+// emails are ignored so the endpoint cannot be used to probe for accounts.
+func (h *PasswordHandler) HandleForgotPassword(ctx context.Context, cmd commands.ForgotPasswordCommand) error {
+	email, err := valueobjects.NewEmail(cmd.Email)
+	if err != nil {
+		log.Printf("[handler:password][HandleForgotPassword] error parsing email '%s': %v", cmd.Email, err)
+		return err
+	}
+
+	acc, err := h.accountByEmail(ctx, cmd.Role, email.Value())
+	if err != nil {
+		log.Printf("[handler:password][HandleForgotPassword] error getting %s by email: %v", cmd.Role, err)
+		return err
+	} else if acc == nil {
+		log.Printf("[handler:password][HandleForgotPassword] no %s uses email '%s'", cmd.Role, email.Value())
+		return nil
+	}
+
+	secret, err := tokens.NewResetSecret()
+	if err != nil {
+		log.Printf("[handler:password][HandleForgotPassword] error generating reset secret: %v", err)
+		return err
+	}
+
+	reset := tokens.NewResetToken(acc.id, cmd.Role, tokens.HashResetSecret(secret), h.now().Add(h.ttl))
+
+	err = h.uow.Do(ctx, func(ctx context.Context) error {
+		if _, err := h.resets.InvalidateBySubject(ctx, acc.id); err != nil {
+			return err
+		}
+		_, err := h.resets.Create(ctx, reset)
+		return err
+	})
+	if err != nil {
+		log.Printf("[handler:password][HandleForgotPassword] error storing reset token for '%s': %v", acc.id, err)
+		return err
+	}
+
+	err = h.notifier.Notify(ctx, abstractions.Notification{
+		To:      acc.email,
+		Subject: "Password reset",
+		Body:    fmt.Sprintf("Use the token %s to choose a new password. It expires at %s.", secret, reset.ExpiresAt().Format(time.RFC3339)),
+	})
+	if err != nil {
+		log.Printf("[handler:password][HandleForgotPassword] error notifying '%s': %v", acc.id, err)
+		return err
+	}
+
+	return nil
+}
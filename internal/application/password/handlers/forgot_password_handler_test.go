@@ -0,0 +1,102 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/password/commands"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/token"
+	vo "github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestHandleForgotPassword(t *testing.T) {
+	f := newFixture()
+	ptn := newPatient(t)
+	now := time.Now()
+	f.handler.now = func() time.Time { return now }
+
+	var stored *tokens.ResetToken
+	f.patients.On("ExistByEmail", mock.Anything, "patient@example.com").Return(true, nil)
+	f.patients.On("GetByEmail", mock.Anything, "patient@example.com").Return(ptn, nil)
+	f.resets.On("InvalidateBySubject", mock.Anything, ptn.Id()).Return(1, nil)
+	f.resets.On("Create", mock.Anything, mock.AnythingOfType("*tokens.ResetToken")).
+		Run(func(args mock.Arguments) { stored = args.Get(1).(*tokens.ResetToken) }).
+		Return(nil, nil)
+
+	err := f.handler.HandleForgotPassword(context.Background(), commands.ForgotPasswordCommand{Role: tokens.Patient, Email: "patient@example.com"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, f.uow.committed)
+	assert.Len(t, f.notifier.sent, 1)
+
+	msg := f.notifier.sent[0]
+	assert.Equal(t, "patient@example.com", msg.To)
+
+	assert.NotNil(t, stored)
+	assert.Equal(t, ptn.Id(), stored.SubjectId())
+	assert.Equal(t, now.Add(time.Hour), stored.ExpiresAt())
+
+	secret := strings.Fields(msg.Body)[3]
+	assert.Equal(t, tokens.HashResetSecret(secret), stored.Hash())
+	assert.NotContains(t, msg.Body, stored.Hash())
+
+	f.patients.AssertExpectations(t)
+	f.resets.AssertExpectations(t)
+}
+
+func TestHandleForgotPassword_UnknownEmail(t *testing.T) {
+	f := newFixture()
+
+	f.admins.On("ExistByEmail", mock.Anything, "ghost@example.com").Return(false, nil)
+
+	err := f.handler.HandleForgotPassword(context.Background(), commands.ForgotPasswordCommand{Role: tokens.Administrator, Email: "ghost@example.com"})
+
+	assert.NoError(t, err)
+	assert.Empty(t, f.notifier.sent)
+	assert.Zero(t, f.uow.committed)
+	f.resets.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestHandleForgotPassword_Errors(t *testing.T) {
+	t.Run("Invalid email", func(t *testing.T) {
+		f := newFixture()
+
+		err := f.handler.HandleForgotPassword(context.Background(), commands.ForgotPasswordCommand{Role: tokens.Administrator, Email: "invalid"})
+
+		assert.ErrorIs(t, err, vo.ErrInvalidEmail)
+	})
+
+	t.Run("Store failure", func(t *testing.T) {
+		f := newFixture()
+		admin := newAdministrator(t)
+
+		f.admins.On("ExistByEmail", mock.Anything, "admin@example.com").Return(true, nil)
+		f.admins.On("GetByEmail", mock.Anything, "admin@example.com").Return(admin, nil)
+		f.resets.On("InvalidateBySubject", mock.Anything, admin.Id()).Return(0, nil)
+		f.resets.On("Create", mock.Anything, mock.Anything).Return(nil, ErrDbFailurePassword)
+
+		err := f.handler.HandleForgotPassword(context.Background(), commands.ForgotPasswordCommand{Role: tokens.Administrator, Email: "admin@example.com"})
+
+		assert.ErrorIs(t, err, ErrDbFailurePassword)
+		assert.Equal(t, 1, f.uow.rolledBack)
+		assert.Empty(t, f.notifier.sent)
+	})
+
+	t.Run("Notifier failure", func(t *testing.T) {
+		f := newFixture()
+		admin := newAdministrator(t)
+		f.notifier.err = ErrDbFailurePassword
+
+		f.admins.On("ExistByEmail", mock.Anything, "admin@example.com").Return(true, nil)
+		f.admins.On("GetByEmail", mock.Anything, "admin@example.com").Return(admin, nil)
+		f.resets.On("InvalidateBySubject", mock.Anything, admin.Id()).Return(0, nil)
+		f.resets.On("Create", mock.Anything, mock.Anything).Return(nil, nil)
+
+		err := f.handler.HandleForgotPassword(context.Background(), commands.ForgotPasswordCommand{Role: tokens.Administrator, Email: "admin@example.com"})
+
+		assert.ErrorIs(t, err, ErrDbFailurePassword)
+	})
+}
@@ -0,0 +1,125 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/abstractions"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/administrator"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/patient"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/token"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	"github.com/google/uuid"
+	"time"
+)
+
+const DefaultResetTTL = 30 * time.Minute
+
+var (
+	ErrWrongPassword     = errors.New("current password does not match")
+	ErrSamePassword      = errors.New("new password must be different from the current one")
+	ErrInvalidResetToken = errors.New("reset token is invalid, expired or already used")
+)
+
+type PasswordHandler struct {
+	administrators administrators.AdministratorRepository
+	patients       patients.PatientRepository
+	resets         tokens.ResetTokenRepository
+	sessions       tokens.TokenRepository
+	notifier       abstractions.Notifier
+	uow            abstractions.UnitOfWork
+	ttl            time.Duration
+	now            func() time.Time
+}
+
+func NewPasswordHandler(a administrators.AdministratorRepository, p patients.PatientRepository, r tokens.ResetTokenRepository, s tokens.TokenRepository, n abstractions.Notifier, u abstractions.UnitOfWork, ttl time.Duration) *PasswordHandler {
+	if ttl <= 0 {
+		ttl = DefaultResetTTL
+	}
+	return &PasswordHandler{
+		administrators: a,
+		patients:       p,
+		resets:         r,
+		sessions:       s,
+		notifier:       n,
+		uow:            u,
+		ttl:            ttl,
+		now:            time.Now,
+	}
+}
+
+type account struct {
+	id       uuid.UUID
+	email    string
+	password valueobjects.Password
+	save     func(ctx context.Context, password valueobjects.Password) error
+}
+
+func (h *PasswordHandler) accountById(ctx context.Context, role tokens.Role, id uuid.UUID) (*account, error) {
+	switch {
+	case role.IsAdministrator():
+		admin, err := h.administrators.GetById(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		return h.administratorAccount(admin), nil
+	case role == tokens.Patient:
+		ptn, err := h.patients.GetById(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		return h.patientAccount(ptn), nil
+	}
+	return nil, tokens.ErrNotARoleToken
+}
+
+func (h *PasswordHandler) accountByEmail(ctx context.Context, role tokens.Role, email string) (*account, error) {
+	switch {
+	case role.IsAdministrator():
+		exist, err := h.administrators.ExistByEmail(ctx, email)
+		if err != nil || !exist {
+			return nil, err
+		}
+		admin, err := h.administrators.GetByEmail(ctx, email)
+		if err != nil {
+			return nil, err
+		}
+		return h.administratorAccount(admin), nil
+	case role == tokens.Patient:
+		exist, err := h.patients.ExistByEmail(ctx, email)
+		if err != nil || !exist {
+			return nil, err
+		}
+		ptn, err := h.patients.GetByEmail(ctx, email)
+		if err != nil {
+			return nil, err
+		}
+		return h.patientAccount(ptn), nil
+	}
+	return nil, tokens.ErrNotARoleToken
+}
+
+func (h *PasswordHandler) administratorAccount(admin *administrators.Administrator) *account {
+	return &account{
+		id:       admin.Id(),
+		email:    admin.Email().Value(),
+		password: admin.Password(),
+		save: func(ctx context.Context, password valueobjects.Password) error {
+			admin.ChangePassword(password)
+			_, err := h.administrators.Update(ctx, admin)
+			return err
+		},
+	}
+}
+
+func (h *PasswordHandler) patientAccount(ptn *patients.Patient) *account {
+	return &account{
+		id:       ptn.Id(),
+		email:    ptn.Email().Value(),
+		password: ptn.Password(),
+		save: func(ctx context.Context, password valueobjects.Password) error {
+			ptn.ChangePassword(password)
+			_, err := h.patients.Update(ctx, ptn)
+			return err
+		},
+	}
+}
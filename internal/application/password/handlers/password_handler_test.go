@@ -0,0 +1,230 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/abstractions"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/administrator"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/patient"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/token"
+	vo "github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+const currentPassword = "Current1!"
+
+var ErrDbFailurePassword = errors.New("database failure")
+
+// The repository mocks embed the interfaces so only the methods the password
+// flows call need an implementation.
+type MockAdministratorRepository struct {
+	mock.Mock
+	administrators.AdministratorRepository
+}
+
+type MockPatientRepository struct {
+	mock.Mock
+	patients.PatientRepository
+}
+
+type MockResetTokenRepository struct {
+	mock.Mock
+}
+
+type MockTokenRepository struct {
+	mock.Mock
+	tokens.TokenRepository
+}
+
+type MockNotifier struct {
+	sent []abstractions.Notification
+	err  error
+}
+
+type MockUnitOfWork struct {
+	committed  int
+	rolledBack int
+}
+
+func (m *MockAdministratorRepository) GetById(ctx context.Context, id uuid.UUID) (*administrators.Administrator, error) {
+	args := m.Called(ctx, id)
+	if v := args.Get(0); v != nil {
+		return v.(*administrators.Administrator), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockAdministratorRepository) GetByEmail(ctx context.Context, email string) (*administrators.Administrator, error) {
+	args := m.Called(ctx, email)
+	if v := args.Get(0); v != nil {
+		return v.(*administrators.Administrator), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockAdministratorRepository) ExistByEmail(ctx context.Context, email string) (bool, error) {
+	args := m.Called(ctx, email)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockAdministratorRepository) Update(ctx context.Context, admin *administrators.Administrator) (*administrators.Administrator, error) {
+	args := m.Called(ctx, admin)
+	if v := args.Get(0); v != nil {
+		return v.(*administrators.Administrator), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockPatientRepository) GetById(ctx context.Context, id uuid.UUID) (*patients.Patient, error) {
+	args := m.Called(ctx, id)
+	if v := args.Get(0); v != nil {
+		return v.(*patients.Patient), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockPatientRepository) GetByEmail(ctx context.Context, email string) (*patients.Patient, error) {
+	args := m.Called(ctx, email)
+	if v := args.Get(0); v != nil {
+		return v.(*patients.Patient), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockPatientRepository) ExistByEmail(ctx context.Context, email string) (bool, error) {
+	args := m.Called(ctx, email)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockPatientRepository) Update(ctx context.Context, ptn *patients.Patient) (*patients.Patient, error) {
+	args := m.Called(ctx, ptn)
+	if v := args.Get(0); v != nil {
+		return v.(*patients.Patient), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockResetTokenRepository) GetByHash(ctx context.Context, hash string) (*tokens.ResetToken, error) {
+	args := m.Called(ctx, hash)
+	if v := args.Get(0); v != nil {
+		return v.(*tokens.ResetToken), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockResetTokenRepository) Create(ctx context.Context, t *tokens.ResetToken) (*tokens.ResetToken, error) {
+	args := m.Called(ctx, t)
+	if v := args.Get(0); v != nil {
+		return v.(*tokens.ResetToken), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockResetTokenRepository) Use(ctx context.Context, id uuid.UUID) (bool, error) {
+	args := m.Called(ctx, id)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockResetTokenRepository) InvalidateBySubject(ctx context.Context, subjectId uuid.UUID) (int, error) {
+	args := m.Called(ctx, subjectId)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockTokenRepository) RevokeBySubject(ctx context.Context, subjectId uuid.UUID) (int, error) {
+	args := m.Called(ctx, subjectId)
+	return args.Int(0), args.Error(1)
+}
+
+func (n *MockNotifier) Notify(_ context.Context, msg abstractions.Notification) error {
+	if n.err != nil {
+		return n.err
+	}
+	n.sent = append(n.sent, msg)
+	return nil
+}
+
+func (u *MockUnitOfWork) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	if err := fn(ctx); err != nil {
+		u.rolledBack++
+		return err
+	}
+	u.committed++
+	return nil
+}
+
+type fixture struct {
+	admins   *MockAdministratorRepository
+	patients *MockPatientRepository
+	resets   *MockResetTokenRepository
+	sessions *MockTokenRepository
+	notifier *MockNotifier
+	uow      *MockUnitOfWork
+	handler  *PasswordHandler
+}
+
+func newFixture() *fixture {
+	f := &fixture{
+		admins:   new(MockAdministratorRepository),
+		patients: new(MockPatientRepository),
+		resets:   new(MockResetTokenRepository),
+		sessions: new(MockTokenRepository),
+		notifier: new(MockNotifier),
+		uow:      new(MockUnitOfWork),
+	}
+	f.handler = NewPasswordHandler(f.admins, f.patients, f.resets, f.sessions, f.notifier, f.uow, time.Hour)
+	return f
+}
+
+func hashed(t *testing.T, plain string) vo.Password {
+	p, err := vo.NewPassword(plain)
+	assert.NoError(t, err)
+
+	h, err := p.Hash()
+	assert.NoError(t, err)
+	return h
+}
+
+func newAdministrator(t *testing.T) *administrators.Administrator {
+	email, err := vo.NewEmail("admin@example.com")
+	assert.NoError(t, err)
+	gender, err := vo.ParseGender("female")
+	assert.NoError(t, err)
+	birth, err := vo.NewBirthDate(time.Now().AddDate(-30, 0, 0))
+	assert.NoError(t, err)
+
+	return administrators.NewAdministrator("Jane", "Doe", email, hashed(t, currentPassword), gender, birth, nil)
+}
+
+func newPatient(t *testing.T) *patients.Patient {
+	email, err := vo.NewEmail("patient@example.com")
+	assert.NoError(t, err)
+	gender, err := vo.ParseGender("male")
+	assert.NoError(t, err)
+	birth, err := vo.NewBirthDate(time.Now().AddDate(-30, 0, 0))
+	assert.NoError(t, err)
+
+	return patients.NewPatient("John", "Doe", email, hashed(t, currentPassword), gender, birth, nil)
+}
+
+func TestNewPasswordHandler(t *testing.T) {
+	h := NewPasswordHandler(nil, nil, nil, nil, nil, nil, 0)
+
+	assert.NotNil(t, h)
+	assert.Equal(t, DefaultResetTTL, h.ttl)
+}
+
+func TestPasswordHandler_UnknownRole(t *testing.T) {
+	f := newFixture()
+
+	acc, err := f.handler.accountById(context.Background(), tokens.Role("X"), uuid.New())
+	assert.Nil(t, acc)
+	assert.ErrorIs(t, err, tokens.ErrNotARoleToken)
+
+	acc, err = f.handler.accountByEmail(context.Background(), tokens.Role("X"), "a@example.com")
+	assert.Nil(t, acc)
+	assert.ErrorIs(t, err, tokens.ErrNotARoleToken)
+}
@@ -0,0 +1,71 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/password/commands"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/token"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	"log"
+)
+
+func (h *PasswordHandler) HandleResetPassword(ctx context.Context, cmd commands.ResetPasswordCommand) error {
+	if cmd.Token == "" {
+		log.Printf("[handler:password][HandleResetPassword] empty reset token")
+		return ErrInvalidResetToken
+	}
+
+	password, err := valueobjects.NewPassword(cmd.NewPassword)
+	if err != nil {
+		log.Printf("[handler:password][HandleResetPassword] error parsing new password: %v", err)
+		return err
+	}
+
+	reset, err := h.resets.GetByHash(ctx, tokens.HashResetSecret(cmd.Token))
+	if errors.Is(err, tokens.ErrNotFoundToken) {
+		log.Printf("[handler:password][HandleResetPassword] unknown reset token")
+		return fmt.Errorf("%w: %w", ErrInvalidResetToken, err)
+	} else if err != nil {
+		log.Printf("[handler:password][HandleResetPassword] error getting reset token: %v", err)
+		return err
+	}
+
+	if err = reset.Validate(h.now()); err != nil {
+		log.Printf("[handler:password][HandleResetPassword] reset token '%s' rejected: %v", reset.Id(), err)
+		return fmt.Errorf("%w: %w", ErrInvalidResetToken, err)
+	}
+
+	hashed, err := password.Hash()
+	if err != nil {
+		log.Printf("[handler:password][HandleResetPassword] error hashing password: %v", err)
+		return err
+	}
+
+	err = h.uow.Do(ctx, func(ctx context.Context) error {
+		used, err := h.resets.Use(ctx, reset.Id())
+		if err != nil {
+			return err
+		} else if !used {
+			return fmt.Errorf("%w: %w", ErrInvalidResetToken, tokens.ErrUsedResetToken)
+		}
+
+		acc, err := h.accountById(ctx, reset.Role(), reset.SubjectId())
+		if err != nil {
+			return err
+		}
+
+		if err = acc.save(ctx, hashed); err != nil {
+			return err
+		}
+
+		_, err = h.sessions.RevokeBySubject(ctx, reset.SubjectId())
+		return err
+	})
+	if err != nil {
+		log.Printf("[handler:password][HandleResetPassword] error resetting password of '%s': %v", reset.SubjectId(), err)
+		return err
+	}
+
+	return nil
+}
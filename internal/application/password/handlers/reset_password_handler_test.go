@@ -0,0 +1,108 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/password/commands"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/token"
+	vo "github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+func TestHandleResetPassword(t *testing.T) {
+	f := newFixture()
+	admin := newAdministrator(t)
+	reset := tokens.NewResetToken(admin.Id(), tokens.Administrator, tokens.HashResetSecret("secret"), time.Now().Add(time.Hour))
+
+	f.resets.On("GetByHash", mock.Anything, reset.Hash()).Return(reset, nil)
+	f.resets.On("Use", mock.Anything, reset.Id()).Return(true, nil)
+	f.admins.On("GetById", mock.Anything, admin.Id()).Return(admin, nil)
+	f.admins.On("Update", mock.Anything, admin).Return(admin, nil)
+	f.sessions.On("RevokeBySubject", mock.Anything, admin.Id()).Return(2, nil)
+
+	err := f.handler.HandleResetPassword(context.Background(), commands.ResetPasswordCommand{Token: "secret", NewPassword: "Renewed2@"})
+
+	assert.NoError(t, err)
+	assert.True(t, admin.Password().Matches("Renewed2@"))
+	assert.Equal(t, 1, f.uow.committed)
+
+	f.resets.AssertExpectations(t)
+	f.admins.AssertExpectations(t)
+	f.sessions.AssertExpectations(t)
+}
+
+func TestHandleResetPassword_Rejected(t *testing.T) {
+	now := time.Now()
+	expired := tokens.NewResetToken(newPatient(t).Id(), tokens.Patient, "hash", now.Add(-time.Minute))
+	used := tokens.NewResetToken(newPatient(t).Id(), tokens.Patient, "hash", now.Add(time.Hour))
+	used.Use()
+
+	cases := []struct {
+		name   string
+		token  string
+		reset  *tokens.ResetToken
+		getErr error
+		want   error
+	}{
+		{"Empty token", "", nil, nil, ErrInvalidResetToken},
+		{"Unknown token", "secret", nil, tokens.ErrNotFoundToken, tokens.ErrNotFoundToken},
+		{"Expired token", "secret", expired, nil, tokens.ErrExpiredToken},
+		{"Used token", "secret", used, nil, tokens.ErrUsedResetToken},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			f := newFixture()
+			f.resets.On("GetByHash", mock.Anything, tokens.HashResetSecret(tc.token)).Return(tc.reset, tc.getErr).Maybe()
+
+			err := f.handler.HandleResetPassword(context.Background(), commands.ResetPasswordCommand{Token: tc.token, NewPassword: "Renewed2@"})
+
+			assert.ErrorIs(t, err, ErrInvalidResetToken)
+			assert.ErrorIs(t, err, tc.want)
+			assert.Zero(t, f.uow.committed)
+		})
+	}
+}
+
+func TestHandleResetPassword_WeakPassword(t *testing.T) {
+	f := newFixture()
+
+	err := f.handler.HandleResetPassword(context.Background(), commands.ResetPasswordCommand{Token: "secret", NewPassword: "softpassword"})
+
+	assert.ErrorIs(t, err, vo.ErrSoftPassword)
+}
+
+func TestHandleResetPassword_ConcurrentUse(t *testing.T) {
+	f := newFixture()
+	ptn := newPatient(t)
+	reset := tokens.NewResetToken(ptn.Id(), tokens.Patient, tokens.HashResetSecret("secret"), time.Now().Add(time.Hour))
+
+	f.resets.On("GetByHash", mock.Anything, reset.Hash()).Return(reset, nil)
+	f.resets.On("Use", mock.Anything, reset.Id()).Return(false, nil)
+
+	err := f.handler.HandleResetPassword(context.Background(), commands.ResetPasswordCommand{Token: "secret", NewPassword: "Renewed2@"})
+
+	assert.ErrorIs(t, err, ErrInvalidResetToken)
+	assert.ErrorIs(t, err, tokens.ErrUsedResetToken)
+	assert.Equal(t, 1, f.uow.rolledBack)
+	f.patients.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+}
+
+func TestHandleResetPassword_SaveFailureRollsBack(t *testing.T) {
+	f := newFixture()
+	ptn := newPatient(t)
+	reset := tokens.NewResetToken(ptn.Id(), tokens.Patient, tokens.HashResetSecret("secret"), time.Now().Add(time.Hour))
+
+	f.resets.On("GetByHash", mock.Anything, reset.Hash()).Return(reset, nil)
+	f.resets.On("Use", mock.Anything, reset.Id()).Return(true, nil)
+	f.patients.On("GetById", mock.Anything, ptn.Id()).Return(ptn, nil)
+	f.patients.On("Update", mock.Anything, ptn).Return(nil, ErrDbFailurePassword)
+
+	err := f.handler.HandleResetPassword(context.Background(), commands.ResetPasswordCommand{Token: "secret", NewPassword: "Renewed2@"})
+
+	assert.ErrorIs(t, err, ErrDbFailurePassword)
+	assert.Equal(t, 1, f.uow.rolledBack)
+	f.sessions.AssertNotCalled(t, "RevokeBySubject", mock.Anything, mock.Anything)
+}
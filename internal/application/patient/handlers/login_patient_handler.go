@@ -7,7 +7,6 @@ import (
 	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/patient/mappers"
 	patients "github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/patient"
 	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
-	"golang.org/x/crypto/bcrypt"
 	"log"
 )
 
@@ -24,13 +23,8 @@ func (h *PatientHandler) HandleLogin(ctx context.Context, cmd commands.LoginPati
 		return nil, err
 	} else if !exist {
 		log.Printf("[handler:patient][HandleLogin] the Patient doesn't exist '%v'", cmd.Email)
-		return nil, patients.ErrNotFoundPatient
-	}
-
-	password, err := valueobjects.NewPassword(cmd.Password)
-	if err != nil {
-		log.Printf("[handler:patient][HandleUpdate] error parsing password: %v", err)
-		return nil, err
+		valueobjects.SimulatePasswordMatch(cmd.Password)
+		return nil, patients.ErrInvalidCredentialsPatient
 	}
 
 	patient, err := h.repository.GetByEmail(ctx, email.Value())
@@ -38,7 +32,7 @@ func (h *PatientHandler) HandleLogin(ctx context.Context, cmd commands.LoginPati
 		return nil, err
 	}
 
-	if err = bcrypt.CompareHashAndPassword([]byte(patient.Password().String()), []byte(password.String())); err != nil {
+	if !patient.Password().Matches(cmd.Password) {
 		log.Printf("[handler:patient][HandleLogin] invalid credentials for email=%s", cmd.Email)
 		return nil, patients.ErrInvalidCredentialsPatient
 	}
@@ -3,6 +3,7 @@ package handlers
 import (
 	"context"
 	"errors"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/abstractions"
 	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/patient"
 	vo "github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
 	"github.com/google/uuid"
@@ -41,11 +42,11 @@ func (m *MockFactory) Create(firstName string, lastName string, email vo.Email,
 	return result, args.Error(1)
 }
 
-func (m *MockRepository) GetAll(ctx context.Context) ([]*patients.Patient, error) {
+func (m *MockRepository) GetAll(ctx context.Context, filter patients.PatientFilter, pagination abstractions.Pagination) (*abstractions.Page[*patients.Patient], error) {
 	return nil, nil
 }
 
-func (m *MockRepository) GetList(ctx context.Context) ([]*patients.Patient, error) {
+func (m *MockRepository) GetList(ctx context.Context, filter patients.PatientFilter, pagination abstractions.Pagination) (*abstractions.Page[*patients.Patient], error) {
 	return nil, nil
 }
 
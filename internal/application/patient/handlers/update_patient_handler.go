@@ -35,12 +35,6 @@ func (h *PatientHandler) HandleUpdate(ctx context.Context, cmd commands.UpdatePa
 		return nil, patients.ErrNotFoundPatient
 	}
 
-	password, err := valueobjects.NewPassword(cmd.Password)
-	if err != nil {
-		log.Printf("[handler:patient][HandleUpdate] error parsing password: %v", err)
-		return nil, err
-	}
-
 	gender, err := valueobjects.ParseGender(cmd.Gender)
 	if err != nil {
 		log.Printf("[handler:patient][HandleUpdate] error parsing gender: %v", err)
@@ -59,7 +53,13 @@ func (h *PatientHandler) HandleUpdate(ctx context.Context, cmd commands.UpdatePa
 		return nil, err
 	}
 
-	patient := patients.NewPatient(cmd.FirstName, cmd.LastName, email, password, gender, birth, phone)
+	current, err := h.repository.GetById(ctx, cmd.Id)
+	if err != nil {
+		log.Printf("[handler:patient][HandleUpdate] error getting current Patient '%v': %v", cmd.Id, err)
+		return nil, err
+	}
+
+	patient := patients.NewPatient(cmd.FirstName, cmd.LastName, email, current.Password(), gender, birth, phone)
 	patient.AggregateRoot = abstractions.NewAggregateRoot(cmd.Id)
 
 	patient, err = h.repository.Update(ctx, patient)
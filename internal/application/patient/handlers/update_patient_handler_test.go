@@ -13,6 +13,8 @@ import (
 	"time"
 )
 
+const storedPassword = "$2a$10$3J9wq7F0s8G2bXHkzQvFqO5tLh8mY2nP4rZxN1uVY3sTq6aKbL1Pa"
+
 func TestHandleUpdate(t *testing.T) {
 	ctx := context.Background()
 
@@ -27,7 +29,6 @@ func TestHandleUpdate(t *testing.T) {
 		FirstName: "Jane",
 		LastName:  "Doe",
 		Email:     "jane@example.com",
-		Password:  "Strong1!",
 		Gender:    "female",
 		Birth:     time.Now().AddDate(-25, 0, 0),
 		Phone:     nil,
@@ -38,11 +39,12 @@ func TestHandleUpdate(t *testing.T) {
 		phone, _ = vo.NewPhone(cmd.Phone)
 	}
 
-	email, password, gender, birth := valueObjects(t, cmd.Email, cmd.Password, cmd.Gender, cmd.Birth)
+	email, password, gender, birth := valueObjects(t, cmd.Email, storedPassword, cmd.Gender, cmd.Birth)
 	patient := patients.NewPatient(cmd.FirstName, cmd.LastName, email, password, gender, birth, phone)
 	patient.AggregateRoot = abstractions.NewAggregateRoot(cmd.Id)
 
 	mockRepo.On("ExistById", mock.Anything, cmd.Id).Return(true, nil)
+	mockRepo.On("GetById", mock.Anything, cmd.Id).Return(patient, nil)
 	mockRepo.On("Update", mock.Anything, patient).Return(patient, nil)
 
 	resp, err := handler.HandleUpdate(ctx, cmd)
@@ -74,16 +76,16 @@ func TestHandleUpdate_RepositoryError(t *testing.T) {
 		FirstName: "Jane",
 		LastName:  "Doe",
 		Email:     "jane@example.com",
-		Password:  "Strong1!",
 		Gender:    "female",
 		Birth:     time.Now().AddDate(-25, 0, 0),
 	}
 
-	email, password, gender, birth := valueObjects(t, cmd.Email, cmd.Password, cmd.Gender, cmd.Birth)
+	email, password, gender, birth := valueObjects(t, cmd.Email, storedPassword, cmd.Gender, cmd.Birth)
 	patient := patients.NewPatient(cmd.FirstName, cmd.LastName, email, password, gender, birth, nil)
 	patient.AggregateRoot = abstractions.NewAggregateRoot(cmd.Id)
 
 	mockRepo.On("ExistById", mock.Anything, cmd.Id).Return(true, nil)
+	mockRepo.On("GetById", mock.Anything, cmd.Id).Return(patient, nil)
 	mockRepo.On("Update", mock.Anything, patient).Return(nil, ErrDbFailurePatient)
 
 	resp, err := handler.HandleUpdate(ctx, cmd)
@@ -95,6 +97,33 @@ func TestHandleUpdate_RepositoryError(t *testing.T) {
 	mockRepo.AssertExpectations(t)
 }
 
+func TestHandleUpdate_CurrentError(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(MockRepository)
+	mockFactory := new(MockFactory)
+	handler := NewPatientHandler(mockRepo, mockFactory)
+
+	cmd := commands.UpdatePatientCommand{
+		Id:        uuid.New(),
+		FirstName: "Jane",
+		LastName:  "Doe",
+		Email:     "jane@example.com",
+		Gender:    "female",
+		Birth:     time.Now().AddDate(-25, 0, 0),
+	}
+
+	mockRepo.On("ExistById", mock.Anything, cmd.Id).Return(true, nil)
+	mockRepo.On("GetById", mock.Anything, cmd.Id).Return((*patients.Patient)(nil), ErrDbFailurePatient)
+
+	resp, err := handler.HandleUpdate(ctx, cmd)
+
+	assert.ErrorIs(t, err, ErrDbFailurePatient)
+	assert.Nil(t, resp)
+
+	mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	mockRepo.AssertExpectations(t)
+}
+
 func TestHandleUpdate_IdError(t *testing.T) {
 	ctx := context.Background()
 	mockRepo := new(MockRepository)
@@ -108,7 +137,6 @@ func TestHandleUpdate_IdError(t *testing.T) {
 		FirstName: "Jane",
 		LastName:  "Doe",
 		Email:     "jane@example.com",
-		Password:  "Strong1!",
 		Gender:    "female",
 		Birth:     time.Now().AddDate(-25, 0, 0),
 	}
@@ -134,7 +162,6 @@ func TestHandleUpdate_EmailError(t *testing.T) {
 		FirstName: "Jane",
 		LastName:  "Doe",
 		Email:     "",
-		Password:  "Strong1!",
 		Gender:    "female",
 		Birth:     time.Now().AddDate(-25, 0, 0),
 	}
@@ -182,7 +209,6 @@ func TestHandleUpdate_ExistenceCheck(t *testing.T) {
 				FirstName: "Jane",
 				LastName:  "Doe",
 				Email:     "jane@example.com",
-				Password:  "Strong1!",
 				Gender:    "female",
 				Birth:     time.Now().AddDate(-25, 0, 0),
 				Phone:     nil,
@@ -191,9 +217,10 @@ func TestHandleUpdate_ExistenceCheck(t *testing.T) {
 			mockRepo.On("ExistById", mock.Anything, cmd.Id).Return(tc.idExists, tc.repoError)
 
 			if tc.idExists && tc.repoError == nil {
-				email, password, gender, birth := valueObjects(t, cmd.Email, cmd.Password, cmd.Gender, cmd.Birth)
+				email, password, gender, birth := valueObjects(t, cmd.Email, storedPassword, cmd.Gender, cmd.Birth)
 				patient := patients.NewPatient(cmd.FirstName, cmd.LastName, email, password, gender, birth, nil)
 				patient.AggregateRoot = abstractions.NewAggregateRoot(cmd.Id)
+				mockRepo.On("GetById", mock.Anything, cmd.Id).Return(patient, nil)
 
 				mockRepo.On("Update", mock.Anything, mock.AnythingOfType("*patients.Patient")).Return(patient, nil)
 			}
@@ -220,47 +247,6 @@ func TestHandleUpdate_ExistenceCheck(t *testing.T) {
 	}
 }
 
-func TestHandleUpdate_PasswordError(t *testing.T) {
-	ctx := context.Background()
-	mockRepo := new(MockRepository)
-	mockFactory := new(MockFactory)
-	handler := NewPatientHandler(mockRepo, mockFactory)
-
-	assert.NotNil(t, handler)
-
-	cmd := commands.UpdatePatientCommand{
-		Id:        uuid.New(),
-		FirstName: "Jane",
-		LastName:  "Doe",
-		Email:     "valid@email.com",
-		Password:  "",
-		Gender:    "female",
-		Birth:     time.Now().AddDate(-25, 0, 0),
-	}
-
-	mockRepo.On("ExistById", mock.Anything, cmd.Id).Return(true, nil)
-
-	resp, err := handler.HandleUpdate(ctx, cmd)
-
-	assert.ErrorIs(t, err, vo.ErrEmptyPassword)
-	assert.Nil(t, resp)
-
-	cmd.Password = "Abcdef1!Abcdef1!Abcdef1!Abcdef1!Abcdef1!Abcdef1!Abcdef1!Abcdef1!Abcdef1!"
-	resp, err = handler.HandleUpdate(ctx, cmd)
-	assert.ErrorIs(t, err, vo.ErrLongPassword)
-	assert.Nil(t, resp)
-
-	cmd.Password = "short"
-	resp, err = handler.HandleUpdate(ctx, cmd)
-	assert.ErrorIs(t, err, vo.ErrShortPassword)
-	assert.Nil(t, resp)
-
-	cmd.Password = "softpassword"
-	resp, err = handler.HandleUpdate(ctx, cmd)
-	assert.ErrorIs(t, err, vo.ErrSoftPassword)
-	assert.Nil(t, resp)
-}
-
 func TestHandleUpdate_GenderError(t *testing.T) {
 	ctx := context.Background()
 	mockRepo := new(MockRepository)
@@ -274,7 +260,6 @@ func TestHandleUpdate_GenderError(t *testing.T) {
 		FirstName: "Jane",
 		LastName:  "Doe",
 		Email:     "valid@email.com",
-		Password:  "strong!1S",
 		Gender:    "X",
 		Birth:     time.Now().AddDate(-25, 0, 0),
 	}
@@ -300,7 +285,6 @@ func TestHandleUpdate_BirthError(t *testing.T) {
 		FirstName: "Jane",
 		LastName:  "Doe",
 		Email:     "valid@email.com",
-		Password:  "strong!1S",
 		Gender:    "F",
 		Birth:     time.Now().AddDate(5, 0, 0),
 	}
@@ -333,7 +317,6 @@ func TestHandleUpdate_PhoneError(t *testing.T) {
 		FirstName: "Jane",
 		LastName:  "Doe",
 		Email:     "valid@email.com",
-		Password:  "strong!1S",
 		Gender:    "F",
 		Birth:     time.Now().AddDate(-25, 0, 0),
 		Phone:     &phone,
@@ -1,3 +1,10 @@
 package queries
 
-type GetAllPatientsQuery struct{}
+import "github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/abstractions"
+
+type GetAllPatientsQuery struct {
+	Name       string
+	Gender     string
+	Deleted    *bool
+	Pagination abstractions.Pagination
+}
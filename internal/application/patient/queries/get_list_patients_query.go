@@ -1,3 +1,9 @@
 package queries
 
-type GetListPatientsQuery struct{}
+import "github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/abstractions"
+
+type GetListPatientsQuery struct {
+	Name       string
+	Gender     string
+	Pagination abstractions.Pagination
+}
@@ -0,0 +1,9 @@
+package commands
+
+type CreatePlanCommand struct {
+	Code         string
+	Name         string
+	DurationDays int
+	Pattern      string
+	BasePrice    int
+}
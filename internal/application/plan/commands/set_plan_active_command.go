@@ -0,0 +1,8 @@
+package commands
+
+import "github.com/google/uuid"
+
+type SetPlanActiveCommand struct {
+	Id     uuid.UUID
+	Active bool
+}
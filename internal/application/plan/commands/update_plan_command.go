@@ -0,0 +1,11 @@
+package commands
+
+import "github.com/google/uuid"
+
+type UpdatePlanCommand struct {
+	Id           uuid.UUID
+	Name         string
+	DurationDays int
+	Pattern      string
+	BasePrice    int
+}
@@ -0,0 +1,16 @@
+package dto
+
+import "time"
+
+type PlanDTO struct {
+	Id            string    `json:"id"`
+	Code          string    `json:"code"`
+	Name          string    `json:"name"`
+	DurationDays  int       `json:"durationDays"`
+	Pattern       string    `json:"pattern"`
+	DeliveryCount int       `json:"deliveryCount"`
+	BasePrice     int       `json:"basePrice"`
+	Active        bool      `json:"active"`
+	CreatedAt     time.Time `json:"createdAt"`
+	UpdatedAt     time.Time `json:"updatedAt"`
+}
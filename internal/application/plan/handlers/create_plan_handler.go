@@ -0,0 +1,45 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/plan/commands"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/plan"
+	"log"
+)
+
+func (h *PlanHandler) HandleCreate(ctx context.Context, cmd commands.CreatePlanCommand) (*plans.Plan, error) {
+	pattern, err := plans.ParseDeliveryPattern(cmd.Pattern)
+	if err != nil {
+		log.Printf("[handler:plan][HandleCreate] error parsing delivery pattern: %v", err)
+		return nil, err
+	}
+
+	plan, err := h.factory.Create(cmd.Code, cmd.Name, cmd.DurationDays, pattern, cmd.BasePrice)
+	if err != nil {
+		log.Printf("[handler:plan][HandleCreate] error creating plan factory: %v", err)
+		return nil, err
+	}
+
+	var created *plans.Plan
+	err = h.uow.Do(ctx, func(ctx context.Context) error {
+		exist, err := h.repository.ExistByCode(ctx, cmd.Code)
+		if err != nil {
+			return err
+		}
+
+		if exist {
+			return fmt.Errorf("%w: got %s", plans.ErrExistPlan, cmd.Code)
+		}
+
+		created, err = h.repository.Create(ctx, plan)
+		return err
+	})
+	if err != nil {
+		log.Printf("[handler:plan][HandleCreate] error creating plan '%s': %v", cmd.Code, err)
+		return nil, err
+	}
+
+	log.Printf("[handler:plan][HandleCreate] plan '%s' created", cmd.Code)
+	return created, nil
+}
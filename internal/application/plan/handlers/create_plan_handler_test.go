@@ -0,0 +1,77 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/plan/commands"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/plan"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+)
+
+func TestPlanHandler_HandleCreate(t *testing.T) {
+	mockRepo := new(MockRepository)
+	uow := new(MockUnitOfWork)
+	handler := NewPlanHandler(mockRepo, plans.NewPlanFactory(), uow)
+	cmd := commands.CreatePlanCommand{Code: "TRIAL", Name: "Trial", DurationDays: 3, Pattern: "daily", BasePrice: 300}
+
+	mockRepo.On("ExistByCode", mock.Anything, "TRIAL").Return(false, nil)
+	mockRepo.On("Create", mock.Anything, mock.MatchedBy(func(p *plans.Plan) bool {
+		return p.Code() == "TRIAL" && p.DurationDays() == 3 && p.Pattern() == plans.Daily && p.Active()
+	})).Return(plans.NewPlan("TRIAL", "Trial", 3, plans.Daily, 300), nil)
+
+	plan, err := handler.HandleCreate(context.Background(), cmd)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "TRIAL", plan.Code())
+	assert.Equal(t, 1, uow.committed)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestPlanHandler_HandleCreate_Errors(t *testing.T) {
+	t.Run("pattern", func(t *testing.T) {
+		handler := NewPlanHandler(new(MockRepository), plans.NewPlanFactory(), new(MockUnitOfWork))
+
+		plan, err := handler.HandleCreate(context.Background(), commands.CreatePlanCommand{Code: "TRIAL", Name: "Trial", DurationDays: 3, Pattern: "hourly"})
+
+		assert.Nil(t, plan)
+		assert.ErrorIs(t, err, plans.ErrPatternPlan)
+	})
+
+	t.Run("terms", func(t *testing.T) {
+		handler := NewPlanHandler(new(MockRepository), plans.NewPlanFactory(), new(MockUnitOfWork))
+
+		plan, err := handler.HandleCreate(context.Background(), commands.CreatePlanCommand{Code: "TRIAL", Name: "Trial", DurationDays: 0, Pattern: "D"})
+
+		assert.Nil(t, plan)
+		assert.ErrorIs(t, err, plans.ErrDurationPlan)
+	})
+
+	t.Run("duplicated", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		uow := new(MockUnitOfWork)
+		handler := NewPlanHandler(mockRepo, plans.NewPlanFactory(), uow)
+
+		mockRepo.On("ExistByCode", mock.Anything, "TRIAL").Return(true, nil)
+
+		plan, err := handler.HandleCreate(context.Background(), commands.CreatePlanCommand{Code: "TRIAL", Name: "Trial", DurationDays: 3, Pattern: "D"})
+
+		assert.Nil(t, plan)
+		assert.ErrorIs(t, err, plans.ErrExistPlan)
+		assert.Equal(t, 1, uow.rolledBack)
+		mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("database", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		handler := NewPlanHandler(mockRepo, plans.NewPlanFactory(), new(MockUnitOfWork))
+
+		mockRepo.On("ExistByCode", mock.Anything, "TRIAL").Return(false, nil)
+		mockRepo.On("Create", mock.Anything, mock.Anything).Return((*plans.Plan)(nil), ErrDbFailurePlan)
+
+		plan, err := handler.HandleCreate(context.Background(), commands.CreatePlanCommand{Code: "TRIAL", Name: "Trial", DurationDays: 3, Pattern: "D"})
+
+		assert.Nil(t, plan)
+		assert.ErrorIs(t, err, ErrDbFailurePlan)
+	})
+}
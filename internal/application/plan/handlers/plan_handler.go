@@ -0,0 +1,20 @@
+package handlers
+
+import (
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/abstractions"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/plan"
+)
+
+type PlanHandler struct {
+	repository plans.PlanRepository
+	factory    plans.PlanFactory
+	uow        abstractions.UnitOfWork
+}
+
+func NewPlanHandler(r plans.PlanRepository, f plans.PlanFactory, u abstractions.UnitOfWork) *PlanHandler {
+	return &PlanHandler{
+		repository: r,
+		factory:    f,
+		uow:        u,
+	}
+}
@@ -0,0 +1,73 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/plan"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+)
+
+var ErrDbFailurePlan = errors.New("db failure")
+
+type MockRepository struct {
+	mock.Mock
+}
+
+type MockUnitOfWork struct {
+	committed  int
+	rolledBack int
+}
+
+func (u *MockUnitOfWork) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	if err := fn(ctx); err != nil {
+		u.rolledBack++
+		return err
+	}
+	u.committed++
+	return nil
+}
+
+func (m *MockRepository) GetAll(ctx context.Context, includeInactive bool) ([]*plans.Plan, error) {
+	args := m.Called(ctx, includeInactive)
+
+	var result []*plans.Plan
+	if v := args.Get(0); v != nil {
+		result = v.([]*plans.Plan)
+	}
+
+	return result, args.Error(1)
+}
+
+func (m *MockRepository) GetById(ctx context.Context, id uuid.UUID) (*plans.Plan, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(*plans.Plan), args.Error(1)
+}
+
+func (m *MockRepository) GetByCode(ctx context.Context, code string) (*plans.Plan, error) {
+	args := m.Called(ctx, code)
+	return args.Get(0).(*plans.Plan), args.Error(1)
+}
+
+func (m *MockRepository) ExistByCode(ctx context.Context, code string) (bool, error) {
+	args := m.Called(ctx, code)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockRepository) Create(ctx context.Context, plan *plans.Plan) (*plans.Plan, error) {
+	args := m.Called(ctx, plan)
+	return args.Get(0).(*plans.Plan), args.Error(1)
+}
+
+func (m *MockRepository) Update(ctx context.Context, plan *plans.Plan) (*plans.Plan, error) {
+	args := m.Called(ctx, plan)
+	return args.Get(0).(*plans.Plan), args.Error(1)
+}
+
+func TestNewPlanHandler(t *testing.T) {
+	h := NewPlanHandler(new(MockRepository), plans.NewPlanFactory(), new(MockUnitOfWork))
+
+	assert.NotEmpty(t, h)
+}
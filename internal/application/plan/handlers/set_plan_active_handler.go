@@ -0,0 +1,34 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/plan/commands"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/plan"
+	"log"
+)
+
+func (h *PlanHandler) HandleSetActive(ctx context.Context, cmd commands.SetPlanActiveCommand) (*plans.Plan, error) {
+	var updated *plans.Plan
+	err := h.uow.Do(ctx, func(ctx context.Context) error {
+		plan, err := h.repository.GetById(ctx, cmd.Id)
+		if err != nil {
+			return err
+		}
+
+		if cmd.Active {
+			plan.Activate()
+		} else {
+			plan.Deactivate()
+		}
+
+		updated, err = h.repository.Update(ctx, plan)
+		return err
+	})
+	if err != nil {
+		log.Printf("[handler:plan][HandleSetActive] error changing plan '%s': %v", cmd.Id, err)
+		return nil, err
+	}
+
+	log.Printf("[handler:plan][HandleSetActive] plan '%s' active: %t", cmd.Id, cmd.Active)
+	return updated, nil
+}
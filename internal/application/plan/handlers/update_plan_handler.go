@@ -0,0 +1,38 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/plan/commands"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/plan"
+	"log"
+)
+
+func (h *PlanHandler) HandleUpdate(ctx context.Context, cmd commands.UpdatePlanCommand) (*plans.Plan, error) {
+	pattern, err := plans.ParseDeliveryPattern(cmd.Pattern)
+	if err != nil {
+		log.Printf("[handler:plan][HandleUpdate] error parsing delivery pattern: %v", err)
+		return nil, err
+	}
+
+	var updated *plans.Plan
+	err = h.uow.Do(ctx, func(ctx context.Context) error {
+		plan, err := h.repository.GetById(ctx, cmd.Id)
+		if err != nil {
+			return err
+		}
+
+		if err = plan.Update(cmd.Name, cmd.DurationDays, pattern, cmd.BasePrice); err != nil {
+			return err
+		}
+
+		updated, err = h.repository.Update(ctx, plan)
+		return err
+	})
+	if err != nil {
+		log.Printf("[handler:plan][HandleUpdate] error updating plan '%s': %v", cmd.Id, err)
+		return nil, err
+	}
+
+	log.Printf("[handler:plan][HandleUpdate] plan '%s' updated", cmd.Id)
+	return updated, nil
+}
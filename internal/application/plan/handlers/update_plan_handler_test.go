@@ -0,0 +1,78 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/plan/commands"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/plan"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+)
+
+func TestPlanHandler_HandleUpdate(t *testing.T) {
+	mockRepo := new(MockRepository)
+	handler := NewPlanHandler(mockRepo, plans.NewPlanFactory(), new(MockUnitOfWork))
+	plan := plans.NewPlan("WEEKLY", "Weekly", 7, plans.Daily, 650)
+
+	mockRepo.On("GetById", mock.Anything, plan.Id()).Return(plan, nil)
+	mockRepo.On("Update", mock.Anything, plan).Return(plan, nil)
+
+	updated, err := handler.HandleUpdate(context.Background(), commands.UpdatePlanCommand{
+		Id: plan.Id(), Name: "Weekly lunch", DurationDays: 14, Pattern: "weekly", BasePrice: 400,
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Weekly lunch", updated.Name())
+	assert.Equal(t, 14, updated.DurationDays())
+	assert.Equal(t, plans.Weekly, updated.Pattern())
+	assert.Equal(t, 400, updated.BasePrice())
+}
+
+func TestPlanHandler_HandleUpdate_Errors(t *testing.T) {
+	t.Run("not found", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		handler := NewPlanHandler(mockRepo, plans.NewPlanFactory(), new(MockUnitOfWork))
+		id := uuid.New()
+
+		mockRepo.On("GetById", mock.Anything, id).Return((*plans.Plan)(nil), plans.ErrNotFoundPlan)
+
+		plan, err := handler.HandleUpdate(context.Background(), commands.UpdatePlanCommand{Id: id, Name: "Weekly", DurationDays: 7, Pattern: "D"})
+
+		assert.Nil(t, plan)
+		assert.ErrorIs(t, err, plans.ErrNotFoundPlan)
+	})
+
+	t.Run("terms", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		handler := NewPlanHandler(mockRepo, plans.NewPlanFactory(), new(MockUnitOfWork))
+		plan := plans.NewPlan("WEEKLY", "Weekly", 7, plans.Daily, 650)
+
+		mockRepo.On("GetById", mock.Anything, plan.Id()).Return(plan, nil)
+
+		updated, err := handler.HandleUpdate(context.Background(), commands.UpdatePlanCommand{Id: plan.Id(), Name: "Weekly", DurationDays: 7, Pattern: "D", BasePrice: -1})
+
+		assert.Nil(t, updated)
+		assert.ErrorIs(t, err, plans.ErrBasePricePlan)
+		mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+}
+
+func TestPlanHandler_HandleSetActive(t *testing.T) {
+	mockRepo := new(MockRepository)
+	handler := NewPlanHandler(mockRepo, plans.NewPlanFactory(), new(MockUnitOfWork))
+	plan := plans.NewPlan("WEEKLY", "Weekly", 7, plans.Daily, 650)
+
+	mockRepo.On("GetById", mock.Anything, plan.Id()).Return(plan, nil)
+	mockRepo.On("Update", mock.Anything, plan).Return(plan, nil)
+
+	updated, err := handler.HandleSetActive(context.Background(), commands.SetPlanActiveCommand{Id: plan.Id(), Active: false})
+
+	assert.NoError(t, err)
+	assert.False(t, updated.Active())
+
+	updated, err = handler.HandleSetActive(context.Background(), commands.SetPlanActiveCommand{Id: plan.Id(), Active: true})
+
+	assert.NoError(t, err)
+	assert.True(t, updated.Active())
+}
@@ -0,0 +1,21 @@
+package mappers
+
+import (
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/plan/dto"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/plan"
+)
+
+func MapToPlanDTO(plan *plans.Plan) *dto.PlanDTO {
+	return &dto.PlanDTO{
+		Id:            plan.Id().String(),
+		Code:          plan.Code(),
+		Name:          plan.Name(),
+		DurationDays:  plan.DurationDays(),
+		Pattern:       plan.Pattern().String(),
+		DeliveryCount: plan.DeliveryCount(),
+		BasePrice:     plan.BasePrice(),
+		Active:        plan.Active(),
+		CreatedAt:     plan.CreatedAt(),
+		UpdatedAt:     plan.UpdatedAt(),
+	}
+}
@@ -0,0 +1,23 @@
+package mappers
+
+import (
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/plan"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestMapToPlanDTO(t *testing.T) {
+	plan := plans.NewPlan("SIXTY_DAYS", "Sixty days", 60, plans.EveryOtherDay, 5500)
+	plan.Deactivate()
+
+	d := MapToPlanDTO(plan)
+
+	assert.Equal(t, plan.Id().String(), d.Id)
+	assert.Equal(t, "SIXTY_DAYS", d.Code)
+	assert.Equal(t, "Sixty days", d.Name)
+	assert.Equal(t, 60, d.DurationDays)
+	assert.Equal(t, "every-other-day", d.Pattern)
+	assert.Equal(t, 30, d.DeliveryCount)
+	assert.Equal(t, 5500, d.BasePrice)
+	assert.False(t, d.Active)
+}
@@ -0,0 +1,5 @@
+package queries
+
+type GetAllPlansQuery struct {
+	IncludeInactive bool
+}
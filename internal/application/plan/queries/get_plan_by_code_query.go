@@ -0,0 +1,5 @@
+package queries
+
+type GetPlanByCodeQuery struct {
+	Code string
+}
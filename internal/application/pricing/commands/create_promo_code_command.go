@@ -0,0 +1,13 @@
+package commands
+
+import "time"
+
+type CreatePromoCodeCommand struct {
+	Code       string
+	Kind       string
+	Value      int
+	Currency   string
+	ValidFrom  time.Time
+	ValidUntil *time.Time
+	MaxUses    int
+}
@@ -0,0 +1,11 @@
+package commands
+
+type CreateZoneCommand struct {
+	Name      string
+	Latitude  float64
+	Longitude float64
+	Radius    int
+	Boundary  []byte
+	Surcharge int
+	Currency  string
+}
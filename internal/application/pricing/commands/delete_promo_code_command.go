@@ -0,0 +1,7 @@
+package commands
+
+import "github.com/google/uuid"
+
+type DeletePromoCodeCommand struct {
+	Id uuid.UUID
+}
@@ -0,0 +1,6 @@
+package dto
+
+type CoverageDTO struct {
+	Covered bool     `json:"covered"`
+	Zone    *ZoneDTO `json:"zone,omitempty"`
+}
@@ -0,0 +1,17 @@
+package dto
+
+import "time"
+
+type PromoCodeDTO struct {
+	Id         string     `json:"id"`
+	Code       string     `json:"code"`
+	Kind       string     `json:"kind"`
+	Value      int        `json:"value"`
+	Currency   string     `json:"currency"`
+	ValidFrom  time.Time  `json:"validFrom"`
+	ValidUntil *time.Time `json:"validUntil,omitempty"`
+	MaxUses    int        `json:"maxUses"`
+	Uses       int        `json:"uses"`
+	CreatedAt  time.Time  `json:"createdAt"`
+	UpdatedAt  time.Time  `json:"updatedAt"`
+}
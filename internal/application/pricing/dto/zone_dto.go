@@ -0,0 +1,19 @@
+package dto
+
+import (
+	"encoding/json"
+	contractDto "github.com/carlosclavijo/Nutricenter-Contracting/internal/application/contract/dto"
+	"time"
+)
+
+type ZoneDTO struct {
+	Id        string               `json:"id"`
+	Name      string               `json:"name"`
+	Latitude  float64              `json:"latitude"`
+	Longitude float64              `json:"longitude"`
+	Radius    int                  `json:"radius"`
+	Boundary  json.RawMessage      `json:"boundary,omitempty"`
+	Surcharge contractDto.MoneyDTO `json:"surcharge"`
+	CreatedAt time.Time            `json:"createdAt"`
+	UpdatedAt time.Time            `json:"updatedAt"`
+}
@@ -0,0 +1,52 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/pricing/commands"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/pricing"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	"log"
+)
+
+func (h *PricingHandler) HandleCreatePromoCode(ctx context.Context, cmd commands.CreatePromoCodeCommand) (*pricing.PromoCode, error) {
+	kind, err := pricing.ParseDiscountKind(cmd.Kind)
+	if err != nil {
+		log.Printf("[handler:pricing][HandleCreatePromoCode] error parsing discount kind: %v", err)
+		return nil, err
+	}
+
+	currency, err := valueobjects.ParseCurrency(cmd.Currency)
+	if err != nil {
+		log.Printf("[handler:pricing][HandleCreatePromoCode] error parsing currency: %v", err)
+		return nil, err
+	}
+
+	promo, err := h.codeFactory.Create(cmd.Code, kind, cmd.Value, currency, cmd.ValidFrom, cmd.ValidUntil, cmd.MaxUses)
+	if err != nil {
+		log.Printf("[handler:pricing][HandleCreatePromoCode] error creating promo code factory: %v", err)
+		return nil, err
+	}
+
+	exist, err := h.promoCodes.ExistByCode(ctx, promo.Code())
+	if err != nil {
+		log.Printf("[handler:pricing][HandleCreatePromoCode] error checking promo code '%s': %v", promo.Code(), err)
+		return nil, err
+	} else if exist {
+		log.Printf("[handler:pricing][HandleCreatePromoCode] promo code '%s' already exist", promo.Code())
+		return nil, fmt.Errorf("%w: %s", pricing.ErrExistPromoCode, promo.Code())
+	}
+
+	var created *pricing.PromoCode
+	err = h.uow.Do(ctx, func(ctx context.Context) error {
+		created, err = h.promoCodes.Create(ctx, promo)
+		return err
+	})
+	if err != nil {
+		log.Printf("[handler:pricing][HandleCreatePromoCode] error creating promo code '%s': %v", promo.Code(), err)
+		return nil, err
+	}
+
+	log.Printf("[handler:pricing][HandleCreatePromoCode] promo code '%s' created", promo.Code())
+	return created, nil
+}
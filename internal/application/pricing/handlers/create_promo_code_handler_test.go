@@ -0,0 +1,111 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/pricing/commands"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/pricing"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+func TestPricingHandler_HandleCreatePromoCode(t *testing.T) {
+	codes := new(MockPromoCodeRepository)
+	uow := new(MockUnitOfWork)
+	handler := newPricingHandler(new(MockZoneRepository), codes, uow)
+	from := time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC)
+	until := from.AddDate(0, 1, 0)
+	cmd := commands.CreatePromoCodeCommand{
+		Code:       " welcome10 ",
+		Kind:       "percentage",
+		Value:      1000,
+		ValidFrom:  from,
+		ValidUntil: &until,
+		MaxUses:    50,
+	}
+	created := pricing.NewPromoCode("WELCOME10", pricing.Percentage, 1000, valueobjects.BOB, from, &until, 50)
+
+	codes.On("ExistByCode", mock.Anything, "WELCOME10").Return(false, nil)
+	codes.On("Create", mock.Anything, mock.MatchedBy(func(p *pricing.PromoCode) bool {
+		return p.Code() == "WELCOME10" && p.Kind() == pricing.Percentage && p.Currency() == valueobjects.BOB && p.MaxUses() == 50
+	})).Return(created, nil)
+
+	promo, err := handler.HandleCreatePromoCode(context.Background(), cmd)
+
+	assert.NoError(t, err)
+	assert.Equal(t, created, promo)
+	assert.Equal(t, 1, uow.committed)
+	codes.AssertExpectations(t)
+}
+
+func TestPricingHandler_HandleCreatePromoCode_Errors(t *testing.T) {
+	from := time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC)
+	before := from.AddDate(0, 0, -1)
+
+	cases := []struct {
+		name string
+		cmd  commands.CreatePromoCodeCommand
+		err  error
+	}{
+		{"kind", commands.CreatePromoCodeCommand{Code: "WELCOME10", Kind: "free", Value: 1000}, pricing.ErrKindPromoCode},
+		{"currency", commands.CreatePromoCodeCommand{Code: "WELCOME10", Kind: "F", Value: 1000, Currency: "EUR"}, valueobjects.ErrCurrencyMoney},
+		{"code", commands.CreatePromoCodeCommand{Code: "W!", Kind: "P", Value: 1000}, pricing.ErrCodePromoCode},
+		{"value", commands.CreatePromoCodeCommand{Code: "WELCOME10", Kind: "P", Value: 10001}, pricing.ErrValuePromoCode},
+		{"validity", commands.CreatePromoCodeCommand{Code: "WELCOME10", Kind: "P", Value: 1000, ValidFrom: from, ValidUntil: &before}, pricing.ErrValidityPromoCode},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			codes := new(MockPromoCodeRepository)
+			handler := newPricingHandler(new(MockZoneRepository), codes, new(MockUnitOfWork))
+
+			promo, err := handler.HandleCreatePromoCode(context.Background(), tc.cmd)
+
+			assert.Nil(t, promo)
+			assert.ErrorIs(t, err, tc.err)
+			codes.AssertNotCalled(t, "Create")
+		})
+	}
+
+	t.Run("exist", func(t *testing.T) {
+		codes := new(MockPromoCodeRepository)
+		handler := newPricingHandler(new(MockZoneRepository), codes, new(MockUnitOfWork))
+
+		codes.On("ExistByCode", mock.Anything, "WELCOME10").Return(true, nil)
+
+		promo, err := handler.HandleCreatePromoCode(context.Background(), commands.CreatePromoCodeCommand{Code: "WELCOME10", Kind: "P", Value: 1000})
+
+		assert.Nil(t, promo)
+		assert.ErrorIs(t, err, pricing.ErrExistPromoCode)
+		codes.AssertNotCalled(t, "Create")
+	})
+
+	t.Run("exist check", func(t *testing.T) {
+		codes := new(MockPromoCodeRepository)
+		handler := newPricingHandler(new(MockZoneRepository), codes, new(MockUnitOfWork))
+
+		codes.On("ExistByCode", mock.Anything, "WELCOME10").Return(false, ErrDbFailurePricing)
+
+		promo, err := handler.HandleCreatePromoCode(context.Background(), commands.CreatePromoCodeCommand{Code: "WELCOME10", Kind: "P", Value: 1000})
+
+		assert.Nil(t, promo)
+		assert.ErrorIs(t, err, ErrDbFailurePricing)
+	})
+
+	t.Run("repository", func(t *testing.T) {
+		codes := new(MockPromoCodeRepository)
+		uow := new(MockUnitOfWork)
+		handler := newPricingHandler(new(MockZoneRepository), codes, uow)
+
+		codes.On("ExistByCode", mock.Anything, "WELCOME10").Return(false, nil)
+		codes.On("Create", mock.Anything, mock.Anything).Return(nil, ErrDbFailurePricing)
+
+		promo, err := handler.HandleCreatePromoCode(context.Background(), commands.CreatePromoCodeCommand{Code: "WELCOME10", Kind: "P", Value: 1000})
+
+		assert.Nil(t, promo)
+		assert.ErrorIs(t, err, ErrDbFailurePricing)
+		assert.Equal(t, 1, uow.rolledBack)
+	})
+}
@@ -0,0 +1,60 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/pricing/commands"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/pricing"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	"log"
+)
+
+func (h *PricingHandler) HandleCreateZone(ctx context.Context, cmd commands.CreateZoneCommand) (*pricing.Zone, error) {
+	currency, err := valueobjects.ParseCurrency(cmd.Currency)
+	if err != nil {
+		log.Printf("[handler:pricing][HandleCreateZone] error parsing currency: %v", err)
+		return nil, err
+	}
+
+	surcharge, err := valueobjects.NewMoney(cmd.Surcharge, currency)
+	if err != nil {
+		log.Printf("[handler:pricing][HandleCreateZone] error creating surcharge: %v", err)
+		return nil, err
+	}
+
+	zone, err := h.zone(cmd, surcharge)
+	if err != nil {
+		log.Printf("[handler:pricing][HandleCreateZone] error creating zone factory: %v", err)
+		return nil, err
+	}
+
+	var created *pricing.Zone
+	err = h.uow.Do(ctx, func(ctx context.Context) error {
+		created, err = h.zones.Create(ctx, zone)
+		return err
+	})
+	if err != nil {
+		log.Printf("[handler:pricing][HandleCreateZone] error creating zone '%s': %v", cmd.Name, err)
+		return nil, err
+	}
+
+	log.Printf("[handler:pricing][HandleCreateZone] zone '%s' created", cmd.Name)
+	return created, nil
+}
+
+func (h *PricingHandler) zone(cmd commands.CreateZoneCommand, surcharge valueobjects.Money) (*pricing.Zone, error) {
+	if cmd.Boundary != nil {
+		boundary, err := valueobjects.ParsePolygon(cmd.Boundary)
+		if err != nil {
+			log.Printf("[handler:pricing][HandleCreateZone] error parsing boundary: %v", err)
+			return nil, err
+		}
+		return h.zoneFactory.CreateBounded(cmd.Name, boundary, surcharge)
+	}
+
+	center, err := valueobjects.NewCoordinates(cmd.Latitude, cmd.Longitude)
+	if err != nil {
+		log.Printf("[handler:pricing][HandleCreateZone] error creating coordinates: %v", err)
+		return nil, err
+	}
+	return h.zoneFactory.Create(cmd.Name, center, cmd.Radius, surcharge)
+}
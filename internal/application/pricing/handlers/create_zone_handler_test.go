@@ -0,0 +1,122 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/pricing/commands"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/pricing"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+)
+
+func TestPricingHandler_HandleCreateZone(t *testing.T) {
+	zones := new(MockZoneRepository)
+	uow := new(MockUnitOfWork)
+	handler := newPricingHandler(zones, new(MockPromoCodeRepository), uow)
+	cmd := commands.CreateZoneCommand{
+		Name:      "Urubó",
+		Latitude:  -17.7580,
+		Longitude: -63.2300,
+		Radius:    3000,
+		Surcharge: 500,
+		Currency:  "BOB",
+	}
+
+	zones.On("Create", mock.Anything, mock.AnythingOfType("*pricing.Zone")).Run(func(args mock.Arguments) {
+		created := args.Get(1).(*pricing.Zone)
+		assert.Equal(t, "Urubó", created.Name())
+		assert.Equal(t, 3000, created.Radius())
+		assert.Equal(t, valueobjects.BOB, created.Surcharge().Currency())
+	}).Return(pricing.NewZone("Urubó", mustCoordinates(t, -17.7580, -63.2300), 3000, mustMoney(t, 500)), nil)
+
+	zone, err := handler.HandleCreateZone(context.Background(), cmd)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Urubó", zone.Name())
+	assert.Equal(t, 1, uow.committed)
+	zones.AssertExpectations(t)
+}
+
+const urubo = `{"type":"Polygon","coordinates":[[[-63.25,-17.77],[-63.21,-17.77],[-63.21,-17.74],[-63.25,-17.74],[-63.25,-17.77]]]}`
+
+func TestPricingHandler_HandleCreateZone_Boundary(t *testing.T) {
+	zones := new(MockZoneRepository)
+	uow := new(MockUnitOfWork)
+	handler := newPricingHandler(zones, new(MockPromoCodeRepository), uow)
+	cmd := commands.CreateZoneCommand{
+		Name:     "Urubó",
+		Boundary: []byte(urubo),
+		Currency: "BOB",
+	}
+
+	zones.On("Create", mock.Anything, mock.AnythingOfType("*pricing.Zone")).Run(func(args mock.Arguments) {
+		created := args.Get(1).(*pricing.Zone)
+		assert.NotNil(t, created.Boundary())
+		assert.True(t, created.Covers(mustCoordinates(t, -17.7580, -63.2300)))
+		assert.False(t, created.Covers(mustCoordinates(t, -17.7863, -63.1812)))
+		assert.True(t, created.Surcharge().IsZero())
+	}).Return(nil, nil)
+
+	_, err := handler.HandleCreateZone(context.Background(), cmd)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, uow.committed)
+	zones.AssertExpectations(t)
+}
+
+func TestPricingHandler_HandleCreateZone_Errors(t *testing.T) {
+	cases := []struct {
+		name string
+		cmd  commands.CreateZoneCommand
+		err  error
+	}{
+		{"coordinates", commands.CreateZoneCommand{Name: "Urubó", Latitude: 91, Radius: 3000, Surcharge: 500, Currency: "BOB"}, valueobjects.ErrOutOfBoundariesLatitude},
+		{"currency", commands.CreateZoneCommand{Name: "Urubó", Radius: 3000, Surcharge: 500, Currency: "EUR"}, valueobjects.ErrCurrencyMoney},
+		{"radius", commands.CreateZoneCommand{Name: "Urubó", Surcharge: 500, Currency: "BOB"}, pricing.ErrRadiusZone},
+		{"surcharge", commands.CreateZoneCommand{Name: "Urubó", Radius: 3000, Surcharge: -100, Currency: "BOB"}, pricing.ErrSurchargeZone},
+		{"boundary", commands.CreateZoneCommand{Name: "Urubó", Boundary: []byte(`{"type":"Point","coordinates":[-63.23,-17.75]}`), Currency: "BOB"}, valueobjects.ErrGeoJSONPolygon},
+		{"boundary ring", commands.CreateZoneCommand{Name: "Urubó", Boundary: []byte(`{"type":"Polygon","coordinates":[[[-63.25,-17.77],[-63.21,-17.77],[-63.25,-17.77]]]}`), Currency: "BOB"}, valueobjects.ErrRingPolygon},
+		{"boundary name", commands.CreateZoneCommand{Boundary: []byte(urubo), Currency: "BOB"}, pricing.ErrEmptyNameZone},
+		{"name", commands.CreateZoneCommand{Radius: 3000, Surcharge: 500, Currency: "BOB"}, pricing.ErrEmptyNameZone},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			zones := new(MockZoneRepository)
+			handler := newPricingHandler(zones, new(MockPromoCodeRepository), new(MockUnitOfWork))
+
+			zone, err := handler.HandleCreateZone(context.Background(), tc.cmd)
+
+			assert.Nil(t, zone)
+			assert.ErrorIs(t, err, tc.err)
+			zones.AssertNotCalled(t, "Create")
+		})
+	}
+
+	t.Run("repository", func(t *testing.T) {
+		zones := new(MockZoneRepository)
+		uow := new(MockUnitOfWork)
+		handler := newPricingHandler(zones, new(MockPromoCodeRepository), uow)
+
+		zones.On("Create", mock.Anything, mock.Anything).Return(nil, ErrDbFailurePricing)
+
+		zone, err := handler.HandleCreateZone(context.Background(), commands.CreateZoneCommand{Name: "Urubó", Radius: 3000, Surcharge: 500, Currency: "BOB"})
+
+		assert.Nil(t, zone)
+		assert.ErrorIs(t, err, ErrDbFailurePricing)
+		assert.Equal(t, 1, uow.rolledBack)
+	})
+}
+
+func mustCoordinates(t *testing.T, lat, lon float64) valueobjects.Coordinates {
+	c, err := valueobjects.NewCoordinates(lat, lon)
+	assert.NoError(t, err)
+	return c
+}
+
+func mustMoney(t *testing.T, amount int) valueobjects.Money {
+	m, err := valueobjects.NewMoney(amount, valueobjects.BOB)
+	assert.NoError(t, err)
+	return m
+}
@@ -0,0 +1,20 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/pricing/commands"
+	"log"
+)
+
+func (h *PricingHandler) HandleDeletePromoCode(ctx context.Context, cmd commands.DeletePromoCodeCommand) error {
+	err := h.uow.Do(ctx, func(ctx context.Context) error {
+		return h.promoCodes.Delete(ctx, cmd.Id)
+	})
+	if err != nil {
+		log.Printf("[handler:pricing][HandleDeletePromoCode] error deleting promo code '%s': %v", cmd.Id, err)
+		return err
+	}
+
+	log.Printf("[handler:pricing][HandleDeletePromoCode] promo code '%s' deleted", cmd.Id)
+	return nil
+}
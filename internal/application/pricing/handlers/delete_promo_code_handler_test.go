@@ -0,0 +1,26 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/pricing/commands"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/pricing"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+)
+
+func TestPricingHandler_HandleDeletePromoCode(t *testing.T) {
+	codes := new(MockPromoCodeRepository)
+	uow := new(MockUnitOfWork)
+	handler := newPricingHandler(new(MockZoneRepository), codes, uow)
+	id, missing := uuid.New(), uuid.New()
+
+	codes.On("Delete", mock.Anything, id).Return(nil)
+	codes.On("Delete", mock.Anything, missing).Return(pricing.ErrNotFoundPromoCode)
+
+	assert.NoError(t, handler.HandleDeletePromoCode(context.Background(), commands.DeletePromoCodeCommand{Id: id}))
+	assert.ErrorIs(t, handler.HandleDeletePromoCode(context.Background(), commands.DeletePromoCodeCommand{Id: missing}), pricing.ErrNotFoundPromoCode)
+	assert.Equal(t, 1, uow.committed)
+	assert.Equal(t, 1, uow.rolledBack)
+}
@@ -0,0 +1,20 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/pricing/commands"
+	"log"
+)
+
+func (h *PricingHandler) HandleDeleteZone(ctx context.Context, cmd commands.DeleteZoneCommand) error {
+	err := h.uow.Do(ctx, func(ctx context.Context) error {
+		return h.zones.Delete(ctx, cmd.Id)
+	})
+	if err != nil {
+		log.Printf("[handler:pricing][HandleDeleteZone] error deleting zone '%s': %v", cmd.Id, err)
+		return err
+	}
+
+	log.Printf("[handler:pricing][HandleDeleteZone] zone '%s' deleted", cmd.Id)
+	return nil
+}
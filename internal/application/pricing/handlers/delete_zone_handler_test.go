@@ -0,0 +1,26 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/pricing/commands"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/pricing"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+)
+
+func TestPricingHandler_HandleDeleteZone(t *testing.T) {
+	zones := new(MockZoneRepository)
+	uow := new(MockUnitOfWork)
+	handler := newPricingHandler(zones, new(MockPromoCodeRepository), uow)
+	id, missing := uuid.New(), uuid.New()
+
+	zones.On("Delete", mock.Anything, id).Return(nil)
+	zones.On("Delete", mock.Anything, missing).Return(pricing.ErrNotFoundZone)
+
+	assert.NoError(t, handler.HandleDeleteZone(context.Background(), commands.DeleteZoneCommand{Id: id}))
+	assert.ErrorIs(t, handler.HandleDeleteZone(context.Background(), commands.DeleteZoneCommand{Id: missing}), pricing.ErrNotFoundZone)
+	assert.Equal(t, 1, uow.committed)
+	assert.Equal(t, 1, uow.rolledBack)
+}
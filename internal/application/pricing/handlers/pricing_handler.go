@@ -0,0 +1,24 @@
+package handlers
+
+import (
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/abstractions"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/pricing"
+)
+
+type PricingHandler struct {
+	zones       pricing.ZoneRepository
+	promoCodes  pricing.PromoCodeRepository
+	zoneFactory pricing.ZoneFactory
+	codeFactory pricing.PromoCodeFactory
+	uow         abstractions.UnitOfWork
+}
+
+func NewPricingHandler(z pricing.ZoneRepository, p pricing.PromoCodeRepository, zf pricing.ZoneFactory, pf pricing.PromoCodeFactory, u abstractions.UnitOfWork) *PricingHandler {
+	return &PricingHandler{
+		zones:       z,
+		promoCodes:  p,
+		zoneFactory: zf,
+		codeFactory: pf,
+		uow:         u,
+	}
+}
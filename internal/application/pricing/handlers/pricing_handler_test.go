@@ -0,0 +1,82 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/pricing"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+)
+
+var ErrDbFailurePricing = errors.New("db failure")
+
+type MockZoneRepository struct {
+	mock.Mock
+	pricing.ZoneRepository
+}
+
+type MockPromoCodeRepository struct {
+	mock.Mock
+	pricing.PromoCodeRepository
+}
+
+type MockUnitOfWork struct {
+	committed  int
+	rolledBack int
+}
+
+func TestNewPricingHandler(t *testing.T) {
+	zones, codes := new(MockZoneRepository), new(MockPromoCodeRepository)
+
+	handler := newPricingHandler(zones, codes, new(MockUnitOfWork))
+
+	assert.NotNil(t, handler)
+	assert.Equal(t, zones, handler.zones)
+	assert.Equal(t, codes, handler.promoCodes)
+}
+
+func newPricingHandler(z *MockZoneRepository, p *MockPromoCodeRepository, u *MockUnitOfWork) *PricingHandler {
+	return NewPricingHandler(z, p, pricing.NewZoneFactory(), pricing.NewPromoCodeFactory(), u)
+}
+
+func (u *MockUnitOfWork) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	if err := fn(ctx); err != nil {
+		u.rolledBack++
+		return err
+	}
+	u.committed++
+	return nil
+}
+
+func (m *MockZoneRepository) Create(ctx context.Context, zone *pricing.Zone) (*pricing.Zone, error) {
+	args := m.Called(ctx, zone)
+	if v := args.Get(0); v != nil {
+		return v.(*pricing.Zone), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockZoneRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockPromoCodeRepository) ExistByCode(ctx context.Context, code string) (bool, error) {
+	args := m.Called(ctx, code)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockPromoCodeRepository) Create(ctx context.Context, promo *pricing.PromoCode) (*pricing.PromoCode, error) {
+	args := m.Called(ctx, promo)
+	if v := args.Get(0); v != nil {
+		return v.(*pricing.PromoCode), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockPromoCodeRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
@@ -0,0 +1,43 @@
+package mappers
+
+import (
+	"encoding/json"
+	contractMappers "github.com/carlosclavijo/Nutricenter-Contracting/internal/application/contract/mappers"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/pricing/dto"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/pricing"
+)
+
+func MapToZoneDTO(zone *pricing.Zone) *dto.ZoneDTO {
+	var boundary json.RawMessage
+	if b := zone.Boundary(); b != nil {
+		boundary, _ = json.Marshal(b)
+	}
+
+	return &dto.ZoneDTO{
+		Id:        zone.Id().String(),
+		Name:      zone.Name(),
+		Latitude:  zone.Center().Latitude(),
+		Longitude: zone.Center().Longitude(),
+		Radius:    zone.Radius(),
+		Boundary:  boundary,
+		Surcharge: contractMappers.MapToMoneyDTO(zone.Surcharge()),
+		CreatedAt: zone.CreatedAt(),
+		UpdatedAt: zone.UpdatedAt(),
+	}
+}
+
+func MapToPromoCodeDTO(promo *pricing.PromoCode) *dto.PromoCodeDTO {
+	return &dto.PromoCodeDTO{
+		Id:         promo.Id().String(),
+		Code:       promo.Code(),
+		Kind:       promo.Kind().String(),
+		Value:      promo.Value(),
+		Currency:   string(promo.Currency()),
+		ValidFrom:  promo.ValidFrom(),
+		ValidUntil: promo.ValidUntil(),
+		MaxUses:    promo.MaxUses(),
+		Uses:       promo.Uses(),
+		CreatedAt:  promo.CreatedAt(),
+		UpdatedAt:  promo.UpdatedAt(),
+	}
+}
@@ -0,0 +1,57 @@
+package mappers
+
+import (
+	contractDto "github.com/carlosclavijo/Nutricenter-Contracting/internal/application/contract/dto"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/pricing"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestMapToZoneDTO(t *testing.T) {
+	center, err := valueobjects.NewCoordinates(-17.7863, -63.1812)
+	assert.NoError(t, err)
+	surcharge, err := valueobjects.NewMoney(500, valueobjects.BOB)
+	assert.NoError(t, err)
+	zone := pricing.NewZone("Urubó", center, 3000, surcharge)
+
+	d := MapToZoneDTO(zone)
+
+	assert.Equal(t, zone.Id().String(), d.Id)
+	assert.Equal(t, "Urubó", d.Name)
+	assert.Equal(t, -17.7863, d.Latitude)
+	assert.Equal(t, -63.1812, d.Longitude)
+	assert.Equal(t, 3000, d.Radius)
+	assert.Equal(t, contractDto.MoneyDTO{Amount: 500, Currency: "BOB"}, d.Surcharge)
+	assert.Nil(t, d.Boundary)
+
+	boundary := `{"type":"Polygon","coordinates":[[[-63.19,-17.79],[-63.17,-17.79],[-63.17,-17.77],[-63.19,-17.77],[-63.19,-17.79]]]}`
+	polygon, err := valueobjects.ParsePolygon([]byte(boundary))
+	assert.NoError(t, err)
+
+	d = MapToZoneDTO(pricing.NewBoundedZone("Downtown", polygon, surcharge))
+	assert.JSONEq(t, boundary, string(d.Boundary))
+}
+
+func TestMapToPromoCodeDTO(t *testing.T) {
+	from := time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC)
+	until := from.AddDate(0, 1, 0)
+	promo := pricing.NewPromoCode("WELCOME10", pricing.Percentage, 1000, valueobjects.BOB, from, &until, 50)
+
+	d := MapToPromoCodeDTO(promo)
+
+	assert.Equal(t, promo.Id().String(), d.Id)
+	assert.Equal(t, "WELCOME10", d.Code)
+	assert.Equal(t, "percentage", d.Kind)
+	assert.Equal(t, 1000, d.Value)
+	assert.Equal(t, "BOB", d.Currency)
+	assert.Equal(t, from, d.ValidFrom)
+	assert.Equal(t, &until, d.ValidUntil)
+	assert.Equal(t, 50, d.MaxUses)
+	assert.Equal(t, 0, d.Uses)
+
+	d = MapToPromoCodeDTO(pricing.NewPromoCode("MINUS50", pricing.Fixed, 5000, valueobjects.BOB, from, nil, 0))
+	assert.Equal(t, "fixed", d.Kind)
+	assert.Nil(t, d.ValidUntil)
+}
@@ -0,0 +1,6 @@
+package queries
+
+type CheckCoverageQuery struct {
+	Latitude  float64
+	Longitude float64
+}
@@ -0,0 +1,3 @@
+package queries
+
+type GetPromoCodesQuery struct{}
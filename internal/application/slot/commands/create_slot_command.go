@@ -0,0 +1,11 @@
+package commands
+
+import "github.com/google/uuid"
+
+type CreateSlotCommand struct {
+	ZoneId   uuid.UUID
+	Name     string
+	Start    string
+	End      string
+	Capacity int
+}
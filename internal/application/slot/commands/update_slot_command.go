@@ -0,0 +1,11 @@
+package commands
+
+import "github.com/google/uuid"
+
+type UpdateSlotCommand struct {
+	Id       uuid.UUID
+	Name     string
+	Start    string
+	End      string
+	Capacity int
+}
@@ -0,0 +1,14 @@
+package dto
+
+import "time"
+
+type SlotDTO struct {
+	Id        string    `json:"id"`
+	ZoneId    string    `json:"zoneId"`
+	Name      string    `json:"name"`
+	Start     string    `json:"start"`
+	End       string    `json:"end"`
+	Capacity  int       `json:"capacity"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
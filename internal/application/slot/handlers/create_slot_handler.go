@@ -0,0 +1,45 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/slot/commands"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/slot"
+	"log"
+)
+
+func (h *SlotHandler) HandleCreate(ctx context.Context, cmd commands.CreateSlotCommand) (*slots.Slot, error) {
+	start, err := slots.ParseClock(cmd.Start)
+	if err != nil {
+		log.Printf("[handler:slot][HandleCreate] error parsing start: %v", err)
+		return nil, err
+	}
+
+	end, err := slots.ParseClock(cmd.End)
+	if err != nil {
+		log.Printf("[handler:slot][HandleCreate] error parsing end: %v", err)
+		return nil, err
+	}
+
+	slot, err := h.factory.Create(cmd.ZoneId, cmd.Name, start, end, cmd.Capacity)
+	if err != nil {
+		log.Printf("[handler:slot][HandleCreate] error creating slot factory: %v", err)
+		return nil, err
+	}
+
+	var created *slots.Slot
+	err = h.uow.Do(ctx, func(ctx context.Context) error {
+		if _, err = h.zones.GetById(ctx, cmd.ZoneId); err != nil {
+			return err
+		}
+
+		created, err = h.repository.Create(ctx, slot)
+		return err
+	})
+	if err != nil {
+		log.Printf("[handler:slot][HandleCreate] error creating slot '%s': %v", cmd.Name, err)
+		return nil, err
+	}
+
+	log.Printf("[handler:slot][HandleCreate] slot '%s' created", created.Id())
+	return created, nil
+}
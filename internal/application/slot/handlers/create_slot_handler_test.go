@@ -0,0 +1,100 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/slot/commands"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/pricing"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/slot"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+func TestSlotHandler_HandleCreate(t *testing.T) {
+	mockRepo := new(MockRepository)
+	mockZones := new(MockZoneRepository)
+	uow := new(MockUnitOfWork)
+	handler := NewSlotHandler(mockRepo, mockZones, slots.NewSlotFactory(), uow)
+
+	fee, err := valueobjects.NewMoney(100, valueobjects.BOB)
+	assert.NoError(t, err)
+	zone := pricing.NewZone("Downtown", valueobjects.Coordinates{}, 1000, fee)
+	cmd := commands.CreateSlotCommand{ZoneId: zone.Id(), Name: "Lunch", Start: "11:30", End: "14:00", Capacity: 30}
+
+	mockZones.On("GetById", mock.Anything, zone.Id()).Return(zone, nil)
+	mockRepo.On("Create", mock.Anything, mock.MatchedBy(func(s *slots.Slot) bool {
+		return s.ZoneId() == zone.Id() && s.Name() == "Lunch" && s.Start() == 11*time.Hour+30*time.Minute && s.End() == 14*time.Hour && s.Capacity() == 30
+	})).Return(slots.NewSlot(zone.Id(), "Lunch", 11*time.Hour+30*time.Minute, 14*time.Hour, 30), nil)
+
+	slot, err := handler.HandleCreate(context.Background(), cmd)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Lunch", slot.Name())
+	assert.Equal(t, 1, uow.committed)
+	mockRepo.AssertExpectations(t)
+	mockZones.AssertExpectations(t)
+}
+
+func TestSlotHandler_HandleCreate_Errors(t *testing.T) {
+	zoneId := uuid.New()
+
+	cases := []struct {
+		name string
+		cmd  commands.CreateSlotCommand
+		err  error
+	}{
+		{"start", commands.CreateSlotCommand{ZoneId: zoneId, Name: "Lunch", Start: "noon", End: "14:00", Capacity: 30}, slots.ErrClockSlot},
+		{"end", commands.CreateSlotCommand{ZoneId: zoneId, Name: "Lunch", Start: "11:30", End: "25:00", Capacity: 30}, slots.ErrClockSlot},
+		{"zone", commands.CreateSlotCommand{Name: "Lunch", Start: "11:30", End: "14:00", Capacity: 30}, slots.ErrZoneIdSlot},
+		{"window", commands.CreateSlotCommand{ZoneId: zoneId, Name: "Lunch", Start: "14:00", End: "11:30", Capacity: 30}, slots.ErrWindowSlot},
+		{"capacity", commands.CreateSlotCommand{ZoneId: zoneId, Name: "Lunch", Start: "11:30", End: "14:00"}, slots.ErrCapacitySlot},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockRepo := new(MockRepository)
+			handler := NewSlotHandler(mockRepo, new(MockZoneRepository), slots.NewSlotFactory(), new(MockUnitOfWork))
+
+			slot, err := handler.HandleCreate(context.Background(), tc.cmd)
+
+			assert.Nil(t, slot)
+			assert.ErrorIs(t, err, tc.err)
+			mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+		})
+	}
+
+	t.Run("unknown zone", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockZones := new(MockZoneRepository)
+		uow := new(MockUnitOfWork)
+		handler := NewSlotHandler(mockRepo, mockZones, slots.NewSlotFactory(), uow)
+
+		mockZones.On("GetById", mock.Anything, zoneId).Return(nil, pricing.ErrNotFoundZone)
+
+		slot, err := handler.HandleCreate(context.Background(), commands.CreateSlotCommand{ZoneId: zoneId, Name: "Lunch", Start: "11:30", End: "14:00", Capacity: 30})
+
+		assert.Nil(t, slot)
+		assert.ErrorIs(t, err, pricing.ErrNotFoundZone)
+		assert.Equal(t, 1, uow.rolledBack)
+		mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("database", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockZones := new(MockZoneRepository)
+		uow := new(MockUnitOfWork)
+		handler := NewSlotHandler(mockRepo, mockZones, slots.NewSlotFactory(), uow)
+
+		mockZones.On("GetById", mock.Anything, zoneId).Return(nil, nil)
+		mockRepo.On("Create", mock.Anything, mock.Anything).Return(nil, ErrDbFailureSlot)
+
+		slot, err := handler.HandleCreate(context.Background(), commands.CreateSlotCommand{ZoneId: zoneId, Name: "Lunch", Start: "11:30", End: "14:00", Capacity: 30})
+
+		assert.Nil(t, slot)
+		assert.ErrorIs(t, err, ErrDbFailureSlot)
+		assert.Equal(t, 1, uow.rolledBack)
+	})
+}
@@ -0,0 +1,20 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/slot/commands"
+	"log"
+)
+
+func (h *SlotHandler) HandleDelete(ctx context.Context, cmd commands.DeleteSlotCommand) error {
+	err := h.uow.Do(ctx, func(ctx context.Context) error {
+		return h.repository.Delete(ctx, cmd.Id)
+	})
+	if err != nil {
+		log.Printf("[handler:slot][HandleDelete] error deleting slot '%s': %v", cmd.Id, err)
+		return err
+	}
+
+	log.Printf("[handler:slot][HandleDelete] slot '%s' deleted", cmd.Id)
+	return nil
+}
@@ -0,0 +1,26 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/slot/commands"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/slot"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+)
+
+func TestSlotHandler_HandleDelete(t *testing.T) {
+	mockRepo := new(MockRepository)
+	uow := new(MockUnitOfWork)
+	handler := NewSlotHandler(mockRepo, new(MockZoneRepository), slots.NewSlotFactory(), uow)
+	id, missing := uuid.New(), uuid.New()
+
+	mockRepo.On("Delete", mock.Anything, id).Return(nil)
+	mockRepo.On("Delete", mock.Anything, missing).Return(slots.ErrNotFoundSlot)
+
+	assert.NoError(t, handler.HandleDelete(context.Background(), commands.DeleteSlotCommand{Id: id}))
+	assert.ErrorIs(t, handler.HandleDelete(context.Background(), commands.DeleteSlotCommand{Id: missing}), slots.ErrNotFoundSlot)
+	assert.Equal(t, 1, uow.committed)
+	assert.Equal(t, 1, uow.rolledBack)
+}
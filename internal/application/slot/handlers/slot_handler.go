@@ -0,0 +1,23 @@
+package handlers
+
+import (
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/abstractions"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/pricing"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/slot"
+)
+
+type SlotHandler struct {
+	repository slots.SlotRepository
+	zones      pricing.ZoneRepository
+	factory    slots.SlotFactory
+	uow        abstractions.UnitOfWork
+}
+
+func NewSlotHandler(r slots.SlotRepository, z pricing.ZoneRepository, f slots.SlotFactory, u abstractions.UnitOfWork) *SlotHandler {
+	return &SlotHandler{
+		repository: r,
+		zones:      z,
+		factory:    f,
+		uow:        u,
+	}
+}
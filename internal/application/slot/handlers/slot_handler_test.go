@@ -0,0 +1,86 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/pricing"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/slot"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+)
+
+var ErrDbFailureSlot = errors.New("db failure")
+
+type MockRepository struct {
+	mock.Mock
+	slots.SlotRepository
+}
+
+type MockZoneRepository struct {
+	mock.Mock
+	pricing.ZoneRepository
+}
+
+type MockUnitOfWork struct {
+	committed  int
+	rolledBack int
+}
+
+func TestNewSlotHandler(t *testing.T) {
+	repo := new(MockRepository)
+	zones := new(MockZoneRepository)
+
+	handler := NewSlotHandler(repo, zones, slots.NewSlotFactory(), new(MockUnitOfWork))
+
+	assert.NotNil(t, handler)
+	assert.Equal(t, repo, handler.repository)
+	assert.Equal(t, zones, handler.zones)
+}
+
+func (u *MockUnitOfWork) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	if err := fn(ctx); err != nil {
+		u.rolledBack++
+		return err
+	}
+	u.committed++
+	return nil
+}
+
+func (m *MockRepository) GetById(ctx context.Context, id uuid.UUID) (*slots.Slot, error) {
+	args := m.Called(ctx, id)
+	if v := args.Get(0); v != nil {
+		return v.(*slots.Slot), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockRepository) Create(ctx context.Context, slot *slots.Slot) (*slots.Slot, error) {
+	args := m.Called(ctx, slot)
+	if v := args.Get(0); v != nil {
+		return v.(*slots.Slot), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockRepository) Update(ctx context.Context, slot *slots.Slot) (*slots.Slot, error) {
+	args := m.Called(ctx, slot)
+	if v := args.Get(0); v != nil {
+		return v.(*slots.Slot), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockZoneRepository) GetById(ctx context.Context, id uuid.UUID) (*pricing.Zone, error) {
+	args := m.Called(ctx, id)
+	if v := args.Get(0); v != nil {
+		return v.(*pricing.Zone), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
@@ -0,0 +1,44 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/slot/commands"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/slot"
+	"log"
+)
+
+func (h *SlotHandler) HandleUpdate(ctx context.Context, cmd commands.UpdateSlotCommand) (*slots.Slot, error) {
+	start, err := slots.ParseClock(cmd.Start)
+	if err != nil {
+		log.Printf("[handler:slot][HandleUpdate] error parsing start: %v", err)
+		return nil, err
+	}
+
+	end, err := slots.ParseClock(cmd.End)
+	if err != nil {
+		log.Printf("[handler:slot][HandleUpdate] error parsing end: %v", err)
+		return nil, err
+	}
+
+	var updated *slots.Slot
+	err = h.uow.Do(ctx, func(ctx context.Context) error {
+		slot, err := h.repository.GetById(ctx, cmd.Id)
+		if err != nil {
+			return err
+		}
+
+		if err = slot.Update(cmd.Name, start, end, cmd.Capacity); err != nil {
+			return err
+		}
+
+		updated, err = h.repository.Update(ctx, slot)
+		return err
+	})
+	if err != nil {
+		log.Printf("[handler:slot][HandleUpdate] error updating slot '%s': %v", cmd.Id, err)
+		return nil, err
+	}
+
+	log.Printf("[handler:slot][HandleUpdate] slot '%s' updated", cmd.Id)
+	return updated, nil
+}
@@ -0,0 +1,76 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/slot/commands"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/slot"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+func TestSlotHandler_HandleUpdate(t *testing.T) {
+	mockRepo := new(MockRepository)
+	uow := new(MockUnitOfWork)
+	handler := NewSlotHandler(mockRepo, new(MockZoneRepository), slots.NewSlotFactory(), uow)
+	slot := slots.NewSlot(uuid.New(), "Lunch", 11*time.Hour+30*time.Minute, 14*time.Hour, 30)
+
+	mockRepo.On("GetById", mock.Anything, slot.Id()).Return(slot, nil)
+	mockRepo.On("Update", mock.Anything, slot).Return(slot, nil)
+
+	updated, err := handler.HandleUpdate(context.Background(), commands.UpdateSlotCommand{Id: slot.Id(), Name: "Early lunch", Start: "11:00", End: "13:00", Capacity: 40})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Early lunch", updated.Name())
+	assert.Equal(t, 11*time.Hour, updated.Start())
+	assert.Equal(t, 13*time.Hour, updated.End())
+	assert.Equal(t, 40, updated.Capacity())
+	assert.Equal(t, 1, uow.committed)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestSlotHandler_HandleUpdate_Errors(t *testing.T) {
+	t.Run("clock", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		handler := NewSlotHandler(mockRepo, new(MockZoneRepository), slots.NewSlotFactory(), new(MockUnitOfWork))
+
+		slot, err := handler.HandleUpdate(context.Background(), commands.UpdateSlotCommand{Id: uuid.New(), Name: "Lunch", Start: "11:30", End: "2pm", Capacity: 30})
+
+		assert.Nil(t, slot)
+		assert.ErrorIs(t, err, slots.ErrClockSlot)
+		mockRepo.AssertNotCalled(t, "GetById", mock.Anything, mock.Anything)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		uow := new(MockUnitOfWork)
+		handler := NewSlotHandler(mockRepo, new(MockZoneRepository), slots.NewSlotFactory(), uow)
+		id := uuid.New()
+
+		mockRepo.On("GetById", mock.Anything, id).Return(nil, slots.ErrNotFoundSlot)
+
+		slot, err := handler.HandleUpdate(context.Background(), commands.UpdateSlotCommand{Id: id, Name: "Lunch", Start: "11:30", End: "14:00", Capacity: 30})
+
+		assert.Nil(t, slot)
+		assert.ErrorIs(t, err, slots.ErrNotFoundSlot)
+		assert.Equal(t, 1, uow.rolledBack)
+	})
+
+	t.Run("invalid details", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		uow := new(MockUnitOfWork)
+		handler := NewSlotHandler(mockRepo, new(MockZoneRepository), slots.NewSlotFactory(), uow)
+		slot := slots.NewSlot(uuid.New(), "Lunch", 11*time.Hour+30*time.Minute, 14*time.Hour, 30)
+
+		mockRepo.On("GetById", mock.Anything, slot.Id()).Return(slot, nil)
+
+		updated, err := handler.HandleUpdate(context.Background(), commands.UpdateSlotCommand{Id: slot.Id(), Name: "Lunch", Start: "14:00", End: "14:00", Capacity: 30})
+
+		assert.Nil(t, updated)
+		assert.ErrorIs(t, err, slots.ErrWindowSlot)
+		assert.Equal(t, 1, uow.rolledBack)
+		mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+}
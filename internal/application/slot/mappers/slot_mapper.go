@@ -0,0 +1,19 @@
+package mappers
+
+import (
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/slot/dto"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/slot"
+)
+
+func MapToSlotDTO(slot *slots.Slot) *dto.SlotDTO {
+	return &dto.SlotDTO{
+		Id:        slot.Id().String(),
+		ZoneId:    slot.ZoneId().String(),
+		Name:      slot.Name(),
+		Start:     slots.FormatClock(slot.Start()),
+		End:       slots.FormatClock(slot.End()),
+		Capacity:  slot.Capacity(),
+		CreatedAt: slot.CreatedAt(),
+		UpdatedAt: slot.UpdatedAt(),
+	}
+}
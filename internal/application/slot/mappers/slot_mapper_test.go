@@ -0,0 +1,24 @@
+package mappers
+
+import (
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/slot"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestMapToSlotDTO(t *testing.T) {
+	slot := slots.NewSlot(uuid.New(), "Lunch", 11*time.Hour+30*time.Minute, 14*time.Hour, 30)
+
+	dto := MapToSlotDTO(slot)
+
+	assert.Equal(t, slot.Id().String(), dto.Id)
+	assert.Equal(t, slot.ZoneId().String(), dto.ZoneId)
+	assert.Equal(t, "Lunch", dto.Name)
+	assert.Equal(t, "11:30", dto.Start)
+	assert.Equal(t, "14:00", dto.End)
+	assert.Equal(t, 30, dto.Capacity)
+	assert.Equal(t, slot.CreatedAt(), dto.CreatedAt)
+	assert.Equal(t, slot.UpdatedAt(), dto.UpdatedAt)
+}
@@ -0,0 +1,18 @@
+package abstractions
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+var (
+	ErrNotFoundBlob = errors.New("blob not found")
+	ErrKeyBlob      = errors.New("blob key is not valid")
+)
+
+type BlobStore interface {
+	Put(ctx context.Context, key string, r io.Reader) error
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+}
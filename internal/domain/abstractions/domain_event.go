@@ -6,8 +6,11 @@ import (
 )
 
 type DomainEvent struct {
-	id         uuid.UUID
-	occurredOn time.Time
+	id          uuid.UUID
+	name        string
+	aggregateId uuid.UUID
+	payload     any
+	occurredOn  time.Time
 }
 
 func NewDomainEvent() *DomainEvent {
@@ -17,10 +20,32 @@ func NewDomainEvent() *DomainEvent {
 	}
 }
 
+func NewNamedDomainEvent(name string, aggregateId uuid.UUID, payload any) *DomainEvent {
+	return &DomainEvent{
+		id:          uuid.New(),
+		name:        name,
+		aggregateId: aggregateId,
+		payload:     payload,
+		occurredOn:  time.Now(),
+	}
+}
+
 func (d *DomainEvent) Id() uuid.UUID {
 	return d.id
 }
 
+func (d *DomainEvent) Name() string {
+	return d.name
+}
+
+func (d *DomainEvent) AggregateId() uuid.UUID {
+	return d.aggregateId
+}
+
+func (d *DomainEvent) Payload() any {
+	return d.payload
+}
+
 func (d *DomainEvent) OccurredOn() time.Time {
 	return d.occurredOn
 }
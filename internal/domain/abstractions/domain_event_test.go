@@ -1,6 +1,7 @@
 package abstractions
 
 import (
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"testing"
 	"time"
@@ -18,3 +19,16 @@ func TestNewDomainEvent(t *testing.T) {
 
 	assert.WithinDuration(t, now, event.OccurredOn(), time.Second)
 }
+
+func TestNewNamedDomainEvent(t *testing.T) {
+	aggregateId := uuid.New()
+	payload := map[string]string{"key": "value"}
+
+	event := NewNamedDomainEvent("aggregate.changed", aggregateId, payload)
+
+	assert.NotEqual(t, uuid.Nil, event.Id())
+	assert.Equal(t, "aggregate.changed", event.Name())
+	assert.Equal(t, aggregateId, event.AggregateId())
+	assert.Equal(t, payload, event.Payload())
+	assert.WithinDuration(t, time.Now(), event.OccurredOn(), time.Second)
+}
@@ -0,0 +1,13 @@
+package abstractions
+
+import "context"
+
+type Notification struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+type Notifier interface {
+	Notify(ctx context.Context, n Notification) error
+}
@@ -0,0 +1,157 @@
+package abstractions
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+type SortOrder string
+
+const (
+	Ascending  SortOrder = "asc"
+	Descending SortOrder = "desc"
+
+	DefaultPageLimit = 20
+	MaxPageLimit     = 100
+
+	cursorPrefix = "o:"
+)
+
+var (
+	ErrInvalidPagePagination   = errors.New("page must be greater than zero")
+	ErrInvalidLimitPagination  = errors.New("limit must be between 1 and 100")
+	ErrInvalidCursorPagination = errors.New("cursor is not valid")
+	ErrInvalidOrderPagination  = errors.New("order must be 'asc' or 'desc'")
+	ErrInvalidSortPagination   = errors.New("sort field is not supported")
+)
+
+type Pagination struct {
+	Page   int
+	Limit  int
+	Cursor string
+	Sort   string
+	Order  SortOrder
+}
+
+func NewPagination(page, limit int, cursor, sort, order string) (Pagination, error) {
+	if page < 0 {
+		return Pagination{}, fmt.Errorf("%w: got %d", ErrInvalidPagePagination, page)
+	}
+
+	if limit < 0 || limit > MaxPageLimit {
+		return Pagination{}, fmt.Errorf("%w: got %d", ErrInvalidLimitPagination, limit)
+	}
+
+	if cursor != "" {
+		if _, err := DecodeCursor(cursor); err != nil {
+			return Pagination{}, err
+		}
+	}
+
+	so := SortOrder(strings.ToLower(order))
+	if so != "" && so != Ascending && so != Descending {
+		return Pagination{}, fmt.Errorf("%w: got %s", ErrInvalidOrderPagination, order)
+	}
+
+	return Pagination{
+		Page:   page,
+		Limit:  limit,
+		Cursor: cursor,
+		Sort:   sort,
+		Order:  so,
+	}, nil
+}
+
+func (p Pagination) PageLimit() int {
+	if p.Limit <= 0 {
+		return DefaultPageLimit
+	}
+	if p.Limit > MaxPageLimit {
+		return MaxPageLimit
+	}
+	return p.Limit
+}
+
+func (p Pagination) Offset() int {
+	if p.Cursor != "" {
+		if offset, err := DecodeCursor(p.Cursor); err == nil {
+			return offset
+		}
+	}
+	if p.Page > 1 {
+		return (p.Page - 1) * p.PageLimit()
+	}
+	return 0
+}
+
+func (p Pagination) Descending() bool {
+	return p.Order == Descending
+}
+
+type Page[T any] struct {
+	Items  []T
+	Total  int
+	Limit  int
+	Offset int
+}
+
+func NewPage[T any](items []T, total int, p Pagination) *Page[T] {
+	return &Page[T]{
+		Items:  items,
+		Total:  total,
+		Limit:  p.PageLimit(),
+		Offset: p.Offset(),
+	}
+}
+
+func MapPage[T, U any](p *Page[T], fn func(T) U) *Page[U] {
+	items := make([]U, 0, len(p.Items))
+	for _, item := range p.Items {
+		items = append(items, fn(item))
+	}
+
+	return &Page[U]{
+		Items:  items,
+		Total:  p.Total,
+		Limit:  p.Limit,
+		Offset: p.Offset,
+	}
+}
+
+func (p *Page[T]) Number() int {
+	return p.Offset/p.Limit + 1
+}
+
+func (p *Page[T]) NextCursor() string {
+	next := p.Offset + len(p.Items)
+	if len(p.Items) == 0 || next >= p.Total {
+		return ""
+	}
+	return EncodeCursor(next)
+}
+
+func EncodeCursor(offset int) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(cursorPrefix + strconv.Itoa(offset)))
+}
+
+func DecodeCursor(cursor string) (int, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, fmt.Errorf("%w: got %s", ErrInvalidCursorPagination, cursor)
+	}
+
+	value, ok := strings.CutPrefix(string(raw), cursorPrefix)
+	if !ok {
+		return 0, fmt.Errorf("%w: got %s", ErrInvalidCursorPagination, cursor)
+	}
+
+	offset, err := strconv.Atoi(value)
+	if err != nil || offset < 0 {
+		return 0, fmt.Errorf("%w: got %s", ErrInvalidCursorPagination, cursor)
+	}
+
+	return offset, nil
+}
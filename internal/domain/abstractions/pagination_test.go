@@ -0,0 +1,83 @@
+package abstractions
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestNewPagination(t *testing.T) {
+	p, err := NewPagination(3, 10, "", "created_at", "DESC")
+
+	assert.NoError(t, err)
+	assert.Equal(t, 3, p.Page)
+	assert.Equal(t, 10, p.PageLimit())
+	assert.Equal(t, 20, p.Offset())
+	assert.Equal(t, "created_at", p.Sort)
+	assert.True(t, p.Descending())
+}
+
+func TestNewPagination_Defaults(t *testing.T) {
+	p, err := NewPagination(0, 0, "", "", "")
+
+	assert.NoError(t, err)
+	assert.Equal(t, DefaultPageLimit, p.PageLimit())
+	assert.Equal(t, 0, p.Offset())
+	assert.False(t, p.Descending())
+}
+
+func TestNewPagination_Cursor(t *testing.T) {
+	p, err := NewPagination(5, 10, EncodeCursor(42), "", "")
+
+	assert.NoError(t, err)
+	assert.Equal(t, 42, p.Offset())
+}
+
+func TestNewPagination_Invalid(t *testing.T) {
+	cases := []struct {
+		name          string
+		page, limit   int
+		cursor, order string
+		err           error
+	}{
+		{"Negative page", -1, 10, "", "", ErrInvalidPagePagination},
+		{"Negative limit", 1, -1, "", "", ErrInvalidLimitPagination},
+		{"Limit too big", 1, MaxPageLimit + 1, "", "", ErrInvalidLimitPagination},
+		{"Malformed cursor", 1, 10, "%%%", "", ErrInvalidCursorPagination},
+		{"Foreign cursor", 1, 10, "YWJj", "", ErrInvalidCursorPagination},
+		{"Unknown order", 1, 10, "", "up", ErrInvalidOrderPagination},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := NewPagination(tc.page, tc.limit, tc.cursor, "", tc.order)
+			assert.ErrorIs(t, err, tc.err)
+		})
+	}
+}
+
+func TestPage_NextCursor(t *testing.T) {
+	p := NewPage([]int{1, 2, 3}, 7, Pagination{Limit: 3})
+
+	assert.Equal(t, 1, p.Number())
+	assert.Equal(t, EncodeCursor(3), p.NextCursor())
+
+	offset, err := DecodeCursor(p.NextCursor())
+	assert.NoError(t, err)
+
+	last := NewPage([]int{7}, 7, Pagination{Limit: 3, Cursor: EncodeCursor(offset + 3)})
+
+	assert.Equal(t, 3, last.Number())
+	assert.Empty(t, last.NextCursor())
+}
+
+func TestMapPage(t *testing.T) {
+	p := NewPage([]int{1, 2}, 4, Pagination{Page: 2, Limit: 2})
+
+	mapped := MapPage(p, func(i int) string { return string(rune('a' + i)) })
+
+	assert.Equal(t, []string{"b", "c"}, mapped.Items)
+	assert.Equal(t, 4, mapped.Total)
+	assert.Equal(t, 2, mapped.Limit)
+	assert.Equal(t, 2, mapped.Offset)
+	assert.Empty(t, mapped.NextCursor())
+}
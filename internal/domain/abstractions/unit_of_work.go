@@ -0,0 +1,7 @@
+package abstractions
+
+import "context"
+
+type UnitOfWork interface {
+	Do(ctx context.Context, fn func(ctx context.Context) error) error
+}
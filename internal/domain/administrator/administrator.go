@@ -88,6 +88,11 @@ func (a *Administrator) Logged() {
 	a.lastLoginAt = time.Now()
 }
 
+func (a *Administrator) ChangePassword(password vo.Password) {
+	a.password = password
+	a.updatedAt = time.Now()
+}
+
 func NewAdministrator(firstName, lastName string, email vo.Email, password vo.Password, gender vo.Gender, birth vo.BirthDate, phone *vo.Phone) *Administrator {
 	return &Administrator{
 		AggregateRoot: abstractions.NewAggregateRoot(uuid.New()),
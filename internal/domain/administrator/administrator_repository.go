@@ -2,17 +2,26 @@ package administrators
 
 import (
 	"context"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/abstractions"
+	vo "github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
 	"github.com/google/uuid"
 )
 
+type AdministratorFilter struct {
+	Name    string
+	Gender  *vo.Gender
+	Deleted *bool
+}
+
 type AdministratorRepository interface {
-	GetAll(ctx context.Context) ([]*Administrator, error)
-	GetList(ctx context.Context) ([]*Administrator, error)
+	GetAll(ctx context.Context, filter AdministratorFilter, pagination abstractions.Pagination) (*abstractions.Page[*Administrator], error)
+	GetList(ctx context.Context, filter AdministratorFilter, pagination abstractions.Pagination) (*abstractions.Page[*Administrator], error)
 	GetById(ctx context.Context, id uuid.UUID) (*Administrator, error)
 	GetByEmail(ctx context.Context, email string) (*Administrator, error)
 
 	ExistById(ctx context.Context, id uuid.UUID) (bool, error)
 	ExistByEmail(ctx context.Context, email string) (bool, error)
+	IsSuper(ctx context.Context, id uuid.UUID) (bool, error)
 
 	Create(ctx context.Context, administrator *Administrator) (*Administrator, error)
 	Update(ctx context.Context, administrator *Administrator) (*Administrator, error)
@@ -2,10 +2,16 @@ package contracts
 
 import (
 	"errors"
+	"fmt"
 	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/abstractions"
 	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/delivery"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/holiday"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/plan"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/pricing"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/slot"
 	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
 	"github.com/google/uuid"
+	"sort"
 	"time"
 )
 
@@ -18,7 +24,14 @@ type Contract struct {
 	creationDate    time.Time
 	startDate       time.Time
 	endDate         time.Time
-	costValue       int
+	costValue       valueobjects.Money
+	price           *pricing.Breakdown
+	statusReason    string
+	suspendedAt     *time.Time
+	autoRenew       bool
+	renewedFrom     *uuid.UUID
+	weekdays        WeekdayMask
+	slotId          *uuid.UUID
 	deliveries      []deliveries.Delivery
 	createdAt       time.Time
 	updatedAt       time.Time
@@ -35,24 +48,177 @@ var (
 	ErrEmptyStreetContract           = errors.New("street name is empty")
 	ErrNumberPositiveNumberContract  = errors.New("number is not a positive number")
 	ErrChangeStatusContract          = errors.New("status cannot be change")
+	ErrNotFoundContract              = errors.New("contract not found")
+	ErrDateRangeContract             = errors.New("first date is after last date")
+	ErrNoDeliveriesInRangeContract   = errors.New("contract has no deliveries in the date range")
+	ErrReasonContract                = errors.New("reason is required")
+	ErrNotRenewableContract          = errors.New("contract cannot be renewed")
+	ErrAlreadyRenewedContract        = errors.New("contract was already renewed")
+	ErrAutoRenewContract             = errors.New("auto-renew cannot be changed")
+	ErrPriceContract                 = errors.New("price breakdown does not match the cost")
 )
 
 func (c *Contract) Active() error {
 	if c.contractStatus != Created {
-		return ErrChangeStatusContract
+		return fmt.Errorf("%w: %s to %s", ErrChangeStatusContract, c.contractStatus.String(), Active.String())
 	}
 	c.contractStatus = Active
+	c.raise(ContractActivated)
 	return nil
 }
 
 func (c *Contract) Completed() error {
-	if c.contractStatus != Active {
-		return ErrChangeStatusContract
+	if err := c.transition(Finished); err != nil {
+		return err
 	}
-	c.contractStatus = Finished
+	c.raise(ContractCompleted)
 	return nil
 }
 
+func (c *Contract) Suspend(reason string, at time.Time) error {
+	if reason == "" {
+		return ErrReasonContract
+	}
+
+	if err := c.transition(Suspended); err != nil {
+		return err
+	}
+	c.statusReason = reason
+	c.suspendedAt = &at
+	c.raise(ContractSuspended)
+	return nil
+}
+
+func (c *Contract) Resume(at time.Time, cal *holidays.Calendar) ([]*deliveries.Delivery, error) {
+	if c.contractStatus != Suspended || c.suspendedAt == nil {
+		return nil, fmt.Errorf("%w: %s to %s", ErrChangeStatusContract, c.contractStatus.String(), Active.String())
+	}
+
+	from := startOfDay(*c.suspendedAt)
+	days := daysBetween(from, startOfDay(at))
+
+	var moved []*deliveries.Delivery
+	if days > 0 {
+		for i := range c.deliveries {
+			d := &c.deliveries[i]
+			if d.Status() == deliveries.Pending && !d.Date().Before(from) {
+				moved = append(moved, d)
+			}
+		}
+		sort.Slice(moved, func(i, j int) bool { return moved[i].Date().Before(moved[j].Date()) })
+
+		rules := DeliveryRules{weekdays: c.weekdays, holidays: cal}
+		end := c.endDate.AddDate(0, 0, days)
+		dates := make([]time.Time, len(moved))
+		var last time.Time
+		for i, d := range moved {
+			date := d.Date().AddDate(0, 0, days)
+			if !last.IsZero() && !date.After(last) {
+				date = last.AddDate(0, 0, 1)
+			}
+
+			date, err := rules.next(at, date, d.Coordinates())
+			if err != nil {
+				return nil, err
+			}
+			dates[i], last = date, date
+		}
+
+		for i, d := range moved {
+			if err := d.Reschedule(dates[i]); err != nil {
+				return nil, err
+			}
+		}
+
+		if last.After(end) {
+			end = last
+		}
+		c.endDate = end
+	}
+
+	c.contractStatus = Active
+	c.statusReason = ""
+	c.suspendedAt = nil
+	c.raise(ContractResumed)
+	return moved, nil
+}
+
+func (c *Contract) Cancel(reason string) ([]*deliveries.Delivery, error) {
+	if reason == "" {
+		return nil, ErrReasonContract
+	}
+
+	if err := c.transition(Cancelled); err != nil {
+		return nil, err
+	}
+
+	var cancelled []*deliveries.Delivery
+	for i := range c.deliveries {
+		d := &c.deliveries[i]
+		if d.Status() != deliveries.Pending {
+			continue
+		}
+
+		if err := d.ChangeStatus(deliveries.Cancelled); err != nil {
+			return nil, err
+		}
+		cancelled = append(cancelled, d)
+	}
+
+	c.statusReason = reason
+	c.suspendedAt = nil
+	c.raise(ContractCancelled)
+	return cancelled, nil
+}
+
+func (c *Contract) transition(next ContractStatus) error {
+	if !c.contractStatus.CanTransitionTo(next) {
+		return fmt.Errorf("%w: %s to %s", ErrChangeStatusContract, c.contractStatus.String(), next.String())
+	}
+	c.contractStatus = next
+	return nil
+}
+
+func (c *Contract) UpdateDeliveries(first, last time.Time, street string, number int, coordinates valueobjects.Coordinates) ([]*deliveries.Delivery, error) {
+	if street == "" {
+		return nil, ErrEmptyStreetContract
+	}
+
+	if number <= 0 {
+		return nil, fmt.Errorf("%w: got %d", ErrNumberPositiveNumberContract, number)
+	}
+
+	from, to := startOfDay(first), startOfDay(last).AddDate(0, 0, 1)
+	if !from.Before(to) {
+		return nil, fmt.Errorf("%w: got %s - %s", ErrDateRangeContract, first.Format(time.DateOnly), last.Format(time.DateOnly))
+	}
+
+	var inRange []*deliveries.Delivery
+	for i := range c.deliveries {
+		d := &c.deliveries[i]
+		if d.Date().Before(from) || !d.Date().Before(to) {
+			continue
+		}
+
+		if d.Status() != deliveries.Pending {
+			return nil, fmt.Errorf("%w: delivery %s on %s", deliveries.ErrNotPendingDelivery, d.Id(), d.Date().Format(time.DateOnly))
+		}
+		inRange = append(inRange, d)
+	}
+
+	if len(inRange) == 0 {
+		return nil, ErrNoDeliveriesInRangeContract
+	}
+
+	for _, d := range inRange {
+		if err := d.Update(street, number, coordinates); err != nil {
+			return nil, err
+		}
+	}
+
+	return inRange, nil
+}
+
 func (c *Contract) Id() uuid.UUID {
 	return c.Entity.Id
 }
@@ -85,10 +251,68 @@ func (c *Contract) EndDate() time.Time {
 	return c.endDate
 }
 
-func (c *Contract) CostValue() int {
+func (c *Contract) CostValue() valueobjects.Money {
 	return c.costValue
 }
 
+func (c *Contract) Price() *pricing.Breakdown {
+	return c.price
+}
+
+func (c *Contract) Priced(b *pricing.Breakdown) error {
+	if b == nil {
+		c.price = nil
+		return nil
+	}
+
+	if b.Total() != c.costValue {
+		return fmt.Errorf("%w: got %s, the contract costs %s", ErrPriceContract, b.Total(), c.costValue)
+	}
+	c.price = b
+	return nil
+}
+
+func (c *Contract) StatusReason() string {
+	return c.statusReason
+}
+
+func (c *Contract) SuspendedAt() *time.Time {
+	return c.suspendedAt
+}
+
+func (c *Contract) AutoRenew() bool {
+	return c.autoRenew
+}
+
+func (c *Contract) RenewedFrom() *uuid.UUID {
+	return c.renewedFrom
+}
+
+func (c *Contract) Weekdays() WeekdayMask {
+	return c.weekdays
+}
+
+func (c *Contract) SlotId() *uuid.UUID {
+	return c.slotId
+}
+
+func (c *Contract) Book(slot *slots.Slot) error {
+	for i := range c.deliveries {
+		d := &c.deliveries[i]
+		if d.Status() != deliveries.Pending {
+			continue
+		}
+
+		if err := d.Book(slot); err != nil {
+			return err
+		}
+	}
+
+	id := slot.Id()
+	c.slotId = &id
+	return nil
+}
+
 func (c *Contract) Deliveries() []deliveries.Delivery {
 	return c.deliveries
 }
@@ -105,45 +329,45 @@ func (c *Contract) DeletedAt() *time.Time {
 	return c.deletedAt
 }
 
-func NewContract(administratorId uuid.UUID, patientId uuid.UUID, contractType ContractType, start time.Time, costValue int, street string, number int, coordinates valueobjects.Coordinates) *Contract {
+func NewContract(administratorId uuid.UUID, patientId uuid.UUID, plan *plans.Plan, start time.Time, costValue valueobjects.Money, street string, number int, coordinates valueobjects.Coordinates) *Contract {
 	id := uuid.New()
-	var endDate time.Time
-	if contractType == HalfMonth {
-		endDate = start.AddDate(0, 0, 14)
-	} else if contractType == Monthly {
-		endDate = start.AddDate(0, 0, 29)
-	}
 	return &Contract{
 		AggregateRoot:   abstractions.NewAggregateRoot(id),
 		administratorId: administratorId,
 		patientId:       patientId,
-		contractType:    contractType,
+		contractType:    ContractType(plan.Code()),
 		contractStatus:  Created,
 		creationDate:    time.Now(),
 		startDate:       start,
-		endDate:         endDate,
+		endDate:         plan.EndDate(start),
 		costValue:       costValue,
-		deliveries:      createCalendar(contractType, id, start, street, number, coordinates),
+		weekdays:        EveryDay,
+		deliveries:      createCalendar(plan.DeliveryDates(start), id, street, number, coordinates),
 	}
 }
 
-func createCalendar(typ ContractType, contractId uuid.UUID, date time.Time, street string, number int, coordinates valueobjects.Coordinates) []deliveries.Delivery {
-	var days []deliveries.Delivery
-	if typ == HalfMonth {
-		for i := 0; i < 15; i++ {
-			d := deliveries.NewDelivery(contractId, date.AddDate(0, 0, 0+i), street, number, coordinates)
-			days = append(days, *d)
-		}
-	} else if typ == Monthly {
-		for i := 0; i < 30; i++ {
-			d := deliveries.NewDelivery(contractId, date.AddDate(0, 0, 0+i), street, number, coordinates)
-			days = append(days, *d)
-		}
+func createCalendar(dates []time.Time, contractId uuid.UUID, street string, number int, coordinates valueobjects.Coordinates) []deliveries.Delivery {
+	days := make([]deliveries.Delivery, 0, len(dates))
+	for _, date := range dates {
+		d := deliveries.NewDelivery(contractId, date, street, number, coordinates)
+		days = append(days, *d)
 	}
 	return days
 }
 
-func NewContractFromDb(id, aId, pId uuid.UUID, cType, cStatus string, cDate, sDate, eDate time.Time, cost int, d []deliveries.Delivery, cAt, uAt time.Time, dAt *time.Time) (*Contract, error) {
+func startOfDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
+
+// daysBetween counts calendar days, so a daylight saving change in between
+// does not round the result down.
+func daysBetween(from, to time.Time) int {
+	f := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.UTC)
+	t := time.Date(to.Year(), to.Month(), to.Day(), 0, 0, 0, 0, time.UTC)
+	return int(t.Sub(f).Hours() / 24)
+}
+
+func NewContractFromDb(id, aId, pId uuid.UUID, cType, cStatus string, reason *string, suspendedAt *time.Time, autoRenew bool, renewedFrom *uuid.UUID, cDate, sDate, eDate time.Time, cost int, currency string, weekdays int, slotId *uuid.UUID, d []deliveries.Delivery, cAt, uAt time.Time, dAt *time.Time) (*Contract, error) {
 	contractType, err := ParseContractType(cType)
 	if err != nil {
 		return nil, err
@@ -154,6 +378,21 @@ func NewContractFromDb(id, aId, pId uuid.UUID, cType, cStatus string, cDate, sDa
 		return nil, err
 	}
 
+	costValue, err := valueobjects.NewMoney(cost, valueobjects.Currency(currency))
+	if err != nil {
+		return nil, err
+	}
+
+	mask := WeekdayMask(weekdays)
+	if weekdays < 0 || !mask.IsValid() {
+		return nil, fmt.Errorf("%w: got %d", ErrWeekdaysContract, weekdays)
+	}
+
+	var statusReason string
+	if reason != nil {
+		statusReason = *reason
+	}
+
 	return &Contract{
 		AggregateRoot:   abstractions.NewAggregateRoot(id),
 		administratorId: aId,
@@ -163,7 +402,13 @@ func NewContractFromDb(id, aId, pId uuid.UUID, cType, cStatus string, cDate, sDa
 		creationDate:    cDate,
 		startDate:       sDate,
 		endDate:         eDate,
-		costValue:       cost,
+		costValue:       costValue,
+		statusReason:    statusReason,
+		suspendedAt:     suspendedAt,
+		autoRenew:       autoRenew,
+		renewedFrom:     renewedFrom,
+		weekdays:        mask,
+		slotId:          slotId,
 		deliveries:      d,
 		createdAt:       cAt,
 		updatedAt:       uAt,
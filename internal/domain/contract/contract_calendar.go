@@ -0,0 +1,194 @@
+package contracts
+
+import (
+	"errors"
+	"fmt"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/holiday"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/plan"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	"strings"
+	"time"
+)
+
+type WeekdayMask uint8
+
+const (
+	EveryDay       WeekdayMask = 1<<7 - 1
+	MondayToFriday WeekdayMask = EveryDay &^ (1<<time.Saturday | 1<<time.Sunday)
+)
+
+const MaxCalendarDays = plans.MaxDurationDays
+
+var (
+	ErrWeekdaysContract     = errors.New("weekdays are not valid")
+	ErrExcludedDateContract = errors.New("excluded date is before the start date")
+	ErrCalendarContract     = errors.New("delivery calendar is too long")
+	ErrHolidayContract      = errors.New("delivery falls on a holiday")
+)
+
+var weekdayNames = map[string]time.Weekday{
+	"sunday":    time.Sunday,
+	"monday":    time.Monday,
+	"tuesday":   time.Tuesday,
+	"wednesday": time.Wednesday,
+	"thursday":  time.Thursday,
+	"friday":    time.Friday,
+	"saturday":  time.Saturday,
+}
+
+func NewWeekdayMask(days ...time.Weekday) WeekdayMask {
+	var m WeekdayMask
+	for _, d := range days {
+		m |= 1 << d
+	}
+	return m
+}
+
+func ParseWeekdayMask(days []string) (WeekdayMask, error) {
+	if len(days) == 0 {
+		return EveryDay, nil
+	}
+
+	var m WeekdayMask
+	for _, s := range days {
+		day, ok := parseWeekday(s)
+		if !ok {
+			return 0, fmt.Errorf("%w: got %s", ErrWeekdaysContract, s)
+		}
+		m |= 1 << day
+	}
+	return m, nil
+}
+
+func parseWeekday(s string) (time.Weekday, bool) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	if len(s) < 3 {
+		return 0, false
+	}
+
+	for name, day := range weekdayNames {
+		if name == s || name[:3] == s {
+			return day, true
+		}
+	}
+	return 0, false
+}
+
+func (m WeekdayMask) Has(day time.Weekday) bool {
+	return m&(1<<day) != 0
+}
+
+func (m WeekdayMask) IsValid() bool {
+	return m != 0 && m&^EveryDay == 0
+}
+
+func (m WeekdayMask) Weekdays() []string {
+	var names []string
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		if m.Has(day) {
+			names = append(names, strings.ToLower(day.String()))
+		}
+	}
+	return names
+}
+
+type DeliveryRules struct {
+	weekdays WeekdayMask
+	excluded map[string]struct{}
+	holidays *holidays.Calendar
+}
+
+func NewDeliveryRules(weekdays WeekdayMask, excluded []time.Time) (DeliveryRules, error) {
+	if !weekdays.IsValid() {
+		return DeliveryRules{}, fmt.Errorf("%w: got %07b", ErrWeekdaysContract, weekdays)
+	}
+
+	rules := DeliveryRules{weekdays: weekdays, excluded: make(map[string]struct{}, len(excluded))}
+	for _, date := range excluded {
+		rules.excluded[date.Format(time.DateOnly)] = struct{}{}
+	}
+	return rules, nil
+}
+
+func (r DeliveryRules) Weekdays() WeekdayMask {
+	if r.weekdays == 0 {
+		return EveryDay
+	}
+	return r.weekdays
+}
+
+func (r DeliveryRules) WithHolidays(c *holidays.Calendar) DeliveryRules {
+	r.holidays = c
+	return r
+}
+
+func (r DeliveryRules) Allows(day time.Time) bool {
+	if !r.Weekdays().Has(day.Weekday()) {
+		return false
+	}
+	_, skipped := r.excluded[day.Format(time.DateOnly)]
+	return !skipped
+}
+
+func (r DeliveryRules) calendar(plan *plans.Plan, start time.Time, at valueobjects.Coordinates) ([]time.Time, time.Time, error) {
+	first := startOfDay(start)
+	for date := range r.excluded {
+		if d, err := time.ParseInLocation(time.DateOnly, date, start.Location()); err == nil && d.Before(first) {
+			return nil, time.Time{}, fmt.Errorf("%w: got %s", ErrExcludedDateContract, date)
+		}
+	}
+
+	planned := plan.DeliveryDates(start)
+	if len(planned) == 0 {
+		return nil, plan.EndDate(start), nil
+	}
+
+	dates := make([]time.Time, 0, len(planned))
+	day := start
+	for len(dates) < len(planned) {
+		next, err := r.next(start, day, at)
+		if err != nil {
+			return nil, time.Time{}, err
+		}
+		dates = append(dates, next)
+		day = next.AddDate(0, 0, plan.Pattern().Interval())
+	}
+
+	end := plan.EndDate(start).AddDate(0, 0, daysBetween(planned[len(planned)-1], dates[len(dates)-1]))
+	if daysBetween(start, end) >= MaxCalendarDays {
+		return nil, time.Time{}, fmt.Errorf("%w: more than %d days", ErrCalendarContract, MaxCalendarDays)
+	}
+	return dates, end, nil
+}
+
+func (r DeliveryRules) next(start, day time.Time, at valueobjects.Coordinates) (time.Time, error) {
+	for {
+		if daysBetween(start, day) >= MaxCalendarDays {
+			return time.Time{}, fmt.Errorf("%w: more than %d days", ErrCalendarContract, MaxCalendarDays)
+		}
+
+		if r.Allows(day) {
+			h := r.holidays.ClosedOn(day, at)
+			if h == nil {
+				return day, nil
+			}
+
+			if h.Policy() == holidays.Refuse {
+				return time.Time{}, fmt.Errorf("%w: %s on %s", ErrHolidayContract, h.Name(), day.Format(time.DateOnly))
+			}
+		}
+		day = day.AddDate(0, 0, 1)
+	}
+}
+
+func (c *Contract) schedule(plan *plans.Plan, rules DeliveryRules, street string, number int, coordinates valueobjects.Coordinates) error {
+	dates, end, err := rules.calendar(plan, c.startDate, coordinates)
+	if err != nil {
+		return err
+	}
+
+	c.weekdays = rules.Weekdays()
+	c.endDate = end
+	c.deliveries = createCalendar(dates, c.Id(), street, number, coordinates)
+	return nil
+}
@@ -0,0 +1,262 @@
+package contracts
+
+import (
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/holiday"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/plan"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestParseWeekdayMask(t *testing.T) {
+	cases := []struct {
+		days     []string
+		expected WeekdayMask
+	}{
+		{nil, EveryDay},
+		{[]string{"mon", "tue", "wed", "thu", "fri"}, MondayToFriday},
+		{[]string{"Monday", " SATURDAY ", "sat"}, NewWeekdayMask(time.Monday, time.Saturday)},
+		{[]string{"sun"}, NewWeekdayMask(time.Sunday)},
+	}
+
+	for _, tc := range cases {
+		mask, err := ParseWeekdayMask(tc.days)
+		assert.NoError(t, err)
+		assert.Equal(t, tc.expected, mask)
+	}
+
+	for _, invalid := range []string{"", "mo", "funday", "m"} {
+		mask, err := ParseWeekdayMask([]string{invalid})
+		assert.ErrorIs(t, err, ErrWeekdaysContract)
+		assert.Zero(t, mask)
+	}
+
+	assert.Equal(t, []string{"monday", "tuesday", "wednesday", "thursday", "friday"}, MondayToFriday.Weekdays())
+}
+
+func TestNewDeliveryRules(t *testing.T) {
+	_, err := NewDeliveryRules(0, nil)
+	assert.ErrorIs(t, err, ErrWeekdaysContract)
+
+	_, err = NewDeliveryRules(EveryDay+1, nil)
+	assert.ErrorIs(t, err, ErrWeekdaysContract)
+
+	holiday := time.Date(2025, 3, 4, 0, 0, 0, 0, time.UTC)
+	rules, err := NewDeliveryRules(MondayToFriday, []time.Time{holiday})
+	assert.NoError(t, err)
+	assert.Equal(t, MondayToFriday, rules.Weekdays())
+	assert.True(t, rules.Allows(holiday.AddDate(0, 0, -1)))
+	assert.False(t, rules.Allows(holiday))
+	assert.False(t, rules.Allows(time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)))
+
+	assert.Equal(t, EveryDay, DeliveryRules{}.Weekdays())
+	assert.True(t, DeliveryRules{}.Allows(holiday))
+}
+
+func TestDeliveryRules_Calendar(t *testing.T) {
+	// 2025-03-03 is a Monday.
+	start := time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC)
+	weekdays, err := NewDeliveryRules(MondayToFriday, nil)
+	assert.NoError(t, err)
+
+	t.Run("every day keeps the plan", func(t *testing.T) {
+		dates, end, err := DeliveryRules{}.calendar(monthlyPlan, start, valueobjects.Coordinates{})
+
+		assert.NoError(t, err)
+		assert.Equal(t, monthlyPlan.DeliveryDates(start), dates)
+		assert.Equal(t, monthlyPlan.EndDate(start), end)
+	})
+
+	t.Run("weekdays only", func(t *testing.T) {
+		dates, end, err := weekdays.calendar(halfMonthPlan, start, valueobjects.Coordinates{})
+
+		assert.NoError(t, err)
+		assert.Len(t, dates, halfMonthPlan.DeliveryCount())
+		for _, d := range dates {
+			assert.NotEqual(t, time.Saturday, d.Weekday())
+			assert.NotEqual(t, time.Sunday, d.Weekday())
+		}
+		// Fifteen weekdays from a Monday end on the Friday two weeks later.
+		assert.Equal(t, "2025-03-21", dates[len(dates)-1].Format(time.DateOnly))
+		assert.Equal(t, "2025-03-21", end.Format(time.DateOnly))
+	})
+
+	t.Run("excluded dates", func(t *testing.T) {
+		rules, err := NewDeliveryRules(EveryDay, []time.Time{start, start.AddDate(0, 0, 5)})
+		assert.NoError(t, err)
+
+		dates, end, err := rules.calendar(halfMonthPlan, start, valueobjects.Coordinates{})
+
+		assert.NoError(t, err)
+		assert.Len(t, dates, 15)
+		assert.Equal(t, start.AddDate(0, 0, 1), dates[0])
+		assert.NotContains(t, dates, start.AddDate(0, 0, 5))
+		assert.Equal(t, halfMonthPlan.EndDate(start).AddDate(0, 0, 2), end)
+	})
+
+	t.Run("interval from the last delivery", func(t *testing.T) {
+		weekly := plans.NewPlan("WEEKLY", "Weekly", 28, plans.Weekly, 1000)
+		rules, err := NewDeliveryRules(NewWeekdayMask(time.Wednesday), nil)
+		assert.NoError(t, err)
+
+		dates, end, err := rules.calendar(weekly, start, valueobjects.Coordinates{})
+
+		assert.NoError(t, err)
+		assert.Len(t, dates, 4)
+		for _, d := range dates {
+			assert.Equal(t, time.Wednesday, d.Weekday())
+		}
+		assert.Equal(t, weekly.EndDate(start).AddDate(0, 0, 2), end)
+	})
+
+	t.Run("excluded date before start", func(t *testing.T) {
+		rules, err := NewDeliveryRules(EveryDay, []time.Time{start.AddDate(0, 0, -1)})
+		assert.NoError(t, err)
+
+		dates, _, err := rules.calendar(halfMonthPlan, start, valueobjects.Coordinates{})
+
+		assert.ErrorIs(t, err, ErrExcludedDateContract)
+		assert.Nil(t, dates)
+	})
+
+	t.Run("too long", func(t *testing.T) {
+		yearly := plans.NewPlan("YEARLY", "Yearly", 300, plans.Daily, 1000)
+		rules, err := NewDeliveryRules(NewWeekdayMask(time.Sunday), nil)
+		assert.NoError(t, err)
+
+		dates, _, err := rules.calendar(yearly, start, valueobjects.Coordinates{})
+
+		assert.ErrorIs(t, err, ErrCalendarContract)
+		assert.Nil(t, dates)
+	})
+}
+
+func TestContractFactory_Create_Rules(t *testing.T) {
+	coords, err := valueobjects.NewCoordinates(-17.7863, -63.1812)
+	assert.NoError(t, err)
+
+	start := time.Now().AddDate(0, 0, 3)
+	rules, err := NewDeliveryRules(MondayToFriday, []time.Time{start.AddDate(0, 0, 1)})
+	assert.NoError(t, err)
+
+	contract, err := NewContractFactory().Create(uuid.New(), uuid.New(), monthlyPlan, start, rules, tenBolivianos, "Sesame Street", 30, coords)
+
+	assert.NoError(t, err)
+	assert.Equal(t, MondayToFriday, contract.Weekdays())
+	assert.Len(t, contract.Deliveries(), monthlyPlan.DeliveryCount())
+	for _, d := range contract.Deliveries() {
+		assert.True(t, rules.Allows(d.Date()))
+	}
+	assert.True(t, contract.EndDate().After(monthlyPlan.EndDate(start)))
+
+	rules, err = NewDeliveryRules(EveryDay, []time.Time{start.AddDate(0, 0, -1)})
+	assert.NoError(t, err)
+
+	contract, err = NewContractFactory().Create(uuid.New(), uuid.New(), monthlyPlan, start, rules, tenBolivianos, "Sesame Street", 30, coords)
+
+	assert.ErrorIs(t, err, ErrExcludedDateContract)
+	assert.Nil(t, contract)
+}
+
+func TestContract_Renew_KeepsWeekdays(t *testing.T) {
+	coords, err := valueobjects.NewCoordinates(-17.7863, -63.1812)
+	assert.NoError(t, err)
+
+	start := time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC)
+	contract := NewContract(uuid.New(), uuid.New(), halfMonthPlan, start, tenBolivianos, "Sesame Street", 30, coords)
+	rules, err := NewDeliveryRules(MondayToFriday, nil)
+	assert.NoError(t, err)
+	assert.NoError(t, contract.schedule(halfMonthPlan, rules, "Sesame Street", 30, coords))
+	assert.NoError(t, contract.Active())
+
+	renewal, err := contract.Renew(halfMonthPlan, nil, contract.EndDate())
+
+	assert.NoError(t, err)
+	assert.Equal(t, MondayToFriday, renewal.Weekdays())
+	assert.Len(t, renewal.Deliveries(), halfMonthPlan.DeliveryCount())
+	for _, d := range renewal.Deliveries() {
+		assert.True(t, rules.Allows(d.Date()))
+	}
+}
+
+func TestDeliveryRules_Calendar_Holidays(t *testing.T) {
+	start := time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC)
+	home, err := valueobjects.NewCoordinates(-17.7863, -63.1812)
+	assert.NoError(t, err)
+	away, err := valueobjects.NewCoordinates(-16.5000, -68.1500)
+	assert.NoError(t, err)
+	area, err := holidays.NewArea("Santa Cruz", home, 5000)
+	assert.NoError(t, err)
+
+	t.Run("shift", func(t *testing.T) {
+		carnival := holidays.NewHoliday(start.AddDate(0, 0, 1), "Carnival", nil, holidays.Shift)
+		rules := DeliveryRules{}.WithHolidays(holidays.NewCalendar(carnival))
+
+		dates, end, err := rules.calendar(halfMonthPlan, start, home)
+
+		assert.NoError(t, err)
+		assert.Len(t, dates, 15)
+		assert.NotContains(t, dates, start.AddDate(0, 0, 1))
+		assert.Equal(t, halfMonthPlan.EndDate(start).AddDate(0, 0, 1), end)
+	})
+
+	t.Run("refuse", func(t *testing.T) {
+		closure := holidays.NewHoliday(start.AddDate(0, 0, 4), "Closure", nil, holidays.Refuse)
+		rules := DeliveryRules{}.WithHolidays(holidays.NewCalendar(closure))
+
+		dates, _, err := rules.calendar(halfMonthPlan, start, home)
+
+		assert.ErrorIs(t, err, ErrHolidayContract)
+		assert.ErrorContains(t, err, "2025-03-07")
+		assert.Nil(t, dates)
+	})
+
+	t.Run("regional", func(t *testing.T) {
+		regional := holidays.NewHoliday(start, "Anniversary", area, holidays.Refuse)
+		rules := DeliveryRules{}.WithHolidays(holidays.NewCalendar(regional))
+
+		_, _, err := rules.calendar(halfMonthPlan, start, home)
+		assert.ErrorIs(t, err, ErrHolidayContract)
+
+		dates, _, err := rules.calendar(halfMonthPlan, start, away)
+		assert.NoError(t, err)
+		assert.Equal(t, start, dates[0])
+	})
+}
+
+func TestContract_Resume_Holidays(t *testing.T) {
+	coords, err := valueobjects.NewCoordinates(-17.7863, -63.1812)
+	assert.NoError(t, err)
+
+	suspendedAt := time.Now().AddDate(0, 0, 1)
+	contract := NewContract(uuid.New(), uuid.New(), halfMonthPlan, suspendedAt, tenBolivianos, "Sesame Street", 30, coords)
+	assert.NoError(t, contract.Active())
+	assert.NoError(t, contract.Suspend("travel", suspendedAt))
+
+	resumedAt := suspendedAt.AddDate(0, 0, 2)
+	holiday := holidays.NewHoliday(resumedAt, "Holiday", nil, holidays.Shift)
+
+	moved, err := contract.Resume(resumedAt, holidays.NewCalendar(holiday))
+
+	assert.NoError(t, err)
+	assert.Len(t, moved, 15)
+	for i, d := range moved {
+		assert.NotEqual(t, resumedAt.Format(time.DateOnly), d.Date().Format(time.DateOnly))
+		if i > 0 {
+			assert.True(t, d.Date().After(moved[i-1].Date()))
+		}
+	}
+	assert.False(t, contract.EndDate().Before(moved[len(moved)-1].Date()))
+
+	suspended := NewContract(uuid.New(), uuid.New(), halfMonthPlan, suspendedAt, tenBolivianos, "Sesame Street", 30, coords)
+	assert.NoError(t, suspended.Active())
+	assert.NoError(t, suspended.Suspend("travel", suspendedAt))
+	closure := holidays.NewHoliday(resumedAt.AddDate(0, 0, 3), "Closure", nil, holidays.Refuse)
+
+	_, err = suspended.Resume(resumedAt, holidays.NewCalendar(closure))
+
+	assert.ErrorIs(t, err, ErrHolidayContract)
+	assert.Equal(t, Suspended, suspended.ContractStatus())
+}
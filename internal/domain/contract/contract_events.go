@@ -0,0 +1,36 @@
+package contracts
+
+import (
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/abstractions"
+	"github.com/google/uuid"
+)
+
+const (
+	ContractCreated   = "contract.created"
+	ContractActivated = "contract.activated"
+	ContractCompleted = "contract.completed"
+	ContractSuspended = "contract.suspended"
+	ContractResumed   = "contract.resumed"
+	ContractCancelled = "contract.cancelled"
+	ContractRenewed   = "contract.renewed"
+)
+
+type ContractEvent struct {
+	ContractId      uuid.UUID  `json:"contractId"`
+	AdministratorId uuid.UUID  `json:"administratorId"`
+	PatientId       uuid.UUID  `json:"patientId"`
+	Status          string     `json:"status"`
+	Reason          string     `json:"reason,omitempty"`
+	RenewedFrom     *uuid.UUID `json:"renewedFrom,omitempty"`
+}
+
+func (c *Contract) raise(name string) {
+	c.AddDomainEvent(*abstractions.NewNamedDomainEvent(name, c.Id(), ContractEvent{
+		ContractId:      c.Id(),
+		AdministratorId: c.administratorId,
+		PatientId:       c.patientId,
+		Status:          c.contractStatus.String(),
+		Reason:          c.statusReason,
+		RenewedFrom:     c.renewedFrom,
+	}))
+}
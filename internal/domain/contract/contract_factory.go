@@ -2,6 +2,7 @@ package contracts
 
 import (
 	"fmt"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/plan"
 	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
 	"github.com/google/uuid"
 	"log"
@@ -9,12 +10,12 @@ import (
 )
 
 type ContractFactory interface {
-	Create(administratorId, patientId uuid.UUID, contractType ContractType, start time.Time, cost int, street string, number int, coordinates valueobjects.Coordinates) (*Contract, error)
+	Create(administratorId, patientId uuid.UUID, plan *plans.Plan, start time.Time, rules DeliveryRules, cost valueobjects.Money, street string, number int, coordinates valueobjects.Coordinates) (*Contract, error)
 }
 
 type contractFactory struct{}
 
-func (contractFactory) Create(administratorId, patientId uuid.UUID, contractType ContractType, start time.Time, cost int, street string, number int, coordinates valueobjects.Coordinates) (*Contract, error) {
+func (contractFactory) Create(administratorId, patientId uuid.UUID, plan *plans.Plan, start time.Time, rules DeliveryRules, cost valueobjects.Money, street string, number int, coordinates valueobjects.Coordinates) (*Contract, error) {
 	if administratorId == uuid.Nil {
 		log.Printf("[factory:contract] administratorId '%s' is not a valid UUID", administratorId)
 		return nil, ErrAdministratorIdContract
@@ -25,19 +26,29 @@ func (contractFactory) Create(administratorId, patientId uuid.UUID, contractType
 		return nil, ErrPatientIdContract
 	}
 
-	if contractType != HalfMonth && contractType != Monthly {
-		log.Printf("[factory:contract] contractType '%s' is invalid", contractType)
-		return nil, fmt.Errorf("%w: got %s", ErrTypeContract, contractType.String())
+	if plan == nil {
+		log.Printf("[factory:contract] plan is missing")
+		return nil, fmt.Errorf("%w: no plan given", ErrTypeContract)
+	}
+
+	if !plan.Active() {
+		log.Printf("[factory:contract] plan '%s' is not active", plan.Code())
+		return nil, fmt.Errorf("%w: got %s", plans.ErrInactivePlan, plan.Code())
 	}
 
 	if !isAtLeastTwoDaysFromToday(start) {
-		log.Printf("[factory:contract] startDate '%s' is before it could be", contractType)
+		log.Printf("[factory:contract] startDate '%s' is before it could be", start)
 		return nil, fmt.Errorf("%w: got %v", ErrStartDateContract, start)
 	}
 
-	if cost <= 0 {
-		log.Printf("[factory:contract] cost '%v' suppose to be a positive number", contractType)
-		return nil, fmt.Errorf("%w: got %d", ErrCostNonPositiveNumberContract, cost)
+	if !cost.Currency().IsValid() {
+		log.Printf("[factory:contract] cost currency '%s' is not supported", cost.Currency())
+		return nil, fmt.Errorf("%w: got %s", valueobjects.ErrCurrencyMoney, cost.Currency())
+	}
+
+	if !cost.IsPositive() {
+		log.Printf("[factory:contract] cost '%v' suppose to be a positive number", cost)
+		return nil, fmt.Errorf("%w: got %s", ErrCostNonPositiveNumberContract, cost)
 	}
 
 	if street == "" {
@@ -50,8 +61,15 @@ func (contractFactory) Create(administratorId, patientId uuid.UUID, contractType
 		return nil, fmt.Errorf("%w: got %d", ErrNumberPositiveNumberContract, number)
 	}
 
-	log.Printf("[factory:contract] contractType '%s' is valid", contractType)
-	return NewContract(administratorId, patientId, contractType, start, cost, street, number, coordinates), nil
+	log.Printf("[factory:contract] plan '%s' is valid", plan.Code())
+	contract := NewContract(administratorId, patientId, plan, start, cost, street, number, coordinates)
+	if err := contract.schedule(plan, rules, street, number, coordinates); err != nil {
+		log.Printf("[factory:contract] calendar of plan '%s' from '%s' is not valid: %v", plan.Code(), start, err)
+		return nil, err
+	}
+
+	contract.raise(ContractCreated)
+	return contract, nil
 }
 
 func isAtLeastTwoDaysFromToday(date time.Time) bool {
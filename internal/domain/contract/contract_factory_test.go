@@ -2,6 +2,7 @@ package contracts
 
 import (
 	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/delivery"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/plan"
 	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -55,7 +56,7 @@ func TestContractFactory_Create(t *testing.T) {
 			assert.Equal(t, coords.Latitude(), tc.latitude)
 			assert.Equal(t, coords.Longitude(), tc.longitude)
 
-			contract, err := factory.Create(adminId, patientId, ctype, tc.start, tc.cost, tc.street, tc.number, coords)
+			contract, err := factory.Create(adminId, patientId, planOf(ctype), tc.start, DeliveryRules{}, bolivianos(tc.cost), tc.street, tc.number, coords)
 			assert.NotNil(t, contract)
 			assert.NotNil(t, contract.Id())
 			assert.NotNil(t, contract.EndDate())
@@ -68,9 +69,11 @@ func TestContractFactory_Create(t *testing.T) {
 			assert.Equal(t, ctype, contract.ContractType())
 			assert.Contains(t, []string{"monthly", "half-month"}, contract.ContractType().String())
 			assert.Equal(t, tc.start.Format(time.RFC3339), contract.StartDate().Format(time.RFC3339))
-			assert.Equal(t, tc.cost, contract.CostValue())
+			assert.Equal(t, bolivianos(tc.cost), contract.CostValue())
 			assert.Equal(t, Created, contract.ContractStatus())
 			assert.WithinDuration(t, time.Now(), contract.CreationDate(), time.Second)
+			assert.Len(t, contract.DomainEvents(), 1)
+			assert.Equal(t, ContractCreated, contract.DomainEvents()[0].Name())
 
 			days := 0
 			if ctype == Monthly {
@@ -128,57 +131,70 @@ func TestContractFactory_Create_Errors(t *testing.T) {
 
 	administratorId := uuid.Nil
 	patientId := uuid.New()
-	contractType := Monthly
+	plan := monthlyPlan
 	start := time.Now().AddDate(0, 0, 5)
-	cost := 100
+	cost := bolivianos(100)
 	street := "Main Street"
 	number := 123
 	coordinates, err := valueobjects.NewCoordinates(40.7128, -74.0060)
 	assert.NoError(t, err)
 
-	contract, err := factory.Create(administratorId, patientId, contractType, start, cost, street, number, coordinates)
+	contract, err := factory.Create(administratorId, patientId, plan, start, DeliveryRules{}, cost, street, number, coordinates)
 	assert.ErrorIs(t, err, ErrAdministratorIdContract)
 	assert.Nil(t, contract)
 
 	administratorId = uuid.New()
 	patientId = uuid.Nil
 
-	contract, err = factory.Create(administratorId, patientId, contractType, start, cost, street, number, coordinates)
+	contract, err = factory.Create(administratorId, patientId, plan, start, DeliveryRules{}, cost, street, number, coordinates)
 	assert.ErrorIs(t, err, ErrPatientIdContract)
 	assert.Nil(t, contract)
 
 	patientId = uuid.New()
-	contractType = ContractType("X")
+	plan = nil
 
-	contract, err = factory.Create(administratorId, patientId, contractType, start, cost, street, number, coordinates)
+	contract, err = factory.Create(administratorId, patientId, plan, start, DeliveryRules{}, cost, street, number, coordinates)
 	assert.ErrorIs(t, err, ErrTypeContract)
 	assert.Nil(t, contract)
 
-	contractType = HalfMonth
+	plan = plans.NewPlan("RETIRED", "Retired", 15, plans.Daily, 0)
+	plan.Deactivate()
+
+	contract, err = factory.Create(administratorId, patientId, plan, start, DeliveryRules{}, cost, street, number, coordinates)
+	assert.ErrorIs(t, err, plans.ErrInactivePlan)
+	assert.Nil(t, contract)
+
+	plan = halfMonthPlan
 	start = time.Now().AddDate(0, 0, 1)
 
-	contract, err = factory.Create(administratorId, patientId, contractType, start, cost, street, number, coordinates)
+	contract, err = factory.Create(administratorId, patientId, plan, start, DeliveryRules{}, cost, street, number, coordinates)
 	assert.ErrorIs(t, err, ErrStartDateContract)
 	assert.Nil(t, contract)
 
 	start = time.Now().AddDate(0, 0, 5)
-	cost = -10
+	cost = bolivianos(-10)
 
-	contract, err = factory.Create(administratorId, patientId, contractType, start, cost, street, number, coordinates)
+	contract, err = factory.Create(administratorId, patientId, plan, start, DeliveryRules{}, cost, street, number, coordinates)
 	assert.ErrorIs(t, err, ErrCostNonPositiveNumberContract)
 	assert.Nil(t, contract)
 
-	cost = 100
+	cost = valueobjects.Money{}
+
+	contract, err = factory.Create(administratorId, patientId, plan, start, DeliveryRules{}, cost, street, number, coordinates)
+	assert.ErrorIs(t, err, valueobjects.ErrCurrencyMoney)
+	assert.Nil(t, contract)
+
+	cost = bolivianos(100)
 	street = ""
 
-	contract, err = factory.Create(administratorId, patientId, contractType, start, cost, street, number, coordinates)
+	contract, err = factory.Create(administratorId, patientId, plan, start, DeliveryRules{}, cost, street, number, coordinates)
 	assert.ErrorIs(t, err, ErrEmptyStreetContract)
 	assert.Nil(t, contract)
 
 	street = "Main Street"
 	number = 0
 
-	contract, err = factory.Create(administratorId, patientId, contractType, start, cost, street, number, coordinates)
+	contract, err = factory.Create(administratorId, patientId, plan, start, DeliveryRules{}, cost, street, number, coordinates)
 	assert.ErrorIs(t, err, ErrNumberPositiveNumberContract)
 	assert.Nil(t, contract)
 }
@@ -0,0 +1,86 @@
+package contracts
+
+import (
+	"fmt"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/delivery"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/holiday"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/plan"
+	"time"
+)
+
+const (
+	RenewalWindowDays   = 7
+	AutoRenewOptOutDays = 3
+)
+
+func (c *Contract) SetAutoRenew(enabled bool) error {
+	if c.contractStatus == Finished || c.contractStatus == Cancelled {
+		return fmt.Errorf("%w: contract is %s", ErrAutoRenewContract, c.contractStatus.String())
+	}
+	c.autoRenew = enabled
+	return nil
+}
+
+func (c *Contract) OptOutDeadline() time.Time {
+	return startOfDay(c.endDate).AddDate(0, 0, -AutoRenewOptOutDays)
+}
+
+func (c *Contract) AutoRenewDue(now time.Time) bool {
+	if !c.autoRenew || (c.contractStatus != Active && c.contractStatus != Finished) {
+		return false
+	}
+	return !startOfDay(now).Before(c.OptOutDeadline())
+}
+
+func (c *Contract) Renew(plan *plans.Plan, cal *holidays.Calendar, now time.Time) (*Contract, error) {
+	today, end := startOfDay(now), startOfDay(c.endDate)
+
+	switch c.contractStatus {
+	case Finished:
+	case Active:
+		if today.Before(end.AddDate(0, 0, -RenewalWindowDays)) {
+			return nil, fmt.Errorf("%w: ends on %s", ErrNotRenewableContract, c.endDate.Format(time.DateOnly))
+		}
+	default:
+		return nil, fmt.Errorf("%w: contract is %s", ErrNotRenewableContract, c.contractStatus.String())
+	}
+
+	start := end.AddDate(0, 0, 1)
+	if start.Before(today) {
+		return nil, fmt.Errorf("%w: renewal would start on %s", ErrNotRenewableContract, start.Format(time.DateOnly))
+	}
+
+	if plan == nil || plan.Code() != string(c.contractType) {
+		return nil, fmt.Errorf("%w: renewal needs plan %s", ErrTypeContract, c.contractType.String())
+	}
+
+	if !plan.Active() {
+		return nil, fmt.Errorf("%w: %w: %s", ErrNotRenewableContract, plans.ErrInactivePlan, plan.Code())
+	}
+
+	last := c.lastDelivery()
+	if last == nil {
+		return nil, fmt.Errorf("%w: contract has no deliveries", ErrNotRenewableContract)
+	}
+
+	renewal := NewContract(c.administratorId, c.patientId, plan, start, c.costValue, last.Street(), last.Number(), last.Coordinates())
+	if err := renewal.schedule(plan, DeliveryRules{weekdays: c.weekdays, holidays: cal}, last.Street(), last.Number(), last.Coordinates()); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrNotRenewableContract, err)
+	}
+
+	id := c.Id()
+	renewal.renewedFrom = &id
+	renewal.autoRenew = c.autoRenew
+	renewal.raise(ContractRenewed)
+	return renewal, nil
+}
+
+func (c *Contract) lastDelivery() *deliveries.Delivery {
+	var last *deliveries.Delivery
+	for i := range c.deliveries {
+		if last == nil || c.deliveries[i].Date().After(last.Date()) {
+			last = &c.deliveries[i]
+		}
+	}
+	return last
+}
@@ -0,0 +1,100 @@
+package contracts
+
+import (
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/delivery"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestContract_Renew(t *testing.T) {
+	coords, err := valueobjects.NewCoordinates(-17.7863, -63.1812)
+	assert.NoError(t, err)
+	moved, err := valueobjects.NewCoordinates(51.5237, -0.1585)
+	assert.NoError(t, err)
+
+	start := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	contract := NewContract(uuid.New(), uuid.New(), halfMonthPlan, start, tenBolivianos, "Sesame Street", 30, coords)
+	assert.NoError(t, contract.Active())
+	assert.NoError(t, contract.SetAutoRenew(true))
+	_, err = contract.UpdateDeliveries(start.AddDate(0, 0, 10), start.AddDate(0, 0, 14), "Baker Street", 221, moved)
+	assert.NoError(t, err)
+
+	_, err = contract.Renew(halfMonthPlan, nil, start.AddDate(0, 0, 7).Add(-time.Hour))
+	assert.ErrorIs(t, err, ErrNotRenewableContract)
+
+	renewal, err := contract.Renew(halfMonthPlan, nil, start.AddDate(0, 0, 7))
+	assert.NoError(t, err)
+	assert.NotEqual(t, contract.Id(), renewal.Id())
+	assert.Equal(t, contract.AdministratorId(), renewal.AdministratorId())
+	assert.Equal(t, contract.PatientId(), renewal.PatientId())
+	assert.Equal(t, HalfMonth, renewal.ContractType())
+	assert.Equal(t, Created, renewal.ContractStatus())
+	assert.Equal(t, tenBolivianos, renewal.CostValue())
+	assert.Equal(t, start.AddDate(0, 0, 15), renewal.StartDate())
+	assert.Equal(t, contract.Id(), *renewal.RenewedFrom())
+	assert.True(t, renewal.AutoRenew())
+	assert.Len(t, renewal.Deliveries(), 15)
+	assert.Equal(t, "Baker Street", renewal.Deliveries()[0].Street())
+	assert.Equal(t, 221, renewal.Deliveries()[0].Number())
+	assert.Equal(t, moved, renewal.Deliveries()[0].Coordinates())
+
+	events := renewal.DomainEvents()
+	assert.Len(t, events, 1)
+	assert.Equal(t, ContractRenewed, events[0].Name())
+	assert.Equal(t, contract.Id(), *events[0].Payload().(ContractEvent).RenewedFrom)
+}
+
+func TestContract_Renew_Invalid(t *testing.T) {
+	start := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 0, 14)
+
+	created := NewContract(uuid.New(), uuid.New(), halfMonthPlan, start, tenBolivianos, "Sesame Street", 30, valueobjects.Coordinates{})
+	_, err := created.Renew(halfMonthPlan, nil, end)
+	assert.ErrorIs(t, err, ErrNotRenewableContract)
+
+	cancelled := NewContract(uuid.New(), uuid.New(), halfMonthPlan, start, tenBolivianos, "Sesame Street", 30, valueobjects.Coordinates{})
+	_, err = cancelled.Cancel("moved abroad")
+	assert.NoError(t, err)
+	_, err = cancelled.Renew(halfMonthPlan, nil, end)
+	assert.ErrorIs(t, err, ErrNotRenewableContract)
+
+	finished := NewContract(uuid.New(), uuid.New(), halfMonthPlan, start, tenBolivianos, "Sesame Street", 30, valueobjects.Coordinates{})
+	assert.NoError(t, finished.Active())
+	assert.NoError(t, finished.Completed())
+	_, err = finished.Renew(halfMonthPlan, nil, end.AddDate(0, 0, 2))
+	assert.ErrorIs(t, err, ErrNotRenewableContract)
+
+	renewal, err := finished.Renew(halfMonthPlan, nil, end.AddDate(0, 0, 1))
+	assert.NoError(t, err)
+	assert.Equal(t, end.AddDate(0, 0, 1), renewal.StartDate())
+
+	empty, err := NewContractFromDb(uuid.New(), uuid.New(), uuid.New(), "H", "F", nil, nil, false, nil, start, start, end, 1000, "BOB", int(EveryDay), nil, []deliveries.Delivery{}, start, start, nil)
+	assert.NoError(t, err)
+	_, err = empty.Renew(halfMonthPlan, nil, end)
+	assert.ErrorIs(t, err, ErrNotRenewableContract)
+}
+
+func TestContract_AutoRenew(t *testing.T) {
+	start := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	contract := NewContract(uuid.New(), uuid.New(), halfMonthPlan, start, tenBolivianos, "Sesame Street", 30, valueobjects.Coordinates{})
+	deadline := start.AddDate(0, 0, 14-AutoRenewOptOutDays)
+
+	assert.Equal(t, deadline, contract.OptOutDeadline())
+	assert.False(t, contract.AutoRenewDue(deadline))
+
+	assert.NoError(t, contract.SetAutoRenew(true))
+	assert.False(t, contract.AutoRenewDue(deadline), "created contracts are not renewed")
+
+	assert.NoError(t, contract.Active())
+	assert.False(t, contract.AutoRenewDue(deadline.Add(-time.Minute)))
+	assert.True(t, contract.AutoRenewDue(deadline))
+
+	assert.NoError(t, contract.SetAutoRenew(false))
+	assert.False(t, contract.AutoRenewDue(deadline))
+
+	assert.NoError(t, contract.Completed())
+	assert.ErrorIs(t, contract.SetAutoRenew(true), ErrAutoRenewContract)
+}
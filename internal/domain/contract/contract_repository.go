@@ -2,23 +2,45 @@ package contracts
 
 import (
 	"context"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/abstractions"
 	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/delivery"
 	"github.com/google/uuid"
+	"time"
 )
 
+type ContractFilter struct {
+	Status          *ContractStatus
+	Type            *ContractType
+	PatientId       *uuid.UUID
+	AdministratorId *uuid.UUID
+	From            *time.Time
+	To              *time.Time
+}
+
 type ContractRepository interface {
-	GetAll(ctx context.Context) ([]*Contract, error)
+	GetAll(ctx context.Context, filter ContractFilter, pagination abstractions.Pagination) (*abstractions.Page[*Contract], error)
 	GetById(ctx context.Context, id uuid.UUID) (*Contract, error)
 
 	Create(ctx context.Context, contract *Contract) (*Contract, error)
-	ChangeStatus(ctx context.Context, id uuid.UUID, status string) (*Contract, error)
+	ChangeStatus(ctx context.Context, contract *Contract) (*Contract, error)
+	SetAutoRenew(ctx context.Context, contract *Contract) error
 
 	ExistById(ctx context.Context, id uuid.UUID) (bool, error)
+	ExistsRenewal(ctx context.Context, id uuid.UUID) (bool, error)
+	GetAutoRenewable(ctx context.Context, since, until time.Time) ([]uuid.UUID, error)
+	GetToActivate(ctx context.Context, before time.Time) ([]uuid.UUID, error)
+	GetToComplete(ctx context.Context, before time.Time) ([]uuid.UUID, error)
+	GetWithPastDeliveries(ctx context.Context, before time.Time) ([]uuid.UUID, error)
 	Count(ctx context.Context) (int, error)
 
-	GetAllDeliveries(ctx context.Context) ([]*deliveries.Delivery, error)
+	GetAllDeliveries(ctx context.Context, contractId uuid.UUID) ([]*deliveries.Delivery, error)
 	GetDeliveriesById(ctx context.Context, id uuid.UUID) (*deliveries.Delivery, error)
+	GetPendingDeliveriesOn(ctx context.Context, day time.Time) ([]*deliveries.Delivery, error)
 
 	UpdateDelivery(ctx context.Context, id uuid.UUID, delivery *deliveries.Delivery) (*deliveries.Delivery, error)
-	ChangeStatusDelivery(ctx context.Context, id uuid.UUID, status string) (*deliveries.Delivery, error)
+	UpdateDeliveries(ctx context.Context, contractId uuid.UUID, deliveries []*deliveries.Delivery) ([]*deliveries.Delivery, error)
+	RescheduleDeliveries(ctx context.Context, contractId uuid.UUID, deliveries []*deliveries.Delivery) error
+	AssignDeliveries(ctx context.Context, deliveries []*deliveries.Delivery) error
+	BookDelivery(ctx context.Context, delivery *deliveries.Delivery) (*deliveries.Delivery, error)
+	ChangeStatusDelivery(ctx context.Context, delivery *deliveries.Delivery) (*deliveries.Delivery, error)
 }
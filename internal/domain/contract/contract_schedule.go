@@ -0,0 +1,36 @@
+package contracts
+
+import (
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/delivery"
+	"time"
+)
+
+func (c *Contract) ActivationDue(now time.Time) bool {
+	return c.contractStatus == Created && !startOfDay(now).Before(startOfDay(c.startDate))
+}
+
+func (c *Contract) CompletionDue(now time.Time) bool {
+	return c.contractStatus == Active && startOfDay(c.endDate).Before(startOfDay(now))
+}
+
+func (c *Contract) ClosePastDeliveries(now time.Time) ([]*deliveries.Delivery, error) {
+	if c.contractStatus != Active {
+		return nil, nil
+	}
+
+	today := startOfDay(now)
+	var closed []*deliveries.Delivery
+	for i := range c.deliveries {
+		d := &c.deliveries[i]
+		if d.Status() != deliveries.Pending || !d.Date().Before(today) {
+			continue
+		}
+
+		if err := d.ChangeStatus(deliveries.Cancelled); err != nil {
+			return nil, err
+		}
+		closed = append(closed, d)
+	}
+
+	return closed, nil
+}
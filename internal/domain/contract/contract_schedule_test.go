@@ -0,0 +1,61 @@
+package contracts
+
+import (
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/delivery"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestContract_ActivationDue(t *testing.T) {
+	start := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	contract := NewContract(uuid.New(), uuid.New(), halfMonthPlan, start, tenBolivianos, "Sesame Street", 30, valueobjects.Coordinates{})
+
+	assert.False(t, contract.ActivationDue(start.Add(-time.Minute)))
+	assert.True(t, contract.ActivationDue(start))
+	assert.True(t, contract.ActivationDue(start.AddDate(0, 0, 3)))
+
+	assert.NoError(t, contract.Active())
+	assert.False(t, contract.ActivationDue(start))
+}
+
+func TestContract_CompletionDue(t *testing.T) {
+	start := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 0, 14)
+	contract := NewContract(uuid.New(), uuid.New(), halfMonthPlan, start, tenBolivianos, "Sesame Street", 30, valueobjects.Coordinates{})
+
+	assert.False(t, contract.CompletionDue(end.AddDate(0, 0, 1)), "created contracts are not completed")
+
+	assert.NoError(t, contract.Active())
+	assert.False(t, contract.CompletionDue(end.Add(23*time.Hour)))
+	assert.True(t, contract.CompletionDue(end.AddDate(0, 0, 1)))
+}
+
+func TestContract_ClosePastDeliveries(t *testing.T) {
+	start := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	contract := NewContract(uuid.New(), uuid.New(), halfMonthPlan, start, tenBolivianos, "Sesame Street", 30, valueobjects.Coordinates{})
+	now := start.AddDate(0, 0, 4).Add(8 * time.Hour)
+
+	closed, err := contract.ClosePastDeliveries(now)
+	assert.NoError(t, err)
+	assert.Empty(t, closed)
+
+	assert.NoError(t, contract.Active())
+	assert.NoError(t, contract.Deliveries()[1].ChangeStatus(deliveries.Cancelled))
+
+	closed, err = contract.ClosePastDeliveries(now)
+	assert.NoError(t, err)
+	assert.Len(t, closed, 3)
+	for _, d := range closed {
+		assert.Equal(t, deliveries.Cancelled, d.Status())
+		assert.True(t, d.Date().Before(start.AddDate(0, 0, 4)))
+	}
+	assert.Equal(t, deliveries.Cancelled, contract.Deliveries()[1].Status())
+	assert.Equal(t, deliveries.Pending, contract.Deliveries()[4].Status())
+
+	closed, err = contract.ClosePastDeliveries(now)
+	assert.NoError(t, err)
+	assert.Empty(t, closed)
+}
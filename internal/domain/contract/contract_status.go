@@ -5,11 +5,19 @@ import "fmt"
 type ContractStatus string
 
 const (
-	Created  ContractStatus = "C" // Created
-	Active   ContractStatus = "A" // Active
-	Finished ContractStatus = "F" // Finished
+	Created   ContractStatus = "C" // Created
+	Active    ContractStatus = "A" // Active
+	Finished  ContractStatus = "F" // Finished
+	Suspended ContractStatus = "S" // Suspended
+	Cancelled ContractStatus = "N" // Cancelled
 )
 
+var transitions = map[ContractStatus][]ContractStatus{
+	Created:   {Active, Cancelled},
+	Active:    {Finished, Suspended, Cancelled},
+	Suspended: {Active, Cancelled},
+}
+
 func (s ContractStatus) String() string {
 	switch s {
 	case Created:
@@ -18,11 +26,24 @@ func (s ContractStatus) String() string {
 		return "active"
 	case Finished:
 		return "finished"
+	case Suspended:
+		return "suspended"
+	case Cancelled:
+		return "cancelled"
 	default:
 		return "unknown"
 	}
 }
 
+func (s ContractStatus) CanTransitionTo(next ContractStatus) bool {
+	for _, t := range transitions[s] {
+		if t == next {
+			return true
+		}
+	}
+	return false
+}
+
 func ParseContractStatus(s string) (ContractStatus, error) {
 	switch s {
 	case "created", "C":
@@ -31,6 +52,10 @@ func ParseContractStatus(s string) (ContractStatus, error) {
 		return Active, nil
 	case "finished", "F":
 		return Finished, nil
+	case "suspended", "S":
+		return Suspended, nil
+	case "cancelled", "N":
+		return Cancelled, nil
 	default:
 		return "", fmt.Errorf("%w: got %s", ErrStatusContract, s)
 	}
@@ -51,3 +51,26 @@ func TestContractStatus(t *testing.T) {
 	assert.Error(t, err)
 	assert.Equal(t, ContractStatus(""), cs)
 }
+
+func TestContractStatus_Lifecycle(t *testing.T) {
+	cs, err := ParseContractStatus("suspended")
+	assert.NoError(t, err)
+	assert.Equal(t, Suspended, cs)
+	assert.Equal(t, "suspended", cs.String())
+
+	cs, err = ParseContractStatus("N")
+	assert.NoError(t, err)
+	assert.Equal(t, Cancelled, cs)
+	assert.Equal(t, "cancelled", cs.String())
+
+	assert.True(t, Created.CanTransitionTo(Active))
+	assert.True(t, Created.CanTransitionTo(Cancelled))
+	assert.False(t, Created.CanTransitionTo(Suspended))
+	assert.True(t, Active.CanTransitionTo(Suspended))
+	assert.True(t, Active.CanTransitionTo(Finished))
+	assert.True(t, Suspended.CanTransitionTo(Active))
+	assert.False(t, Suspended.CanTransitionTo(Finished))
+	assert.False(t, Active.CanTransitionTo(Active))
+	assert.False(t, Finished.CanTransitionTo(Cancelled))
+	assert.False(t, Cancelled.CanTransitionTo(Active))
+}
@@ -2,12 +2,34 @@ package contracts
 
 import (
 	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/delivery"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/plan"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/pricing"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/slot"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"testing"
 	"time"
 )
 
+var (
+	halfMonthPlan = plans.NewPlan(string(HalfMonth), "Half-month", 15, plans.Daily, 1000)
+	monthlyPlan   = plans.NewPlan(string(Monthly), "Monthly", 30, plans.Daily, 2000)
+	tenBolivianos = bolivianos(1000)
+)
+
+func bolivianos(amount int) valueobjects.Money {
+	m, _ := valueobjects.NewMoney(amount, valueobjects.BOB)
+	return m
+}
+
+func planOf(t ContractType) *plans.Plan {
+	if t == Monthly {
+		return monthlyPlan
+	}
+	return halfMonthPlan
+}
+
 func TestNewContractFromDb(t *testing.T) {
 	cases := []struct {
 		name                             string
@@ -15,6 +37,7 @@ func TestNewContractFromDb(t *testing.T) {
 		cType, cStatus                   string
 		creationDate, startDate, endDate time.Time
 		costValue                        int
+		currency                         string
 		createdAt, updatedAt             time.Time
 		deletedAt                        *time.Time
 	}{
@@ -24,7 +47,7 @@ func TestNewContractFromDb(t *testing.T) {
 			time.Now().AddDate(0, -1, 0),
 			time.Now().AddDate(0, 0, 3),
 			time.Now().AddDate(0, 0, 3).AddDate(0, 0, 29),
-			200,
+			200, "BOB",
 			time.Now().AddDate(0, -1, 0),
 			time.Now().AddDate(0, -1, 0),
 			nil,
@@ -36,7 +59,7 @@ func TestNewContractFromDb(t *testing.T) {
 			time.Now().AddDate(0, -2, 0),
 			time.Now().AddDate(0, 0, 5),
 			time.Now().AddDate(0, 0, 5).AddDate(0, 0, 14),
-			150,
+			150, "USD",
 			time.Now().AddDate(0, -2, 0),
 			time.Now().AddDate(0, -2, 0),
 			nil,
@@ -46,8 +69,8 @@ func TestNewContractFromDb(t *testing.T) {
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
 			contract, err := NewContractFromDb(
-				tc.id, tc.adminId, tc.patientId, tc.cType, tc.cStatus,
-				tc.creationDate, tc.startDate, tc.endDate, tc.costValue,
+				tc.id, tc.adminId, tc.patientId, tc.cType, tc.cStatus, nil, nil, false, nil,
+				tc.creationDate, tc.startDate, tc.endDate, tc.costValue, tc.currency, int(MondayToFriday), nil,
 				[]deliveries.Delivery{}, tc.createdAt, tc.updatedAt, tc.deletedAt,
 			)
 
@@ -61,7 +84,9 @@ func TestNewContractFromDb(t *testing.T) {
 			assert.Equal(t, tc.creationDate.Format(time.RFC3339), contract.CreationDate().Format(time.RFC3339))
 			assert.Equal(t, tc.startDate.Format(time.RFC3339), contract.StartDate().Format(time.RFC3339))
 			assert.Equal(t, tc.endDate.Format(time.RFC3339), contract.EndDate().Format(time.RFC3339))
-			assert.Equal(t, tc.costValue, contract.CostValue())
+			assert.Equal(t, tc.costValue, contract.CostValue().Amount())
+			assert.Equal(t, tc.currency, string(contract.CostValue().Currency()))
+			assert.Equal(t, MondayToFriday, contract.Weekdays())
 			assert.Equal(t, tc.createdAt.Format(time.RFC3339), contract.CreatedAt().Format(time.RFC3339))
 			assert.Equal(t, tc.updatedAt.Format(time.RFC3339), contract.UpdatedAt().Format(time.RFC3339))
 			if tc.deletedAt != nil {
@@ -81,7 +106,7 @@ func TestNewContractFromDB_Invalid(t *testing.T) {
 	id := uuid.New()
 	administratorId := uuid.New()
 	patientId := uuid.New()
-	ctype := "X"
+	ctype := "x"
 	status := "X"
 	created := time.Now()
 	start := time.Now().AddDate(0, 0, 5)
@@ -91,18 +116,25 @@ func TestNewContractFromDB_Invalid(t *testing.T) {
 	createdAt := time.Now().AddDate(0, -6, 0)
 	updatedAt := time.Now().AddDate(0, -3, 0)
 
-	contract, err := NewContractFromDb(id, administratorId, patientId, ctype, status, created, start, end, cost, ds, createdAt, updatedAt, nil)
+	contract, err := NewContractFromDb(id, administratorId, patientId, ctype, status, nil, nil, false, nil, created, start, end, cost, "BOB", int(EveryDay), nil, ds, createdAt, updatedAt, nil)
 	assert.ErrorIs(t, err, ErrTypeContract)
 	assert.Nil(t, contract)
 
 	ctype = "monthly"
-	contract, err = NewContractFromDb(id, administratorId, patientId, ctype, status, created, start, end, cost, ds, createdAt, updatedAt, nil)
+	contract, err = NewContractFromDb(id, administratorId, patientId, ctype, status, nil, nil, false, nil, created, start, end, cost, "BOB", int(EveryDay), nil, ds, createdAt, updatedAt, nil)
 	assert.ErrorIs(t, err, ErrStatusContract)
 	assert.Nil(t, contract)
 
 	status = "created"
+	contract, err = NewContractFromDb(id, administratorId, patientId, ctype, status, nil, nil, false, nil, created, start, end, cost, "BOB", 0, nil, ds, createdAt, updatedAt, nil)
+	assert.ErrorIs(t, err, ErrWeekdaysContract)
+	assert.Nil(t, contract)
+
+	contract, err = NewContractFromDb(id, administratorId, patientId, ctype, status, nil, nil, false, nil, created, start, end, cost, "EUR", int(EveryDay), nil, ds, createdAt, updatedAt, nil)
+	assert.ErrorIs(t, err, valueobjects.ErrCurrencyMoney)
+	assert.Nil(t, contract)
 
-	contract, err = NewContractFromDb(id, administratorId, patientId, ctype, status, created, start, end, cost, ds, createdAt, updatedAt, nil)
+	contract, err = NewContractFromDb(id, administratorId, patientId, ctype, status, nil, nil, false, nil, created, start, end, cost, "BOB", int(EveryDay), nil, ds, createdAt, updatedAt, nil)
 	assert.NotNil(t, contract)
 	assert.NoError(t, err)
 
@@ -118,3 +150,237 @@ func TestNewContractFromDB_Invalid(t *testing.T) {
 	err = contract.Completed()
 	assert.NoError(t, err)
 }
+
+func TestContract_UpdateDeliveries(t *testing.T) {
+	start := time.Now().AddDate(0, 0, 3)
+	coords, err := valueobjects.NewCoordinates(-17.7863, -63.1812)
+	assert.NoError(t, err)
+
+	newCoords, err := valueobjects.NewCoordinates(51.5237, -0.1585)
+	assert.NoError(t, err)
+
+	contract := NewContract(uuid.New(), uuid.New(), monthlyPlan, start, tenBolivianos, "Sesame Street", 30, coords)
+
+	updated, err := contract.UpdateDeliveries(start.AddDate(0, 0, 5), start.AddDate(0, 0, 11), "Baker Street", 221, newCoords)
+	assert.NoError(t, err)
+	assert.Len(t, updated, 7)
+
+	for i, d := range contract.Deliveries() {
+		if i >= 5 && i <= 11 {
+			assert.Equal(t, "Baker Street", d.Street())
+			assert.Equal(t, 221, d.Number())
+			assert.Equal(t, newCoords, d.Coordinates())
+		} else {
+			assert.Equal(t, "Sesame Street", d.Street())
+			assert.Equal(t, 30, d.Number())
+			assert.Equal(t, coords, d.Coordinates())
+		}
+	}
+}
+
+func TestContract_Book(t *testing.T) {
+	coords, err := valueobjects.NewCoordinates(-17.7863, -63.1812)
+	assert.NoError(t, err)
+
+	contract := NewContract(uuid.New(), uuid.New(), halfMonthPlan, time.Now().AddDate(0, 0, 3), tenBolivianos, "Sesame Street", 30, coords)
+	delivered := &contract.Deliveries()[0]
+	before := delivered.Date()
+	assert.NoError(t, delivered.ChangeStatus(deliveries.Delivered))
+	assert.Nil(t, contract.SlotId())
+
+	dinner := slots.NewSlot(uuid.New(), "Dinner", 19*time.Hour, 21*time.Hour, 50)
+	assert.NoError(t, contract.Book(dinner))
+
+	id := dinner.Id()
+	assert.Equal(t, &id, contract.SlotId())
+	assert.Nil(t, delivered.SlotId())
+	assert.Equal(t, before, delivered.Date())
+	for _, d := range contract.Deliveries()[1:] {
+		assert.Equal(t, &id, d.SlotId())
+		assert.Equal(t, 19, d.Date().Hour())
+		assert.Equal(t, 0, d.Date().Minute())
+	}
+}
+
+func TestContract_UpdateDeliveries_Invalid(t *testing.T) {
+	start := time.Now().AddDate(0, 0, 3)
+	coords, err := valueobjects.NewCoordinates(-17.7863, -63.1812)
+	assert.NoError(t, err)
+
+	contract := NewContract(uuid.New(), uuid.New(), halfMonthPlan, start, tenBolivianos, "Sesame Street", 30, coords)
+	err = contract.deliveries[4].ChangeStatus(deliveries.Delivered)
+	assert.NoError(t, err)
+
+	updated, err := contract.UpdateDeliveries(start, start.AddDate(0, 0, 6), "Baker Street", 221, coords)
+	assert.ErrorIs(t, err, deliveries.ErrNotPendingDelivery)
+	assert.Nil(t, updated)
+	for _, d := range contract.Deliveries() {
+		assert.Equal(t, "Sesame Street", d.Street())
+	}
+
+	updated, err = contract.UpdateDeliveries(start.AddDate(0, 0, 6), start, "Baker Street", 221, coords)
+	assert.ErrorIs(t, err, ErrDateRangeContract)
+	assert.Nil(t, updated)
+
+	updated, err = contract.UpdateDeliveries(start.AddDate(0, 1, 0), start.AddDate(0, 2, 0), "Baker Street", 221, coords)
+	assert.ErrorIs(t, err, ErrNoDeliveriesInRangeContract)
+	assert.Nil(t, updated)
+
+	updated, err = contract.UpdateDeliveries(start, start, "", 221, coords)
+	assert.ErrorIs(t, err, ErrEmptyStreetContract)
+	assert.Nil(t, updated)
+
+	updated, err = contract.UpdateDeliveries(start, start, "Baker Street", 0, coords)
+	assert.ErrorIs(t, err, ErrNumberPositiveNumberContract)
+	assert.Nil(t, updated)
+}
+
+func TestContract_Lifecycle(t *testing.T) {
+	coords, err := valueobjects.NewCoordinates(-17.7863, -63.1812)
+	assert.NoError(t, err)
+
+	contract := NewContract(uuid.New(), uuid.New(), halfMonthPlan, time.Now().AddDate(0, 0, 3), tenBolivianos, "Sesame Street", 30, coords)
+
+	assert.ErrorIs(t, contract.Completed(), ErrChangeStatusContract)
+	assert.NoError(t, contract.Active())
+	assert.ErrorIs(t, contract.Active(), ErrChangeStatusContract)
+	assert.NoError(t, contract.Completed())
+	assert.Equal(t, Finished, contract.ContractStatus())
+
+	events := contract.DomainEvents()
+	assert.Len(t, events, 2)
+	assert.Equal(t, ContractActivated, events[0].Name())
+	assert.Equal(t, ContractCompleted, events[1].Name())
+
+	payload := events[1].Payload().(ContractEvent)
+	assert.Equal(t, contract.Id(), payload.ContractId)
+	assert.Equal(t, contract.PatientId(), payload.PatientId)
+	assert.Equal(t, "finished", payload.Status)
+}
+
+func TestContract_Suspend_Resume(t *testing.T) {
+	coords, err := valueobjects.NewCoordinates(-17.7863, -63.1812)
+	assert.NoError(t, err)
+
+	start := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	contract := NewContract(uuid.New(), uuid.New(), halfMonthPlan, start, tenBolivianos, "Sesame Street", 30, coords)
+	end := contract.EndDate()
+
+	assert.ErrorIs(t, contract.Suspend("travel", start), ErrChangeStatusContract)
+	assert.NoError(t, contract.Active())
+	assert.NoError(t, contract.Deliveries()[0].ChangeStatus(deliveries.Delivered))
+
+	assert.ErrorIs(t, contract.Suspend("", start), ErrReasonContract)
+	suspendedAt := start.AddDate(0, 0, 5).Add(10 * time.Hour)
+	assert.NoError(t, contract.Suspend("travel", suspendedAt))
+	assert.Equal(t, Suspended, contract.ContractStatus())
+	assert.Equal(t, "travel", contract.StatusReason())
+	assert.Equal(t, suspendedAt, *contract.SuspendedAt())
+	assert.ErrorIs(t, contract.Completed(), ErrChangeStatusContract)
+	assert.ErrorIs(t, contract.Active(), ErrChangeStatusContract)
+
+	moved, err := contract.Resume(suspendedAt.AddDate(0, 0, 3), nil)
+	assert.NoError(t, err)
+	assert.Len(t, moved, 10)
+	assert.Equal(t, start.AddDate(0, 0, 8), moved[0].Date())
+	assert.Equal(t, end.AddDate(0, 0, 3), contract.EndDate())
+	assert.Equal(t, start.AddDate(0, 0, 4), contract.Deliveries()[4].Date())
+	assert.Equal(t, Active, contract.ContractStatus())
+	assert.Empty(t, contract.StatusReason())
+	assert.Nil(t, contract.SuspendedAt())
+
+	_, err = contract.Resume(time.Now(), nil)
+	assert.ErrorIs(t, err, ErrChangeStatusContract)
+
+	events := contract.DomainEvents()
+	assert.Len(t, events, 3)
+	assert.Equal(t, ContractSuspended, events[1].Name())
+	assert.Equal(t, "travel", events[1].Payload().(ContractEvent).Reason)
+	assert.Equal(t, ContractResumed, events[2].Name())
+}
+
+func TestContract_Resume_SameDay(t *testing.T) {
+	contract := NewContract(uuid.New(), uuid.New(), halfMonthPlan, time.Now(), tenBolivianos, "Sesame Street", 30, valueobjects.Coordinates{})
+	end := contract.EndDate()
+	now := time.Now()
+
+	assert.NoError(t, contract.Active())
+	assert.NoError(t, contract.Suspend("sick", now))
+
+	moved, err := contract.Resume(now, nil)
+	assert.NoError(t, err)
+	assert.Empty(t, moved)
+	assert.Equal(t, end, contract.EndDate())
+}
+
+func TestContract_Cancel(t *testing.T) {
+	coords, err := valueobjects.NewCoordinates(-17.7863, -63.1812)
+	assert.NoError(t, err)
+
+	cases := []struct {
+		name    string
+		prepare func(c *Contract)
+	}{
+		{"created", func(c *Contract) {}},
+		{"active", func(c *Contract) { _ = c.Active() }},
+		{"suspended", func(c *Contract) {
+			_ = c.Active()
+			_ = c.Suspend("travel", time.Now())
+		}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			contract := NewContract(uuid.New(), uuid.New(), monthlyPlan, time.Now().AddDate(0, 0, 3), tenBolivianos, "Sesame Street", 30, coords)
+			tc.prepare(contract)
+			assert.NoError(t, contract.Deliveries()[0].ChangeStatus(deliveries.Delivered))
+
+			_, err := contract.Cancel("")
+			assert.ErrorIs(t, err, ErrReasonContract)
+
+			cancelled, err := contract.Cancel("moved abroad")
+			assert.NoError(t, err)
+			assert.Len(t, cancelled, 29)
+			assert.Equal(t, Cancelled, contract.ContractStatus())
+			assert.Equal(t, "moved abroad", contract.StatusReason())
+			assert.Nil(t, contract.SuspendedAt())
+			assert.Equal(t, deliveries.Delivered, contract.Deliveries()[0].Status())
+			for _, d := range cancelled {
+				assert.Equal(t, deliveries.Cancelled, d.Status())
+			}
+
+			events := contract.DomainEvents()
+			assert.Equal(t, ContractCancelled, events[len(events)-1].Name())
+
+			_, err = contract.Cancel("again")
+			assert.ErrorIs(t, err, ErrChangeStatusContract)
+			assert.ErrorIs(t, contract.Active(), ErrChangeStatusContract)
+		})
+	}
+}
+
+func TestContract_Cancel_Finished(t *testing.T) {
+	contract := NewContract(uuid.New(), uuid.New(), halfMonthPlan, time.Now(), tenBolivianos, "Sesame Street", 30, valueobjects.Coordinates{})
+	assert.NoError(t, contract.Active())
+	assert.NoError(t, contract.Completed())
+
+	cancelled, err := contract.Cancel("too late")
+	assert.Nil(t, cancelled)
+	assert.ErrorIs(t, err, ErrChangeStatusContract)
+	assert.Equal(t, Finished, contract.ContractStatus())
+}
+
+func TestContract_Priced(t *testing.T) {
+	price, err := pricing.Price(halfMonthPlan, 15, nil, nil, nil, time.Now())
+	assert.NoError(t, err)
+
+	contract := NewContract(uuid.New(), uuid.New(), halfMonthPlan, time.Now(), price.Total(), "Sesame Street", 30, valueobjects.Coordinates{})
+	assert.Nil(t, contract.Price())
+
+	assert.NoError(t, contract.Priced(price))
+	assert.Equal(t, price, contract.Price())
+
+	other := NewContract(uuid.New(), uuid.New(), halfMonthPlan, time.Now(), bolivianos(900), "Sesame Street", 30, valueobjects.Coordinates{})
+	assert.ErrorIs(t, other.Priced(price), ErrPriceContract)
+	assert.Nil(t, other.Price())
+}
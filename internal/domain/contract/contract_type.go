@@ -1,6 +1,9 @@
 package contracts
 
-import "fmt"
+import (
+	"fmt"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/plan"
+)
 
 type ContractType string
 
@@ -15,8 +18,10 @@ func (t ContractType) String() string {
 		return "monthly"
 	case HalfMonth:
 		return "half-month"
-	default:
+	case "":
 		return "unknown"
+	default:
+		return string(t)
 	}
 }
 
@@ -26,7 +31,10 @@ func ParseContractType(s string) (ContractType, error) {
 		return Monthly, nil
 	case "half-month", "H":
 		return HalfMonth, nil
-	default:
+	}
+
+	if !plans.IsValidCode(s) {
 		return "", fmt.Errorf("%w: got %s", ErrTypeContract, s)
 	}
+	return ContractType(s), nil
 }
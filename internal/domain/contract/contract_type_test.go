@@ -8,7 +8,7 @@ import (
 func TestContractType(t *testing.T) {
 	monthly := Monthly
 	halfMonth := HalfMonth
-	other, err := ParseContractType("X")
+	other, err := ParseContractType("x")
 
 	assert.NotNil(t, err)
 
@@ -37,6 +37,11 @@ func TestContractType(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, HalfMonth, ct)
 
+	ct, err = ParseContractType("WEEKLY")
+	assert.NoError(t, err)
+	assert.Equal(t, ContractType("WEEKLY"), ct)
+	assert.Equal(t, "WEEKLY", ct.String())
+
 	ct, err = ParseContractType("invalid")
 	assert.Error(t, err)
 	assert.Equal(t, ContractType(""), ct)
@@ -0,0 +1,143 @@
+package couriers
+
+import (
+	"errors"
+	"fmt"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/abstractions"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/contract"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	"github.com/google/uuid"
+	"time"
+)
+
+type Courier struct {
+	*abstractions.AggregateRoot
+	name          string
+	phone         *valueobjects.Phone
+	availability  contracts.WeekdayMask
+	maxDeliveries int
+	createdAt     time.Time
+	updatedAt     time.Time
+	deletedAt     *time.Time
+}
+
+var (
+	ErrEmptyNameCourier     = errors.New("courier name is empty")
+	ErrLongNameCourier      = errors.New("courier name cannot be longer than 100 characters")
+	ErrAvailabilityCourier  = errors.New("courier availability is not valid")
+	ErrMaxDeliveriesCourier = errors.New("max deliveries per shift is not a positive number")
+	ErrNotFoundCourier      = errors.New("courier not found")
+	ErrUnavailableCourier   = errors.New("courier does not work on that day")
+	ErrFullCourier          = errors.New("courier shift is full")
+)
+
+func (c *Courier) Update(name string, phone *valueobjects.Phone, availability contracts.WeekdayMask, maxDeliveries int) error {
+	if err := validateDetails(name, availability, maxDeliveries); err != nil {
+		return err
+	}
+
+	c.name = name
+	c.phone = phone
+	c.availability = availability
+	c.maxDeliveries = maxDeliveries
+	return nil
+}
+
+func (c *Courier) AvailableOn(day time.Time) bool {
+	return c.availability.Has(day.Weekday())
+}
+
+func (c *Courier) CanTake(day time.Time, assigned int) error {
+	if !c.AvailableOn(day) {
+		return fmt.Errorf("%w: %s on %s", ErrUnavailableCourier, c.name, day.Format(time.DateOnly))
+	}
+	if assigned >= c.maxDeliveries {
+		return fmt.Errorf("%w: %s has %d of %d deliveries on %s", ErrFullCourier, c.name, assigned, c.maxDeliveries, day.Format(time.DateOnly))
+	}
+	return nil
+}
+
+func (c *Courier) Id() uuid.UUID {
+	return c.Entity.Id
+}
+
+func (c *Courier) Name() string {
+	return c.name
+}
+
+func (c *Courier) Phone() *valueobjects.Phone {
+	return c.phone
+}
+
+func (c *Courier) Availability() contracts.WeekdayMask {
+	return c.availability
+}
+
+func (c *Courier) MaxDeliveries() int {
+	return c.maxDeliveries
+}
+
+func (c *Courier) CreatedAt() time.Time {
+	return c.createdAt
+}
+
+func (c *Courier) UpdatedAt() time.Time {
+	return c.updatedAt
+}
+
+func (c *Courier) DeletedAt() *time.Time {
+	return c.deletedAt
+}
+
+func validateDetails(name string, availability contracts.WeekdayMask, maxDeliveries int) error {
+	if name == "" {
+		return ErrEmptyNameCourier
+	}
+
+	if len(name) > 100 {
+		return fmt.Errorf("%w: got %s, size %d", ErrLongNameCourier, name, len(name))
+	}
+
+	if !availability.IsValid() {
+		return fmt.Errorf("%w: got %07b", ErrAvailabilityCourier, availability)
+	}
+
+	if maxDeliveries <= 0 {
+		return fmt.Errorf("%w: got %d", ErrMaxDeliveriesCourier, maxDeliveries)
+	}
+
+	return nil
+}
+
+func NewCourier(name string, phone *valueobjects.Phone, availability contracts.WeekdayMask, maxDeliveries int) *Courier {
+	return &Courier{
+		AggregateRoot: abstractions.NewAggregateRoot(uuid.New()),
+		name:          name,
+		phone:         phone,
+		availability:  availability,
+		maxDeliveries: maxDeliveries,
+	}
+}
+
+func NewCourierFromDb(id uuid.UUID, name string, phone *string, weekdays, maxDeliveries int, cAt, uAt time.Time, dAt *time.Time) (*Courier, error) {
+	p, err := valueobjects.NewPhone(phone)
+	if err != nil {
+		return nil, err
+	}
+
+	availability := contracts.WeekdayMask(weekdays)
+	if !availability.IsValid() {
+		return nil, fmt.Errorf("%w: got %07b", ErrAvailabilityCourier, weekdays)
+	}
+
+	return &Courier{
+		AggregateRoot: abstractions.NewAggregateRoot(id),
+		name:          name,
+		phone:         p,
+		availability:  availability,
+		maxDeliveries: maxDeliveries,
+		createdAt:     cAt,
+		updatedAt:     uAt,
+		deletedAt:     dAt,
+	}, nil
+}
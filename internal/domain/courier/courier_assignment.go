@@ -0,0 +1,58 @@
+package couriers
+
+import (
+	"cmp"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/delivery"
+	"github.com/google/uuid"
+	"slices"
+	"time"
+)
+
+func AutoAssign(day time.Time, dlvrs []*deliveries.Delivery, cs []*Courier, assigned map[uuid.UUID]int) ([]*deliveries.Delivery, []*deliveries.Delivery) {
+	var available []*Courier
+	for _, c := range cs {
+		if c.AvailableOn(day) {
+			available = append(available, c)
+		}
+	}
+
+	var handed, left []*deliveries.Delivery
+	for _, d := range dlvrs {
+		if d.Status() != deliveries.Pending || d.CourierId() != nil {
+			continue
+		}
+
+		courier := leastLoaded(available, assigned)
+		if courier == nil {
+			left = append(left, d)
+			continue
+		}
+
+		if err := d.Assign(courier.Id()); err != nil {
+			left = append(left, d)
+			continue
+		}
+		assigned[courier.Id()]++
+		handed = append(handed, d)
+	}
+	return handed, left
+}
+
+func leastLoaded(cs []*Courier, assigned map[uuid.UUID]int) *Courier {
+	var open []*Courier
+	for _, c := range cs {
+		if assigned[c.Id()] < c.MaxDeliveries() {
+			open = append(open, c)
+		}
+	}
+	if len(open) == 0 {
+		return nil
+	}
+
+	return slices.MinFunc(open, func(a, b *Courier) int {
+		if n := cmp.Compare(assigned[a.Id()], assigned[b.Id()]); n != 0 {
+			return n
+		}
+		return cmp.Compare(b.MaxDeliveries()-assigned[b.Id()], a.MaxDeliveries()-assigned[a.Id()])
+	})
+}
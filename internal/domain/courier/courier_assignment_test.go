@@ -0,0 +1,90 @@
+package couriers
+
+import (
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/contract"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/delivery"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func pendingDeliveries(day time.Time, n int) []*deliveries.Delivery {
+	coordinates, _ := valueobjects.NewCoordinates(-17.78, -63.18)
+	dlvrs := make([]*deliveries.Delivery, n)
+	for i := range dlvrs {
+		dlvrs[i] = deliveries.NewDelivery(uuid.New(), day, "Street", i+1, coordinates)
+	}
+	return dlvrs
+}
+
+func TestAutoAssign(t *testing.T) {
+	monday := time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC)
+	ana := NewCourier("Ana", nil, contracts.EveryDay, 3)
+	luis := NewCourier("Luis", nil, contracts.EveryDay, 2)
+	dlvrs := pendingDeliveries(monday, 4)
+
+	handed, left := AutoAssign(monday, dlvrs, []*Courier{ana, luis}, map[uuid.UUID]int{})
+
+	assert.Len(t, handed, 4)
+	assert.Empty(t, left)
+
+	counts := map[uuid.UUID]int{}
+	for _, d := range handed {
+		counts[*d.CourierId()]++
+	}
+	assert.Equal(t, 2, counts[ana.Id()])
+	assert.Equal(t, 2, counts[luis.Id()])
+}
+
+func TestAutoAssign_Capacity(t *testing.T) {
+	monday := time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC)
+	ana := NewCourier("Ana", nil, contracts.EveryDay, 3)
+	assigned := map[uuid.UUID]int{ana.Id(): 2}
+	dlvrs := pendingDeliveries(monday, 3)
+
+	handed, left := AutoAssign(monday, dlvrs, []*Courier{ana}, assigned)
+
+	assert.Len(t, handed, 1)
+	assert.Len(t, left, 2)
+	assert.Equal(t, 3, assigned[ana.Id()])
+	for _, d := range left {
+		assert.Nil(t, d.CourierId())
+	}
+}
+
+func TestAutoAssign_Availability(t *testing.T) {
+	saturday := time.Date(2025, 3, 8, 0, 0, 0, 0, time.UTC)
+	ana := NewCourier("Ana", nil, contracts.MondayToFriday, 10)
+	luis := NewCourier("Luis", nil, contracts.NewWeekdayMask(time.Saturday), 10)
+	dlvrs := pendingDeliveries(saturday, 2)
+
+	handed, left := AutoAssign(saturday, dlvrs, []*Courier{ana, luis}, map[uuid.UUID]int{})
+
+	assert.Len(t, handed, 2)
+	assert.Empty(t, left)
+	for _, d := range handed {
+		assert.Equal(t, luis.Id(), *d.CourierId())
+	}
+
+	handed, left = AutoAssign(saturday, pendingDeliveries(saturday, 1), []*Courier{ana}, map[uuid.UUID]int{})
+	assert.Empty(t, handed)
+	assert.Len(t, left, 1)
+}
+
+func TestAutoAssign_SkipsAssignedAndClosed(t *testing.T) {
+	monday := time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC)
+	ana := NewCourier("Ana", nil, contracts.EveryDay, 10)
+	luis := NewCourier("Luis", nil, contracts.EveryDay, 10)
+	dlvrs := pendingDeliveries(monday, 3)
+	assert.NoError(t, dlvrs[0].Assign(luis.Id()))
+	assert.NoError(t, dlvrs[1].ChangeStatus(deliveries.Cancelled))
+
+	handed, left := AutoAssign(monday, dlvrs, []*Courier{ana}, map[uuid.UUID]int{})
+
+	assert.Equal(t, []*deliveries.Delivery{dlvrs[2]}, handed)
+	assert.Empty(t, left)
+	assert.Equal(t, luis.Id(), *dlvrs[0].CourierId())
+	assert.Nil(t, dlvrs[1].CourierId())
+}
@@ -0,0 +1,27 @@
+package couriers
+
+import (
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/contract"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	"log"
+)
+
+type CourierFactory interface {
+	Create(name string, phone *valueobjects.Phone, availability contracts.WeekdayMask, maxDeliveries int) (*Courier, error)
+}
+
+type courierFactory struct{}
+
+func (courierFactory) Create(name string, phone *valueobjects.Phone, availability contracts.WeekdayMask, maxDeliveries int) (*Courier, error) {
+	if err := validateDetails(name, availability, maxDeliveries); err != nil {
+		log.Printf("[factory:courier] courier '%s' has invalid details: %v", name, err)
+		return nil, err
+	}
+
+	log.Printf("[factory:courier] courier '%s' created", name)
+	return NewCourier(name, phone, availability, maxDeliveries), nil
+}
+
+func NewCourierFactory() CourierFactory {
+	return &courierFactory{}
+}
@@ -0,0 +1,47 @@
+package couriers
+
+import (
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/contract"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestCourierFactory_Create(t *testing.T) {
+	factory := NewCourierFactory()
+
+	courier, err := factory.Create("Luis", nil, contracts.MondayToFriday, 20)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, courier.Id())
+	assert.Equal(t, "Luis", courier.Name())
+	assert.Nil(t, courier.Phone())
+	assert.Equal(t, contracts.MondayToFriday, courier.Availability())
+	assert.Equal(t, 20, courier.MaxDeliveries())
+}
+
+func TestCourierFactory_CreateErrors(t *testing.T) {
+	factory := NewCourierFactory()
+
+	cases := []struct {
+		name          string
+		courier       string
+		availability  contracts.WeekdayMask
+		maxDeliveries int
+		err           error
+	}{
+		{"empty name", "", contracts.EveryDay, 20, ErrEmptyNameCourier},
+		{"long name", string(make([]byte, 101)), contracts.EveryDay, 20, ErrLongNameCourier},
+		{"no days", "Luis", 0, 20, ErrAvailabilityCourier},
+		{"zero deliveries", "Luis", contracts.EveryDay, 0, ErrMaxDeliveriesCourier},
+		{"negative deliveries", "Luis", contracts.EveryDay, -3, ErrMaxDeliveriesCourier},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			courier, err := factory.Create(tc.courier, nil, tc.availability, tc.maxDeliveries)
+
+			assert.Nil(t, courier)
+			assert.ErrorIs(t, err, tc.err)
+		})
+	}
+}
@@ -0,0 +1,22 @@
+package couriers
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"time"
+)
+
+type CourierRepository interface {
+	GetAll(ctx context.Context) ([]*Courier, error)
+	GetById(ctx context.Context, id uuid.UUID) (*Courier, error)
+
+	// Lock holds the couriers until the unit of work ends, so that deliveries
+	// handed to them are counted one after the other.
+	Lock(ctx context.Context, ids []uuid.UUID) error
+
+	CountAssignedOn(ctx context.Context, day time.Time) (map[uuid.UUID]int, error)
+
+	Create(ctx context.Context, courier *Courier) (*Courier, error)
+	Update(ctx context.Context, courier *Courier) (*Courier, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+}
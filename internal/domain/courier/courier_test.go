@@ -0,0 +1,63 @@
+package couriers
+
+import (
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/contract"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestCourier_Update(t *testing.T) {
+	courier := NewCourier("Luis", nil, contracts.MondayToFriday, 20)
+
+	assert.NoError(t, courier.Update("Luis Rojas", nil, contracts.EveryDay, 25))
+	assert.Equal(t, "Luis Rojas", courier.Name())
+	assert.Equal(t, contracts.EveryDay, courier.Availability())
+	assert.Equal(t, 25, courier.MaxDeliveries())
+
+	assert.ErrorIs(t, courier.Update("", nil, contracts.EveryDay, 25), ErrEmptyNameCourier)
+	assert.ErrorIs(t, courier.Update(string(make([]byte, 101)), nil, contracts.EveryDay, 25), ErrLongNameCourier)
+	assert.ErrorIs(t, courier.Update("Luis", nil, 0, 25), ErrAvailabilityCourier)
+	assert.ErrorIs(t, courier.Update("Luis", nil, contracts.EveryDay, 0), ErrMaxDeliveriesCourier)
+	assert.Equal(t, "Luis Rojas", courier.Name())
+}
+
+func TestCourier_CanTake(t *testing.T) {
+	courier := NewCourier("Luis", nil, contracts.MondayToFriday, 2)
+	monday := time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC)
+	saturday := monday.AddDate(0, 0, 5)
+
+	assert.True(t, courier.AvailableOn(monday))
+	assert.False(t, courier.AvailableOn(saturday))
+
+	assert.NoError(t, courier.CanTake(monday, 0))
+	assert.NoError(t, courier.CanTake(monday, 1))
+	assert.ErrorIs(t, courier.CanTake(monday, 2), ErrFullCourier)
+	assert.ErrorIs(t, courier.CanTake(saturday, 0), ErrUnavailableCourier)
+}
+
+func TestNewCourierFromDb(t *testing.T) {
+	id := uuid.New()
+	phone := "70000000"
+	now := time.Now()
+
+	courier, err := NewCourierFromDb(id, "Luis", &phone, int(contracts.MondayToFriday), 20, now, now, nil)
+
+	assert.NoError(t, err)
+	assert.Equal(t, id, courier.Id())
+	assert.Equal(t, "Luis", courier.Name())
+	assert.Equal(t, phone, *courier.Phone().String())
+	assert.Equal(t, contracts.MondayToFriday, courier.Availability())
+	assert.Equal(t, 20, courier.MaxDeliveries())
+	assert.Equal(t, now, courier.CreatedAt())
+	assert.Equal(t, now, courier.UpdatedAt())
+	assert.Nil(t, courier.DeletedAt())
+
+	_, err = NewCourierFromDb(id, "Luis", nil, 1<<7, 20, now, now, nil)
+	assert.ErrorIs(t, err, ErrAvailabilityCourier)
+
+	bad := "phone"
+	_, err = NewCourierFromDb(id, "Luis", &bad, int(contracts.EveryDay), 20, now, now, nil)
+	assert.Error(t, err)
+}
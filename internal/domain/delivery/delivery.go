@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/abstractions"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/slot"
 	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
 	"github.com/google/uuid"
 	"time"
@@ -13,6 +14,8 @@ var (
 	ErrNotPendingDelivery         = errors.New("delivery is not pending so you can't update it")
 	ErrCannotChangeDeliveryStatus = errors.New("cannot make that status change")
 	ErrNotADeliveryStatus         = errors.New("not a delivery status")
+	ErrNotFoundDelivery           = errors.New("delivery not found")
+	ErrContractMismatchDelivery   = errors.New("delivery does not belong to the contract")
 )
 
 type Delivery struct {
@@ -23,6 +26,8 @@ type Delivery struct {
 	number      int
 	coordinates valueobjects.Coordinates
 	status      DeliveryStatus
+	courierId   *uuid.UUID
+	slotId      *uuid.UUID
 	createdAt   time.Time
 	updatedAt   time.Time
 	deletedAt   *time.Time
@@ -56,6 +61,14 @@ func (d *Delivery) Status() DeliveryStatus {
 	return d.status
 }
 
+func (d *Delivery) CourierId() *uuid.UUID {
+	return d.courierId
+}
+
+func (d *Delivery) SlotId() *uuid.UUID {
+	return d.slotId
+}
+
 func (d *Delivery) CreatedAt() time.Time {
 	return d.createdAt
 }
@@ -81,12 +94,58 @@ func (d *Delivery) Update(street string, number int, coordinates valueobjects.Co
 
 }
 
+func (d *Delivery) Reschedule(date time.Time) error {
+	if d.Status() != Pending {
+		return ErrNotPendingDelivery
+	}
+	d.date = date
+	d.updatedAt = time.Now()
+
+	return nil
+}
+
 func (d *Delivery) ChangeStatus(status DeliveryStatus) error {
-	if status != Delivered && status != Cancelled && d.status != Pending {
+	if (status != Delivered && status != Cancelled) || d.status != Pending {
 		return fmt.Errorf("%w: got %s", ErrCannotChangeDeliveryStatus, status)
 	}
 
 	d.status = status
+	d.updatedAt = time.Now()
+
+	if status == Delivered {
+		d.raise(DeliveryDelivered)
+	} else {
+		d.raise(DeliveryCancelled)
+	}
+	return nil
+}
+
+func (d *Delivery) Assign(courierId uuid.UUID) error {
+	if d.Status() != Pending {
+		return ErrNotPendingDelivery
+	}
+	d.courierId = &courierId
+	d.updatedAt = time.Now()
+	return nil
+}
+
+func (d *Delivery) Unassign() error {
+	if d.Status() != Pending {
+		return ErrNotPendingDelivery
+	}
+	d.courierId = nil
+	d.updatedAt = time.Now()
+	return nil
+}
+
+func (d *Delivery) Book(slot *slots.Slot) error {
+	if d.Status() != Pending {
+		return ErrNotPendingDelivery
+	}
+	id := slot.Id()
+	d.slotId = &id
+	d.date = slot.StartOn(d.date)
+	d.updatedAt = time.Now()
 	return nil
 }
 
@@ -102,7 +161,7 @@ func NewDelivery(contractId uuid.UUID, date time.Time, street string, number int
 	}
 }
 
-func NewDeliveryFromDB(id, contractId uuid.UUID, date time.Time, street string, number int, latitude, longitude float64, status string, createdAt time.Time, updatedAt time.Time, deletedAt *time.Time) (*Delivery, error) {
+func NewDeliveryFromDB(id, contractId uuid.UUID, date time.Time, street string, number int, latitude, longitude float64, status string, courierId, slotId *uuid.UUID, createdAt time.Time, updatedAt time.Time, deletedAt *time.Time) (*Delivery, error) {
 	coordinates, err := valueobjects.NewCoordinates(latitude, longitude)
 	if err != nil {
 		return nil, err
@@ -121,6 +180,8 @@ func NewDeliveryFromDB(id, contractId uuid.UUID, date time.Time, street string,
 		number:      number,
 		coordinates: coordinates,
 		status:      newStatus,
+		courierId:   courierId,
+		slotId:      slotId,
 		createdAt:   createdAt,
 		updatedAt:   updatedAt,
 		deletedAt:   deletedAt,
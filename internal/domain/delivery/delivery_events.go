@@ -0,0 +1,29 @@
+package deliveries
+
+import (
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/abstractions"
+	"github.com/google/uuid"
+	"time"
+)
+
+const (
+	DeliveryDelivered = "delivery.delivered"
+	DeliveryCancelled = "delivery.cancelled"
+	DeliveryFlagged   = "delivery.flagged"
+)
+
+type DeliveryEvent struct {
+	DeliveryId uuid.UUID `json:"deliveryId"`
+	ContractId uuid.UUID `json:"contractId"`
+	Date       time.Time `json:"date"`
+	Status     string    `json:"status"`
+}
+
+func (d *Delivery) raise(name string) {
+	d.AddDomainEvent(*abstractions.NewNamedDomainEvent(name, d.contractId, DeliveryEvent{
+		DeliveryId: d.Id(),
+		ContractId: d.contractId,
+		Date:       d.date,
+		Status:     d.status.String(),
+	}))
+}
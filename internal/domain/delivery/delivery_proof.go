@@ -0,0 +1,140 @@
+package deliveries
+
+import (
+	"errors"
+	"fmt"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/abstractions"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	"github.com/google/uuid"
+	"math"
+	"time"
+)
+
+var (
+	ErrEmptyRecipientProof = errors.New("proof recipient is empty")
+	ErrLongRecipientProof  = errors.New("proof recipient cannot be longer than 100 characters")
+	ErrReceivedAtProof     = errors.New("proof timestamp is not valid")
+	ErrToleranceProof      = errors.New("hand-off tolerance is not a positive number")
+	ErrNotFoundProof       = errors.New("proof of delivery not found")
+	ErrImageProof          = errors.New("proof image must be a JPEG or PNG")
+	ErrRequiredProof       = errors.New("a delivery is only delivered with a proof of delivery")
+)
+
+type Proof struct {
+	*abstractions.Entity
+	deliveryId   uuid.UUID
+	recipient    string
+	receivedAt   time.Time
+	location     valueobjects.Coordinates
+	distance     int
+	flagged      bool
+	photoKey     *string
+	signatureKey *string
+	createdAt    time.Time
+}
+
+func (d *Delivery) Deliver(recipient string, receivedAt time.Time, location valueobjects.Coordinates, tolerance int) (*Proof, error) {
+	if recipient == "" {
+		return nil, ErrEmptyRecipientProof
+	}
+
+	if len(recipient) > 100 {
+		return nil, fmt.Errorf("%w: got %s, size %d", ErrLongRecipientProof, recipient, len(recipient))
+	}
+
+	if receivedAt.IsZero() || receivedAt.After(time.Now()) {
+		return nil, fmt.Errorf("%w: got %s", ErrReceivedAtProof, receivedAt.Format(time.RFC3339))
+	}
+
+	if tolerance <= 0 {
+		return nil, fmt.Errorf("%w: got %d", ErrToleranceProof, tolerance)
+	}
+
+	distance := int(math.Round(d.coordinates.DistanceTo(location)))
+	flagged := distance > tolerance
+
+	if err := d.ChangeStatus(Delivered); err != nil {
+		return nil, err
+	}
+	if flagged {
+		d.raise(DeliveryFlagged)
+	}
+
+	return &Proof{
+		Entity:     abstractions.NewEntity(uuid.New()),
+		deliveryId: d.Id(),
+		recipient:  recipient,
+		receivedAt: receivedAt,
+		location:   location,
+		distance:   distance,
+		flagged:    flagged,
+	}, nil
+}
+
+func (p *Proof) AttachPhoto(key string) {
+	p.photoKey = &key
+}
+
+func (p *Proof) AttachSignature(key string) {
+	p.signatureKey = &key
+}
+
+func (p *Proof) Id() uuid.UUID {
+	return p.Entity.Id
+}
+
+func (p *Proof) DeliveryId() uuid.UUID {
+	return p.deliveryId
+}
+
+func (p *Proof) Recipient() string {
+	return p.recipient
+}
+
+func (p *Proof) ReceivedAt() time.Time {
+	return p.receivedAt
+}
+
+func (p *Proof) Location() valueobjects.Coordinates {
+	return p.location
+}
+
+func (p *Proof) Distance() int {
+	return p.distance
+}
+
+func (p *Proof) Flagged() bool {
+	return p.flagged
+}
+
+func (p *Proof) PhotoKey() *string {
+	return p.photoKey
+}
+
+func (p *Proof) SignatureKey() *string {
+	return p.signatureKey
+}
+
+func (p *Proof) CreatedAt() time.Time {
+	return p.createdAt
+}
+
+func NewProofFromDb(id, deliveryId uuid.UUID, recipient string, receivedAt time.Time, latitude, longitude float64, distance int, flagged bool, photoKey, signatureKey *string, createdAt time.Time) (*Proof, error) {
+	location, err := valueobjects.NewCoordinates(latitude, longitude)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Proof{
+		Entity:       abstractions.NewEntity(id),
+		deliveryId:   deliveryId,
+		recipient:    recipient,
+		receivedAt:   receivedAt,
+		location:     location,
+		distance:     distance,
+		flagged:      flagged,
+		photoKey:     photoKey,
+		signatureKey: signatureKey,
+		createdAt:    createdAt,
+	}, nil
+}
@@ -0,0 +1,11 @@
+package deliveries
+
+import (
+	"context"
+	"github.com/google/uuid"
+)
+
+type ProofRepository interface {
+	GetByDelivery(ctx context.Context, deliveryId uuid.UUID) (*Proof, error)
+	Create(ctx context.Context, proof *Proof) (*Proof, error)
+}
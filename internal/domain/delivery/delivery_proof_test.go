@@ -0,0 +1,135 @@
+package deliveries
+
+import (
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestDelivery_Deliver(t *testing.T) {
+	address, _ := valueobjects.NewCoordinates(-17.7863, -63.1812)
+	doorstep, _ := valueobjects.NewCoordinates(-17.7864, -63.1812)
+	across, _ := valueobjects.NewCoordinates(-17.7963, -63.1812)
+	receivedAt := time.Now().Add(-time.Minute)
+
+	cases := []struct {
+		name     string
+		location valueobjects.Coordinates
+		flagged  bool
+		events   []string
+	}{
+		{"doorstep", doorstep, false, []string{DeliveryDelivered}},
+		{"across town", across, true, []string{DeliveryDelivered, DeliveryFlagged}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			d := NewDelivery(uuid.New(), time.Now(), "Sesame Street", 30, address)
+
+			proof, err := d.Deliver("Ana Vaca", receivedAt, tc.location, 150)
+
+			assert.NoError(t, err)
+			assert.Equal(t, Delivered, d.Status())
+			assert.Equal(t, d.Id(), proof.DeliveryId())
+			assert.Equal(t, "Ana Vaca", proof.Recipient())
+			assert.Equal(t, receivedAt, proof.ReceivedAt())
+			assert.Equal(t, tc.location, proof.Location())
+			assert.Equal(t, tc.flagged, proof.Flagged())
+			assert.Nil(t, proof.PhotoKey())
+			assert.Nil(t, proof.SignatureKey())
+
+			var names []string
+			for _, e := range d.DomainEvents() {
+				names = append(names, e.Name())
+			}
+			assert.Equal(t, tc.events, names)
+		})
+	}
+}
+
+func TestDelivery_Deliver_Distance(t *testing.T) {
+	address, _ := valueobjects.NewCoordinates(-17.7863, -63.1812)
+	location, _ := valueobjects.NewCoordinates(-17.7963, -63.1812)
+	d := NewDelivery(uuid.New(), time.Now(), "Sesame Street", 30, address)
+
+	proof, err := d.Deliver("Ana", time.Now(), location, 2000)
+
+	assert.NoError(t, err)
+	assert.InDelta(t, 1112, proof.Distance(), 1)
+	assert.False(t, proof.Flagged())
+}
+
+func TestDelivery_Deliver_Errors(t *testing.T) {
+	now := time.Now()
+
+	cases := []struct {
+		name       string
+		recipient  string
+		receivedAt time.Time
+		tolerance  int
+		err        error
+	}{
+		{"empty recipient", "", now, 150, ErrEmptyRecipientProof},
+		{"long recipient", string(make([]byte, 101)), now, 150, ErrLongRecipientProof},
+		{"no timestamp", "Ana", time.Time{}, 150, ErrReceivedAtProof},
+		{"future timestamp", "Ana", now.Add(time.Hour), 150, ErrReceivedAtProof},
+		{"tolerance", "Ana", now, 0, ErrToleranceProof},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			d := NewDelivery(uuid.New(), now, "Sesame Street", 30, valueobjects.Coordinates{})
+
+			proof, err := d.Deliver(tc.recipient, tc.receivedAt, valueobjects.Coordinates{}, tc.tolerance)
+
+			assert.Nil(t, proof)
+			assert.ErrorIs(t, err, tc.err)
+			assert.Equal(t, Pending, d.Status())
+		})
+	}
+
+	t.Run("not pending", func(t *testing.T) {
+		d := NewDelivery(uuid.New(), now, "Sesame Street", 30, valueobjects.Coordinates{})
+		assert.NoError(t, d.ChangeStatus(Cancelled))
+
+		proof, err := d.Deliver("Ana", now, valueobjects.Coordinates{}, 150)
+
+		assert.Nil(t, proof)
+		assert.ErrorIs(t, err, ErrCannotChangeDeliveryStatus)
+	})
+}
+
+func TestProof_Attach(t *testing.T) {
+	d := NewDelivery(uuid.New(), time.Now(), "Sesame Street", 30, valueobjects.Coordinates{})
+	proof, err := d.Deliver("Ana", time.Now(), valueobjects.Coordinates{}, 150)
+	assert.NoError(t, err)
+
+	proof.AttachPhoto("deliveries/photo.jpg")
+	proof.AttachSignature("deliveries/signature.png")
+
+	assert.Equal(t, "deliveries/photo.jpg", *proof.PhotoKey())
+	assert.Equal(t, "deliveries/signature.png", *proof.SignatureKey())
+}
+
+func TestNewProofFromDb(t *testing.T) {
+	id, deliveryId := uuid.New(), uuid.New()
+	now := time.Now()
+	key := "deliveries/photo.jpg"
+
+	proof, err := NewProofFromDb(id, deliveryId, "Ana", now, -17.7863, -63.1812, 420, true, &key, nil, now)
+
+	assert.NoError(t, err)
+	assert.Equal(t, id, proof.Id())
+	assert.Equal(t, deliveryId, proof.DeliveryId())
+	assert.Equal(t, -17.7863, proof.Location().Latitude())
+	assert.Equal(t, 420, proof.Distance())
+	assert.True(t, proof.Flagged())
+	assert.Equal(t, key, *proof.PhotoKey())
+	assert.Nil(t, proof.SignatureKey())
+	assert.Equal(t, now, proof.CreatedAt())
+
+	_, err = NewProofFromDb(id, deliveryId, "Ana", now, 91, 0, 0, false, nil, nil, now)
+	assert.ErrorIs(t, err, valueobjects.ErrOutOfBoundariesLatitude)
+}
@@ -1,6 +1,7 @@
 package deliveries
 
 import (
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/slot"
 	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -61,7 +62,7 @@ func TestNewDeliveryFromDB(t *testing.T) {
 			assert.Empty(t, d.UpdatedAt())
 			assert.Empty(t, d.DeletedAt())
 
-			d, err = NewDeliveryFromDB(uuid.New(), uuid.New(), tc.date, tc.street, tc.number, tc.lat, tc.lon, tc.status, tc.createdAt, tc.updatedAt, tc.deletedAt)
+			d, err = NewDeliveryFromDB(uuid.New(), uuid.New(), tc.date, tc.street, tc.number, tc.lat, tc.lon, tc.status, nil, nil, tc.createdAt, tc.updatedAt, tc.deletedAt)
 
 			assert.NotEmpty(t, d.Coordinates())
 
@@ -153,17 +154,118 @@ func TestNewDeliveryFromDB_Invalid(t *testing.T) {
 	createdAt := time.Now().AddDate(0, 6, 0)
 	updatedAt := time.Now().AddDate(0, 3, 0)
 
-	delivery, err := NewDeliveryFromDB(id, contractId, date, street, number, lat, lon, status, createdAt, updatedAt, nil)
+	delivery, err := NewDeliveryFromDB(id, contractId, date, street, number, lat, lon, status, nil, nil, createdAt, updatedAt, nil)
 	assert.ErrorIs(t, err, valueobjects.ErrOutOfBoundariesLatitude)
 	assert.Nil(t, delivery)
 
 	lat = 42
-	delivery, err = NewDeliveryFromDB(id, contractId, date, street, number, lat, lon, status, createdAt, updatedAt, nil)
+	delivery, err = NewDeliveryFromDB(id, contractId, date, street, number, lat, lon, status, nil, nil, createdAt, updatedAt, nil)
 	assert.ErrorIs(t, err, valueobjects.ErrOutOfBoundariesLongitude)
 	assert.Nil(t, delivery)
 
 	lon = -90.48
-	delivery, err = NewDeliveryFromDB(id, contractId, date, street, number, lat, lon, status, createdAt, updatedAt, nil)
+	delivery, err = NewDeliveryFromDB(id, contractId, date, street, number, lat, lon, status, nil, nil, createdAt, updatedAt, nil)
 	assert.ErrorIs(t, err, ErrNotADeliveryStatus)
 	assert.Nil(t, delivery)
 }
+
+func TestDelivery_ChangeStatus(t *testing.T) {
+	coords, err := valueobjects.NewCoordinates(-17.7863, -63.1812)
+	assert.NoError(t, err)
+
+	cases := []struct {
+		name     string
+		from, to DeliveryStatus
+		wantErr  bool
+	}{
+		{"Pending to Delivered", Pending, Delivered, false},
+		{"Pending to Cancelled", Pending, Cancelled, false},
+		{"Pending to Pending", Pending, Pending, true},
+		{"Delivered to Cancelled", Delivered, Cancelled, true},
+		{"Cancelled to Delivered", Cancelled, Delivered, true},
+		{"Delivered to Delivered", Delivered, Delivered, true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			d := NewDelivery(uuid.New(), time.Now(), "Sesame Street", 30, coords)
+			d.status = tc.from
+
+			err := d.ChangeStatus(tc.to)
+			if tc.wantErr {
+				assert.ErrorIs(t, err, ErrCannotChangeDeliveryStatus)
+				assert.Equal(t, tc.from, d.Status())
+				assert.Empty(t, d.UpdatedAt())
+				assert.Empty(t, d.DomainEvents())
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.to, d.Status())
+			assert.NotEmpty(t, d.UpdatedAt())
+
+			events := d.DomainEvents()
+			assert.Len(t, events, 1)
+			assert.Equal(t, "delivery."+tc.to.String(), events[0].Name())
+			assert.Equal(t, d.ContractId(), events[0].AggregateId())
+			assert.Equal(t, tc.to.String(), events[0].Payload().(DeliveryEvent).Status)
+		})
+	}
+}
+
+func TestDelivery_Reschedule(t *testing.T) {
+	date := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	d := NewDelivery(uuid.New(), date, "Sesame Street", 30, valueobjects.Coordinates{})
+
+	assert.NoError(t, d.Reschedule(date.AddDate(0, 0, 2)))
+	assert.Equal(t, date.AddDate(0, 0, 2), d.Date())
+
+	assert.NoError(t, d.ChangeStatus(Delivered))
+	assert.ErrorIs(t, d.Reschedule(date), ErrNotPendingDelivery)
+}
+
+func TestDelivery_Assign(t *testing.T) {
+	d := NewDelivery(uuid.New(), time.Now(), "Sesame Street", 30, valueobjects.Coordinates{})
+	courierId, otherId := uuid.New(), uuid.New()
+
+	assert.Nil(t, d.CourierId())
+
+	assert.NoError(t, d.Assign(courierId))
+	assert.Equal(t, &courierId, d.CourierId())
+	assert.NotEmpty(t, d.UpdatedAt())
+
+	assert.NoError(t, d.Assign(otherId))
+	assert.Equal(t, &otherId, d.CourierId())
+
+	assert.NoError(t, d.Unassign())
+	assert.Nil(t, d.CourierId())
+
+	assert.NoError(t, d.Assign(courierId))
+	assert.NoError(t, d.ChangeStatus(Delivered))
+	assert.ErrorIs(t, d.Assign(otherId), ErrNotPendingDelivery)
+	assert.ErrorIs(t, d.Unassign(), ErrNotPendingDelivery)
+	assert.Equal(t, &courierId, d.CourierId())
+}
+
+func TestDelivery_Book(t *testing.T) {
+	date := time.Date(2025, 3, 1, 8, 0, 0, 0, time.UTC)
+	d := NewDelivery(uuid.New(), date, "Sesame Street", 30, valueobjects.Coordinates{})
+	lunch := slots.NewSlot(uuid.New(), "Lunch", 12*time.Hour, 14*time.Hour, 10)
+	dinner := slots.NewSlot(uuid.New(), "Dinner", 19*time.Hour, 21*time.Hour, 10)
+
+	assert.Nil(t, d.SlotId())
+
+	assert.NoError(t, d.Book(lunch))
+	lunchId := lunch.Id()
+	assert.Equal(t, &lunchId, d.SlotId())
+	assert.Equal(t, time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC), d.Date())
+
+	assert.NoError(t, d.Book(dinner))
+	dinnerId := dinner.Id()
+	assert.Equal(t, &dinnerId, d.SlotId())
+	assert.Equal(t, time.Date(2025, 3, 1, 19, 0, 0, 0, time.UTC), d.Date())
+
+	assert.NoError(t, d.ChangeStatus(Cancelled))
+	assert.ErrorIs(t, d.Book(lunch), ErrNotPendingDelivery)
+	assert.Equal(t, &dinnerId, d.SlotId())
+}
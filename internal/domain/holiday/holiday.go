@@ -0,0 +1,155 @@
+package holidays
+
+import (
+	"errors"
+	"fmt"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/abstractions"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/delivery"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	"github.com/google/uuid"
+	"time"
+)
+
+type Holiday struct {
+	*abstractions.AggregateRoot
+	date      time.Time
+	name      string
+	area      *Area
+	policy    Policy
+	createdAt time.Time
+	updatedAt time.Time
+	deletedAt *time.Time
+}
+
+type Area struct {
+	name   string
+	center valueobjects.Coordinates
+	radius int
+}
+
+var (
+	ErrDateHoliday      = errors.New("holiday date is empty")
+	ErrEmptyNameHoliday = errors.New("holiday name is empty")
+	ErrLongNameHoliday  = errors.New("holiday name cannot be longer than 100 characters")
+	ErrAreaNameHoliday  = errors.New("area name is empty")
+	ErrRadiusHoliday    = errors.New("area radius is not a positive number")
+	ErrPolicyHoliday    = errors.New("holiday policy is not valid")
+	ErrNotFoundHoliday  = errors.New("holiday not found")
+)
+
+func NewArea(name string, center valueobjects.Coordinates, radius int) (*Area, error) {
+	if name == "" {
+		return nil, ErrAreaNameHoliday
+	}
+
+	if radius <= 0 {
+		return nil, fmt.Errorf("%w: got %d", ErrRadiusHoliday, radius)
+	}
+
+	return &Area{name: name, center: center, radius: radius}, nil
+}
+
+func (a *Area) Covers(c valueobjects.Coordinates) bool {
+	return a.center.DistanceTo(c) <= float64(a.radius)
+}
+
+func (a *Area) Name() string {
+	return a.name
+}
+
+func (a *Area) Center() valueobjects.Coordinates {
+	return a.center
+}
+
+func (a *Area) Radius() int {
+	return a.radius
+}
+
+func (h *Holiday) Closes(day time.Time, c valueobjects.Coordinates) bool {
+	if h.date.Format(time.DateOnly) != day.Format(time.DateOnly) {
+		return false
+	}
+	return h.area == nil || h.area.Covers(c)
+}
+
+func (h *Holiday) Impact(dlvrs []*deliveries.Delivery) []*deliveries.Delivery {
+	var impacted []*deliveries.Delivery
+	for _, d := range dlvrs {
+		if d.Status() == deliveries.Pending && h.Closes(d.Date(), d.Coordinates()) {
+			impacted = append(impacted, d)
+		}
+	}
+	return impacted
+}
+
+func (h *Holiday) Id() uuid.UUID {
+	return h.Entity.Id
+}
+
+func (h *Holiday) Date() time.Time {
+	return h.date
+}
+
+func (h *Holiday) Name() string {
+	return h.name
+}
+
+func (h *Holiday) Area() *Area {
+	return h.area
+}
+
+func (h *Holiday) Policy() Policy {
+	return h.policy
+}
+
+func (h *Holiday) CreatedAt() time.Time {
+	return h.createdAt
+}
+
+func (h *Holiday) UpdatedAt() time.Time {
+	return h.updatedAt
+}
+
+func (h *Holiday) DeletedAt() *time.Time {
+	return h.deletedAt
+}
+
+func NewHoliday(date time.Time, name string, area *Area, policy Policy) *Holiday {
+	return &Holiday{
+		AggregateRoot: abstractions.NewAggregateRoot(uuid.New()),
+		date:          date,
+		name:          name,
+		area:          area,
+		policy:        policy,
+	}
+}
+
+func NewHolidayFromDb(id uuid.UUID, date time.Time, name string, areaName *string, latitude, longitude *float64, radius *int, policy string, cAt, uAt time.Time, dAt *time.Time) (*Holiday, error) {
+	p, err := ParsePolicy(policy)
+	if err != nil {
+		return nil, err
+	}
+
+	var area *Area
+	if areaName != nil && latitude != nil && longitude != nil && radius != nil {
+		center, err := valueobjects.NewCoordinates(*latitude, *longitude)
+		if err != nil {
+			return nil, err
+		}
+
+		if area, err = NewArea(*areaName, center, *radius); err != nil {
+			return nil, err
+		}
+	}
+
+	return &Holiday{
+		AggregateRoot: abstractions.NewAggregateRoot(id),
+		date:          date,
+		name:          name,
+		area:          area,
+		policy:        p,
+		createdAt:     cAt,
+		updatedAt:     uAt,
+		deletedAt:     dAt,
+	}, nil
+}
@@ -0,0 +1,70 @@
+package holidays
+
+import (
+	"fmt"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	"time"
+)
+
+type Policy string
+
+const (
+	Shift  Policy = "S"
+	Refuse Policy = "R"
+)
+
+func (p Policy) String() string {
+	switch p {
+	case Shift:
+		return "shift"
+	case Refuse:
+		return "refuse"
+	default:
+		return "unknown"
+	}
+}
+
+func ParsePolicy(s string) (Policy, error) {
+	switch s {
+	case "", "S", "shift":
+		return Shift, nil
+	case "R", "refuse":
+		return Refuse, nil
+	default:
+		return "", fmt.Errorf("%w: got %s", ErrPolicyHoliday, s)
+	}
+}
+
+type Calendar struct {
+	holidays []*Holiday
+}
+
+func NewCalendar(hs ...*Holiday) *Calendar {
+	return &Calendar{holidays: hs}
+}
+
+func (c *Calendar) ClosedOn(day time.Time, at valueobjects.Coordinates) *Holiday {
+	if c == nil {
+		return nil
+	}
+
+	var closed *Holiday
+	for _, h := range c.holidays {
+		if !h.Closes(day, at) {
+			continue
+		}
+
+		if h.policy == Refuse {
+			return h
+		}
+		closed = h
+	}
+	return closed
+}
+
+func (c *Calendar) Holidays() []*Holiday {
+	if c == nil {
+		return nil
+	}
+	return c.holidays
+}
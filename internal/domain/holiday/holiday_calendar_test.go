@@ -0,0 +1,53 @@
+package holidays
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestParsePolicy(t *testing.T) {
+	cases := []struct {
+		in       string
+		expected Policy
+	}{
+		{"", Shift},
+		{"S", Shift},
+		{"shift", Shift},
+		{"R", Refuse},
+		{"refuse", Refuse},
+	}
+
+	for _, tc := range cases {
+		p, err := ParsePolicy(tc.in)
+		assert.NoError(t, err)
+		assert.Equal(t, tc.expected, p)
+	}
+
+	p, err := ParsePolicy("skip")
+	assert.ErrorIs(t, err, ErrPolicyHoliday)
+	assert.Empty(t, p)
+
+	assert.Equal(t, "shift", Shift.String())
+	assert.Equal(t, "refuse", Refuse.String())
+	assert.Equal(t, "unknown", Policy("X").String())
+}
+
+func TestCalendar_ClosedOn(t *testing.T) {
+	date := time.Date(2025, 8, 6, 0, 0, 0, 0, time.UTC)
+	area, err := NewArea("Santa Cruz", santaCruz, 5000)
+	assert.NoError(t, err)
+
+	national := NewHoliday(date, "Independence Day", nil, Shift)
+	regional := NewHoliday(date, "Anniversary", area, Refuse)
+	calendar := NewCalendar(national, regional)
+
+	assert.Equal(t, regional, calendar.ClosedOn(date, santaCruz))
+	assert.Equal(t, national, calendar.ClosedOn(date, laPaz))
+	assert.Nil(t, calendar.ClosedOn(date.AddDate(0, 0, 1), santaCruz))
+	assert.Len(t, calendar.Holidays(), 2)
+
+	var none *Calendar
+	assert.Nil(t, none.ClosedOn(date, santaCruz))
+	assert.Nil(t, none.Holidays())
+}
@@ -0,0 +1,43 @@
+package holidays
+
+import (
+	"fmt"
+	"log"
+	"time"
+)
+
+type HolidayFactory interface {
+	Create(date time.Time, name string, area *Area, policy Policy) (*Holiday, error)
+}
+
+type holidayFactory struct{}
+
+func (holidayFactory) Create(date time.Time, name string, area *Area, policy Policy) (*Holiday, error) {
+	if date.IsZero() {
+		log.Printf("[factory:holiday] date is empty")
+		return nil, ErrDateHoliday
+	}
+
+	if name == "" {
+		log.Printf("[factory:holiday] name is empty")
+		return nil, ErrEmptyNameHoliday
+	}
+
+	if len(name) > 100 {
+		log.Printf("[factory:holiday] name '%s' is longer than 100 characters", name)
+		return nil, fmt.Errorf("%w: got %s, size %d", ErrLongNameHoliday, name, len(name))
+	}
+
+	if policy != Shift && policy != Refuse {
+		log.Printf("[factory:holiday] policy '%s' is not valid", policy)
+		return nil, fmt.Errorf("%w: got %s", ErrPolicyHoliday, policy)
+	}
+
+	log.Printf("[factory:holiday] holiday '%s' on %s created", name, date.Format(time.DateOnly))
+	day := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+	return NewHoliday(day, name, area, policy), nil
+}
+
+func NewHolidayFactory() HolidayFactory {
+	return &holidayFactory{}
+}
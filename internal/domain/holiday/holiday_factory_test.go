@@ -0,0 +1,48 @@
+package holidays
+
+import (
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestHolidayFactory_Create(t *testing.T) {
+	factory := NewHolidayFactory()
+	date := time.Date(2025, 8, 6, 15, 30, 0, 0, time.FixedZone("BOT", -4*60*60))
+
+	holiday, err := factory.Create(date, "Independence Day", nil, Refuse)
+
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2025, 8, 6, 0, 0, 0, 0, time.UTC), holiday.Date())
+	assert.Equal(t, "Independence Day", holiday.Name())
+	assert.Equal(t, Refuse, holiday.Policy())
+	assert.Nil(t, holiday.Area())
+}
+
+func TestHolidayFactory_Create_Errors(t *testing.T) {
+	factory := NewHolidayFactory()
+	date := time.Date(2025, 8, 6, 0, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		name   string
+		date   time.Time
+		title  string
+		policy Policy
+		err    error
+	}{
+		{"empty date", time.Time{}, "Independence Day", Shift, ErrDateHoliday},
+		{"empty name", date, "", Shift, ErrEmptyNameHoliday},
+		{"long name", date, strings.Repeat("a", 101), Shift, ErrLongNameHoliday},
+		{"invalid policy", date, "Independence Day", Policy("X"), ErrPolicyHoliday},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			holiday, err := factory.Create(tc.date, tc.title, nil, tc.policy)
+
+			assert.ErrorIs(t, err, tc.err)
+			assert.Nil(t, holiday)
+		})
+	}
+}
@@ -0,0 +1,15 @@
+package holidays
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"time"
+)
+
+type HolidayRepository interface {
+	GetBetween(ctx context.Context, from, to time.Time) ([]*Holiday, error)
+	GetById(ctx context.Context, id uuid.UUID) (*Holiday, error)
+
+	Create(ctx context.Context, holiday *Holiday) (*Holiday, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+}
@@ -0,0 +1,100 @@
+package holidays
+
+import (
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/delivery"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+var (
+	santaCruz, _ = valueobjects.NewCoordinates(-17.7863, -63.1812)
+	laPaz, _     = valueobjects.NewCoordinates(-16.5000, -68.1500)
+)
+
+func TestNewArea(t *testing.T) {
+	area, err := NewArea("Santa Cruz", santaCruz, 5000)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Santa Cruz", area.Name())
+	assert.Equal(t, santaCruz, area.Center())
+	assert.Equal(t, 5000, area.Radius())
+	assert.True(t, area.Covers(santaCruz))
+	assert.False(t, area.Covers(laPaz))
+
+	area, err = NewArea("", santaCruz, 5000)
+	assert.ErrorIs(t, err, ErrAreaNameHoliday)
+	assert.Nil(t, area)
+
+	area, err = NewArea("Santa Cruz", santaCruz, 0)
+	assert.ErrorIs(t, err, ErrRadiusHoliday)
+	assert.Nil(t, area)
+}
+
+func TestHoliday_Closes(t *testing.T) {
+	date := time.Date(2025, 8, 6, 0, 0, 0, 0, time.UTC)
+	area, err := NewArea("Santa Cruz", santaCruz, 5000)
+	assert.NoError(t, err)
+
+	national := NewHoliday(date, "Independence Day", nil, Shift)
+	regional := NewHoliday(date, "Anniversary", area, Refuse)
+
+	local := time.Date(2025, 8, 6, 12, 0, 0, 0, time.FixedZone("BOT", -4*60*60))
+	assert.True(t, national.Closes(local, laPaz))
+	assert.False(t, national.Closes(local.AddDate(0, 0, 1), laPaz))
+	assert.True(t, regional.Closes(local, santaCruz))
+	assert.False(t, regional.Closes(local, laPaz))
+}
+
+func TestHoliday_Impact(t *testing.T) {
+	date := time.Date(2025, 8, 6, 0, 0, 0, 0, time.UTC)
+	holiday := NewHoliday(date, "Independence Day", nil, Shift)
+
+	newDelivery := func(day time.Time, status string) *deliveries.Delivery {
+		d, err := deliveries.NewDeliveryFromDB(uuid.New(), uuid.New(), day, "Sesame Street", 30, -17.7863, -63.1812, status, nil, nil, day, day, nil)
+		assert.NoError(t, err)
+		return d
+	}
+
+	pending := newDelivery(date, "P")
+	delivered := newDelivery(date, "D")
+	other := newDelivery(date.AddDate(0, 0, 1), "P")
+
+	impacted := holiday.Impact([]*deliveries.Delivery{pending, delivered, other})
+
+	assert.Equal(t, []*deliveries.Delivery{pending}, impacted)
+}
+
+func TestNewHolidayFromDb(t *testing.T) {
+	id := uuid.New()
+	date := time.Date(2025, 8, 6, 0, 0, 0, 0, time.UTC)
+	now := time.Now()
+	name, lat, lon, radius := "Santa Cruz", -17.7863, -63.1812, 5000
+
+	holiday, err := NewHolidayFromDb(id, date, "Anniversary", &name, &lat, &lon, &radius, "R", now, now, nil)
+
+	assert.NoError(t, err)
+	assert.Equal(t, id, holiday.Id())
+	assert.Equal(t, date, holiday.Date())
+	assert.Equal(t, "Anniversary", holiday.Name())
+	assert.Equal(t, Refuse, holiday.Policy())
+	assert.Equal(t, name, holiday.Area().Name())
+	assert.Equal(t, now, holiday.CreatedAt())
+	assert.Equal(t, now, holiday.UpdatedAt())
+	assert.Nil(t, holiday.DeletedAt())
+
+	holiday, err = NewHolidayFromDb(id, date, "Independence Day", nil, nil, nil, nil, "S", now, now, nil)
+	assert.NoError(t, err)
+	assert.Nil(t, holiday.Area())
+
+	holiday, err = NewHolidayFromDb(id, date, "Independence Day", nil, nil, nil, nil, "X", now, now, nil)
+	assert.ErrorIs(t, err, ErrPolicyHoliday)
+	assert.Nil(t, holiday)
+
+	radius = 0
+	holiday, err = NewHolidayFromDb(id, date, "Anniversary", &name, &lat, &lon, &radius, "R", now, now, nil)
+	assert.ErrorIs(t, err, ErrRadiusHoliday)
+	assert.Nil(t, holiday)
+}
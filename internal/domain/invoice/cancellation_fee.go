@@ -0,0 +1,47 @@
+package invoices
+
+import (
+	"errors"
+	"fmt"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+)
+
+type CancellationFee struct {
+	rate  int
+	fixed int
+}
+
+var (
+	ErrRateCancellationFee  = errors.New("cancellation fee rate is not between 0 and 10000 basis points")
+	ErrFixedCancellationFee = errors.New("fixed cancellation fee is negative")
+)
+
+func NewCancellationFee(rate, fixed int) (CancellationFee, error) {
+	if rate < 0 || rate > 10000 {
+		return CancellationFee{}, fmt.Errorf("%w: got %d", ErrRateCancellationFee, rate)
+	}
+
+	if fixed < 0 {
+		return CancellationFee{}, fmt.Errorf("%w: got %d", ErrFixedCancellationFee, fixed)
+	}
+
+	return CancellationFee{rate: rate, fixed: fixed}, nil
+}
+
+func (f CancellationFee) Rate() int {
+	return f.rate
+}
+
+func (f CancellationFee) Fixed() int {
+	return f.fixed
+}
+
+func (f CancellationFee) Of(refundable valueobjects.Money) valueobjects.Money {
+	fee := (refundable.Amount()*f.rate+5000)/10000 + f.fixed
+	if fee > refundable.Amount() {
+		fee = refundable.Amount()
+	}
+
+	money, _ := valueobjects.NewMoney(fee, refundable.Currency())
+	return money
+}
@@ -0,0 +1,48 @@
+package invoices
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestNewCancellationFee(t *testing.T) {
+	fee, err := NewCancellationFee(1000, 500)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1000, fee.Rate())
+	assert.Equal(t, 500, fee.Fixed())
+
+	_, err = NewCancellationFee(-1, 0)
+	assert.ErrorIs(t, err, ErrRateCancellationFee)
+	_, err = NewCancellationFee(10001, 0)
+	assert.ErrorIs(t, err, ErrRateCancellationFee)
+	_, err = NewCancellationFee(0, -1)
+	assert.ErrorIs(t, err, ErrFixedCancellationFee)
+}
+
+func TestCancellationFee_Of(t *testing.T) {
+	cases := []struct {
+		name       string
+		rate       int
+		fixed      int
+		refundable int
+		expected   int
+	}{
+		{"no fee", 0, 0, 30000, 0},
+		{"rate", 1000, 0, 30000, 3000},
+		{"rate rounded half up", 1250, 0, 30002, 3750},
+		{"fixed", 0, 5000, 30000, 5000},
+		{"rate and fixed", 1000, 5000, 30000, 8000},
+		{"capped at refundable", 0, 5000, 3000, 3000},
+		{"nothing refundable", 1000, 5000, 0, 0},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			fee, err := NewCancellationFee(tc.rate, tc.fixed)
+			assert.NoError(t, err)
+
+			assert.Equal(t, bolivianos(tc.expected), fee.Of(bolivianos(tc.refundable)))
+		})
+	}
+}
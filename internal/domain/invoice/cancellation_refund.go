@@ -0,0 +1,59 @@
+package invoices
+
+import (
+	"errors"
+	"fmt"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/contract"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/delivery"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	"github.com/google/uuid"
+)
+
+type CancellationRefund struct {
+	ContractId uuid.UUID
+	Deliveries int
+	Delivered  int
+	Pending    int
+	Cancelled  int
+	UnitPrice  valueobjects.Money
+	Consumed   valueobjects.Money
+	Refundable valueobjects.Money
+	Fee        valueobjects.Money
+	Total      valueobjects.Money
+}
+
+var ErrDeliveriesRefund = errors.New("plan delivery count is not a positive number")
+
+// ProrateRefund prorates the consumed amount on the whole cost, so that a
+// contract delivered in full refunds nothing whatever the unit price rounding.
+func ProrateRefund(contract *contracts.Contract, planDeliveries int, fee CancellationFee) (CancellationRefund, error) {
+	if planDeliveries <= 0 {
+		return CancellationRefund{}, fmt.Errorf("%w: got %d", ErrDeliveriesRefund, planDeliveries)
+	}
+
+	if !contract.ContractStatus().CanTransitionTo(contracts.Cancelled) {
+		return CancellationRefund{}, fmt.Errorf("%w: %s to %s", contracts.ErrChangeStatusContract, contract.ContractStatus().String(), contracts.Cancelled.String())
+	}
+
+	refund := CancellationRefund{ContractId: contract.Id(), Deliveries: planDeliveries}
+	for _, d := range contract.Deliveries() {
+		switch d.Status() {
+		case deliveries.Delivered:
+			refund.Delivered++
+		case deliveries.Pending:
+			refund.Pending++
+		case deliveries.Cancelled:
+			refund.Cancelled++
+		}
+	}
+
+	cost := contract.CostValue()
+	consumed := min(refund.Delivered, planDeliveries)
+
+	refund.UnitPrice, _ = valueobjects.NewMoney(cost.Amount()/planDeliveries, cost.Currency())
+	refund.Consumed, _ = valueobjects.NewMoney(cost.Amount()*consumed/planDeliveries, cost.Currency())
+	refund.Refundable, _ = cost.Sub(refund.Consumed)
+	refund.Fee = fee.Of(refund.Refundable)
+	refund.Total, _ = refund.Refundable.Sub(refund.Fee)
+	return refund, nil
+}
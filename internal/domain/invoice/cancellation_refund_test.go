@@ -0,0 +1,94 @@
+package invoices
+
+import (
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/contract"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/delivery"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/plan"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+var halfMonthPlan = plans.NewPlan("H", "Half-month", 15, plans.Daily, 1000)
+
+func newRunningContract(t *testing.T, days int, cost int) *contracts.Contract {
+	coords, err := valueobjects.NewCoordinates(-17.7863, -63.1812)
+	assert.NoError(t, err)
+
+	now := time.Now()
+	contract := contracts.NewContract(uuid.New(), uuid.New(), halfMonthPlan, now.AddDate(0, 0, -days), bolivianos(cost), "Sesame Street", 30, coords)
+	assert.NoError(t, contract.Active())
+	for i := range contract.Deliveries() {
+		if d := &contract.Deliveries()[i]; d.Date().Before(now) {
+			assert.NoError(t, d.ChangeStatus(deliveries.Delivered))
+		}
+	}
+	return contract
+}
+
+func TestProrateRefund(t *testing.T) {
+	contract := newRunningContract(t, 5, 45000)
+	fee, err := NewCancellationFee(1000, 0)
+	assert.NoError(t, err)
+
+	refund, err := ProrateRefund(contract, halfMonthPlan.DeliveryCount(), fee)
+
+	assert.NoError(t, err)
+	assert.Equal(t, contract.Id(), refund.ContractId)
+	assert.Equal(t, 15, refund.Deliveries)
+	assert.Equal(t, 5, refund.Delivered)
+	assert.Equal(t, 10, refund.Pending)
+	assert.Equal(t, 0, refund.Cancelled)
+	assert.Equal(t, bolivianos(3000), refund.UnitPrice)
+	assert.Equal(t, bolivianos(15000), refund.Consumed)
+	assert.Equal(t, bolivianos(30000), refund.Refundable)
+	assert.Equal(t, bolivianos(3000), refund.Fee)
+	assert.Equal(t, bolivianos(27000), refund.Total)
+}
+
+func TestProrateRefund_Rounding(t *testing.T) {
+	contract := newRunningContract(t, 1, 1000)
+
+	refund, err := ProrateRefund(contract, 3, CancellationFee{})
+
+	assert.NoError(t, err)
+	assert.Equal(t, bolivianos(333), refund.UnitPrice)
+	assert.Equal(t, bolivianos(333), refund.Consumed)
+	assert.Equal(t, bolivianos(667), refund.Total)
+
+	refund, err = ProrateRefund(contract, 1, CancellationFee{})
+
+	assert.NoError(t, err)
+	assert.Equal(t, bolivianos(1000), refund.Consumed)
+	assert.Equal(t, bolivianos(0), refund.Total)
+}
+
+func TestProrateRefund_NotStarted(t *testing.T) {
+	coords, err := valueobjects.NewCoordinates(-17.7863, -63.1812)
+	assert.NoError(t, err)
+	contract := contracts.NewContract(uuid.New(), uuid.New(), halfMonthPlan, time.Now().AddDate(0, 0, 3), bolivianos(45000), "Sesame Street", 30, coords)
+	fee, err := NewCancellationFee(0, 5000)
+	assert.NoError(t, err)
+
+	refund, err := ProrateRefund(contract, 15, fee)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 0, refund.Delivered)
+	assert.Equal(t, bolivianos(45000), refund.Refundable)
+	assert.Equal(t, bolivianos(40000), refund.Total)
+}
+
+func TestProrateRefund_Errors(t *testing.T) {
+	contract := newRunningContract(t, 5, 45000)
+
+	_, err := ProrateRefund(contract, 0, CancellationFee{})
+	assert.ErrorIs(t, err, ErrDeliveriesRefund)
+
+	_, err = contract.Cancel("moved abroad")
+	assert.NoError(t, err)
+
+	_, err = ProrateRefund(contract, 15, CancellationFee{})
+	assert.ErrorIs(t, err, contracts.ErrChangeStatusContract)
+}
@@ -0,0 +1,78 @@
+package invoices
+
+import (
+	"errors"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/abstractions"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	"github.com/google/uuid"
+	"time"
+)
+
+type CreditNote struct {
+	*abstractions.Entity
+	invoiceId uuid.UUID
+	amount    valueobjects.Money
+	reason    string
+	issuedAt  time.Time
+	createdAt time.Time
+}
+
+var (
+	ErrAmountCreditNote = errors.New("credit note amount is not a positive number")
+	ErrCreditNote       = errors.New("credit is more than what is left of the invoice")
+)
+
+func (c *CreditNote) Id() uuid.UUID {
+	return c.Entity.Id
+}
+
+func (c *CreditNote) InvoiceId() uuid.UUID {
+	return c.invoiceId
+}
+
+func (c *CreditNote) Amount() valueobjects.Money {
+	return c.amount
+}
+
+func (c *CreditNote) Reason() string {
+	return c.reason
+}
+
+func (c *CreditNote) IssuedAt() time.Time {
+	return c.issuedAt
+}
+
+func (c *CreditNote) CreatedAt() time.Time {
+	return c.createdAt
+}
+
+func NewCreditNote(invoiceId uuid.UUID, amount valueobjects.Money, reason string, issuedAt time.Time) *CreditNote {
+	return &CreditNote{
+		Entity:    abstractions.NewEntity(uuid.New()),
+		invoiceId: invoiceId,
+		amount:    amount,
+		reason:    reason,
+		issuedAt:  issuedAt,
+	}
+}
+
+func NewCreditNoteFromDb(id, invoiceId uuid.UUID, amount int, currency, reason string, issuedAt, cAt time.Time) (*CreditNote, error) {
+	c, err := valueobjects.ParseCurrency(currency)
+	if err != nil {
+		return nil, err
+	}
+
+	money, err := valueobjects.NewMoney(amount, c)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CreditNote{
+		Entity:    abstractions.NewEntity(id),
+		invoiceId: invoiceId,
+		amount:    money,
+		reason:    reason,
+		issuedAt:  issuedAt,
+		createdAt: cAt,
+	}, nil
+}
@@ -0,0 +1,35 @@
+package invoices
+
+import (
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestNewCreditNote(t *testing.T) {
+	invoiceId := uuid.New()
+
+	note := NewCreditNote(invoiceId, bolivianos(27000), "moved abroad", issuedAt)
+
+	assert.NotEqual(t, uuid.Nil, note.Id())
+	assert.Equal(t, invoiceId, note.InvoiceId())
+	assert.Equal(t, bolivianos(27000), note.Amount())
+	assert.Equal(t, "moved abroad", note.Reason())
+	assert.Equal(t, issuedAt, note.IssuedAt())
+}
+
+func TestNewCreditNoteFromDb(t *testing.T) {
+	id, invoiceId := uuid.New(), uuid.New()
+
+	note, err := NewCreditNoteFromDb(id, invoiceId, 27000, "BOB", "moved abroad", issuedAt, issuedAt)
+
+	assert.NoError(t, err)
+	assert.Equal(t, id, note.Id())
+	assert.Equal(t, invoiceId, note.InvoiceId())
+	assert.Equal(t, bolivianos(27000), note.Amount())
+	assert.Equal(t, issuedAt, note.CreatedAt())
+
+	_, err = NewCreditNoteFromDb(id, invoiceId, 27000, "EUR", "moved abroad", issuedAt, issuedAt)
+	assert.ErrorIs(t, err, valueobjects.ErrCurrencyMoney)
+}
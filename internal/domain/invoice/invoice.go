@@ -0,0 +1,259 @@
+package invoices
+
+import (
+	"errors"
+	"fmt"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/abstractions"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	"github.com/google/uuid"
+	"time"
+)
+
+type Invoice struct {
+	*abstractions.AggregateRoot
+	series     string
+	sequence   int
+	contractId uuid.UUID
+	patientId  uuid.UUID
+	amount     valueobjects.Money
+	issuedAt   time.Time
+	payments   []*Payment
+	credits    []*CreditNote
+	createdAt  time.Time
+	updatedAt  time.Time
+}
+
+var (
+	ErrSeriesInvoice     = errors.New("invoice series is not valid")
+	ErrSequenceInvoice   = errors.New("invoice sequence is not a positive number")
+	ErrContractIdInvoice = errors.New("contractId is not a valid UUID")
+	ErrPatientIdInvoice  = errors.New("patientId is not a valid UUID")
+	ErrAmountInvoice     = errors.New("invoice amount is negative")
+	ErrNotFoundInvoice   = errors.New("invoice not found")
+	ErrAmountPayment     = errors.New("payment amount is not a positive number")
+	ErrSettledInvoice    = errors.New("invoice is already paid")
+	ErrFullPayment       = errors.New("a full payment must settle the balance")
+	ErrPartialPayment    = errors.New("a partial payment must be less than the balance")
+	ErrRefundPayment     = errors.New("refund is more than what was paid")
+	ErrUnpaidInvoice     = errors.New("invoice is not paid")
+	ErrDeclinedPayment   = errors.New("payment was declined by the provider")
+)
+
+func (i *Invoice) Number() string {
+	return fmt.Sprintf("%s-%06d", i.series, i.sequence)
+}
+
+func (i *Invoice) Paid() valueobjects.Money {
+	paid, _ := valueobjects.NewMoney(0, i.amount.Currency())
+	for _, p := range i.payments {
+		if p.kind == Refund {
+			paid, _ = paid.Sub(p.amount)
+		} else {
+			paid, _ = paid.Add(p.amount)
+		}
+	}
+	return paid
+}
+
+func (i *Invoice) Refunded() valueobjects.Money {
+	refunded, _ := valueobjects.NewMoney(0, i.amount.Currency())
+	for _, p := range i.payments {
+		if p.kind == Refund {
+			refunded, _ = refunded.Add(p.amount)
+		}
+	}
+	return refunded
+}
+
+func (i *Invoice) Credited() valueobjects.Money {
+	credited, _ := valueobjects.NewMoney(0, i.amount.Currency())
+	for _, c := range i.credits {
+		credited, _ = credited.Add(c.amount)
+	}
+	return credited
+}
+
+func (i *Invoice) Balance() valueobjects.Money {
+	balance, _ := i.amount.Sub(i.Credited())
+	balance, _ = balance.Sub(i.Paid())
+	return balance
+}
+
+func (i *Invoice) IsPaid() bool {
+	return !i.Balance().IsPositive()
+}
+
+func (i *Invoice) Status() InvoiceStatus {
+	switch {
+	case i.Credited().IsPositive() && i.Credited() == i.amount && i.Paid().IsZero():
+		return Credited
+	case i.IsPaid():
+		return Paid
+	case i.Refunded().IsPositive() && i.Paid().IsZero():
+		return Refunded
+	case i.Paid().IsPositive():
+		return PartiallyPaid
+	default:
+		return Unpaid
+	}
+}
+
+func (i *Invoice) Check(kind PaymentKind, amount valueobjects.Money) error {
+	if !amount.IsPositive() {
+		return fmt.Errorf("%w: got %s", ErrAmountPayment, amount)
+	}
+
+	if kind == Refund {
+		cmp, err := amount.Compare(i.Paid())
+		if err != nil {
+			return err
+		}
+		if cmp > 0 {
+			return fmt.Errorf("%w: got %s, paid %s", ErrRefundPayment, amount, i.Paid())
+		}
+		return nil
+	}
+
+	if i.IsPaid() {
+		return fmt.Errorf("%w: %s", ErrSettledInvoice, i.Number())
+	}
+
+	cmp, err := amount.Compare(i.Balance())
+	if err != nil {
+		return err
+	}
+
+	switch kind {
+	case Full:
+		if cmp != 0 {
+			return fmt.Errorf("%w: got %s, balance %s", ErrFullPayment, amount, i.Balance())
+		}
+	case Partial:
+		if cmp >= 0 {
+			return fmt.Errorf("%w: got %s, balance %s", ErrPartialPayment, amount, i.Balance())
+		}
+	default:
+		return fmt.Errorf("%w: got %s", ErrKindPayment, kind)
+	}
+	return nil
+}
+
+func (i *Invoice) Credit(amount valueobjects.Money, reason string, at time.Time) (*CreditNote, error) {
+	if !amount.IsPositive() {
+		return nil, fmt.Errorf("%w: got %s", ErrAmountCreditNote, amount)
+	}
+
+	left, _ := i.amount.Sub(i.Credited())
+	cmp, err := amount.Compare(left)
+	if err != nil {
+		return nil, err
+	}
+	if cmp > 0 {
+		return nil, fmt.Errorf("%w: got %s, left %s", ErrCreditNote, amount, left)
+	}
+
+	c := NewCreditNote(i.Id(), amount, reason, at)
+	i.credits = append(i.credits, c)
+	return c, nil
+}
+
+func (i *Invoice) Record(kind PaymentKind, amount valueobjects.Money, provider, reference string, at time.Time) (*Payment, error) {
+	if err := i.Check(kind, amount); err != nil {
+		return nil, err
+	}
+
+	p := NewPayment(i.Id(), kind, amount, provider, reference, at)
+	i.payments = append(i.payments, p)
+	return p, nil
+}
+
+func (i *Invoice) HasPayment(provider, reference string) bool {
+	for _, p := range i.payments {
+		if p.provider == provider && p.reference == reference {
+			return true
+		}
+	}
+	return false
+}
+
+func (i *Invoice) Id() uuid.UUID {
+	return i.Entity.Id
+}
+
+func (i *Invoice) Series() string {
+	return i.series
+}
+
+func (i *Invoice) Sequence() int {
+	return i.sequence
+}
+
+func (i *Invoice) ContractId() uuid.UUID {
+	return i.contractId
+}
+
+func (i *Invoice) PatientId() uuid.UUID {
+	return i.patientId
+}
+
+func (i *Invoice) Amount() valueobjects.Money {
+	return i.amount
+}
+
+func (i *Invoice) IssuedAt() time.Time {
+	return i.issuedAt
+}
+
+func (i *Invoice) Payments() []*Payment {
+	return i.payments
+}
+
+func (i *Invoice) CreditNotes() []*CreditNote {
+	return i.credits
+}
+
+func (i *Invoice) CreatedAt() time.Time {
+	return i.createdAt
+}
+
+func (i *Invoice) UpdatedAt() time.Time {
+	return i.updatedAt
+}
+
+func NewInvoice(series string, sequence int, contractId, patientId uuid.UUID, amount valueobjects.Money, issuedAt time.Time) *Invoice {
+	return &Invoice{
+		AggregateRoot: abstractions.NewAggregateRoot(uuid.New()),
+		series:        series,
+		sequence:      sequence,
+		contractId:    contractId,
+		patientId:     patientId,
+		amount:        amount,
+		issuedAt:      issuedAt,
+	}
+}
+
+func NewInvoiceFromDb(id uuid.UUID, series string, sequence int, contractId, patientId uuid.UUID, amount int, currency string, issuedAt time.Time, payments []*Payment, credits []*CreditNote, cAt, uAt time.Time) (*Invoice, error) {
+	c, err := valueobjects.ParseCurrency(currency)
+	if err != nil {
+		return nil, err
+	}
+
+	money, err := valueobjects.NewMoney(amount, c)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Invoice{
+		AggregateRoot: abstractions.NewAggregateRoot(id),
+		series:        series,
+		sequence:      sequence,
+		contractId:    contractId,
+		patientId:     patientId,
+		amount:        money,
+		issuedAt:      issuedAt,
+		payments:      payments,
+		credits:       credits,
+		createdAt:     cAt,
+		updatedAt:     uAt,
+	}, nil
+}
@@ -0,0 +1,57 @@
+package invoices
+
+import (
+	"fmt"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	"github.com/google/uuid"
+	"log"
+	"regexp"
+	"time"
+)
+
+var seriesPattern = regexp.MustCompile(`^[A-Z0-9]{1,10}$`)
+
+func IsValidSeries(series string) bool {
+	return seriesPattern.MatchString(series)
+}
+
+type InvoiceFactory interface {
+	Create(series string, sequence int, contractId, patientId uuid.UUID, amount valueobjects.Money, issuedAt time.Time) (*Invoice, error)
+}
+
+type invoiceFactory struct{}
+
+func (invoiceFactory) Create(series string, sequence int, contractId, patientId uuid.UUID, amount valueobjects.Money, issuedAt time.Time) (*Invoice, error) {
+	if !IsValidSeries(series) {
+		log.Printf("[factory:invoice] series '%s' is not valid", series)
+		return nil, fmt.Errorf("%w: got %s", ErrSeriesInvoice, series)
+	}
+
+	if sequence <= 0 {
+		log.Printf("[factory:invoice] sequence '%d' needs to be a positive number", sequence)
+		return nil, fmt.Errorf("%w: got %d", ErrSequenceInvoice, sequence)
+	}
+
+	if contractId == uuid.Nil {
+		log.Printf("[factory:invoice] contractId is nil")
+		return nil, ErrContractIdInvoice
+	}
+
+	if patientId == uuid.Nil {
+		log.Printf("[factory:invoice] patientId is nil")
+		return nil, ErrPatientIdInvoice
+	}
+
+	if amount.IsNegative() {
+		log.Printf("[factory:invoice] amount '%s' is negative", amount)
+		return nil, fmt.Errorf("%w: got %s", ErrAmountInvoice, amount)
+	}
+
+	invoice := NewInvoice(series, sequence, contractId, patientId, amount, issuedAt)
+	log.Printf("[factory:invoice] invoice '%s' created", invoice.Number())
+	return invoice, nil
+}
+
+func NewInvoiceFactory() InvoiceFactory {
+	return &invoiceFactory{}
+}
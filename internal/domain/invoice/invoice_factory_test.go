@@ -0,0 +1,59 @@
+package invoices
+
+import (
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestIsValidSeries(t *testing.T) {
+	for _, valid := range []string{"NC", "A", "SCZ2025", "0123456789"} {
+		assert.True(t, IsValidSeries(valid), valid)
+	}
+
+	for _, invalid := range []string{"", "nc", "N-C", "N C", "ABCDEFGHIJK"} {
+		assert.False(t, IsValidSeries(invalid), invalid)
+	}
+}
+
+func TestInvoiceFactory_Create(t *testing.T) {
+	factory := NewInvoiceFactory()
+	contractId, patientId := uuid.New(), uuid.New()
+
+	invoice, err := factory.Create("NC", 1, contractId, patientId, bolivianos(45000), issuedAt)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "NC-000001", invoice.Number())
+	assert.Equal(t, contractId, invoice.ContractId())
+	assert.Equal(t, patientId, invoice.PatientId())
+}
+
+func TestInvoiceFactory_Create_Errors(t *testing.T) {
+	factory := NewInvoiceFactory()
+	contractId, patientId := uuid.New(), uuid.New()
+
+	cases := []struct {
+		name       string
+		series     string
+		sequence   int
+		contractId uuid.UUID
+		patientId  uuid.UUID
+		amount     int
+		err        error
+	}{
+		{"series", "nc", 1, contractId, patientId, 45000, ErrSeriesInvoice},
+		{"sequence", "NC", 0, contractId, patientId, 45000, ErrSequenceInvoice},
+		{"contract", "NC", 1, uuid.Nil, patientId, 45000, ErrContractIdInvoice},
+		{"patient", "NC", 1, contractId, uuid.Nil, 45000, ErrPatientIdInvoice},
+		{"amount", "NC", 1, contractId, patientId, -1, ErrAmountInvoice},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			invoice, err := factory.Create(tc.series, tc.sequence, tc.contractId, tc.patientId, bolivianos(tc.amount), issuedAt)
+
+			assert.Nil(t, invoice)
+			assert.ErrorIs(t, err, tc.err)
+		})
+	}
+}
@@ -0,0 +1,18 @@
+package invoices
+
+import (
+	"context"
+	"github.com/google/uuid"
+)
+
+type InvoiceRepository interface {
+	GetById(ctx context.Context, id uuid.UUID) (*Invoice, error)
+	GetByIdForUpdate(ctx context.Context, id uuid.UUID) (*Invoice, error)
+	GetByContract(ctx context.Context, contractId uuid.UUID) (*Invoice, error)
+	GetByPatient(ctx context.Context, patientId uuid.UUID) ([]*Invoice, error)
+
+	NextSequence(ctx context.Context, series string) (int, error)
+	Create(ctx context.Context, invoice *Invoice) (*Invoice, error)
+	AddPayment(ctx context.Context, payment *Payment) (*Payment, error)
+	AddCreditNote(ctx context.Context, note *CreditNote) (*CreditNote, error)
+}
@@ -0,0 +1,28 @@
+package invoices
+
+type InvoiceStatus string
+
+const (
+	Unpaid        InvoiceStatus = "U"
+	PartiallyPaid InvoiceStatus = "P"
+	Paid          InvoiceStatus = "D"
+	Refunded      InvoiceStatus = "R"
+	Credited      InvoiceStatus = "C"
+)
+
+func (s InvoiceStatus) String() string {
+	switch s {
+	case Unpaid:
+		return "unpaid"
+	case PartiallyPaid:
+		return "partially paid"
+	case Paid:
+		return "paid"
+	case Refunded:
+		return "refunded"
+	case Credited:
+		return "credited"
+	default:
+		return "unknown"
+	}
+}
@@ -0,0 +1,156 @@
+package invoices
+
+import (
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+var issuedAt = time.Date(2025, 10, 1, 10, 0, 0, 0, time.UTC)
+
+func newInvoice() *Invoice {
+	return NewInvoice("NC", 42, uuid.New(), uuid.New(), bolivianos(45000), issuedAt)
+}
+
+func TestNewInvoice(t *testing.T) {
+	contractId, patientId := uuid.New(), uuid.New()
+
+	invoice := NewInvoice("NC", 42, contractId, patientId, bolivianos(45000), issuedAt)
+
+	assert.NotEqual(t, uuid.Nil, invoice.Id())
+	assert.Equal(t, "NC", invoice.Series())
+	assert.Equal(t, 42, invoice.Sequence())
+	assert.Equal(t, "NC-000042", invoice.Number())
+	assert.Equal(t, contractId, invoice.ContractId())
+	assert.Equal(t, patientId, invoice.PatientId())
+	assert.Equal(t, bolivianos(45000), invoice.Amount())
+	assert.Equal(t, issuedAt, invoice.IssuedAt())
+	assert.Empty(t, invoice.Payments())
+	assert.Equal(t, bolivianos(0), invoice.Paid())
+	assert.Equal(t, bolivianos(45000), invoice.Balance())
+	assert.False(t, invoice.IsPaid())
+	assert.Equal(t, Unpaid, invoice.Status())
+}
+
+func TestInvoice_Record(t *testing.T) {
+	invoice := newInvoice()
+
+	partial, err := invoice.Record(Partial, bolivianos(20000), "fake", "fake_1", issuedAt.Add(time.Hour))
+	assert.NoError(t, err)
+	assert.Equal(t, invoice.Id(), partial.InvoiceId())
+	assert.Equal(t, Partial, partial.Kind())
+	assert.Equal(t, bolivianos(20000), invoice.Paid())
+	assert.Equal(t, bolivianos(25000), invoice.Balance())
+	assert.Equal(t, PartiallyPaid, invoice.Status())
+
+	_, err = invoice.Record(Full, bolivianos(25000), "fake", "fake_2", issuedAt.Add(2*time.Hour))
+	assert.NoError(t, err)
+	assert.True(t, invoice.IsPaid())
+	assert.Equal(t, Paid, invoice.Status())
+	assert.Len(t, invoice.Payments(), 2)
+
+	_, err = invoice.Record(Refund, bolivianos(45000), "fake", "fake_3", issuedAt.Add(3*time.Hour))
+	assert.NoError(t, err)
+	assert.Equal(t, bolivianos(0), invoice.Paid())
+	assert.Equal(t, bolivianos(45000), invoice.Refunded())
+	assert.Equal(t, Refunded, invoice.Status())
+}
+
+func TestInvoice_Check(t *testing.T) {
+	invoice := newInvoice()
+	dollars, _ := valueobjects.NewMoney(100, valueobjects.USD)
+
+	assert.ErrorIs(t, invoice.Check(Partial, bolivianos(0)), ErrAmountPayment)
+	assert.ErrorIs(t, invoice.Check(Partial, bolivianos(-1)), ErrAmountPayment)
+	assert.ErrorIs(t, invoice.Check(Full, bolivianos(44999)), ErrFullPayment)
+	assert.ErrorIs(t, invoice.Check(Partial, bolivianos(45000)), ErrPartialPayment)
+	assert.ErrorIs(t, invoice.Check(Refund, bolivianos(1)), ErrRefundPayment)
+	assert.ErrorIs(t, invoice.Check(Partial, dollars), valueobjects.ErrCurrencyMismatchMoney)
+	assert.ErrorIs(t, invoice.Check(PaymentKind("X"), bolivianos(1)), ErrKindPayment)
+	assert.NoError(t, invoice.Check(Full, bolivianos(45000)))
+
+	_, err := invoice.Record(Full, bolivianos(45000), "fake", "fake_1", issuedAt)
+	assert.NoError(t, err)
+	assert.ErrorIs(t, invoice.Check(Partial, bolivianos(1)), ErrSettledInvoice)
+	assert.NoError(t, invoice.Check(Refund, bolivianos(45000)))
+	assert.ErrorIs(t, invoice.Check(Refund, bolivianos(45001)), ErrRefundPayment)
+
+	_, err = invoice.Record(Refund, bolivianos(46000), "fake", "fake_2", issuedAt)
+	assert.ErrorIs(t, err, ErrRefundPayment)
+	assert.Len(t, invoice.Payments(), 1)
+}
+
+func TestInvoice_Credit(t *testing.T) {
+	invoice := newInvoice()
+
+	note, err := invoice.Credit(bolivianos(27000), "moved abroad", issuedAt.AddDate(0, 0, 5))
+
+	assert.NoError(t, err)
+	assert.Equal(t, invoice.Id(), note.InvoiceId())
+	assert.Equal(t, bolivianos(27000), invoice.Credited())
+	assert.Equal(t, bolivianos(18000), invoice.Balance())
+	assert.Equal(t, Unpaid, invoice.Status())
+	assert.ErrorIs(t, invoice.Check(Full, bolivianos(45000)), ErrFullPayment)
+	assert.NoError(t, invoice.Check(Full, bolivianos(18000)))
+
+	_, err = invoice.Credit(bolivianos(18001), "too much", issuedAt)
+	assert.ErrorIs(t, err, ErrCreditNote)
+	_, err = invoice.Credit(bolivianos(0), "nothing", issuedAt)
+	assert.ErrorIs(t, err, ErrAmountCreditNote)
+
+	_, err = invoice.Credit(bolivianos(18000), "the rest", issuedAt)
+	assert.NoError(t, err)
+	assert.Len(t, invoice.CreditNotes(), 2)
+	assert.Equal(t, Credited, invoice.Status())
+}
+
+func TestInvoice_Credit_Paid(t *testing.T) {
+	invoice := newInvoice()
+	_, err := invoice.Record(Full, bolivianos(45000), "fake", "fake_1", issuedAt)
+	assert.NoError(t, err)
+
+	_, err = invoice.Credit(bolivianos(27000), "moved abroad", issuedAt.AddDate(0, 0, 5))
+
+	assert.NoError(t, err)
+	assert.Equal(t, bolivianos(-27000), invoice.Balance())
+	assert.Equal(t, Paid, invoice.Status())
+
+	_, err = invoice.Record(Refund, bolivianos(27000), "fake", "fake_2", issuedAt.AddDate(0, 0, 6))
+	assert.NoError(t, err)
+	assert.Equal(t, bolivianos(0), invoice.Balance())
+}
+
+func TestInvoice_IsPaid_Free(t *testing.T) {
+	invoice := NewInvoice("NC", 1, uuid.New(), uuid.New(), bolivianos(0), issuedAt)
+
+	assert.True(t, invoice.IsPaid())
+	assert.Equal(t, Paid, invoice.Status())
+}
+
+func TestInvoiceStatus_String(t *testing.T) {
+	assert.Equal(t, "unpaid", Unpaid.String())
+	assert.Equal(t, "partially paid", PartiallyPaid.String())
+	assert.Equal(t, "paid", Paid.String())
+	assert.Equal(t, "refunded", Refunded.String())
+	assert.Equal(t, "credited", Credited.String())
+	assert.Equal(t, "unknown", InvoiceStatus("X").String())
+}
+
+func TestNewInvoiceFromDb(t *testing.T) {
+	id, contractId, patientId := uuid.New(), uuid.New(), uuid.New()
+	payment := NewPayment(id, Partial, bolivianos(10000), "fake", "fake_1", issuedAt)
+
+	invoice, err := NewInvoiceFromDb(id, "NC", 7, contractId, patientId, 45000, "BOB", issuedAt, []*Payment{payment}, nil, issuedAt, issuedAt)
+
+	assert.NoError(t, err)
+	assert.Equal(t, id, invoice.Id())
+	assert.Equal(t, "NC-000007", invoice.Number())
+	assert.Equal(t, bolivianos(35000), invoice.Balance())
+	assert.Equal(t, issuedAt, invoice.CreatedAt())
+	assert.Equal(t, issuedAt, invoice.UpdatedAt())
+
+	_, err = NewInvoiceFromDb(id, "NC", 7, contractId, patientId, 45000, "EUR", issuedAt, nil, nil, issuedAt, issuedAt)
+	assert.ErrorIs(t, err, valueobjects.ErrCurrencyMoney)
+}
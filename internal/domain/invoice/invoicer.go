@@ -0,0 +1,97 @@
+package invoices
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/contract"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	"github.com/google/uuid"
+	"time"
+)
+
+type Invoicer struct {
+	repository  InvoiceRepository
+	factory     InvoiceFactory
+	series      string
+	requirePaid bool
+	fee         CancellationFee
+}
+
+func NewInvoicer(r InvoiceRepository, f InvoiceFactory, series string, requirePaid bool, fee CancellationFee) *Invoicer {
+	return &Invoicer{
+		repository:  r,
+		factory:     f,
+		series:      series,
+		requirePaid: requirePaid,
+		fee:         fee,
+	}
+}
+
+func (i *Invoicer) Issue(ctx context.Context, contractId, patientId uuid.UUID, amount valueobjects.Money, at time.Time) (*Invoice, error) {
+	sequence, err := i.repository.NextSequence(ctx, i.series)
+	if err != nil {
+		return nil, err
+	}
+
+	invoice, err := i.factory.Create(i.series, sequence, contractId, patientId, amount, at)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.repository.Create(ctx, invoice)
+}
+
+func (i *Invoicer) CheckPaid(ctx context.Context, contractId uuid.UUID) error {
+	if !i.requirePaid {
+		return nil
+	}
+
+	invoice, err := i.repository.GetByContract(ctx, contractId)
+	if errors.Is(err, ErrNotFoundInvoice) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	if !invoice.IsPaid() {
+		return fmt.Errorf("%w: %s owes %s", ErrUnpaidInvoice, invoice.Number(), invoice.Balance())
+	}
+	return nil
+}
+
+func (i *Invoicer) QuoteRefund(contract *contracts.Contract, planDeliveries int) (CancellationRefund, error) {
+	return ProrateRefund(contract, planDeliveries, i.fee)
+}
+
+func (i *Invoicer) Credit(ctx context.Context, refund CancellationRefund, reason string, at time.Time) (*CreditNote, error) {
+	if !refund.Total.IsPositive() {
+		return nil, nil
+	}
+
+	invoice, err := i.repository.GetByContract(ctx, refund.ContractId)
+	if errors.Is(err, ErrNotFoundInvoice) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	note, err := invoice.Credit(refund.Total, reason, at)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.repository.AddCreditNote(ctx, note)
+}
+
+func (i *Invoicer) Series() string {
+	return i.series
+}
+
+func (i *Invoicer) RequiresPayment() bool {
+	return i.requirePaid
+}
+
+func (i *Invoicer) Fee() CancellationFee {
+	return i.fee
+}
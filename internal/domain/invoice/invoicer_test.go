@@ -0,0 +1,128 @@
+package invoices
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+type fakeRepository struct {
+	InvoiceRepository
+	sequences map[string]int
+	invoices  map[uuid.UUID]*Invoice
+	err       error
+}
+
+func newFakeRepository() *fakeRepository {
+	return &fakeRepository{sequences: map[string]int{}, invoices: map[uuid.UUID]*Invoice{}}
+}
+
+func (r *fakeRepository) NextSequence(_ context.Context, series string) (int, error) {
+	if r.err != nil {
+		return 0, r.err
+	}
+	r.sequences[series]++
+	return r.sequences[series], nil
+}
+
+func (r *fakeRepository) Create(_ context.Context, invoice *Invoice) (*Invoice, error) {
+	r.invoices[invoice.ContractId()] = invoice
+	return invoice, nil
+}
+
+func (r *fakeRepository) AddCreditNote(_ context.Context, note *CreditNote) (*CreditNote, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+	return note, nil
+}
+
+func (r *fakeRepository) GetByContract(_ context.Context, contractId uuid.UUID) (*Invoice, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+	if invoice, ok := r.invoices[contractId]; ok {
+		return invoice, nil
+	}
+	return nil, ErrNotFoundInvoice
+}
+
+func TestInvoicer_Issue(t *testing.T) {
+	repo := newFakeRepository()
+	invoicer := NewInvoicer(repo, NewInvoiceFactory(), "NC", false, CancellationFee{})
+
+	first, err := invoicer.Issue(context.Background(), uuid.New(), uuid.New(), bolivianos(45000), issuedAt)
+	assert.NoError(t, err)
+	second, err := invoicer.Issue(context.Background(), uuid.New(), uuid.New(), bolivianos(90000), issuedAt)
+	assert.NoError(t, err)
+
+	assert.Equal(t, "NC-000001", first.Number())
+	assert.Equal(t, "NC-000002", second.Number())
+	assert.Equal(t, "NC", invoicer.Series())
+
+	repo.err = errors.New("db failure")
+	_, err = invoicer.Issue(context.Background(), uuid.New(), uuid.New(), bolivianos(45000), issuedAt)
+	assert.ErrorIs(t, err, repo.err)
+
+	_, err = NewInvoicer(newFakeRepository(), NewInvoiceFactory(), "nc", false, CancellationFee{}).Issue(context.Background(), uuid.New(), uuid.New(), bolivianos(45000), issuedAt)
+	assert.ErrorIs(t, err, ErrSeriesInvoice)
+}
+
+func TestInvoicer_CheckPaid(t *testing.T) {
+	repo := newFakeRepository()
+	invoicer := NewInvoicer(repo, NewInvoiceFactory(), "NC", true, CancellationFee{})
+	contractId := uuid.New()
+
+	invoice, err := invoicer.Issue(context.Background(), contractId, uuid.New(), bolivianos(45000), issuedAt)
+	assert.NoError(t, err)
+
+	assert.True(t, invoicer.RequiresPayment())
+	assert.ErrorIs(t, invoicer.CheckPaid(context.Background(), contractId), ErrUnpaidInvoice)
+	assert.NoError(t, invoicer.CheckPaid(context.Background(), uuid.New()))
+
+	_, err = invoice.Record(Full, bolivianos(45000), "fake", "fake_1", issuedAt)
+	assert.NoError(t, err)
+	assert.NoError(t, invoicer.CheckPaid(context.Background(), contractId))
+
+	optional := NewInvoicer(newFakeRepository(), NewInvoiceFactory(), "NC", false, CancellationFee{})
+	assert.False(t, optional.RequiresPayment())
+	assert.NoError(t, optional.CheckPaid(context.Background(), contractId))
+
+	repo.err = errors.New("db failure")
+	assert.ErrorIs(t, invoicer.CheckPaid(context.Background(), contractId), repo.err)
+}
+
+func TestInvoicer_Credit(t *testing.T) {
+	repo := newFakeRepository()
+	fee, err := NewCancellationFee(1000, 0)
+	assert.NoError(t, err)
+	invoicer := NewInvoicer(repo, NewInvoiceFactory(), "NC", false, fee)
+	contract := newRunningContract(t, 5, 45000)
+
+	invoice, err := invoicer.Issue(context.Background(), contract.Id(), contract.PatientId(), contract.CostValue(), issuedAt)
+	assert.NoError(t, err)
+
+	refund, err := invoicer.QuoteRefund(contract, 15)
+	assert.NoError(t, err)
+	assert.Equal(t, fee, invoicer.Fee())
+	assert.Equal(t, bolivianos(27000), refund.Total)
+
+	note, err := invoicer.Credit(context.Background(), refund, "moved abroad", issuedAt)
+	assert.NoError(t, err)
+	assert.Equal(t, bolivianos(27000), note.Amount())
+	assert.Equal(t, bolivianos(18000), invoice.Balance())
+
+	note, err = invoicer.Credit(context.Background(), CancellationRefund{ContractId: uuid.New(), Total: bolivianos(1000)}, "not invoiced", issuedAt)
+	assert.NoError(t, err)
+	assert.Nil(t, note)
+
+	note, err = invoicer.Credit(context.Background(), CancellationRefund{ContractId: contract.Id(), Total: bolivianos(0)}, "nothing", issuedAt)
+	assert.NoError(t, err)
+	assert.Nil(t, note)
+
+	repo.err = errors.New("db failure")
+	_, err = invoicer.Credit(context.Background(), refund, "moved abroad", issuedAt)
+	assert.ErrorIs(t, err, repo.err)
+}
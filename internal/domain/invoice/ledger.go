@@ -0,0 +1,102 @@
+package invoices
+
+import (
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	"github.com/google/uuid"
+	"sort"
+	"time"
+)
+
+type EntryKind string
+
+const (
+	InvoiceEntry EntryKind = "invoice"
+	PaymentEntry EntryKind = "payment"
+	RefundEntry  EntryKind = "refund"
+	CreditEntry  EntryKind = "credit"
+)
+
+type LedgerEntry struct {
+	Date       time.Time
+	Kind       EntryKind
+	InvoiceId  uuid.UUID
+	Number     string
+	ContractId uuid.UUID
+	Reference  string
+	Amount     valueobjects.Money
+	Balance    valueobjects.Money
+}
+
+func Ledger(invoices []*Invoice) []LedgerEntry {
+	var entries []LedgerEntry
+	for _, inv := range invoices {
+		entries = append(entries, LedgerEntry{
+			Date:       inv.issuedAt,
+			Kind:       InvoiceEntry,
+			InvoiceId:  inv.Id(),
+			Number:     inv.Number(),
+			ContractId: inv.contractId,
+			Amount:     inv.amount,
+		})
+
+		for _, p := range inv.payments {
+			entry := LedgerEntry{
+				Date:       p.paidAt,
+				Kind:       PaymentEntry,
+				InvoiceId:  inv.Id(),
+				Number:     inv.Number(),
+				ContractId: inv.contractId,
+				Reference:  p.reference,
+				Amount:     p.amount.Negate(),
+			}
+			if p.kind == Refund {
+				entry.Kind, entry.Amount = RefundEntry, p.amount
+			}
+			entries = append(entries, entry)
+		}
+
+		for _, c := range inv.credits {
+			entries = append(entries, LedgerEntry{
+				Date:       c.issuedAt,
+				Kind:       CreditEntry,
+				InvoiceId:  inv.Id(),
+				Number:     inv.Number(),
+				ContractId: inv.contractId,
+				Reference:  c.reason,
+				Amount:     c.amount.Negate(),
+			})
+		}
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].Date.Before(entries[j].Date)
+	})
+
+	balances := make(map[valueobjects.Currency]valueobjects.Money)
+	for i := range entries {
+		currency := entries[i].Amount.Currency()
+		balance, ok := balances[currency]
+		if !ok {
+			balance, _ = valueobjects.NewMoney(0, currency)
+		}
+		balance, _ = balance.Add(entries[i].Amount)
+		balances[currency], entries[i].Balance = balance, balance
+	}
+
+	return entries
+}
+
+func Balances(entries []LedgerEntry) []valueobjects.Money {
+	var balances []valueobjects.Money
+	index := make(map[valueobjects.Currency]int)
+	for _, e := range entries {
+		currency := e.Balance.Currency()
+		if i, ok := index[currency]; ok {
+			balances[i] = e.Balance
+			continue
+		}
+		index[currency] = len(balances)
+		balances = append(balances, e.Balance)
+	}
+	return balances
+}
@@ -0,0 +1,61 @@
+package invoices
+
+import (
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestLedger(t *testing.T) {
+	patientId := uuid.New()
+	first := NewInvoice("NC", 1, uuid.New(), patientId, bolivianos(45000), issuedAt)
+	second := NewInvoice("NC", 2, uuid.New(), patientId, bolivianos(90000), issuedAt.AddDate(0, 0, 15))
+	dollars, _ := valueobjects.NewMoney(5000, valueobjects.USD)
+	third := NewInvoice("NC", 3, uuid.New(), patientId, dollars, issuedAt.AddDate(0, 0, 20))
+
+	_, err := first.Record(Full, bolivianos(45000), "fake", "fake_1", issuedAt.AddDate(0, 0, 1))
+	assert.NoError(t, err)
+	_, err = first.Record(Refund, bolivianos(5000), "fake", "fake_2", issuedAt.AddDate(0, 0, 16))
+	assert.NoError(t, err)
+	_, err = second.Record(Partial, bolivianos(30000), "fake", "fake_3", issuedAt.AddDate(0, 0, 15).Add(time.Hour))
+	assert.NoError(t, err)
+
+	entries := Ledger([]*Invoice{second, first, third})
+
+	assert.Len(t, entries, 6)
+	assert.Equal(t, []EntryKind{InvoiceEntry, PaymentEntry, InvoiceEntry, PaymentEntry, RefundEntry, InvoiceEntry},
+		[]EntryKind{entries[0].Kind, entries[1].Kind, entries[2].Kind, entries[3].Kind, entries[4].Kind, entries[5].Kind})
+	assert.Equal(t, "NC-000001", entries[0].Number)
+	assert.Equal(t, bolivianos(45000), entries[0].Balance)
+	assert.Equal(t, bolivianos(-45000), entries[1].Amount)
+	assert.Equal(t, "fake_1", entries[1].Reference)
+	assert.Equal(t, bolivianos(0), entries[1].Balance)
+	assert.Equal(t, bolivianos(90000), entries[2].Balance)
+	assert.Equal(t, bolivianos(60000), entries[3].Balance)
+	assert.Equal(t, bolivianos(5000), entries[4].Amount)
+	assert.Equal(t, bolivianos(65000), entries[4].Balance)
+	assert.Equal(t, dollars, entries[5].Balance)
+	assert.Equal(t, second.ContractId(), entries[2].ContractId)
+
+	assert.Equal(t, []valueobjects.Money{bolivianos(65000), dollars}, Balances(entries))
+	assert.Empty(t, Ledger(nil))
+	assert.Empty(t, Balances(nil))
+}
+
+func TestLedger_CreditNote(t *testing.T) {
+	invoice := newInvoice()
+	_, err := invoice.Record(Full, bolivianos(45000), "fake", "fake_1", issuedAt.AddDate(0, 0, 1))
+	assert.NoError(t, err)
+	_, err = invoice.Credit(bolivianos(27000), "moved abroad", issuedAt.AddDate(0, 0, 5))
+	assert.NoError(t, err)
+
+	entries := Ledger([]*Invoice{invoice})
+
+	assert.Len(t, entries, 3)
+	assert.Equal(t, CreditEntry, entries[2].Kind)
+	assert.Equal(t, "moved abroad", entries[2].Reference)
+	assert.Equal(t, bolivianos(-27000), entries[2].Amount)
+	assert.Equal(t, bolivianos(-27000), entries[2].Balance)
+}
@@ -0,0 +1,126 @@
+package invoices
+
+import (
+	"errors"
+	"fmt"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/abstractions"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	"github.com/google/uuid"
+	"strings"
+	"time"
+)
+
+type PaymentKind string
+
+const (
+	Full    PaymentKind = "F"
+	Partial PaymentKind = "P"
+	Refund  PaymentKind = "R"
+)
+
+var ErrKindPayment = errors.New("payment kind is not valid")
+
+var paymentKinds = map[string]PaymentKind{"F": Full, "FULL": Full, "P": Partial, "PARTIAL": Partial, "R": Refund, "REFUND": Refund}
+
+func ParsePaymentKind(s string) (PaymentKind, error) {
+	if kind, ok := paymentKinds[strings.ToUpper(strings.TrimSpace(s))]; ok {
+		return kind, nil
+	}
+	return "", fmt.Errorf("%w: got %s", ErrKindPayment, s)
+}
+
+func (k PaymentKind) String() string {
+	switch k {
+	case Full:
+		return "full"
+	case Partial:
+		return "partial"
+	case Refund:
+		return "refund"
+	default:
+		return "unknown"
+	}
+}
+
+type Payment struct {
+	*abstractions.Entity
+	invoiceId uuid.UUID
+	kind      PaymentKind
+	amount    valueobjects.Money
+	provider  string
+	reference string
+	paidAt    time.Time
+	createdAt time.Time
+}
+
+func (p *Payment) Id() uuid.UUID {
+	return p.Entity.Id
+}
+
+func (p *Payment) InvoiceId() uuid.UUID {
+	return p.invoiceId
+}
+
+func (p *Payment) Kind() PaymentKind {
+	return p.kind
+}
+
+func (p *Payment) Amount() valueobjects.Money {
+	return p.amount
+}
+
+func (p *Payment) Provider() string {
+	return p.provider
+}
+
+func (p *Payment) Reference() string {
+	return p.reference
+}
+
+func (p *Payment) PaidAt() time.Time {
+	return p.paidAt
+}
+
+func (p *Payment) CreatedAt() time.Time {
+	return p.createdAt
+}
+
+func NewPayment(invoiceId uuid.UUID, kind PaymentKind, amount valueobjects.Money, provider, reference string, paidAt time.Time) *Payment {
+	return &Payment{
+		Entity:    abstractions.NewEntity(uuid.New()),
+		invoiceId: invoiceId,
+		kind:      kind,
+		amount:    amount,
+		provider:  provider,
+		reference: reference,
+		paidAt:    paidAt,
+	}
+}
+
+func NewPaymentFromDb(id, invoiceId uuid.UUID, kind string, amount int, currency, provider, reference string, paidAt, cAt time.Time) (*Payment, error) {
+	paymentKind, err := ParsePaymentKind(kind)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := valueobjects.ParseCurrency(currency)
+	if err != nil {
+		return nil, err
+	}
+
+	money, err := valueobjects.NewMoney(amount, c)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Payment{
+		Entity:    abstractions.NewEntity(id),
+		invoiceId: invoiceId,
+		kind:      paymentKind,
+		amount:    money,
+		provider:  provider,
+		reference: reference,
+		paidAt:    paidAt,
+		createdAt: cAt,
+	}, nil
+}
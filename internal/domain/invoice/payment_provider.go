@@ -0,0 +1,12 @@
+package invoices
+
+import (
+	"context"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+)
+
+type PaymentProvider interface {
+	Name() string
+	Charge(ctx context.Context, invoice *Invoice, amount valueobjects.Money, idempotencyKey string) (string, error)
+	Refund(ctx context.Context, invoice *Invoice, amount valueobjects.Money, idempotencyKey string) (string, error)
+}
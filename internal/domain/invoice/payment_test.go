@@ -0,0 +1,65 @@
+package invoices
+
+import (
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestParsePaymentKind(t *testing.T) {
+	cases := []struct {
+		input    string
+		expected PaymentKind
+	}{
+		{"F", Full},
+		{"full", Full},
+		{" p ", Partial},
+		{"PARTIAL", Partial},
+		{"refund", Refund},
+	}
+
+	for _, tc := range cases {
+		kind, err := ParsePaymentKind(tc.input)
+		assert.NoError(t, err)
+		assert.Equal(t, tc.expected, kind)
+	}
+
+	kind, err := ParsePaymentKind("gift")
+	assert.ErrorIs(t, err, ErrKindPayment)
+	assert.Empty(t, kind)
+
+	assert.Equal(t, "full", Full.String())
+	assert.Equal(t, "partial", Partial.String())
+	assert.Equal(t, "refund", Refund.String())
+	assert.Equal(t, "unknown", PaymentKind("X").String())
+}
+
+func TestNewPaymentFromDb(t *testing.T) {
+	id, invoiceId := uuid.New(), uuid.New()
+	paidAt := time.Date(2025, 10, 2, 9, 30, 0, 0, time.UTC)
+
+	p, err := NewPaymentFromDb(id, invoiceId, "P", 20000, "BOB", "fake", "fake_1", paidAt, paidAt)
+
+	assert.NoError(t, err)
+	assert.Equal(t, id, p.Id())
+	assert.Equal(t, invoiceId, p.InvoiceId())
+	assert.Equal(t, Partial, p.Kind())
+	assert.Equal(t, bolivianos(20000), p.Amount())
+	assert.Equal(t, "fake", p.Provider())
+	assert.Equal(t, "fake_1", p.Reference())
+	assert.Equal(t, paidAt, p.PaidAt())
+	assert.Equal(t, paidAt, p.CreatedAt())
+
+	_, err = NewPaymentFromDb(id, invoiceId, "X", 20000, "BOB", "fake", "fake_1", paidAt, paidAt)
+	assert.ErrorIs(t, err, ErrKindPayment)
+
+	_, err = NewPaymentFromDb(id, invoiceId, "P", 20000, "EUR", "fake", "fake_1", paidAt, paidAt)
+	assert.ErrorIs(t, err, valueobjects.ErrCurrencyMoney)
+}
+
+func bolivianos(amount int) valueobjects.Money {
+	m, _ := valueobjects.NewMoney(amount, valueobjects.BOB)
+	return m
+}
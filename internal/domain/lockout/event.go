@@ -0,0 +1,109 @@
+package lockouts
+
+import (
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/abstractions"
+	"github.com/google/uuid"
+	"time"
+)
+
+type Event struct {
+	*abstractions.Entity
+	scope       Scope
+	key         string
+	eventType   EventType
+	failures    int
+	lockedUntil *time.Time
+	actorId     *uuid.UUID
+	createdAt   time.Time
+}
+
+type EventFilter struct {
+	Scope *Scope
+	Key   string
+	Type  *EventType
+	From  *time.Time
+	To    *time.Time
+}
+
+func NewLockedEvent(t *Throttle) *Event {
+	return &Event{
+		Entity:      abstractions.NewEntity(uuid.New()),
+		scope:       t.scope,
+		key:         t.key,
+		eventType:   Locked,
+		failures:    t.failures,
+		lockedUntil: t.lockedUntil,
+		createdAt:   time.Now(),
+	}
+}
+
+func NewUnlockedEvent(t *Throttle, actorId *uuid.UUID) *Event {
+	return &Event{
+		Entity:    abstractions.NewEntity(uuid.New()),
+		scope:     t.scope,
+		key:       t.key,
+		eventType: Unlocked,
+		failures:  t.failures,
+		actorId:   actorId,
+		createdAt: time.Now(),
+	}
+}
+
+func NewEventFromDB(id uuid.UUID, scope, key, eventType string, failures int, lockedUntil *time.Time, actorId *uuid.UUID, createdAt time.Time) (*Event, error) {
+	s, err := ParseScope(scope)
+	if err != nil {
+		return nil, err
+	}
+
+	et, err := ParseEventType(eventType)
+	if err != nil {
+		return nil, err
+	}
+
+	if key == "" {
+		return nil, ErrEmptyKeyLockout
+	}
+
+	return &Event{
+		Entity:      abstractions.NewEntity(id),
+		scope:       s,
+		key:         key,
+		eventType:   et,
+		failures:    failures,
+		lockedUntil: lockedUntil,
+		actorId:     actorId,
+		createdAt:   createdAt,
+	}, nil
+}
+
+func (e *Event) Id() uuid.UUID {
+	return e.Entity.Id
+}
+
+func (e *Event) Scope() Scope {
+	return e.scope
+}
+
+func (e *Event) Key() string {
+	return e.key
+}
+
+func (e *Event) Type() EventType {
+	return e.eventType
+}
+
+func (e *Event) Failures() int {
+	return e.failures
+}
+
+func (e *Event) LockedUntil() *time.Time {
+	return e.lockedUntil
+}
+
+func (e *Event) ActorId() *uuid.UUID {
+	return e.actorId
+}
+
+func (e *Event) CreatedAt() time.Time {
+	return e.createdAt
+}
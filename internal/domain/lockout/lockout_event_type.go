@@ -0,0 +1,32 @@
+package lockouts
+
+import "fmt"
+
+type EventType string
+
+const (
+	Locked   EventType = "L" // Locked
+	Unlocked EventType = "U" // Unlocked
+)
+
+func (t EventType) String() string {
+	switch t {
+	case Locked:
+		return "locked"
+	case Unlocked:
+		return "unlocked"
+	default:
+		return "unknown"
+	}
+}
+
+func ParseEventType(s string) (EventType, error) {
+	switch s {
+	case "locked", "L":
+		return Locked, nil
+	case "unlocked", "U":
+		return Unlocked, nil
+	default:
+		return "", fmt.Errorf("%w: got %s", ErrNotAnEventTypeLockout, s)
+	}
+}
@@ -0,0 +1,15 @@
+package lockouts
+
+import (
+	"context"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/abstractions"
+)
+
+type LockoutRepository interface {
+	GetThrottle(ctx context.Context, scope Scope, key string) (*Throttle, error)
+	GetEvents(ctx context.Context, filter EventFilter, pagination abstractions.Pagination) (*abstractions.Page[*Event], error)
+
+	SaveThrottle(ctx context.Context, throttle *Throttle) error
+	DeleteThrottle(ctx context.Context, scope Scope, key string) error
+	CreateEvent(ctx context.Context, event *Event) error
+}
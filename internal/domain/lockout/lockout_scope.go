@@ -0,0 +1,32 @@
+package lockouts
+
+import "fmt"
+
+type Scope string
+
+const (
+	Account Scope = "A" // Account
+	Address Scope = "I" // IP Address
+)
+
+func (s Scope) String() string {
+	switch s {
+	case Account:
+		return "account"
+	case Address:
+		return "address"
+	default:
+		return "unknown"
+	}
+}
+
+func ParseScope(s string) (Scope, error) {
+	switch s {
+	case "account", "A":
+		return Account, nil
+	case "address", "I":
+		return Address, nil
+	default:
+		return "", fmt.Errorf("%w: got %s", ErrNotAScopeLockout, s)
+	}
+}
@@ -0,0 +1,37 @@
+package lockouts
+
+import "time"
+
+type Policy struct {
+	Threshold int
+	Base      time.Duration
+	Max       time.Duration
+	Window    time.Duration
+}
+
+var (
+	AccountPolicy = Policy{Threshold: 5, Base: 30 * time.Second, Max: time.Hour, Window: 24 * time.Hour}
+	AddressPolicy = Policy{Threshold: 20, Base: time.Minute, Max: time.Hour, Window: 24 * time.Hour}
+)
+
+func PolicyFor(s Scope) Policy {
+	if s == Address {
+		return AddressPolicy
+	}
+	return AccountPolicy
+}
+
+func (p Policy) Backoff(failures int) time.Duration {
+	if failures < p.Threshold {
+		return 0
+	}
+
+	d := p.Base
+	for i := p.Threshold; i < failures; i++ {
+		d *= 2
+		if d >= p.Max {
+			return p.Max
+		}
+	}
+	return min(d, p.Max)
+}
@@ -0,0 +1,103 @@
+package lockouts
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	ErrEmptyKeyLockout       = errors.New("key cannot be empty")
+	ErrNotAScopeLockout      = errors.New("not a lockout scope")
+	ErrNotAnEventTypeLockout = errors.New("not a lockout event type")
+	ErrLockedOut             = errors.New("too many failed attempts, try again later")
+	ErrNotLockedLockout      = errors.New("key is not locked")
+	ErrDateRangeLockout      = errors.New("from date is after to date")
+)
+
+type Throttle struct {
+	scope         Scope
+	key           string
+	failures      int
+	lockedUntil   *time.Time
+	lastFailureAt *time.Time
+}
+
+func NewThrottle(scope Scope, key string) *Throttle {
+	return &Throttle{
+		scope: scope,
+		key:   key,
+	}
+}
+
+func NewThrottleFromDB(scope, key string, failures int, lockedUntil, lastFailureAt *time.Time) (*Throttle, error) {
+	s, err := ParseScope(scope)
+	if err != nil {
+		return nil, err
+	}
+
+	if key == "" {
+		return nil, ErrEmptyKeyLockout
+	}
+
+	return &Throttle{
+		scope:         s,
+		key:           key,
+		failures:      failures,
+		lockedUntil:   lockedUntil,
+		lastFailureAt: lastFailureAt,
+	}, nil
+}
+
+func (t *Throttle) Scope() Scope {
+	return t.scope
+}
+
+func (t *Throttle) Key() string {
+	return t.key
+}
+
+func (t *Throttle) Failures() int {
+	return t.failures
+}
+
+func (t *Throttle) LockedUntil() *time.Time {
+	return t.lockedUntil
+}
+
+func (t *Throttle) LastFailureAt() *time.Time {
+	return t.lastFailureAt
+}
+
+func (t *Throttle) RetryAfter(now time.Time) time.Duration {
+	if t.lockedUntil == nil || !now.Before(*t.lockedUntil) {
+		return 0
+	}
+	return t.lockedUntil.Sub(now)
+}
+
+func (t *Throttle) Locked(now time.Time) bool {
+	return t.RetryAfter(now) > 0
+}
+
+func (t *Throttle) WasLocked() bool {
+	return t.lockedUntil != nil
+}
+
+func (t *Throttle) Fail(now time.Time, p Policy) bool {
+	if t.lastFailureAt != nil && now.Sub(*t.lastFailureAt) > p.Window {
+		t.failures = 0
+		t.lockedUntil = nil
+	}
+
+	t.failures++
+	t.lastFailureAt = &now
+
+	backoff := p.Backoff(t.failures)
+	if backoff == 0 {
+		return false
+	}
+
+	until := now.Add(backoff)
+	t.lockedUntil = &until
+	return true
+}
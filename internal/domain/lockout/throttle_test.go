@@ -0,0 +1,158 @@
+package lockouts
+
+import (
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestPolicy_Backoff(t *testing.T) {
+	p := Policy{Threshold: 3, Base: time.Minute, Max: 10 * time.Minute}
+
+	cases := []struct {
+		failures int
+		want     time.Duration
+	}{
+		{1, 0},
+		{2, 0},
+		{3, time.Minute},
+		{4, 2 * time.Minute},
+		{5, 4 * time.Minute},
+		{6, 8 * time.Minute},
+		{7, 10 * time.Minute},
+		{50, 10 * time.Minute},
+	}
+
+	for _, tc := range cases {
+		assert.Equal(t, tc.want, p.Backoff(tc.failures), "failures=%d", tc.failures)
+	}
+}
+
+func TestPolicyFor(t *testing.T) {
+	assert.Equal(t, AccountPolicy, PolicyFor(Account))
+	assert.Equal(t, AddressPolicy, PolicyFor(Address))
+}
+
+func TestThrottle_Fail(t *testing.T) {
+	p := Policy{Threshold: 2, Base: time.Minute, Max: time.Hour, Window: time.Hour}
+	now := time.Now()
+	th := NewThrottle(Account, "A:jane@doe.com")
+
+	assert.False(t, th.Fail(now, p))
+	assert.False(t, th.Locked(now))
+	assert.False(t, th.WasLocked())
+
+	assert.True(t, th.Fail(now, p))
+	assert.True(t, th.Locked(now))
+	assert.Equal(t, time.Minute, th.RetryAfter(now))
+
+	later := now.Add(2 * time.Minute)
+	assert.False(t, th.Locked(later))
+	assert.True(t, th.WasLocked())
+
+	assert.True(t, th.Fail(later, p))
+	assert.Equal(t, 3, th.Failures())
+	assert.Equal(t, 2*time.Minute, th.RetryAfter(later))
+}
+
+func TestThrottle_Fail_WindowExpired(t *testing.T) {
+	p := Policy{Threshold: 2, Base: time.Minute, Max: time.Hour, Window: time.Hour}
+	now := time.Now()
+	th := NewThrottle(Address, "10.0.0.1")
+
+	th.Fail(now, p)
+	th.Fail(now, p)
+
+	next := now.Add(2 * time.Hour)
+	assert.False(t, th.Fail(next, p))
+	assert.Equal(t, 1, th.Failures())
+	assert.Nil(t, th.LockedUntil())
+	assert.Equal(t, next, *th.LastFailureAt())
+}
+
+func TestNewThrottleFromDB(t *testing.T) {
+	until := time.Now().Add(time.Minute)
+
+	th, err := NewThrottleFromDB("I", "10.0.0.1", 21, &until, nil)
+
+	assert.NoError(t, err)
+	assert.Equal(t, Address, th.Scope())
+	assert.Equal(t, "10.0.0.1", th.Key())
+	assert.Equal(t, 21, th.Failures())
+	assert.True(t, th.Locked(time.Now()))
+
+	_, err = NewThrottleFromDB("X", "10.0.0.1", 0, nil, nil)
+	assert.ErrorIs(t, err, ErrNotAScopeLockout)
+
+	_, err = NewThrottleFromDB("A", "", 0, nil, nil)
+	assert.ErrorIs(t, err, ErrEmptyKeyLockout)
+}
+
+func TestEvents(t *testing.T) {
+	now := time.Now()
+	th := NewThrottle(Account, "P:john@doe.com")
+	th.Fail(now, Policy{Threshold: 1, Base: time.Minute, Max: time.Hour, Window: time.Hour})
+
+	locked := NewLockedEvent(th)
+	assert.Equal(t, Locked, locked.Type())
+	assert.Equal(t, Account, locked.Scope())
+	assert.Equal(t, "P:john@doe.com", locked.Key())
+	assert.Equal(t, 1, locked.Failures())
+	assert.Equal(t, th.LockedUntil(), locked.LockedUntil())
+	assert.Nil(t, locked.ActorId())
+
+	actor := uuid.New()
+	unlocked := NewUnlockedEvent(th, &actor)
+	assert.Equal(t, Unlocked, unlocked.Type())
+	assert.Nil(t, unlocked.LockedUntil())
+	assert.Equal(t, &actor, unlocked.ActorId())
+	assert.NotEqual(t, locked.Id(), unlocked.Id())
+}
+
+func TestNewEventFromDB(t *testing.T) {
+	id := uuid.New()
+	now := time.Now()
+
+	e, err := NewEventFromDB(id, "A", "A:jane@doe.com", "U", 5, nil, nil, now)
+
+	assert.NoError(t, err)
+	assert.Equal(t, id, e.Id())
+	assert.Equal(t, Unlocked, e.Type())
+	assert.Equal(t, now, e.CreatedAt())
+
+	cases := []struct {
+		name, scope, key, eventType string
+		err                         error
+	}{
+		{"Unknown scope", "X", "key", "L", ErrNotAScopeLockout},
+		{"Unknown type", "A", "key", "X", ErrNotAnEventTypeLockout},
+		{"Empty key", "A", "", "L", ErrEmptyKeyLockout},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			e, err := NewEventFromDB(id, tc.scope, tc.key, tc.eventType, 0, nil, nil, now)
+
+			assert.Nil(t, e)
+			assert.ErrorIs(t, err, tc.err)
+		})
+	}
+}
+
+func TestScopeAndEventType(t *testing.T) {
+	assert.Equal(t, "account", Account.String())
+	assert.Equal(t, "address", Address.String())
+	assert.Equal(t, "unknown", Scope("X").String())
+	assert.Equal(t, "locked", Locked.String())
+	assert.Equal(t, "unlocked", Unlocked.String())
+	assert.Equal(t, "unknown", EventType("X").String())
+
+	s, err := ParseScope("address")
+	assert.NoError(t, err)
+	assert.Equal(t, Address, s)
+
+	et, err := ParseEventType("locked")
+	assert.NoError(t, err)
+	assert.Equal(t, Locked, et)
+}
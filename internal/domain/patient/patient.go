@@ -36,6 +36,11 @@ var (
 	ErrInvalidCredentialsPatient = errors.New("invalid credentials")
 )
 
+func (p *Patient) ChangePassword(password vo.Password) {
+	p.password = password
+	p.updatedAt = time.Now()
+}
+
 func NewPatient(firstName, lastName string, email vo.Email, password vo.Password, gender vo.Gender, birth vo.BirthDate, phone *vo.Phone) *Patient {
 	return &Patient{
 		AggregateRoot: abstractions.NewAggregateRoot(uuid.New()),
@@ -0,0 +1,27 @@
+package patients
+
+import (
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/abstractions"
+	"github.com/google/uuid"
+)
+
+const (
+	PatientRegistered = "patient.registered"
+	PatientDeleted    = "patient.deleted"
+)
+
+type PatientEvent struct {
+	PatientId uuid.UUID `json:"patientId"`
+	Email     string    `json:"email"`
+}
+
+func (p *Patient) raise(name string) {
+	p.AddDomainEvent(*abstractions.NewNamedDomainEvent(name, p.Id(), PatientEvent{
+		PatientId: p.Id(),
+		Email:     p.email.Value(),
+	}))
+}
+
+func (p *Patient) Deleted() {
+	p.raise(PatientDeleted)
+}
@@ -46,7 +46,9 @@ func (patientFactory) Create(firstName, lastName string, email vo.Email, passwor
 	}
 
 	log.Printf("[factory:patient][SUCCESS] patient created")
-	return NewPatient(firstName, lastName, email, password, gender, birth, phone), nil
+	patient := NewPatient(firstName, lastName, email, password, gender, birth, phone)
+	patient.raise(PatientRegistered)
+	return patient, nil
 }
 
 func NewPatientFactory() PatientFactory {
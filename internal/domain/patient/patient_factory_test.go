@@ -76,6 +76,8 @@ func TestPatientFactory_Create(t *testing.T) {
 
 			assert.NotNil(t, patient)
 			assert.NotNil(t, patient.Id())
+			assert.Len(t, patient.DomainEvents(), 1)
+			assert.Equal(t, PatientRegistered, patient.DomainEvents()[0].Name())
 			assert.NotNil(t, patient.LastLoginAt())
 			assert.NotNil(t, patient.CreatedAt())
 			assert.NotNil(t, patient.UpdatedAt())
@@ -2,12 +2,20 @@ package patients
 
 import (
 	"context"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/abstractions"
+	vo "github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
 	"github.com/google/uuid"
 )
 
+type PatientFilter struct {
+	Name    string
+	Gender  *vo.Gender
+	Deleted *bool
+}
+
 type PatientRepository interface {
-	GetAll(ctx context.Context) ([]*Patient, error)
-	GetList(ctx context.Context) ([]*Patient, error)
+	GetAll(ctx context.Context, filter PatientFilter, pagination abstractions.Pagination) (*abstractions.Page[*Patient], error)
+	GetList(ctx context.Context, filter PatientFilter, pagination abstractions.Pagination) (*abstractions.Page[*Patient], error)
 	GetById(ctx context.Context, id uuid.UUID) (*Patient, error)
 	GetByEmail(ctx context.Context, email string) (*Patient, error)
 
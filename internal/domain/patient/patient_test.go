@@ -112,3 +112,15 @@ func TestNewAdministratorFromDB_Invalid(t *testing.T) {
 	assert.ErrorIs(t, err, valueobjects.ErrNotNumericPhoneNumber)
 	assert.Nil(t, admin)
 }
+
+func TestPatient_Deleted(t *testing.T) {
+	patient, err := NewPatientFromDB(uuid.New(), "Jane", "Doe", "jane@doe.com", "$2a$10$7EqJtq98hPqEX7fNZaFWoOhi5BWX4Z1Z5p5p5p5p5p5p5p5p5p5p5", "female", time.Now().AddDate(-25, 0, 0), nil, time.Now(), time.Now(), time.Now(), nil)
+	assert.NoError(t, err)
+	assert.Empty(t, patient.DomainEvents())
+
+	patient.Deleted()
+
+	assert.Len(t, patient.DomainEvents(), 1)
+	assert.Equal(t, PatientDeleted, patient.DomainEvents()[0].Name())
+	assert.Equal(t, PatientEvent{PatientId: patient.Id(), Email: "jane@doe.com"}, patient.DomainEvents()[0].Payload())
+}
@@ -0,0 +1,50 @@
+package plans
+
+import "fmt"
+
+type DeliveryPattern string
+
+const (
+	Daily         DeliveryPattern = "D" // Every day
+	EveryOtherDay DeliveryPattern = "E" // Every second day
+	Weekly        DeliveryPattern = "W" // Once a week
+)
+
+func (p DeliveryPattern) String() string {
+	switch p {
+	case Daily:
+		return "daily"
+	case EveryOtherDay:
+		return "every-other-day"
+	case Weekly:
+		return "weekly"
+	default:
+		return "unknown"
+	}
+}
+
+func (p DeliveryPattern) Interval() int {
+	switch p {
+	case Daily:
+		return 1
+	case EveryOtherDay:
+		return 2
+	case Weekly:
+		return 7
+	default:
+		return 0
+	}
+}
+
+func ParseDeliveryPattern(s string) (DeliveryPattern, error) {
+	switch s {
+	case "daily", "D":
+		return Daily, nil
+	case "every-other-day", "E":
+		return EveryOtherDay, nil
+	case "weekly", "W":
+		return Weekly, nil
+	default:
+		return "", fmt.Errorf("%w: got %s", ErrPatternPlan, s)
+	}
+}
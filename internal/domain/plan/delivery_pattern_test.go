@@ -0,0 +1,40 @@
+package plans
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestDeliveryPattern(t *testing.T) {
+	cases := []struct {
+		input    string
+		expected DeliveryPattern
+		name     string
+		interval int
+	}{
+		{"daily", Daily, "daily", 1},
+		{"D", Daily, "daily", 1},
+		{"every-other-day", EveryOtherDay, "every-other-day", 2},
+		{"E", EveryOtherDay, "every-other-day", 2},
+		{"weekly", Weekly, "weekly", 7},
+		{"W", Weekly, "weekly", 7},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.input, func(t *testing.T) {
+			pattern, err := ParseDeliveryPattern(tc.input)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, pattern)
+			assert.Equal(t, tc.name, pattern.String())
+			assert.Equal(t, tc.interval, pattern.Interval())
+		})
+	}
+
+	pattern, err := ParseDeliveryPattern("X")
+
+	assert.ErrorIs(t, err, ErrPatternPlan)
+	assert.Equal(t, DeliveryPattern(""), pattern)
+	assert.Equal(t, "unknown", pattern.String())
+	assert.Zero(t, pattern.Interval())
+}
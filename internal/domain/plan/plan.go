@@ -0,0 +1,182 @@
+package plans
+
+import (
+	"errors"
+	"fmt"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/abstractions"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	"github.com/google/uuid"
+	"regexp"
+	"time"
+)
+
+const MaxDurationDays = 365
+
+type Plan struct {
+	*abstractions.AggregateRoot
+	code         string
+	name         string
+	durationDays int
+	pattern      DeliveryPattern
+	basePrice    int
+	active       bool
+	createdAt    time.Time
+	updatedAt    time.Time
+	deletedAt    *time.Time
+}
+
+var (
+	ErrCodePlan      = errors.New("plan code is not valid")
+	ErrEmptyNamePlan = errors.New("plan name is empty")
+	ErrLongNamePlan  = errors.New("plan name cannot be longer than 100 characters")
+	ErrDurationPlan  = errors.New("plan duration is not valid")
+	ErrPatternPlan   = errors.New("delivery pattern is not valid")
+	ErrBasePricePlan = errors.New("base price is negative")
+	ErrExistPlan     = errors.New("plan already exist")
+	ErrNotFoundPlan  = errors.New("plan not found")
+	ErrInactivePlan  = errors.New("plan is not active")
+)
+
+var codePattern = regexp.MustCompile(`^[A-Z][A-Z0-9_]{0,19}$`)
+
+func IsValidCode(code string) bool {
+	return codePattern.MatchString(code)
+}
+
+func (p *Plan) Update(name string, durationDays int, pattern DeliveryPattern, basePrice int) error {
+	if err := validateTerms(name, durationDays, pattern, basePrice); err != nil {
+		return err
+	}
+
+	p.name = name
+	p.durationDays = durationDays
+	p.pattern = pattern
+	p.basePrice = basePrice
+	return nil
+}
+
+func (p *Plan) Activate() {
+	p.active = true
+}
+
+func (p *Plan) Deactivate() {
+	p.active = false
+}
+
+func (p *Plan) DeliveryDates(start time.Time) []time.Time {
+	var dates []time.Time
+	for offset := 0; offset < p.durationDays; offset += p.pattern.Interval() {
+		dates = append(dates, start.AddDate(0, 0, offset))
+	}
+	return dates
+}
+
+func (p *Plan) DeliveryCount() int {
+	interval := p.pattern.Interval()
+	return (p.durationDays + interval - 1) / interval
+}
+
+func (p *Plan) EndDate(start time.Time) time.Time {
+	return start.AddDate(0, 0, p.durationDays-1)
+}
+
+func (p *Plan) Id() uuid.UUID {
+	return p.Entity.Id
+}
+
+func (p *Plan) Code() string {
+	return p.code
+}
+
+func (p *Plan) Name() string {
+	return p.name
+}
+
+func (p *Plan) DurationDays() int {
+	return p.durationDays
+}
+
+func (p *Plan) Pattern() DeliveryPattern {
+	return p.pattern
+}
+
+func (p *Plan) BasePrice() int {
+	return p.basePrice
+}
+
+func (p *Plan) Price() valueobjects.Money {
+	price, _ := valueobjects.NewMoney(p.basePrice, valueobjects.DefaultCurrency)
+	return price
+}
+
+func (p *Plan) Active() bool {
+	return p.active
+}
+
+func (p *Plan) CreatedAt() time.Time {
+	return p.createdAt
+}
+
+func (p *Plan) UpdatedAt() time.Time {
+	return p.updatedAt
+}
+
+func (p *Plan) DeletedAt() *time.Time {
+	return p.deletedAt
+}
+
+func validateTerms(name string, durationDays int, pattern DeliveryPattern, basePrice int) error {
+	if name == "" {
+		return ErrEmptyNamePlan
+	}
+
+	if len(name) > 100 {
+		return fmt.Errorf("%w: got %s, size %d", ErrLongNamePlan, name, len(name))
+	}
+
+	if durationDays <= 0 || durationDays > MaxDurationDays {
+		return fmt.Errorf("%w: got %d, it must be between 1 and %d days", ErrDurationPlan, durationDays, MaxDurationDays)
+	}
+
+	if pattern.Interval() == 0 {
+		return fmt.Errorf("%w: got %s", ErrPatternPlan, pattern)
+	}
+
+	if basePrice < 0 {
+		return fmt.Errorf("%w: got %d", ErrBasePricePlan, basePrice)
+	}
+
+	return nil
+}
+
+func NewPlan(code, name string, durationDays int, pattern DeliveryPattern, basePrice int) *Plan {
+	return &Plan{
+		AggregateRoot: abstractions.NewAggregateRoot(uuid.New()),
+		code:          code,
+		name:          name,
+		durationDays:  durationDays,
+		pattern:       pattern,
+		basePrice:     basePrice,
+		active:        true,
+	}
+}
+
+func NewPlanFromDb(id uuid.UUID, code, name string, durationDays int, pattern string, basePrice int, active bool, cAt, uAt time.Time, dAt *time.Time) (*Plan, error) {
+	deliveryPattern, err := ParseDeliveryPattern(pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Plan{
+		AggregateRoot: abstractions.NewAggregateRoot(id),
+		code:          code,
+		name:          name,
+		durationDays:  durationDays,
+		pattern:       deliveryPattern,
+		basePrice:     basePrice,
+		active:        active,
+		createdAt:     cAt,
+		updatedAt:     uAt,
+		deletedAt:     dAt,
+	}, nil
+}
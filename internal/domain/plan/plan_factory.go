@@ -0,0 +1,31 @@
+package plans
+
+import (
+	"fmt"
+	"log"
+)
+
+type PlanFactory interface {
+	Create(code, name string, durationDays int, pattern DeliveryPattern, basePrice int) (*Plan, error)
+}
+
+type planFactory struct{}
+
+func (planFactory) Create(code, name string, durationDays int, pattern DeliveryPattern, basePrice int) (*Plan, error) {
+	if !IsValidCode(code) {
+		log.Printf("[factory:plan] code '%s' is not valid", code)
+		return nil, fmt.Errorf("%w: got %s", ErrCodePlan, code)
+	}
+
+	if err := validateTerms(name, durationDays, pattern, basePrice); err != nil {
+		log.Printf("[factory:plan] plan '%s' has invalid terms: %v", code, err)
+		return nil, err
+	}
+
+	log.Printf("[factory:plan] plan '%s' created", code)
+	return NewPlan(code, name, durationDays, pattern, basePrice), nil
+}
+
+func NewPlanFactory() PlanFactory {
+	return &planFactory{}
+}
@@ -0,0 +1,47 @@
+package plans
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestPlanFactory_Create(t *testing.T) {
+	factory := NewPlanFactory()
+
+	plan, err := factory.Create("SIXTY_DAYS", "Sixty days", 60, Daily, 5500)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, plan.Id())
+	assert.Equal(t, "SIXTY_DAYS", plan.Code())
+	assert.Equal(t, "Sixty days", plan.Name())
+	assert.Equal(t, 60, plan.DurationDays())
+	assert.Equal(t, Daily, plan.Pattern())
+	assert.Equal(t, 5500, plan.BasePrice())
+	assert.True(t, plan.Active())
+}
+
+func TestPlanFactory_Create_Errors(t *testing.T) {
+	factory := NewPlanFactory()
+
+	cases := []struct {
+		name, code, planName string
+		duration, price      int
+		pattern              DeliveryPattern
+		err                  error
+	}{
+		{"code", "trial", "Trial", 3, 0, Daily, ErrCodePlan},
+		{"name", "TRIAL", "", 3, 0, Daily, ErrEmptyNamePlan},
+		{"duration", "TRIAL", "Trial", 0, 0, Daily, ErrDurationPlan},
+		{"pattern", "TRIAL", "Trial", 3, 0, DeliveryPattern(""), ErrPatternPlan},
+		{"price", "TRIAL", "Trial", 3, -5, Daily, ErrBasePricePlan},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			plan, err := factory.Create(tc.code, tc.planName, tc.duration, tc.pattern, tc.price)
+
+			assert.Nil(t, plan)
+			assert.ErrorIs(t, err, tc.err)
+		})
+	}
+}
@@ -0,0 +1,17 @@
+package plans
+
+import (
+	"context"
+	"github.com/google/uuid"
+)
+
+type PlanRepository interface {
+	GetAll(ctx context.Context, includeInactive bool) ([]*Plan, error)
+	GetById(ctx context.Context, id uuid.UUID) (*Plan, error)
+	GetByCode(ctx context.Context, code string) (*Plan, error)
+
+	ExistByCode(ctx context.Context, code string) (bool, error)
+
+	Create(ctx context.Context, plan *Plan) (*Plan, error)
+	Update(ctx context.Context, plan *Plan) (*Plan, error)
+}
@@ -0,0 +1,104 @@
+package plans
+
+import (
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestPlan_Calendar(t *testing.T) {
+	start := time.Date(2025, 3, 3, 12, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		name     string
+		duration int
+		pattern  DeliveryPattern
+		count    int
+		last     time.Time
+	}{
+		{"trial", 3, Daily, 3, start.AddDate(0, 0, 2)},
+		{"half-month", 15, Daily, 15, start.AddDate(0, 0, 14)},
+		{"sixty days", 60, Daily, 60, start.AddDate(0, 0, 59)},
+		{"every other day", 15, EveryOtherDay, 8, start.AddDate(0, 0, 14)},
+		{"weekly", 30, Weekly, 5, start.AddDate(0, 0, 28)},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			plan := NewPlan("PLAN", tc.name, tc.duration, tc.pattern, 100)
+			dates := plan.DeliveryDates(start)
+
+			assert.Len(t, dates, tc.count)
+			assert.Equal(t, tc.count, plan.DeliveryCount())
+			assert.Equal(t, start, dates[0])
+			assert.Equal(t, tc.last, dates[len(dates)-1])
+			assert.Equal(t, start.AddDate(0, 0, tc.duration-1), plan.EndDate(start))
+		})
+	}
+}
+
+func TestPlan_Update(t *testing.T) {
+	plan := NewPlan("WEEKLY", "Weekly", 7, Daily, 350)
+
+	assert.NoError(t, plan.Update("Weekly lunch", 7, EveryOtherDay, 200))
+	assert.Equal(t, "WEEKLY", plan.Code())
+	assert.Equal(t, "Weekly lunch", plan.Name())
+	assert.Equal(t, EveryOtherDay, plan.Pattern())
+	assert.Equal(t, 200, plan.BasePrice())
+	assert.Equal(t, 200, plan.Price().Amount())
+	assert.Equal(t, valueobjects.DefaultCurrency, plan.Price().Currency())
+
+	assert.ErrorIs(t, plan.Update("", 7, Daily, 200), ErrEmptyNamePlan)
+	assert.ErrorIs(t, plan.Update(string(make([]byte, 101)), 7, Daily, 200), ErrLongNamePlan)
+	assert.ErrorIs(t, plan.Update("Weekly", 0, Daily, 200), ErrDurationPlan)
+	assert.ErrorIs(t, plan.Update("Weekly", MaxDurationDays+1, Daily, 200), ErrDurationPlan)
+	assert.ErrorIs(t, plan.Update("Weekly", 7, DeliveryPattern("X"), 200), ErrPatternPlan)
+	assert.ErrorIs(t, plan.Update("Weekly", 7, Daily, -1), ErrBasePricePlan)
+	assert.Equal(t, "Weekly lunch", plan.Name())
+}
+
+func TestPlan_Activation(t *testing.T) {
+	plan := NewPlan("TRIAL", "Trial", 3, Daily, 0)
+	assert.True(t, plan.Active())
+
+	plan.Deactivate()
+	assert.False(t, plan.Active())
+
+	plan.Activate()
+	assert.True(t, plan.Active())
+}
+
+func TestIsValidCode(t *testing.T) {
+	for _, code := range []string{"M", "H", "WEEKLY", "TRIAL_3D", "DAYS60"} {
+		assert.True(t, IsValidCode(code), code)
+	}
+
+	for _, code := range []string{"", "weekly", "3DAYS", "TRIAL-3D", "A23456789012345678901"} {
+		assert.False(t, IsValidCode(code), code)
+	}
+}
+
+func TestNewPlanFromDb(t *testing.T) {
+	id, now := uuid.New(), time.Now()
+
+	plan, err := NewPlanFromDb(id, "M", "Monthly", 30, "D", 3000, false, now, now, nil)
+
+	assert.NoError(t, err)
+	assert.Equal(t, id, plan.Id())
+	assert.Equal(t, "M", plan.Code())
+	assert.Equal(t, "Monthly", plan.Name())
+	assert.Equal(t, 30, plan.DurationDays())
+	assert.Equal(t, Daily, plan.Pattern())
+	assert.Equal(t, 3000, plan.BasePrice())
+	assert.False(t, plan.Active())
+	assert.Equal(t, now, plan.CreatedAt())
+	assert.Equal(t, now, plan.UpdatedAt())
+	assert.Nil(t, plan.DeletedAt())
+
+	plan, err = NewPlanFromDb(id, "M", "Monthly", 30, "X", 3000, true, now, now, nil)
+
+	assert.Nil(t, plan)
+	assert.ErrorIs(t, err, ErrPatternPlan)
+}
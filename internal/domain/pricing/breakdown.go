@@ -0,0 +1,134 @@
+package pricing
+
+import (
+	"errors"
+	"fmt"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/plan"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	"time"
+)
+
+var (
+	ErrDeliveriesPricing = errors.New("number of deliveries is not a positive number")
+	ErrTotalPricing      = errors.New("price breakdown does not add up")
+)
+
+type Breakdown struct {
+	base       valueobjects.Money
+	deliveries int
+	zone       string
+	surcharge  valueobjects.Money
+	promoCode  string
+	discount   valueobjects.Money
+	taxes      []TaxLine
+	total      valueobjects.Money
+}
+
+func Price(plan *plans.Plan, deliveries int, zone *Zone, promo *PromoCode, taxes []TaxRate, at time.Time) (*Breakdown, error) {
+	if deliveries <= 0 {
+		return nil, fmt.Errorf("%w: got %d", ErrDeliveriesPricing, deliveries)
+	}
+
+	// The plan price covers its own number of deliveries, a calendar with
+	// more or fewer of them pays in proportion.
+	price := plan.Price()
+	b := &Breakdown{base: price, deliveries: deliveries}
+	if count := plan.DeliveryCount(); count > 0 && count != deliveries {
+		b.base, _ = valueobjects.NewMoney((price.Amount()*deliveries+count/2)/count, price.Currency())
+	}
+
+	b.surcharge, _ = valueobjects.NewMoney(0, price.Currency())
+	if zone != nil && !zone.Surcharge().IsZero() {
+		b.zone = zone.Name()
+		b.surcharge = zone.Surcharge().Multiply(deliveries)
+	}
+
+	subtotal, err := b.base.Add(b.surcharge)
+	if err != nil {
+		return nil, fmt.Errorf("%w: zone %s", err, b.zone)
+	}
+
+	b.discount, _ = valueobjects.NewMoney(0, price.Currency())
+	if promo != nil {
+		if err = promo.Check(at); err != nil {
+			return nil, err
+		}
+
+		if b.discount, err = promo.Discount(subtotal); err != nil {
+			return nil, err
+		}
+		b.promoCode = promo.Code()
+	}
+
+	taxable, _ := subtotal.Sub(b.discount)
+	b.total = taxable
+	for _, t := range taxes {
+		line := TaxLine{TaxRate: t, amount: t.Of(taxable)}
+		b.taxes = append(b.taxes, line)
+		b.total, _ = b.total.Add(line.amount)
+	}
+
+	return b, nil
+}
+
+func (b *Breakdown) Base() valueobjects.Money {
+	return b.base
+}
+
+func (b *Breakdown) Deliveries() int {
+	return b.deliveries
+}
+
+func (b *Breakdown) Zone() string {
+	return b.zone
+}
+
+func (b *Breakdown) Surcharge() valueobjects.Money {
+	return b.surcharge
+}
+
+func (b *Breakdown) Subtotal() valueobjects.Money {
+	subtotal, _ := b.base.Add(b.surcharge)
+	return subtotal
+}
+
+func (b *Breakdown) PromoCode() string {
+	return b.promoCode
+}
+
+func (b *Breakdown) Discount() valueobjects.Money {
+	return b.discount
+}
+
+func (b *Breakdown) Taxes() []TaxLine {
+	return b.taxes
+}
+
+func (b *Breakdown) Total() valueobjects.Money {
+	return b.total
+}
+
+func NewBreakdownFromDb(currency string, base, deliveries int, zone string, surcharge int, promoCode string, discount int, taxes []TaxLine, total int) (*Breakdown, error) {
+	c, err := valueobjects.ParseCurrency(currency)
+	if err != nil {
+		return nil, err
+	}
+
+	b := &Breakdown{deliveries: deliveries, zone: zone, promoCode: promoCode, taxes: taxes}
+	b.base, _ = valueobjects.NewMoney(base, c)
+	b.surcharge, _ = valueobjects.NewMoney(surcharge, c)
+	b.discount, _ = valueobjects.NewMoney(discount, c)
+	b.total, _ = valueobjects.NewMoney(total, c)
+
+	sum, _ := b.Subtotal().Sub(b.discount)
+	for _, t := range taxes {
+		if sum, err = sum.Add(t.amount); err != nil {
+			return nil, err
+		}
+	}
+
+	if sum != b.total {
+		return nil, fmt.Errorf("%w: got %s, expected %s", ErrTotalPricing, b.total, sum)
+	}
+	return b, nil
+}
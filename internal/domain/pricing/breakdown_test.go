@@ -0,0 +1,103 @@
+package pricing
+
+import (
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/plan"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+var halfMonthPlan = plans.NewPlan("H", "Half month", 15, plans.Daily, 45000)
+
+func TestPrice(t *testing.T) {
+	at := time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC)
+	zone := NewZone("Downtown", coordinates(t, -17.7863, -63.1812), 1000, bolivianos(200))
+	promo := NewPromoCode("WELCOME10", Percentage, 1000, valueobjects.BOB, at, nil, 0)
+	iva, err := NewTaxRate("IVA", 1300)
+	assert.NoError(t, err)
+
+	t.Run("plan price", func(t *testing.T) {
+		b, err := Price(halfMonthPlan, 15, nil, nil, nil, at)
+
+		assert.NoError(t, err)
+		assert.Equal(t, bolivianos(45000), b.Base())
+		assert.Equal(t, 15, b.Deliveries())
+		assert.Empty(t, b.Zone())
+		assert.Equal(t, bolivianos(0), b.Surcharge())
+		assert.Empty(t, b.PromoCode())
+		assert.Equal(t, bolivianos(0), b.Discount())
+		assert.Empty(t, b.Taxes())
+		assert.Equal(t, halfMonthPlan.Price(), b.Total())
+	})
+
+	t.Run("zone, promo code and taxes", func(t *testing.T) {
+		b, err := Price(halfMonthPlan, 15, zone, promo, []TaxRate{iva}, at)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Downtown", b.Zone())
+		assert.Equal(t, bolivianos(3000), b.Surcharge())
+		assert.Equal(t, bolivianos(48000), b.Subtotal())
+		assert.Equal(t, "WELCOME10", b.PromoCode())
+		assert.Equal(t, bolivianos(4800), b.Discount())
+		assert.Len(t, b.Taxes(), 1)
+		assert.Equal(t, "IVA", b.Taxes()[0].Name())
+		assert.Equal(t, bolivianos(5616), b.Taxes()[0].Amount())
+		assert.Equal(t, bolivianos(48816), b.Total())
+	})
+
+	t.Run("zone without surcharge", func(t *testing.T) {
+		free, err := valueobjects.NewMoney(0, valueobjects.USD)
+		assert.NoError(t, err)
+
+		b, err := Price(halfMonthPlan, 15, NewZone("Center", zone.Center(), 1000, free), nil, nil, at)
+
+		assert.NoError(t, err)
+		assert.Empty(t, b.Zone())
+		assert.Equal(t, bolivianos(0), b.Surcharge())
+		assert.Equal(t, halfMonthPlan.Price(), b.Total())
+	})
+
+	t.Run("fewer deliveries than the plan", func(t *testing.T) {
+		b, err := Price(halfMonthPlan, 10, nil, nil, nil, at)
+
+		assert.NoError(t, err)
+		assert.Equal(t, bolivianos(30000), b.Base())
+		assert.Equal(t, bolivianos(30000), b.Total())
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		_, err := Price(halfMonthPlan, 0, nil, nil, nil, at)
+		assert.ErrorIs(t, err, ErrDeliveriesPricing)
+
+		_, err = Price(halfMonthPlan, 15, nil, promo, nil, at.AddDate(0, 0, -1))
+		assert.ErrorIs(t, err, ErrNotStartedPromoCode)
+
+		dollars, err := valueobjects.NewMoney(100, valueobjects.USD)
+		assert.NoError(t, err)
+		_, err = Price(halfMonthPlan, 15, NewZone("Abroad", zone.Center(), 1000, dollars), nil, nil, at)
+		assert.ErrorIs(t, err, valueobjects.ErrCurrencyMismatchMoney)
+	})
+}
+
+func TestNewBreakdownFromDb(t *testing.T) {
+	taxes := []TaxLine{NewTaxLine("IVA", 1300, bolivianos(5616))}
+
+	b, err := NewBreakdownFromDb("BOB", 45000, 15, "Downtown", 3000, "WELCOME10", 4800, taxes, 48816)
+
+	assert.NoError(t, err)
+	assert.Equal(t, bolivianos(45000), b.Base())
+	assert.Equal(t, 15, b.Deliveries())
+	assert.Equal(t, "Downtown", b.Zone())
+	assert.Equal(t, bolivianos(3000), b.Surcharge())
+	assert.Equal(t, "WELCOME10", b.PromoCode())
+	assert.Equal(t, bolivianos(4800), b.Discount())
+	assert.Equal(t, taxes, b.Taxes())
+	assert.Equal(t, bolivianos(48816), b.Total())
+
+	_, err = NewBreakdownFromDb("BOB", 45000, 15, "", 0, "", 0, nil, 40000)
+	assert.ErrorIs(t, err, ErrTotalPricing)
+
+	_, err = NewBreakdownFromDb("EUR", 45000, 15, "", 0, "", 0, nil, 45000)
+	assert.ErrorIs(t, err, valueobjects.ErrCurrencyMoney)
+}
@@ -0,0 +1,57 @@
+package pricing
+
+import (
+	"context"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/plan"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	"time"
+)
+
+type Pricer struct {
+	zones  ZoneRepository
+	promos PromoCodeRepository
+	taxes  []TaxRate
+}
+
+func NewPricer(z ZoneRepository, p PromoCodeRepository, taxes ...TaxRate) *Pricer {
+	return &Pricer{
+		zones:  z,
+		promos: p,
+		taxes:  taxes,
+	}
+}
+
+func (p *Pricer) Quote(ctx context.Context, plan *plans.Plan, deliveries int, at valueobjects.Coordinates, code string, now time.Time) (*Breakdown, error) {
+	zone, err := p.Serve(ctx, at)
+	if err != nil {
+		return nil, err
+	}
+
+	var promo *PromoCode
+	if code = NormalizeCode(code); code != "" {
+		if promo, err = p.promos.GetByCode(ctx, code); err != nil {
+			return nil, err
+		}
+	}
+
+	return Price(plan, deliveries, zone, promo, p.taxes, now)
+}
+
+func (p *Pricer) Serve(ctx context.Context, at valueobjects.Coordinates) (*Zone, error) {
+	zones, err := p.zones.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return Serving(zones, at)
+}
+
+func (p *Pricer) Redeem(ctx context.Context, b *Breakdown) error {
+	if b == nil || b.PromoCode() == "" {
+		return nil
+	}
+	return p.promos.Redeem(ctx, b.PromoCode())
+}
+
+func (p *Pricer) Taxes() []TaxRate {
+	return p.taxes
+}
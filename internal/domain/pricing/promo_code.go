@@ -0,0 +1,211 @@
+package pricing
+
+import (
+	"errors"
+	"fmt"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/abstractions"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	"github.com/google/uuid"
+	"regexp"
+	"strings"
+	"time"
+)
+
+type DiscountKind string
+
+const (
+	Percentage DiscountKind = "P"
+	Fixed      DiscountKind = "F"
+)
+
+type PromoCode struct {
+	*abstractions.AggregateRoot
+	code       string
+	kind       DiscountKind
+	value      int
+	currency   valueobjects.Currency
+	validFrom  time.Time
+	validUntil *time.Time
+	maxUses    int
+	uses       int
+	createdAt  time.Time
+	updatedAt  time.Time
+	deletedAt  *time.Time
+}
+
+var (
+	ErrCodePromoCode       = errors.New("promo code is not valid")
+	ErrKindPromoCode       = errors.New("discount kind is not valid")
+	ErrValuePromoCode      = errors.New("discount value is not valid")
+	ErrValidityPromoCode   = errors.New("promo code ends before it starts")
+	ErrMaxUsesPromoCode    = errors.New("maximum uses is negative")
+	ErrNotStartedPromoCode = errors.New("promo code is not valid yet")
+	ErrExpiredPromoCode    = errors.New("promo code has expired")
+	ErrUsedUpPromoCode     = errors.New("promo code has no uses left")
+	ErrExistPromoCode      = errors.New("promo code already exist")
+	ErrNotFoundPromoCode   = errors.New("promo code not found")
+)
+
+var (
+	promoCodePattern = regexp.MustCompile(`^[A-Z0-9][A-Z0-9_-]{2,29}$`)
+	discountKinds    = map[string]DiscountKind{"P": Percentage, "PERCENTAGE": Percentage, "F": Fixed, "FIXED": Fixed}
+)
+
+func IsValidCode(code string) bool {
+	return promoCodePattern.MatchString(code)
+}
+
+func NormalizeCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+func ParseDiscountKind(s string) (DiscountKind, error) {
+	if kind, ok := discountKinds[strings.ToUpper(strings.TrimSpace(s))]; ok {
+		return kind, nil
+	}
+	return "", fmt.Errorf("%w: got %s", ErrKindPromoCode, s)
+}
+
+func (k DiscountKind) String() string {
+	switch k {
+	case Percentage:
+		return "percentage"
+	case Fixed:
+		return "fixed"
+	default:
+		return "unknown"
+	}
+}
+
+func (p *PromoCode) Check(at time.Time) error {
+	if at.Before(p.validFrom) {
+		return fmt.Errorf("%w: %s starts on %s", ErrNotStartedPromoCode, p.code, p.validFrom.Format(time.DateOnly))
+	}
+
+	if p.validUntil != nil && at.After(*p.validUntil) {
+		return fmt.Errorf("%w: %s ended on %s", ErrExpiredPromoCode, p.code, p.validUntil.Format(time.DateOnly))
+	}
+
+	if p.maxUses > 0 && p.uses >= p.maxUses {
+		return fmt.Errorf("%w: %s was used %d times", ErrUsedUpPromoCode, p.code, p.uses)
+	}
+
+	return nil
+}
+
+func (p *PromoCode) Discount(price valueobjects.Money) (valueobjects.Money, error) {
+	discount := percentOf(price, p.value)
+	if p.kind == Fixed {
+		fixed, err := valueobjects.NewMoney(p.value, p.currency)
+		if err != nil {
+			return valueobjects.Money{}, err
+		}
+		discount = fixed
+	}
+
+	cmp, err := discount.Compare(price)
+	if err != nil {
+		return valueobjects.Money{}, fmt.Errorf("%w: promo code %s", err, p.code)
+	}
+
+	if cmp > 0 {
+		return price, nil
+	}
+	return discount, nil
+}
+
+func (p *PromoCode) Redeem(at time.Time) error {
+	if err := p.Check(at); err != nil {
+		return err
+	}
+	p.uses++
+	return nil
+}
+
+func (p *PromoCode) Id() uuid.UUID {
+	return p.Entity.Id
+}
+
+func (p *PromoCode) Code() string {
+	return p.code
+}
+
+func (p *PromoCode) Kind() DiscountKind {
+	return p.kind
+}
+
+func (p *PromoCode) Value() int {
+	return p.value
+}
+
+func (p *PromoCode) Currency() valueobjects.Currency {
+	return p.currency
+}
+
+func (p *PromoCode) ValidFrom() time.Time {
+	return p.validFrom
+}
+
+func (p *PromoCode) ValidUntil() *time.Time {
+	return p.validUntil
+}
+
+func (p *PromoCode) MaxUses() int {
+	return p.maxUses
+}
+
+func (p *PromoCode) Uses() int {
+	return p.uses
+}
+
+func (p *PromoCode) CreatedAt() time.Time {
+	return p.createdAt
+}
+
+func (p *PromoCode) UpdatedAt() time.Time {
+	return p.updatedAt
+}
+
+func (p *PromoCode) DeletedAt() *time.Time {
+	return p.deletedAt
+}
+
+func NewPromoCode(code string, kind DiscountKind, value int, currency valueobjects.Currency, validFrom time.Time, validUntil *time.Time, maxUses int) *PromoCode {
+	return &PromoCode{
+		AggregateRoot: abstractions.NewAggregateRoot(uuid.New()),
+		code:          code,
+		kind:          kind,
+		value:         value,
+		currency:      currency,
+		validFrom:     validFrom,
+		validUntil:    validUntil,
+		maxUses:       maxUses,
+	}
+}
+
+func NewPromoCodeFromDb(id uuid.UUID, code, kind string, value int, currency string, validFrom time.Time, validUntil *time.Time, maxUses, uses int, cAt, uAt time.Time, dAt *time.Time) (*PromoCode, error) {
+	discountKind, err := ParseDiscountKind(kind)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := valueobjects.ParseCurrency(currency)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PromoCode{
+		AggregateRoot: abstractions.NewAggregateRoot(id),
+		code:          code,
+		kind:          discountKind,
+		value:         value,
+		currency:      c,
+		validFrom:     validFrom,
+		validUntil:    validUntil,
+		maxUses:       maxUses,
+		uses:          uses,
+		createdAt:     cAt,
+		updatedAt:     uAt,
+		deletedAt:     dAt,
+	}, nil
+}
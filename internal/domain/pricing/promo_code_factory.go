@@ -0,0 +1,64 @@
+package pricing
+
+import (
+	"fmt"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	"log"
+	"time"
+)
+
+type PromoCodeFactory interface {
+	Create(code string, kind DiscountKind, value int, currency valueobjects.Currency, validFrom time.Time, validUntil *time.Time, maxUses int) (*PromoCode, error)
+}
+
+type promoCodeFactory struct{}
+
+func (promoCodeFactory) Create(code string, kind DiscountKind, value int, currency valueobjects.Currency, validFrom time.Time, validUntil *time.Time, maxUses int) (*PromoCode, error) {
+	code = NormalizeCode(code)
+	if !IsValidCode(code) {
+		log.Printf("[factory:promo_code] code '%s' is not valid", code)
+		return nil, fmt.Errorf("%w: got %s", ErrCodePromoCode, code)
+	}
+
+	switch kind {
+	case Percentage:
+		if value <= 0 || value > MaxBasisPoints {
+			log.Printf("[factory:promo_code] percentage '%d' is out of range", value)
+			return nil, fmt.Errorf("%w: got %d, it must be between 1 and %d basis points", ErrValuePromoCode, value, MaxBasisPoints)
+		}
+	case Fixed:
+		if value <= 0 {
+			log.Printf("[factory:promo_code] amount '%d' needs to be a positive number", value)
+			return nil, fmt.Errorf("%w: got %d", ErrValuePromoCode, value)
+		}
+	default:
+		log.Printf("[factory:promo_code] kind '%s' is not valid", kind)
+		return nil, fmt.Errorf("%w: got %s", ErrKindPromoCode, kind)
+	}
+
+	if !currency.IsValid() {
+		log.Printf("[factory:promo_code] currency '%s' is not supported", currency)
+		return nil, fmt.Errorf("%w: got %s", valueobjects.ErrCurrencyMoney, currency)
+	}
+
+	if validFrom.IsZero() {
+		validFrom = time.Now()
+	}
+
+	if validUntil != nil && validUntil.Before(validFrom) {
+		log.Printf("[factory:promo_code] valid until '%s' is before valid from '%s'", validUntil, validFrom)
+		return nil, fmt.Errorf("%w: got %s - %s", ErrValidityPromoCode, validFrom.Format(time.DateOnly), validUntil.Format(time.DateOnly))
+	}
+
+	if maxUses < 0 {
+		log.Printf("[factory:promo_code] max uses '%d' is negative", maxUses)
+		return nil, fmt.Errorf("%w: got %d", ErrMaxUsesPromoCode, maxUses)
+	}
+
+	log.Printf("[factory:promo_code] promo code '%s' created", code)
+	return NewPromoCode(code, kind, value, currency, validFrom, validUntil, maxUses), nil
+}
+
+func NewPromoCodeFactory() PromoCodeFactory {
+	return &promoCodeFactory{}
+}
@@ -0,0 +1,64 @@
+package pricing
+
+import (
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestPromoCodeFactory_Create(t *testing.T) {
+	from := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	until := from.AddDate(0, 1, 0)
+
+	promo, err := NewPromoCodeFactory().Create(" welcome10 ", Percentage, 1000, valueobjects.BOB, from, &until, 50)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "WELCOME10", promo.Code())
+	assert.Equal(t, Percentage, promo.Kind())
+	assert.Equal(t, 1000, promo.Value())
+	assert.Equal(t, from, promo.ValidFrom())
+	assert.Equal(t, &until, promo.ValidUntil())
+	assert.Equal(t, 50, promo.MaxUses())
+	assert.Zero(t, promo.Uses())
+
+	promo, err = NewPromoCodeFactory().Create("FIFTY", Fixed, 5000, valueobjects.USD, time.Time{}, nil, 0)
+
+	assert.NoError(t, err)
+	assert.False(t, promo.ValidFrom().IsZero())
+	assert.Nil(t, promo.ValidUntil())
+}
+
+func TestPromoCodeFactory_Create_Errors(t *testing.T) {
+	from := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	before := from.AddDate(0, 0, -1)
+
+	cases := []struct {
+		name     string
+		code     string
+		kind     DiscountKind
+		value    int
+		currency valueobjects.Currency
+		until    *time.Time
+		maxUses  int
+		err      error
+	}{
+		{"invalid code", "A B", Percentage, 1000, valueobjects.BOB, nil, 0, ErrCodePromoCode},
+		{"invalid kind", "CODE", DiscountKind("X"), 1000, valueobjects.BOB, nil, 0, ErrKindPromoCode},
+		{"zero percentage", "CODE", Percentage, 0, valueobjects.BOB, nil, 0, ErrValuePromoCode},
+		{"percentage above a hundred", "CODE", Percentage, MaxBasisPoints + 1, valueobjects.BOB, nil, 0, ErrValuePromoCode},
+		{"negative amount", "CODE", Fixed, -100, valueobjects.BOB, nil, 0, ErrValuePromoCode},
+		{"invalid currency", "CODE", Fixed, 100, valueobjects.Currency("EUR"), nil, 0, valueobjects.ErrCurrencyMoney},
+		{"ends before it starts", "CODE", Fixed, 100, valueobjects.BOB, &before, 0, ErrValidityPromoCode},
+		{"negative max uses", "CODE", Fixed, 100, valueobjects.BOB, nil, -1, ErrMaxUsesPromoCode},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			promo, err := NewPromoCodeFactory().Create(tc.code, tc.kind, tc.value, tc.currency, from, tc.until, tc.maxUses)
+
+			assert.ErrorIs(t, err, tc.err)
+			assert.Nil(t, promo)
+		})
+	}
+}
@@ -0,0 +1,16 @@
+package pricing
+
+import (
+	"context"
+	"github.com/google/uuid"
+)
+
+type PromoCodeRepository interface {
+	GetAll(ctx context.Context) ([]*PromoCode, error)
+	GetByCode(ctx context.Context, code string) (*PromoCode, error)
+	ExistByCode(ctx context.Context, code string) (bool, error)
+
+	Create(ctx context.Context, promo *PromoCode) (*PromoCode, error)
+	Redeem(ctx context.Context, code string) error
+	Delete(ctx context.Context, id uuid.UUID) error
+}
@@ -0,0 +1,132 @@
+package pricing
+
+import (
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestParseDiscountKind(t *testing.T) {
+	cases := []struct {
+		input    string
+		expected DiscountKind
+	}{
+		{"P", Percentage},
+		{"percentage", Percentage},
+		{" f ", Fixed},
+		{"FIXED", Fixed},
+	}
+
+	for _, tc := range cases {
+		kind, err := ParseDiscountKind(tc.input)
+		assert.NoError(t, err)
+		assert.Equal(t, tc.expected, kind)
+	}
+
+	kind, err := ParseDiscountKind("free")
+	assert.ErrorIs(t, err, ErrKindPromoCode)
+	assert.Empty(t, kind)
+
+	assert.Equal(t, "percentage", Percentage.String())
+	assert.Equal(t, "fixed", Fixed.String())
+	assert.Equal(t, "unknown", DiscountKind("X").String())
+}
+
+func TestIsValidCode(t *testing.T) {
+	for _, valid := range []string{"WELCOME10", "SPRING-2025", "VIP_1", "B2B"} {
+		assert.True(t, IsValidCode(valid), valid)
+	}
+
+	for _, invalid := range []string{"", "AB", "welcome", "-WELCOME", "WELCOME 10", "ABCDEFGHIJKLMNOPQRSTUVWXYZ01234"} {
+		assert.False(t, IsValidCode(invalid), invalid)
+	}
+
+	assert.Equal(t, "WELCOME10", NormalizeCode(" welcome10 "))
+}
+
+func TestPromoCode_Check(t *testing.T) {
+	from := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	until := time.Date(2025, 3, 31, 23, 59, 59, 0, time.UTC)
+	promo := NewPromoCode("MARCH", Percentage, 1000, valueobjects.BOB, from, &until, 2)
+
+	assert.ErrorIs(t, promo.Check(from.Add(-time.Second)), ErrNotStartedPromoCode)
+	assert.NoError(t, promo.Check(from))
+	assert.NoError(t, promo.Check(until))
+	assert.ErrorIs(t, promo.Check(until.Add(time.Second)), ErrExpiredPromoCode)
+
+	assert.NoError(t, promo.Redeem(from))
+	assert.NoError(t, promo.Redeem(from))
+	assert.Equal(t, 2, promo.Uses())
+	assert.ErrorIs(t, promo.Check(from), ErrUsedUpPromoCode)
+	assert.ErrorIs(t, promo.Redeem(from), ErrUsedUpPromoCode)
+	assert.Equal(t, 2, promo.Uses())
+
+	unlimited := NewPromoCode("ALWAYS", Percentage, 1000, valueobjects.BOB, from, nil, 0)
+	for i := 0; i < 5; i++ {
+		assert.NoError(t, unlimited.Redeem(until.AddDate(1, 0, 0)))
+	}
+}
+
+func TestPromoCode_Discount(t *testing.T) {
+	from := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		name     string
+		kind     DiscountKind
+		value    int
+		currency valueobjects.Currency
+		price    valueobjects.Money
+		expected valueobjects.Money
+	}{
+		{"percentage", Percentage, 1000, valueobjects.BOB, bolivianos(45000), bolivianos(4500)},
+		{"percentage rounds half up", Percentage, 1250, valueobjects.BOB, bolivianos(1004), bolivianos(126)},
+		{"full percentage", Percentage, MaxBasisPoints, valueobjects.BOB, bolivianos(45000), bolivianos(45000)},
+		{"fixed", Fixed, 5000, valueobjects.BOB, bolivianos(45000), bolivianos(5000)},
+		{"fixed above the price", Fixed, 50000, valueobjects.BOB, bolivianos(45000), bolivianos(45000)},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			promo := NewPromoCode("CODE", tc.kind, tc.value, tc.currency, from, nil, 0)
+
+			discount, err := promo.Discount(tc.price)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, discount)
+		})
+	}
+
+	dollars := NewPromoCode("DOLLARS", Fixed, 1000, valueobjects.USD, from, nil, 0)
+	_, err := dollars.Discount(bolivianos(45000))
+	assert.ErrorIs(t, err, valueobjects.ErrCurrencyMismatchMoney)
+}
+
+func TestNewPromoCodeFromDb(t *testing.T) {
+	id := uuid.New()
+	now := time.Now()
+	until := now.AddDate(0, 1, 0)
+
+	promo, err := NewPromoCodeFromDb(id, "WELCOME10", "P", 1000, "BOB", now, &until, 100, 3, now, now, nil)
+
+	assert.NoError(t, err)
+	assert.Equal(t, id, promo.Id())
+	assert.Equal(t, "WELCOME10", promo.Code())
+	assert.Equal(t, Percentage, promo.Kind())
+	assert.Equal(t, 1000, promo.Value())
+	assert.Equal(t, valueobjects.BOB, promo.Currency())
+	assert.Equal(t, now, promo.ValidFrom())
+	assert.Equal(t, &until, promo.ValidUntil())
+	assert.Equal(t, 100, promo.MaxUses())
+	assert.Equal(t, 3, promo.Uses())
+	assert.Equal(t, now, promo.CreatedAt())
+	assert.Equal(t, now, promo.UpdatedAt())
+	assert.Nil(t, promo.DeletedAt())
+
+	_, err = NewPromoCodeFromDb(id, "WELCOME10", "X", 1000, "BOB", now, nil, 0, 0, now, now, nil)
+	assert.ErrorIs(t, err, ErrKindPromoCode)
+
+	_, err = NewPromoCodeFromDb(id, "WELCOME10", "F", 1000, "EUR", now, nil, 0, 0, now, now, nil)
+	assert.ErrorIs(t, err, valueobjects.ErrCurrencyMoney)
+}
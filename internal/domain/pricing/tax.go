@@ -0,0 +1,92 @@
+package pricing
+
+import (
+	"errors"
+	"fmt"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	"math"
+	"strconv"
+	"strings"
+)
+
+const MaxBasisPoints = 10000
+
+var (
+	ErrNameTax = errors.New("tax name is empty")
+	ErrRateTax = errors.New("tax rate is not valid")
+)
+
+type TaxRate struct {
+	name string
+	rate int
+}
+
+func NewTaxRate(name string, rate int) (TaxRate, error) {
+	if name == "" {
+		return TaxRate{}, ErrNameTax
+	}
+
+	if rate <= 0 || rate > MaxBasisPoints {
+		return TaxRate{}, fmt.Errorf("%w: got %d, it must be between 1 and %d basis points", ErrRateTax, rate, MaxBasisPoints)
+	}
+
+	return TaxRate{name: name, rate: rate}, nil
+}
+
+func ParseTaxRates(s string) ([]TaxRate, error) {
+	var rates []TaxRate
+	for _, entry := range strings.Split(s, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		name, percent, ok := strings.Cut(entry, ":")
+		if !ok {
+			return nil, fmt.Errorf("%w: got %s", ErrRateTax, entry)
+		}
+
+		p, err := strconv.ParseFloat(strings.TrimSpace(percent), 64)
+		if err != nil {
+			return nil, fmt.Errorf("%w: got %s", ErrRateTax, entry)
+		}
+
+		rate, err := NewTaxRate(strings.TrimSpace(name), int(math.Round(p*100)))
+		if err != nil {
+			return nil, err
+		}
+		rates = append(rates, rate)
+	}
+	return rates, nil
+}
+
+func (t TaxRate) Name() string {
+	return t.name
+}
+
+func (t TaxRate) Rate() int {
+	return t.rate
+}
+
+func (t TaxRate) Of(amount valueobjects.Money) valueobjects.Money {
+	return percentOf(amount, t.rate)
+}
+
+type TaxLine struct {
+	TaxRate
+	amount valueobjects.Money
+}
+
+func NewTaxLine(name string, rate int, amount valueobjects.Money) TaxLine {
+	return TaxLine{TaxRate: TaxRate{name: name, rate: rate}, amount: amount}
+}
+
+func (l TaxLine) Amount() valueobjects.Money {
+	return l.amount
+}
+
+func percentOf(amount valueobjects.Money, basisPoints int) valueobjects.Money {
+	part := (amount.Amount()*basisPoints + MaxBasisPoints/2) / MaxBasisPoints
+	money, _ := valueobjects.NewMoney(part, amount.Currency())
+	return money
+}
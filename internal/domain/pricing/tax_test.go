@@ -0,0 +1,69 @@
+package pricing
+
+import (
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestNewTaxRate(t *testing.T) {
+	rate, err := NewTaxRate("IVA", 1300)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "IVA", rate.Name())
+	assert.Equal(t, 1300, rate.Rate())
+
+	_, err = NewTaxRate("", 1300)
+	assert.ErrorIs(t, err, ErrNameTax)
+
+	for _, invalid := range []int{0, -1, MaxBasisPoints + 1} {
+		_, err = NewTaxRate("IVA", invalid)
+		assert.ErrorIs(t, err, ErrRateTax)
+	}
+}
+
+func TestParseTaxRates(t *testing.T) {
+	rates, err := ParseTaxRates(" IVA:13, IT:3.5 ,")
+
+	assert.NoError(t, err)
+	assert.Len(t, rates, 2)
+	assert.Equal(t, "IVA", rates[0].Name())
+	assert.Equal(t, 1300, rates[0].Rate())
+	assert.Equal(t, "IT", rates[1].Name())
+	assert.Equal(t, 350, rates[1].Rate())
+
+	rates, err = ParseTaxRates("")
+	assert.NoError(t, err)
+	assert.Empty(t, rates)
+
+	for _, invalid := range []string{"IVA", "IVA:thirteen", ":13", "IVA:0", "IVA:101"} {
+		rates, err = ParseTaxRates(invalid)
+		assert.Error(t, err, invalid)
+		assert.Nil(t, rates)
+	}
+}
+
+func TestTaxRate_Of(t *testing.T) {
+	rate, err := NewTaxRate("IVA", 1300)
+	assert.NoError(t, err)
+
+	cases := []struct {
+		amount   int
+		expected int
+	}{
+		{10000, 1300},
+		{150, 20},
+		{115, 15},
+		{3, 0},
+		{0, 0},
+	}
+
+	for _, tc := range cases {
+		amount, err := valueobjects.NewMoney(tc.amount, valueobjects.USD)
+		assert.NoError(t, err)
+
+		tax := rate.Of(amount)
+		assert.Equal(t, tc.expected, tax.Amount(), "%d", tc.amount)
+		assert.Equal(t, valueobjects.USD, tax.Currency())
+	}
+}
@@ -0,0 +1,146 @@
+package pricing
+
+import (
+	"errors"
+	"fmt"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/abstractions"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	"github.com/google/uuid"
+	"math"
+	"time"
+)
+
+type Zone struct {
+	*abstractions.AggregateRoot
+	name      string
+	center    valueobjects.Coordinates
+	radius    int
+	boundary  *valueobjects.Polygon
+	surcharge valueobjects.Money
+	createdAt time.Time
+	updatedAt time.Time
+	deletedAt *time.Time
+}
+
+var (
+	ErrEmptyNameZone  = errors.New("zone name is empty")
+	ErrLongNameZone   = errors.New("zone name cannot be longer than 100 characters")
+	ErrRadiusZone     = errors.New("zone radius is not a positive number")
+	ErrSurchargeZone  = errors.New("zone surcharge is a negative number")
+	ErrNotFoundZone   = errors.New("zone not found")
+	ErrNotCoveredZone = errors.New("address is outside the service area")
+)
+
+func (z *Zone) Covers(c valueobjects.Coordinates) bool {
+	if z.boundary != nil {
+		return z.boundary.Contains(c)
+	}
+	return z.center.DistanceTo(c) <= float64(z.radius)
+}
+
+func ZoneFor(zones []*Zone, c valueobjects.Coordinates) *Zone {
+	var found *Zone
+	for _, z := range zones {
+		if z.Covers(c) && (found == nil || z.radius < found.radius) {
+			found = z
+		}
+	}
+	return found
+}
+
+func Serving(zones []*Zone, c valueobjects.Coordinates) (*Zone, error) {
+	if len(zones) == 0 {
+		return nil, nil
+	}
+
+	z := ZoneFor(zones, c)
+	if z == nil {
+		return nil, fmt.Errorf("%w: got %.6f, %.6f", ErrNotCoveredZone, c.Latitude(), c.Longitude())
+	}
+	return z, nil
+}
+
+func (z *Zone) Id() uuid.UUID {
+	return z.Entity.Id
+}
+
+func (z *Zone) Name() string {
+	return z.name
+}
+
+func (z *Zone) Center() valueobjects.Coordinates {
+	return z.center
+}
+
+func (z *Zone) Radius() int {
+	return z.radius
+}
+
+func (z *Zone) Boundary() *valueobjects.Polygon {
+	return z.boundary
+}
+
+func (z *Zone) Surcharge() valueobjects.Money {
+	return z.surcharge
+}
+
+func (z *Zone) CreatedAt() time.Time {
+	return z.createdAt
+}
+
+func (z *Zone) UpdatedAt() time.Time {
+	return z.updatedAt
+}
+
+func (z *Zone) DeletedAt() *time.Time {
+	return z.deletedAt
+}
+
+func NewZone(name string, center valueobjects.Coordinates, radius int, surcharge valueobjects.Money) *Zone {
+	return &Zone{
+		AggregateRoot: abstractions.NewAggregateRoot(uuid.New()),
+		name:          name,
+		center:        center,
+		radius:        radius,
+		surcharge:     surcharge,
+	}
+}
+
+func NewBoundedZone(name string, boundary valueobjects.Polygon, surcharge valueobjects.Money) *Zone {
+	z := NewZone(name, boundary.Center(), int(math.Ceil(boundary.Reach())), surcharge)
+	z.boundary = &boundary
+	return z
+}
+
+func NewZoneFromDb(id uuid.UUID, name string, latitude, longitude float64, radius, surcharge int, currency string, boundary []byte, cAt, uAt time.Time, dAt *time.Time) (*Zone, error) {
+	center, err := valueobjects.NewCoordinates(latitude, longitude)
+	if err != nil {
+		return nil, err
+	}
+
+	var polygon *valueobjects.Polygon
+	if boundary != nil {
+		p, err := valueobjects.ParsePolygon(boundary)
+		if err != nil {
+			return nil, err
+		}
+		polygon = &p
+	}
+
+	money, err := valueobjects.NewMoney(surcharge, valueobjects.Currency(currency))
+	if err != nil {
+		return nil, err
+	}
+
+	return &Zone{
+		AggregateRoot: abstractions.NewAggregateRoot(id),
+		name:          name,
+		center:        center,
+		radius:        radius,
+		boundary:      polygon,
+		surcharge:     money,
+		createdAt:     cAt,
+		updatedAt:     uAt,
+		deletedAt:     dAt,
+	}, nil
+}
@@ -0,0 +1,70 @@
+package pricing
+
+import (
+	"fmt"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	"log"
+)
+
+type ZoneFactory interface {
+	Create(name string, center valueobjects.Coordinates, radius int, surcharge valueobjects.Money) (*Zone, error)
+	CreateBounded(name string, boundary valueobjects.Polygon, surcharge valueobjects.Money) (*Zone, error)
+}
+
+type zoneFactory struct{}
+
+func (f zoneFactory) Create(name string, center valueobjects.Coordinates, radius int, surcharge valueobjects.Money) (*Zone, error) {
+	if err := f.check(name, surcharge); err != nil {
+		return nil, err
+	}
+
+	if radius <= 0 {
+		log.Printf("[factory:zone] radius '%d' needs to be a positive number", radius)
+		return nil, fmt.Errorf("%w: got %d", ErrRadiusZone, radius)
+	}
+
+	log.Printf("[factory:zone] zone '%s' created", name)
+	return NewZone(name, center, radius, surcharge), nil
+}
+
+func (f zoneFactory) CreateBounded(name string, boundary valueobjects.Polygon, surcharge valueobjects.Money) (*Zone, error) {
+	if err := f.check(name, surcharge); err != nil {
+		return nil, err
+	}
+
+	if len(boundary.Rings()) == 0 {
+		log.Printf("[factory:zone] zone '%s' has an empty boundary", name)
+		return nil, valueobjects.ErrEmptyPolygon
+	}
+
+	log.Printf("[factory:zone] zone '%s' created with a boundary", name)
+	return NewBoundedZone(name, boundary, surcharge), nil
+}
+
+func (zoneFactory) check(name string, surcharge valueobjects.Money) error {
+	if name == "" {
+		log.Printf("[factory:zone] name is empty")
+		return ErrEmptyNameZone
+	}
+
+	if len(name) > 100 {
+		log.Printf("[factory:zone] name '%s' is longer than 100 characters", name)
+		return fmt.Errorf("%w: got %s, size %d", ErrLongNameZone, name, len(name))
+	}
+
+	if !surcharge.Currency().IsValid() {
+		log.Printf("[factory:zone] surcharge currency '%s' is not supported", surcharge.Currency())
+		return fmt.Errorf("%w: got %s", valueobjects.ErrCurrencyMoney, surcharge.Currency())
+	}
+
+	if surcharge.IsNegative() {
+		log.Printf("[factory:zone] surcharge '%s' cannot be a negative number", surcharge)
+		return fmt.Errorf("%w: got %s", ErrSurchargeZone, surcharge)
+	}
+
+	return nil
+}
+
+func NewZoneFactory() ZoneFactory {
+	return &zoneFactory{}
+}
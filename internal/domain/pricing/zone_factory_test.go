@@ -0,0 +1,92 @@
+package pricing
+
+import (
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+func TestZoneFactory_Create(t *testing.T) {
+	center := coordinates(t, -17.7863, -63.1812)
+
+	zone, err := NewZoneFactory().Create("Downtown", center, 1000, bolivianos(250))
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Downtown", zone.Name())
+	assert.Equal(t, center, zone.Center())
+	assert.Equal(t, 1000, zone.Radius())
+	assert.Equal(t, bolivianos(250), zone.Surcharge())
+	assert.Nil(t, zone.Boundary())
+
+	zone, err = NewZoneFactory().Create("Downtown", center, 1000, bolivianos(0))
+
+	assert.NoError(t, err)
+	assert.True(t, zone.Surcharge().IsZero())
+}
+
+func TestZoneFactory_CreateBounded(t *testing.T) {
+	boundary := polygon(t, downtownGeoJSON)
+
+	zone, err := NewZoneFactory().CreateBounded("Downtown", boundary, bolivianos(0))
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Downtown", zone.Name())
+	assert.Equal(t, &boundary, zone.Boundary())
+	assert.Equal(t, boundary.Center(), zone.Center())
+	assert.Equal(t, 1536, zone.Radius())
+	assert.True(t, zone.Surcharge().IsZero())
+}
+
+func TestZoneFactory_CreateBounded_Errors(t *testing.T) {
+	boundary := polygon(t, downtownGeoJSON)
+
+	cases := []struct {
+		name      string
+		zone      string
+		boundary  valueobjects.Polygon
+		surcharge valueobjects.Money
+		err       error
+	}{
+		{"empty name", "", boundary, bolivianos(250), ErrEmptyNameZone},
+		{"no boundary", "Downtown", valueobjects.Polygon{}, bolivianos(250), valueobjects.ErrEmptyPolygon},
+		{"no currency", "Downtown", boundary, valueobjects.Money{}, valueobjects.ErrCurrencyMoney},
+		{"negative surcharge", "Downtown", boundary, bolivianos(-100), ErrSurchargeZone},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			zone, err := NewZoneFactory().CreateBounded(tc.zone, tc.boundary, tc.surcharge)
+
+			assert.ErrorIs(t, err, tc.err)
+			assert.Nil(t, zone)
+		})
+	}
+}
+
+func TestZoneFactory_Create_Errors(t *testing.T) {
+	center := coordinates(t, -17.7863, -63.1812)
+
+	cases := []struct {
+		name      string
+		zone      string
+		radius    int
+		surcharge valueobjects.Money
+		err       error
+	}{
+		{"empty name", "", 1000, bolivianos(250), ErrEmptyNameZone},
+		{"long name", strings.Repeat("a", 101), 1000, bolivianos(250), ErrLongNameZone},
+		{"zero radius", "Downtown", 0, bolivianos(250), ErrRadiusZone},
+		{"no currency", "Downtown", 1000, valueobjects.Money{}, valueobjects.ErrCurrencyMoney},
+		{"negative surcharge", "Downtown", 1000, bolivianos(-100), ErrSurchargeZone},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			zone, err := NewZoneFactory().Create(tc.zone, center, tc.radius, tc.surcharge)
+
+			assert.ErrorIs(t, err, tc.err)
+			assert.Nil(t, zone)
+		})
+	}
+}
@@ -0,0 +1,14 @@
+package pricing
+
+import (
+	"context"
+	"github.com/google/uuid"
+)
+
+type ZoneRepository interface {
+	GetAll(ctx context.Context) ([]*Zone, error)
+	GetById(ctx context.Context, id uuid.UUID) (*Zone, error)
+
+	Create(ctx context.Context, zone *Zone) (*Zone, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+}
@@ -0,0 +1,101 @@
+package pricing
+
+import (
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func bolivianos(amount int) valueobjects.Money {
+	money, _ := valueobjects.NewMoney(amount, valueobjects.BOB)
+	return money
+}
+
+func coordinates(t *testing.T, latitude, longitude float64) valueobjects.Coordinates {
+	c, err := valueobjects.NewCoordinates(latitude, longitude)
+	assert.NoError(t, err)
+	return c
+}
+
+const downtownGeoJSON = `{"type":"Polygon","coordinates":[[[-63.19,-17.79],[-63.17,-17.79],[-63.17,-17.77],[-63.19,-17.77],[-63.19,-17.79]]]}`
+
+func polygon(t *testing.T, geoJSON string) valueobjects.Polygon {
+	p, err := valueobjects.ParsePolygon([]byte(geoJSON))
+	assert.NoError(t, err)
+	return p
+}
+
+func TestZone_Covers(t *testing.T) {
+	circle := NewZone("Circle", coordinates(t, -17.78, -63.18), 1000, bolivianos(0))
+	square := NewBoundedZone("Square", polygon(t, downtownGeoJSON), bolivianos(0))
+	corner := coordinates(t, -17.7705, -63.1705)
+
+	assert.True(t, circle.Covers(coordinates(t, -17.78, -63.18)))
+	assert.False(t, circle.Covers(corner))
+	assert.True(t, square.Covers(corner))
+	assert.False(t, square.Covers(coordinates(t, -17.7695, -63.18)))
+}
+
+func TestServing(t *testing.T) {
+	square := NewBoundedZone("Square", polygon(t, downtownGeoJSON), bolivianos(0))
+	inside, outside := coordinates(t, -17.78, -63.18), coordinates(t, -16.50, -68.15)
+
+	zone, err := Serving([]*Zone{square}, inside)
+	assert.NoError(t, err)
+	assert.Equal(t, square, zone)
+
+	zone, err = Serving([]*Zone{square}, outside)
+	assert.ErrorIs(t, err, ErrNotCoveredZone)
+	assert.Nil(t, zone)
+
+	zone, err = Serving(nil, outside)
+	assert.NoError(t, err)
+	assert.Nil(t, zone)
+}
+
+func TestZoneFor(t *testing.T) {
+	center := coordinates(t, -17.7863, -63.1812)
+	city := NewZone("City", center, 10000, bolivianos(500))
+	downtown := NewZone("Downtown", center, 1000, bolivianos(200))
+	zones := []*Zone{city, downtown}
+
+	assert.Equal(t, downtown, ZoneFor(zones, center))
+	assert.Equal(t, city, ZoneFor(zones, coordinates(t, -17.7500, -63.1812)))
+	assert.Nil(t, ZoneFor(zones, coordinates(t, -16.5000, -68.1500)))
+	assert.Nil(t, ZoneFor(nil, center))
+}
+
+func TestNewZoneFromDb(t *testing.T) {
+	id := uuid.New()
+	now := time.Now()
+
+	zone, err := NewZoneFromDb(id, "Downtown", -17.7863, -63.1812, 1000, 250, "USD", nil, now, now, nil)
+
+	assert.NoError(t, err)
+	assert.Equal(t, id, zone.Id())
+	assert.Equal(t, "Downtown", zone.Name())
+	assert.Equal(t, -17.7863, zone.Center().Latitude())
+	assert.Equal(t, -63.1812, zone.Center().Longitude())
+	assert.Equal(t, 1000, zone.Radius())
+	assert.Equal(t, 250, zone.Surcharge().Amount())
+	assert.Equal(t, valueobjects.USD, zone.Surcharge().Currency())
+	assert.Equal(t, now, zone.CreatedAt())
+	assert.Equal(t, now, zone.UpdatedAt())
+	assert.Nil(t, zone.DeletedAt())
+	assert.Nil(t, zone.Boundary())
+
+	zone, err = NewZoneFromDb(id, "Downtown", -17.78, -63.18, 1536, 0, "BOB", []byte(downtownGeoJSON), now, now, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, polygon(t, downtownGeoJSON), *zone.Boundary())
+
+	_, err = NewZoneFromDb(id, "Downtown", -91, -63.1812, 1000, 250, "BOB", nil, now, now, nil)
+	assert.ErrorIs(t, err, valueobjects.ErrOutOfBoundariesLatitude)
+
+	_, err = NewZoneFromDb(id, "Downtown", -17.7863, -63.1812, 1000, 250, "EUR", nil, now, now, nil)
+	assert.ErrorIs(t, err, valueobjects.ErrCurrencyMoney)
+
+	_, err = NewZoneFromDb(id, "Downtown", -17.78, -63.18, 1536, 0, "BOB", []byte(`{"type":"Point"}`), now, now, nil)
+	assert.ErrorIs(t, err, valueobjects.ErrGeoJSONPolygon)
+}
@@ -0,0 +1,50 @@
+package routes
+
+import (
+	"errors"
+	"fmt"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+)
+
+type Fleet struct {
+	drivers  int
+	capacity int
+	depot    valueobjects.Coordinates
+}
+
+var (
+	ErrDriversFleet  = errors.New("fleet driver count is not a positive number")
+	ErrCapacityFleet = errors.New("vehicle capacity is not a positive number")
+)
+
+func NewFleet(drivers, capacity int, depot valueobjects.Coordinates) (Fleet, error) {
+	if drivers <= 0 {
+		return Fleet{}, fmt.Errorf("%w: got %d", ErrDriversFleet, drivers)
+	}
+	if capacity <= 0 {
+		return Fleet{}, fmt.Errorf("%w: got %d", ErrCapacityFleet, capacity)
+	}
+	return Fleet{drivers: drivers, capacity: capacity, depot: depot}, nil
+}
+
+func (f Fleet) Drivers() int {
+	return f.drivers
+}
+
+func (f Fleet) Capacity() int {
+	return f.capacity
+}
+
+func (f Fleet) Depot() valueobjects.Coordinates {
+	return f.depot
+}
+
+func (f Fleet) With(drivers, capacity int) (Fleet, error) {
+	if drivers == 0 {
+		drivers = f.drivers
+	}
+	if capacity == 0 {
+		capacity = f.capacity
+	}
+	return NewFleet(drivers, capacity, f.depot)
+}
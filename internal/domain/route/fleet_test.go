@@ -0,0 +1,66 @@
+package routes
+
+import (
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func coordinates(t *testing.T, latitude, longitude float64) valueobjects.Coordinates {
+	c, err := valueobjects.NewCoordinates(latitude, longitude)
+	assert.NoError(t, err)
+	return c
+}
+
+func TestNewFleet(t *testing.T) {
+	depot := coordinates(t, -17.7863, -63.1812)
+
+	fleet, err := NewFleet(3, 20, depot)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 3, fleet.Drivers())
+	assert.Equal(t, 20, fleet.Capacity())
+	assert.Equal(t, depot, fleet.Depot())
+}
+
+func TestNewFleet_Errors(t *testing.T) {
+	cases := []struct {
+		name     string
+		drivers  int
+		capacity int
+		err      error
+	}{
+		{"no drivers", 0, 20, ErrDriversFleet},
+		{"negative drivers", -1, 20, ErrDriversFleet},
+		{"no capacity", 3, 0, ErrCapacityFleet},
+		{"negative capacity", 3, -5, ErrCapacityFleet},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			fleet, err := NewFleet(tc.drivers, tc.capacity, coordinates(t, -17.7863, -63.1812))
+
+			assert.ErrorIs(t, err, tc.err)
+			assert.Equal(t, Fleet{}, fleet)
+		})
+	}
+}
+
+func TestFleet_With(t *testing.T) {
+	depot := coordinates(t, -17.7863, -63.1812)
+	fleet, err := NewFleet(3, 20, depot)
+	assert.NoError(t, err)
+
+	same, err := fleet.With(0, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, fleet, same)
+
+	other, err := fleet.With(5, 8)
+	assert.NoError(t, err)
+	assert.Equal(t, 5, other.Drivers())
+	assert.Equal(t, 8, other.Capacity())
+	assert.Equal(t, depot, other.Depot())
+
+	_, err = fleet.With(-2, 0)
+	assert.ErrorIs(t, err, ErrDriversFleet)
+}
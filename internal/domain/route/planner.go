@@ -0,0 +1,180 @@
+package routes
+
+import (
+	"cmp"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/delivery"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/pricing"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/slot"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	"github.com/google/uuid"
+	"slices"
+	"time"
+)
+
+func Plan(date time.Time, dlvrs []*deliveries.Delivery, zones []*pricing.Zone, slotList []*slots.Slot, fleet Fleet) []*Manifest {
+	manifests := make([]*Manifest, fleet.Drivers())
+	for i := range manifests {
+		manifests[i] = &Manifest{Driver: i + 1, Date: date, Routes: []*Route{}}
+	}
+
+	var trips []*Route
+	for _, c := range cluster(dlvrs, zones, slotList) {
+		ordered := nearestNeighbour(fleet.Depot(), c.deliveries)
+		for chunk := range slices.Chunk(ordered, fleet.Capacity()) {
+			trips = append(trips, newRoute(c.zone, c.slot, fleet.Depot(), twoOpt(fleet.Depot(), nearestNeighbour(fleet.Depot(), chunk))))
+		}
+	}
+
+	slices.SortStableFunc(trips, func(a, b *Route) int {
+		if len(a.Stops) != len(b.Stops) {
+			return len(b.Stops) - len(a.Stops)
+		}
+		return cmp.Compare(b.Distance, a.Distance)
+	})
+
+	for _, t := range trips {
+		m := slices.MinFunc(manifests, func(a, b *Manifest) int {
+			if a.Stops() != b.Stops() {
+				return a.Stops() - b.Stops()
+			}
+			return cmp.Compare(a.Distance(), b.Distance())
+		})
+		m.Routes = append(m.Routes, t)
+	}
+
+	for _, m := range manifests {
+		slices.SortStableFunc(m.Routes, func(a, b *Route) int { return compareSlots(a.Slot, b.Slot) })
+		for i, r := range m.Routes {
+			r.Trip = i + 1
+		}
+	}
+
+	return manifests
+}
+
+func compareSlots(a, b *slots.Slot) int {
+	if a == nil || b == nil {
+		return cmp.Compare(boolInt(a == nil), boolInt(b == nil))
+	}
+	return cmp.Or(cmp.Compare(a.Start(), b.Start()), cmp.Compare(a.Name(), b.Name()), cmp.Compare(a.Id().String(), b.Id().String()))
+}
+
+func boolInt(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+type zoneCluster struct {
+	zone       string
+	slot       *slots.Slot
+	deliveries []*deliveries.Delivery
+}
+
+type clusterKey struct {
+	zone string
+	slot uuid.UUID
+}
+
+func cluster(dlvrs []*deliveries.Delivery, zones []*pricing.Zone, slotList []*slots.Slot) []zoneCluster {
+	known := make(map[uuid.UUID]*slots.Slot, len(slotList))
+	for _, s := range slotList {
+		known[s.Id()] = s
+	}
+
+	byKey := make(map[clusterKey]*zoneCluster)
+	for _, d := range dlvrs {
+		if d.Status() != deliveries.Pending {
+			continue
+		}
+
+		key := clusterKey{}
+		if z := pricing.ZoneFor(zones, d.Coordinates()); z != nil {
+			key.zone = z.Name()
+		}
+		if id := d.SlotId(); id != nil && known[*id] != nil {
+			key.slot = *id
+		}
+
+		c, ok := byKey[key]
+		if !ok {
+			c = &zoneCluster{zone: key.zone, slot: known[key.slot]}
+			byKey[key] = c
+		}
+		c.deliveries = append(c.deliveries, d)
+	}
+
+	clusters := make([]zoneCluster, 0, len(byKey))
+	for _, c := range byKey {
+		clusters = append(clusters, *c)
+	}
+	slices.SortFunc(clusters, func(a, b zoneCluster) int {
+		if (a.zone == "") != (b.zone == "") {
+			return cmp.Compare(b.zone, a.zone)
+		}
+		return cmp.Or(cmp.Compare(a.zone, b.zone), compareSlots(a.slot, b.slot))
+	})
+	return clusters
+}
+
+func nearestNeighbour(depot valueobjects.Coordinates, dlvrs []*deliveries.Delivery) []*deliveries.Delivery {
+	left := slices.Clone(dlvrs)
+	ordered := make([]*deliveries.Delivery, 0, len(dlvrs))
+
+	at := depot
+	for len(left) > 0 {
+		next := 0
+		for i, d := range left {
+			if at.DistanceTo(d.Coordinates()) < at.DistanceTo(left[next].Coordinates()) {
+				next = i
+			}
+		}
+		at = left[next].Coordinates()
+		ordered = append(ordered, left[next])
+		left = slices.Delete(left, next, next+1)
+	}
+	return ordered
+}
+
+func twoOpt(depot valueobjects.Coordinates, dlvrs []*deliveries.Delivery) []*deliveries.Delivery {
+	tour := slices.Clone(dlvrs)
+	point := func(i int) valueobjects.Coordinates {
+		if i < 0 || i >= len(tour) {
+			return depot
+		}
+		return tour[i].Coordinates()
+	}
+
+	for improved := true; improved; {
+		improved = false
+		for i := 0; i < len(tour)-1; i++ {
+			for k := i + 1; k < len(tour); k++ {
+				a, b, c, d := point(i-1), point(i), point(k), point(k+1)
+				if a.DistanceTo(c)+b.DistanceTo(d) < a.DistanceTo(b)+c.DistanceTo(d)-minGain {
+					slices.Reverse(tour[i : k+1])
+					improved = true
+				}
+			}
+		}
+	}
+	return tour
+}
+
+// minGain is the fewest metres a 2-opt move must save, so that rounding does
+// not swap equal stretches back and forth.
+const minGain = 1e-6
+
+func newRoute(zone string, slot *slots.Slot, depot valueobjects.Coordinates, dlvrs []*deliveries.Delivery) *Route {
+	route := &Route{Zone: zone, Slot: slot, Stops: make([]Stop, 0, len(dlvrs))}
+
+	at := depot
+	for i, d := range dlvrs {
+		leg := at.DistanceTo(d.Coordinates())
+		route.Stops = append(route.Stops, Stop{Sequence: i + 1, Delivery: d, Distance: leg})
+		route.Distance += leg
+		at = d.Coordinates()
+	}
+	route.Distance += at.DistanceTo(depot)
+	return route
+}
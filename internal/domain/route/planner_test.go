@@ -0,0 +1,221 @@
+package routes
+
+import (
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/delivery"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/pricing"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/slot"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+var day = time.Date(2025, 10, 20, 0, 0, 0, 0, time.UTC)
+
+func newDelivery(t *testing.T, latitude, longitude float64, status string) *deliveries.Delivery {
+	d, err := deliveries.NewDeliveryFromDB(uuid.New(), uuid.New(), day, "Sesame Street", 30, latitude, longitude, status, nil, nil, day, day, nil)
+	assert.NoError(t, err)
+	return d
+}
+
+func newFleet(t *testing.T, drivers, capacity int) Fleet {
+	fleet, err := NewFleet(drivers, capacity, coordinates(t, -17.7863, -63.1812))
+	assert.NoError(t, err)
+	return fleet
+}
+
+func surcharge() valueobjects.Money {
+	money, _ := valueobjects.NewMoney(200, valueobjects.BOB)
+	return money
+}
+
+func stopsOf(r *Route) []*deliveries.Delivery {
+	dlvrs := make([]*deliveries.Delivery, 0, len(r.Stops))
+	for _, s := range r.Stops {
+		dlvrs = append(dlvrs, s.Delivery)
+	}
+	return dlvrs
+}
+
+func TestPlan_Zones(t *testing.T) {
+	downtown := pricing.NewZone("Downtown", coordinates(t, -17.7863, -63.1812), 2000, surcharge())
+	north := pricing.NewZone("North", coordinates(t, -17.7000, -63.1600), 3000, surcharge())
+	zones := []*pricing.Zone{north, downtown}
+
+	center1 := newDelivery(t, -17.7850, -63.1800, "P")
+	center2 := newDelivery(t, -17.7900, -63.1850, "P")
+	north1 := newDelivery(t, -17.7010, -63.1610, "P")
+	outside := newDelivery(t, -17.9000, -63.3000, "P")
+	delivered := newDelivery(t, -17.7860, -63.1810, "D")
+
+	manifests := Plan(day, []*deliveries.Delivery{north1, center1, outside, delivered, center2}, zones, nil, newFleet(t, 3, 10))
+
+	assert.Len(t, manifests, 3)
+	byZone := make(map[string][]*deliveries.Delivery)
+	for i, m := range manifests {
+		assert.Equal(t, i+1, m.Driver)
+		assert.Equal(t, day, m.Date)
+		assert.Len(t, m.Routes, 1)
+		byZone[m.Routes[0].Zone] = stopsOf(m.Routes[0])
+	}
+	assert.ElementsMatch(t, []*deliveries.Delivery{center1, center2}, byZone["Downtown"])
+	assert.Equal(t, []*deliveries.Delivery{north1}, byZone["North"])
+	assert.Equal(t, []*deliveries.Delivery{outside}, byZone[""])
+	assert.Equal(t, "Downtown", manifests[0].Routes[0].Zone)
+}
+
+func TestCluster(t *testing.T) {
+	downtown := pricing.NewZone("Downtown", coordinates(t, -17.7863, -63.1812), 2000, surcharge())
+	north := pricing.NewZone("North", coordinates(t, -17.7000, -63.1600), 3000, surcharge())
+	center := newDelivery(t, -17.7850, -63.1800, "P")
+	north1 := newDelivery(t, -17.7010, -63.1610, "P")
+	outside := newDelivery(t, -17.9000, -63.3000, "P")
+	cancelled := newDelivery(t, -17.7010, -63.1610, "C")
+
+	clusters := cluster([]*deliveries.Delivery{outside, north1, cancelled, center}, []*pricing.Zone{north, downtown}, nil)
+
+	assert.Equal(t, []zoneCluster{
+		{zone: "Downtown", deliveries: []*deliveries.Delivery{center}},
+		{zone: "North", deliveries: []*deliveries.Delivery{north1}},
+		{zone: "", deliveries: []*deliveries.Delivery{outside}},
+	}, clusters)
+}
+
+func TestCluster_Slots(t *testing.T) {
+	downtown := pricing.NewZone("Downtown", coordinates(t, -17.7863, -63.1812), 2000, surcharge())
+	lunch := slots.NewSlot(downtown.Id(), "Lunch", 11*time.Hour+30*time.Minute, 14*time.Hour, 30)
+	dinner := slots.NewSlot(downtown.Id(), "Dinner", 19*time.Hour, 21*time.Hour, 30)
+	deleted := slots.NewSlot(downtown.Id(), "Breakfast", 7*time.Hour, 9*time.Hour, 30)
+
+	atLunch := newDelivery(t, -17.7850, -63.1800, "P")
+	assert.NoError(t, atLunch.Book(lunch))
+	atDinner := newDelivery(t, -17.7900, -63.1850, "P")
+	assert.NoError(t, atDinner.Book(dinner))
+	unknown := newDelivery(t, -17.7860, -63.1810, "P")
+	assert.NoError(t, unknown.Book(deleted))
+	anytime := newDelivery(t, -17.7870, -63.1820, "P")
+
+	clusters := cluster([]*deliveries.Delivery{anytime, atDinner, unknown, atLunch}, []*pricing.Zone{downtown}, []*slots.Slot{dinner, lunch})
+
+	assert.Equal(t, []zoneCluster{
+		{zone: "Downtown", slot: lunch, deliveries: []*deliveries.Delivery{atLunch}},
+		{zone: "Downtown", slot: dinner, deliveries: []*deliveries.Delivery{atDinner}},
+		{zone: "Downtown", deliveries: []*deliveries.Delivery{anytime, unknown}},
+	}, clusters)
+}
+
+func TestPlan_Slots(t *testing.T) {
+	downtown := pricing.NewZone("Downtown", coordinates(t, -17.7863, -63.1812), 2000, surcharge())
+	lunch := slots.NewSlot(downtown.Id(), "Lunch", 11*time.Hour+30*time.Minute, 14*time.Hour, 30)
+	dinner := slots.NewSlot(downtown.Id(), "Dinner", 19*time.Hour, 21*time.Hour, 30)
+
+	var dlvrs []*deliveries.Delivery
+	for i, s := range []*slots.Slot{dinner, dinner, nil, lunch} {
+		d := newDelivery(t, -17.7850+float64(i)*0.001, -63.1800, "P")
+		if s != nil {
+			assert.NoError(t, d.Book(s))
+		}
+		dlvrs = append(dlvrs, d)
+	}
+
+	manifests := Plan(day, dlvrs, []*pricing.Zone{downtown}, []*slots.Slot{lunch, dinner}, newFleet(t, 1, 10))
+
+	assert.Len(t, manifests[0].Routes, 3)
+	assert.Equal(t, lunch, manifests[0].Routes[0].Slot)
+	assert.Equal(t, dinner, manifests[0].Routes[1].Slot)
+	assert.Len(t, manifests[0].Routes[1].Stops, 2)
+	assert.Nil(t, manifests[0].Routes[2].Slot)
+	for i, r := range manifests[0].Routes {
+		assert.Equal(t, i+1, r.Trip)
+		assert.Equal(t, "Downtown", r.Zone)
+	}
+}
+
+func TestPlan_Capacity(t *testing.T) {
+	dlvrs := []*deliveries.Delivery{
+		newDelivery(t, -17.7800, -63.1812, "P"),
+		newDelivery(t, -17.7700, -63.1812, "P"),
+		newDelivery(t, -17.7600, -63.1812, "P"),
+		newDelivery(t, -17.7500, -63.1812, "P"),
+		newDelivery(t, -17.7400, -63.1812, "P"),
+	}
+
+	manifests := Plan(day, dlvrs, nil, nil, newFleet(t, 1, 2))
+
+	assert.Len(t, manifests, 1)
+	assert.Len(t, manifests[0].Routes, 3)
+	assert.Equal(t, 5, manifests[0].Stops())
+	for i, r := range manifests[0].Routes {
+		assert.Equal(t, i+1, r.Trip)
+		assert.LessOrEqual(t, len(r.Stops), 2)
+	}
+	assert.ElementsMatch(t, []*deliveries.Delivery{dlvrs[2], dlvrs[3]}, stopsOf(manifests[0].Routes[0]))
+	assert.ElementsMatch(t, []*deliveries.Delivery{dlvrs[0], dlvrs[1]}, stopsOf(manifests[0].Routes[1]))
+	assert.Equal(t, []*deliveries.Delivery{dlvrs[4]}, stopsOf(manifests[0].Routes[2]))
+}
+
+func TestPlan_Drivers(t *testing.T) {
+	var dlvrs []*deliveries.Delivery
+	for i := range 7 {
+		dlvrs = append(dlvrs, newDelivery(t, -17.7800+float64(i)*0.005, -63.1812, "P"))
+	}
+
+	manifests := Plan(day, dlvrs, nil, nil, newFleet(t, 2, 3))
+
+	assert.Len(t, manifests, 2)
+	assert.ElementsMatch(t, []int{3, 4}, []int{manifests[0].Stops(), manifests[1].Stops()})
+}
+
+func TestPlan_Empty(t *testing.T) {
+	manifests := Plan(day, nil, nil, nil, newFleet(t, 2, 10))
+
+	assert.Len(t, manifests, 2)
+	for i, m := range manifests {
+		assert.Equal(t, i+1, m.Driver)
+		assert.NotNil(t, m.Routes)
+		assert.Empty(t, m.Routes)
+	}
+}
+
+func TestNearestNeighbour(t *testing.T) {
+	depot := coordinates(t, -17.7863, -63.1812)
+	near := newDelivery(t, -17.7800, -63.1812, "P")
+	middle := newDelivery(t, -17.7600, -63.1812, "P")
+	far := newDelivery(t, -17.7000, -63.1812, "P")
+
+	ordered := nearestNeighbour(depot, []*deliveries.Delivery{far, near, middle})
+
+	assert.Equal(t, []*deliveries.Delivery{near, middle, far}, ordered)
+	assert.Empty(t, nearestNeighbour(depot, nil))
+}
+
+func TestTwoOpt(t *testing.T) {
+	depot := coordinates(t, -17.7800, -63.1900)
+	a := newDelivery(t, -17.7800, -63.1800, "P")
+	b := newDelivery(t, -17.7700, -63.1800, "P")
+	c := newDelivery(t, -17.7700, -63.1900, "P")
+
+	crossed := []*deliveries.Delivery{b, a, c}
+	improved := twoOpt(depot, crossed)
+
+	assert.Less(t, newRoute("", nil, depot, improved).Distance, newRoute("", nil, depot, crossed).Distance)
+	assert.Contains(t, [][]*deliveries.Delivery{{a, b, c}, {c, b, a}}, improved)
+	assert.Equal(t, []*deliveries.Delivery{b, a, c}, crossed)
+}
+
+func TestNewRoute(t *testing.T) {
+	depot := coordinates(t, -17.7863, -63.1812)
+	first := newDelivery(t, -17.7800, -63.1812, "P")
+	second := newDelivery(t, -17.7700, -63.1812, "P")
+
+	route := newRoute("Downtown", nil, depot, []*deliveries.Delivery{first, second})
+
+	assert.Equal(t, "Downtown", route.Zone)
+	assert.Len(t, route.Stops, 2)
+	assert.Equal(t, 1, route.Stops[0].Sequence)
+	assert.Equal(t, depot.DistanceTo(first.Coordinates()), route.Stops[0].Distance)
+	assert.Equal(t, 2, route.Stops[1].Sequence)
+	assert.Equal(t, first.Coordinates().DistanceTo(second.Coordinates()), route.Stops[1].Distance)
+	assert.InDelta(t, 2*depot.DistanceTo(second.Coordinates()), route.Distance, 1)
+}
@@ -0,0 +1,56 @@
+package routes
+
+import (
+	"errors"
+	"fmt"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/delivery"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/slot"
+	"time"
+)
+
+type Stop struct {
+	Sequence int
+	Delivery *deliveries.Delivery
+	Distance float64
+}
+
+type Route struct {
+	Trip     int
+	Zone     string
+	Slot     *slots.Slot
+	Stops    []Stop
+	Distance float64
+}
+
+type Manifest struct {
+	Driver int
+	Date   time.Time
+	Routes []*Route
+}
+
+var ErrNotFoundManifest = errors.New("manifest not found")
+
+func (m *Manifest) Stops() int {
+	n := 0
+	for _, r := range m.Routes {
+		n += len(r.Stops)
+	}
+	return n
+}
+
+func (m *Manifest) Distance() float64 {
+	d := 0.0
+	for _, r := range m.Routes {
+		d += r.Distance
+	}
+	return d
+}
+
+func ManifestOf(manifests []*Manifest, driver int) (*Manifest, error) {
+	for _, m := range manifests {
+		if m.Driver == driver {
+			return m, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: driver %d", ErrNotFoundManifest, driver)
+}
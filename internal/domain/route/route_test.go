@@ -0,0 +1,36 @@
+package routes
+
+import (
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/delivery"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestManifest_Totals(t *testing.T) {
+	manifest := &Manifest{Driver: 1, Date: time.Now(), Routes: []*Route{
+		{Trip: 1, Stops: []Stop{{Sequence: 1}, {Sequence: 2}}, Distance: 1500},
+		{Trip: 2, Stops: []Stop{{Sequence: 1}}, Distance: 800},
+	}}
+
+	assert.Equal(t, 3, manifest.Stops())
+	assert.Equal(t, 2300.0, manifest.Distance())
+
+	empty := &Manifest{Driver: 2}
+	assert.Equal(t, 0, empty.Stops())
+	assert.Equal(t, 0.0, empty.Distance())
+}
+
+func TestManifestOf(t *testing.T) {
+	first := &Manifest{Driver: 1, Routes: []*Route{{Stops: []Stop{{Delivery: &deliveries.Delivery{}}}}}}
+	second := &Manifest{Driver: 2}
+	manifests := []*Manifest{first, second}
+
+	m, err := ManifestOf(manifests, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, second, m)
+
+	m, err = ManifestOf(manifests, 3)
+	assert.Nil(t, m)
+	assert.ErrorIs(t, err, ErrNotFoundManifest)
+}
@@ -0,0 +1,165 @@
+package slots
+
+import (
+	"errors"
+	"fmt"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/abstractions"
+	"github.com/google/uuid"
+	"time"
+)
+
+type Slot struct {
+	*abstractions.AggregateRoot
+	zoneId    uuid.UUID
+	name      string
+	start     time.Duration
+	end       time.Duration
+	capacity  int
+	createdAt time.Time
+	updatedAt time.Time
+	deletedAt *time.Time
+}
+
+var (
+	ErrZoneIdSlot    = errors.New("zoneId is not a valid UUID")
+	ErrEmptyNameSlot = errors.New("slot name is empty")
+	ErrLongNameSlot  = errors.New("slot name cannot be longer than 50 characters")
+	ErrClockSlot     = errors.New("slot time is not a valid HH:MM time")
+	ErrWindowSlot    = errors.New("slot must end after it starts")
+	ErrCapacitySlot  = errors.New("slot capacity is not a positive number")
+	ErrNotFoundSlot  = errors.New("slot not found")
+	ErrZoneSlot      = errors.New("slot does not serve the delivery address")
+	ErrFullSlot      = errors.New("slot is full")
+)
+
+func (s *Slot) Update(name string, start, end time.Duration, capacity int) error {
+	if err := validateDetails(name, start, end, capacity); err != nil {
+		return err
+	}
+
+	s.name = name
+	s.start = start
+	s.end = end
+	s.capacity = capacity
+	return nil
+}
+
+func (s *Slot) StartOn(day time.Time) time.Time {
+	return startOfDay(day).Add(s.start)
+}
+
+func (s *Slot) EndOn(day time.Time) time.Time {
+	return startOfDay(day).Add(s.end)
+}
+
+func (s *Slot) CanTake(day time.Time, booked int) error {
+	if booked >= s.capacity {
+		return fmt.Errorf("%w: %s has %d of %d deliveries on %s", ErrFullSlot, s.name, booked, s.capacity, day.Format(time.DateOnly))
+	}
+	return nil
+}
+
+func (s *Slot) Id() uuid.UUID {
+	return s.Entity.Id
+}
+
+func (s *Slot) ZoneId() uuid.UUID {
+	return s.zoneId
+}
+
+func (s *Slot) Name() string {
+	return s.name
+}
+
+func (s *Slot) Start() time.Duration {
+	return s.start
+}
+
+func (s *Slot) End() time.Duration {
+	return s.end
+}
+
+func (s *Slot) Capacity() int {
+	return s.capacity
+}
+
+func (s *Slot) CreatedAt() time.Time {
+	return s.createdAt
+}
+
+func (s *Slot) UpdatedAt() time.Time {
+	return s.updatedAt
+}
+
+func (s *Slot) DeletedAt() *time.Time {
+	return s.deletedAt
+}
+
+func validateDetails(name string, start, end time.Duration, capacity int) error {
+	if name == "" {
+		return ErrEmptyNameSlot
+	}
+
+	if len(name) > 50 {
+		return fmt.Errorf("%w: got %s, size %d", ErrLongNameSlot, name, len(name))
+	}
+
+	if start < 0 || end > 24*time.Hour || end <= start {
+		return fmt.Errorf("%w: got %s - %s", ErrWindowSlot, FormatClock(start), FormatClock(end))
+	}
+
+	if capacity <= 0 {
+		return fmt.Errorf("%w: got %d", ErrCapacitySlot, capacity)
+	}
+	return nil
+}
+
+func ParseClock(s string) (time.Duration, error) {
+	var h, m int
+	if n, err := fmt.Sscanf(s, "%d:%d", &h, &m); err != nil || n != 2 || len(s) != 5 {
+		return 0, fmt.Errorf("%w: got %s", ErrClockSlot, s)
+	}
+
+	if h < 0 || m < 0 || m > 59 || h > 24 || (h == 24 && m != 0) {
+		return 0, fmt.Errorf("%w: got %s", ErrClockSlot, s)
+	}
+	return time.Duration(h)*time.Hour + time.Duration(m)*time.Minute, nil
+}
+
+func FormatClock(d time.Duration) string {
+	return fmt.Sprintf("%02d:%02d", int(d/time.Hour), int(d%time.Hour/time.Minute))
+}
+
+func startOfDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
+
+func NewSlot(zoneId uuid.UUID, name string, start, end time.Duration, capacity int) *Slot {
+	return &Slot{
+		AggregateRoot: abstractions.NewAggregateRoot(uuid.New()),
+		zoneId:        zoneId,
+		name:          name,
+		start:         start,
+		end:           end,
+		capacity:      capacity,
+	}
+}
+
+func NewSlotFromDb(id, zoneId uuid.UUID, name string, start, end, capacity int, cAt, uAt time.Time, dAt *time.Time) (*Slot, error) {
+	from, to := time.Duration(start)*time.Minute, time.Duration(end)*time.Minute
+	if err := validateDetails(name, from, to, capacity); err != nil {
+		return nil, err
+	}
+
+	return &Slot{
+		AggregateRoot: abstractions.NewAggregateRoot(id),
+		zoneId:        zoneId,
+		name:          name,
+		start:         from,
+		end:           to,
+		capacity:      capacity,
+		createdAt:     cAt,
+		updatedAt:     uAt,
+		deletedAt:     dAt,
+	}, nil
+}
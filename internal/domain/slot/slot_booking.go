@@ -0,0 +1,66 @@
+package slots
+
+import (
+	"context"
+	"fmt"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/pricing"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	"github.com/google/uuid"
+	"slices"
+	"time"
+)
+
+type Booker struct {
+	slots SlotRepository
+	zones pricing.ZoneRepository
+}
+
+func NewBooker(s SlotRepository, z pricing.ZoneRepository) *Booker {
+	return &Booker{
+		slots: s,
+		zones: z,
+	}
+}
+
+func (b *Booker) Slot(ctx context.Context, id uuid.UUID, at valueobjects.Coordinates) (*Slot, error) {
+	slot, err := b.slots.GetById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	zones, err := b.zones.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if z := pricing.ZoneFor(zones, at); z == nil || z.Id() != slot.ZoneId() {
+		return nil, fmt.Errorf("%w: %s at %.6f, %.6f", ErrZoneSlot, slot.Name(), at.Latitude(), at.Longitude())
+	}
+	return slot, nil
+}
+
+func (b *Booker) Reserve(ctx context.Context, slot *Slot, days []time.Time) error {
+	if len(days) == 0 {
+		return nil
+	}
+
+	if err := b.slots.Lock(ctx, slot.Id()); err != nil {
+		return err
+	}
+
+	sorted := slices.SortedFunc(slices.Values(days), func(a, b time.Time) int { return a.Compare(b) })
+	booked, err := b.slots.CountBooked(ctx, slot.Id(), startOfDay(sorted[0]), startOfDay(sorted[len(sorted)-1]).AddDate(0, 0, 1))
+	if err != nil {
+		return err
+	}
+
+	taken := make(map[string]int, len(sorted))
+	for _, day := range sorted {
+		key := day.Format(time.DateOnly)
+		if err = slot.CanTake(day, booked[key]+taken[key]); err != nil {
+			return err
+		}
+		taken[key]++
+	}
+	return nil
+}
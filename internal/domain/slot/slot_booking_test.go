@@ -0,0 +1,107 @@
+package slots
+
+import (
+	"context"
+	"errors"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/pricing"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+type fakeSlots struct {
+	SlotRepository
+	slots  map[uuid.UUID]*Slot
+	booked map[string]int
+	locked []uuid.UUID
+	err    error
+}
+
+func (r *fakeSlots) Lock(_ context.Context, id uuid.UUID) error {
+	r.locked = append(r.locked, id)
+	return nil
+}
+
+func (r *fakeSlots) GetById(_ context.Context, id uuid.UUID) (*Slot, error) {
+	if slot, ok := r.slots[id]; ok {
+		return slot, nil
+	}
+	return nil, ErrNotFoundSlot
+}
+
+func (r *fakeSlots) CountBooked(_ context.Context, _ uuid.UUID, _, _ time.Time) (map[string]int, error) {
+	return r.booked, r.err
+}
+
+type fakeZones struct {
+	pricing.ZoneRepository
+	zones []*pricing.Zone
+}
+
+func (r *fakeZones) GetAll(_ context.Context) ([]*pricing.Zone, error) {
+	return r.zones, nil
+}
+
+func coordinates(t *testing.T, latitude, longitude float64) valueobjects.Coordinates {
+	c, err := valueobjects.NewCoordinates(latitude, longitude)
+	assert.NoError(t, err)
+	return c
+}
+
+func TestBooker_Slot(t *testing.T) {
+	center := coordinates(t, -17.7863, -63.1812)
+	fee, _ := valueobjects.NewMoney(500, valueobjects.BOB)
+	city := pricing.NewZone("City", center, 10000, fee)
+	downtown := pricing.NewZone("Downtown", center, 1000, fee)
+
+	cityLunch := NewSlot(city.Id(), "Lunch", 12*time.Hour, 14*time.Hour, 10)
+	downtownLunch := NewSlot(downtown.Id(), "Lunch", 12*time.Hour, 14*time.Hour, 10)
+	booker := NewBooker(
+		&fakeSlots{slots: map[uuid.UUID]*Slot{cityLunch.Id(): cityLunch, downtownLunch.Id(): downtownLunch}},
+		&fakeZones{zones: []*pricing.Zone{city, downtown}},
+	)
+	ctx := context.Background()
+
+	slot, err := booker.Slot(ctx, downtownLunch.Id(), center)
+	assert.NoError(t, err)
+	assert.Equal(t, downtownLunch, slot)
+
+	slot, err = booker.Slot(ctx, cityLunch.Id(), coordinates(t, -17.7500, -63.1812))
+	assert.NoError(t, err)
+	assert.Equal(t, cityLunch, slot)
+
+	_, err = booker.Slot(ctx, cityLunch.Id(), center)
+	assert.ErrorIs(t, err, ErrZoneSlot)
+
+	_, err = booker.Slot(ctx, cityLunch.Id(), coordinates(t, -16.5000, -68.1500))
+	assert.ErrorIs(t, err, ErrZoneSlot)
+
+	_, err = booker.Slot(ctx, uuid.New(), center)
+	assert.ErrorIs(t, err, ErrNotFoundSlot)
+}
+
+func TestBooker_Reserve(t *testing.T) {
+	slot := lunch()
+	monday := time.Date(2025, 10, 20, 9, 0, 0, 0, time.UTC)
+	tuesday := monday.AddDate(0, 0, 1)
+	repo := &fakeSlots{booked: map[string]int{"2025-10-21": 1}}
+	booker := NewBooker(repo, &fakeZones{})
+	ctx := context.Background()
+
+	assert.NoError(t, booker.Reserve(ctx, slot, nil))
+	assert.Empty(t, repo.locked)
+	assert.NoError(t, booker.Reserve(ctx, slot, []time.Time{tuesday, monday}))
+	assert.Equal(t, []uuid.UUID{slot.Id()}, repo.locked)
+	assert.NoError(t, booker.Reserve(ctx, slot, []time.Time{monday, monday}))
+	assert.ErrorIs(t, booker.Reserve(ctx, slot, []time.Time{tuesday, tuesday}), ErrFullSlot)
+	assert.Equal(t, 1, repo.booked["2025-10-21"])
+
+	repo.booked = nil
+	assert.NoError(t, booker.Reserve(ctx, slot, []time.Time{monday, monday}))
+	assert.ErrorIs(t, booker.Reserve(ctx, slot, []time.Time{monday, monday, monday}), ErrFullSlot)
+
+	repo.err = errors.New("db failure")
+	assert.ErrorIs(t, booker.Reserve(ctx, slot, []time.Time{monday}), repo.err)
+}
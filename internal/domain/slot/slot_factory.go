@@ -0,0 +1,32 @@
+package slots
+
+import (
+	"github.com/google/uuid"
+	"log"
+	"time"
+)
+
+type SlotFactory interface {
+	Create(zoneId uuid.UUID, name string, start, end time.Duration, capacity int) (*Slot, error)
+}
+
+type slotFactory struct{}
+
+func (slotFactory) Create(zoneId uuid.UUID, name string, start, end time.Duration, capacity int) (*Slot, error) {
+	if zoneId == uuid.Nil {
+		log.Printf("[factory:slot] zoneId '%s' is not a valid UUID", zoneId)
+		return nil, ErrZoneIdSlot
+	}
+
+	if err := validateDetails(name, start, end, capacity); err != nil {
+		log.Printf("[factory:slot] slot '%s' is not valid: %v", name, err)
+		return nil, err
+	}
+
+	log.Printf("[factory:slot] slot '%s' created", name)
+	return NewSlot(zoneId, name, start, end, capacity), nil
+}
+
+func NewSlotFactory() SlotFactory {
+	return &slotFactory{}
+}
@@ -0,0 +1,38 @@
+package slots
+
+import (
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestSlotFactory_Create(t *testing.T) {
+	zoneId := uuid.New()
+
+	slot, err := NewSlotFactory().Create(zoneId, "Dinner", 19*time.Hour, 21*time.Hour, 30)
+
+	assert.NoError(t, err)
+	assert.NotEqual(t, uuid.Nil, slot.Id())
+	assert.Equal(t, zoneId, slot.ZoneId())
+	assert.Equal(t, "Dinner", slot.Name())
+	assert.Equal(t, 19*time.Hour, slot.Start())
+	assert.Equal(t, 21*time.Hour, slot.End())
+	assert.Equal(t, 30, slot.Capacity())
+}
+
+func TestSlotFactory_Create_Err(t *testing.T) {
+	factory := NewSlotFactory()
+
+	_, err := factory.Create(uuid.Nil, "Dinner", 19*time.Hour, 21*time.Hour, 30)
+	assert.ErrorIs(t, err, ErrZoneIdSlot)
+
+	_, err = factory.Create(uuid.New(), "", 19*time.Hour, 21*time.Hour, 30)
+	assert.ErrorIs(t, err, ErrEmptyNameSlot)
+
+	_, err = factory.Create(uuid.New(), "Dinner", 21*time.Hour, 19*time.Hour, 30)
+	assert.ErrorIs(t, err, ErrWindowSlot)
+
+	_, err = factory.Create(uuid.New(), "Dinner", 19*time.Hour, 21*time.Hour, -1)
+	assert.ErrorIs(t, err, ErrCapacitySlot)
+}
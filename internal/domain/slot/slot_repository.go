@@ -0,0 +1,23 @@
+package slots
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"time"
+)
+
+type SlotRepository interface {
+	GetAll(ctx context.Context) ([]*Slot, error)
+	GetByZone(ctx context.Context, zoneId uuid.UUID) ([]*Slot, error)
+	GetById(ctx context.Context, id uuid.UUID) (*Slot, error)
+
+	// Lock holds the slot until the unit of work ends, so that bookings into
+	// it are counted one after the other.
+	Lock(ctx context.Context, id uuid.UUID) error
+
+	CountBooked(ctx context.Context, id uuid.UUID, from, to time.Time) (map[string]int, error)
+
+	Create(ctx context.Context, slot *Slot) (*Slot, error)
+	Update(ctx context.Context, slot *Slot) (*Slot, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+}
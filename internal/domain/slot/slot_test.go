@@ -0,0 +1,123 @@
+package slots
+
+import (
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+	"time"
+)
+
+func lunch() *Slot {
+	return NewSlot(uuid.New(), "Lunch", 11*time.Hour+30*time.Minute, 14*time.Hour, 2)
+}
+
+func TestSlot_Window(t *testing.T) {
+	slot := lunch()
+	day := time.Date(2025, 10, 20, 8, 15, 0, 0, time.UTC)
+
+	assert.Equal(t, time.Date(2025, 10, 20, 11, 30, 0, 0, time.UTC), slot.StartOn(day))
+	assert.Equal(t, time.Date(2025, 10, 20, 14, 0, 0, 0, time.UTC), slot.EndOn(day))
+}
+
+func TestSlot_CanTake(t *testing.T) {
+	slot := lunch()
+	day := time.Date(2025, 10, 20, 0, 0, 0, 0, time.UTC)
+
+	assert.NoError(t, slot.CanTake(day, 0))
+	assert.NoError(t, slot.CanTake(day, 1))
+	assert.ErrorIs(t, slot.CanTake(day, 2), ErrFullSlot)
+}
+
+func TestSlot_Update(t *testing.T) {
+	slot := lunch()
+
+	err := slot.Update("Dinner", 19*time.Hour, 21*time.Hour, 10)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Dinner", slot.Name())
+	assert.Equal(t, 19*time.Hour, slot.Start())
+	assert.Equal(t, 21*time.Hour, slot.End())
+	assert.Equal(t, 10, slot.Capacity())
+}
+
+func TestSlot_Update_Err(t *testing.T) {
+	cases := []struct {
+		name       string
+		slotName   string
+		start, end time.Duration
+		capacity   int
+		err        error
+	}{
+		{"Empty Name", "", 19 * time.Hour, 21 * time.Hour, 10, ErrEmptyNameSlot},
+		{"Long Name", strings.Repeat("a", 51), 19 * time.Hour, 21 * time.Hour, 10, ErrLongNameSlot},
+		{"Ends Before", "Dinner", 21 * time.Hour, 19 * time.Hour, 10, ErrWindowSlot},
+		{"Empty Window", "Dinner", 19 * time.Hour, 19 * time.Hour, 10, ErrWindowSlot},
+		{"Past Midnight", "Dinner", 23 * time.Hour, 25 * time.Hour, 10, ErrWindowSlot},
+		{"Zero Capacity", "Dinner", 19 * time.Hour, 21 * time.Hour, 0, ErrCapacitySlot},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			slot := lunch()
+
+			err := slot.Update(tc.slotName, tc.start, tc.end, tc.capacity)
+
+			assert.ErrorIs(t, err, tc.err)
+			assert.Equal(t, "Lunch", slot.Name())
+			assert.Equal(t, 2, slot.Capacity())
+		})
+	}
+}
+
+func TestParseClock(t *testing.T) {
+	cases := []struct {
+		in  string
+		out time.Duration
+	}{
+		{"00:00", 0},
+		{"11:30", 11*time.Hour + 30*time.Minute},
+		{"24:00", 24 * time.Hour},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.in, func(t *testing.T) {
+			d, err := ParseClock(tc.in)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.out, d)
+			assert.Equal(t, tc.in, FormatClock(d))
+		})
+	}
+}
+
+func TestParseClock_Err(t *testing.T) {
+	for _, in := range []string{"", "noon", "9:30", "11:60", "24:30", "25:00", "11:30:00"} {
+		t.Run(in, func(t *testing.T) {
+			_, err := ParseClock(in)
+
+			assert.ErrorIs(t, err, ErrClockSlot)
+		})
+	}
+}
+
+func TestNewSlotFromDb(t *testing.T) {
+	id, zoneId := uuid.New(), uuid.New()
+	now := time.Now()
+
+	slot, err := NewSlotFromDb(id, zoneId, "Lunch", 690, 840, 25, now, now, nil)
+
+	assert.NoError(t, err)
+	assert.Equal(t, id, slot.Id())
+	assert.Equal(t, zoneId, slot.ZoneId())
+	assert.Equal(t, "Lunch", slot.Name())
+	assert.Equal(t, 11*time.Hour+30*time.Minute, slot.Start())
+	assert.Equal(t, 14*time.Hour, slot.End())
+	assert.Equal(t, 25, slot.Capacity())
+	assert.Equal(t, now, slot.CreatedAt())
+	assert.Equal(t, now, slot.UpdatedAt())
+	assert.Nil(t, slot.DeletedAt())
+
+	_, err = NewSlotFromDb(id, zoneId, "Lunch", 840, 690, 25, now, now, nil)
+	assert.ErrorIs(t, err, ErrWindowSlot)
+}
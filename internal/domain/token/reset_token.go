@@ -0,0 +1,128 @@
+package tokens
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/abstractions"
+	"github.com/google/uuid"
+	"time"
+)
+
+const resetSecretSize = 32
+
+var (
+	ErrEmptyHashResetToken = errors.New("reset token hash cannot be empty")
+	ErrUsedResetToken      = errors.New("reset token has already been used")
+)
+
+type ResetToken struct {
+	*abstractions.Entity
+	subjectId uuid.UUID
+	role      Role
+	hash      string
+	expiresAt time.Time
+	usedAt    *time.Time
+	createdAt time.Time
+}
+
+func NewResetToken(subjectId uuid.UUID, role Role, hash string, expiresAt time.Time) *ResetToken {
+	return &ResetToken{
+		Entity:    abstractions.NewEntity(uuid.New()),
+		subjectId: subjectId,
+		role:      role,
+		hash:      hash,
+		expiresAt: expiresAt,
+		createdAt: time.Now(),
+	}
+}
+
+func NewResetTokenFromDB(id, subjectId uuid.UUID, role, hash string, expiresAt time.Time, usedAt *time.Time, createdAt time.Time) (*ResetToken, error) {
+	if id == uuid.Nil {
+		return nil, ErrEmptyIdToken
+	}
+
+	if subjectId == uuid.Nil {
+		return nil, ErrEmptySubjectIdToken
+	}
+
+	if hash == "" {
+		return nil, ErrEmptyHashResetToken
+	}
+
+	r, err := ParseRole(role)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ResetToken{
+		Entity:    abstractions.NewEntity(id),
+		subjectId: subjectId,
+		role:      r,
+		hash:      hash,
+		expiresAt: expiresAt,
+		usedAt:    usedAt,
+		createdAt: createdAt,
+	}, nil
+}
+
+func (t *ResetToken) Id() uuid.UUID {
+	return t.Entity.Id
+}
+
+func (t *ResetToken) SubjectId() uuid.UUID {
+	return t.subjectId
+}
+
+func (t *ResetToken) Role() Role {
+	return t.role
+}
+
+func (t *ResetToken) Hash() string {
+	return t.hash
+}
+
+func (t *ResetToken) ExpiresAt() time.Time {
+	return t.expiresAt
+}
+
+func (t *ResetToken) UsedAt() *time.Time {
+	return t.usedAt
+}
+
+func (t *ResetToken) CreatedAt() time.Time {
+	return t.createdAt
+}
+
+func (t *ResetToken) Validate(now time.Time) error {
+	if t.usedAt != nil {
+		return ErrUsedResetToken
+	}
+	if !now.Before(t.expiresAt) {
+		return ErrExpiredToken
+	}
+	return nil
+}
+
+func (t *ResetToken) Use() {
+	if t.usedAt != nil {
+		return
+	}
+	now := time.Now()
+	t.usedAt = &now
+}
+
+func NewResetSecret() (string, error) {
+	b := make([]byte, resetSecretSize)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func HashResetSecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,14 @@
+package tokens
+
+import (
+	"context"
+	"github.com/google/uuid"
+)
+
+type ResetTokenRepository interface {
+	GetByHash(ctx context.Context, hash string) (*ResetToken, error)
+
+	Create(ctx context.Context, token *ResetToken) (*ResetToken, error)
+	Use(ctx context.Context, id uuid.UUID) (bool, error)
+	InvalidateBySubject(ctx context.Context, subjectId uuid.UUID) (int, error)
+}
@@ -0,0 +1,75 @@
+package tokens
+
+import (
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestNewResetToken(t *testing.T) {
+	subjectId := uuid.New()
+	expiresAt := time.Now().Add(time.Hour)
+
+	token := NewResetToken(subjectId, Patient, HashResetSecret("secret"), expiresAt)
+
+	assert.NotEqual(t, uuid.Nil, token.Id())
+	assert.Equal(t, subjectId, token.SubjectId())
+	assert.Equal(t, Patient, token.Role())
+	assert.Equal(t, HashResetSecret("secret"), token.Hash())
+	assert.Equal(t, expiresAt, token.ExpiresAt())
+	assert.Nil(t, token.UsedAt())
+	assert.NoError(t, token.Validate(time.Now()))
+}
+
+func TestNewResetTokenFromDB_Invalid(t *testing.T) {
+	now := time.Now()
+	cases := []struct {
+		name          string
+		id, subjectId uuid.UUID
+		role, hash    string
+		err           error
+	}{
+		{"Nil id", uuid.Nil, uuid.New(), "A", "hash", ErrEmptyIdToken},
+		{"Nil subject", uuid.New(), uuid.Nil, "A", "hash", ErrEmptySubjectIdToken},
+		{"Empty hash", uuid.New(), uuid.New(), "A", "", ErrEmptyHashResetToken},
+		{"Unknown role", uuid.New(), uuid.New(), "X", "hash", ErrNotARoleToken},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			token, err := NewResetTokenFromDB(tc.id, tc.subjectId, tc.role, tc.hash, now, nil, now)
+
+			assert.Nil(t, token)
+			assert.ErrorIs(t, err, tc.err)
+		})
+	}
+}
+
+func TestResetToken_Validate(t *testing.T) {
+	now := time.Now()
+
+	expired := NewResetToken(uuid.New(), Administrator, "hash", now.Add(-time.Second))
+	assert.ErrorIs(t, expired.Validate(now), ErrExpiredToken)
+
+	used := NewResetToken(uuid.New(), Administrator, "hash", now.Add(time.Hour))
+	used.Use()
+	usedAt := used.UsedAt()
+	used.Use()
+
+	assert.Same(t, usedAt, used.UsedAt())
+	assert.ErrorIs(t, used.Validate(now), ErrUsedResetToken)
+}
+
+func TestResetSecret(t *testing.T) {
+	first, err := NewResetSecret()
+	assert.NoError(t, err)
+
+	second, err := NewResetSecret()
+	assert.NoError(t, err)
+
+	assert.NotEqual(t, first, second)
+	assert.Len(t, HashResetSecret(first), 64)
+	assert.Equal(t, HashResetSecret(first), HashResetSecret(first))
+	assert.NotEqual(t, first, HashResetSecret(first))
+}
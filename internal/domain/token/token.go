@@ -0,0 +1,115 @@
+package tokens
+
+import (
+	"errors"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/abstractions"
+	"github.com/google/uuid"
+	"time"
+)
+
+var (
+	ErrEmptyIdToken        = errors.New("id cannot be nil")
+	ErrEmptySubjectIdToken = errors.New("subject id cannot be nil")
+	ErrNotARoleToken       = errors.New("not a token role")
+	ErrNotAKindToken       = errors.New("not a token kind")
+	ErrNotFoundToken       = errors.New("token not found")
+	ErrRevokedToken        = errors.New("token has been revoked")
+	ErrExpiredToken        = errors.New("token has expired")
+)
+
+type Token struct {
+	*abstractions.Entity
+	subjectId uuid.UUID
+	role      Role
+	kind      Kind
+	expiresAt time.Time
+	revokedAt *time.Time
+	createdAt time.Time
+}
+
+func NewToken(id, subjectId uuid.UUID, role Role, kind Kind, expiresAt time.Time) *Token {
+	return &Token{
+		Entity:    abstractions.NewEntity(id),
+		subjectId: subjectId,
+		role:      role,
+		kind:      kind,
+		expiresAt: expiresAt,
+		createdAt: time.Now(),
+	}
+}
+
+func NewTokenFromDB(id, subjectId uuid.UUID, role, kind string, expiresAt time.Time, revokedAt *time.Time, createdAt time.Time) (*Token, error) {
+	if id == uuid.Nil {
+		return nil, ErrEmptyIdToken
+	}
+
+	if subjectId == uuid.Nil {
+		return nil, ErrEmptySubjectIdToken
+	}
+
+	r, err := ParseRole(role)
+	if err != nil {
+		return nil, err
+	}
+
+	k, err := ParseKind(kind)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Token{
+		Entity:    abstractions.NewEntity(id),
+		subjectId: subjectId,
+		role:      r,
+		kind:      k,
+		expiresAt: expiresAt,
+		revokedAt: revokedAt,
+		createdAt: createdAt,
+	}, nil
+}
+
+func (t *Token) Id() uuid.UUID {
+	return t.Entity.Id
+}
+
+func (t *Token) SubjectId() uuid.UUID {
+	return t.subjectId
+}
+
+func (t *Token) Role() Role {
+	return t.role
+}
+
+func (t *Token) Kind() Kind {
+	return t.kind
+}
+
+func (t *Token) ExpiresAt() time.Time {
+	return t.expiresAt
+}
+
+func (t *Token) RevokedAt() *time.Time {
+	return t.revokedAt
+}
+
+func (t *Token) CreatedAt() time.Time {
+	return t.createdAt
+}
+
+func (t *Token) Validate(now time.Time) error {
+	if t.revokedAt != nil {
+		return ErrRevokedToken
+	}
+	if !now.Before(t.expiresAt) {
+		return ErrExpiredToken
+	}
+	return nil
+}
+
+func (t *Token) Revoke() {
+	if t.revokedAt != nil {
+		return
+	}
+	now := time.Now()
+	t.revokedAt = &now
+}
@@ -0,0 +1,32 @@
+package tokens
+
+import "fmt"
+
+type Kind string
+
+const (
+	Access  Kind = "A" // Access
+	Refresh Kind = "R" // Refresh
+)
+
+func (k Kind) String() string {
+	switch k {
+	case Access:
+		return "access"
+	case Refresh:
+		return "refresh"
+	default:
+		return "unknown"
+	}
+}
+
+func ParseKind(s string) (Kind, error) {
+	switch s {
+	case "access", "A":
+		return Access, nil
+	case "refresh", "R":
+		return Refresh, nil
+	default:
+		return "", fmt.Errorf("%w: got %s", ErrNotAKindToken, s)
+	}
+}
@@ -0,0 +1,14 @@
+package tokens
+
+import (
+	"context"
+	"github.com/google/uuid"
+)
+
+type TokenRepository interface {
+	GetById(ctx context.Context, id uuid.UUID) (*Token, error)
+
+	Create(ctx context.Context, token *Token) (*Token, error)
+	Revoke(ctx context.Context, id uuid.UUID) (bool, error)
+	RevokeBySubject(ctx context.Context, subjectId uuid.UUID) (int, error)
+}
@@ -0,0 +1,41 @@
+package tokens
+
+import "fmt"
+
+type Role string
+
+const (
+	Administrator      Role = "A" // Administrator
+	SuperAdministrator Role = "S" // Super Administrator
+	Patient            Role = "P" // Patient
+)
+
+func (r Role) String() string {
+	switch r {
+	case Administrator:
+		return "administrator"
+	case SuperAdministrator:
+		return "super-administrator"
+	case Patient:
+		return "patient"
+	default:
+		return "unknown"
+	}
+}
+
+func (r Role) IsAdministrator() bool {
+	return r == Administrator || r == SuperAdministrator
+}
+
+func ParseRole(s string) (Role, error) {
+	switch s {
+	case "administrator", "A":
+		return Administrator, nil
+	case "super-administrator", "S":
+		return SuperAdministrator, nil
+	case "patient", "P":
+		return Patient, nil
+	default:
+		return "", fmt.Errorf("%w: got %s", ErrNotARoleToken, s)
+	}
+}
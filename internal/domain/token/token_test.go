@@ -0,0 +1,96 @@
+package tokens
+
+import (
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestNewToken(t *testing.T) {
+	id, subjectId := uuid.New(), uuid.New()
+	expiresAt := time.Now().Add(time.Hour)
+
+	token := NewToken(id, subjectId, Administrator, Refresh, expiresAt)
+
+	assert.Equal(t, id, token.Id())
+	assert.Equal(t, subjectId, token.SubjectId())
+	assert.Equal(t, Administrator, token.Role())
+	assert.Equal(t, Refresh, token.Kind())
+	assert.Equal(t, expiresAt, token.ExpiresAt())
+	assert.Nil(t, token.RevokedAt())
+	assert.NoError(t, token.Validate(time.Now()))
+}
+
+func TestNewTokenFromDB(t *testing.T) {
+	id, subjectId := uuid.New(), uuid.New()
+	now := time.Now()
+
+	token, err := NewTokenFromDB(id, subjectId, "P", "A", now.Add(time.Minute), nil, now)
+
+	assert.NoError(t, err)
+	assert.Equal(t, Patient, token.Role())
+	assert.Equal(t, Access, token.Kind())
+	assert.Equal(t, now, token.CreatedAt())
+}
+
+func TestNewTokenFromDB_Invalid(t *testing.T) {
+	now := time.Now()
+	cases := []struct {
+		name          string
+		id, subjectId uuid.UUID
+		role, kind    string
+		err           error
+	}{
+		{"Nil id", uuid.Nil, uuid.New(), "A", "A", ErrEmptyIdToken},
+		{"Nil subject", uuid.New(), uuid.Nil, "A", "A", ErrEmptySubjectIdToken},
+		{"Unknown role", uuid.New(), uuid.New(), "X", "A", ErrNotARoleToken},
+		{"Unknown kind", uuid.New(), uuid.New(), "A", "X", ErrNotAKindToken},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			token, err := NewTokenFromDB(tc.id, tc.subjectId, tc.role, tc.kind, now, nil, now)
+			assert.Nil(t, token)
+			assert.ErrorIs(t, err, tc.err)
+		})
+	}
+}
+
+func TestToken_Validate(t *testing.T) {
+	now := time.Now()
+
+	expired := NewToken(uuid.New(), uuid.New(), Patient, Access, now.Add(-time.Second))
+	assert.ErrorIs(t, expired.Validate(now), ErrExpiredToken)
+
+	revoked := NewToken(uuid.New(), uuid.New(), Patient, Access, now.Add(time.Hour))
+	revoked.Revoke()
+	revokedAt := revoked.RevokedAt()
+	revoked.Revoke()
+
+	assert.NotNil(t, revokedAt)
+	assert.Same(t, revokedAt, revoked.RevokedAt())
+	assert.ErrorIs(t, revoked.Validate(now), ErrRevokedToken)
+}
+
+func TestParseRole(t *testing.T) {
+	r, err := ParseRole("administrator")
+	assert.NoError(t, err)
+	assert.Equal(t, Administrator, r)
+	assert.Equal(t, "administrator", r.String())
+	assert.Equal(t, "patient", Patient.String())
+	assert.Equal(t, "super-administrator", SuperAdministrator.String())
+	assert.True(t, SuperAdministrator.IsAdministrator())
+	assert.True(t, Administrator.IsAdministrator())
+	assert.False(t, Patient.IsAdministrator())
+	assert.Equal(t, "unknown", Role("X").String())
+}
+
+func TestParseKind(t *testing.T) {
+	k, err := ParseKind("refresh")
+	assert.NoError(t, err)
+	assert.Equal(t, Refresh, k)
+	assert.Equal(t, "refresh", k.String())
+	assert.Equal(t, "access", Access.String())
+	assert.Equal(t, "unknown", Kind("X").String())
+}
@@ -3,6 +3,7 @@ package valueobjects
 import (
 	"errors"
 	"fmt"
+	"math"
 )
 
 type Coordinates struct {
@@ -32,3 +33,14 @@ func (c Coordinates) Latitude() float64 {
 func (c Coordinates) Longitude() float64 {
 	return c.lon
 }
+
+const earthRadiusMeters = 6371000
+
+func (c Coordinates) DistanceTo(other Coordinates) float64 {
+	lat1, lat2 := c.lat*math.Pi/180, other.lat*math.Pi/180
+	dLat := lat2 - lat1
+	dLon := (other.lon - c.lon) * math.Pi / 180
+
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return 2 * earthRadiusMeters * math.Asin(math.Sqrt(h))
+}
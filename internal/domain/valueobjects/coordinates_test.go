@@ -80,3 +80,14 @@ func TestNewCoordinates_LongitudeError(t *testing.T) {
 		})
 	}
 }
+
+func TestCoordinates_DistanceTo(t *testing.T) {
+	plaza, err := NewCoordinates(-17.7834, -63.1821)
+	assert.NoError(t, err)
+	airport, err := NewCoordinates(-17.6448, -63.1354)
+	assert.NoError(t, err)
+
+	assert.Zero(t, plaza.DistanceTo(plaza))
+	assert.InDelta(t, 16200, plaza.DistanceTo(airport), 300)
+	assert.Equal(t, plaza.DistanceTo(airport), airport.DistanceTo(plaza))
+}
@@ -0,0 +1,121 @@
+package valueobjects
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+type Currency string
+
+const (
+	BOB Currency = "BOB"
+	USD Currency = "USD"
+)
+
+const DefaultCurrency = BOB
+
+type Money struct {
+	amount   int
+	currency Currency
+}
+
+var (
+	ErrCurrencyMoney         = errors.New("currency is not supported")
+	ErrCurrencyMismatchMoney = errors.New("amounts are in different currencies")
+)
+
+func ParseCurrency(s string) (Currency, error) {
+	c := Currency(strings.ToUpper(strings.TrimSpace(s)))
+	if c == "" {
+		return DefaultCurrency, nil
+	}
+
+	if !c.IsValid() {
+		return "", fmt.Errorf("%w: got %s", ErrCurrencyMoney, s)
+	}
+	return c, nil
+}
+
+func (c Currency) IsValid() bool {
+	return c == BOB || c == USD
+}
+
+func NewMoney(amount int, currency Currency) (Money, error) {
+	if !currency.IsValid() {
+		return Money{}, fmt.Errorf("%w: got %s", ErrCurrencyMoney, currency)
+	}
+	return Money{amount: amount, currency: currency}, nil
+}
+
+func (m Money) Amount() int {
+	return m.amount
+}
+
+func (m Money) Currency() Currency {
+	return m.currency
+}
+
+func (m Money) IsPositive() bool {
+	return m.amount > 0
+}
+
+func (m Money) IsNegative() bool {
+	return m.amount < 0
+}
+
+func (m Money) IsZero() bool {
+	return m.amount == 0
+}
+
+func (m Money) Add(other Money) (Money, error) {
+	if err := m.sameCurrency(other); err != nil {
+		return Money{}, err
+	}
+	return Money{amount: m.amount + other.amount, currency: m.currency}, nil
+}
+
+func (m Money) Sub(other Money) (Money, error) {
+	if err := m.sameCurrency(other); err != nil {
+		return Money{}, err
+	}
+	return Money{amount: m.amount - other.amount, currency: m.currency}, nil
+}
+
+func (m Money) Multiply(n int) Money {
+	return Money{amount: m.amount * n, currency: m.currency}
+}
+
+func (m Money) Negate() Money {
+	return Money{amount: -m.amount, currency: m.currency}
+}
+
+func (m Money) Compare(other Money) (int, error) {
+	if err := m.sameCurrency(other); err != nil {
+		return 0, err
+	}
+
+	switch {
+	case m.amount < other.amount:
+		return -1, nil
+	case m.amount > other.amount:
+		return 1, nil
+	default:
+		return 0, nil
+	}
+}
+
+func (m Money) String() string {
+	sign, amount := "", m.amount
+	if amount < 0 {
+		sign, amount = "-", -amount
+	}
+	return fmt.Sprintf("%s %s%d.%02d", m.currency, sign, amount/100, amount%100)
+}
+
+func (m Money) sameCurrency(other Money) error {
+	if m.currency != other.currency {
+		return fmt.Errorf("%w: %s and %s", ErrCurrencyMismatchMoney, m.currency, other.currency)
+	}
+	return nil
+}
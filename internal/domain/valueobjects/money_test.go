@@ -0,0 +1,91 @@
+package valueobjects
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestParseCurrency(t *testing.T) {
+	cases := []struct {
+		in       string
+		expected Currency
+	}{
+		{"", BOB},
+		{"BOB", BOB},
+		{"usd", USD},
+		{" Usd ", USD},
+	}
+
+	for _, tc := range cases {
+		c, err := ParseCurrency(tc.in)
+		assert.NoError(t, err)
+		assert.Equal(t, tc.expected, c)
+	}
+
+	c, err := ParseCurrency("EUR")
+	assert.ErrorIs(t, err, ErrCurrencyMoney)
+	assert.Empty(t, c)
+}
+
+func TestNewMoney(t *testing.T) {
+	m, err := NewMoney(125050, BOB)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 125050, m.Amount())
+	assert.Equal(t, BOB, m.Currency())
+	assert.True(t, m.IsPositive())
+	assert.False(t, m.IsNegative())
+	assert.False(t, m.IsZero())
+	assert.Equal(t, "BOB 1250.50", m.String())
+
+	m, err = NewMoney(100, Currency("EUR"))
+	assert.ErrorIs(t, err, ErrCurrencyMoney)
+	assert.Zero(t, m)
+
+	m, err = NewMoney(100, "")
+	assert.ErrorIs(t, err, ErrCurrencyMoney)
+	assert.Zero(t, m)
+}
+
+func TestMoney_Arithmetic(t *testing.T) {
+	a, _ := NewMoney(1000, USD)
+	b, _ := NewMoney(250, USD)
+
+	sum, err := a.Add(b)
+	assert.NoError(t, err)
+	assert.Equal(t, 1250, sum.Amount())
+	assert.Equal(t, USD, sum.Currency())
+
+	diff, err := b.Sub(a)
+	assert.NoError(t, err)
+	assert.Equal(t, -750, diff.Amount())
+	assert.True(t, diff.IsNegative())
+	assert.Equal(t, "USD -7.50", diff.String())
+
+	assert.Equal(t, 3000, a.Multiply(3).Amount())
+	assert.Equal(t, -1000, a.Negate().Amount())
+
+	cmp, err := a.Compare(b)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, cmp)
+	cmp, err = b.Compare(a)
+	assert.NoError(t, err)
+	assert.Equal(t, -1, cmp)
+	cmp, err = a.Compare(a)
+	assert.NoError(t, err)
+	assert.Zero(t, cmp)
+}
+
+func TestMoney_MixedCurrencies(t *testing.T) {
+	bob, _ := NewMoney(1000, BOB)
+	usd, _ := NewMoney(1000, USD)
+
+	_, err := bob.Add(usd)
+	assert.ErrorIs(t, err, ErrCurrencyMismatchMoney)
+
+	_, err = bob.Sub(usd)
+	assert.ErrorIs(t, err, ErrCurrencyMismatchMoney)
+
+	_, err = bob.Compare(usd)
+	assert.ErrorIs(t, err, ErrCurrencyMismatchMoney)
+}
@@ -3,9 +3,11 @@ package valueobjects
 import (
 	"errors"
 	"fmt"
+	"golang.org/x/crypto/bcrypt"
 	"log"
 	"regexp"
 	"strings"
+	"sync"
 )
 
 type Password struct {
@@ -62,6 +64,31 @@ func (p Password) String() string {
 	return p.value
 }
 
+func (p Password) Hash() (Password, error) {
+	hashed, err := bcrypt.GenerateFromPassword([]byte(p.value), bcrypt.DefaultCost)
+	if err != nil {
+		log.Printf("[valueobject:password] error hashing password: %v", err)
+		return Password{}, err
+	}
+	return NewHashedPassword(string(hashed))
+}
+
+func (p Password) Matches(plain string) bool {
+	return bcrypt.CompareHashAndPassword([]byte(p.value), []byte(plain)) == nil
+}
+
+// decoyHash is compared against when there is no account to check, so that a
+// login for an unknown email takes as long as one with a wrong password.
+var decoyHash = sync.OnceValue(func() []byte {
+	hashed, _ := bcrypt.GenerateFromPassword([]byte("decoy-password"), bcrypt.DefaultCost)
+	return hashed
+})
+
+func SimulatePasswordMatch(plain string) bool {
+	_ = bcrypt.CompareHashAndPassword(decoyHash(), []byte(plain))
+	return false
+}
+
 func isStrongPassword(v string) bool {
 	hasLower := regexp.MustCompile(`[a-z]`).MatchString(v)
 	hasUpper := regexp.MustCompile(`[A-Z]`).MatchString(v)
@@ -225,3 +225,21 @@ func TestNewHashedPassword_LengthError(t *testing.T) {
 		})
 	}
 }
+
+func TestPassword_Hash(t *testing.T) {
+	plain, err := NewPassword("Abcdef1!")
+	assert.NoError(t, err)
+
+	hashed, err := plain.Hash()
+
+	assert.NoError(t, err)
+	assert.NotEqual(t, plain.String(), hashed.String())
+	assert.True(t, hashed.Matches("Abcdef1!"))
+	assert.False(t, hashed.Matches("Abcdef1?"))
+}
+
+func TestSimulatePasswordMatch(t *testing.T) {
+	assert.False(t, SimulatePasswordMatch("decoy-password"))
+	assert.False(t, SimulatePasswordMatch(""))
+	assert.Len(t, decoyHash(), 60)
+}
@@ -0,0 +1,125 @@
+package valueobjects
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+type Polygon struct {
+	rings [][]Coordinates
+}
+
+var (
+	ErrEmptyPolygon   = errors.New("polygon has no outer ring")
+	ErrRingPolygon    = errors.New("polygon ring needs at least four positions and must end where it starts")
+	ErrGeoJSONPolygon = errors.New("polygon is not a valid GeoJSON Polygon geometry")
+)
+
+func NewPolygon(rings ...[]Coordinates) (Polygon, error) {
+	if len(rings) == 0 {
+		return Polygon{}, ErrEmptyPolygon
+	}
+
+	for i, ring := range rings {
+		if len(ring) < 4 || ring[0] != ring[len(ring)-1] {
+			return Polygon{}, fmt.Errorf("%w: ring %d", ErrRingPolygon, i)
+		}
+	}
+
+	return Polygon{rings: rings}, nil
+}
+
+// geoJSONPolygon is a GeoJSON Polygon geometry, its positions are longitude
+// first.
+type geoJSONPolygon struct {
+	Type        string        `json:"type"`
+	Coordinates [][][]float64 `json:"coordinates"`
+}
+
+func ParsePolygon(data []byte) (Polygon, error) {
+	var g geoJSONPolygon
+	if err := json.Unmarshal(data, &g); err != nil {
+		return Polygon{}, fmt.Errorf("%w: %v", ErrGeoJSONPolygon, err)
+	}
+
+	if g.Type != "Polygon" {
+		return Polygon{}, fmt.Errorf("%w: got type '%s'", ErrGeoJSONPolygon, g.Type)
+	}
+
+	rings := make([][]Coordinates, len(g.Coordinates))
+	for i, ring := range g.Coordinates {
+		for _, position := range ring {
+			if len(position) < 2 {
+				return Polygon{}, fmt.Errorf("%w: ring %d has a position without longitude and latitude", ErrGeoJSONPolygon, i)
+			}
+
+			c, err := NewCoordinates(position[1], position[0])
+			if err != nil {
+				return Polygon{}, err
+			}
+			rings[i] = append(rings[i], c)
+		}
+	}
+
+	return NewPolygon(rings...)
+}
+
+func (p Polygon) MarshalJSON() ([]byte, error) {
+	g := geoJSONPolygon{Type: "Polygon", Coordinates: make([][][]float64, len(p.rings))}
+	for i, ring := range p.rings {
+		for _, c := range ring {
+			g.Coordinates[i] = append(g.Coordinates[i], []float64{c.lon, c.lat})
+		}
+	}
+	return json.Marshal(g)
+}
+
+func (p Polygon) Rings() [][]Coordinates {
+	return p.rings
+}
+
+// Contains reports whether c lies inside the outer ring and outside all the
+// holes, by casting a ray from it and counting the edges it crosses. Edges are
+// taken as straight lines on the map, which is close enough at city scale.
+func (p Polygon) Contains(c Coordinates) bool {
+	inside := false
+	for _, ring := range p.rings {
+		for i, j := 0, len(ring)-1; i < len(ring); j, i = i, i+1 {
+			a, b := ring[i], ring[j]
+			if (a.lat > c.lat) != (b.lat > c.lat) && c.lon < (b.lon-a.lon)*(c.lat-a.lat)/(b.lat-a.lat)+a.lon {
+				inside = !inside
+			}
+		}
+	}
+	return inside
+}
+
+func (p Polygon) Center() Coordinates {
+	if len(p.rings) == 0 {
+		return Coordinates{}
+	}
+
+	ring := p.rings[0][:len(p.rings[0])-1]
+	var lat, lon float64
+	for _, c := range ring {
+		lat += c.lat
+		lon += c.lon
+	}
+	n := float64(len(ring))
+	return Coordinates{lat: lat / n, lon: lon / n}
+}
+
+func (p Polygon) Reach() float64 {
+	if len(p.rings) == 0 {
+		return 0
+	}
+
+	center, reach := p.Center(), 0.0
+	for _, c := range p.rings[0] {
+		if d := center.DistanceTo(c); d > reach {
+			reach = d
+		}
+	}
+	return reach
+}
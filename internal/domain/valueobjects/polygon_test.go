@@ -0,0 +1,80 @@
+package valueobjects
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+const downtownGeoJSON = `{"type":"Polygon","coordinates":[
+	[[-63.19,-17.79],[-63.17,-17.79],[-63.17,-17.77],[-63.19,-17.77],[-63.19,-17.79]],
+	[[-63.185,-17.785],[-63.180,-17.785],[-63.180,-17.780],[-63.185,-17.780],[-63.185,-17.785]]
+]}`
+
+func mustCoordinates(t *testing.T, lat, lon float64) Coordinates {
+	c, err := NewCoordinates(lat, lon)
+	assert.NoError(t, err)
+	return c
+}
+
+func TestParsePolygon(t *testing.T) {
+	polygon, err := ParsePolygon([]byte(downtownGeoJSON))
+
+	assert.NoError(t, err)
+	assert.Len(t, polygon.Rings(), 2)
+	assert.Len(t, polygon.Rings()[0], 5)
+	assert.Equal(t, -17.79, polygon.Rings()[0][0].Latitude())
+	assert.Equal(t, -63.19, polygon.Rings()[0][0].Longitude())
+	assert.InDelta(t, -17.78, polygon.Center().Latitude(), 1e-9)
+	assert.InDelta(t, -63.18, polygon.Center().Longitude(), 1e-9)
+	assert.InDelta(t, 1500, polygon.Reach(), 100)
+}
+
+func TestParsePolygon_Errors(t *testing.T) {
+	cases := []struct {
+		name string
+		data string
+		err  error
+	}{
+		{"not json", `{"type":`, ErrGeoJSONPolygon},
+		{"not a polygon", `{"type":"Point","coordinates":[-63.18,-17.78]}`, ErrGeoJSONPolygon},
+		{"short position", `{"type":"Polygon","coordinates":[[[-63.19],[-63.17,-17.79],[-63.17,-17.77],[-63.19]]]}`, ErrGeoJSONPolygon},
+		{"no rings", `{"type":"Polygon","coordinates":[]}`, ErrEmptyPolygon},
+		{"open ring", `{"type":"Polygon","coordinates":[[[-63.19,-17.79],[-63.17,-17.79],[-63.17,-17.77],[-63.19,-17.77]]]}`, ErrRingPolygon},
+		{"short ring", `{"type":"Polygon","coordinates":[[[-63.19,-17.79],[-63.17,-17.79],[-63.19,-17.79]]]}`, ErrRingPolygon},
+		{"latitude", `{"type":"Polygon","coordinates":[[[-63.19,-97.79],[-63.17,-17.79],[-63.17,-17.77],[-63.19,-97.79]]]}`, ErrOutOfBoundariesLatitude},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			polygon, err := ParsePolygon([]byte(tc.data))
+
+			assert.ErrorIs(t, err, tc.err)
+			assert.Empty(t, polygon.Rings())
+		})
+	}
+}
+
+func TestPolygon_Contains(t *testing.T) {
+	polygon, err := ParsePolygon([]byte(downtownGeoJSON))
+	assert.NoError(t, err)
+
+	assert.True(t, polygon.Contains(mustCoordinates(t, -17.775, -63.175)))
+	assert.True(t, polygon.Contains(mustCoordinates(t, -17.788, -63.188)))
+	assert.False(t, polygon.Contains(mustCoordinates(t, -17.7825, -63.1825)), "inside the hole")
+	assert.False(t, polygon.Contains(mustCoordinates(t, -17.80, -63.18)))
+	assert.False(t, polygon.Contains(mustCoordinates(t, -16.50, -68.15)))
+	assert.False(t, Polygon{}.Contains(mustCoordinates(t, -17.78, -63.18)))
+}
+
+func TestPolygon_MarshalJSON(t *testing.T) {
+	polygon, err := ParsePolygon([]byte(downtownGeoJSON))
+	assert.NoError(t, err)
+
+	data, err := json.Marshal(polygon)
+	assert.NoError(t, err)
+
+	again, err := ParsePolygon(data)
+	assert.NoError(t, err)
+	assert.Equal(t, polygon, again)
+}
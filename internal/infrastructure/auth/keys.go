@@ -0,0 +1,124 @@
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+)
+
+const MinKeyLength = 32
+
+var (
+	ErrNoKeysAuth        = errors.New("no signing keys configured")
+	ErrMalformedKeysAuth = errors.New("signing keys must be formatted as 'kid:secret' pairs separated by commas")
+	ErrShortKeyAuth      = errors.New("signing key must be at least 32 bytes long")
+	ErrUnknownKeyAuth    = errors.New("unknown signing key")
+	ErrActiveKeyAuth     = errors.New("the active signing key cannot be retired")
+)
+
+type KeySet struct {
+	mu     sync.RWMutex
+	active string
+	keys   map[string][]byte
+}
+
+func NewKeySet(active string, keys map[string][]byte) (*KeySet, error) {
+	if len(keys) == 0 {
+		return nil, ErrNoKeysAuth
+	}
+
+	ks := &KeySet{keys: make(map[string][]byte, len(keys))}
+	for kid, secret := range keys {
+		if len(secret) < MinKeyLength {
+			return nil, fmt.Errorf("%w: got %d bytes for '%s'", ErrShortKeyAuth, len(secret), kid)
+		}
+		ks.keys[kid] = secret
+	}
+
+	if _, ok := ks.keys[active]; !ok {
+		return nil, fmt.Errorf("%w: got %s", ErrUnknownKeyAuth, active)
+	}
+	ks.active = active
+
+	return ks, nil
+}
+
+func LoadKeySet() (*KeySet, error) {
+	raw := os.Getenv("JWT_SIGNING_KEYS")
+	if raw == "" {
+		return nil, ErrNoKeysAuth
+	}
+
+	keys := make(map[string][]byte)
+	first := ""
+	for _, pair := range strings.Split(raw, ",") {
+		kid, secret, ok := strings.Cut(strings.TrimSpace(pair), ":")
+		if !ok || kid == "" || secret == "" {
+			return nil, ErrMalformedKeysAuth
+		}
+		if first == "" {
+			first = kid
+		}
+		keys[kid] = []byte(secret)
+	}
+
+	active := os.Getenv("JWT_ACTIVE_KEY")
+	if active == "" {
+		active = first
+	}
+
+	return NewKeySet(active, keys)
+}
+
+func (k *KeySet) Rotate(kid string, secret []byte) error {
+	if len(secret) < MinKeyLength {
+		return fmt.Errorf("%w: got %d bytes for '%s'", ErrShortKeyAuth, len(secret), kid)
+	}
+
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	k.keys[kid] = secret
+	k.active = kid
+	return nil
+}
+
+func (k *KeySet) Retire(kid string) error {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	if kid == k.active {
+		return ErrActiveKeyAuth
+	}
+	if _, ok := k.keys[kid]; !ok {
+		return fmt.Errorf("%w: got %s", ErrUnknownKeyAuth, kid)
+	}
+
+	delete(k.keys, kid)
+	return nil
+}
+
+func (k *KeySet) Active() string {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+	return k.active
+}
+
+func (k *KeySet) signingKey() (string, []byte) {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+	return k.active, k.keys[k.active]
+}
+
+func (k *KeySet) verificationKey(kid string) ([]byte, error) {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+
+	secret, ok := k.keys[kid]
+	if !ok {
+		return nil, fmt.Errorf("%w: got %s", ErrUnknownKeyAuth, kid)
+	}
+	return secret, nil
+}
@@ -0,0 +1,106 @@
+package auth
+
+import (
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+var (
+	secretOne = []byte(strings.Repeat("a", MinKeyLength))
+	secretTwo = []byte(strings.Repeat("b", MinKeyLength))
+)
+
+func TestNewKeySet(t *testing.T) {
+	ks, err := NewKeySet("one", map[string][]byte{"one": secretOne, "two": secretTwo})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "one", ks.Active())
+
+	kid, secret := ks.signingKey()
+	assert.Equal(t, "one", kid)
+	assert.Equal(t, secretOne, secret)
+}
+
+func TestNewKeySet_Invalid(t *testing.T) {
+	cases := []struct {
+		name   string
+		active string
+		keys   map[string][]byte
+		err    error
+	}{
+		{"No keys", "one", nil, ErrNoKeysAuth},
+		{"Short key", "one", map[string][]byte{"one": []byte("short")}, ErrShortKeyAuth},
+		{"Unknown active", "two", map[string][]byte{"one": secretOne}, ErrUnknownKeyAuth},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ks, err := NewKeySet(tc.active, tc.keys)
+			assert.Nil(t, ks)
+			assert.ErrorIs(t, err, tc.err)
+		})
+	}
+}
+
+func TestLoadKeySet(t *testing.T) {
+	t.Setenv("JWT_SIGNING_KEYS", "one:"+string(secretOne)+", two:"+string(secretTwo))
+	t.Setenv("JWT_ACTIVE_KEY", "two")
+
+	ks, err := LoadKeySet()
+
+	assert.NoError(t, err)
+	assert.Equal(t, "two", ks.Active())
+
+	secret, err := ks.verificationKey("one")
+	assert.NoError(t, err)
+	assert.Equal(t, secretOne, secret)
+}
+
+func TestLoadKeySet_DefaultsToFirst(t *testing.T) {
+	t.Setenv("JWT_SIGNING_KEYS", "one:"+string(secretOne))
+	t.Setenv("JWT_ACTIVE_KEY", "")
+
+	ks, err := LoadKeySet()
+
+	assert.NoError(t, err)
+	assert.Equal(t, "one", ks.Active())
+}
+
+func TestLoadKeySet_Invalid(t *testing.T) {
+	t.Setenv("JWT_SIGNING_KEYS", "")
+	_, err := LoadKeySet()
+	assert.ErrorIs(t, err, ErrNoKeysAuth)
+
+	t.Setenv("JWT_SIGNING_KEYS", "no-separator")
+	_, err = LoadKeySet()
+	assert.ErrorIs(t, err, ErrMalformedKeysAuth)
+}
+
+func TestKeySet_RotateAndRetire(t *testing.T) {
+	ks, err := NewKeySet("one", map[string][]byte{"one": secretOne})
+	assert.NoError(t, err)
+
+	assert.ErrorIs(t, ks.Rotate("two", []byte("short")), ErrShortKeyAuth)
+	assert.NoError(t, ks.Rotate("two", secretTwo))
+	assert.Equal(t, "two", ks.Active())
+
+	assert.ErrorIs(t, ks.Retire("two"), ErrActiveKeyAuth)
+	assert.ErrorIs(t, ks.Retire("three"), ErrUnknownKeyAuth)
+	assert.NoError(t, ks.Retire("one"))
+
+	_, err = ks.verificationKey("one")
+	assert.ErrorIs(t, err, ErrUnknownKeyAuth)
+}
+
+func TestLoadConfig(t *testing.T) {
+	t.Setenv("JWT_ISSUER", "tests")
+	t.Setenv("JWT_ACCESS_TTL", "5m")
+	t.Setenv("JWT_REFRESH_TTL", "not-a-duration")
+
+	cfg := LoadConfig()
+
+	assert.Equal(t, "tests", cfg.Issuer)
+	assert.Equal(t, 5*60, int(cfg.AccessTTL.Seconds()))
+	assert.Equal(t, DefaultRefreshTTL, cfg.RefreshTTL)
+}
@@ -0,0 +1,293 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/abstractions"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/token"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"log"
+	"os"
+	"time"
+)
+
+const (
+	DefaultIssuer     = "nutricenter-contracting"
+	DefaultAccessTTL  = 15 * time.Minute
+	DefaultRefreshTTL = 7 * 24 * time.Hour
+	TokenType         = "Bearer"
+)
+
+var (
+	ErrInvalidTokenAuth = errors.New("invalid token")
+	ErrWrongKindAuth    = errors.New("token kind not accepted here")
+	ErrSubjectAuth      = errors.New("token does not belong to the subject")
+)
+
+type Config struct {
+	Issuer     string
+	AccessTTL  time.Duration
+	RefreshTTL time.Duration
+}
+
+func LoadConfig() Config {
+	cfg := Config{Issuer: DefaultIssuer, AccessTTL: DefaultAccessTTL, RefreshTTL: DefaultRefreshTTL}
+
+	if issuer := os.Getenv("JWT_ISSUER"); issuer != "" {
+		cfg.Issuer = issuer
+	}
+	if ttl, err := time.ParseDuration(os.Getenv("JWT_ACCESS_TTL")); err == nil && ttl > 0 {
+		cfg.AccessTTL = ttl
+	}
+	if ttl, err := time.ParseDuration(os.Getenv("JWT_REFRESH_TTL")); err == nil && ttl > 0 {
+		cfg.RefreshTTL = ttl
+	}
+
+	return cfg
+}
+
+type Claims struct {
+	Role string `json:"role"`
+	Kind string `json:"kind"`
+	jwt.RegisteredClaims
+}
+
+type TokenPair struct {
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token"`
+	TokenType    string `json:"token_type"`
+	ExpiresIn    int    `json:"expires_in"`
+}
+
+type Principal struct {
+	TokenId   uuid.UUID
+	SubjectId uuid.UUID
+	Role      tokens.Role
+	ExpiresAt time.Time
+}
+
+type Service struct {
+	keys       *KeySet
+	repository tokens.TokenRepository
+	uow        abstractions.UnitOfWork
+	cfg        Config
+	now        func() time.Time
+}
+
+func NewService(keys *KeySet, r tokens.TokenRepository, u abstractions.UnitOfWork, cfg Config) *Service {
+	return &Service{
+		keys:       keys,
+		repository: r,
+		uow:        u,
+		cfg:        cfg,
+		now:        time.Now,
+	}
+}
+
+func (s *Service) Keys() *KeySet {
+	return s.keys
+}
+
+func (s *Service) Issue(ctx context.Context, subjectId uuid.UUID, role tokens.Role) (*TokenPair, error) {
+	var pair *TokenPair
+
+	err := s.uow.Do(ctx, func(ctx context.Context) error {
+		var err error
+		pair, err = s.issue(ctx, subjectId, role)
+		return err
+	})
+	if err != nil {
+		log.Printf("[auth:service][Issue] error issuing tokens for '%s': %v", subjectId, err)
+		return nil, err
+	}
+
+	return pair, nil
+}
+
+func (s *Service) Authenticate(ctx context.Context, raw string) (*Principal, error) {
+	claims, t, err := s.verify(ctx, raw, tokens.Access)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Principal{
+		TokenId:   t.Id(),
+		SubjectId: t.SubjectId(),
+		Role:      t.Role(),
+		ExpiresAt: claims.ExpiresAt.Time,
+	}, nil
+}
+
+// Refresh exchanges a refresh token for a new pair, revoking the one presented.
+// Presenting an already revoked refresh token revokes every token of the
+// subject, since it means the token was stolen or replayed.
+func (s *Service) Refresh(ctx context.Context, raw string) (*TokenPair, error) {
+	_, t, err := s.verify(ctx, raw, tokens.Refresh)
+	if errors.Is(err, tokens.ErrRevokedToken) {
+		s.revokeSubject(ctx, t.SubjectId())
+		return nil, err
+	} else if err != nil {
+		log.Printf("[auth:service][Refresh] error verifying refresh token: %v", err)
+		return nil, err
+	}
+
+	var pair *TokenPair
+	err = s.uow.Do(ctx, func(ctx context.Context) error {
+		revoked, err := s.repository.Revoke(ctx, t.Id())
+		if err != nil {
+			return err
+		} else if !revoked {
+			return fmt.Errorf("%w: %w", ErrInvalidTokenAuth, tokens.ErrRevokedToken)
+		}
+
+		pair, err = s.issue(ctx, t.SubjectId(), t.Role())
+		return err
+	})
+	if errors.Is(err, tokens.ErrRevokedToken) {
+		s.revokeSubject(ctx, t.SubjectId())
+		return nil, err
+	} else if err != nil {
+		log.Printf("[auth:service][Refresh] error refreshing tokens: %v", err)
+		return nil, err
+	}
+
+	return pair, nil
+}
+
+func (s *Service) Logout(ctx context.Context, principal *Principal, refresh string) error {
+	return s.uow.Do(ctx, func(ctx context.Context) error {
+		if _, err := s.repository.Revoke(ctx, principal.TokenId); err != nil {
+			return err
+		}
+
+		if refresh == "" {
+			return nil
+		}
+
+		_, t, err := s.verify(ctx, refresh, tokens.Refresh)
+		if errors.Is(err, tokens.ErrRevokedToken) || errors.Is(err, tokens.ErrExpiredToken) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		if t.SubjectId() != principal.SubjectId {
+			return ErrSubjectAuth
+		}
+
+		_, err = s.repository.Revoke(ctx, t.Id())
+		return err
+	})
+}
+
+func (s *Service) LogoutAll(ctx context.Context, principal *Principal) (int, error) {
+	return s.repository.RevokeBySubject(ctx, principal.SubjectId)
+}
+
+func (s *Service) revokeSubject(ctx context.Context, subjectId uuid.UUID) {
+	n, err := s.repository.RevokeBySubject(ctx, subjectId)
+	if err != nil {
+		log.Printf("[auth:service][revokeSubject] error revoking tokens of '%s': %v", subjectId, err)
+		return
+	}
+	log.Printf("[auth:service][revokeSubject] refresh token reused, revoked %d tokens of '%s'", n, subjectId)
+}
+
+func (s *Service) issue(ctx context.Context, subjectId uuid.UUID, role tokens.Role) (*TokenPair, error) {
+	access, err := s.sign(ctx, subjectId, role, tokens.Access, s.cfg.AccessTTL)
+	if err != nil {
+		return nil, err
+	}
+
+	refresh, err := s.sign(ctx, subjectId, role, tokens.Refresh, s.cfg.RefreshTTL)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TokenPair{
+		AccessToken:  access,
+		RefreshToken: refresh,
+		TokenType:    TokenType,
+		ExpiresIn:    int(s.cfg.AccessTTL.Seconds()),
+	}, nil
+}
+
+func (s *Service) sign(ctx context.Context, subjectId uuid.UUID, role tokens.Role, kind tokens.Kind, ttl time.Duration) (string, error) {
+	now := s.now()
+	t := tokens.NewToken(uuid.New(), subjectId, role, kind, now.Add(ttl).Truncate(time.Second))
+
+	if _, err := s.repository.Create(ctx, t); err != nil {
+		return "", err
+	}
+
+	claims := Claims{
+		Role: string(role),
+		Kind: string(kind),
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        t.Id().String(),
+			Subject:   subjectId.String(),
+			Issuer:    s.cfg.Issuer,
+			IssuedAt:  jwt.NewNumericDate(now),
+			NotBefore: jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(t.ExpiresAt()),
+		},
+	}
+
+	kid, secret := s.keys.signingKey()
+	jt := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	jt.Header["kid"] = kid
+
+	signed, err := jt.SignedString(secret)
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrInvalidTokenAuth, err)
+	}
+
+	return signed, nil
+}
+
+func (s *Service) verify(ctx context.Context, raw string, kind tokens.Kind) (*Claims, *tokens.Token, error) {
+	claims := &Claims{}
+
+	_, err := jwt.ParseWithClaims(raw, claims, func(t *jwt.Token) (any, error) {
+		kid, _ := t.Header["kid"].(string)
+		return s.keys.verificationKey(kid)
+	},
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithIssuer(s.cfg.Issuer),
+		jwt.WithExpirationRequired(),
+		jwt.WithTimeFunc(s.now),
+	)
+	if errors.Is(err, jwt.ErrTokenExpired) {
+		return nil, nil, fmt.Errorf("%w: %w", ErrInvalidTokenAuth, tokens.ErrExpiredToken)
+	} else if err != nil {
+		return nil, nil, fmt.Errorf("%w: %w", ErrInvalidTokenAuth, err)
+	}
+
+	if claims.Kind != string(kind) {
+		return nil, nil, fmt.Errorf("%w: got %s", ErrWrongKindAuth, claims.Kind)
+	}
+
+	id, err := uuid.Parse(claims.ID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %w", ErrInvalidTokenAuth, err)
+	}
+
+	t, err := s.repository.GetById(ctx, id)
+	if errors.Is(err, tokens.ErrNotFoundToken) {
+		return nil, nil, fmt.Errorf("%w: %w", ErrInvalidTokenAuth, err)
+	} else if err != nil {
+		return nil, nil, err
+	}
+
+	if t.Kind() != kind || t.SubjectId().String() != claims.Subject {
+		return nil, nil, ErrInvalidTokenAuth
+	}
+
+	if err = t.Validate(s.now()); err != nil {
+		return claims, t, fmt.Errorf("%w: %w", ErrInvalidTokenAuth, err)
+	}
+
+	return claims, t, nil
+}
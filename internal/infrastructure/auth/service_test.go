@@ -0,0 +1,275 @@
+package auth
+
+import (
+	"context"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/token"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"sync"
+	"testing"
+	"time"
+)
+
+type memoryTokenRepository struct {
+	mu     sync.Mutex
+	tokens map[uuid.UUID]*tokens.Token
+}
+
+func newMemoryTokenRepository() *memoryTokenRepository {
+	return &memoryTokenRepository{tokens: map[uuid.UUID]*tokens.Token{}}
+}
+
+func (m *memoryTokenRepository) GetById(ctx context.Context, id uuid.UUID) (*tokens.Token, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	t, ok := m.tokens[id]
+	if !ok {
+		return nil, tokens.ErrNotFoundToken
+	}
+	return t, nil
+}
+
+func (m *memoryTokenRepository) Create(ctx context.Context, t *tokens.Token) (*tokens.Token, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.tokens[t.Id()] = t
+	return t, nil
+}
+
+func (m *memoryTokenRepository) Revoke(ctx context.Context, id uuid.UUID) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	t, ok := m.tokens[id]
+	if !ok || t.RevokedAt() != nil {
+		return false, nil
+	}
+	t.Revoke()
+	return true, nil
+}
+
+func (m *memoryTokenRepository) RevokeBySubject(ctx context.Context, subjectId uuid.UUID) (int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	n := 0
+	for _, t := range m.tokens {
+		if t.SubjectId() == subjectId && t.RevokedAt() == nil {
+			t.Revoke()
+			n++
+		}
+	}
+	return n, nil
+}
+
+type directUnitOfWork struct{}
+
+func (directUnitOfWork) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func newTestService(t *testing.T) (*Service, *memoryTokenRepository) {
+	ks, err := NewKeySet("one", map[string][]byte{"one": secretOne})
+	assert.NoError(t, err)
+
+	repo := newMemoryTokenRepository()
+	svc := NewService(ks, repo, directUnitOfWork{}, Config{Issuer: "tests", AccessTTL: time.Minute, RefreshTTL: time.Hour})
+	return svc, repo
+}
+
+func TestService_IssueAndAuthenticate(t *testing.T) {
+	ctx := context.Background()
+	svc, repo := newTestService(t)
+	subjectId := uuid.New()
+
+	pair, err := svc.Issue(ctx, subjectId, tokens.Administrator)
+
+	assert.NoError(t, err)
+	assert.Equal(t, TokenType, pair.TokenType)
+	assert.Equal(t, 60, pair.ExpiresIn)
+	assert.Len(t, repo.tokens, 2)
+
+	principal, err := svc.Authenticate(ctx, pair.AccessToken)
+
+	assert.NoError(t, err)
+	assert.Equal(t, subjectId, principal.SubjectId)
+	assert.Equal(t, tokens.Administrator, principal.Role)
+
+	_, err = svc.Authenticate(ctx, pair.RefreshToken)
+	assert.ErrorIs(t, err, ErrWrongKindAuth)
+}
+
+func TestService_Authenticate_Invalid(t *testing.T) {
+	ctx := context.Background()
+	svc, _ := newTestService(t)
+
+	pair, err := svc.Issue(ctx, uuid.New(), tokens.Patient)
+	assert.NoError(t, err)
+
+	_, err = svc.Authenticate(ctx, "not-a-token")
+	assert.ErrorIs(t, err, ErrInvalidTokenAuth)
+
+	_, err = svc.Authenticate(ctx, pair.AccessToken+"x")
+	assert.ErrorIs(t, err, ErrInvalidTokenAuth)
+
+	svc.now = func() time.Time { return time.Now().Add(2 * time.Minute) }
+	_, err = svc.Authenticate(ctx, pair.AccessToken)
+	assert.ErrorIs(t, err, tokens.ErrExpiredToken)
+}
+
+func TestService_Authenticate_ForeignSignature(t *testing.T) {
+	ctx := context.Background()
+	svc, repo := newTestService(t)
+
+	id := uuid.New()
+	_, err := repo.Create(ctx, tokens.NewToken(id, uuid.New(), tokens.Administrator, tokens.Access, time.Now().Add(time.Minute)))
+	assert.NoError(t, err)
+
+	forged := jwt.NewWithClaims(jwt.SigningMethodHS256, Claims{
+		Role: string(tokens.Administrator),
+		Kind: string(tokens.Access),
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        id.String(),
+			Issuer:    "tests",
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Minute)),
+		},
+	})
+	forged.Header["kid"] = "one"
+	raw, err := forged.SignedString(secretTwo)
+	assert.NoError(t, err)
+
+	_, err = svc.Authenticate(ctx, raw)
+	assert.ErrorIs(t, err, ErrInvalidTokenAuth)
+}
+
+func TestService_KeyRotation(t *testing.T) {
+	ctx := context.Background()
+	svc, _ := newTestService(t)
+
+	old, err := svc.Issue(ctx, uuid.New(), tokens.Patient)
+	assert.NoError(t, err)
+
+	assert.NoError(t, svc.Keys().Rotate("two", secretTwo))
+
+	fresh, err := svc.Issue(ctx, uuid.New(), tokens.Patient)
+	assert.NoError(t, err)
+
+	_, err = svc.Authenticate(ctx, old.AccessToken)
+	assert.NoError(t, err)
+	_, err = svc.Authenticate(ctx, fresh.AccessToken)
+	assert.NoError(t, err)
+
+	assert.NoError(t, svc.Keys().Retire("one"))
+
+	_, err = svc.Authenticate(ctx, old.AccessToken)
+	assert.ErrorIs(t, err, ErrUnknownKeyAuth)
+	_, err = svc.Authenticate(ctx, fresh.AccessToken)
+	assert.NoError(t, err)
+}
+
+func TestService_Refresh(t *testing.T) {
+	ctx := context.Background()
+	svc, _ := newTestService(t)
+	subjectId := uuid.New()
+
+	pair, err := svc.Issue(ctx, subjectId, tokens.Patient)
+	assert.NoError(t, err)
+
+	rotated, err := svc.Refresh(ctx, pair.RefreshToken)
+
+	assert.NoError(t, err)
+	assert.NotEqual(t, pair.RefreshToken, rotated.RefreshToken)
+
+	principal, err := svc.Authenticate(ctx, rotated.AccessToken)
+	assert.NoError(t, err)
+	assert.Equal(t, subjectId, principal.SubjectId)
+	assert.Equal(t, tokens.Patient, principal.Role)
+
+	_, err = svc.Refresh(ctx, pair.AccessToken)
+	assert.ErrorIs(t, err, ErrWrongKindAuth)
+}
+
+func TestService_Refresh_ReuseRevokesSubject(t *testing.T) {
+	ctx := context.Background()
+	svc, _ := newTestService(t)
+
+	pair, err := svc.Issue(ctx, uuid.New(), tokens.Patient)
+	assert.NoError(t, err)
+
+	rotated, err := svc.Refresh(ctx, pair.RefreshToken)
+	assert.NoError(t, err)
+
+	_, err = svc.Refresh(ctx, pair.RefreshToken)
+	assert.ErrorIs(t, err, tokens.ErrRevokedToken)
+
+	_, err = svc.Authenticate(ctx, rotated.AccessToken)
+	assert.ErrorIs(t, err, tokens.ErrRevokedToken)
+	_, err = svc.Refresh(ctx, rotated.RefreshToken)
+	assert.ErrorIs(t, err, tokens.ErrRevokedToken)
+}
+
+func TestService_Logout(t *testing.T) {
+	ctx := context.Background()
+	svc, _ := newTestService(t)
+	subjectId := uuid.New()
+
+	pair, err := svc.Issue(ctx, subjectId, tokens.Administrator)
+	assert.NoError(t, err)
+	other, err := svc.Issue(ctx, subjectId, tokens.Administrator)
+	assert.NoError(t, err)
+
+	principal, err := svc.Authenticate(ctx, pair.AccessToken)
+	assert.NoError(t, err)
+
+	assert.NoError(t, svc.Logout(ctx, principal, pair.RefreshToken))
+
+	_, err = svc.Authenticate(ctx, pair.AccessToken)
+	assert.ErrorIs(t, err, tokens.ErrRevokedToken)
+	_, err = svc.Refresh(ctx, pair.RefreshToken)
+	assert.ErrorIs(t, err, tokens.ErrRevokedToken)
+
+	_, err = svc.Authenticate(ctx, other.AccessToken)
+	assert.ErrorIs(t, err, tokens.ErrRevokedToken, "reusing a revoked refresh token revokes the whole subject")
+}
+
+func TestService_Logout_ForeignRefreshToken(t *testing.T) {
+	ctx := context.Background()
+	svc, _ := newTestService(t)
+
+	mine, err := svc.Issue(ctx, uuid.New(), tokens.Patient)
+	assert.NoError(t, err)
+	theirs, err := svc.Issue(ctx, uuid.New(), tokens.Patient)
+	assert.NoError(t, err)
+
+	principal, err := svc.Authenticate(ctx, mine.AccessToken)
+	assert.NoError(t, err)
+
+	assert.ErrorIs(t, svc.Logout(ctx, principal, theirs.RefreshToken), ErrSubjectAuth)
+
+	_, err = svc.Refresh(ctx, theirs.RefreshToken)
+	assert.NoError(t, err)
+}
+
+func TestService_LogoutAll(t *testing.T) {
+	ctx := context.Background()
+	svc, _ := newTestService(t)
+	subjectId := uuid.New()
+
+	first, err := svc.Issue(ctx, subjectId, tokens.Patient)
+	assert.NoError(t, err)
+	second, err := svc.Issue(ctx, subjectId, tokens.Patient)
+	assert.NoError(t, err)
+
+	principal, err := svc.Authenticate(ctx, first.AccessToken)
+	assert.NoError(t, err)
+
+	n, err := svc.LogoutAll(ctx, principal)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 4, n)
+	_, err = svc.Authenticate(ctx, second.AccessToken)
+	assert.ErrorIs(t, err, tokens.ErrRevokedToken)
+}
@@ -0,0 +1,41 @@
+package billing
+
+import (
+	"fmt"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/invoice"
+	"log"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+)
+
+func LoadCancellationFee() invoices.CancellationFee {
+	fee, err := parseCancellationFee(os.Getenv("CANCELLATION_FEE_PERCENT"), os.Getenv("CANCELLATION_FEE_FIXED"))
+	if err != nil {
+		log.Printf("[billing:cancellation][LoadCancellationFee] ignoring cancellation fee: %v", err)
+		return invoices.CancellationFee{}
+	}
+	return fee
+}
+
+func parseCancellationFee(percent, fixed string) (invoices.CancellationFee, error) {
+	var rate, amount int
+	if percent = strings.TrimSpace(percent); percent != "" {
+		p, err := strconv.ParseFloat(percent, 64)
+		if err != nil {
+			return invoices.CancellationFee{}, fmt.Errorf("%w: got %s", invoices.ErrRateCancellationFee, percent)
+		}
+		rate = int(math.Round(p * 100))
+	}
+
+	if fixed = strings.TrimSpace(fixed); fixed != "" {
+		f, err := strconv.Atoi(fixed)
+		if err != nil {
+			return invoices.CancellationFee{}, fmt.Errorf("%w: got %s", invoices.ErrFixedCancellationFee, fixed)
+		}
+		amount = f
+	}
+
+	return invoices.NewCancellationFee(rate, amount)
+}
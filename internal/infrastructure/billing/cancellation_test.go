@@ -0,0 +1,38 @@
+package billing
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestLoadCancellationFee(t *testing.T) {
+	cases := []struct {
+		name    string
+		percent string
+		fixed   string
+		rate    int
+		amount  int
+	}{
+		{"unset", "", "", 0, 0},
+		{"percent", "10", "", 1000, 0},
+		{"fractional percent", " 12.5 ", "", 1250, 0},
+		{"fixed", "", "5000", 0, 5000},
+		{"both", "10", "5000", 1000, 5000},
+		{"malformed percent", "ten", "5000", 0, 0},
+		{"malformed fixed", "10", "50.00", 0, 0},
+		{"percent out of range", "150", "", 0, 0},
+		{"negative fixed", "", "-1", 0, 0},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Setenv("CANCELLATION_FEE_PERCENT", tc.percent)
+			t.Setenv("CANCELLATION_FEE_FIXED", tc.fixed)
+
+			fee := LoadCancellationFee()
+
+			assert.Equal(t, tc.rate, fee.Rate())
+			assert.Equal(t, tc.amount, fee.Fixed())
+		})
+	}
+}
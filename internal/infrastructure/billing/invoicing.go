@@ -0,0 +1,29 @@
+package billing
+
+import (
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/invoice"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+)
+
+const DefaultInvoiceSeries = "NC"
+
+func LoadInvoiceSeries() string {
+	series := strings.ToUpper(strings.TrimSpace(os.Getenv("INVOICE_SERIES")))
+	if series == "" {
+		return DefaultInvoiceSeries
+	}
+
+	if !invoices.IsValidSeries(series) {
+		log.Printf("[billing:invoicing][LoadInvoiceSeries] ignoring INVOICE_SERIES '%s': %v", series, invoices.ErrSeriesInvoice)
+		return DefaultInvoiceSeries
+	}
+	return series
+}
+
+func LoadRequirePayment() bool {
+	v, err := strconv.ParseBool(os.Getenv("REQUIRE_PAYMENT_BEFORE_ACTIVATION"))
+	return err == nil && v
+}
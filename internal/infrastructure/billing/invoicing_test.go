@@ -0,0 +1,28 @@
+package billing
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestLoadInvoiceSeries(t *testing.T) {
+	t.Setenv("INVOICE_SERIES", "")
+	assert.Equal(t, DefaultInvoiceSeries, LoadInvoiceSeries())
+
+	t.Setenv("INVOICE_SERIES", " scz ")
+	assert.Equal(t, "SCZ", LoadInvoiceSeries())
+
+	t.Setenv("INVOICE_SERIES", "SANTA-CRUZ")
+	assert.Equal(t, DefaultInvoiceSeries, LoadInvoiceSeries())
+}
+
+func TestLoadRequirePayment(t *testing.T) {
+	t.Setenv("REQUIRE_PAYMENT_BEFORE_ACTIVATION", "")
+	assert.False(t, LoadRequirePayment())
+
+	t.Setenv("REQUIRE_PAYMENT_BEFORE_ACTIVATION", "true")
+	assert.True(t, LoadRequirePayment())
+
+	t.Setenv("REQUIRE_PAYMENT_BEFORE_ACTIVATION", "yes")
+	assert.False(t, LoadRequirePayment())
+}
@@ -0,0 +1,67 @@
+package billing
+
+import (
+	"context"
+	"fmt"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/invoice"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	"github.com/google/uuid"
+	"log"
+	"os"
+	"sync"
+)
+
+type FakeProvider struct {
+	mu         sync.Mutex
+	declined   map[int]bool
+	references map[string]string
+}
+
+func NewFakeProvider() *FakeProvider {
+	return &FakeProvider{declined: make(map[int]bool), references: make(map[string]string)}
+}
+
+func (p *FakeProvider) Decline(amount int) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.declined[amount] = true
+}
+
+func (p *FakeProvider) Name() string {
+	return "fake"
+}
+
+func (p *FakeProvider) Charge(_ context.Context, invoice *invoices.Invoice, amount valueobjects.Money, idempotencyKey string) (string, error) {
+	return p.transact("Charge", invoice, amount, idempotencyKey)
+}
+
+func (p *FakeProvider) Refund(_ context.Context, invoice *invoices.Invoice, amount valueobjects.Money, idempotencyKey string) (string, error) {
+	return p.transact("Refund", invoice, amount, idempotencyKey)
+}
+
+func (p *FakeProvider) transact(method string, invoice *invoices.Invoice, amount valueobjects.Money, idempotencyKey string) (string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if reference, ok := p.references[idempotencyKey]; ok {
+		log.Printf("[billing:fake][%s] replayed '%s' on invoice '%s' as '%s'", method, idempotencyKey, invoice.Number(), reference)
+		return reference, nil
+	}
+
+	if p.declined[amount.Amount()] {
+		log.Printf("[billing:fake][%s] declined %s on invoice '%s'", method, amount, invoice.Number())
+		return "", fmt.Errorf("%w: %s on %s", invoices.ErrDeclinedPayment, amount, invoice.Number())
+	}
+
+	reference := "fake_" + uuid.NewString()
+	p.references[idempotencyKey] = reference
+	log.Printf("[billing:fake][%s] %s on invoice '%s' as '%s'", method, amount, invoice.Number(), reference)
+	return reference, nil
+}
+
+func LoadPaymentProvider() invoices.PaymentProvider {
+	if name := os.Getenv("PAYMENT_PROVIDER"); name != "" && name != "fake" {
+		log.Printf("[billing:provider][LoadPaymentProvider] unknown provider '%s', using the fake one", name)
+	}
+	return NewFakeProvider()
+}
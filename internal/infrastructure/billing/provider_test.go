@@ -0,0 +1,49 @@
+package billing
+
+import (
+	"context"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/invoice"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestFakeProvider(t *testing.T) {
+	provider := NewFakeProvider()
+	amount, _ := valueobjects.NewMoney(45000, valueobjects.BOB)
+	invoice := invoices.NewInvoice("NC", 1, uuid.New(), uuid.New(), amount, time.Now())
+
+	assert.Equal(t, "fake", provider.Name())
+
+	charge, err := provider.Charge(context.Background(), invoice, amount, "key_1")
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(charge, "fake_"))
+
+	refund, err := provider.Refund(context.Background(), invoice, amount, "key_2")
+	assert.NoError(t, err)
+	assert.NotEqual(t, charge, refund)
+
+	provider.Decline(45000)
+
+	replayed, err := provider.Charge(context.Background(), invoice, amount, "key_1")
+	assert.NoError(t, err)
+	assert.Equal(t, charge, replayed)
+
+	reference, err := provider.Charge(context.Background(), invoice, amount, "key_3")
+	assert.ErrorIs(t, err, invoices.ErrDeclinedPayment)
+	assert.Empty(t, reference)
+
+	_, err = provider.Refund(context.Background(), invoice, amount, "key_4")
+	assert.ErrorIs(t, err, invoices.ErrDeclinedPayment)
+}
+
+func TestLoadPaymentProvider(t *testing.T) {
+	t.Setenv("PAYMENT_PROVIDER", "")
+	assert.Equal(t, "fake", LoadPaymentProvider().Name())
+
+	t.Setenv("PAYMENT_PROVIDER", "stripe")
+	assert.Equal(t, "fake", LoadPaymentProvider().Name())
+}
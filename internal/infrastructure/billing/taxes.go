@@ -0,0 +1,16 @@
+package billing
+
+import (
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/pricing"
+	"log"
+	"os"
+)
+
+func LoadTaxRates() []pricing.TaxRate {
+	rates, err := pricing.ParseTaxRates(os.Getenv("PRICING_TAXES"))
+	if err != nil {
+		log.Printf("[billing:taxes][LoadTaxRates] ignoring PRICING_TAXES: %v", err)
+		return nil
+	}
+	return rates
+}
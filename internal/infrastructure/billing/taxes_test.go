@@ -0,0 +1,22 @@
+package billing
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestLoadTaxRates(t *testing.T) {
+	t.Setenv("PRICING_TAXES", "")
+	assert.Empty(t, LoadTaxRates())
+
+	t.Setenv("PRICING_TAXES", "IVA:13, IT:3")
+	rates := LoadTaxRates()
+	assert.Len(t, rates, 2)
+	assert.Equal(t, "IVA", rates[0].Name())
+	assert.Equal(t, 1300, rates[0].Rate())
+	assert.Equal(t, "IT", rates[1].Name())
+	assert.Equal(t, 300, rates[1].Rate())
+
+	t.Setenv("PRICING_TAXES", "IVA=13")
+	assert.Empty(t, LoadTaxRates())
+}
@@ -0,0 +1,50 @@
+package dispatch
+
+import (
+	"encoding/csv"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/dispatch/dto"
+	"io"
+	"strconv"
+)
+
+var ManifestHeader = []string{
+	"date", "driver", "trip", "zone", "slot", "slot_start", "slot_end", "sequence", "delivery_id", "contract_id",
+	"street", "number", "latitude", "longitude", "distance_m",
+}
+
+func WriteManifestsCSV(w io.Writer, manifests []*dto.ManifestDTO) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(ManifestHeader); err != nil {
+		return err
+	}
+
+	for _, m := range manifests {
+		for _, r := range m.Routes {
+			for _, s := range r.Stops {
+				record := []string{
+					m.Date,
+					strconv.Itoa(m.Driver),
+					strconv.Itoa(r.Trip),
+					r.Zone,
+					r.Slot,
+					r.Start,
+					r.End,
+					strconv.Itoa(s.Sequence),
+					s.DeliveryId,
+					s.ContractId,
+					s.Street,
+					strconv.Itoa(s.Number),
+					strconv.FormatFloat(s.Latitude, 'f', -1, 64),
+					strconv.FormatFloat(s.Longitude, 'f', -1, 64),
+					strconv.Itoa(s.Distance),
+				}
+				if err := cw.Write(record); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
@@ -0,0 +1,64 @@
+package dispatch
+
+import (
+	"bytes"
+	"errors"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/dispatch/dto"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestWriteManifestsCSV(t *testing.T) {
+	manifests := []*dto.ManifestDTO{
+		{Driver: 1, Date: "2025-10-20", Routes: []*dto.RouteDTO{{
+			Trip: 1,
+			Zone: "Downtown",
+			Stops: []*dto.StopDTO{
+				{Sequence: 1, DeliveryId: "d1", ContractId: "c1", Street: "Sesame Street", Number: 30, Latitude: -17.785, Longitude: -63.18, Distance: 201},
+				{Sequence: 2, DeliveryId: "d2", ContractId: "c2", Street: "Elm Street, North", Number: 13, Latitude: -17.79, Longitude: -63.185, Distance: 720},
+			},
+		}, {
+			Trip:  2,
+			Zone:  "Downtown",
+			Slot:  "Dinner",
+			Start: "19:00",
+			End:   "21:00",
+			Stops: []*dto.StopDTO{
+				{Sequence: 1, DeliveryId: "d3", ContractId: "c3", Street: "Sesame Street", Number: 31, Latitude: -17.786, Longitude: -63.18, Distance: 190},
+			},
+		}}},
+		{Driver: 2, Date: "2025-10-20", Routes: []*dto.RouteDTO{}},
+	}
+
+	var buf bytes.Buffer
+	err := WriteManifestsCSV(&buf, manifests)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "date,driver,trip,zone,slot,slot_start,slot_end,sequence,delivery_id,contract_id,street,number,latitude,longitude,distance_m\n"+
+		"2025-10-20,1,1,Downtown,,,,1,d1,c1,Sesame Street,30,-17.785,-63.18,201\n"+
+		"2025-10-20,1,1,Downtown,,,,2,d2,c2,\"Elm Street, North\",13,-17.79,-63.185,720\n"+
+		"2025-10-20,1,2,Downtown,Dinner,19:00,21:00,1,d3,c3,Sesame Street,31,-17.786,-63.18,190\n", buf.String())
+}
+
+func TestWriteManifestsCSV_Empty(t *testing.T) {
+	var buf bytes.Buffer
+
+	err := WriteManifestsCSV(&buf, nil)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "date,driver,trip,zone,slot,slot_start,slot_end,sequence,delivery_id,contract_id,street,number,latitude,longitude,distance_m\n", buf.String())
+}
+
+type failingWriter struct{}
+
+var errWrite = errors.New("disk full")
+
+func (failingWriter) Write([]byte) (int, error) {
+	return 0, errWrite
+}
+
+func TestWriteManifestsCSV_WriteError(t *testing.T) {
+	err := WriteManifestsCSV(failingWriter{}, nil)
+
+	assert.ErrorIs(t, err, errWrite)
+}
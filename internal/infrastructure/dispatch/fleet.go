@@ -0,0 +1,66 @@
+package dispatch
+
+import (
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/route"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+)
+
+const (
+	DefaultDrivers        = 1
+	DefaultCapacity       = 30
+	DefaultDepotLatitude  = -17.7863
+	DefaultDepotLongitude = -63.1812
+)
+
+func LoadFleet() routes.Fleet {
+	drivers := loadInt("DISPATCH_DRIVERS", DefaultDrivers)
+	capacity := loadInt("DISPATCH_VEHICLE_CAPACITY", DefaultCapacity)
+
+	depot, err := valueobjects.NewCoordinates(
+		loadFloat("DISPATCH_DEPOT_LATITUDE", DefaultDepotLatitude),
+		loadFloat("DISPATCH_DEPOT_LONGITUDE", DefaultDepotLongitude),
+	)
+	if err != nil {
+		log.Printf("[dispatch:fleet][LoadFleet] ignoring depot: %v", err)
+		depot, _ = valueobjects.NewCoordinates(DefaultDepotLatitude, DefaultDepotLongitude)
+	}
+
+	fleet, err := routes.NewFleet(drivers, capacity, depot)
+	if err != nil {
+		log.Printf("[dispatch:fleet][LoadFleet] ignoring fleet: %v", err)
+		fleet, _ = routes.NewFleet(DefaultDrivers, DefaultCapacity, depot)
+	}
+	return fleet
+}
+
+func loadInt(name string, fallback int) int {
+	value := strings.TrimSpace(os.Getenv(name))
+	if value == "" {
+		return fallback
+	}
+
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		log.Printf("[dispatch:fleet][loadInt] ignoring %s '%s': %v", name, value, err)
+		return fallback
+	}
+	return n
+}
+
+func loadFloat(name string, fallback float64) float64 {
+	value := strings.TrimSpace(os.Getenv(name))
+	if value == "" {
+		return fallback
+	}
+
+	f, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		log.Printf("[dispatch:fleet][loadFloat] ignoring %s '%s': %v", name, value, err)
+		return fallback
+	}
+	return f
+}
@@ -0,0 +1,41 @@
+package dispatch
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestLoadFleet(t *testing.T) {
+	cases := []struct {
+		name      string
+		env       [4]string
+		drivers   int
+		capacity  int
+		latitude  float64
+		longitude float64
+	}{
+		{"unset", [4]string{"", "", "", ""}, 1, 30, -17.7863, -63.1812},
+		{"set", [4]string{"4", " 12 ", "-16.5", "-68.15"}, 4, 12, -16.5, -68.15},
+		{"malformed drivers", [4]string{"four", "12", "", ""}, 1, 12, -17.7863, -63.1812},
+		{"malformed latitude", [4]string{"4", "12", "south", "-68.15"}, 4, 12, -17.7863, -68.15},
+		{"non positive drivers", [4]string{"0", "12", "", ""}, 1, 30, -17.7863, -63.1812},
+		{"negative capacity", [4]string{"4", "-3", "", ""}, 1, 30, -17.7863, -63.1812},
+		{"latitude out of range", [4]string{"4", "12", "95", "-68.15"}, 4, 12, -17.7863, -63.1812},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Setenv("DISPATCH_DRIVERS", tc.env[0])
+			t.Setenv("DISPATCH_VEHICLE_CAPACITY", tc.env[1])
+			t.Setenv("DISPATCH_DEPOT_LATITUDE", tc.env[2])
+			t.Setenv("DISPATCH_DEPOT_LONGITUDE", tc.env[3])
+
+			fleet := LoadFleet()
+
+			assert.Equal(t, tc.drivers, fleet.Drivers())
+			assert.Equal(t, tc.capacity, fleet.Capacity())
+			assert.Equal(t, tc.latitude, fleet.Depot().Latitude())
+			assert.Equal(t, tc.longitude, fleet.Depot().Longitude())
+		})
+	}
+}
@@ -0,0 +1,14 @@
+package dispatch
+
+import "log"
+
+const DefaultHandOffTolerance = 150
+
+func LoadHandOffTolerance() int {
+	tolerance := loadInt("DISPATCH_HANDOFF_TOLERANCE", DefaultHandOffTolerance)
+	if tolerance <= 0 {
+		log.Printf("[dispatch:handoff][LoadHandOffTolerance] ignoring non positive tolerance %d", tolerance)
+		return DefaultHandOffTolerance
+	}
+	return tolerance
+}
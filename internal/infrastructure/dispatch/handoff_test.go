@@ -0,0 +1,28 @@
+package dispatch
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestLoadHandOffTolerance(t *testing.T) {
+	cases := []struct {
+		name      string
+		env       string
+		tolerance int
+	}{
+		{"unset", "", 150},
+		{"set", " 300 ", 300},
+		{"malformed", "far", 150},
+		{"zero", "0", 150},
+		{"negative", "-20", 150},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Setenv("DISPATCH_HANDOFF_TOLERANCE", tc.env)
+
+			assert.Equal(t, tc.tolerance, LoadHandOffTolerance())
+		})
+	}
+}
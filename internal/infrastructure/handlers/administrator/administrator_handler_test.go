@@ -3,6 +3,7 @@ package handlers
 import (
 	"context"
 	"errors"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/abstractions"
 	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/administrator"
 	vo "github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
 	"github.com/google/uuid"
@@ -35,14 +36,24 @@ func (m *MockFactory) Create(firstName, lastName string, email vo.Email, passwor
 	return nil, args.Error(1)
 }
 
-func (m *MockRepository) GetAll(ctx context.Context) ([]*administrators.Administrator, error) {
-	args := m.Called(ctx)
-	return args.Get(0).([]*administrators.Administrator), args.Error(1)
+func (m *MockRepository) GetAll(ctx context.Context, filter administrators.AdministratorFilter, pagination abstractions.Pagination) (*abstractions.Page[*administrators.Administrator], error) {
+	args := m.Called(ctx, filter, pagination)
+
+	var result *abstractions.Page[*administrators.Administrator]
+	if r := args.Get(0); r != nil {
+		result = r.(*abstractions.Page[*administrators.Administrator])
+	}
+	return result, args.Error(1)
 }
 
-func (m *MockRepository) GetList(ctx context.Context) ([]*administrators.Administrator, error) {
-	args := m.Called(ctx)
-	return args.Get(0).([]*administrators.Administrator), args.Error(1)
+func (m *MockRepository) GetList(ctx context.Context, filter administrators.AdministratorFilter, pagination abstractions.Pagination) (*abstractions.Page[*administrators.Administrator], error) {
+	args := m.Called(ctx, filter, pagination)
+
+	var result *abstractions.Page[*administrators.Administrator]
+	if r := args.Get(0); r != nil {
+		result = r.(*abstractions.Page[*administrators.Administrator])
+	}
+	return result, args.Error(1)
 }
 
 func (m *MockRepository) GetById(ctx context.Context, id uuid.UUID) (*administrators.Administrator, error) {
@@ -78,6 +89,15 @@ func (m *MockRepository) ExistByEmail(ctx context.Context, email string) (bool,
 	return v.(bool), args.Error(1)
 }
 
+func (m *MockRepository) IsSuper(ctx context.Context, id uuid.UUID) (bool, error) {
+	args := m.Called(ctx, id)
+	v := args.Get(0)
+	if v == nil {
+		return false, args.Error(1)
+	}
+	return v.(bool), args.Error(1)
+}
+
 func (m *MockRepository) Create(ctx context.Context, administrator *administrators.Administrator) (*administrators.Administrator, error) {
 	return nil, nil
 }
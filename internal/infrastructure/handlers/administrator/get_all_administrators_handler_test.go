@@ -4,7 +4,9 @@ import (
 	"context"
 	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/administrator/dto"
 	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/administrator/queries"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/abstractions"
 	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/administrator"
+	vo "github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"testing"
@@ -36,7 +38,7 @@ func TestAdministratorHandler_HandleGetAll(t *testing.T) {
 		admins = append(admins, admin)
 	}
 
-	mockRepo.On("GetAll", mock.Anything).Return(admins, nil)
+	mockRepo.On("GetAll", mock.Anything, administrators.AdministratorFilter{}, abstractions.Pagination{}).Return(abstractions.NewPage(admins, len(admins), abstractions.Pagination{}), nil)
 
 	cmd := queries.GetAllAdministratorsQuery{}
 
@@ -44,12 +46,13 @@ func TestAdministratorHandler_HandleGetAll(t *testing.T) {
 
 	assert.NoError(t, err)
 	assert.NotNil(t, resp)
-	assert.IsType(t, []*dto.AdministratorDTO{}, resp)
-	assert.Len(t, resp, len(cases))
+	assert.IsType(t, &abstractions.Page[*dto.AdministratorDTO]{}, resp)
+	assert.Len(t, resp.Items, len(cases))
+	assert.Equal(t, len(cases), resp.Total)
 
 	for i, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
-			got := resp[i]
+			got := resp.Items[i]
 			assert.NotNil(t, got.Id)
 			assert.Equal(t, tc.firstName, got.FirstName)
 			assert.Equal(t, tc.lastName, got.LastName)
@@ -73,7 +76,7 @@ func TestHandleGetAll_Error(t *testing.T) {
 
 	assert.NotNil(t, handler)
 
-	mockRepo.On("GetAll", mock.Anything).Return([]*administrators.Administrator(nil), ErrDbConnectionAdministrator)
+	mockRepo.On("GetAll", mock.Anything, mock.Anything, mock.Anything).Return(nil, ErrDbConnectionAdministrator)
 
 	cmd := queries.GetAllAdministratorsQuery{}
 
@@ -85,3 +88,42 @@ func TestHandleGetAll_Error(t *testing.T) {
 	mockRepo.AssertExpectations(t)
 	mockFactory.AssertExpectations(t)
 }
+
+func TestAdministratorHandler_HandleGetAll_Filters(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(MockRepository)
+	mockFactory := new(MockFactory)
+	handler := NewAdministratorHandler(mockRepo, mockFactory)
+
+	deleted := true
+	female := vo.Female
+	pagination := abstractions.Pagination{Page: 2, Limit: 5, Sort: "last_name", Order: abstractions.Descending}
+	filter := administrators.AdministratorFilter{Name: "doe", Gender: &female, Deleted: &deleted}
+
+	mockRepo.On("GetAll", mock.Anything, filter, pagination).Return(abstractions.NewPage([]*administrators.Administrator{}, 6, pagination), nil)
+
+	qry := queries.GetAllAdministratorsQuery{Name: "doe", Gender: "female", Deleted: &deleted, Pagination: pagination}
+
+	resp, err := handler.HandleGetAll(ctx, qry)
+
+	assert.NoError(t, err)
+	assert.Empty(t, resp.Items)
+	assert.Equal(t, 6, resp.Total)
+	assert.Equal(t, 2, resp.Number())
+
+	mockRepo.AssertExpectations(t)
+}
+
+func TestAdministratorHandler_HandleGetAll_InvalidGender(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(MockRepository)
+	mockFactory := new(MockFactory)
+	handler := NewAdministratorHandler(mockRepo, mockFactory)
+
+	resp, err := handler.HandleGetAll(ctx, queries.GetAllAdministratorsQuery{Gender: "robot"})
+
+	assert.ErrorIs(t, err, vo.ErrNotAGender)
+	assert.Nil(t, resp)
+
+	mockRepo.AssertNotCalled(t, "GetAll", mock.Anything, mock.Anything, mock.Anything)
+}
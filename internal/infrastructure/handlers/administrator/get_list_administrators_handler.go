@@ -5,21 +5,31 @@ import (
 	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/administrator/dto"
 	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/administrator/mappers"
 	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/administrator/queries"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/abstractions"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/administrator"
+	vo "github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
 	"log"
 )
 
-func (h *AdministratorHandler) HandleGetList(ctx context.Context, qry queries.GetListAdministratorsQuery) ([]*dto.AdministratorDTO, error) {
-	administrators, err := h.repository.GetList(ctx)
+func (h *AdministratorHandler) HandleGetList(ctx context.Context, qry queries.GetListAdministratorsQuery) (*abstractions.Page[*dto.AdministratorDTO], error) {
+	filter := administrators.AdministratorFilter{
+		Name: qry.Name,
+	}
+
+	if qry.Gender != "" {
+		gender, err := vo.ParseGender(qry.Gender)
+		if err != nil {
+			log.Printf("[handler:administrator][HandleGetList] invalid gender filter: %v", err)
+			return nil, err
+		}
+		filter.Gender = &gender
+	}
+
+	page, err := h.repository.GetList(ctx, filter, qry.Pagination)
 	if err != nil {
 		log.Printf("[handler:administrator][HandleGetList] error getting administrators list: %v", err)
 		return nil, err
 	}
 
-	var adminsDTO []*dto.AdministratorDTO
-	for _, admin := range administrators {
-		adminDTO := mappers.MapToAdministratorDTO(admin)
-		adminsDTO = append(adminsDTO, adminDTO)
-	}
-
-	return adminsDTO, nil
+	return abstractions.MapPage(page, mappers.MapToAdministratorDTO), nil
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/administrator/dto"
 	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/administrator/queries"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/abstractions"
 	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/administrator"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -38,18 +39,19 @@ func TestAdministratorHandler_HandleGetList(t *testing.T) {
 		admins = append(admins, admin)
 	}
 
-	mockRepo.On("GetList", mock.Anything).Return(admins, nil)
+	mockRepo.On("GetList", mock.Anything, administrators.AdministratorFilter{}, abstractions.Pagination{}).Return(abstractions.NewPage(admins, len(admins), abstractions.Pagination{}), nil)
 
 	resp, err := handler.HandleGetList(ctx, cmd)
 
 	assert.NoError(t, err)
 	assert.NotNil(t, resp)
-	assert.IsType(t, []*dto.AdministratorDTO{}, resp)
-	assert.Len(t, resp, len(cases))
+	assert.IsType(t, &abstractions.Page[*dto.AdministratorDTO]{}, resp)
+	assert.Len(t, resp.Items, len(cases))
+	assert.Equal(t, len(cases), resp.Total)
 
 	for i, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
-			got := resp[i]
+			got := resp.Items[i]
 			assert.NotNil(t, got.Id)
 			assert.Equal(t, tc.firstName, got.FirstName)
 			assert.Equal(t, tc.lastName, got.LastName)
@@ -72,7 +74,7 @@ func TestHandleGetList_Error(t *testing.T) {
 
 	assert.NotNil(t, handler)
 
-	mockRepo.On("GetList", mock.Anything).Return([]*administrators.Administrator(nil), ErrDbConnectionAdministrator)
+	mockRepo.On("GetList", mock.Anything, mock.Anything, mock.Anything).Return(nil, ErrDbConnectionAdministrator)
 
 	cmd := queries.GetListAdministratorsQuery{}
 
@@ -0,0 +1,16 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/administrator/queries"
+	"log"
+)
+
+func (h *AdministratorHandler) HandleIsSuper(ctx context.Context, qry queries.IsSuperAdministratorQuery) (bool, error) {
+	super, err := h.repository.IsSuper(ctx, qry.Id)
+	if err != nil {
+		log.Printf("[handler:administrator][HandleIsSuper] error proving if an administrator is super: %v", err)
+		return false, err
+	}
+	return super, nil
+}
@@ -0,0 +1,50 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/administrator/queries"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+)
+
+func TestAdministratorHandler_HandleIsSuper(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(MockRepository)
+	mockFactory := new(MockFactory)
+	handler := NewAdministratorHandler(mockRepo, mockFactory)
+
+	assert.NotNil(t, handler)
+
+	cases := []struct {
+		name               string
+		super, wantResp    bool
+		repoError, wantErr error
+	}{
+		{"super administrator", true, true, nil, nil},
+		{"regular administrator", false, false, nil, nil},
+		{"repository error", false, false, ErrDbConnectionAdministrator, ErrDbConnectionAdministrator},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockRepo.ExpectedCalls = nil
+
+			id := uuid.New()
+			mockRepo.On("IsSuper", mock.Anything, id).Return(tc.super, tc.repoError)
+
+			resp, err := handler.HandleIsSuper(ctx, queries.IsSuperAdministratorQuery{Id: id})
+
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				assert.False(t, resp)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.wantResp, resp)
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
@@ -5,28 +5,47 @@ import (
 	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/contract/dto"
 	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/contract/mappers"
 	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/contract/queries"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/abstractions"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/contract"
 	"log"
 )
 
-func (h *ContractHandler) HandleGetAll(ctx context.Context, qry queries.GetAllContractsQuery) ([]*dto.ContractDTO, error) {
-	var contractsDTO []*dto.ContractDTO
+func (h *ContractHandler) HandleGetAll(ctx context.Context, qry queries.GetAllContractsQuery) (*abstractions.Page[*dto.ContractDTO], error) {
+	filter := contracts.ContractFilter{
+		PatientId:       qry.PatientId,
+		AdministratorId: qry.AdministratorId,
+		From:            qry.From,
+		To:              qry.To,
+	}
 
-	contracts, err := h.repository.GetAll(ctx)
-	if err != nil {
-		log.Printf("[handler:contract][HandleGetAll] error getting all contracts: %v", err)
-		return nil, err
+	if qry.Status != "" {
+		status, err := contracts.ParseContractStatus(qry.Status)
+		if err != nil {
+			log.Printf("[handler:contract][HandleGetAll] invalid status filter: %v", err)
+			return nil, err
+		}
+		filter.Status = &status
 	}
 
-	for i := range contracts {
-		var deliveriesDTO []*dto.DeliveryDTO
-		for _, delivery := range contracts[i].Deliveries() {
-			deliveryDTO := mappers.MapToDeliveryDTO(&delivery)
-			deliveriesDTO = append(deliveriesDTO, deliveryDTO)
+	if qry.Type != "" {
+		contractType, err := contracts.ParseContractType(qry.Type)
+		if err != nil {
+			log.Printf("[handler:contract][HandleGetAll] invalid type filter: %v", err)
+			return nil, err
 		}
+		filter.Type = &contractType
+	}
 
-		contractDTO := mappers.MapToContractDTO(contracts[i])
-		contractsDTO = append(contractsDTO, contractDTO)
+	if filter.From != nil && filter.To != nil && filter.To.Before(*filter.From) {
+		log.Printf("[handler:contract][HandleGetAll] invalid date range %s - %s", filter.From, filter.To)
+		return nil, contracts.ErrDateRangeContract
+	}
+
+	page, err := h.repository.GetAll(ctx, filter, qry.Pagination)
+	if err != nil {
+		log.Printf("[handler:contract][HandleGetAll] error getting all contracts: %v", err)
+		return nil, err
 	}
 
-	return contractsDTO, nil
+	return abstractions.MapPage(page, mappers.MapToContractDTO), nil
 }
@@ -0,0 +1,28 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/contract/dto"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/contract/mappers"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/contract/queries"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/delivery"
+	"log"
+)
+
+func (h *ContractHandler) HandleGetDeliveryById(ctx context.Context, qry queries.GetDeliveryByIdQuery) (*dto.DeliveryResponse, error) {
+	delivery, err := h.repository.GetDeliveriesById(ctx, qry.Id)
+	if err != nil {
+		log.Printf("[handler:contract][HandleGetDeliveryById] error getting delivery by its id: %v", err)
+		return nil, err
+	}
+
+	if delivery.ContractId() != qry.ContractId {
+		log.Printf("[handler:contract][HandleGetDeliveryById] delivery '%s' does not belong to contract '%s'", qry.Id, qry.ContractId)
+		return nil, deliveries.ErrContractMismatchDelivery
+	}
+
+	deliveryDTO := mappers.MapToDeliveryDTO(delivery)
+	deliveryResponse := mappers.MapToDeliveryResposnse(deliveryDTO, delivery.CreatedAt(), delivery.UpdatedAt(), delivery.DeletedAt())
+
+	return deliveryResponse, nil
+}
@@ -0,0 +1,25 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/contract/dto"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/contract/mappers"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/contract/queries"
+	"log"
+)
+
+func (h *ContractHandler) HandleGetListDeliveries(ctx context.Context, qry queries.GetListDeliveriesQuery) ([]*dto.DeliveryDTO, error) {
+	deliveries, err := h.repository.GetAllDeliveries(ctx, qry.ContractId)
+	if err != nil {
+		log.Printf("[handler:contract][HandleGetListDeliveries] error getting deliveries of contract '%s': %v", qry.ContractId, err)
+		return nil, err
+	}
+
+	var deliveriesDTO []*dto.DeliveryDTO
+	for _, delivery := range deliveries {
+		deliveryDTO := mappers.MapToDeliveryDTO(delivery)
+		deliveriesDTO = append(deliveriesDTO, deliveryDTO)
+	}
+
+	return deliveriesDTO, nil
+}
@@ -0,0 +1,15 @@
+package handlers
+
+import (
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/courier"
+)
+
+type CourierHandler struct {
+	repository couriers.CourierRepository
+}
+
+func NewCourierHandler(r couriers.CourierRepository) *CourierHandler {
+	return &CourierHandler{
+		repository: r,
+	}
+}
@@ -0,0 +1,40 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/courier"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+)
+
+type MockRepository struct {
+	mock.Mock
+	couriers.CourierRepository
+}
+
+func TestNewCourierHandler(t *testing.T) {
+	repo := new(MockRepository)
+
+	handler := NewCourierHandler(repo)
+
+	assert.NotNil(t, handler)
+	assert.Equal(t, repo, handler.repository)
+}
+
+func (m *MockRepository) GetAll(ctx context.Context) ([]*couriers.Courier, error) {
+	args := m.Called(ctx)
+	if v := args.Get(0); v != nil {
+		return v.([]*couriers.Courier), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockRepository) GetById(ctx context.Context, id uuid.UUID) (*couriers.Courier, error) {
+	args := m.Called(ctx, id)
+	if v := args.Get(0); v != nil {
+		return v.(*couriers.Courier), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
@@ -0,0 +1,24 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/courier/dto"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/courier/mappers"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/courier/queries"
+	"log"
+)
+
+func (h *CourierHandler) HandleGetAll(ctx context.Context, _ queries.GetAllCouriersQuery) ([]*dto.CourierDTO, error) {
+	list, err := h.repository.GetAll(ctx)
+	if err != nil {
+		log.Printf("[handler:courier][HandleGetAll] error getting couriers: %v", err)
+		return nil, err
+	}
+
+	couriersDTO := make([]*dto.CourierDTO, 0, len(list))
+	for _, c := range list {
+		couriersDTO = append(couriersDTO, mappers.MapToCourierDTO(c))
+	}
+
+	return couriersDTO, nil
+}
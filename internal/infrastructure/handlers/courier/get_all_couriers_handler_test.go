@@ -0,0 +1,39 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/courier/queries"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/contract"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/courier"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+)
+
+func TestCourierHandler_HandleGetAll(t *testing.T) {
+	repo := new(MockRepository)
+	handler := NewCourierHandler(repo)
+	list := []*couriers.Courier{
+		couriers.NewCourier("Ana", nil, contracts.MondayToFriday, 20),
+		couriers.NewCourier("Luis", nil, contracts.EveryDay, 15),
+	}
+
+	repo.On("GetAll", mock.Anything).Return(list, nil).Once()
+
+	result, err := handler.HandleGetAll(context.Background(), queries.GetAllCouriersQuery{})
+
+	assert.NoError(t, err)
+	assert.Len(t, result, 2)
+	assert.Equal(t, "Ana", result[0].Name)
+	assert.Len(t, result[0].Weekdays, 5)
+	assert.Equal(t, 15, result[1].MaxDeliveries)
+
+	dbErr := errors.New("db failure")
+	repo.On("GetAll", mock.Anything).Return(nil, dbErr).Once()
+
+	result, err = handler.HandleGetAll(context.Background(), queries.GetAllCouriersQuery{})
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, dbErr)
+}
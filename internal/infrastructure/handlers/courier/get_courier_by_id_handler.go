@@ -0,0 +1,19 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/courier/dto"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/courier/mappers"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/courier/queries"
+	"log"
+)
+
+func (h *CourierHandler) HandleGetById(ctx context.Context, qry queries.GetCourierByIdQuery) (*dto.CourierDTO, error) {
+	courier, err := h.repository.GetById(ctx, qry.Id)
+	if err != nil {
+		log.Printf("[handler:courier][HandleGetById] error getting courier '%s': %v", qry.Id, err)
+		return nil, err
+	}
+
+	return mappers.MapToCourierDTO(courier), nil
+}
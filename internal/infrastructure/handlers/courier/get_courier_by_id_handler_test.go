@@ -0,0 +1,33 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/courier/queries"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/contract"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/courier"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+)
+
+func TestCourierHandler_HandleGetById(t *testing.T) {
+	repo := new(MockRepository)
+	handler := NewCourierHandler(repo)
+	courier := couriers.NewCourier("Ana", nil, contracts.MondayToFriday, 20)
+	missing := uuid.New()
+
+	repo.On("GetById", mock.Anything, courier.Id()).Return(courier, nil)
+	repo.On("GetById", mock.Anything, missing).Return(nil, couriers.ErrNotFoundCourier)
+
+	result, err := handler.HandleGetById(context.Background(), queries.GetCourierByIdQuery{Id: courier.Id()})
+
+	assert.NoError(t, err)
+	assert.Equal(t, courier.Id().String(), result.Id)
+	assert.Equal(t, 20, result.MaxDeliveries)
+
+	result, err = handler.HandleGetById(context.Background(), queries.GetCourierByIdQuery{Id: missing})
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, couriers.ErrNotFoundCourier)
+}
@@ -0,0 +1,34 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/delivery/dto"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/delivery/mappers"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/delivery/queries"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/delivery"
+	"github.com/google/uuid"
+	"log"
+)
+
+func (h *ProofHandler) HandleGetProof(ctx context.Context, qry queries.GetProofQuery) (*dto.ProofDTO, error) {
+	proof, err := h.proof(ctx, qry.ContractId, qry.DeliveryId)
+	if err != nil {
+		log.Printf("[handler:proof][HandleGetProof] error getting proof of delivery '%s': %v", qry.DeliveryId, err)
+		return nil, err
+	}
+
+	return mappers.MapToProofDTO(proof), nil
+}
+
+func (h *ProofHandler) proof(ctx context.Context, contractId, deliveryId uuid.UUID) (*deliveries.Proof, error) {
+	delivery, err := h.contracts.GetDeliveriesById(ctx, deliveryId)
+	if err != nil {
+		return nil, err
+	}
+
+	if delivery.ContractId() != contractId {
+		return nil, deliveries.ErrContractMismatchDelivery
+	}
+
+	return h.proofs.GetByDelivery(ctx, deliveryId)
+}
@@ -0,0 +1,91 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/delivery/queries"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/delivery"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+)
+
+func TestProofHandler_HandleGetProof(t *testing.T) {
+	contractRepo := new(MockContractRepository)
+	proofRepo := new(MockProofRepository)
+	handler := NewProofHandler(contractRepo, proofRepo, new(MockBlobStore))
+
+	photoKey := "deliveries/photo.jpg"
+	delivery, proof := newProof(t, &photoKey, nil)
+
+	contractRepo.On("GetDeliveriesById", mock.Anything, delivery.Id()).Return(delivery, nil)
+	proofRepo.On("GetByDelivery", mock.Anything, delivery.Id()).Return(proof, nil)
+
+	result, err := handler.HandleGetProof(context.Background(), queries.GetProofQuery{ContractId: delivery.ContractId(), DeliveryId: delivery.Id()})
+
+	assert.NoError(t, err)
+	assert.NotNil(t, result)
+	assert.Equal(t, proof.Id().String(), result.Id)
+	assert.Equal(t, "Ana", result.Recipient)
+	assert.True(t, result.HasPhoto)
+	assert.False(t, result.HasSignature)
+
+	contractRepo.AssertExpectations(t)
+	proofRepo.AssertExpectations(t)
+}
+
+func TestProofHandler_HandleGetProof_Err(t *testing.T) {
+	cases := []struct {
+		name  string
+		setup func(d *deliveries.Delivery, c *MockContractRepository, p *MockProofRepository)
+		qry   func(d *deliveries.Delivery) queries.GetProofQuery
+		err   error
+	}{
+		{
+			name: "Delivery Not Found",
+			setup: func(d *deliveries.Delivery, c *MockContractRepository, _ *MockProofRepository) {
+				c.On("GetDeliveriesById", mock.Anything, d.Id()).Return(nil, deliveries.ErrNotFoundDelivery)
+			},
+			qry: func(d *deliveries.Delivery) queries.GetProofQuery {
+				return queries.GetProofQuery{ContractId: d.ContractId(), DeliveryId: d.Id()}
+			},
+			err: deliveries.ErrNotFoundDelivery,
+		},
+		{
+			name: "Contract Mismatch",
+			setup: func(d *deliveries.Delivery, c *MockContractRepository, _ *MockProofRepository) {
+				c.On("GetDeliveriesById", mock.Anything, d.Id()).Return(d, nil)
+			},
+			qry: func(d *deliveries.Delivery) queries.GetProofQuery {
+				return queries.GetProofQuery{ContractId: uuid.New(), DeliveryId: d.Id()}
+			},
+			err: deliveries.ErrContractMismatchDelivery,
+		},
+		{
+			name: "Proof Not Found",
+			setup: func(d *deliveries.Delivery, c *MockContractRepository, p *MockProofRepository) {
+				c.On("GetDeliveriesById", mock.Anything, d.Id()).Return(d, nil)
+				p.On("GetByDelivery", mock.Anything, d.Id()).Return(nil, deliveries.ErrNotFoundProof)
+			},
+			qry: func(d *deliveries.Delivery) queries.GetProofQuery {
+				return queries.GetProofQuery{ContractId: d.ContractId(), DeliveryId: d.Id()}
+			},
+			err: deliveries.ErrNotFoundProof,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			contractRepo := new(MockContractRepository)
+			proofRepo := new(MockProofRepository)
+			handler := NewProofHandler(contractRepo, proofRepo, new(MockBlobStore))
+			delivery, _ := newProof(t, nil, nil)
+			tc.setup(delivery, contractRepo, proofRepo)
+
+			result, err := handler.HandleGetProof(context.Background(), tc.qry(delivery))
+
+			assert.Nil(t, result)
+			assert.ErrorIs(t, err, tc.err)
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/delivery/queries"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/abstractions"
+	"io"
+	"log"
+	"mime"
+	"path"
+)
+
+func (h *ProofHandler) HandleGetProofImage(ctx context.Context, qry queries.GetProofImageQuery) (io.ReadCloser, string, error) {
+	proof, err := h.proof(ctx, qry.ContractId, qry.DeliveryId)
+	if err != nil {
+		log.Printf("[handler:proof][HandleGetProofImage] error getting proof of delivery '%s': %v", qry.DeliveryId, err)
+		return nil, "", err
+	}
+
+	var key *string
+	switch qry.Image {
+	case queries.PhotoImage:
+		key = proof.PhotoKey()
+	case queries.SignatureImage:
+		key = proof.SignatureKey()
+	}
+
+	if key == nil {
+		log.Printf("[handler:proof][HandleGetProofImage] proof of delivery '%s' has no %s", qry.DeliveryId, qry.Image)
+		return nil, "", fmt.Errorf("%w: %s of delivery %s", abstractions.ErrNotFoundBlob, qry.Image, qry.DeliveryId)
+	}
+
+	blob, err := h.blobs.Get(ctx, *key)
+	if err != nil {
+		log.Printf("[handler:proof][HandleGetProofImage] error opening '%s': %v", *key, err)
+		return nil, "", err
+	}
+
+	return blob, mime.TypeByExtension(path.Ext(*key)), nil
+}
@@ -0,0 +1,100 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/delivery/queries"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/abstractions"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestProofHandler_HandleGetProofImage(t *testing.T) {
+	cases := []struct {
+		name        string
+		image       string
+		key         string
+		contentType string
+	}{
+		{"Photo", queries.PhotoImage, "deliveries/photo.jpg", "image/jpeg"},
+		{"Signature", queries.SignatureImage, "deliveries/signature.png", "image/png"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			contractRepo := new(MockContractRepository)
+			proofRepo := new(MockProofRepository)
+			blobs := new(MockBlobStore)
+			handler := NewProofHandler(contractRepo, proofRepo, blobs)
+
+			delivery, proof := newProof(t, &tc.key, &tc.key)
+
+			contractRepo.On("GetDeliveriesById", mock.Anything, delivery.Id()).Return(delivery, nil)
+			proofRepo.On("GetByDelivery", mock.Anything, delivery.Id()).Return(proof, nil)
+			blobs.On("Get", mock.Anything, tc.key).Return(io.NopCloser(strings.NewReader("image")), nil)
+
+			blob, contentType, err := handler.HandleGetProofImage(context.Background(), queries.GetProofImageQuery{
+				ContractId: delivery.ContractId(),
+				DeliveryId: delivery.Id(),
+				Image:      tc.image,
+			})
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.contentType, contentType)
+			content, _ := io.ReadAll(blob)
+			assert.Equal(t, "image", string(content))
+			blobs.AssertExpectations(t)
+		})
+	}
+}
+
+func TestProofHandler_HandleGetProofImage_Err(t *testing.T) {
+	t.Run("No Image", func(t *testing.T) {
+		contractRepo := new(MockContractRepository)
+		proofRepo := new(MockProofRepository)
+		blobs := new(MockBlobStore)
+		handler := NewProofHandler(contractRepo, proofRepo, blobs)
+
+		photoKey := "deliveries/photo.jpg"
+		delivery, proof := newProof(t, &photoKey, nil)
+
+		contractRepo.On("GetDeliveriesById", mock.Anything, delivery.Id()).Return(delivery, nil)
+		proofRepo.On("GetByDelivery", mock.Anything, delivery.Id()).Return(proof, nil)
+
+		blob, contentType, err := handler.HandleGetProofImage(context.Background(), queries.GetProofImageQuery{
+			ContractId: delivery.ContractId(),
+			DeliveryId: delivery.Id(),
+			Image:      queries.SignatureImage,
+		})
+
+		assert.Nil(t, blob)
+		assert.Empty(t, contentType)
+		assert.ErrorIs(t, err, abstractions.ErrNotFoundBlob)
+		blobs.AssertNotCalled(t, "Get", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Blob Missing", func(t *testing.T) {
+		contractRepo := new(MockContractRepository)
+		proofRepo := new(MockProofRepository)
+		blobs := new(MockBlobStore)
+		handler := NewProofHandler(contractRepo, proofRepo, blobs)
+
+		photoKey := "deliveries/photo.jpg"
+		delivery, proof := newProof(t, &photoKey, nil)
+
+		contractRepo.On("GetDeliveriesById", mock.Anything, delivery.Id()).Return(delivery, nil)
+		proofRepo.On("GetByDelivery", mock.Anything, delivery.Id()).Return(proof, nil)
+		blobs.On("Get", mock.Anything, photoKey).Return(nil, abstractions.ErrNotFoundBlob)
+
+		blob, _, err := handler.HandleGetProofImage(context.Background(), queries.GetProofImageQuery{
+			ContractId: delivery.ContractId(),
+			DeliveryId: delivery.Id(),
+			Image:      queries.PhotoImage,
+		})
+
+		assert.Nil(t, blob)
+		assert.ErrorIs(t, err, abstractions.ErrNotFoundBlob)
+	})
+}
@@ -0,0 +1,21 @@
+package handlers
+
+import (
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/abstractions"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/contract"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/delivery"
+)
+
+type ProofHandler struct {
+	contracts contracts.ContractRepository
+	proofs    deliveries.ProofRepository
+	blobs     abstractions.BlobStore
+}
+
+func NewProofHandler(c contracts.ContractRepository, p deliveries.ProofRepository, b abstractions.BlobStore) *ProofHandler {
+	return &ProofHandler{
+		contracts: c,
+		proofs:    p,
+		blobs:     b,
+	}
+}
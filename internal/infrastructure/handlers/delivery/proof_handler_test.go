@@ -0,0 +1,85 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/contract"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/delivery"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"io"
+	"testing"
+	"time"
+)
+
+type MockContractRepository struct {
+	mock.Mock
+	contracts.ContractRepository
+}
+
+type MockProofRepository struct {
+	mock.Mock
+	deliveries.ProofRepository
+}
+
+type MockBlobStore struct {
+	mock.Mock
+}
+
+func TestNewProofHandler(t *testing.T) {
+	contractRepo := new(MockContractRepository)
+	proofRepo := new(MockProofRepository)
+	blobs := new(MockBlobStore)
+
+	handler := NewProofHandler(contractRepo, proofRepo, blobs)
+
+	assert.NotNil(t, handler)
+	assert.Equal(t, contractRepo, handler.contracts)
+	assert.Equal(t, proofRepo, handler.proofs)
+	assert.Equal(t, blobs, handler.blobs)
+}
+
+func newProof(t *testing.T, photoKey, signatureKey *string) (*deliveries.Delivery, *deliveries.Proof) {
+	address, _ := valueobjects.NewCoordinates(-17.7863, -63.1812)
+	delivery := deliveries.NewDelivery(uuid.New(), time.Now().AddDate(0, 0, 1), "Sesame Street", 30, address)
+
+	proof, err := deliveries.NewProofFromDb(uuid.New(), delivery.Id(), "Ana", time.Now(), -17.7864, -63.1812, 11, false, photoKey, signatureKey, time.Now())
+	assert.NoError(t, err)
+
+	return delivery, proof
+}
+
+func (m *MockContractRepository) GetDeliveriesById(ctx context.Context, id uuid.UUID) (*deliveries.Delivery, error) {
+	args := m.Called(ctx, id)
+	if v := args.Get(0); v != nil {
+		return v.(*deliveries.Delivery), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockProofRepository) GetByDelivery(ctx context.Context, deliveryId uuid.UUID) (*deliveries.Proof, error) {
+	args := m.Called(ctx, deliveryId)
+	if v := args.Get(0); v != nil {
+		return v.(*deliveries.Proof), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockBlobStore) Put(ctx context.Context, key string, r io.Reader) error {
+	args := m.Called(ctx, key, r)
+	return args.Error(0)
+}
+
+func (m *MockBlobStore) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	args := m.Called(ctx, key)
+	if v := args.Get(0); v != nil {
+		return v.(io.ReadCloser), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockBlobStore) Delete(ctx context.Context, key string) error {
+	args := m.Called(ctx, key)
+	return args.Error(0)
+}
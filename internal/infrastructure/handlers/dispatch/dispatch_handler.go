@@ -0,0 +1,24 @@
+package handlers
+
+import (
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/contract"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/pricing"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/route"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/slot"
+)
+
+type DispatchHandler struct {
+	contracts contracts.ContractRepository
+	zones     pricing.ZoneRepository
+	slots     slots.SlotRepository
+	fleet     routes.Fleet
+}
+
+func NewDispatchHandler(c contracts.ContractRepository, z pricing.ZoneRepository, s slots.SlotRepository, f routes.Fleet) *DispatchHandler {
+	return &DispatchHandler{
+		contracts: c,
+		zones:     z,
+		slots:     s,
+		fleet:     f,
+	}
+}
@@ -0,0 +1,83 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/contract"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/delivery"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/pricing"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/route"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/slot"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+type MockContractRepository struct {
+	mock.Mock
+	contracts.ContractRepository
+}
+
+type MockZoneRepository struct {
+	mock.Mock
+	pricing.ZoneRepository
+}
+
+type MockSlotRepository struct {
+	mock.Mock
+	slots.SlotRepository
+}
+
+func newFleet(t *testing.T, drivers, capacity int) routes.Fleet {
+	depot, err := valueobjects.NewCoordinates(-17.7863, -63.1812)
+	assert.NoError(t, err)
+	fleet, err := routes.NewFleet(drivers, capacity, depot)
+	assert.NoError(t, err)
+	return fleet
+}
+
+func TestNewDispatchHandler(t *testing.T) {
+	contractRepo := new(MockContractRepository)
+	zoneRepo := new(MockZoneRepository)
+	slotRepo := new(MockSlotRepository)
+	fleet := newFleet(t, 2, 10)
+
+	handler := NewDispatchHandler(contractRepo, zoneRepo, slotRepo, fleet)
+
+	assert.NotNil(t, handler)
+	assert.Equal(t, contractRepo, handler.contracts)
+	assert.Equal(t, zoneRepo, handler.zones)
+	assert.Equal(t, slotRepo, handler.slots)
+	assert.Equal(t, fleet, handler.fleet)
+}
+
+func (m *MockContractRepository) GetPendingDeliveriesOn(ctx context.Context, day time.Time) ([]*deliveries.Delivery, error) {
+	args := m.Called(ctx, day)
+	if v := args.Get(0); v != nil {
+		return v.([]*deliveries.Delivery), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockZoneRepository) GetAll(ctx context.Context) ([]*pricing.Zone, error) {
+	args := m.Called(ctx)
+	if v := args.Get(0); v != nil {
+		return v.([]*pricing.Zone), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func newSlots() *MockSlotRepository {
+	m := new(MockSlotRepository)
+	m.On("GetAll", mock.Anything).Return([]*slots.Slot{}, nil).Maybe()
+	return m
+}
+
+func (m *MockSlotRepository) GetAll(ctx context.Context) ([]*slots.Slot, error) {
+	args := m.Called(ctx)
+	if v := args.Get(0); v != nil {
+		return v.([]*slots.Slot), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
@@ -0,0 +1,62 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/dispatch/dto"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/dispatch/mappers"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/dispatch/queries"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/route"
+	"log"
+	"time"
+)
+
+func (h *DispatchHandler) HandleGetManifests(ctx context.Context, qry queries.GetManifestsQuery) ([]*dto.ManifestDTO, error) {
+	manifests, err := h.plan(ctx, qry.Date, qry.Drivers, qry.Capacity)
+	if err != nil {
+		log.Printf("[handler:dispatch][HandleGetManifests] error planning deliveries on %s: %v", qry.Date.Format(time.DateOnly), err)
+		return nil, err
+	}
+
+	log.Printf("[handler:dispatch][HandleGetManifests] planned %d manifests on %s", len(manifests), qry.Date.Format(time.DateOnly))
+	return mappers.MapToManifestsDTO(manifests), nil
+}
+
+func (h *DispatchHandler) HandleGetManifest(ctx context.Context, qry queries.GetManifestQuery) (*dto.ManifestDTO, error) {
+	manifests, err := h.plan(ctx, qry.Date, qry.Drivers, qry.Capacity)
+	if err != nil {
+		log.Printf("[handler:dispatch][HandleGetManifest] error planning deliveries on %s: %v", qry.Date.Format(time.DateOnly), err)
+		return nil, err
+	}
+
+	manifest, err := routes.ManifestOf(manifests, qry.Driver)
+	if err != nil {
+		log.Printf("[handler:dispatch][HandleGetManifest] error getting manifest on %s: %v", qry.Date.Format(time.DateOnly), err)
+		return nil, err
+	}
+
+	return mappers.MapToManifestDTO(manifest), nil
+}
+
+func (h *DispatchHandler) plan(ctx context.Context, date time.Time, drivers, capacity int) ([]*routes.Manifest, error) {
+	fleet, err := h.fleet.With(drivers, capacity)
+	if err != nil {
+		return nil, err
+	}
+
+	dlvrs, err := h.contracts.GetPendingDeliveriesOn(ctx, date)
+	if err != nil {
+		return nil, err
+	}
+
+	zones, err := h.zones.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	slotList, err := h.slots.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return routes.Plan(date, dlvrs, zones, slotList, fleet), nil
+}
@@ -0,0 +1,165 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/dispatch/queries"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/delivery"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/pricing"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/route"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/slot"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+var day = time.Date(2025, 10, 20, 0, 0, 0, 0, time.UTC)
+
+func newDeliveries(t *testing.T) []*deliveries.Delivery {
+	var dlvrs []*deliveries.Delivery
+	for i := range 5 {
+		d, err := deliveries.NewDeliveryFromDB(uuid.New(), uuid.New(), day, "Sesame Street", 30+i, -17.7800+float64(i)*0.002, -63.1812, "P", nil, nil, day, day, nil)
+		assert.NoError(t, err)
+		dlvrs = append(dlvrs, d)
+	}
+	return dlvrs
+}
+
+func newZones(t *testing.T) []*pricing.Zone {
+	center, err := valueobjects.NewCoordinates(-17.7863, -63.1812)
+	assert.NoError(t, err)
+	surcharge, err := valueobjects.NewMoney(200, valueobjects.BOB)
+	assert.NoError(t, err)
+	return []*pricing.Zone{pricing.NewZone("Downtown", center, 5000, surcharge)}
+}
+
+func TestDispatchHandler_HandleGetManifests(t *testing.T) {
+	contractRepo := new(MockContractRepository)
+	zoneRepo := new(MockZoneRepository)
+	handler := NewDispatchHandler(contractRepo, zoneRepo, newSlots(), newFleet(t, 1, 10))
+
+	contractRepo.On("GetPendingDeliveriesOn", mock.Anything, day).Return(newDeliveries(t), nil)
+	zoneRepo.On("GetAll", mock.Anything).Return(newZones(t), nil)
+
+	manifests, err := handler.HandleGetManifests(context.Background(), queries.GetManifestsQuery{Date: day, Drivers: 2, Capacity: 2})
+
+	assert.NoError(t, err)
+	assert.Len(t, manifests, 2)
+	assert.Equal(t, "2025-10-20", manifests[0].Date)
+	assert.Equal(t, 5, manifests[0].Stops+manifests[1].Stops)
+	assert.Equal(t, "Downtown", manifests[0].Routes[0].Zone)
+	for _, m := range manifests {
+		for _, r := range m.Routes {
+			assert.LessOrEqual(t, len(r.Stops), 2)
+		}
+	}
+}
+
+func TestDispatchHandler_HandleGetManifests_Slots(t *testing.T) {
+	contractRepo := new(MockContractRepository)
+	zoneRepo := new(MockZoneRepository)
+	slotRepo := new(MockSlotRepository)
+	handler := NewDispatchHandler(contractRepo, zoneRepo, slotRepo, newFleet(t, 1, 10))
+
+	zones := newZones(t)
+	lunch := slots.NewSlot(zones[0].Id(), "Lunch", 11*time.Hour+30*time.Minute, 14*time.Hour, 30)
+	dlvrs := newDeliveries(t)
+	assert.NoError(t, dlvrs[0].Book(lunch))
+	assert.NoError(t, dlvrs[1].Book(lunch))
+
+	contractRepo.On("GetPendingDeliveriesOn", mock.Anything, day).Return(dlvrs, nil)
+	zoneRepo.On("GetAll", mock.Anything).Return(zones, nil)
+	slotRepo.On("GetAll", mock.Anything).Return([]*slots.Slot{lunch}, nil)
+
+	manifests, err := handler.HandleGetManifests(context.Background(), queries.GetManifestsQuery{Date: day})
+
+	assert.NoError(t, err)
+	assert.Len(t, manifests[0].Routes, 2)
+	assert.Equal(t, 1, manifests[0].Routes[0].Trip)
+	assert.Equal(t, "Lunch", manifests[0].Routes[0].Slot)
+	assert.Equal(t, "11:30", manifests[0].Routes[0].Start)
+	assert.Equal(t, "14:00", manifests[0].Routes[0].End)
+	assert.Len(t, manifests[0].Routes[0].Stops, 2)
+	assert.Empty(t, manifests[0].Routes[1].Slot)
+	assert.Len(t, manifests[0].Routes[1].Stops, 3)
+}
+
+func TestDispatchHandler_HandleGetManifests_Errors(t *testing.T) {
+	dbErr := errors.New("db failure")
+
+	t.Run("invalid fleet", func(t *testing.T) {
+		contractRepo := new(MockContractRepository)
+		handler := NewDispatchHandler(contractRepo, new(MockZoneRepository), newSlots(), newFleet(t, 1, 10))
+
+		manifests, err := handler.HandleGetManifests(context.Background(), queries.GetManifestsQuery{Date: day, Capacity: -1})
+
+		assert.Nil(t, manifests)
+		assert.ErrorIs(t, err, routes.ErrCapacityFleet)
+		contractRepo.AssertNotCalled(t, "GetPendingDeliveriesOn", mock.Anything, mock.Anything)
+	})
+
+	t.Run("deliveries", func(t *testing.T) {
+		contractRepo := new(MockContractRepository)
+		handler := NewDispatchHandler(contractRepo, new(MockZoneRepository), newSlots(), newFleet(t, 1, 10))
+
+		contractRepo.On("GetPendingDeliveriesOn", mock.Anything, day).Return(nil, dbErr)
+
+		manifests, err := handler.HandleGetManifests(context.Background(), queries.GetManifestsQuery{Date: day})
+
+		assert.Nil(t, manifests)
+		assert.ErrorIs(t, err, dbErr)
+	})
+
+	t.Run("zones", func(t *testing.T) {
+		contractRepo := new(MockContractRepository)
+		zoneRepo := new(MockZoneRepository)
+		handler := NewDispatchHandler(contractRepo, zoneRepo, newSlots(), newFleet(t, 1, 10))
+
+		contractRepo.On("GetPendingDeliveriesOn", mock.Anything, day).Return(newDeliveries(t), nil)
+		zoneRepo.On("GetAll", mock.Anything).Return(nil, dbErr)
+
+		manifests, err := handler.HandleGetManifests(context.Background(), queries.GetManifestsQuery{Date: day})
+
+		assert.Nil(t, manifests)
+		assert.ErrorIs(t, err, dbErr)
+	})
+
+	t.Run("slots", func(t *testing.T) {
+		contractRepo := new(MockContractRepository)
+		zoneRepo := new(MockZoneRepository)
+		slotRepo := new(MockSlotRepository)
+		handler := NewDispatchHandler(contractRepo, zoneRepo, slotRepo, newFleet(t, 1, 10))
+
+		contractRepo.On("GetPendingDeliveriesOn", mock.Anything, day).Return(newDeliveries(t), nil)
+		zoneRepo.On("GetAll", mock.Anything).Return(newZones(t), nil)
+		slotRepo.On("GetAll", mock.Anything).Return(nil, dbErr)
+
+		manifests, err := handler.HandleGetManifests(context.Background(), queries.GetManifestsQuery{Date: day})
+
+		assert.Nil(t, manifests)
+		assert.ErrorIs(t, err, dbErr)
+	})
+}
+
+func TestDispatchHandler_HandleGetManifest(t *testing.T) {
+	contractRepo := new(MockContractRepository)
+	zoneRepo := new(MockZoneRepository)
+	handler := NewDispatchHandler(contractRepo, zoneRepo, newSlots(), newFleet(t, 3, 10))
+
+	contractRepo.On("GetPendingDeliveriesOn", mock.Anything, day).Return(newDeliveries(t), nil)
+	zoneRepo.On("GetAll", mock.Anything).Return(newZones(t), nil)
+
+	manifest, err := handler.HandleGetManifest(context.Background(), queries.GetManifestQuery{Date: day, Driver: 1})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, manifest.Driver)
+	assert.Equal(t, 5, manifest.Stops)
+
+	manifest, err = handler.HandleGetManifest(context.Background(), queries.GetManifestQuery{Date: day, Driver: 4})
+
+	assert.Nil(t, manifest)
+	assert.ErrorIs(t, err, routes.ErrNotFoundManifest)
+}
@@ -0,0 +1,27 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/holiday/dto"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/holiday/mappers"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/holiday/queries"
+	"log"
+)
+
+func (h *HolidayHandler) HandleGetImpact(ctx context.Context, qry queries.GetHolidayImpactQuery) (*dto.HolidayImpactDTO, error) {
+	holiday, err := h.repository.GetById(ctx, qry.Id)
+	if err != nil {
+		log.Printf("[handler:holiday][HandleGetImpact] error getting holiday '%s': %v", qry.Id, err)
+		return nil, err
+	}
+
+	dlvrs, err := h.contracts.GetPendingDeliveriesOn(ctx, holiday.Date())
+	if err != nil {
+		log.Printf("[handler:holiday][HandleGetImpact] error getting deliveries on %s: %v", holiday.Date(), err)
+		return nil, err
+	}
+
+	impacted := holiday.Impact(dlvrs)
+	log.Printf("[handler:holiday][HandleGetImpact] holiday '%s' falls on %d deliveries", qry.Id, len(impacted))
+	return mappers.MapToHolidayImpactDTO(holiday, impacted), nil
+}
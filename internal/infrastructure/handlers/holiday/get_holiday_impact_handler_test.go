@@ -0,0 +1,78 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/holiday/queries"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/delivery"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/holiday"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+func TestHolidayHandler_HandleGetImpact(t *testing.T) {
+	repo := new(MockRepository)
+	contractRepo := new(MockContractRepository)
+	handler := NewHolidayHandler(repo, contractRepo)
+
+	date := time.Date(2025, 9, 24, 0, 0, 0, 0, time.UTC)
+	center, err := valueobjects.NewCoordinates(-17.7863, -63.1812)
+	assert.NoError(t, err)
+	area, err := holidays.NewArea("Santa Cruz", center, 5000)
+	assert.NoError(t, err)
+	holiday := holidays.NewHoliday(date, "Anniversary", area, holidays.Refuse)
+
+	inside, err := deliveries.NewDeliveryFromDB(uuid.New(), uuid.New(), date, "Sesame Street", 30, -17.7863, -63.1812, "P", nil, nil, date, date, nil)
+	assert.NoError(t, err)
+	outside, err := deliveries.NewDeliveryFromDB(uuid.New(), uuid.New(), date, "Elm Street", 13, -16.5, -68.15, "P", nil, nil, date, date, nil)
+	assert.NoError(t, err)
+
+	repo.On("GetById", mock.Anything, holiday.Id()).Return(holiday, nil)
+	contractRepo.On("GetPendingDeliveriesOn", mock.Anything, date).Return([]*deliveries.Delivery{inside, outside}, nil)
+
+	report, err := handler.HandleGetImpact(context.Background(), queries.GetHolidayImpactQuery{Id: holiday.Id()})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, report.Deliveries)
+	assert.Len(t, report.Contracts, 1)
+	assert.Equal(t, inside.ContractId().String(), report.Contracts[0].ContractId)
+	assert.Equal(t, inside.Id().String(), report.Contracts[0].Deliveries[0].Id)
+}
+
+func TestHolidayHandler_HandleGetImpact_Errors(t *testing.T) {
+	date := time.Date(2025, 8, 6, 0, 0, 0, 0, time.UTC)
+	holiday := holidays.NewHoliday(date, "Independence Day", nil, holidays.Shift)
+	dbErr := errors.New("db failure")
+
+	t.Run("not found", func(t *testing.T) {
+		repo := new(MockRepository)
+		contractRepo := new(MockContractRepository)
+		handler := NewHolidayHandler(repo, contractRepo)
+
+		repo.On("GetById", mock.Anything, holiday.Id()).Return(nil, holidays.ErrNotFoundHoliday)
+
+		report, err := handler.HandleGetImpact(context.Background(), queries.GetHolidayImpactQuery{Id: holiday.Id()})
+
+		assert.Nil(t, report)
+		assert.ErrorIs(t, err, holidays.ErrNotFoundHoliday)
+		contractRepo.AssertNotCalled(t, "GetPendingDeliveriesOn", mock.Anything, mock.Anything)
+	})
+
+	t.Run("deliveries", func(t *testing.T) {
+		repo := new(MockRepository)
+		contractRepo := new(MockContractRepository)
+		handler := NewHolidayHandler(repo, contractRepo)
+
+		repo.On("GetById", mock.Anything, holiday.Id()).Return(holiday, nil)
+		contractRepo.On("GetPendingDeliveriesOn", mock.Anything, date).Return(nil, dbErr)
+
+		report, err := handler.HandleGetImpact(context.Background(), queries.GetHolidayImpactQuery{Id: holiday.Id()})
+
+		assert.Nil(t, report)
+		assert.ErrorIs(t, err, dbErr)
+	})
+}
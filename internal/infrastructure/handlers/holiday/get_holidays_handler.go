@@ -0,0 +1,24 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/holiday/dto"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/holiday/mappers"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/holiday/queries"
+	"log"
+)
+
+func (h *HolidayHandler) HandleGetAll(ctx context.Context, qry queries.GetHolidaysQuery) ([]*dto.HolidayDTO, error) {
+	list, err := h.repository.GetBetween(ctx, qry.From, qry.To)
+	if err != nil {
+		log.Printf("[handler:holiday][HandleGetAll] error getting holidays: %v", err)
+		return nil, err
+	}
+
+	holidaysDTO := make([]*dto.HolidayDTO, 0, len(list))
+	for _, hol := range list {
+		holidaysDTO = append(holidaysDTO, mappers.MapToHolidayDTO(hol))
+	}
+
+	return holidaysDTO, nil
+}
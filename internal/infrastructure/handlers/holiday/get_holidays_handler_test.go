@@ -0,0 +1,45 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/holiday/queries"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/holiday"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+func TestHolidayHandler_HandleGetAll(t *testing.T) {
+	repo := new(MockRepository)
+	handler := NewHolidayHandler(repo, new(MockContractRepository))
+	from := time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 1, 0)
+	list := []*holidays.Holiday{
+		holidays.NewHoliday(from.AddDate(0, 0, 5), "Independence Day", nil, holidays.Shift),
+		holidays.NewHoliday(from.AddDate(0, 0, 14), "Closure", nil, holidays.Refuse),
+	}
+
+	repo.On("GetBetween", mock.Anything, from, to).Return(list, nil)
+
+	result, err := handler.HandleGetAll(context.Background(), queries.GetHolidaysQuery{From: from, To: to})
+
+	assert.NoError(t, err)
+	assert.Len(t, result, 2)
+	assert.Equal(t, "2025-08-06", result[0].Date)
+	assert.Equal(t, "refuse", result[1].Policy)
+}
+
+func TestHolidayHandler_HandleGetAll_Error(t *testing.T) {
+	repo := new(MockRepository)
+	handler := NewHolidayHandler(repo, new(MockContractRepository))
+	dbErr := errors.New("db failure")
+
+	repo.On("GetBetween", mock.Anything, mock.Anything, mock.Anything).Return(nil, dbErr)
+
+	result, err := handler.HandleGetAll(context.Background(), queries.GetHolidaysQuery{})
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, dbErr)
+}
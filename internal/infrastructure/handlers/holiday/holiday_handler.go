@@ -0,0 +1,18 @@
+package handlers
+
+import (
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/contract"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/holiday"
+)
+
+type HolidayHandler struct {
+	repository holidays.HolidayRepository
+	contracts  contracts.ContractRepository
+}
+
+func NewHolidayHandler(r holidays.HolidayRepository, c contracts.ContractRepository) *HolidayHandler {
+	return &HolidayHandler{
+		repository: r,
+		contracts:  c,
+	}
+}
@@ -0,0 +1,58 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/contract"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/delivery"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/holiday"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+type MockRepository struct {
+	mock.Mock
+	holidays.HolidayRepository
+}
+
+type MockContractRepository struct {
+	mock.Mock
+	contracts.ContractRepository
+}
+
+func TestNewHolidayHandler(t *testing.T) {
+	repo := new(MockRepository)
+	contractRepo := new(MockContractRepository)
+
+	handler := NewHolidayHandler(repo, contractRepo)
+
+	assert.NotNil(t, handler)
+	assert.Equal(t, repo, handler.repository)
+	assert.Equal(t, contractRepo, handler.contracts)
+}
+
+func (m *MockRepository) GetBetween(ctx context.Context, from, to time.Time) ([]*holidays.Holiday, error) {
+	args := m.Called(ctx, from, to)
+	if v := args.Get(0); v != nil {
+		return v.([]*holidays.Holiday), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockRepository) GetById(ctx context.Context, id uuid.UUID) (*holidays.Holiday, error) {
+	args := m.Called(ctx, id)
+	if v := args.Get(0); v != nil {
+		return v.(*holidays.Holiday), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockContractRepository) GetPendingDeliveriesOn(ctx context.Context, day time.Time) ([]*deliveries.Delivery, error) {
+	args := m.Called(ctx, day)
+	if v := args.Get(0); v != nil {
+		return v.([]*deliveries.Delivery), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
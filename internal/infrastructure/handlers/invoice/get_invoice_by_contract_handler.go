@@ -0,0 +1,19 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/invoice/dto"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/invoice/mappers"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/invoice/queries"
+	"log"
+)
+
+func (h *InvoiceHandler) HandleGetByContract(ctx context.Context, qry queries.GetInvoiceByContractQuery) (*dto.InvoiceDTO, error) {
+	invoice, err := h.repository.GetByContract(ctx, qry.ContractId)
+	if err != nil {
+		log.Printf("[handler:invoice][HandleGetByContract] error getting invoice of contract '%s': %v", qry.ContractId, err)
+		return nil, err
+	}
+
+	return mappers.MapToInvoiceDTO(invoice), nil
+}
@@ -0,0 +1,38 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/invoice/queries"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/invoice"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+func TestInvoiceHandler_HandleGetByContract(t *testing.T) {
+	repo := new(MockInvoiceRepository)
+	handler := NewInvoiceHandler(repo)
+	invoice := newInvoice(t, 1, uuid.New(), time.Now())
+
+	repo.On("GetByContract", mock.Anything, invoice.ContractId()).Return(invoice, nil)
+
+	result, err := handler.HandleGetByContract(context.Background(), queries.GetInvoiceByContractQuery{ContractId: invoice.ContractId()})
+
+	assert.NoError(t, err)
+	assert.Equal(t, invoice.ContractId().String(), result.ContractId)
+}
+
+func TestInvoiceHandler_HandleGetByContract_NotFound(t *testing.T) {
+	repo := new(MockInvoiceRepository)
+	handler := NewInvoiceHandler(repo)
+	contractId := uuid.New()
+
+	repo.On("GetByContract", mock.Anything, contractId).Return(nil, invoices.ErrNotFoundInvoice)
+
+	result, err := handler.HandleGetByContract(context.Background(), queries.GetInvoiceByContractQuery{ContractId: contractId})
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, invoices.ErrNotFoundInvoice)
+}
@@ -0,0 +1,19 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/invoice/dto"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/invoice/mappers"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/invoice/queries"
+	"log"
+)
+
+func (h *InvoiceHandler) HandleGetById(ctx context.Context, qry queries.GetInvoiceByIdQuery) (*dto.InvoiceDTO, error) {
+	invoice, err := h.repository.GetById(ctx, qry.Id)
+	if err != nil {
+		log.Printf("[handler:invoice][HandleGetById] error getting invoice '%s': %v", qry.Id, err)
+		return nil, err
+	}
+
+	return mappers.MapToInvoiceDTO(invoice), nil
+}
@@ -0,0 +1,41 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/invoice/queries"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/invoice"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+func TestInvoiceHandler_HandleGetById(t *testing.T) {
+	repo := new(MockInvoiceRepository)
+	handler := NewInvoiceHandler(repo)
+	invoice := newInvoice(t, 3, uuid.New(), time.Now())
+
+	repo.On("GetById", mock.Anything, invoice.Id()).Return(invoice, nil)
+
+	result, err := handler.HandleGetById(context.Background(), queries.GetInvoiceByIdQuery{Id: invoice.Id()})
+
+	assert.NoError(t, err)
+	assert.Equal(t, invoice.Id().String(), result.Id)
+	assert.Equal(t, "NC-000003", result.Number)
+	assert.Equal(t, "unpaid", result.Status)
+	assert.Equal(t, 45000, result.Balance.Amount)
+}
+
+func TestInvoiceHandler_HandleGetById_NotFound(t *testing.T) {
+	repo := new(MockInvoiceRepository)
+	handler := NewInvoiceHandler(repo)
+	id := uuid.New()
+
+	repo.On("GetById", mock.Anything, id).Return(nil, invoices.ErrNotFoundInvoice)
+
+	result, err := handler.HandleGetById(context.Background(), queries.GetInvoiceByIdQuery{Id: id})
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, invoices.ErrNotFoundInvoice)
+}
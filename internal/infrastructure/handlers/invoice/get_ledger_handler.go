@@ -0,0 +1,20 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/invoice/dto"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/invoice/mappers"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/invoice/queries"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/invoice"
+	"log"
+)
+
+func (h *InvoiceHandler) HandleGetLedger(ctx context.Context, qry queries.GetLedgerQuery) (*dto.LedgerDTO, error) {
+	list, err := h.repository.GetByPatient(ctx, qry.PatientId)
+	if err != nil {
+		log.Printf("[handler:invoice][HandleGetLedger] error getting invoices of patient '%s': %v", qry.PatientId, err)
+		return nil, err
+	}
+
+	return mappers.MapToLedgerDTO(qry.PatientId, invoices.Ledger(list)), nil
+}
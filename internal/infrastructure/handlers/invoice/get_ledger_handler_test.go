@@ -0,0 +1,52 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/invoice/queries"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/invoice"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+func TestInvoiceHandler_HandleGetLedger(t *testing.T) {
+	repo := new(MockInvoiceRepository)
+	handler := NewInvoiceHandler(repo)
+	patientId := uuid.New()
+	start := time.Date(2025, 9, 1, 9, 0, 0, 0, time.UTC)
+	first := newInvoice(t, 1, patientId, start)
+	second := newInvoice(t, 2, patientId, start.AddDate(0, 1, 0))
+	paid, err := valueobjects.NewMoney(45000, valueobjects.BOB)
+	assert.NoError(t, err)
+	_, err = first.Record(invoices.Full, paid, "fake", "fake_1", start.AddDate(0, 0, 2))
+	assert.NoError(t, err)
+
+	repo.On("GetByPatient", mock.Anything, patientId).Return([]*invoices.Invoice{first, second}, nil)
+
+	result, err := handler.HandleGetLedger(context.Background(), queries.GetLedgerQuery{PatientId: patientId})
+
+	assert.NoError(t, err)
+	assert.Equal(t, patientId.String(), result.PatientId)
+	assert.Len(t, result.Entries, 3)
+	assert.Equal(t, []string{"invoice", "payment", "invoice"}, []string{result.Entries[0].Kind, result.Entries[1].Kind, result.Entries[2].Kind})
+	assert.Equal(t, 0, result.Entries[1].Balance.Amount)
+	assert.Len(t, result.Balances, 1)
+	assert.Equal(t, 45000, result.Balances[0].Amount)
+}
+
+func TestInvoiceHandler_HandleGetLedger_Error(t *testing.T) {
+	repo := new(MockInvoiceRepository)
+	handler := NewInvoiceHandler(repo)
+	dbErr := errors.New("db failure")
+
+	repo.On("GetByPatient", mock.Anything, mock.Anything).Return(nil, dbErr)
+
+	result, err := handler.HandleGetLedger(context.Background(), queries.GetLedgerQuery{PatientId: uuid.New()})
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, dbErr)
+}
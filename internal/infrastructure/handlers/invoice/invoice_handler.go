@@ -0,0 +1,15 @@
+package handlers
+
+import (
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/invoice"
+)
+
+type InvoiceHandler struct {
+	repository invoices.InvoiceRepository
+}
+
+func NewInvoiceHandler(r invoices.InvoiceRepository) *InvoiceHandler {
+	return &InvoiceHandler{
+		repository: r,
+	}
+}
@@ -0,0 +1,56 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/invoice"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+type MockInvoiceRepository struct {
+	mock.Mock
+	invoices.InvoiceRepository
+}
+
+func TestNewInvoiceHandler(t *testing.T) {
+	repo := new(MockInvoiceRepository)
+
+	handler := NewInvoiceHandler(repo)
+
+	assert.NotNil(t, handler)
+	assert.Equal(t, repo, handler.repository)
+}
+
+func newInvoice(t *testing.T, sequence int, patientId uuid.UUID, issuedAt time.Time) *invoices.Invoice {
+	amount, err := valueobjects.NewMoney(45000, valueobjects.BOB)
+	assert.NoError(t, err)
+	return invoices.NewInvoice("NC", sequence, uuid.New(), patientId, amount, issuedAt)
+}
+
+func (m *MockInvoiceRepository) GetById(ctx context.Context, id uuid.UUID) (*invoices.Invoice, error) {
+	args := m.Called(ctx, id)
+	if v := args.Get(0); v != nil {
+		return v.(*invoices.Invoice), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockInvoiceRepository) GetByContract(ctx context.Context, contractId uuid.UUID) (*invoices.Invoice, error) {
+	args := m.Called(ctx, contractId)
+	if v := args.Get(0); v != nil {
+		return v.(*invoices.Invoice), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockInvoiceRepository) GetByPatient(ctx context.Context, patientId uuid.UUID) ([]*invoices.Invoice, error) {
+	args := m.Called(ctx, patientId)
+	if v := args.Get(0); v != nil {
+		return v.([]*invoices.Invoice), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
@@ -0,0 +1,50 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/lockout/dto"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/lockout/mappers"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/lockout/queries"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/abstractions"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/lockout"
+	"log"
+)
+
+func (h *LockoutHandler) HandleGetEvents(ctx context.Context, qry queries.GetLockoutEventsQuery) (*abstractions.Page[*dto.LockoutEventDTO], error) {
+	filter := lockouts.EventFilter{
+		Key:  qry.Key,
+		From: qry.From,
+		To:   qry.To,
+	}
+
+	if qry.Scope != "" {
+		scope, err := lockouts.ParseScope(qry.Scope)
+		if err != nil {
+			log.Printf("[handler:lockout][HandleGetEvents] invalid scope filter: %v", err)
+			return nil, err
+		}
+		filter.Scope = &scope
+	}
+
+	if qry.Type != "" {
+		eventType, err := lockouts.ParseEventType(qry.Type)
+		if err != nil {
+			log.Printf("[handler:lockout][HandleGetEvents] invalid type filter: %v", err)
+			return nil, err
+		}
+		filter.Type = &eventType
+	}
+
+	if filter.From != nil && filter.To != nil && filter.To.Before(*filter.From) {
+		log.Printf("[handler:lockout][HandleGetEvents] invalid date range %s - %s", filter.From, filter.To)
+		return nil, lockouts.ErrDateRangeLockout
+	}
+
+	page, err := h.repository.GetEvents(ctx, filter, qry.Pagination)
+	if err != nil {
+		log.Printf("[handler:lockout][HandleGetEvents] error getting lockout events: %v", err)
+		return nil, err
+	}
+
+	return abstractions.MapPage(page, mappers.MapToLockoutEventDTO), nil
+}
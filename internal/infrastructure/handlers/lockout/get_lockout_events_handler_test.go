@@ -0,0 +1,71 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/lockout/queries"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/abstractions"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/lockout"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+func TestLockoutHandler_HandleGetEvents(t *testing.T) {
+	repo := new(MockRepository)
+	handler := NewLockoutHandler(repo)
+
+	th := lockouts.NewThrottle(lockouts.Account, "A:john@doe.com")
+	events := []*lockouts.Event{lockouts.NewLockedEvent(th), lockouts.NewUnlockedEvent(th, nil)}
+	from := time.Now().AddDate(0, 0, -1)
+	scope, eventType := lockouts.Account, lockouts.Locked
+	pagination := abstractions.Pagination{Limit: 2}
+	filter := lockouts.EventFilter{Scope: &scope, Key: "A:john@doe.com", Type: &eventType, From: &from}
+
+	repo.On("GetEvents", mock.Anything, filter, pagination).Return(abstractions.NewPage(events, 5, pagination), nil)
+
+	page, err := handler.HandleGetEvents(context.Background(), queries.GetLockoutEventsQuery{
+		Scope:      "account",
+		Key:        "A:john@doe.com",
+		Type:       "locked",
+		From:       &from,
+		Pagination: pagination,
+	})
+
+	assert.NoError(t, err)
+	assert.Len(t, page.Items, 2)
+	assert.Equal(t, 5, page.Total)
+	assert.Equal(t, "locked", page.Items[0].Type)
+	assert.Equal(t, "unlocked", page.Items[1].Type)
+	repo.AssertExpectations(t)
+}
+
+func TestLockoutHandler_HandleGetEvents_Errors(t *testing.T) {
+	now := time.Now()
+	before := now.Add(-time.Hour)
+	ErrRepository := errors.New("database failure")
+
+	cases := []struct {
+		name string
+		qry  queries.GetLockoutEventsQuery
+		want error
+	}{
+		{"Invalid scope", queries.GetLockoutEventsQuery{Scope: "device"}, lockouts.ErrNotAScopeLockout},
+		{"Invalid type", queries.GetLockoutEventsQuery{Type: "banned"}, lockouts.ErrNotAnEventTypeLockout},
+		{"Invalid range", queries.GetLockoutEventsQuery{From: &now, To: &before}, lockouts.ErrDateRangeLockout},
+		{"Repository", queries.GetLockoutEventsQuery{}, ErrRepository},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			repo := new(MockRepository)
+			repo.On("GetEvents", mock.Anything, lockouts.EventFilter{}, abstractions.Pagination{}).Return(nil, ErrRepository).Maybe()
+
+			page, err := NewLockoutHandler(repo).HandleGetEvents(context.Background(), tc.qry)
+
+			assert.ErrorIs(t, err, tc.want)
+			assert.Nil(t, page)
+		})
+	}
+}
@@ -0,0 +1,15 @@
+package handlers
+
+import (
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/lockout"
+)
+
+type LockoutHandler struct {
+	repository lockouts.LockoutRepository
+}
+
+func NewLockoutHandler(r lockouts.LockoutRepository) *LockoutHandler {
+	return &LockoutHandler{
+		repository: r,
+	}
+}
@@ -0,0 +1,32 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/abstractions"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/lockout"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+)
+
+type MockRepository struct {
+	mock.Mock
+	lockouts.LockoutRepository
+}
+
+func TestNewLockoutHandler(t *testing.T) {
+	repo := new(MockRepository)
+
+	handler := NewLockoutHandler(repo)
+
+	assert.NotNil(t, handler)
+	assert.Equal(t, repo, handler.repository)
+}
+
+func (m *MockRepository) GetEvents(ctx context.Context, filter lockouts.EventFilter, pagination abstractions.Pagination) (*abstractions.Page[*lockouts.Event], error) {
+	args := m.Called(ctx, filter, pagination)
+	if v := args.Get(0); v != nil {
+		return v.(*abstractions.Page[*lockouts.Event]), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
@@ -5,21 +5,32 @@ import (
 	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/patient/dto"
 	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/patient/mappers"
 	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/patient/queries"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/abstractions"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/patient"
+	vo "github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
 	"log"
 )
 
-func (h *PatientHandler) HandleGetAll(ctx context.Context, qry queries.GetAllPatientsQuery) ([]*dto.PatientDTO, error) {
-	patients, err := h.repository.GetAll(ctx)
-	if err != nil {
-		log.Printf("[handler:patient][HandleGetAll] error getting all patients: %v", err)
-		return nil, err
+func (h *PatientHandler) HandleGetAll(ctx context.Context, qry queries.GetAllPatientsQuery) (*abstractions.Page[*dto.PatientDTO], error) {
+	filter := patients.PatientFilter{
+		Name:    qry.Name,
+		Deleted: qry.Deleted,
+	}
+
+	if qry.Gender != "" {
+		gender, err := vo.ParseGender(qry.Gender)
+		if err != nil {
+			log.Printf("[handler:[patient]][HandleGetAll] invalid gender filter: %v", err)
+			return nil, err
+		}
+		filter.Gender = &gender
 	}
 
-	var patientsDTO []*dto.PatientDTO
-	for _, patient := range patients {
-		patientDTO := mappers.MapToPatientDTO(patient)
-		patientsDTO = append(patientsDTO, patientDTO)
+	page, err := h.repository.GetAll(ctx, filter, qry.Pagination)
+	if err != nil {
+		log.Printf("[handler:[patient]][HandleGetAll] error getting all patients: %v", err)
+		return nil, err
 	}
 
-	return patientsDTO, nil
+	return abstractions.MapPage(page, mappers.MapToPatientDTO), nil
 }
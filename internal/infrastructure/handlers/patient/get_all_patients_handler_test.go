@@ -4,7 +4,9 @@ import (
 	"context"
 	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/patient/dto"
 	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/patient/queries"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/abstractions"
 	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/patient"
+	vo "github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"testing"
@@ -36,7 +38,7 @@ func TestPatientHandler_HandleGetAll(t *testing.T) {
 		ptnts = append(ptnts, patient)
 	}
 
-	mockRepo.On("GetAll", mock.Anything).Return(ptnts, nil)
+	mockRepo.On("GetAll", mock.Anything, patients.PatientFilter{}, abstractions.Pagination{}).Return(abstractions.NewPage(ptnts, len(ptnts), abstractions.Pagination{}), nil)
 
 	cmd := queries.GetAllPatientsQuery{}
 
@@ -44,12 +46,13 @@ func TestPatientHandler_HandleGetAll(t *testing.T) {
 
 	assert.NoError(t, err)
 	assert.NotNil(t, resp)
-	assert.IsType(t, []*dto.PatientDTO{}, resp)
-	assert.Len(t, resp, len(cases))
+	assert.IsType(t, &abstractions.Page[*dto.PatientDTO]{}, resp)
+	assert.Len(t, resp.Items, len(cases))
+	assert.Equal(t, len(cases), resp.Total)
 
 	for i, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
-			got := resp[i]
+			got := resp.Items[i]
 			assert.NotNil(t, got.Id)
 			assert.Equal(t, tc.firstName, got.FirstName)
 			assert.Equal(t, tc.lastName, got.LastName)
@@ -73,7 +76,7 @@ func TestHandleGetAll_Error(t *testing.T) {
 
 	assert.NotNil(t, handler)
 
-	mockRepo.On("GetAll", mock.Anything).Return([]*patients.Patient(nil), ErrDbConnectionPatient)
+	mockRepo.On("GetAll", mock.Anything, mock.Anything, mock.Anything).Return(nil, ErrDbConnectionPatient)
 
 	cmd := queries.GetAllPatientsQuery{}
 
@@ -85,3 +88,42 @@ func TestHandleGetAll_Error(t *testing.T) {
 	mockRepo.AssertExpectations(t)
 	mockFactory.AssertExpectations(t)
 }
+
+func TestPatientHandler_HandleGetAll_Filters(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(MockRepository)
+	mockFactory := new(MockFactory)
+	handler := NewPatientHandler(mockRepo, mockFactory)
+
+	deleted := true
+	female := vo.Female
+	pagination := abstractions.Pagination{Page: 2, Limit: 5, Sort: "last_name", Order: abstractions.Descending}
+	filter := patients.PatientFilter{Name: "doe", Gender: &female, Deleted: &deleted}
+
+	mockRepo.On("GetAll", mock.Anything, filter, pagination).Return(abstractions.NewPage([]*patients.Patient{}, 6, pagination), nil)
+
+	qry := queries.GetAllPatientsQuery{Name: "doe", Gender: "female", Deleted: &deleted, Pagination: pagination}
+
+	resp, err := handler.HandleGetAll(ctx, qry)
+
+	assert.NoError(t, err)
+	assert.Empty(t, resp.Items)
+	assert.Equal(t, 6, resp.Total)
+	assert.Equal(t, 2, resp.Number())
+
+	mockRepo.AssertExpectations(t)
+}
+
+func TestPatientHandler_HandleGetAll_InvalidGender(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(MockRepository)
+	mockFactory := new(MockFactory)
+	handler := NewPatientHandler(mockRepo, mockFactory)
+
+	resp, err := handler.HandleGetAll(ctx, queries.GetAllPatientsQuery{Gender: "robot"})
+
+	assert.ErrorIs(t, err, vo.ErrNotAGender)
+	assert.Nil(t, resp)
+
+	mockRepo.AssertNotCalled(t, "GetAll", mock.Anything, mock.Anything, mock.Anything)
+}
@@ -5,21 +5,31 @@ import (
 	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/patient/dto"
 	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/patient/mappers"
 	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/patient/queries"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/abstractions"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/patient"
+	vo "github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
 	"log"
 )
 
-func (h *PatientHandler) HandleGetList(ctx context.Context, qry queries.GetListPatientsQuery) ([]*dto.PatientDTO, error) {
-	patients, err := h.repository.GetList(ctx)
+func (h *PatientHandler) HandleGetList(ctx context.Context, qry queries.GetListPatientsQuery) (*abstractions.Page[*dto.PatientDTO], error) {
+	filter := patients.PatientFilter{
+		Name: qry.Name,
+	}
+
+	if qry.Gender != "" {
+		gender, err := vo.ParseGender(qry.Gender)
+		if err != nil {
+			log.Printf("[handler:[patient]][HandleGetList] invalid gender filter: %v", err)
+			return nil, err
+		}
+		filter.Gender = &gender
+	}
+
+	page, err := h.repository.GetList(ctx, filter, qry.Pagination)
 	if err != nil {
 		log.Printf("[handler:[patient]][HandleGetList] error getting patients list: %v", err)
 		return nil, err
 	}
 
-	var patientsDTO []*dto.PatientDTO
-	for _, patient := range patients {
-		patientDTO := mappers.MapToPatientDTO(patient)
-		patientsDTO = append(patientsDTO, patientDTO)
-	}
-
-	return patientsDTO, nil
+	return abstractions.MapPage(page, mappers.MapToPatientDTO), nil
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/patient/dto"
 	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/patient/queries"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/abstractions"
 	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/patient"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -38,18 +39,19 @@ func TestPatientHandler_HandleGetList(t *testing.T) {
 		ptnts = append(ptnts, patient)
 	}
 
-	mockRepo.On("GetList", mock.Anything).Return(ptnts, nil)
+	mockRepo.On("GetList", mock.Anything, patients.PatientFilter{}, abstractions.Pagination{}).Return(abstractions.NewPage(ptnts, len(ptnts), abstractions.Pagination{}), nil)
 
 	resp, err := handler.HandleGetList(ctx, cmd)
 
 	assert.NoError(t, err)
 	assert.NotNil(t, resp)
-	assert.IsType(t, []*dto.PatientDTO{}, resp)
-	assert.Len(t, resp, len(cases))
+	assert.IsType(t, &abstractions.Page[*dto.PatientDTO]{}, resp)
+	assert.Len(t, resp.Items, len(cases))
+	assert.Equal(t, len(cases), resp.Total)
 
 	for i, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
-			got := resp[i]
+			got := resp.Items[i]
 			assert.NotNil(t, got.Id)
 			assert.Equal(t, tc.firstName, got.FirstName)
 			assert.Equal(t, tc.lastName, got.LastName)
@@ -72,7 +74,7 @@ func TestHandleGetList_Error(t *testing.T) {
 
 	assert.NotNil(t, handler)
 
-	mockRepo.On("GetList", mock.Anything).Return([]*patients.Patient(nil), ErrDbConnectionPatient)
+	mockRepo.On("GetList", mock.Anything, mock.Anything, mock.Anything).Return(nil, ErrDbConnectionPatient)
 
 	cmd := queries.GetListPatientsQuery{}
 
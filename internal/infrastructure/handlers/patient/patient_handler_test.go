@@ -3,6 +3,7 @@ package handlers
 import (
 	"context"
 	"errors"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/abstractions"
 	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/patient"
 	vo "github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
 	"github.com/google/uuid"
@@ -35,14 +36,24 @@ func (m *MockFactory) Create(firstName, lastName string, email vo.Email, passwor
 	return nil, args.Error(1)
 }
 
-func (m *MockRepository) GetAll(ctx context.Context) ([]*patients.Patient, error) {
-	args := m.Called(ctx)
-	return args.Get(0).([]*patients.Patient), args.Error(1)
+func (m *MockRepository) GetAll(ctx context.Context, filter patients.PatientFilter, pagination abstractions.Pagination) (*abstractions.Page[*patients.Patient], error) {
+	args := m.Called(ctx, filter, pagination)
+
+	var result *abstractions.Page[*patients.Patient]
+	if r := args.Get(0); r != nil {
+		result = r.(*abstractions.Page[*patients.Patient])
+	}
+	return result, args.Error(1)
 }
 
-func (m *MockRepository) GetList(ctx context.Context) ([]*patients.Patient, error) {
-	args := m.Called(ctx)
-	return args.Get(0).([]*patients.Patient), args.Error(1)
+func (m *MockRepository) GetList(ctx context.Context, filter patients.PatientFilter, pagination abstractions.Pagination) (*abstractions.Page[*patients.Patient], error) {
+	args := m.Called(ctx, filter, pagination)
+
+	var result *abstractions.Page[*patients.Patient]
+	if r := args.Get(0); r != nil {
+		result = r.(*abstractions.Page[*patients.Patient])
+	}
+	return result, args.Error(1)
 }
 
 func (m *MockRepository) GetById(ctx context.Context, id uuid.UUID) (*patients.Patient, error) {
@@ -0,0 +1,24 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/plan/dto"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/plan/mappers"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/plan/queries"
+	"log"
+)
+
+func (h *PlanHandler) HandleGetAll(ctx context.Context, qry queries.GetAllPlansQuery) ([]*dto.PlanDTO, error) {
+	list, err := h.repository.GetAll(ctx, qry.IncludeInactive)
+	if err != nil {
+		log.Printf("[handler:plan][HandleGetAll] error getting plans: %v", err)
+		return nil, err
+	}
+
+	plansDTO := make([]*dto.PlanDTO, 0, len(list))
+	for _, p := range list {
+		plansDTO = append(plansDTO, mappers.MapToPlanDTO(p))
+	}
+
+	return plansDTO, nil
+}
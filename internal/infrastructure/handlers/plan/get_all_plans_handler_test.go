@@ -0,0 +1,42 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/plan/queries"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/plan"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+)
+
+func TestPlanHandler_HandleGetAll(t *testing.T) {
+	repo := new(MockRepository)
+	handler := NewPlanHandler(repo)
+	list := []*plans.Plan{
+		plans.NewPlan("TRIAL", "Trial", 3, plans.Daily, 300),
+		plans.NewPlan("WEEKLY", "Weekly", 7, plans.Daily, 650),
+	}
+
+	repo.On("GetAll", mock.Anything, true).Return(list, nil)
+
+	result, err := handler.HandleGetAll(context.Background(), queries.GetAllPlansQuery{IncludeInactive: true})
+
+	assert.NoError(t, err)
+	assert.Len(t, result, 2)
+	assert.Equal(t, "TRIAL", result[0].Code)
+	assert.Equal(t, 7, result[1].DeliveryCount)
+}
+
+func TestPlanHandler_HandleGetAll_Error(t *testing.T) {
+	repo := new(MockRepository)
+	handler := NewPlanHandler(repo)
+	dbErr := errors.New("db failure")
+
+	repo.On("GetAll", mock.Anything, false).Return(nil, dbErr)
+
+	result, err := handler.HandleGetAll(context.Background(), queries.GetAllPlansQuery{})
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, dbErr)
+}
@@ -0,0 +1,19 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/plan/dto"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/plan/mappers"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/plan/queries"
+	"log"
+)
+
+func (h *PlanHandler) HandleGetByCode(ctx context.Context, qry queries.GetPlanByCodeQuery) (*dto.PlanDTO, error) {
+	plan, err := h.repository.GetByCode(ctx, qry.Code)
+	if err != nil {
+		log.Printf("[handler:plan][HandleGetByCode] error getting plan '%s': %v", qry.Code, err)
+		return nil, err
+	}
+
+	return mappers.MapToPlanDTO(plan), nil
+}
@@ -0,0 +1,30 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/plan/queries"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/plan"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+)
+
+func TestPlanHandler_HandleGetByCode(t *testing.T) {
+	repo := new(MockRepository)
+	handler := NewPlanHandler(repo)
+	plan := plans.NewPlan("SIXTY_DAYS", "Sixty days", 60, plans.Daily, 5500)
+
+	repo.On("GetByCode", mock.Anything, "SIXTY_DAYS").Return(plan, nil)
+	repo.On("GetByCode", mock.Anything, "NONE").Return(nil, plans.ErrNotFoundPlan)
+
+	result, err := handler.HandleGetByCode(context.Background(), queries.GetPlanByCodeQuery{Code: "SIXTY_DAYS"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, plan.Id().String(), result.Id)
+	assert.Equal(t, 60, result.DeliveryCount)
+
+	result, err = handler.HandleGetByCode(context.Background(), queries.GetPlanByCodeQuery{Code: "NONE"})
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, plans.ErrNotFoundPlan)
+}
@@ -0,0 +1,15 @@
+package handlers
+
+import (
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/plan"
+)
+
+type PlanHandler struct {
+	repository plans.PlanRepository
+}
+
+func NewPlanHandler(r plans.PlanRepository) *PlanHandler {
+	return &PlanHandler{
+		repository: r,
+	}
+}
@@ -0,0 +1,39 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/plan"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+)
+
+type MockRepository struct {
+	mock.Mock
+	plans.PlanRepository
+}
+
+func TestNewPlanHandler(t *testing.T) {
+	repo := new(MockRepository)
+
+	handler := NewPlanHandler(repo)
+
+	assert.NotNil(t, handler)
+	assert.Equal(t, repo, handler.repository)
+}
+
+func (m *MockRepository) GetAll(ctx context.Context, includeInactive bool) ([]*plans.Plan, error) {
+	args := m.Called(ctx, includeInactive)
+	if v := args.Get(0); v != nil {
+		return v.([]*plans.Plan), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockRepository) GetByCode(ctx context.Context, code string) (*plans.Plan, error) {
+	args := m.Called(ctx, code)
+	if v := args.Get(0); v != nil {
+		return v.(*plans.Plan), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
@@ -0,0 +1,39 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/pricing/dto"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/pricing/mappers"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/pricing/queries"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/pricing"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	"log"
+)
+
+func (h *PricingHandler) HandleCheckCoverage(ctx context.Context, qry queries.CheckCoverageQuery) (*dto.CoverageDTO, error) {
+	at, err := valueobjects.NewCoordinates(qry.Latitude, qry.Longitude)
+	if err != nil {
+		log.Printf("[handler:pricing][HandleCheckCoverage] error creating coordinates: %v", err)
+		return nil, err
+	}
+
+	list, err := h.zones.GetAll(ctx)
+	if err != nil {
+		log.Printf("[handler:pricing][HandleCheckCoverage] error getting zones: %v", err)
+		return nil, err
+	}
+
+	zone, err := pricing.Serving(list, at)
+	if errors.Is(err, pricing.ErrNotCoveredZone) {
+		return &dto.CoverageDTO{Covered: false}, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	coverage := &dto.CoverageDTO{Covered: true}
+	if zone != nil {
+		coverage.Zone = mappers.MapToZoneDTO(zone)
+	}
+	return coverage, nil
+}
@@ -0,0 +1,71 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/pricing/queries"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/pricing"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+)
+
+func TestPricingHandler_HandleCheckCoverage(t *testing.T) {
+	boundary, err := valueobjects.ParsePolygon([]byte(`{"type":"Polygon","coordinates":[[[-63.25,-17.77],[-63.21,-17.77],[-63.21,-17.74],[-63.25,-17.74],[-63.25,-17.77]]]}`))
+	assert.NoError(t, err)
+	surcharge, err := valueobjects.NewMoney(500, valueobjects.BOB)
+	assert.NoError(t, err)
+	urubo := pricing.NewBoundedZone("Urubó", boundary, surcharge)
+
+	cases := []struct {
+		name    string
+		zones   []*pricing.Zone
+		qry     queries.CheckCoverageQuery
+		covered bool
+		zone    string
+	}{
+		{"inside", []*pricing.Zone{urubo}, queries.CheckCoverageQuery{Latitude: -17.7580, Longitude: -63.2300}, true, "Urubó"},
+		{"outside", []*pricing.Zone{urubo}, queries.CheckCoverageQuery{Latitude: -17.7863, Longitude: -63.1812}, false, ""},
+		{"no zones", []*pricing.Zone{}, queries.CheckCoverageQuery{Latitude: -17.7863, Longitude: -63.1812}, true, ""},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			zones := new(MockZoneRepository)
+			handler := NewPricingHandler(zones, new(MockPromoCodeRepository))
+
+			zones.On("GetAll", mock.Anything).Return(tc.zones, nil)
+
+			result, err := handler.HandleCheckCoverage(context.Background(), tc.qry)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.covered, result.Covered)
+			if tc.zone == "" {
+				assert.Nil(t, result.Zone)
+			} else {
+				assert.Equal(t, tc.zone, result.Zone.Name)
+				assert.Equal(t, 500, result.Zone.Surcharge.Amount)
+			}
+		})
+	}
+}
+
+func TestPricingHandler_HandleCheckCoverage_Errors(t *testing.T) {
+	zones := new(MockZoneRepository)
+	handler := NewPricingHandler(zones, new(MockPromoCodeRepository))
+
+	result, err := handler.HandleCheckCoverage(context.Background(), queries.CheckCoverageQuery{Latitude: 91})
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, valueobjects.ErrOutOfBoundariesLatitude)
+	zones.AssertNotCalled(t, "GetAll")
+
+	dbErr := errors.New("db failure")
+	zones.On("GetAll", mock.Anything).Return(nil, dbErr)
+
+	result, err = handler.HandleCheckCoverage(context.Background(), queries.CheckCoverageQuery{Latitude: -17.7863, Longitude: -63.1812})
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, dbErr)
+}
@@ -0,0 +1,24 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/pricing/dto"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/pricing/mappers"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/pricing/queries"
+	"log"
+)
+
+func (h *PricingHandler) HandleGetPromoCodes(ctx context.Context, _ queries.GetPromoCodesQuery) ([]*dto.PromoCodeDTO, error) {
+	list, err := h.promoCodes.GetAll(ctx)
+	if err != nil {
+		log.Printf("[handler:pricing][HandleGetPromoCodes] error getting promo codes: %v", err)
+		return nil, err
+	}
+
+	codesDTO := make([]*dto.PromoCodeDTO, 0, len(list))
+	for _, p := range list {
+		codesDTO = append(codesDTO, mappers.MapToPromoCodeDTO(p))
+	}
+
+	return codesDTO, nil
+}
@@ -0,0 +1,45 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/pricing/queries"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/pricing"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+func TestPricingHandler_HandleGetPromoCodes(t *testing.T) {
+	codes := new(MockPromoCodeRepository)
+	handler := NewPricingHandler(new(MockZoneRepository), codes)
+	list := []*pricing.PromoCode{
+		pricing.NewPromoCode("WELCOME10", pricing.Percentage, 1000, valueobjects.BOB, time.Now(), nil, 0),
+		pricing.NewPromoCode("MINUS50", pricing.Fixed, 5000, valueobjects.BOB, time.Now(), nil, 10),
+	}
+
+	codes.On("GetAll", mock.Anything).Return(list, nil)
+
+	result, err := handler.HandleGetPromoCodes(context.Background(), queries.GetPromoCodesQuery{})
+
+	assert.NoError(t, err)
+	assert.Len(t, result, 2)
+	assert.Equal(t, "WELCOME10", result[0].Code)
+	assert.Equal(t, "fixed", result[1].Kind)
+	assert.Equal(t, 10, result[1].MaxUses)
+}
+
+func TestPricingHandler_HandleGetPromoCodes_Error(t *testing.T) {
+	codes := new(MockPromoCodeRepository)
+	handler := NewPricingHandler(new(MockZoneRepository), codes)
+	dbErr := errors.New("db failure")
+
+	codes.On("GetAll", mock.Anything).Return(nil, dbErr)
+
+	result, err := handler.HandleGetPromoCodes(context.Background(), queries.GetPromoCodesQuery{})
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, dbErr)
+}
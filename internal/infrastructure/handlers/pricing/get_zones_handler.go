@@ -0,0 +1,24 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/pricing/dto"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/pricing/mappers"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/pricing/queries"
+	"log"
+)
+
+func (h *PricingHandler) HandleGetZones(ctx context.Context, _ queries.GetZonesQuery) ([]*dto.ZoneDTO, error) {
+	list, err := h.zones.GetAll(ctx)
+	if err != nil {
+		log.Printf("[handler:pricing][HandleGetZones] error getting zones: %v", err)
+		return nil, err
+	}
+
+	zonesDTO := make([]*dto.ZoneDTO, 0, len(list))
+	for _, z := range list {
+		zonesDTO = append(zonesDTO, mappers.MapToZoneDTO(z))
+	}
+
+	return zonesDTO, nil
+}
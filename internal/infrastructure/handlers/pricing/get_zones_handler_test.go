@@ -0,0 +1,43 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/pricing/queries"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/pricing"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+)
+
+func TestPricingHandler_HandleGetZones(t *testing.T) {
+	zones := new(MockZoneRepository)
+	handler := NewPricingHandler(zones, new(MockPromoCodeRepository))
+	center, err := valueobjects.NewCoordinates(-17.7580, -63.2300)
+	assert.NoError(t, err)
+	surcharge, err := valueobjects.NewMoney(500, valueobjects.BOB)
+	assert.NoError(t, err)
+
+	zones.On("GetAll", mock.Anything).Return([]*pricing.Zone{pricing.NewZone("Urubó", center, 3000, surcharge)}, nil)
+
+	result, err := handler.HandleGetZones(context.Background(), queries.GetZonesQuery{})
+
+	assert.NoError(t, err)
+	assert.Len(t, result, 1)
+	assert.Equal(t, "Urubó", result[0].Name)
+	assert.Equal(t, 500, result[0].Surcharge.Amount)
+}
+
+func TestPricingHandler_HandleGetZones_Error(t *testing.T) {
+	zones := new(MockZoneRepository)
+	handler := NewPricingHandler(zones, new(MockPromoCodeRepository))
+	dbErr := errors.New("db failure")
+
+	zones.On("GetAll", mock.Anything).Return(nil, dbErr)
+
+	result, err := handler.HandleGetZones(context.Background(), queries.GetZonesQuery{})
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, dbErr)
+}
@@ -0,0 +1,17 @@
+package handlers
+
+import (
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/pricing"
+)
+
+type PricingHandler struct {
+	zones      pricing.ZoneRepository
+	promoCodes pricing.PromoCodeRepository
+}
+
+func NewPricingHandler(z pricing.ZoneRepository, p pricing.PromoCodeRepository) *PricingHandler {
+	return &PricingHandler{
+		zones:      z,
+		promoCodes: p,
+	}
+}
@@ -0,0 +1,45 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/pricing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+)
+
+type MockZoneRepository struct {
+	mock.Mock
+	pricing.ZoneRepository
+}
+
+type MockPromoCodeRepository struct {
+	mock.Mock
+	pricing.PromoCodeRepository
+}
+
+func TestNewPricingHandler(t *testing.T) {
+	zones, codes := new(MockZoneRepository), new(MockPromoCodeRepository)
+
+	handler := NewPricingHandler(zones, codes)
+
+	assert.NotNil(t, handler)
+	assert.Equal(t, zones, handler.zones)
+	assert.Equal(t, codes, handler.promoCodes)
+}
+
+func (m *MockZoneRepository) GetAll(ctx context.Context) ([]*pricing.Zone, error) {
+	args := m.Called(ctx)
+	if v := args.Get(0); v != nil {
+		return v.([]*pricing.Zone), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockPromoCodeRepository) GetAll(ctx context.Context) ([]*pricing.PromoCode, error) {
+	args := m.Called(ctx)
+	if v := args.Get(0); v != nil {
+		return v.([]*pricing.PromoCode), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
@@ -0,0 +1,34 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/slot/dto"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/slot/mappers"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/slot/queries"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/slot"
+	"log"
+)
+
+func (h *SlotHandler) HandleGetAll(ctx context.Context, qry queries.GetAllSlotsQuery) ([]*dto.SlotDTO, error) {
+	var (
+		list []*slots.Slot
+		err  error
+	)
+
+	if qry.ZoneId != nil {
+		list, err = h.repository.GetByZone(ctx, *qry.ZoneId)
+	} else {
+		list, err = h.repository.GetAll(ctx)
+	}
+	if err != nil {
+		log.Printf("[handler:slot][HandleGetAll] error getting slots: %v", err)
+		return nil, err
+	}
+
+	slotsDTO := make([]*dto.SlotDTO, 0, len(list))
+	for _, s := range list {
+		slotsDTO = append(slotsDTO, mappers.MapToSlotDTO(s))
+	}
+
+	return slotsDTO, nil
+}
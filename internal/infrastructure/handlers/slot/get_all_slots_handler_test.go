@@ -0,0 +1,48 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/slot/queries"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/slot"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+func TestSlotHandler_HandleGetAll(t *testing.T) {
+	repo := new(MockRepository)
+	handler := NewSlotHandler(repo)
+	zoneId := uuid.New()
+	lunch := slots.NewSlot(zoneId, "Lunch", 11*time.Hour+30*time.Minute, 14*time.Hour, 30)
+	dinner := slots.NewSlot(uuid.New(), "Dinner", 19*time.Hour, 21*time.Hour, 20)
+
+	repo.On("GetAll", mock.Anything).Return([]*slots.Slot{lunch, dinner}, nil).Once()
+
+	result, err := handler.HandleGetAll(context.Background(), queries.GetAllSlotsQuery{})
+
+	assert.NoError(t, err)
+	assert.Len(t, result, 2)
+	assert.Equal(t, "Lunch", result[0].Name)
+	assert.Equal(t, "11:30", result[0].Start)
+	assert.Equal(t, "21:00", result[1].End)
+
+	repo.On("GetByZone", mock.Anything, zoneId).Return([]*slots.Slot{lunch}, nil).Once()
+
+	result, err = handler.HandleGetAll(context.Background(), queries.GetAllSlotsQuery{ZoneId: &zoneId})
+
+	assert.NoError(t, err)
+	assert.Len(t, result, 1)
+	assert.Equal(t, zoneId.String(), result[0].ZoneId)
+
+	dbErr := errors.New("db failure")
+	repo.On("GetAll", mock.Anything).Return(nil, dbErr).Once()
+
+	result, err = handler.HandleGetAll(context.Background(), queries.GetAllSlotsQuery{})
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, dbErr)
+	repo.AssertExpectations(t)
+}
@@ -0,0 +1,19 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/slot/dto"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/slot/mappers"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/slot/queries"
+	"log"
+)
+
+func (h *SlotHandler) HandleGetById(ctx context.Context, qry queries.GetSlotByIdQuery) (*dto.SlotDTO, error) {
+	slot, err := h.repository.GetById(ctx, qry.Id)
+	if err != nil {
+		log.Printf("[handler:slot][HandleGetById] error getting slot '%s': %v", qry.Id, err)
+		return nil, err
+	}
+
+	return mappers.MapToSlotDTO(slot), nil
+}
@@ -0,0 +1,33 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/slot/queries"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/slot"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+func TestSlotHandler_HandleGetById(t *testing.T) {
+	repo := new(MockRepository)
+	handler := NewSlotHandler(repo)
+	slot := slots.NewSlot(uuid.New(), "Lunch", 11*time.Hour+30*time.Minute, 14*time.Hour, 30)
+	missing := uuid.New()
+
+	repo.On("GetById", mock.Anything, slot.Id()).Return(slot, nil)
+	repo.On("GetById", mock.Anything, missing).Return(nil, slots.ErrNotFoundSlot)
+
+	result, err := handler.HandleGetById(context.Background(), queries.GetSlotByIdQuery{Id: slot.Id()})
+
+	assert.NoError(t, err)
+	assert.Equal(t, slot.Id().String(), result.Id)
+	assert.Equal(t, 30, result.Capacity)
+
+	result, err = handler.HandleGetById(context.Background(), queries.GetSlotByIdQuery{Id: missing})
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, slots.ErrNotFoundSlot)
+}
@@ -0,0 +1,15 @@
+package handlers
+
+import (
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/slot"
+)
+
+type SlotHandler struct {
+	repository slots.SlotRepository
+}
+
+func NewSlotHandler(r slots.SlotRepository) *SlotHandler {
+	return &SlotHandler{
+		repository: r,
+	}
+}
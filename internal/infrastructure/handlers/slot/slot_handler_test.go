@@ -0,0 +1,48 @@
+package handlers
+
+import (
+	"context"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/slot"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+)
+
+type MockRepository struct {
+	mock.Mock
+	slots.SlotRepository
+}
+
+func TestNewSlotHandler(t *testing.T) {
+	repo := new(MockRepository)
+
+	handler := NewSlotHandler(repo)
+
+	assert.NotNil(t, handler)
+	assert.Equal(t, repo, handler.repository)
+}
+
+func (m *MockRepository) GetAll(ctx context.Context) ([]*slots.Slot, error) {
+	args := m.Called(ctx)
+	if v := args.Get(0); v != nil {
+		return v.([]*slots.Slot), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockRepository) GetByZone(ctx context.Context, zoneId uuid.UUID) ([]*slots.Slot, error) {
+	args := m.Called(ctx, zoneId)
+	if v := args.Get(0); v != nil {
+		return v.([]*slots.Slot), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockRepository) GetById(ctx context.Context, id uuid.UUID) (*slots.Slot, error) {
+	args := m.Called(ctx, id)
+	if v := args.Get(0); v != nil {
+		return v.(*slots.Slot), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
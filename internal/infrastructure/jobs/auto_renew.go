@@ -0,0 +1,28 @@
+package jobs
+
+import (
+	"context"
+	"os"
+	"time"
+)
+
+const DefaultAutoRenewInterval = time.Hour
+
+type AutoRenewer interface {
+	HandleAutoRenew(ctx context.Context, now time.Time) (int, error)
+}
+
+func NewAutoRenewJob(r AutoRenewer, interval time.Duration) Job {
+	return Job{
+		Name:     "contract:auto_renew",
+		Interval: interval,
+		Run:      r.HandleAutoRenew,
+	}
+}
+
+func LoadAutoRenewInterval() time.Duration {
+	if v, err := time.ParseDuration(os.Getenv("CONTRACT_AUTO_RENEW_INTERVAL")); err == nil && v > 0 {
+		return v
+	}
+	return DefaultAutoRenewInterval
+}
@@ -0,0 +1,42 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+type fakeRenewer struct {
+	calls []time.Time
+	n     int
+	err   error
+}
+
+func (f *fakeRenewer) HandleAutoRenew(_ context.Context, now time.Time) (int, error) {
+	f.calls = append(f.calls, now)
+	return f.n, f.err
+}
+
+func TestNewAutoRenewJob(t *testing.T) {
+	now := time.Date(2025, 3, 1, 6, 0, 0, 0, time.UTC)
+	renewer := &fakeRenewer{n: 2, err: errors.New("boom")}
+
+	job := NewAutoRenewJob(renewer, time.Hour)
+	n, err := job.Run(context.Background(), now)
+
+	assert.Equal(t, "contract:auto_renew", job.Name)
+	assert.Equal(t, time.Hour, job.Interval)
+	assert.Equal(t, 2, n)
+	assert.EqualError(t, err, "boom")
+	assert.Equal(t, []time.Time{now}, renewer.calls)
+}
+
+func TestLoadAutoRenewInterval(t *testing.T) {
+	t.Setenv("CONTRACT_AUTO_RENEW_INTERVAL", "")
+	assert.Equal(t, DefaultAutoRenewInterval, LoadAutoRenewInterval())
+
+	t.Setenv("CONTRACT_AUTO_RENEW_INTERVAL", "15m")
+	assert.Equal(t, 15*time.Minute, LoadAutoRenewInterval())
+}
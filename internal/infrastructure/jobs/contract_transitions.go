@@ -0,0 +1,20 @@
+package jobs
+
+import (
+	"context"
+	"time"
+)
+
+type ContractTransitioner interface {
+	HandleActivateDue(ctx context.Context, now time.Time) (int, error)
+	HandleCompleteDue(ctx context.Context, now time.Time) (int, error)
+	HandleClosePastDeliveries(ctx context.Context, now time.Time) (int, error)
+}
+
+func NewContractTransitionJobs(t ContractTransitioner, interval time.Duration) []Job {
+	return []Job{
+		{Name: "contract:activate", Interval: interval, Run: t.HandleActivateDue},
+		{Name: "delivery:close_past", Interval: interval, Run: t.HandleClosePastDeliveries},
+		{Name: "contract:complete", Interval: interval, Run: t.HandleCompleteDue},
+	}
+}
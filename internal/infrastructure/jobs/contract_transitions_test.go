@@ -0,0 +1,47 @@
+package jobs
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+type fakeTransitioner struct {
+	calls []string
+}
+
+func (f *fakeTransitioner) HandleActivateDue(context.Context, time.Time) (int, error) {
+	f.calls = append(f.calls, "activate")
+	return 1, nil
+}
+
+func (f *fakeTransitioner) HandleCompleteDue(context.Context, time.Time) (int, error) {
+	f.calls = append(f.calls, "complete")
+	return 2, nil
+}
+
+func (f *fakeTransitioner) HandleClosePastDeliveries(context.Context, time.Time) (int, error) {
+	f.calls = append(f.calls, "close")
+	return 3, nil
+}
+
+func TestNewContractTransitionJobs(t *testing.T) {
+	transitioner := &fakeTransitioner{}
+	jobs := NewContractTransitionJobs(transitioner, time.Minute)
+
+	var names []string
+	var processed []int
+	for _, job := range jobs {
+		assert.Equal(t, time.Minute, job.Interval)
+		names = append(names, job.Name)
+
+		n, err := job.Run(context.Background(), time.Now())
+		assert.NoError(t, err)
+		processed = append(processed, n)
+	}
+
+	assert.Equal(t, []string{"contract:activate", "delivery:close_past", "contract:complete"}, names)
+	assert.Equal(t, []string{"activate", "close", "complete"}, transitioner.calls)
+	assert.Equal(t, []int{1, 3, 2}, processed)
+}
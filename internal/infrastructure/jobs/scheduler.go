@@ -0,0 +1,153 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"github.com/google/uuid"
+	"log"
+	"os"
+	"sync"
+	"time"
+)
+
+const (
+	DefaultSchedulerInterval = time.Minute
+	DefaultRunRetention      = 7 * 24 * time.Hour
+)
+
+type Job struct {
+	Name     string
+	Interval time.Duration
+	Run      func(ctx context.Context, now time.Time) (int, error)
+}
+
+type Locker interface {
+	TryLock(ctx context.Context, key string) (unlock func(), ok bool, err error)
+}
+
+type RunStore interface {
+	Start(ctx context.Context, run *JobRun) error
+	Finish(ctx context.Context, run *JobRun) error
+	Prune(ctx context.Context, job string, before time.Time) (int64, error)
+}
+
+type JobRun struct {
+	Id         uuid.UUID
+	Job        string
+	Instance   string
+	StartedAt  time.Time
+	FinishedAt *time.Time
+	Processed  int
+	Err        error
+}
+
+type Scheduler struct {
+	jobs      []Job
+	locker    Locker
+	runs      RunStore
+	retention time.Duration
+	instance  string
+	now       func() time.Time
+}
+
+func NewScheduler(locker Locker, runs RunStore, jobs ...Job) *Scheduler {
+	return &Scheduler{
+		jobs:      jobs,
+		locker:    locker,
+		runs:      runs,
+		retention: LoadRunRetention(),
+		instance:  instanceName(),
+		now:       time.Now,
+	}
+}
+
+func LoadSchedulerInterval() time.Duration {
+	if v, err := time.ParseDuration(os.Getenv("SCHEDULER_INTERVAL")); err == nil && v > 0 {
+		return v
+	}
+	return DefaultSchedulerInterval
+}
+
+func LoadRunRetention() time.Duration {
+	if v, err := time.ParseDuration(os.Getenv("JOB_RUN_RETENTION")); err == nil && v > 0 {
+		return v
+	}
+	return DefaultRunRetention
+}
+
+func (s *Scheduler) Run(ctx context.Context) {
+	var wg sync.WaitGroup
+	for _, job := range s.jobs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			s.loop(ctx, job)
+		}()
+	}
+
+	log.Printf("[jobs:scheduler][Run] instance '%s' scheduled %d jobs", s.instance, len(s.jobs))
+	wg.Wait()
+	log.Printf("[jobs:scheduler][Run] stopped: %v", ctx.Err())
+}
+
+func (s *Scheduler) loop(ctx context.Context, job Job) {
+	ticker := time.NewTicker(job.Interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := s.RunOnce(ctx, job); err != nil {
+			log.Printf("[jobs:scheduler][Run] job '%s' failed: %v", job.Name, err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *Scheduler) RunOnce(ctx context.Context, job Job) (bool, error) {
+	unlock, ok, err := s.locker.TryLock(ctx, job.Name)
+	if err != nil {
+		return false, fmt.Errorf("lock failed: %w", err)
+	}
+	if !ok {
+		log.Printf("[jobs:scheduler][RunOnce] job '%s' is running on another instance", job.Name)
+		return false, nil
+	}
+	defer unlock()
+
+	run := &JobRun{
+		Id:        uuid.New(),
+		Job:       job.Name,
+		Instance:  s.instance,
+		StartedAt: s.now(),
+	}
+	if err = s.runs.Start(ctx, run); err != nil {
+		return false, fmt.Errorf("recording run failed: %w", err)
+	}
+
+	run.Processed, run.Err = job.Run(ctx, run.StartedAt)
+	finished := s.now()
+	run.FinishedAt = &finished
+
+	// The run is recorded even when shutdown cancelled it.
+	if err = s.runs.Finish(context.WithoutCancel(ctx), run); err != nil {
+		log.Printf("[jobs:scheduler][RunOnce] error recording the end of job '%s': %v", job.Name, err)
+	}
+	if _, err = s.runs.Prune(context.WithoutCancel(ctx), job.Name, run.StartedAt.Add(-s.retention)); err != nil {
+		log.Printf("[jobs:scheduler][RunOnce] error pruning the old runs of job '%s': %v", job.Name, err)
+	}
+
+	log.Printf("[jobs:scheduler][RunOnce] job '%s' processed %d items in %s", job.Name, run.Processed, finished.Sub(run.StartedAt))
+	return true, run.Err
+}
+
+func instanceName() string {
+	host, err := os.Hostname()
+	if err != nil {
+		host = "unknown"
+	}
+	return fmt.Sprintf("%s:%d", host, os.Getpid())
+}
@@ -0,0 +1,192 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"sync"
+	"testing"
+	"time"
+)
+
+var ErrJobFailed = errors.New("job failed")
+
+type fakeLocker struct {
+	mu       sync.Mutex
+	held     map[string]bool
+	err      error
+	unlocked int
+}
+
+func (l *fakeLocker) TryLock(_ context.Context, key string) (func(), bool, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.err != nil {
+		return nil, false, l.err
+	}
+	if l.held[key] {
+		return nil, false, nil
+	}
+	l.held[key] = true
+
+	return func() {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		delete(l.held, key)
+		l.unlocked++
+	}, true, nil
+}
+
+type fakeRunStore struct {
+	mu       sync.Mutex
+	started  []JobRun
+	finished []JobRun
+	pruned   []time.Time
+	err      error
+}
+
+func (s *fakeRunStore) Start(_ context.Context, run *JobRun) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.started = append(s.started, *run)
+	return s.err
+}
+
+func (s *fakeRunStore) Finish(_ context.Context, run *JobRun) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.finished = append(s.finished, *run)
+	return nil
+}
+
+func (s *fakeRunStore) Prune(_ context.Context, _ string, before time.Time) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.pruned = append(s.pruned, before)
+	return 0, nil
+}
+
+func newFakeLocker() *fakeLocker {
+	return &fakeLocker{held: map[string]bool{}}
+}
+
+func TestScheduler_RunOnce(t *testing.T) {
+	now := time.Date(2025, 3, 1, 6, 0, 0, 0, time.UTC)
+	locker := newFakeLocker()
+	store := &fakeRunStore{}
+
+	var got time.Time
+	job := Job{Name: "contract:activate", Interval: time.Minute, Run: func(_ context.Context, now time.Time) (int, error) {
+		got = now
+		return 3, ErrJobFailed
+	}}
+
+	s := NewScheduler(locker, store, job)
+	s.now = func() time.Time { return now }
+	s.retention = 24 * time.Hour
+
+	ran, err := s.RunOnce(context.Background(), job)
+
+	assert.True(t, ran)
+	assert.ErrorIs(t, err, ErrJobFailed)
+	assert.Equal(t, now, got)
+	assert.Equal(t, 1, locker.unlocked)
+	assert.Len(t, store.started, 1)
+	assert.Len(t, store.finished, 1)
+
+	run := store.finished[0]
+	assert.Equal(t, store.started[0].Id, run.Id)
+	assert.Equal(t, "contract:activate", run.Job)
+	assert.NotEmpty(t, run.Instance)
+	assert.Equal(t, 3, run.Processed)
+	assert.ErrorIs(t, run.Err, ErrJobFailed)
+	assert.NotNil(t, run.FinishedAt)
+	assert.Equal(t, []time.Time{now.Add(-24 * time.Hour)}, store.pruned)
+}
+
+func TestScheduler_RunOnce_Locked(t *testing.T) {
+	locker := newFakeLocker()
+	locker.held["contract:complete"] = true
+	store := &fakeRunStore{}
+
+	called := false
+	job := Job{Name: "contract:complete", Interval: time.Minute, Run: func(context.Context, time.Time) (int, error) {
+		called = true
+		return 0, nil
+	}}
+
+	ran, err := NewScheduler(locker, store, job).RunOnce(context.Background(), job)
+
+	assert.False(t, ran)
+	assert.NoError(t, err)
+	assert.False(t, called)
+	assert.Empty(t, store.started)
+	assert.Empty(t, store.pruned)
+}
+
+func TestScheduler_RunOnce_Errors(t *testing.T) {
+	job := Job{Name: "contract:complete", Interval: time.Minute, Run: func(context.Context, time.Time) (int, error) {
+		t.Fatal("job must not run")
+		return 0, nil
+	}}
+
+	t.Run("lock", func(t *testing.T) {
+		locker := newFakeLocker()
+		locker.err = ErrJobFailed
+
+		ran, err := NewScheduler(locker, &fakeRunStore{}, job).RunOnce(context.Background(), job)
+
+		assert.False(t, ran)
+		assert.ErrorIs(t, err, ErrJobFailed)
+	})
+
+	t.Run("store", func(t *testing.T) {
+		locker := newFakeLocker()
+
+		ran, err := NewScheduler(locker, &fakeRunStore{err: ErrJobFailed}, job).RunOnce(context.Background(), job)
+
+		assert.False(t, ran)
+		assert.ErrorIs(t, err, ErrJobFailed)
+		assert.Equal(t, 1, locker.unlocked)
+	})
+}
+
+func TestScheduler_Run(t *testing.T) {
+	store := &fakeRunStore{}
+	var mu sync.Mutex
+	calls := map[string]int{}
+	count := func(name string) Job {
+		return Job{Name: name, Interval: time.Millisecond, Run: func(context.Context, time.Time) (int, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			calls[name]++
+			return 0, nil
+		}}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	NewScheduler(newFakeLocker(), store, count("a"), count("b")).Run(ctx)
+
+	assert.Greater(t, calls["a"], 1)
+	assert.Greater(t, calls["b"], 1)
+	assert.Equal(t, len(store.started), len(store.finished))
+}
+
+func TestLoadSchedulerInterval(t *testing.T) {
+	t.Setenv("SCHEDULER_INTERVAL", "")
+	assert.Equal(t, DefaultSchedulerInterval, LoadSchedulerInterval())
+
+	t.Setenv("SCHEDULER_INTERVAL", "30s")
+	assert.Equal(t, 30*time.Second, LoadSchedulerInterval())
+}
+
+func TestLoadRunRetention(t *testing.T) {
+	t.Setenv("JOB_RUN_RETENTION", "")
+	assert.Equal(t, DefaultRunRetention, LoadRunRetention())
+
+	t.Setenv("JOB_RUN_RETENTION", "72h")
+	assert.Equal(t, 72*time.Hour, LoadRunRetention())
+}
@@ -0,0 +1,59 @@
+package messaging
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+)
+
+type Handler func(ctx context.Context, msg Message) error
+
+type Dispatcher struct {
+	mu          sync.RWMutex
+	subscribers map[string][]Handler
+	all         []Handler
+}
+
+func NewDispatcher() *Dispatcher {
+	return &Dispatcher{
+		subscribers: map[string][]Handler{},
+	}
+}
+
+func (d *Dispatcher) Subscribe(name string, h Handler) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.subscribers[name] = append(d.subscribers[name], h)
+}
+
+func (d *Dispatcher) SubscribeAll(h Handler) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.all = append(d.all, h)
+}
+
+func (d *Dispatcher) Dispatch(ctx context.Context, msg Message) error {
+	d.mu.RLock()
+	handlers := append(append([]Handler(nil), d.subscribers[msg.Name]...), d.all...)
+	d.mu.RUnlock()
+
+	var errs []error
+	for _, h := range handlers {
+		if err := h(ctx, msg); err != nil {
+			log.Printf("[messaging:dispatcher][Dispatch] subscriber of '%s' failed on '%s': %v", msg.Name, msg.Id, err)
+			errs = append(errs, err)
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("%d of %d subscribers failed: %w", len(errs), len(handlers), errors.Join(errs...))
+	}
+	return nil
+}
+
+func LogSubscriber(_ context.Context, msg Message) error {
+	log.Printf("[messaging:log] %s aggregate=%s payload=%s", msg.Name, msg.AggregateId, msg.Payload)
+	return nil
+}
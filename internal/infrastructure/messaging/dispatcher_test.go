@@ -0,0 +1,57 @@
+package messaging
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestDispatcher_Dispatch(t *testing.T) {
+	d := NewDispatcher()
+	var got []string
+
+	d.Subscribe("contract.created", func(ctx context.Context, msg Message) error {
+		got = append(got, "created:"+msg.Name)
+		return nil
+	})
+	d.Subscribe("contract.completed", func(ctx context.Context, msg Message) error {
+		got = append(got, "completed:"+msg.Name)
+		return nil
+	})
+	d.SubscribeAll(func(ctx context.Context, msg Message) error {
+		got = append(got, "all:"+msg.Name)
+		return nil
+	})
+
+	err := d.Dispatch(context.Background(), Message{Id: uuid.New(), Name: "contract.created"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"created:contract.created", "all:contract.created"}, got)
+}
+
+func TestDispatcher_Dispatch_Failure(t *testing.T) {
+	d := NewDispatcher()
+	boom := errors.New("boom")
+	called := false
+
+	d.Subscribe("patient.deleted", func(ctx context.Context, msg Message) error {
+		return boom
+	})
+	d.Subscribe("patient.deleted", func(ctx context.Context, msg Message) error {
+		called = true
+		return nil
+	})
+
+	err := d.Dispatch(context.Background(), Message{Id: uuid.New(), Name: "patient.deleted"})
+
+	assert.ErrorIs(t, err, boom)
+	assert.True(t, called)
+}
+
+func TestDispatcher_Dispatch_NoSubscribers(t *testing.T) {
+	d := NewDispatcher()
+
+	assert.NoError(t, d.Dispatch(context.Background(), Message{Id: uuid.New(), Name: "delivery.delivered"}))
+}
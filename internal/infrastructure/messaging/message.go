@@ -0,0 +1,25 @@
+package messaging
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/google/uuid"
+	"time"
+)
+
+type Message struct {
+	Id          uuid.UUID
+	Name        string
+	AggregateId uuid.UUID
+	Payload     json.RawMessage
+	OccurredOn  time.Time
+	Attempts    int
+}
+
+// Store is the outbox the relay drains. GetPending is called inside a
+// transaction and must lock the rows it returns so concurrent relays skip them.
+type Store interface {
+	GetPending(ctx context.Context, limit, maxAttempts int) ([]Message, error)
+	MarkPublished(ctx context.Context, id uuid.UUID) error
+	MarkFailed(ctx context.Context, id uuid.UUID, reason string) error
+}
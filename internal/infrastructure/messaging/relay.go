@@ -0,0 +1,110 @@
+package messaging
+
+import (
+	"context"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/abstractions"
+	"log"
+	"os"
+	"strconv"
+	"time"
+)
+
+const (
+	DefaultRelayInterval = 2 * time.Second
+	DefaultRelayBatch    = 100
+	DefaultMaxAttempts   = 10
+)
+
+type RelayConfig struct {
+	Interval    time.Duration
+	Batch       int
+	MaxAttempts int
+}
+
+func LoadRelayConfig() RelayConfig {
+	c := RelayConfig{
+		Interval:    DefaultRelayInterval,
+		Batch:       DefaultRelayBatch,
+		MaxAttempts: DefaultMaxAttempts,
+	}
+
+	if v, err := time.ParseDuration(os.Getenv("OUTBOX_INTERVAL")); err == nil && v > 0 {
+		c.Interval = v
+	}
+	if v, err := strconv.Atoi(os.Getenv("OUTBOX_BATCH")); err == nil && v > 0 {
+		c.Batch = v
+	}
+	if v, err := strconv.Atoi(os.Getenv("OUTBOX_MAX_ATTEMPTS")); err == nil && v > 0 {
+		c.MaxAttempts = v
+	}
+
+	return c
+}
+
+type Relay struct {
+	store      Store
+	uow        abstractions.UnitOfWork
+	dispatcher *Dispatcher
+	config     RelayConfig
+}
+
+func NewRelay(s Store, u abstractions.UnitOfWork, d *Dispatcher, c RelayConfig) *Relay {
+	return &Relay{
+		store:      s,
+		uow:        u,
+		dispatcher: d,
+		config:     c,
+	}
+}
+
+func (r *Relay) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.config.Interval)
+	defer ticker.Stop()
+
+	log.Printf("[messaging:relay][Run] relaying outbox every %s", r.config.Interval)
+	for {
+		select {
+		case <-ctx.Done():
+			log.Printf("[messaging:relay][Run] stopped: %v", ctx.Err())
+			return
+		case <-ticker.C:
+			if _, err := r.Flush(ctx); err != nil {
+				log.Printf("[messaging:relay][Run] error flushing outbox: %v", err)
+			}
+		}
+	}
+}
+
+func (r *Relay) Flush(ctx context.Context) (int, error) {
+	published := 0
+
+	err := r.uow.Do(ctx, func(ctx context.Context) error {
+		msgs, err := r.store.GetPending(ctx, r.config.Batch, r.config.MaxAttempts)
+		if err != nil {
+			return err
+		}
+
+		for _, msg := range msgs {
+			if err = r.dispatcher.Dispatch(ctx, msg); err != nil {
+				if err = r.store.MarkFailed(ctx, msg.Id, err.Error()); err != nil {
+					return err
+				}
+				continue
+			}
+
+			if err = r.store.MarkPublished(ctx, msg.Id); err != nil {
+				return err
+			}
+			published++
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	if published > 0 {
+		log.Printf("[messaging:relay][Flush] published %d messages", published)
+	}
+	return published, nil
+}
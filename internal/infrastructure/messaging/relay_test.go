@@ -0,0 +1,104 @@
+package messaging
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+type directUnitOfWork struct {
+	calls int
+}
+
+func (u *directUnitOfWork) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	u.calls++
+	return fn(ctx)
+}
+
+type fakeStore struct {
+	pending     []Message
+	err         error
+	published   []uuid.UUID
+	failed      map[uuid.UUID]string
+	limit       int
+	maxAttempts int
+}
+
+func (s *fakeStore) GetPending(_ context.Context, limit, maxAttempts int) ([]Message, error) {
+	s.limit, s.maxAttempts = limit, maxAttempts
+	return s.pending, s.err
+}
+
+func (s *fakeStore) MarkPublished(_ context.Context, id uuid.UUID) error {
+	s.published = append(s.published, id)
+	return nil
+}
+
+func (s *fakeStore) MarkFailed(_ context.Context, id uuid.UUID, reason string) error {
+	if s.failed == nil {
+		s.failed = map[uuid.UUID]string{}
+	}
+	s.failed[id] = reason
+	return nil
+}
+
+func TestRelay_Flush(t *testing.T) {
+	ok, bad := Message{Id: uuid.New(), Name: "contract.created"}, Message{Id: uuid.New(), Name: "contract.completed"}
+	store := &fakeStore{pending: []Message{ok, bad}}
+	uow := &directUnitOfWork{}
+
+	d := NewDispatcher()
+	d.Subscribe("contract.completed", func(ctx context.Context, msg Message) error {
+		return errors.New("boom")
+	})
+
+	relay := NewRelay(store, uow, d, RelayConfig{Interval: time.Second, Batch: 25, MaxAttempts: 3})
+	n, err := relay.Flush(context.Background())
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, n)
+	assert.Equal(t, 1, uow.calls)
+	assert.Equal(t, 25, store.limit)
+	assert.Equal(t, 3, store.maxAttempts)
+	assert.Equal(t, []uuid.UUID{ok.Id}, store.published)
+	assert.Contains(t, store.failed[bad.Id], "boom")
+}
+
+func TestRelay_Flush_StoreError(t *testing.T) {
+	boom := errors.New("boom")
+	store := &fakeStore{err: boom}
+
+	relay := NewRelay(store, &directUnitOfWork{}, NewDispatcher(), RelayConfig{Interval: time.Second, Batch: 25, MaxAttempts: 3})
+	n, err := relay.Flush(context.Background())
+
+	assert.ErrorIs(t, err, boom)
+	assert.Zero(t, n)
+}
+
+func TestRelay_Run(t *testing.T) {
+	store := &fakeStore{}
+	uow := &directUnitOfWork{}
+	relay := NewRelay(store, uow, NewDispatcher(), RelayConfig{Interval: time.Millisecond, Batch: 25, MaxAttempts: 3})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	relay.Run(ctx)
+
+	assert.Positive(t, uow.calls)
+}
+
+func TestLoadRelayConfig(t *testing.T) {
+	t.Setenv("OUTBOX_INTERVAL", "500ms")
+	t.Setenv("OUTBOX_BATCH", "20")
+	t.Setenv("OUTBOX_MAX_ATTEMPTS", "nope")
+
+	c := LoadRelayConfig()
+
+	assert.Equal(t, 500*time.Millisecond, c.Interval)
+	assert.Equal(t, 20, c.Batch)
+	assert.Equal(t, DefaultMaxAttempts, c.MaxAttempts)
+}
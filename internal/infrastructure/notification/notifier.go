@@ -0,0 +1,70 @@
+package notification
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/abstractions"
+	"log"
+	"os"
+	"sync"
+	"time"
+)
+
+type LogNotifier struct{}
+
+func NewLogNotifier() *LogNotifier {
+	return &LogNotifier{}
+}
+
+func (n *LogNotifier) Notify(_ context.Context, msg abstractions.Notification) error {
+	log.Printf("[notification:log][Notify] to=%s subject=%q body=%q", msg.To, msg.Subject, msg.Body)
+	return nil
+}
+
+type FileNotifier struct {
+	mu   sync.Mutex
+	path string
+}
+
+type fileRecord struct {
+	SentAt  time.Time `json:"sent_at"`
+	To      string    `json:"to"`
+	Subject string    `json:"subject"`
+	Body    string    `json:"body"`
+}
+
+func NewFileNotifier(path string) *FileNotifier {
+	return &FileNotifier{path: path}
+}
+
+func (n *FileNotifier) Notify(_ context.Context, msg abstractions.Notification) error {
+	line, err := json.Marshal(fileRecord{time.Now(), msg.To, msg.Subject, msg.Body})
+	if err != nil {
+		return err
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	f, err := os.OpenFile(n.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		log.Printf("[notification:file][Notify] error opening '%s': %v", n.path, err)
+		return fmt.Errorf("open notification file: %w", err)
+	}
+	defer f.Close()
+
+	if _, err = f.Write(append(line, '\n')); err != nil {
+		log.Printf("[notification:file][Notify] error writing '%s': %v", n.path, err)
+		return fmt.Errorf("write notification file: %w", err)
+	}
+
+	return nil
+}
+
+func LoadNotifier() abstractions.Notifier {
+	if path := os.Getenv("NOTIFICATION_FILE"); path != "" {
+		return NewFileNotifier(path)
+	}
+	return NewLogNotifier()
+}
@@ -0,0 +1,48 @@
+package notification
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/abstractions"
+	"github.com/stretchr/testify/assert"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestFileNotifier_Notify(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "notifications.log")
+	n := NewFileNotifier(path)
+
+	assert.NoError(t, n.Notify(context.Background(), abstractions.Notification{To: "a@example.com", Subject: "First", Body: "one"}))
+	assert.NoError(t, n.Notify(context.Background(), abstractions.Notification{To: "b@example.com", Subject: "Second", Body: "two"}))
+
+	raw, err := os.ReadFile(path)
+	assert.NoError(t, err)
+
+	lines := strings.Split(strings.TrimSpace(string(raw)), "\n")
+	assert.Len(t, lines, 2)
+
+	var record fileRecord
+	assert.NoError(t, json.Unmarshal([]byte(lines[1]), &record))
+	assert.Equal(t, "b@example.com", record.To)
+	assert.Equal(t, "Second", record.Subject)
+	assert.Equal(t, "two", record.Body)
+}
+
+func TestFileNotifier_Notify_Error(t *testing.T) {
+	n := NewFileNotifier(filepath.Join(t.TempDir(), "missing", "notifications.log"))
+
+	err := n.Notify(context.Background(), abstractions.Notification{To: "a@example.com"})
+
+	assert.Error(t, err)
+}
+
+func TestLoadNotifier(t *testing.T) {
+	t.Setenv("NOTIFICATION_FILE", "")
+	assert.IsType(t, &LogNotifier{}, LoadNotifier())
+
+	t.Setenv("NOTIFICATION_FILE", filepath.Join(t.TempDir(), "out.log"))
+	assert.IsType(t, &FileNotifier{}, LoadNotifier())
+}
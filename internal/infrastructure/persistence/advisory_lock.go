@@ -0,0 +1,54 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"log"
+)
+
+const (
+	QueryTryAdvisoryLock = `SELECT pg_try_advisory_lock(hashtext($1))`
+	QueryAdvisoryUnlock  = `SELECT pg_advisory_unlock(hashtext($1))`
+)
+
+type AdvisoryLocker struct {
+	db *sql.DB
+}
+
+func NewAdvisoryLocker(db *sql.DB) *AdvisoryLocker {
+	return &AdvisoryLocker{db: db}
+}
+
+func (l *AdvisoryLocker) TryLock(ctx context.Context, key string) (func(), bool, error) {
+	conn, err := l.db.Conn(ctx)
+	if err != nil {
+		log.Printf("[infrastructure:advisory_lock] error getting connection: %v", err)
+		return nil, false, fmt.Errorf("connection failed: %w", err)
+	}
+
+	var ok bool
+	if err = conn.QueryRowContext(ctx, QueryTryAdvisoryLock, key).Scan(&ok); err != nil {
+		_ = conn.Close()
+		log.Printf("[infrastructure:advisory_lock] error locking '%s': %v", key, err)
+		return nil, false, fmt.Errorf("lock failed: %w", err)
+	}
+
+	if !ok {
+		_ = conn.Close()
+		return nil, false, nil
+	}
+
+	unlock := func() {
+		if _, err := conn.ExecContext(context.WithoutCancel(ctx), QueryAdvisoryUnlock, key); err != nil {
+			log.Printf("[infrastructure:advisory_lock] error unlocking '%s', discarding the connection: %v", key, err)
+			// A pooled session would keep holding the lock, so the
+			// connection is thrown away instead of returned.
+			_ = conn.Raw(func(any) error { return driver.ErrBadConn })
+		}
+		_ = conn.Close()
+	}
+
+	return unlock, true, nil
+}
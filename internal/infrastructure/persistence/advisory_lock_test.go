@@ -0,0 +1,66 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"regexp"
+	"testing"
+)
+
+var ErrAdvisoryLock = errors.New("advisory lock failed")
+
+func TestAdvisoryLocker_TryLock(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryTryAdvisoryLock)).
+		WithArgs("contract:activate").
+		WillReturnRows(sqlmock.NewRows([]string{"pg_try_advisory_lock"}).AddRow(true))
+	mock.ExpectExec(regexp.QuoteMeta(QueryAdvisoryUnlock)).
+		WithArgs("contract:activate").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	unlock, ok, err := NewAdvisoryLocker(db).TryLock(context.Background(), "contract:activate")
+
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	unlock()
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestAdvisoryLocker_TryLock_Held(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryTryAdvisoryLock)).
+		WithArgs("contract:activate").
+		WillReturnRows(sqlmock.NewRows([]string{"pg_try_advisory_lock"}).AddRow(false))
+
+	unlock, ok, err := NewAdvisoryLocker(db).TryLock(context.Background(), "contract:activate")
+
+	assert.NoError(t, err)
+	assert.False(t, ok)
+	assert.Nil(t, unlock)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestAdvisoryLocker_TryLock_Error(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryTryAdvisoryLock)).
+		WithArgs("contract:activate").
+		WillReturnError(ErrAdvisoryLock)
+
+	unlock, ok, err := NewAdvisoryLocker(db).TryLock(context.Background(), "contract:activate")
+
+	assert.ErrorIs(t, err, ErrAdvisoryLock)
+	assert.False(t, ok)
+	assert.Nil(t, unlock)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
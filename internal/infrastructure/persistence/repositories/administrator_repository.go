@@ -5,7 +5,9 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/abstractions"
 	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/administrator"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/infrastructure/persistence"
 	"github.com/google/uuid"
 	"log"
 	"time"
@@ -19,9 +21,6 @@ const (
 	got                       = "%w: got %w"
 	QueryGetAllAdministrators = `SELECT id, first_name, last_name, email, password, gender, birth, phone, last_login_at, created_at, updated_at, deleted_at
 								FROM administrator`
-	QueryGetListAdministrators = `SELECT id, first_name, last_name, email, password, gender, birth, phone, last_login_at, created_at, updated_at, deleted_at
-									FROM administrator
-									WHERE deleted_at IS NULL`
 	QueryGetAdministratorById = `SELECT first_name, last_name, email, password, gender, birth, phone, last_login_at, created_at, updated_at, deleted_at
 									FROM administrator
 									WHERE id = $1`
@@ -36,6 +35,9 @@ const (
 										FROM administrator
 										WHERE email = $1 
 										AND deleted_at IS NULL)`
+	QueryIsSuperAdministrator = `SELECT EXISTS(SELECT 1
+									FROM super_administrator
+									WHERE administrator_id = $1)`
 	QueryCreateAdministrator = `INSERT INTO administrator(id, first_name, last_name, email, password, gender, birth, phone)
 								VALUES($1, $2, $3, $4, $5, $6, $7, $8)
 								RETURNING id, first_name, last_name, email, password, gender, birth, phone, last_login_at, created_at, updated_at, deleted_at`
@@ -68,7 +70,17 @@ var (
 	ErrIterationRowsAdministrator = errors.New("rows iteration error")
 )
 
-func (r *AdministratorRepository) GetAll(ctx context.Context) ([]*administrators.Administrator, error) {
+func (r *AdministratorRepository) GetAll(ctx context.Context, filter administrators.AdministratorFilter, pagination abstractions.Pagination) (*abstractions.Page[*administrators.Administrator], error) {
+	return r.list(ctx, "GetAll", filter, pagination)
+}
+
+func (r *AdministratorRepository) GetList(ctx context.Context, filter administrators.AdministratorFilter, pagination abstractions.Pagination) (*abstractions.Page[*administrators.Administrator], error) {
+	deleted := false
+	filter.Deleted = &deleted
+	return r.list(ctx, "GetList", filter, pagination)
+}
+
+func (r *AdministratorRepository) list(ctx context.Context, method string, filter administrators.AdministratorFilter, pagination abstractions.Pagination) (*abstractions.Page[*administrators.Administrator], error) {
 	var (
 		admins                                       []*administrators.Administrator
 		id                                           uuid.UUID
@@ -76,80 +88,45 @@ func (r *AdministratorRepository) GetAll(ctx context.Context) ([]*administrators
 		lastLoginAt, createdAt, updatedAt, birth     time.Time
 		deletedAt                                    *time.Time
 		phone                                        *string
+		total                                        int
+		genderCode                                   *string
 	)
 
-	rows, err := r.Db.QueryContext(ctx, QueryGetAllAdministrators)
-	if err != nil {
-		log.Printf("[repository:administrator][GetAll] error executing SQL query '%s': %v", QueryGetAllAdministrators, err)
-		return nil, fmt.Errorf(got, ErrQueryAdministrator, err)
-	}
-
-	defer func(rows *sql.Rows) {
-		if err = rows.Close(); err != nil {
-			log.Printf("[repository:administrator][GetAll] failed to close rows: %v", err)
-			return
-		}
-	}(rows)
-	for rows.Next() {
-		err = rows.Scan(&id, &firstName, &lastName, &email, &password, &gender, &birth, &phone, &lastLoginAt, &createdAt, &updatedAt, &deletedAt)
-		if err != nil {
-			log.Printf("[repository:administrator][GetAll] error reading adminDTO for a slice of admins: %v", err)
-			return nil, fmt.Errorf(got, ErrScanAdministrator, err)
-		}
-
-		admin, err := administrators.NewAdministratorFromDB(id, firstName, lastName, email, password, gender, birth, phone, lastLoginAt, createdAt, updatedAt, deletedAt)
-		if err != nil {
-			log.Printf("[repository:adminstrator][GetAll] error concatenating administrator values from DB")
-			return nil, fmt.Errorf(got, ErrConcatenatingAdministrator, err)
-		}
-
-		admins = append(admins, admin)
+	if filter.Gender != nil {
+		code := string(*filter.Gender)
+		genderCode = &code
 	}
 
-	if err = rows.Err(); err != nil {
-		log.Printf("[repository:administrator][GetAll] error reading admins: %v", err)
-		return nil, fmt.Errorf(got, ErrIterationRowsAdministrator, err)
+	q := peopleListQuery(filter.Name, genderCode, filter.Deleted)
+	query, args, err := q.page(QueryGetAllAdministrators, peopleSortColumns, "created_at", pagination)
+	if err != nil {
+		log.Printf("[repository:administrator][%s] invalid pagination: %v", method, err)
+		return nil, err
 	}
 
-	log.Printf("[repository:administrator][GetAll] successfully fetched %d administrators", len(admins))
-	return admins, nil
-}
-
-func (r *AdministratorRepository) GetList(ctx context.Context) ([]*administrators.Administrator, error) {
-	var (
-		admins                                       []*administrators.Administrator
-		id                                           uuid.UUID
-		firstName, lastName, email, password, gender string
-		lastLoginAt, createdAt, updatedAt, birth     time.Time
-		deletedAt                                    *time.Time
-		phone                                        *string
-	)
-
-	rows, err := r.Db.QueryContext(ctx, QueryGetListAdministrators)
+	rows, err := r.conn(ctx).QueryContext(ctx, query, args...)
 	if err != nil {
-		log.Printf("[repository:administrator][GetList] error executing SQL query '%s': %v", QueryGetListAdministrators, err)
+		log.Printf("[repository:administrator][%s] error executing SQL query '%s': %v", method, query, err)
 		return nil, fmt.Errorf(got, ErrQueryAdministrator, err)
 	}
 
 	defer func(rows *sql.Rows) {
-		err = rows.Close()
-		if err != nil {
-			log.Printf("[repository:administrator][GetList] failed to close rows: %v", err)
+		if err := rows.Close(); err != nil {
+			log.Printf("[repository:administrator][%s] failed to close rows: %v", method, err)
 		}
 	}(rows)
-
 	for rows.Next() {
 		err = rows.Scan(
 			&id, &firstName, &lastName, &email, &password, &gender, &birth, &phone, &lastLoginAt, &createdAt, &updatedAt, &deletedAt,
 		)
 		if err != nil {
-			log.Printf("[repository:administrator][GetList] error reading adminDTO for a slice of admins: %v", err)
+			log.Printf("[repository:administrator][%s] error reading administrator rows: %v", method, err)
 			return nil, fmt.Errorf(got, ErrScanAdministrator, err)
 		}
 
 		admin, err := administrators.NewAdministratorFromDB(id, firstName, lastName, email, password, gender, birth, phone, lastLoginAt, createdAt, updatedAt, deletedAt)
 		if err != nil {
-			log.Printf("[repository:adminstrator][GetList] error concatenating administrator values from DB")
+			log.Printf("[repository:administrator][%s] error concatenating administrator values from DB", method)
 			return nil, fmt.Errorf(got, ErrConcatenatingAdministrator, err)
 		}
 
@@ -157,12 +134,18 @@ func (r *AdministratorRepository) GetList(ctx context.Context) ([]*administrator
 	}
 
 	if err = rows.Err(); err != nil {
-		log.Printf("[repository:administrator][GetList] error Reading admins: %v", err)
+		log.Printf("[repository:administrator][%s] error reading administrators: %v", method, err)
 		return nil, fmt.Errorf(got, ErrIterationRowsAdministrator, err)
 	}
 
-	log.Printf("[repository:administrator][GetList] successfully fetched %d administrators", len(admins))
-	return admins, nil
+	countQuery, countArgs := q.count(QueryCountAllAdministrators)
+	if err = r.conn(ctx).QueryRowContext(ctx, countQuery, countArgs...).Scan(&total); err != nil {
+		log.Printf("[repository:administrator][%s] error executing SQL query '%s': %v", method, countQuery, err)
+		return nil, fmt.Errorf(got, ErrQueryAdministrator, err)
+	}
+
+	log.Printf("[repository:administrator][%s] successfully fetched %d of %d administrators", method, len(admins), total)
+	return abstractions.NewPage(admins, total, pagination), nil
 }
 
 func (r *AdministratorRepository) GetById(ctx context.Context, id uuid.UUID) (*administrators.Administrator, error) {
@@ -173,7 +156,7 @@ func (r *AdministratorRepository) GetById(ctx context.Context, id uuid.UUID) (*a
 		phone                                        *string
 	)
 
-	err := r.Db.QueryRowContext(ctx, QueryGetAdministratorById, id).Scan(
+	err := r.conn(ctx).QueryRowContext(ctx, QueryGetAdministratorById, id).Scan(
 		&firstName, &lastName, &email, &password, &gender, &birth, &phone, &lastLoginAt, &createdAt, &updatedAt, &deletedAt,
 	)
 	if err != nil {
@@ -200,7 +183,7 @@ func (r *AdministratorRepository) GetByEmail(ctx context.Context, email string)
 		phone                                    *string
 	)
 
-	err := r.Db.QueryRowContext(ctx, QueryGetAdministratorByEmail, email).Scan(
+	err := r.conn(ctx).QueryRowContext(ctx, QueryGetAdministratorByEmail, email).Scan(
 		&id, &firstName, &lastName, &password, &gender, &birth, &phone, &lastLoginAt, &createdAt, &updatedAt, &deletedAt,
 	)
 	if err != nil {
@@ -221,7 +204,7 @@ func (r *AdministratorRepository) GetByEmail(ctx context.Context, email string)
 func (r *AdministratorRepository) ExistById(ctx context.Context, id uuid.UUID) (bool, error) {
 	var exist bool
 
-	err := r.Db.QueryRowContext(ctx, QueryExistAdministratorById, id).Scan(&exist)
+	err := r.conn(ctx).QueryRowContext(ctx, QueryExistAdministratorById, id).Scan(&exist)
 	if err != nil {
 		log.Printf("[repository:administrator][ExistById] error executing SQL query '%s': %v", QueryExistAdministratorById, err)
 		return false, fmt.Errorf(got, ErrQueryAdministrator, err)
@@ -234,7 +217,7 @@ func (r *AdministratorRepository) ExistById(ctx context.Context, id uuid.UUID) (
 func (r *AdministratorRepository) ExistByEmail(ctx context.Context, email string) (bool, error) {
 	var exist bool
 
-	err := r.Db.QueryRowContext(ctx, QueryExistAdministratorByEmail, email).Scan(&exist)
+	err := r.conn(ctx).QueryRowContext(ctx, QueryExistAdministratorByEmail, email).Scan(&exist)
 	if err != nil {
 		log.Printf("[repository:administrator][ExistByEmail] error executing SQL query '%s': %v", QueryExistAdministratorByEmail, err)
 		return false, fmt.Errorf(got, ErrQueryAdministrator, err)
@@ -244,6 +227,18 @@ func (r *AdministratorRepository) ExistByEmail(ctx context.Context, email string
 	return exist, nil
 }
 
+func (r *AdministratorRepository) IsSuper(ctx context.Context, id uuid.UUID) (bool, error) {
+	var super bool
+
+	err := r.conn(ctx).QueryRowContext(ctx, QueryIsSuperAdministrator, id).Scan(&super)
+	if err != nil {
+		log.Printf("[repository:administrator][IsSuper] error executing SQL query '%s': %v", QueryIsSuperAdministrator, err)
+		return false, fmt.Errorf(got, ErrQueryAdministrator, err)
+	}
+
+	return super, nil
+}
+
 func (r *AdministratorRepository) Create(ctx context.Context, adm *administrators.Administrator) (*administrators.Administrator, error) {
 	var (
 		id                                           uuid.UUID
@@ -258,7 +253,7 @@ func (r *AdministratorRepository) Create(ctx context.Context, adm *administrator
 		phoneVal = s
 	}
 
-	err := r.Db.QueryRowContext(
+	err := r.conn(ctx).QueryRowContext(
 		ctx, QueryCreateAdministrator, adm.Id(), adm.FirstName(), adm.LastName(), adm.Email().Value(), adm.Password().String(), adm.Gender(), adm.Birth().Value(), phoneVal,
 	).Scan(
 		&id, &firstName, &lastName, &email, &password, &gender, &birth, &phone, &lastLoginAt, &createdAt, &updatedAt, &deletedAt,
@@ -292,7 +287,7 @@ func (r *AdministratorRepository) Update(ctx context.Context, adm *administrator
 		phone = adm.Phone().String()
 	}
 
-	err := r.Db.QueryRowContext(
+	err := r.conn(ctx).QueryRowContext(
 		ctx, QueryUpdateAdministrator, adm.FirstName(), adm.LastName(), adm.Email().Value(), adm.Password().String(), adm.Gender(), adm.Birth().Value(), phone, adm.LastLoginAt(), adm.UpdatedAt(), adm.Id(),
 	).Scan(
 		&id, &firstName, &lastName, &email, &password, &gender, &birth, &phone, &lastLoginAt, &createdAt, &updatedAt, &deletedAt,
@@ -321,7 +316,7 @@ func (r *AdministratorRepository) Delete(ctx context.Context, id uuid.UUID) (*ad
 		phone                                        *string
 	)
 
-	err := r.Db.QueryRowContext(ctx, QueryDeleteAdministrator, id).Scan(
+	err := r.conn(ctx).QueryRowContext(ctx, QueryDeleteAdministrator, id).Scan(
 		&firstName, &lastName, &email, &password, &gender, &birth, &phone, &lastLoginAt, &createdAt, &updatedAt, &deletedAt,
 	)
 
@@ -348,7 +343,7 @@ func (r *AdministratorRepository) Restore(ctx context.Context, id uuid.UUID) (*a
 		phone                                        *string
 	)
 
-	err := r.Db.QueryRowContext(ctx, QueryRestoreAdministrator, id).Scan(&firstName, &lastName, &email, &password, &gender, &birth, &phone, &lastLoginAt, &createdAt, &updatedAt, &deletedAt)
+	err := r.conn(ctx).QueryRowContext(ctx, QueryRestoreAdministrator, id).Scan(&firstName, &lastName, &email, &password, &gender, &birth, &phone, &lastLoginAt, &createdAt, &updatedAt, &deletedAt)
 
 	if err != nil {
 		log.Printf("[repository:administrator][Restore] error executing SQL query '%s': %v", QueryRestoreAdministrator, err)
@@ -368,7 +363,7 @@ func (r *AdministratorRepository) Restore(ctx context.Context, id uuid.UUID) (*a
 func (r *AdministratorRepository) CountAll(ctx context.Context) (int, error) {
 	var count int
 
-	err := r.Db.QueryRowContext(ctx, QueryCountAllAdministrators).Scan(&count)
+	err := r.conn(ctx).QueryRowContext(ctx, QueryCountAllAdministrators).Scan(&count)
 	if err != nil {
 		log.Printf("[repository:administrator][CountAll] error executing SQL query in CountAll: %v", err)
 		return -1, fmt.Errorf(got, ErrQueryAdministrator, err)
@@ -379,7 +374,7 @@ func (r *AdministratorRepository) CountAll(ctx context.Context) (int, error) {
 func (r *AdministratorRepository) CountActive(ctx context.Context) (int, error) {
 	var count int
 
-	err := r.Db.QueryRowContext(ctx, QueryCountActiveAdministrators).Scan(&count)
+	err := r.conn(ctx).QueryRowContext(ctx, QueryCountActiveAdministrators).Scan(&count)
 	if err != nil {
 		log.Printf("[repository:administrator][CountActive] error executing SQL query in CountActive: %v", err)
 		return -1, fmt.Errorf(got, ErrQueryAdministrator, err)
@@ -390,7 +385,7 @@ func (r *AdministratorRepository) CountActive(ctx context.Context) (int, error)
 func (r *AdministratorRepository) CountDeleted(ctx context.Context) (int, error) {
 	var count int
 
-	err := r.Db.QueryRowContext(ctx, QueryCountDeletedAdministrators).Scan(&count)
+	err := r.conn(ctx).QueryRowContext(ctx, QueryCountDeletedAdministrators).Scan(&count)
 	if err != nil {
 		log.Printf("[repository:administrator][CountDeleted] error executing SQL query in CountDeleted: %v", err)
 		return -1, fmt.Errorf(got, ErrQueryAdministrator, err)
@@ -398,6 +393,10 @@ func (r *AdministratorRepository) CountDeleted(ctx context.Context) (int, error)
 	return count, nil
 }
 
+func (r *AdministratorRepository) conn(ctx context.Context) persistence.DBTX {
+	return persistence.Executor(ctx, r.Db)
+}
+
 func NewAdministratorRepository(db *sql.DB) administrators.AdministratorRepository {
 	return &AdministratorRepository{Db: db}
 }
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/abstractions"
 	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/administrator"
 	vo "github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
 	"github.com/google/uuid"
@@ -64,9 +65,13 @@ func TestAdministratorRepository_GetAll(t *testing.T) {
 	}
 
 	mock.ExpectQuery(QueryGetAllAdministrators).WillReturnRows(rows)
+	mock.ExpectQuery(regexp.QuoteMeta(QueryCountAllAdministrators)).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(len(cases)))
 
-	admins, err := repo.GetAll(context.Background())
+	page, err := repo.GetAll(context.Background(), administrators.AdministratorFilter{}, abstractions.Pagination{})
 	assert.NoError(t, err)
+	assert.Equal(t, len(cases), page.Total)
+
+	admins := page.Items
 	assert.Len(t, admins, len(cases))
 
 	for i, tc := range cases {
@@ -88,7 +93,7 @@ func TestAdministratorRepository_GetAll_QueryError(t *testing.T) {
 
 	mock.ExpectQuery(QueryGetAllAdministrators).WillReturnError(ErrDatabaseAdministrator)
 
-	admins, err := repo.GetAll(context.Background())
+	admins, err := repo.GetAll(context.Background(), administrators.AdministratorFilter{}, abstractions.Pagination{})
 
 	assert.Nil(t, admins)
 	assert.Error(t, err)
@@ -111,7 +116,7 @@ func TestAdministratorRepository_GetAll_ScanError(t *testing.T) {
 
 	mock.ExpectQuery(QueryGetAllAdministrators).WillReturnRows(rows)
 
-	admins, err := repo.GetAll(context.Background())
+	admins, err := repo.GetAll(context.Background(), administrators.AdministratorFilter{}, abstractions.Pagination{})
 
 	assert.Nil(t, admins)
 	assert.Error(t, err)
@@ -131,7 +136,7 @@ func TestAdministratorRepository_GetAll_NewAdministratorError(t *testing.T) {
 
 	mock.ExpectQuery(QueryGetAllAdministrators).WillReturnRows(rows)
 
-	admins, err := repo.GetAll(context.Background())
+	admins, err := repo.GetAll(context.Background(), administrators.AdministratorFilter{}, abstractions.Pagination{})
 
 	assert.Nil(t, admins)
 	assert.Error(t, err)
@@ -164,10 +169,14 @@ func TestAdministratorRepository_GetList(t *testing.T) {
 		)
 	}
 
-	mock.ExpectQuery(regexp.QuoteMeta(QueryGetListAdministrators)).WillReturnRows(filteredRows)
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetAllAdministrators + " WHERE deleted_at IS NULL")).WillReturnRows(filteredRows)
+	mock.ExpectQuery(regexp.QuoteMeta(QueryCountAllAdministrators)).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(len(filteredCases)))
 
-	admins, err := repo.GetList(context.Background())
+	page, err := repo.GetList(context.Background(), administrators.AdministratorFilter{}, abstractions.Pagination{})
 	assert.NoError(t, err)
+	assert.Equal(t, len(filteredCases), page.Total)
+
+	admins := page.Items
 	assert.Len(t, admins, len(filteredCases))
 
 	for i, admin := range admins {
@@ -188,9 +197,9 @@ func TestAdministratorRepository_GetList_QueryError(t *testing.T) {
 
 	repo := NewAdministratorRepository(db)
 
-	mock.ExpectQuery(QueryGetListAdministrators).WillReturnError(ErrDatabaseAdministrator)
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetAllAdministrators + " WHERE deleted_at IS NULL")).WillReturnError(ErrDatabaseAdministrator)
 
-	admins, err := repo.GetList(context.Background())
+	admins, err := repo.GetList(context.Background(), administrators.AdministratorFilter{}, abstractions.Pagination{})
 
 	assert.Nil(t, admins)
 	assert.Error(t, err)
@@ -211,9 +220,9 @@ func TestAdministratorRepository_GetList_ScanError(t *testing.T) {
 	newColumns := []string{"id", "first_name"}
 	rows := sqlmock.NewRows(newColumns).AddRow(uuid.New(), "John")
 
-	mock.ExpectQuery(QueryGetListAdministrators).WillReturnRows(rows)
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetAllAdministrators + " WHERE deleted_at IS NULL")).WillReturnRows(rows)
 
-	admins, err := repo.GetList(context.Background())
+	admins, err := repo.GetList(context.Background(), administrators.AdministratorFilter{}, abstractions.Pagination{})
 
 	assert.Nil(t, admins)
 	assert.Error(t, err)
@@ -231,9 +240,9 @@ func TestAdministratorRepository_GetList_NewAdministratorError(t *testing.T) {
 	repo := NewAdministratorRepository(db)
 	rows := sqlmock.NewRows(columns).AddRow(uuid.New(), "Invalid", "User", "invalid-email", "$2a$10$abcdefghijklmnopqrstuvabcdefghijklmnopqrstuvab", "male", time.Now().AddDate(-10, 0, 0), nil, time.Now(), time.Now(), time.Now(), nil)
 
-	mock.ExpectQuery(QueryGetListAdministrators).WillReturnRows(rows)
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetAllAdministrators + " WHERE deleted_at IS NULL")).WillReturnRows(rows)
 
-	admins, err := repo.GetList(context.Background())
+	admins, err := repo.GetList(context.Background(), administrators.AdministratorFilter{}, abstractions.Pagination{})
 
 	assert.Nil(t, admins)
 	assert.Error(t, err)
@@ -243,6 +252,62 @@ func TestAdministratorRepository_GetList_NewAdministratorError(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestAdministratorRepository_GetAll_Filters(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewAdministratorRepository(db)
+	cases := Cases()
+	tc := cases[1]
+
+	deleted := false
+	female := vo.Female
+	filter := administrators.AdministratorFilter{Name: " Doe ", Gender: &female, Deleted: &deleted}
+	pagination := abstractions.Pagination{Limit: 1, Sort: "last_name"}
+
+	where := " WHERE deleted_at IS NULL AND gender = $1 AND (first_name ILIKE $2 OR last_name ILIKE $2)"
+	rows := sqlmock.NewRows(columns).AddRow(tc.id, tc.firstName, tc.lastName, tc.email, tc.password, tc.gender, tc.birth, tc.phone, tc.lastLoginAt, tc.createdAt, tc.updatedAt, tc.deletedAt)
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetAllAdministrators+where+" ORDER BY last_name ASC, id ASC LIMIT $3 OFFSET $4")).
+		WithArgs("F", "%Doe%", 1, 0).
+		WillReturnRows(rows)
+	mock.ExpectQuery(regexp.QuoteMeta(QueryCountAllAdministrators+where)).
+		WithArgs("F", "%Doe%").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+
+	page, err := repo.GetAll(context.Background(), filter, pagination)
+
+	assert.NoError(t, err)
+	assert.Len(t, page.Items, 1)
+	assert.Equal(t, 2, page.Total)
+	assert.Equal(t, abstractions.EncodeCursor(1), page.NextCursor())
+	testCases(t, tc, page.Items[0])
+
+	err = mock.ExpectationsWereMet()
+	assert.NoError(t, err)
+}
+
+func TestAdministratorRepository_GetAll_CountError(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewAdministratorRepository(db)
+
+	mock.ExpectQuery(QueryGetAllAdministrators).WillReturnRows(sqlmock.NewRows(columns))
+	mock.ExpectQuery(regexp.QuoteMeta(QueryCountAllAdministrators)).WillReturnError(ErrDatabaseAdministrator)
+
+	page, err := repo.GetAll(context.Background(), administrators.AdministratorFilter{}, abstractions.Pagination{})
+
+	assert.Nil(t, page)
+	assert.ErrorIs(t, err, ErrQueryAdministrator)
+	assert.ErrorIs(t, err, ErrDatabaseAdministrator)
+
+	err = mock.ExpectationsWereMet()
+	assert.NoError(t, err)
+}
+
 func TestAdministratorRepository_GetById(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	assert.NoError(t, err)
@@ -487,6 +552,47 @@ func TestAdministratorRepository_ExistByEmail_QueryError(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestAdministratorRepository_IsSuper(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewAdministratorRepository(db)
+
+	for _, super := range []bool{true, false} {
+		id := uuid.New()
+		mock.ExpectQuery(regexp.QuoteMeta(QueryIsSuperAdministrator)).WithArgs(id).WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(super))
+
+		result, err := repo.IsSuper(context.Background(), id)
+
+		assert.NoError(t, err)
+		assert.Equal(t, super, result)
+	}
+
+	err = mock.ExpectationsWereMet()
+	assert.NoError(t, err)
+}
+
+func TestAdministratorRepository_IsSuper_QueryError(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewAdministratorRepository(db)
+	id := uuid.New()
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryIsSuperAdministrator)).WithArgs(id).WillReturnError(ErrDatabaseAdministrator)
+
+	super, err := repo.IsSuper(context.Background(), id)
+
+	assert.False(t, super)
+	assert.ErrorIs(t, err, ErrQueryAdministrator)
+	assert.ErrorIs(t, err, ErrDatabaseAdministrator)
+
+	err = mock.ExpectationsWereMet()
+	assert.NoError(t, err)
+}
+
 func TestAdministratorRepository_Create(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	assert.NoError(t, err)
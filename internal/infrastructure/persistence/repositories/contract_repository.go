@@ -3,10 +3,17 @@ package repositories
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/abstractions"
 	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/contract"
 	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/delivery"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/pricing"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/infrastructure/persistence"
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 	"log"
 	"strings"
 	"time"
@@ -16,23 +23,132 @@ type ContractRepository struct {
 	DB *sql.DB
 }
 
-func (r *ContractRepository) GetAll(ctx context.Context) ([]*contracts.Contract, error) {
-	var (
-		cntrcts                                    []*contracts.Contract
+const (
+	QueryGetAllContracts = `SELECT id, administrator_id, patient_id, type, status, status_reason, suspended_at, auto_renew, renewed_from, creation, start, finalized, cost, currency, weekdays, slot_id, created_at, updated_at, deleted_at
+							FROM contract`
+	QueryCountContracts = `SELECT COUNT(*)
+							FROM contract`
+	QueryExistsRenewalContract = `SELECT EXISTS(
+									SELECT 1
+									FROM contract
+									WHERE renewed_from = $1
+								)`
+	QueryGetAutoRenewableContracts = `SELECT c.id
+										FROM contract AS c
+										WHERE c.auto_renew AND c.status IN ('A', 'F') AND c.deleted_at IS NULL AND c.finalized >= $1 AND c.finalized < $2
+											AND NOT EXISTS(SELECT 1 FROM contract AS r WHERE r.renewed_from = c.id)
+										ORDER BY c.finalized, c.id`
+	QueryGetContractsToActivate = `SELECT id
+									FROM contract
+									WHERE status = 'C' AND deleted_at IS NULL AND start < $1
+									ORDER BY start, id`
+	QueryGetContractsToComplete = `SELECT id
+									FROM contract
+									WHERE status = 'A' AND deleted_at IS NULL AND finalized < $1
+									ORDER BY finalized, id`
+	QueryGetContractsWithPastDeliveries = `SELECT DISTINCT d.contract_id
+											FROM delivery AS d
+											JOIN contract AS c ON c.id = d.contract_id
+											WHERE c.status = 'A' AND c.deleted_at IS NULL AND d.status = 'P' AND d.deleted_at IS NULL AND d.date < $1
+											ORDER BY d.contract_id`
+	QuerySetAutoRenewContract = `UPDATE contract
+									SET auto_renew = $1, updated_at = NOW()
+									WHERE id = $2`
+	QueryGetContractPrice = `SELECT currency, base, deliveries, zone, surcharge, promo_code, discount, taxes, total
+								FROM contract_price
+								WHERE contract_id = $1`
+	QueryGetContractPrices = `SELECT contract_id, currency, base, deliveries, zone, surcharge, promo_code, discount, taxes, total
+								FROM contract_price
+								WHERE contract_id = ANY($1::uuid[])`
+	QueryCreateContractPrice = `INSERT INTO contract_price(contract_id, currency, base, deliveries, zone, surcharge, promo_code, discount, taxes, total)
+								VALUES($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`
+	QueryGetDeliveriesByContracts = `SELECT id, contract_id, date, street, number, latitude, longitude, status, courier_id, slot_id, created_at, updated_at, deleted_at
+									FROM delivery
+									WHERE contract_id = ANY($1::uuid[])
+									ORDER BY contract_id, date`
+	QueryGetDeliveriesByContract = `SELECT id, contract_id, date, street, number, latitude, longitude, status, courier_id, slot_id, created_at, updated_at, deleted_at
+									FROM delivery
+									WHERE contract_id = $1
+									ORDER BY date`
+	QueryGetPendingDeliveriesOn = `SELECT d.id, d.contract_id, d.date, d.street, d.number, d.latitude, d.longitude, d.status, d.courier_id, d.slot_id, d.created_at, d.updated_at, d.deleted_at
+									FROM delivery AS d
+									JOIN contract AS c ON c.id = d.contract_id
+									WHERE c.status IN ('C', 'A') AND c.deleted_at IS NULL AND d.status = 'P' AND d.deleted_at IS NULL AND d.date >= $1 AND d.date < $2
+									ORDER BY d.contract_id, d.date`
+	QueryCreateDeliveries = `INSERT INTO delivery(id, contract_id, date, street, number, latitude, longitude, slot_id)
+								VALUES %s
+								RETURNING id, contract_id, date, street, number, latitude, longitude, status, courier_id, slot_id, created_at, updated_at, deleted_at`
+	QueryGetDeliveryById = `SELECT id, contract_id, date, street, number, latitude, longitude, status, courier_id, slot_id, created_at, updated_at, deleted_at
+								FROM delivery
+								WHERE id = $1`
+	QueryUpdateDelivery = `UPDATE delivery
+							SET street = $1, number = $2, latitude = $3, longitude = $4, updated_at = NOW()
+							WHERE id = $5 AND status = 'P' AND deleted_at IS NULL
+							RETURNING id, contract_id, date, street, number, latitude, longitude, status, courier_id, slot_id, created_at, updated_at, deleted_at`
+	QueryUpdateDeliveries = `UPDATE delivery AS d
+								SET street = v.street, number = v.number, latitude = v.latitude, longitude = v.longitude, updated_at = NOW()
+								FROM (VALUES %s) AS v(id, street, number, latitude, longitude)
+								WHERE d.id = v.id AND d.contract_id = $%d AND d.status = 'P' AND d.deleted_at IS NULL
+								RETURNING d.id, d.contract_id, d.date, d.street, d.number, d.latitude, d.longitude, d.status, d.courier_id, d.slot_id, d.created_at, d.updated_at, d.deleted_at`
+	QueryRescheduleDeliveries = `UPDATE delivery AS d
+									SET date = v.date, status = v.status, updated_at = NOW()
+									FROM (VALUES %s) AS v(id, date, status)
+									WHERE d.id = v.id AND d.contract_id = $%d AND d.status = 'P' AND d.deleted_at IS NULL`
+	QueryAssignDeliveries = `UPDATE delivery AS d
+								SET courier_id = v.courier_id, updated_at = NOW()
+								FROM (VALUES %s) AS v(id, courier_id)
+								WHERE d.id = v.id AND d.status = 'P' AND d.deleted_at IS NULL`
+	QueryBookDelivery = `UPDATE delivery
+							SET slot_id = $1, date = $2, updated_at = NOW()
+							WHERE id = $3 AND status = 'P' AND deleted_at IS NULL
+							RETURNING id, contract_id, date, street, number, latitude, longitude, status, courier_id, slot_id, created_at, updated_at, deleted_at`
+	QueryChangeStatusDelivery = `UPDATE delivery
+									SET status = $1, updated_at = NOW()
+									WHERE id = $2 AND status = 'P' AND deleted_at IS NULL
+									RETURNING id, contract_id, date, street, number, latitude, longitude, status, courier_id, slot_id, created_at, updated_at, deleted_at`
+)
+
+var (
+	ErrQueryDelivery         = errors.New("query failed")
+	ErrScanDelivery          = errors.New("scan failed")
+	ErrConcatenatingDelivery = errors.New("error concatenating delivery values from DB")
+	ErrIterationRowsDelivery = errors.New("rows iteration error")
+	ErrScanPrice             = errors.New("price scan failed")
+)
+
+type taxRow struct {
+	Name   string `json:"name"`
+	Rate   int    `json:"rate"`
+	Amount int    `json:"amount"`
+}
+
+func (r *ContractRepository) GetAll(ctx context.Context, filter contracts.ContractFilter, pagination abstractions.Pagination) (*abstractions.Page[*contracts.Contract], error) {
+	type contractRow struct {
 		id, administratorId, patientId             uuid.UUID
-		contractType, contractStatus               string
+		contractType, contractStatus, currency     string
+		reason                                     *string
+		autoRenew                                  bool
+		renewedFrom, slotId                        *uuid.UUID
 		creation, start, end, createdAt, updatedAt time.Time
-		deletedAt                                  *time.Time
-		cost                                       int
-		deliveryList                               []deliveries.Delivery
+		suspendedAt, deletedAt                     *time.Time
+		cost, weekdays                             int
+	}
+
+	var (
+		cntrcts []*contracts.Contract
+		cRows   []contractRow
+		ids     []uuid.UUID
+		total   int
 	)
 
-	query := `
-		SELECT id, administrator_id, patient_id, type, status, creation, start, finalized, cost, created_at, updated_at, deleted_at
-		FROM contract
-	`
+	q := contractListQuery(filter)
+	query, args, err := q.page(QueryGetAllContracts, contractSortColumns, "created_at", pagination)
+	if err != nil {
+		log.Printf("[repository:contract][GetAll] invalid pagination: %v", err)
+		return nil, err
+	}
 
-	rows, err := r.DB.QueryContext(ctx, query)
+	rows, err := r.conn(ctx).QueryContext(ctx, query, args...)
 	if err != nil {
 		log.Printf("[repository:contract][GetAll] error executing SQL statement: %v", err)
 		return nil, fmt.Errorf("query failed: %w", err)
@@ -46,151 +162,160 @@ func (r *ContractRepository) GetAll(ctx context.Context) ([]*contracts.Contract,
 
 	}(rows)
 	for rows.Next() {
+		var cr contractRow
 		err = rows.Scan(
-			&id, &administratorId, &patientId, &contractType, &contractStatus, &creation, &start, &end, &cost, &createdAt, &updatedAt, &deletedAt,
+			&cr.id, &cr.administratorId, &cr.patientId, &cr.contractType, &cr.contractStatus, &cr.reason, &cr.suspendedAt, &cr.autoRenew, &cr.renewedFrom, &cr.creation, &cr.start, &cr.end, &cr.cost, &cr.currency, &cr.weekdays, &cr.slotId, &cr.createdAt, &cr.updatedAt, &cr.deletedAt,
 		)
 		if err != nil {
 			log.Printf("[repository:contract][GetAll] error scanning rows: %v", err)
 			return nil, fmt.Errorf("rows scan failed: %w", err)
 		}
 
-		var (
-			dId                          uuid.UUID
-			date, dCreatedAt, dUpdatedAt time.Time
-			street, status               string
-			number                       int
-			latitude, longitude          float64
-			dDeletedAt                   *time.Time
-		)
+		cRows = append(cRows, cr)
+		ids = append(ids, cr.id)
+	}
 
-		query := `
-			SELECT id , date, street, number, latitude, longitude, status, created_at, updated_at, deleted_at
-			FROM delivery
-			WHERE contract_id = $1
-		`
+	if err = rows.Err(); err != nil {
+		log.Printf("[repository:contract][GetAll] error scanning rows: %v", err)
+		return nil, fmt.Errorf("rows scan failed: %w", err)
+	}
 
-		rows, err = r.DB.QueryContext(ctx, query, id)
-		if err != nil {
-			log.Printf("[repository:contract][GetAll] error executing SQL statement for deliveries: %v", err)
-			return nil, fmt.Errorf("query failed: %w", err)
-		}
+	if err = rows.Close(); err != nil {
+		log.Printf("[repository:contract][GetAll] error closing rows: %v", err)
+		return nil, fmt.Errorf("query failed: %w", err)
+	}
 
-		defer func(rows *sql.Rows) {
-			if err = rows.Close(); err != nil {
-				log.Printf("[repository:contract][GetAll] error closing delivery rows: %v", err)
-				return
-			}
-		}(rows)
-		for rows.Next() {
-			err = rows.Scan(&dId, &date, &street, &number, &latitude, &longitude, &status, &dCreatedAt, &dUpdatedAt, &dDeletedAt)
-			if err != nil {
-				log.Printf("[repository:contract][GetAll] error scanning delivery rows: %v", err)
-				return nil, fmt.Errorf("rows scan failed: %w", err)
-			}
-
-			d, err := deliveries.NewDeliveryFromDB(dId, id, date, street, number, latitude, longitude, status, createdAt, updatedAt, deletedAt)
-			if err != nil {
-				log.Printf("[repository:contract][GetAll] error concatenating delivery values from DB")
-				return nil, fmt.Errorf("%w: error concatenating delivery values from DB", err)
-			}
-
-			deliveryList = append(deliveryList, *d)
-		}
+	countQuery, countArgs := q.count(QueryCountContracts)
+	if err = r.conn(ctx).QueryRowContext(ctx, countQuery, countArgs...).Scan(&total); err != nil {
+		log.Printf("[repository:contract][GetAll] error counting contracts: %v", err)
+		return nil, fmt.Errorf("query failed: %w", err)
+	}
+
+	grouped, err := r.getDeliveriesByContracts(ctx, ids)
+	if err != nil {
+		log.Printf("[repository:contract][GetAll] error getting deliveries: %v", err)
+		return nil, err
+	}
+
+	prices, err := r.getPricesByContracts(ctx, ids)
+	if err != nil {
+		log.Printf("[repository:contract][GetAll] error getting prices: %v", err)
+		return nil, err
+	}
 
-		c, err := contracts.NewContractFromDb(id, administratorId, patientId, contractType, contractStatus, creation, start, end, cost, deliveryList, createdAt, updatedAt, deletedAt)
+	cntrcts = make([]*contracts.Contract, 0, len(cRows))
+	for _, cr := range cRows {
+		c, err := contracts.NewContractFromDb(cr.id, cr.administratorId, cr.patientId, cr.contractType, cr.contractStatus, cr.reason, cr.suspendedAt, cr.autoRenew, cr.renewedFrom, cr.creation, cr.start, cr.end, cr.cost, cr.currency, cr.weekdays, cr.slotId, grouped[cr.id], cr.createdAt, cr.updatedAt, cr.deletedAt)
 		if err != nil {
 			log.Printf("[repository:contract][GetAll] error concatenating contract values from DB")
 			return nil, fmt.Errorf("%w: error concatenating contract values from DB", err)
 		}
 
+		if err = c.Priced(prices[cr.id]); err != nil {
+			log.Printf("[repository:contract][GetAll] error concatenating price values from DB")
+			return nil, fmt.Errorf("%w: error concatenating price values from DB", err)
+		}
+
 		cntrcts = append(cntrcts, c)
 	}
 
-	if err = rows.Err(); err != nil {
-		log.Printf("[repository:contract][GetAll] error scanning rows: %v", err)
-		return nil, fmt.Errorf("rows scan failed: %w", err)
+	log.Printf("[repository:contract][GetAll] successfully fetched %d of %d contracts", len(cntrcts), total)
+	return abstractions.NewPage(cntrcts, total, pagination), nil
+}
+
+func contractListQuery(filter contracts.ContractFilter) *listQuery {
+	q := &listQuery{}
+
+	if filter.Status != nil {
+		q.where("status = " + q.arg(string(*filter.Status)))
+	}
+
+	if filter.Type != nil {
+		q.where("type = " + q.arg(string(*filter.Type)))
+	}
+
+	if filter.PatientId != nil {
+		q.where("patient_id = " + q.arg(*filter.PatientId))
+	}
+
+	if filter.AdministratorId != nil {
+		q.where("administrator_id = " + q.arg(*filter.AdministratorId))
+	}
+
+	if filter.From != nil {
+		q.where("finalized >= " + q.arg(*filter.From))
+	}
+
+	if filter.To != nil {
+		q.where("start <= " + q.arg(*filter.To))
 	}
 
-	log.Printf("[repository:contract][GetAll] successfully fetched %d contracts", len(cntrcts))
-	return cntrcts, nil
+	return q
+}
+
+var contractSortColumns = map[string]string{
+	"creation":   "creation",
+	"start":      "start",
+	"finalized":  "finalized",
+	"cost":       "cost",
+	"created_at": "created_at",
 }
 
 func (r *ContractRepository) GetById(ctx context.Context, id uuid.UUID) (*contracts.Contract, error) {
 	var (
 		administratorId, patientId                 uuid.UUID
-		contractType, contractStatus               string
+		contractType, contractStatus, currency     string
+		reason                                     *string
+		autoRenew                                  bool
+		renewedFrom, slotId                        *uuid.UUID
 		creation, start, end, createdAt, updatedAt time.Time
-		deletedAt                                  *time.Time
-		cost                                       int
-		deliveryList                               []deliveries.Delivery
+		suspendedAt, deletedAt                     *time.Time
+		cost, weekdays                             int
 	)
 
 	query := `
-		SELECT administrator_id, patient_id, type, status, creation, start, finalized, cost, created_at, updated_at, deleted_at
+		SELECT administrator_id, patient_id, type, status, status_reason, suspended_at, auto_renew, renewed_from, creation, start, finalized, cost, currency, weekdays, slot_id, created_at, updated_at, deleted_at
 		FROM contract
 		WHERE id = $1
 	`
 
-	err := r.DB.QueryRowContext(ctx, query, id).Scan(
-		&id, &administratorId, &patientId, &contractType, &contractStatus, &creation, &start, &end, &cost, &createdAt, &updatedAt, &deletedAt,
+	err := r.conn(ctx).QueryRowContext(ctx, query, id).Scan(
+		&administratorId, &patientId, &contractType, &contractStatus, &reason, &suspendedAt, &autoRenew, &renewedFrom, &creation, &start, &end, &cost, &currency, &weekdays, &slotId, &createdAt, &updatedAt, &deletedAt,
 	)
-	if err != nil {
+	if errors.Is(err, sql.ErrNoRows) {
+		log.Printf("[repository:contract][GetById] contract '%s' not found", id)
+		return nil, fmt.Errorf(got, contracts.ErrNotFoundContract, err)
+	} else if err != nil {
 		log.Printf("[repository:contract][GetById] error scanning rows: %v", err)
 		return nil, fmt.Errorf("rows scan failed: %w", err)
 	}
 
-	var (
-		dId                          uuid.UUID
-		date, dCreatedAt, dUpdatedAt time.Time
-		street, status               string
-		number                       int
-		latitude, longitude          float64
-		dDeletedAt                   *time.Time
-	)
-
-	query = `
-		SELECT id , date, street, number, latitude, longitude, status, created_at, updated_at, deleted_at
-		FROM delivery
-		WHERE contract_id = $1
-	`
-
-	rows, err := r.DB.QueryContext(ctx, query, id)
+	dlvrs, err := r.GetAllDeliveries(ctx, id)
 	if err != nil {
-		log.Printf("[repository:contract][GetById] error executing SQL statement for deliveries: %v", err)
-		return nil, fmt.Errorf("query failed: %w", err)
+		log.Printf("[repository:contract][GetById] error getting deliveries: %v", err)
+		return nil, err
 	}
 
-	defer func(rows *sql.Rows) {
-		if err = rows.Close(); err != nil {
-			log.Printf("[repository:contract][GetById] error closing delivery rows: %v", err)
-			return
-		}
-	}(rows)
-	for rows.Next() {
-		err = rows.Scan(&dId, &date, &street, &number, &latitude, &longitude, &status, &dCreatedAt, &dUpdatedAt, &dDeletedAt)
-		if err != nil {
-			log.Printf("[repository:contract][GetById] error scanning delivery rows: %v", err)
-			return nil, fmt.Errorf("rows scan failed: %w", err)
-		}
-
-		d, err := deliveries.NewDeliveryFromDB(dId, id, date, street, number, latitude, longitude, status, createdAt, updatedAt, deletedAt)
-		if err != nil {
-			log.Printf("[repository:contract][GetById] error concatenating delivery values from DB")
-			return nil, fmt.Errorf("%w: error concatenating administrator values from DB", err)
-		}
-
+	deliveryList := make([]deliveries.Delivery, 0, len(dlvrs))
+	for _, d := range dlvrs {
 		deliveryList = append(deliveryList, *d)
 	}
 
-	c, err := contracts.NewContractFromDb(id, administratorId, patientId, contractType, contractStatus, creation, start, end, cost, deliveryList, createdAt, updatedAt, deletedAt)
+	c, err := contracts.NewContractFromDb(id, administratorId, patientId, contractType, contractStatus, reason, suspendedAt, autoRenew, renewedFrom, creation, start, end, cost, currency, weekdays, slotId, deliveryList, createdAt, updatedAt, deletedAt)
 	if err != nil {
 		log.Printf("[repository:contract][GetById] error concatenating contract values from DB")
 		return nil, fmt.Errorf("%w: error concatenating contract values from DB", err)
 	}
 
-	if err = rows.Err(); err != nil {
-		log.Printf("[repository:contract][GetById] error scanning rows: %v", err)
-		return nil, fmt.Errorf("rows scan failed: %w", err)
+	price, err := r.getPrice(ctx, id)
+	if err != nil {
+		log.Printf("[repository:contract][GetById] error getting price: %v", err)
+		return nil, err
+	}
+
+	if err = c.Priced(price); err != nil {
+		log.Printf("[repository:contract][GetById] error concatenating price values from DB")
+		return nil, fmt.Errorf("%w: error concatenating price values from DB", err)
 	}
 
 	log.Printf("[repository:contract][GetById] successfully fetched")
@@ -198,27 +323,50 @@ func (r *ContractRepository) GetById(ctx context.Context, id uuid.UUID) (*contra
 }
 
 func (r *ContractRepository) Create(ctx context.Context, c *contracts.Contract) (*contracts.Contract, error) {
+	var created *contracts.Contract
+	err := saveWithEvents(ctx, r.DB, func(ctx context.Context) error {
+		var err error
+		created, err = r.create(ctx, c)
+		return err
+	}, c)
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+func (r *ContractRepository) create(ctx context.Context, c *contracts.Contract) (*contracts.Contract, error) {
 	var (
 		id, administratorId, patientId             uuid.UUID
-		contractType, contractStatus               string
+		contractType, contractStatus, currency     string
+		reason                                     *string
+		autoRenew                                  bool
+		renewedFrom, slotId                        *uuid.UUID
 		creation, start, end, createdAt, updatedAt time.Time
-		deletedAt                                  *time.Time
-		cost                                       int
+		suspendedAt, deletedAt                     *time.Time
+		cost, weekdays                             int
 	)
 
+	d := c.Deliveries()
+	if len(d) == 0 {
+		log.Printf("[repository:contract][Create] contract '%s' has no deliveries", c.Id())
+		return nil, fmt.Errorf("contract has no deliveries")
+	}
+
 	query := `
-		INSERT INTO contract(id, administrator_id, patient_id, type, start, finalized, cost)
-		VALUES($1, $2, $3, $4, $5, $6, $7)
-		RETURNING id, administrator_id, patient_id, type, status, creation, start, finalized, cost, created_at, updated_at, deleted_at
+		INSERT INTO contract(id, administrator_id, patient_id, type, start, finalized, cost, currency, weekdays, auto_renew, renewed_from, slot_id)
+		VALUES($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+		RETURNING id, administrator_id, patient_id, type, status, status_reason, suspended_at, auto_renew, renewed_from, creation, start, finalized, cost, currency, weekdays, slot_id, created_at, updated_at, deleted_at
 	`
 
-	err := r.DB.QueryRowContext(
+	err := r.conn(ctx).QueryRowContext(
 		ctx, query,
 		c.Id(), c.AdministratorId(), c.PatientId(),
-		string(c.ContractType()), c.StartDate(), c.EndDate(), c.CostValue(),
+		string(c.ContractType()), c.StartDate(), c.EndDate(), c.CostValue().Amount(), string(c.CostValue().Currency()), int(c.Weekdays()), c.AutoRenew(), c.RenewedFrom(), c.SlotId(),
 	).Scan(
-		&id, &administratorId, &patientId, &contractType, &contractStatus,
-		&creation, &start, &end, &cost, &createdAt, &updatedAt, &deletedAt,
+		&id, &administratorId, &patientId, &contractType, &contractStatus, &reason, &suspendedAt, &autoRenew, &renewedFrom,
+		&creation, &start, &end, &cost, &currency, &weekdays, &slotId, &createdAt, &updatedAt, &deletedAt,
 	)
 
 	if err != nil {
@@ -226,98 +374,238 @@ func (r *ContractRepository) Create(ctx context.Context, c *contracts.Contract)
 		return nil, fmt.Errorf("contract insert failed: %w", err)
 	}
 
-	d := c.Deliveries()
-	if len(d) != 15 && len(d) != 30 {
-		log.Printf("[repository:contract][Create] contract deliveries length: %v cannot be other than 15 or 30", len(d))
-		return nil, fmt.Errorf("deliveries can only be 15 or 30 long")
-	}
-
 	var placeholders []string
 	var args []interface{}
 	for i, dl := range d {
-		base := i * 7
+		base := i * 8
 		placeholders = append(placeholders,
-			fmt.Sprintf("($%d,$%d,$%d,$%d,$%d, $%d, $%d)", base+1, base+2, base+3, base+4, base+5, base+6, base+7),
+			fmt.Sprintf("($%d,$%d,$%d,$%d,$%d, $%d, $%d, $%d)", base+1, base+2, base+3, base+4, base+5, base+6, base+7, base+8),
 		)
 		coordinates := dl.Coordinates()
 		args = append(args,
-			dl.Id, dl.ContractId, dl.Date, dl.Street, dl.Number, coordinates.Latitude(), coordinates.Longitude(),
+			dl.Id(), dl.ContractId(), dl.Date(), dl.Street(), dl.Number(), coordinates.Latitude(), coordinates.Longitude(), dl.SlotId(),
 		)
 	}
 
-	query = fmt.Sprintf(
-		"INSERT INTO delivery(id, contract_id, date, street, number, latitude, longitude) VALUES %s RETURNING id, contract_id, date, street, number, latitude, longitude, status",
-		strings.Join(placeholders, ","),
-	)
+	query = fmt.Sprintf(QueryCreateDeliveries, strings.Join(placeholders, ","))
 
-	rows, err := r.DB.QueryContext(ctx, query, args...)
+	rows, err := r.conn(ctx).QueryContext(ctx, query, args...)
 	if err != nil {
 		log.Printf("[repository:contract][Create] delivery bach insert failed: %v", err)
 		return nil, fmt.Errorf("delivery batch insert failed: %w", err)
 	}
-	defer rows.Close()
-
-	var insertedDeliveries []deliveries.Delivery
-	for rows.Next() {
-		var (
-			dId, cId            uuid.UUID
-			date                time.Time
-			street, status      string
-			number              int
-			latitude, longitude float64
-		)
 
-		if err := rows.Scan(&dId, &cId, &date, &street, &number, &latitude, &longitude, &status); err != nil {
-			log.Printf("[repository:contract][Create] scanning rows: %v", err)
-			return nil, fmt.Errorf("scanning delivery failed: %w", err)
+	defer func(rows *sql.Rows) {
+		if err = rows.Close(); err != nil {
+			log.Printf("[repository:contract][Create] failed to close rows: %v", err)
+			return
 		}
+	}(rows)
 
-		delivery, err := deliveries.NewDeliveryFromDB(dId, cId, date, street, number, latitude, longitude, status, createdAt, updatedAt, deletedAt)
+	var insertedDeliveries []deliveries.Delivery
+	for rows.Next() {
+		delivery, err := scanDelivery(rows)
 		if err != nil {
-			log.Printf("[repository:contract][Create] error concatenating delivery values from DB")
-			return nil, fmt.Errorf("%w: error concatenating administrator values from DB", err)
+			log.Printf("[repository:contract][Create] error reading delivery rows: %v", err)
+			return nil, err
 		}
 
 		insertedDeliveries = append(insertedDeliveries, *delivery)
 	}
 
-	contract, err := contracts.NewContractFromDb(id, administratorId, patientId, contractType, contractStatus, creation, start, end, cost, insertedDeliveries, createdAt, updatedAt, deletedAt)
+	if err = rows.Err(); err != nil {
+		log.Printf("[repository:contract][Create] error reading deliveries: %v", err)
+		return nil, fmt.Errorf(got, ErrIterationRowsDelivery, err)
+	}
+
+	contract, err := contracts.NewContractFromDb(id, administratorId, patientId, contractType, contractStatus, reason, suspendedAt, autoRenew, renewedFrom, creation, start, end, cost, currency, weekdays, slotId, insertedDeliveries, createdAt, updatedAt, deletedAt)
 	if err != nil {
 		log.Printf("[repository:contract][Create] error concatenating contract values from DB")
 		return nil, fmt.Errorf("%w: error concatenating contract values from DB", err)
 	}
 
+	if price := c.Price(); price != nil {
+		if err = r.createPrice(ctx, contract.Id(), price); err != nil {
+			log.Printf("[repository:contract][Create] failed inserting price: %v", err)
+			return nil, err
+		}
+
+		if err = contract.Priced(price); err != nil {
+			log.Printf("[repository:contract][Create] error concatenating price values from DB")
+			return nil, fmt.Errorf("%w: error concatenating price values from DB", err)
+		}
+	}
+
 	return contract, nil
 }
 
-func (r *ContractRepository) ChangeStatus(ctx context.Context, id uuid.UUID, status string) (*contracts.Contract, error) {
+func (r *ContractRepository) getPrice(ctx context.Context, contractId uuid.UUID) (*pricing.Breakdown, error) {
+	var p priceRow
+	err := r.conn(ctx).QueryRowContext(ctx, QueryGetContractPrice, contractId).Scan(p.fields()...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf(got, ErrScanPrice, err)
+	}
+
+	return p.breakdown()
+}
+
+func (r *ContractRepository) getPricesByContracts(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]*pricing.Breakdown, error) {
+	prices := make(map[uuid.UUID]*pricing.Breakdown, len(ids))
+	if len(ids) == 0 {
+		return prices, nil
+	}
+
+	keys := make([]string, len(ids))
+	for i, id := range ids {
+		keys[i] = id.String()
+	}
+
+	rows, err := r.conn(ctx).QueryContext(ctx, QueryGetContractPrices, pq.Array(keys))
+	if err != nil {
+		log.Printf("[repository:contract][getPricesByContracts] error executing SQL query '%s': %v", QueryGetContractPrices, err)
+		return nil, fmt.Errorf(got, ErrScanPrice, err)
+	}
+
+	defer func(rows *sql.Rows) {
+		if err = rows.Close(); err != nil {
+			log.Printf("[repository:contract][getPricesByContracts] failed to close rows: %v", err)
+			return
+		}
+	}(rows)
+	for rows.Next() {
+		var (
+			contractId uuid.UUID
+			p          priceRow
+		)
+		if err = rows.Scan(append([]any{&contractId}, p.fields()...)...); err != nil {
+			log.Printf("[repository:contract][getPricesByContracts] error reading price rows: %v", err)
+			return nil, fmt.Errorf(got, ErrScanPrice, err)
+		}
+
+		if prices[contractId], err = p.breakdown(); err != nil {
+			log.Printf("[repository:contract][getPricesByContracts] error concatenating price of contract '%s': %v", contractId, err)
+			return nil, err
+		}
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Printf("[repository:contract][getPricesByContracts] error reading prices: %v", err)
+		return nil, fmt.Errorf(got, ErrScanPrice, err)
+	}
+
+	return prices, nil
+}
+
+type priceRow struct {
+	currency, zone, promoCode             string
+	base, deliveries, surcharge, discount int
+	total                                 int
+	taxes                                 []byte
+}
+
+func (p *priceRow) fields() []any {
+	return []any{&p.currency, &p.base, &p.deliveries, &p.zone, &p.surcharge, &p.promoCode, &p.discount, &p.taxes, &p.total}
+}
+
+func (p *priceRow) breakdown() (*pricing.Breakdown, error) {
+	var rows []taxRow
+	if err := json.Unmarshal(p.taxes, &rows); err != nil {
+		return nil, fmt.Errorf(got, ErrScanPrice, err)
+	}
+
+	c, err := valueobjects.ParseCurrency(p.currency)
+	if err != nil {
+		return nil, err
+	}
+
+	lines := make([]pricing.TaxLine, 0, len(rows))
+	for _, t := range rows {
+		amount, _ := valueobjects.NewMoney(t.Amount, c)
+		lines = append(lines, pricing.NewTaxLine(t.Name, t.Rate, amount))
+	}
+
+	return pricing.NewBreakdownFromDb(p.currency, p.base, p.deliveries, p.zone, p.surcharge, p.promoCode, p.discount, lines, p.total)
+}
+
+func (r *ContractRepository) createPrice(ctx context.Context, contractId uuid.UUID, b *pricing.Breakdown) error {
+	rows := make([]taxRow, 0, len(b.Taxes()))
+	for _, t := range b.Taxes() {
+		rows = append(rows, taxRow{Name: t.Name(), Rate: t.Rate(), Amount: t.Amount().Amount()})
+	}
+
+	taxes, err := json.Marshal(rows)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.conn(ctx).ExecContext(
+		ctx, QueryCreateContractPrice,
+		contractId, string(b.Total().Currency()), b.Base().Amount(), b.Deliveries(), b.Zone(), b.Surcharge().Amount(), b.PromoCode(), b.Discount().Amount(), taxes, b.Total().Amount(),
+	)
+	if err != nil {
+		return fmt.Errorf("price insert failed: %w", err)
+	}
+
+	return nil
+}
+
+func (r *ContractRepository) ChangeStatus(ctx context.Context, c *contracts.Contract) (*contracts.Contract, error) {
+	var changed *contracts.Contract
+	err := saveWithEvents(ctx, r.DB, func(ctx context.Context) error {
+		var err error
+		changed, err = r.changeStatus(ctx, c)
+		return err
+	}, c)
+	if err != nil {
+		return nil, err
+	}
+
+	return changed, nil
+}
+
+func (r *ContractRepository) changeStatus(ctx context.Context, c *contracts.Contract) (*contracts.Contract, error) {
 	var (
 		cId, administratorId, patientId            uuid.UUID
-		contractType, contractStatus               string
+		contractType, contractStatus, currency     string
+		reason                                     *string
+		autoRenew                                  bool
+		renewedFrom, slotId                        *uuid.UUID
 		creation, start, end, createdAt, updatedAt time.Time
-		deletedAt                                  *time.Time
-		cost                                       int
+		suspendedAt, deletedAt                     *time.Time
+		cost, weekdays                             int
 	)
 
 	query := `
 		UPDATE contract
-		SET status = $1
-		WHERE id = $2
+		SET status = $1, status_reason = $2, suspended_at = $3, finalized = $4, updated_at = NOW()
+		WHERE id = $5
+		RETURNING id, administrator_id, patient_id, type, status, status_reason, suspended_at, auto_renew, renewed_from, creation, start, finalized, cost, currency, weekdays, slot_id, created_at, updated_at, deleted_at
 	`
 
-	err := r.DB.QueryRowContext(
-		ctx, query, status, id,
+	var statusReason *string
+	if c.StatusReason() != "" {
+		s := c.StatusReason()
+		statusReason = &s
+	}
+
+	err := r.conn(ctx).QueryRowContext(
+		ctx, query, string(c.ContractStatus()), statusReason, c.SuspendedAt(), c.EndDate(), c.Id(),
 	).Scan(
-		&cId, &administratorId, &patientId, &contractType, &contractStatus,
-		&creation, &start, &end, &cost, &createdAt, &updatedAt, &deletedAt,
+		&cId, &administratorId, &patientId, &contractType, &contractStatus, &reason, &suspendedAt, &autoRenew, &renewedFrom,
+		&creation, &start, &end, &cost, &currency, &weekdays, &slotId, &createdAt, &updatedAt, &deletedAt,
 	)
 
-	if err != nil {
+	if errors.Is(err, sql.ErrNoRows) {
+		log.Printf("[repository:contract][ChangeStatus] contract '%s' not found", c.Id())
+		return nil, fmt.Errorf(got, contracts.ErrNotFoundContract, err)
+	} else if err != nil {
 		log.Printf("[repository:contract][ChangeStatus] error executing SQL query: %v", err)
 		return nil, fmt.Errorf("scan failed: %w", err)
 	}
 
-	contract, err := contracts.NewContractFromDb(id, administratorId, patientId, contractType, contractStatus, creation, start, end, cost, nil, createdAt, updatedAt, deletedAt)
+	contract, err := contracts.NewContractFromDb(cId, administratorId, patientId, contractType, contractStatus, reason, suspendedAt, autoRenew, renewedFrom, creation, start, end, cost, currency, weekdays, slotId, c.Deliveries(), createdAt, updatedAt, deletedAt)
 	if err != nil {
 		log.Printf("[repository:contract][ChangeStatus] error concatenating contract values from DB")
 		return nil, fmt.Errorf("%w: error concatenating contract values from DB", err)
@@ -336,7 +624,7 @@ func (r *ContractRepository) ExistById(ctx context.Context, id uuid.UUID) (bool,
 		)
 	`
 
-	err := r.DB.QueryRowContext(ctx, query, id).Scan(&exist)
+	err := r.conn(ctx).QueryRowContext(ctx, query, id).Scan(&exist)
 	if err != nil {
 		log.Printf("[repository:contract][ExistById] error executing SQL query '%s': %v", query, err)
 		return false, err
@@ -346,6 +634,76 @@ func (r *ContractRepository) ExistById(ctx context.Context, id uuid.UUID) (bool,
 	return exist, nil
 }
 
+func (r *ContractRepository) ExistsRenewal(ctx context.Context, id uuid.UUID) (bool, error) {
+	var exist bool
+	if err := r.conn(ctx).QueryRowContext(ctx, QueryExistsRenewalContract, id).Scan(&exist); err != nil {
+		log.Printf("[repository:contract][ExistsRenewal] error executing SQL query '%s': %v", QueryExistsRenewalContract, err)
+		return false, err
+	}
+
+	return exist, nil
+}
+
+func (r *ContractRepository) GetAutoRenewable(ctx context.Context, since, until time.Time) ([]uuid.UUID, error) {
+	return r.getIds(ctx, "GetAutoRenewable", QueryGetAutoRenewableContracts, since, until)
+}
+
+func (r *ContractRepository) GetToActivate(ctx context.Context, before time.Time) ([]uuid.UUID, error) {
+	return r.getIds(ctx, "GetToActivate", QueryGetContractsToActivate, before)
+}
+
+func (r *ContractRepository) GetToComplete(ctx context.Context, before time.Time) ([]uuid.UUID, error) {
+	return r.getIds(ctx, "GetToComplete", QueryGetContractsToComplete, before)
+}
+
+func (r *ContractRepository) GetWithPastDeliveries(ctx context.Context, before time.Time) ([]uuid.UUID, error) {
+	return r.getIds(ctx, "GetWithPastDeliveries", QueryGetContractsWithPastDeliveries, before)
+}
+
+func (r *ContractRepository) getIds(ctx context.Context, method, query string, args ...any) ([]uuid.UUID, error) {
+	rows, err := r.conn(ctx).QueryContext(ctx, query, args...)
+	if err != nil {
+		log.Printf("[repository:contract][%s] error executing SQL query '%s': %v", method, query, err)
+		return nil, fmt.Errorf("query failed: %w", err)
+	}
+	defer rows.Close()
+
+	var ids []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err = rows.Scan(&id); err != nil {
+			log.Printf("[repository:contract][%s] error scanning rows: %v", method, err)
+			return nil, fmt.Errorf("rows scan failed: %w", err)
+		}
+		ids = append(ids, id)
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Printf("[repository:contract][%s] error scanning rows: %v", method, err)
+		return nil, fmt.Errorf("rows scan failed: %w", err)
+	}
+
+	return ids, nil
+}
+
+func (r *ContractRepository) SetAutoRenew(ctx context.Context, c *contracts.Contract) error {
+	res, err := r.conn(ctx).ExecContext(ctx, QuerySetAutoRenewContract, c.AutoRenew(), c.Id())
+	if err != nil {
+		log.Printf("[repository:contract][SetAutoRenew] error executing SQL query '%s': %v", QuerySetAutoRenewContract, err)
+		return fmt.Errorf("query failed: %w", err)
+	}
+
+	if n, err := res.RowsAffected(); err != nil {
+		return fmt.Errorf("query failed: %w", err)
+	} else if n == 0 {
+		log.Printf("[repository:contract][SetAutoRenew] contract '%s' not found", c.Id())
+		return contracts.ErrNotFoundContract
+	}
+
+	log.Printf("[repository:contract][SetAutoRenew] contract %s auto-renew set to %t", c.Id(), c.AutoRenew())
+	return nil
+}
+
 func (r *ContractRepository) Count(ctx context.Context) (int, error) {
 	var count int
 	query := `
@@ -353,7 +711,7 @@ func (r *ContractRepository) Count(ctx context.Context) (int, error) {
 		FROM contract
 	`
 
-	err := r.DB.QueryRowContext(ctx, query).Scan(&count)
+	err := r.conn(ctx).QueryRowContext(ctx, query).Scan(&count)
 	if err != nil {
 		log.Printf("[repository:contract][CountActive] error executing SQL query in Count: %v", err)
 		return 0, err
@@ -361,24 +719,344 @@ func (r *ContractRepository) Count(ctx context.Context) (int, error) {
 	return count, nil
 }
 
-func (r *ContractRepository) GetAllDeliveries(ctx context.Context) ([]*deliveries.Delivery, error) {
-	//TODO implement me
-	panic("implement me")
+func (r *ContractRepository) GetAllDeliveries(ctx context.Context, contractId uuid.UUID) ([]*deliveries.Delivery, error) {
+	var dlvrs []*deliveries.Delivery
+
+	rows, err := r.conn(ctx).QueryContext(ctx, QueryGetDeliveriesByContract, contractId)
+	if err != nil {
+		log.Printf("[repository:contract][GetAllDeliveries] error executing SQL query '%s': %v", QueryGetDeliveriesByContract, err)
+		return nil, fmt.Errorf(got, ErrQueryDelivery, err)
+	}
+
+	defer func(rows *sql.Rows) {
+		if err = rows.Close(); err != nil {
+			log.Printf("[repository:contract][GetAllDeliveries] failed to close rows: %v", err)
+			return
+		}
+	}(rows)
+	for rows.Next() {
+		d, err := scanDelivery(rows)
+		if err != nil {
+			log.Printf("[repository:contract][GetAllDeliveries] error reading delivery rows: %v", err)
+			return nil, err
+		}
+
+		dlvrs = append(dlvrs, d)
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Printf("[repository:contract][GetAllDeliveries] error reading deliveries: %v", err)
+		return nil, fmt.Errorf(got, ErrIterationRowsDelivery, err)
+	}
+
+	log.Printf("[repository:contract][GetAllDeliveries] successfully fetched %d deliveries", len(dlvrs))
+	return dlvrs, nil
+}
+
+func (r *ContractRepository) GetPendingDeliveriesOn(ctx context.Context, day time.Time) ([]*deliveries.Delivery, error) {
+	var dlvrs []*deliveries.Delivery
+
+	from := time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, day.Location())
+	rows, err := r.conn(ctx).QueryContext(ctx, QueryGetPendingDeliveriesOn, from, from.AddDate(0, 0, 1))
+	if err != nil {
+		log.Printf("[repository:contract][GetPendingDeliveriesOn] error executing SQL query '%s': %v", QueryGetPendingDeliveriesOn, err)
+		return nil, fmt.Errorf(got, ErrQueryDelivery, err)
+	}
+
+	defer func(rows *sql.Rows) {
+		if err = rows.Close(); err != nil {
+			log.Printf("[repository:contract][GetPendingDeliveriesOn] failed to close rows: %v", err)
+			return
+		}
+	}(rows)
+	for rows.Next() {
+		d, err := scanDelivery(rows)
+		if err != nil {
+			log.Printf("[repository:contract][GetPendingDeliveriesOn] error reading delivery rows: %v", err)
+			return nil, err
+		}
+
+		dlvrs = append(dlvrs, d)
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Printf("[repository:contract][GetPendingDeliveriesOn] error reading deliveries: %v", err)
+		return nil, fmt.Errorf(got, ErrIterationRowsDelivery, err)
+	}
+
+	log.Printf("[repository:contract][GetPendingDeliveriesOn] successfully fetched %d deliveries on %s", len(dlvrs), from.Format(time.DateOnly))
+	return dlvrs, nil
+}
+
+func (r *ContractRepository) getDeliveriesByContracts(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID][]deliveries.Delivery, error) {
+	grouped := make(map[uuid.UUID][]deliveries.Delivery, len(ids))
+	if len(ids) == 0 {
+		return grouped, nil
+	}
+
+	keys := make([]string, len(ids))
+	for i, id := range ids {
+		keys[i] = id.String()
+	}
+
+	rows, err := r.conn(ctx).QueryContext(ctx, QueryGetDeliveriesByContracts, pq.Array(keys))
+	if err != nil {
+		log.Printf("[repository:contract][getDeliveriesByContracts] error executing SQL query '%s': %v", QueryGetDeliveriesByContracts, err)
+		return nil, fmt.Errorf(got, ErrQueryDelivery, err)
+	}
+
+	defer func(rows *sql.Rows) {
+		if err = rows.Close(); err != nil {
+			log.Printf("[repository:contract][getDeliveriesByContracts] failed to close rows: %v", err)
+			return
+		}
+	}(rows)
+	for rows.Next() {
+		d, err := scanDelivery(rows)
+		if err != nil {
+			log.Printf("[repository:contract][getDeliveriesByContracts] error reading delivery rows: %v", err)
+			return nil, err
+		}
+
+		grouped[d.ContractId()] = append(grouped[d.ContractId()], *d)
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Printf("[repository:contract][getDeliveriesByContracts] error reading deliveries: %v", err)
+		return nil, fmt.Errorf(got, ErrIterationRowsDelivery, err)
+	}
+
+	return grouped, nil
 }
 
 func (r *ContractRepository) GetDeliveriesById(ctx context.Context, id uuid.UUID) (*deliveries.Delivery, error) {
-	//TODO implement me
-	panic("implement me")
+	d, err := scanDelivery(r.conn(ctx).QueryRowContext(ctx, QueryGetDeliveryById, id))
+	if err != nil {
+		log.Printf("[repository:contract][GetDeliveriesById] error executing SQL query '%s': %v", QueryGetDeliveryById, err)
+		return nil, err
+	}
+
+	log.Printf("[repository:contract][GetDeliveriesById] successfully fetched delivery")
+	return d, nil
 }
 
 func (r *ContractRepository) UpdateDelivery(ctx context.Context, id uuid.UUID, delivery *deliveries.Delivery) (*deliveries.Delivery, error) {
-	//TODO implement me
-	panic("implement me")
+	coordinates := delivery.Coordinates()
+	d, err := scanDelivery(r.conn(ctx).QueryRowContext(
+		ctx, QueryUpdateDelivery, delivery.Street(), delivery.Number(), coordinates.Latitude(), coordinates.Longitude(), id,
+	))
+	if err != nil {
+		log.Printf("[repository:contract][UpdateDelivery] error executing SQL query '%s': %v", QueryUpdateDelivery, err)
+		return nil, notPending(err)
+	}
+
+	log.Printf("[repository:contract][UpdateDelivery] successfully updated delivery %s", id)
+	return d, nil
+}
+
+func (r *ContractRepository) UpdateDeliveries(ctx context.Context, contractId uuid.UUID, dlvrs []*deliveries.Delivery) ([]*deliveries.Delivery, error) {
+	if len(dlvrs) == 0 {
+		return nil, nil
+	}
+
+	var placeholders []string
+	var args []interface{}
+	for i, d := range dlvrs {
+		base := i * 5
+		placeholders = append(placeholders,
+			fmt.Sprintf("($%d::uuid, $%d, $%d::int, $%d::double precision, $%d::double precision)", base+1, base+2, base+3, base+4, base+5),
+		)
+		coordinates := d.Coordinates()
+		args = append(args, d.Id(), d.Street(), d.Number(), coordinates.Latitude(), coordinates.Longitude())
+	}
+	args = append(args, contractId)
+
+	query := fmt.Sprintf(QueryUpdateDeliveries, strings.Join(placeholders, ","), len(args))
+
+	rows, err := r.conn(ctx).QueryContext(ctx, query, args...)
+	if err != nil {
+		log.Printf("[repository:contract][UpdateDeliveries] error executing SQL statement: %v", err)
+		return nil, fmt.Errorf(got, ErrQueryDelivery, err)
+	}
+
+	defer func(rows *sql.Rows) {
+		if err = rows.Close(); err != nil {
+			log.Printf("[repository:contract][UpdateDeliveries] failed to close rows: %v", err)
+			return
+		}
+	}(rows)
+
+	var updated []*deliveries.Delivery
+	for rows.Next() {
+		d, err := scanDelivery(rows)
+		if err != nil {
+			log.Printf("[repository:contract][UpdateDeliveries] error reading delivery rows: %v", err)
+			return nil, err
+		}
+
+		updated = append(updated, d)
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Printf("[repository:contract][UpdateDeliveries] error reading deliveries: %v", err)
+		return nil, fmt.Errorf(got, ErrIterationRowsDelivery, err)
+	}
+
+	if len(updated) != len(dlvrs) {
+		log.Printf("[repository:contract][UpdateDeliveries] %d of %d deliveries are not pending", len(dlvrs)-len(updated), len(dlvrs))
+		return nil, fmt.Errorf("%w: %d of %d deliveries are no longer pending", deliveries.ErrNotPendingDelivery, len(dlvrs)-len(updated), len(dlvrs))
+	}
+
+	log.Printf("[repository:contract][UpdateDeliveries] successfully updated %d deliveries of contract %s", len(updated), contractId)
+	return updated, nil
 }
 
-func (r *ContractRepository) ChangeStatusDelivery(ctx context.Context, id uuid.UUID, status string) (*deliveries.Delivery, error) {
-	//TODO implement me
-	panic("implement me")
+func (r *ContractRepository) RescheduleDeliveries(ctx context.Context, contractId uuid.UUID, dlvrs []*deliveries.Delivery) error {
+	if len(dlvrs) == 0 {
+		return nil
+	}
+
+	var (
+		placeholders []string
+		args         []interface{}
+		sources      []eventSource
+	)
+	for i, d := range dlvrs {
+		base := i * 3
+		placeholders = append(placeholders, fmt.Sprintf("($%d::uuid, $%d::timestamp, $%d::char(1))", base+1, base+2, base+3))
+		args = append(args, d.Id(), d.Date(), string(d.Status()))
+		sources = append(sources, d)
+	}
+	args = append(args, contractId)
+
+	query := fmt.Sprintf(QueryRescheduleDeliveries, strings.Join(placeholders, ","), len(args))
+
+	var affected int64
+	err := saveWithEvents(ctx, r.DB, func(ctx context.Context) error {
+		res, err := r.conn(ctx).ExecContext(ctx, query, args...)
+		if err != nil {
+			return fmt.Errorf(got, ErrQueryDelivery, err)
+		}
+		affected, err = res.RowsAffected()
+		if err != nil {
+			return fmt.Errorf(got, ErrQueryDelivery, err)
+		}
+		if int(affected) != len(dlvrs) {
+			return fmt.Errorf("%w: %d of %d deliveries of contract %s", deliveries.ErrNotPendingDelivery, len(dlvrs)-int(affected), len(dlvrs), contractId)
+		}
+		return nil
+	}, sources...)
+	if err != nil {
+		log.Printf("[repository:contract][RescheduleDeliveries] error executing SQL statement: %v", err)
+		return err
+	}
+
+	log.Printf("[repository:contract][RescheduleDeliveries] successfully rescheduled %d deliveries of contract %s", affected, contractId)
+	return nil
+}
+
+func (r *ContractRepository) AssignDeliveries(ctx context.Context, dlvrs []*deliveries.Delivery) error {
+	if len(dlvrs) == 0 {
+		return nil
+	}
+
+	var (
+		placeholders []string
+		args         []interface{}
+	)
+	for i, d := range dlvrs {
+		base := i * 2
+		placeholders = append(placeholders, fmt.Sprintf("($%d::uuid, $%d::uuid)", base+1, base+2))
+		args = append(args, d.Id(), d.CourierId())
+	}
+
+	query := fmt.Sprintf(QueryAssignDeliveries, strings.Join(placeholders, ","))
+	res, err := r.conn(ctx).ExecContext(ctx, query, args...)
+	if err != nil {
+		log.Printf("[repository:contract][AssignDeliveries] error executing SQL statement: %v", err)
+		return fmt.Errorf(got, ErrQueryDelivery, err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		log.Printf("[repository:contract][AssignDeliveries] error reading affected rows: %v", err)
+		return fmt.Errorf(got, ErrQueryDelivery, err)
+	}
+	if int(affected) != len(dlvrs) {
+		log.Printf("[repository:contract][AssignDeliveries] %d of %d deliveries are not pending", len(dlvrs)-int(affected), len(dlvrs))
+		return fmt.Errorf("%w: %d of %d deliveries are no longer pending", deliveries.ErrNotPendingDelivery, len(dlvrs)-int(affected), len(dlvrs))
+	}
+
+	log.Printf("[repository:contract][AssignDeliveries] successfully assigned %d deliveries", affected)
+	return nil
+}
+
+func (r *ContractRepository) BookDelivery(ctx context.Context, delivery *deliveries.Delivery) (*deliveries.Delivery, error) {
+	d, err := scanDelivery(r.conn(ctx).QueryRowContext(ctx, QueryBookDelivery, delivery.SlotId(), delivery.Date(), delivery.Id()))
+	if err != nil {
+		log.Printf("[repository:contract][BookDelivery] error executing SQL query '%s': %v", QueryBookDelivery, err)
+		return nil, err
+	}
+
+	log.Printf("[repository:contract][BookDelivery] delivery %s booked into slot %s", d.Id(), d.SlotId())
+	return d, nil
+}
+
+func (r *ContractRepository) ChangeStatusDelivery(ctx context.Context, delivery *deliveries.Delivery) (*deliveries.Delivery, error) {
+	var d *deliveries.Delivery
+	err := saveWithEvents(ctx, r.DB, func(ctx context.Context) error {
+		var err error
+		d, err = scanDelivery(r.conn(ctx).QueryRowContext(ctx, QueryChangeStatusDelivery, string(delivery.Status()), delivery.Id()))
+		return err
+	}, delivery)
+	if err != nil {
+		log.Printf("[repository:contract][ChangeStatusDelivery] error executing SQL query '%s': %v", QueryChangeStatusDelivery, err)
+		return nil, notPending(err)
+	}
+
+	log.Printf("[repository:contract][ChangeStatusDelivery] delivery %s status changed to %s", d.Id(), d.Status().String())
+	return d, nil
+}
+
+func (r *ContractRepository) conn(ctx context.Context) persistence.DBTX {
+	return persistence.Executor(ctx, r.DB)
+}
+
+func notPending(err error) error {
+	if errors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf(got, deliveries.ErrNotPendingDelivery, sql.ErrNoRows)
+	}
+	return err
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanDelivery(row rowScanner) (*deliveries.Delivery, error) {
+	var (
+		id, contractId             uuid.UUID
+		date, createdAt, updatedAt time.Time
+		street, status             string
+		number                     int
+		latitude, longitude        float64
+		courierId, slotId          *uuid.UUID
+		deletedAt                  *time.Time
+	)
+
+	err := row.Scan(&id, &contractId, &date, &street, &number, &latitude, &longitude, &status, &courierId, &slotId, &createdAt, &updatedAt, &deletedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf(got, deliveries.ErrNotFoundDelivery, err)
+	} else if err != nil {
+		return nil, fmt.Errorf(got, ErrScanDelivery, err)
+	}
+
+	d, err := deliveries.NewDeliveryFromDB(id, contractId, date, street, number, latitude, longitude, status, courierId, slotId, createdAt, updatedAt, deletedAt)
+	if err != nil {
+		return nil, fmt.Errorf(got, ErrConcatenatingDelivery, err)
+	}
+
+	return d, nil
 }
 
 func NewContractRepository(db *sql.DB) contracts.ContractRepository {
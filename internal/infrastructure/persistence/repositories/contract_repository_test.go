@@ -0,0 +1,1177 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/abstractions"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/contract"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/delivery"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/pricing"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/slot"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/infrastructure/persistence"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"regexp"
+	"testing"
+	"time"
+)
+
+var contractByIdColumns = []string{"administrator_id", "patient_id", "type", "status", "status_reason", "suspended_at", "auto_renew", "renewed_from", "creation", "start", "finalized", "cost", "currency", "weekdays", "slot_id", "created_at", "updated_at", "deleted_at"}
+var priceColumns = []string{"currency", "base", "deliveries", "zone", "surcharge", "promo_code", "discount", "taxes", "total"}
+
+var contractColumns = []string{"id", "administrator_id", "patient_id", "type", "status", "status_reason", "suspended_at", "auto_renew", "renewed_from", "creation", "start", "finalized", "cost", "currency", "weekdays", "slot_id", "created_at", "updated_at", "deleted_at"}
+
+var tenBolivianos, _ = valueobjects.NewMoney(1000, valueobjects.BOB)
+
+var deliveryColumns = []string{"id", "contract_id", "date", "street", "number", "latitude", "longitude", "status", "courier_id", "slot_id", "created_at", "updated_at", "deleted_at"}
+
+func TestContractRepository_GetAllDeliveries(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewContractRepository(db)
+	contractId := uuid.New()
+	now := time.Now()
+
+	rows := sqlmock.NewRows(deliveryColumns)
+	for i := 0; i < 15; i++ {
+		rows.AddRow(uuid.New(), contractId, now.AddDate(0, 0, i), "Sesame Street", 30, -17.7863, -63.1812, "P", nil, nil, now, now, nil)
+	}
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetDeliveriesByContract)).WithArgs(contractId).WillReturnRows(rows)
+
+	dlvrs, err := repo.GetAllDeliveries(context.Background(), contractId)
+	assert.NoError(t, err)
+	assert.Len(t, dlvrs, 15)
+
+	for i, d := range dlvrs {
+		assert.Equal(t, contractId, d.ContractId())
+		assert.Equal(t, now.AddDate(0, 0, i), d.Date())
+		assert.Equal(t, deliveries.Pending, d.Status())
+	}
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestContractRepository_GetAllDeliveries_Errors(t *testing.T) {
+	contractId := uuid.New()
+
+	t.Run("Query error", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		repo := NewContractRepository(db)
+		mock.ExpectQuery(regexp.QuoteMeta(QueryGetDeliveriesByContract)).WithArgs(contractId).WillReturnError(ErrDatabaseAdministrator)
+
+		dlvrs, err := repo.GetAllDeliveries(context.Background(), contractId)
+
+		assert.Nil(t, dlvrs)
+		assert.ErrorIs(t, err, ErrQueryDelivery)
+		assert.ErrorIs(t, err, ErrDatabaseAdministrator)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Scan error", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		repo := NewContractRepository(db)
+		rows := sqlmock.NewRows([]string{"id", "contract_id"}).AddRow(uuid.New(), contractId)
+		mock.ExpectQuery(regexp.QuoteMeta(QueryGetDeliveriesByContract)).WithArgs(contractId).WillReturnRows(rows)
+
+		dlvrs, err := repo.GetAllDeliveries(context.Background(), contractId)
+
+		assert.Nil(t, dlvrs)
+		assert.ErrorIs(t, err, ErrScanDelivery)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Invalid delivery", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		repo := NewContractRepository(db)
+		now := time.Now()
+		rows := sqlmock.NewRows(deliveryColumns).AddRow(uuid.New(), contractId, now, "Sesame Street", 30, -17.7863, -63.1812, "X", nil, nil, now, now, nil)
+		mock.ExpectQuery(regexp.QuoteMeta(QueryGetDeliveriesByContract)).WithArgs(contractId).WillReturnRows(rows)
+
+		dlvrs, err := repo.GetAllDeliveries(context.Background(), contractId)
+
+		assert.Nil(t, dlvrs)
+		assert.ErrorIs(t, err, ErrConcatenatingDelivery)
+		assert.ErrorIs(t, err, deliveries.ErrNotADeliveryStatus)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestContractRepository_GetDeliveriesById(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewContractRepository(db)
+	id, contractId := uuid.New(), uuid.New()
+	now := time.Now()
+
+	rows := sqlmock.NewRows(deliveryColumns).AddRow(id, contractId, now, "Sesame Street", 30, -17.7863, -63.1812, "D", nil, nil, now, now, nil)
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetDeliveryById)).WithArgs(id).WillReturnRows(rows)
+
+	d, err := repo.GetDeliveriesById(context.Background(), id)
+
+	assert.NoError(t, err)
+	assert.Equal(t, id, d.Id())
+	assert.Equal(t, contractId, d.ContractId())
+	assert.Equal(t, deliveries.Delivered, d.Status())
+	assert.Equal(t, now, d.CreatedAt())
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestContractRepository_GetDeliveriesById_NotFound(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewContractRepository(db)
+	id := uuid.New()
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetDeliveryById)).WithArgs(id).WillReturnError(sql.ErrNoRows)
+
+	d, err := repo.GetDeliveriesById(context.Background(), id)
+
+	assert.Nil(t, d)
+	assert.ErrorIs(t, err, deliveries.ErrNotFoundDelivery)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestContractRepository_UpdateDelivery(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewContractRepository(db)
+	contractId := uuid.New()
+	now := time.Now()
+
+	coordinates, err := valueobjects.NewCoordinates(51.5237, -0.1585)
+	assert.NoError(t, err)
+
+	delivery := deliveries.NewDelivery(contractId, now, "Baker Street", 221, coordinates)
+
+	rows := sqlmock.NewRows(deliveryColumns).AddRow(delivery.Id(), contractId, now, "Baker Street", 221, 51.5237, -0.1585, "P", nil, nil, now, now, nil)
+	mock.ExpectQuery(regexp.QuoteMeta(QueryUpdateDelivery)).
+		WithArgs("Baker Street", 221, 51.5237, -0.1585, delivery.Id()).
+		WillReturnRows(rows)
+
+	d, err := repo.UpdateDelivery(context.Background(), delivery.Id(), delivery)
+
+	assert.NoError(t, err)
+	assert.Equal(t, delivery.Id(), d.Id())
+	assert.Equal(t, delivery.Street(), d.Street())
+	assert.Equal(t, delivery.Number(), d.Number())
+	assert.Equal(t, delivery.Coordinates(), d.Coordinates())
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestContractRepository_UpdateDelivery_QueryError(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewContractRepository(db)
+	delivery := deliveries.NewDelivery(uuid.New(), time.Now(), "Baker Street", 221, valueobjects.Coordinates{})
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryUpdateDelivery)).WillReturnError(ErrDatabaseAdministrator)
+
+	d, err := repo.UpdateDelivery(context.Background(), delivery.Id(), delivery)
+
+	assert.Nil(t, d)
+	assert.ErrorIs(t, err, ErrDatabaseAdministrator)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestContractRepository_UpdateDelivery_NotPending(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewContractRepository(db)
+	delivery := deliveries.NewDelivery(uuid.New(), time.Now(), "Baker Street", 221, valueobjects.Coordinates{})
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryUpdateDelivery)).WillReturnError(sql.ErrNoRows)
+
+	d, err := repo.UpdateDelivery(context.Background(), delivery.Id(), delivery)
+
+	assert.Nil(t, d)
+	assert.ErrorIs(t, err, deliveries.ErrNotPendingDelivery)
+	assert.NotErrorIs(t, err, deliveries.ErrNotFoundDelivery)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestContractRepository_ChangeStatusDelivery(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewContractRepository(db)
+	coordinates, err := valueobjects.NewCoordinates(-17.7863, -63.1812)
+	assert.NoError(t, err)
+
+	delivery := deliveries.NewDelivery(uuid.New(), time.Now(), "Sesame Street", 30, coordinates)
+	assert.NoError(t, delivery.ChangeStatus(deliveries.Cancelled))
+	now := time.Now()
+
+	rows := sqlmock.NewRows(deliveryColumns).AddRow(delivery.Id(), delivery.ContractId(), now, "Sesame Street", 30, -17.7863, -63.1812, "C", nil, nil, now, now, nil)
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(QueryChangeStatusDelivery)).WithArgs("C", delivery.Id()).WillReturnRows(rows)
+	mock.ExpectExec("INSERT INTO outbox").
+		WithArgs(sqlmock.AnyArg(), delivery.ContractId(), deliveries.DeliveryCancelled, sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	d, err := repo.ChangeStatusDelivery(context.Background(), delivery)
+
+	assert.NoError(t, err)
+	assert.Equal(t, delivery.Id(), d.Id())
+	assert.Equal(t, deliveries.Cancelled, d.Status())
+	assert.Empty(t, delivery.DomainEvents())
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestContractRepository_ChangeStatusDelivery_OutboxFailureRollsBack(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewContractRepository(db)
+	coordinates, err := valueobjects.NewCoordinates(-17.7863, -63.1812)
+	assert.NoError(t, err)
+
+	delivery := deliveries.NewDelivery(uuid.New(), time.Now(), "Sesame Street", 30, coordinates)
+	assert.NoError(t, delivery.ChangeStatus(deliveries.Delivered))
+	now := time.Now()
+
+	rows := sqlmock.NewRows(deliveryColumns).AddRow(delivery.Id(), delivery.ContractId(), now, "Sesame Street", 30, -17.7863, -63.1812, "D", nil, nil, now, now, nil)
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(QueryChangeStatusDelivery)).WithArgs("D", delivery.Id()).WillReturnRows(rows)
+	mock.ExpectExec("INSERT INTO outbox").WillReturnError(ErrDatabaseAdministrator)
+	mock.ExpectRollback()
+
+	d, err := repo.ChangeStatusDelivery(context.Background(), delivery)
+
+	assert.Nil(t, d)
+	assert.ErrorIs(t, err, ErrQueryOutbox)
+	assert.Len(t, delivery.DomainEvents(), 1)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestContractRepository_ChangeStatusDelivery_NotPending(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewContractRepository(db)
+	delivery := deliveries.NewDelivery(uuid.New(), time.Now(), "Sesame Street", 30, valueobjects.Coordinates{})
+	assert.NoError(t, delivery.ChangeStatus(deliveries.Cancelled))
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(QueryChangeStatusDelivery)).WithArgs("C", delivery.Id()).WillReturnError(sql.ErrNoRows)
+	mock.ExpectRollback()
+
+	d, err := repo.ChangeStatusDelivery(context.Background(), delivery)
+
+	assert.Nil(t, d)
+	assert.ErrorIs(t, err, deliveries.ErrNotPendingDelivery)
+	assert.Len(t, delivery.DomainEvents(), 1)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestContractRepository_BookDelivery(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewContractRepository(db)
+	coordinates, err := valueobjects.NewCoordinates(-17.7863, -63.1812)
+	assert.NoError(t, err)
+
+	delivery := deliveries.NewDelivery(uuid.New(), time.Now().AddDate(0, 0, 3), "Sesame Street", 30, coordinates)
+	slot := slots.NewSlot(uuid.New(), "Dinner", 19*time.Hour, 21*time.Hour, 20)
+	assert.NoError(t, delivery.Book(slot))
+	now := time.Now()
+
+	rows := sqlmock.NewRows(deliveryColumns).AddRow(delivery.Id(), delivery.ContractId(), delivery.Date(), "Sesame Street", 30, -17.7863, -63.1812, "P", nil, slot.Id(), now, now, nil)
+	mock.ExpectQuery(regexp.QuoteMeta(QueryBookDelivery)).WithArgs(delivery.SlotId(), delivery.Date(), delivery.Id()).WillReturnRows(rows)
+
+	d, err := repo.BookDelivery(context.Background(), delivery)
+
+	assert.NoError(t, err)
+	assert.Equal(t, delivery.SlotId(), d.SlotId())
+	assert.Equal(t, delivery.Date(), d.Date())
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestContractRepository_BookDelivery_NotPending(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewContractRepository(db)
+	delivery := deliveries.NewDelivery(uuid.New(), time.Now().AddDate(0, 0, 3), "Sesame Street", 30, valueobjects.Coordinates{})
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryBookDelivery)).WillReturnError(sql.ErrNoRows)
+
+	d, err := repo.BookDelivery(context.Background(), delivery)
+
+	assert.Nil(t, d)
+	assert.ErrorIs(t, err, deliveries.ErrNotFoundDelivery)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestContractRepository_GetById(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewContractRepository(db)
+	id, adminId, patientId := uuid.New(), uuid.New(), uuid.New()
+	now := time.Now()
+	deliveryCreatedAt := now.AddDate(0, 0, -1)
+
+	mock.ExpectQuery("SELECT (.+) FROM contract WHERE id = \\$1").WithArgs(id).WillReturnRows(
+		sqlmock.NewRows(contractByIdColumns).AddRow(adminId, patientId, "H", "A", nil, nil, false, nil, now, now, now.AddDate(0, 0, 14), 45000, "USD", 127, nil, now, now, nil),
+	)
+
+	rows := sqlmock.NewRows(deliveryColumns)
+	for i := 0; i < 15; i++ {
+		rows.AddRow(uuid.New(), id, now.AddDate(0, 0, i), "Sesame Street", 30, -17.7863, -63.1812, "P", nil, nil, deliveryCreatedAt, deliveryCreatedAt, nil)
+	}
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetDeliveriesByContract)).WithArgs(id).WillReturnRows(rows)
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetContractPrice)).WithArgs(id).WillReturnRows(
+		sqlmock.NewRows(priceColumns).AddRow("USD", 40000, 15, "Downtown", 1500, "WELCOME10", 1500, []byte(`[{"name":"IVA","rate":1250,"amount":5000}]`), 45000),
+	)
+
+	c, err := repo.GetById(context.Background(), id)
+
+	assert.NoError(t, err)
+	assert.Equal(t, id, c.Id())
+	assert.Equal(t, adminId, c.AdministratorId())
+	assert.Equal(t, patientId, c.PatientId())
+	assert.Equal(t, contracts.HalfMonth, c.ContractType())
+	assert.Equal(t, contracts.Active, c.ContractStatus())
+	assert.Equal(t, 45000, c.CostValue().Amount())
+	assert.Equal(t, valueobjects.USD, c.CostValue().Currency())
+	assert.Equal(t, "Downtown", c.Price().Zone())
+	assert.Equal(t, "WELCOME10", c.Price().PromoCode())
+	assert.Len(t, c.Price().Taxes(), 1)
+	assert.Equal(t, 5000, c.Price().Taxes()[0].Amount().Amount())
+	assert.Equal(t, c.CostValue(), c.Price().Total())
+	assert.Len(t, c.Deliveries(), 15)
+	for _, d := range c.Deliveries() {
+		assert.Equal(t, deliveryCreatedAt, d.CreatedAt())
+	}
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestContractRepository_GetById_Price(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewContractRepository(db)
+	id, now := uuid.New(), time.Now()
+	contractRow := func() *sqlmock.Rows {
+		return sqlmock.NewRows(contractByIdColumns).AddRow(uuid.New(), uuid.New(), "H", "A", nil, nil, false, nil, now, now, now.AddDate(0, 0, 14), 1000, "BOB", 127, nil, now, now, nil)
+	}
+
+	mock.ExpectQuery("SELECT (.+) FROM contract WHERE id = \\$1").WithArgs(id).WillReturnRows(contractRow())
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetDeliveriesByContract)).WithArgs(id).WillReturnRows(sqlmock.NewRows(deliveryColumns))
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetContractPrice)).WithArgs(id).WillReturnError(sql.ErrNoRows)
+
+	c, err := repo.GetById(context.Background(), id)
+
+	assert.NoError(t, err)
+	assert.Nil(t, c.Price())
+
+	mock.ExpectQuery("SELECT (.+) FROM contract WHERE id = \\$1").WithArgs(id).WillReturnRows(contractRow())
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetDeliveriesByContract)).WithArgs(id).WillReturnRows(sqlmock.NewRows(deliveryColumns))
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetContractPrice)).WithArgs(id).WillReturnRows(
+		sqlmock.NewRows(priceColumns).AddRow("BOB", 900, 15, "", 0, "", 0, []byte(`[]`), 900),
+	)
+
+	c, err = repo.GetById(context.Background(), id)
+
+	assert.Nil(t, c)
+	assert.ErrorIs(t, err, contracts.ErrPriceContract)
+
+	mock.ExpectQuery("SELECT (.+) FROM contract WHERE id = \\$1").WithArgs(id).WillReturnRows(contractRow())
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetDeliveriesByContract)).WithArgs(id).WillReturnRows(sqlmock.NewRows(deliveryColumns))
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetContractPrice)).WithArgs(id).WillReturnError(ErrDatabaseAdministrator)
+
+	c, err = repo.GetById(context.Background(), id)
+
+	assert.Nil(t, c)
+	assert.ErrorIs(t, err, ErrScanPrice)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestContractRepository_GetById_NotFound(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewContractRepository(db)
+	id := uuid.New()
+
+	mock.ExpectQuery("SELECT (.+) FROM contract WHERE id = \\$1").WithArgs(id).WillReturnError(sql.ErrNoRows)
+
+	c, err := repo.GetById(context.Background(), id)
+
+	assert.Nil(t, c)
+	assert.ErrorIs(t, err, contracts.ErrNotFoundContract)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestContractRepository_UpdateDeliveries(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewContractRepository(db)
+	contractId := uuid.New()
+	now := time.Now()
+
+	coordinates, err := valueobjects.NewCoordinates(51.5237, -0.1585)
+	assert.NoError(t, err)
+
+	var dlvrs []*deliveries.Delivery
+	rows := sqlmock.NewRows(deliveryColumns)
+	for i := 0; i < 3; i++ {
+		d := deliveries.NewDelivery(contractId, now.AddDate(0, 0, i), "Baker Street", 221, coordinates)
+		dlvrs = append(dlvrs, d)
+		rows.AddRow(d.Id(), contractId, d.Date(), "Baker Street", 221, 51.5237, -0.1585, "P", nil, nil, now, now, nil)
+	}
+
+	mock.ExpectQuery("UPDATE delivery AS d (.+) FROM \\(VALUES (.+)\\) AS v(.+) WHERE d.id = v.id AND d.contract_id = \\$16 AND d.status = 'P'").
+		WithArgs(
+			dlvrs[0].Id(), "Baker Street", 221, 51.5237, -0.1585,
+			dlvrs[1].Id(), "Baker Street", 221, 51.5237, -0.1585,
+			dlvrs[2].Id(), "Baker Street", 221, 51.5237, -0.1585,
+			contractId,
+		).
+		WillReturnRows(rows)
+
+	updated, err := repo.UpdateDeliveries(context.Background(), contractId, dlvrs)
+
+	assert.NoError(t, err)
+	assert.Len(t, updated, 3)
+	for i, d := range updated {
+		assert.Equal(t, dlvrs[i].Id(), d.Id())
+		assert.Equal(t, "Baker Street", d.Street())
+	}
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestContractRepository_UpdateDeliveries_NotPending(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewContractRepository(db)
+	contractId := uuid.New()
+	now := time.Now()
+
+	pending := deliveries.NewDelivery(contractId, now, "Baker Street", 221, valueobjects.Coordinates{})
+	delivered := deliveries.NewDelivery(contractId, now.AddDate(0, 0, 1), "Baker Street", 221, valueobjects.Coordinates{})
+
+	rows := sqlmock.NewRows(deliveryColumns).
+		AddRow(pending.Id(), contractId, pending.Date(), "Baker Street", 221, 0.0, 0.0, "P", nil, nil, now, now, nil)
+	mock.ExpectQuery("UPDATE delivery AS d").WillReturnRows(rows)
+
+	updated, err := repo.UpdateDeliveries(context.Background(), contractId, []*deliveries.Delivery{pending, delivered})
+
+	assert.Nil(t, updated)
+	assert.ErrorIs(t, err, deliveries.ErrNotPendingDelivery)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestContractRepository_Create_Transactional(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewContractRepository(db)
+	uow := persistence.NewUnitOfWork(db)
+
+	coordinates, err := valueobjects.NewCoordinates(-17.7863, -63.1812)
+	assert.NoError(t, err)
+
+	c := contracts.NewContract(uuid.New(), uuid.New(), halfMonthPlan, time.Now().AddDate(0, 0, 3), tenBolivianos, "Sesame Street", 30, coordinates)
+	now := time.Now()
+
+	rows := sqlmock.NewRows(deliveryColumns)
+	for _, d := range c.Deliveries() {
+		rows.AddRow(d.Id(), c.Id(), d.Date(), d.Street(), d.Number(), -17.7863, -63.1812, "P", nil, nil, now, now, nil)
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("INSERT INTO contract").
+		WithArgs(c.Id(), c.AdministratorId(), c.PatientId(), "H", c.StartDate(), c.EndDate(), 1000, "BOB", 127, false, nil, nil).
+		WillReturnRows(sqlmock.NewRows(contractColumns).AddRow(c.Id(), c.AdministratorId(), c.PatientId(), "H", "C", nil, nil, false, nil, now, c.StartDate(), c.EndDate(), 1000, "BOB", 127, nil, now, now, nil))
+	mock.ExpectQuery("INSERT INTO delivery").WillReturnRows(rows)
+	mock.ExpectCommit()
+
+	var created *contracts.Contract
+	err = uow.Do(context.Background(), func(ctx context.Context) error {
+		created, err = repo.Create(ctx, c)
+		return err
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, c.Id(), created.Id())
+	assert.Equal(t, contracts.Created, created.ContractStatus())
+	assert.Len(t, created.Deliveries(), 15)
+	for _, d := range created.Deliveries() {
+		assert.Equal(t, now, d.CreatedAt())
+	}
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestContractRepository_Create_Price(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewContractRepository(db)
+	uow := persistence.NewUnitOfWork(db)
+
+	iva, err := pricing.NewTaxRate("IVA", 1300)
+	assert.NoError(t, err)
+	price, err := pricing.Price(halfMonthPlan, 15, nil, nil, []pricing.TaxRate{iva}, time.Now())
+	assert.NoError(t, err)
+
+	c := contracts.NewContract(uuid.New(), uuid.New(), halfMonthPlan, time.Now().AddDate(0, 0, 3), price.Total(), "Sesame Street", 30, valueobjects.Coordinates{})
+	assert.NoError(t, c.Priced(price))
+	now := time.Now()
+
+	rows := sqlmock.NewRows(deliveryColumns)
+	for _, d := range c.Deliveries() {
+		rows.AddRow(d.Id(), c.Id(), d.Date(), d.Street(), d.Number(), 0.0, 0.0, "P", nil, nil, now, now, nil)
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("INSERT INTO contract").
+		WillReturnRows(sqlmock.NewRows(contractColumns).AddRow(c.Id(), c.AdministratorId(), c.PatientId(), "H", "C", nil, nil, false, nil, now, c.StartDate(), c.EndDate(), 1130, "BOB", 127, nil, now, now, nil))
+	mock.ExpectQuery("INSERT INTO delivery").WillReturnRows(rows)
+	mock.ExpectExec(regexp.QuoteMeta(QueryCreateContractPrice)).
+		WithArgs(c.Id(), "BOB", 1000, 15, "", 0, "", 0, []byte(`[{"name":"IVA","rate":1300,"amount":130}]`), 1130).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	var created *contracts.Contract
+	err = uow.Do(context.Background(), func(ctx context.Context) error {
+		created, err = repo.Create(ctx, c)
+		return err
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, price, created.Price())
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestContractRepository_Create_StoresEvents(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewContractRepository(db)
+
+	coordinates, err := valueobjects.NewCoordinates(-17.7863, -63.1812)
+	assert.NoError(t, err)
+
+	c, err := contracts.NewContractFactory().Create(uuid.New(), uuid.New(), halfMonthPlan, time.Now().AddDate(0, 0, 3), contracts.DeliveryRules{}, tenBolivianos, "Sesame Street", 30, coordinates)
+	assert.NoError(t, err)
+	now := time.Now()
+
+	rows := sqlmock.NewRows(deliveryColumns)
+	for _, d := range c.Deliveries() {
+		rows.AddRow(d.Id(), c.Id(), d.Date(), d.Street(), d.Number(), -17.7863, -63.1812, "P", nil, nil, now, now, nil)
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("INSERT INTO contract").
+		WillReturnRows(sqlmock.NewRows(contractColumns).AddRow(c.Id(), c.AdministratorId(), c.PatientId(), "H", "C", nil, nil, false, nil, now, c.StartDate(), c.EndDate(), 1000, "BOB", 127, nil, now, now, nil))
+	mock.ExpectQuery("INSERT INTO delivery").WillReturnRows(rows)
+	mock.ExpectExec("INSERT INTO outbox").
+		WithArgs(sqlmock.AnyArg(), c.Id(), contracts.ContractCreated, sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	created, err := repo.Create(context.Background(), c)
+
+	assert.NoError(t, err)
+	assert.Equal(t, c.Id(), created.Id())
+	assert.Empty(t, c.DomainEvents())
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestContractRepository_Create_DeliveriesFailureRollsBack(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewContractRepository(db)
+	uow := persistence.NewUnitOfWork(db)
+
+	coordinates, err := valueobjects.NewCoordinates(-17.7863, -63.1812)
+	assert.NoError(t, err)
+
+	c := contracts.NewContract(uuid.New(), uuid.New(), monthlyPlan, time.Now().AddDate(0, 0, 3), tenBolivianos, "Sesame Street", 30, coordinates)
+	now := time.Now()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("INSERT INTO contract").
+		WillReturnRows(sqlmock.NewRows(contractColumns).AddRow(c.Id(), c.AdministratorId(), c.PatientId(), "M", "C", nil, nil, false, nil, now, c.StartDate(), c.EndDate(), 1000, "BOB", 127, nil, now, now, nil))
+	mock.ExpectQuery("INSERT INTO delivery").WillReturnError(ErrDatabaseAdministrator)
+	mock.ExpectRollback()
+
+	err = uow.Do(context.Background(), func(ctx context.Context) error {
+		_, err := repo.Create(ctx, c)
+		return err
+	})
+
+	assert.ErrorIs(t, err, ErrDatabaseAdministrator)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestContractRepository_ChangeStatus(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewContractRepository(db)
+	now := time.Now()
+	contract := contracts.NewContract(uuid.New(), uuid.New(), monthlyPlan, now, tenBolivianos, "Sesame Street", 30, valueobjects.Coordinates{})
+	id := contract.Id()
+	assert.NoError(t, contract.Active())
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("UPDATE contract SET status = \\$1, status_reason = \\$2, suspended_at = \\$3, finalized = \\$4(.+) WHERE id = \\$5 RETURNING").
+		WithArgs("A", nil, nil, contract.EndDate(), id).
+		WillReturnRows(sqlmock.NewRows(contractColumns).AddRow(id, contract.AdministratorId(), contract.PatientId(), "M", "A", nil, nil, false, nil, now, now, now.AddDate(0, 0, 29), 1000, "BOB", 127, nil, now, now, nil))
+	mock.ExpectExec("INSERT INTO outbox").
+		WithArgs(sqlmock.AnyArg(), id, contracts.ContractActivated, sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	c, err := repo.ChangeStatus(context.Background(), contract)
+
+	assert.NoError(t, err)
+	assert.Equal(t, id, c.Id())
+	assert.Equal(t, contracts.Active, c.ContractStatus())
+	assert.Empty(t, contract.DomainEvents())
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestContractRepository_ChangeStatus_Suspended(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewContractRepository(db)
+	now := time.Now()
+	contract := contracts.NewContract(uuid.New(), uuid.New(), monthlyPlan, now, tenBolivianos, "Sesame Street", 30, valueobjects.Coordinates{})
+	id := contract.Id()
+	assert.NoError(t, contract.Active())
+	assert.NoError(t, contract.Suspend("travel", now))
+	contract.ClearDomainEvents()
+
+	reason := "travel"
+	mock.ExpectQuery("UPDATE contract SET status = (.+) RETURNING").
+		WithArgs("S", &reason, &now, contract.EndDate(), id).
+		WillReturnRows(sqlmock.NewRows(contractColumns).AddRow(id, contract.AdministratorId(), contract.PatientId(), "M", "S", "travel", now, false, nil, now, now, now.AddDate(0, 0, 29), 1000, "BOB", 127, nil, now, now, nil))
+
+	c, err := repo.ChangeStatus(context.Background(), contract)
+
+	assert.NoError(t, err)
+	assert.Equal(t, contracts.Suspended, c.ContractStatus())
+	assert.Equal(t, "travel", c.StatusReason())
+	assert.Equal(t, now, *c.SuspendedAt())
+	assert.Len(t, c.Deliveries(), 30)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestContractRepository_RescheduleDeliveries(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewContractRepository(db)
+	contractId := uuid.New()
+	date := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	moved := deliveries.NewDelivery(contractId, date, "Sesame Street", 30, valueobjects.Coordinates{})
+	assert.NoError(t, moved.Reschedule(date.AddDate(0, 0, 2)))
+	cancelled := deliveries.NewDelivery(contractId, date.AddDate(0, 0, 1), "Sesame Street", 30, valueobjects.Coordinates{})
+	assert.NoError(t, cancelled.ChangeStatus(deliveries.Cancelled))
+
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE delivery AS d (.+) FROM \\(VALUES (.+)\\) AS v\\(id, date, status\\) WHERE d.id = v.id AND d.contract_id = \\$7 AND d.status = 'P'").
+		WithArgs(moved.Id(), date.AddDate(0, 0, 2), "P", cancelled.Id(), cancelled.Date(), "C", contractId).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec("INSERT INTO outbox").
+		WithArgs(sqlmock.AnyArg(), contractId, deliveries.DeliveryCancelled, sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	err = repo.RescheduleDeliveries(context.Background(), contractId, []*deliveries.Delivery{moved, cancelled})
+
+	assert.NoError(t, err)
+	assert.Empty(t, cancelled.DomainEvents())
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestContractRepository_RescheduleDeliveries_Errors(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewContractRepository(db)
+	contractId := uuid.New()
+	d := deliveries.NewDelivery(contractId, time.Now(), "Sesame Street", 30, valueobjects.Coordinates{})
+
+	assert.NoError(t, repo.RescheduleDeliveries(context.Background(), contractId, nil))
+
+	mock.ExpectExec("UPDATE delivery AS d").WillReturnResult(sqlmock.NewResult(0, 0))
+	err = repo.RescheduleDeliveries(context.Background(), contractId, []*deliveries.Delivery{d})
+	assert.ErrorIs(t, err, deliveries.ErrNotPendingDelivery)
+
+	mock.ExpectExec("UPDATE delivery AS d").WillReturnError(ErrDatabaseAdministrator)
+	err = repo.RescheduleDeliveries(context.Background(), contractId, []*deliveries.Delivery{d})
+	assert.ErrorIs(t, err, ErrQueryDelivery)
+	assert.ErrorIs(t, err, ErrDatabaseAdministrator)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestContractRepository_AssignDeliveries(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewContractRepository(db)
+	courierId := uuid.New()
+	date := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	assigned := deliveries.NewDelivery(uuid.New(), date, "Sesame Street", 30, valueobjects.Coordinates{})
+	assert.NoError(t, assigned.Assign(courierId))
+	unassigned := deliveries.NewDelivery(uuid.New(), date, "Sesame Street", 31, valueobjects.Coordinates{})
+
+	mock.ExpectExec("UPDATE delivery AS d (.+) FROM \\(VALUES (.+)\\) AS v\\(id, courier_id\\) WHERE d.id = v.id AND d.status = 'P'").
+		WithArgs(assigned.Id(), &courierId, unassigned.Id(), nil).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+
+	err = repo.AssignDeliveries(context.Background(), []*deliveries.Delivery{assigned, unassigned})
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestContractRepository_AssignDeliveries_Errors(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewContractRepository(db)
+	d := deliveries.NewDelivery(uuid.New(), time.Now(), "Sesame Street", 30, valueobjects.Coordinates{})
+	assert.NoError(t, d.Assign(uuid.New()))
+
+	assert.NoError(t, repo.AssignDeliveries(context.Background(), nil))
+
+	mock.ExpectExec("UPDATE delivery AS d").WillReturnResult(sqlmock.NewResult(0, 0))
+	err = repo.AssignDeliveries(context.Background(), []*deliveries.Delivery{d})
+	assert.ErrorIs(t, err, deliveries.ErrNotPendingDelivery)
+
+	mock.ExpectExec("UPDATE delivery AS d").WillReturnError(ErrDatabaseAdministrator)
+	err = repo.AssignDeliveries(context.Background(), []*deliveries.Delivery{d})
+	assert.ErrorIs(t, err, ErrQueryDelivery)
+	assert.ErrorIs(t, err, ErrDatabaseAdministrator)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func contractListRows(n, perContract int) (*sqlmock.Rows, *sqlmock.Rows, []uuid.UUID, time.Time, time.Time) {
+	cRows := sqlmock.NewRows(contractColumns)
+	dRows := sqlmock.NewRows(deliveryColumns)
+	ids := make([]uuid.UUID, n)
+	contractCreated := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	deliveryCreated := time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)
+	start := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	for i := range ids {
+		ids[i] = uuid.New()
+		cRows.AddRow(ids[i], uuid.New(), uuid.New(), "M", "C", nil, nil, false, nil, contractCreated, start, start.AddDate(0, 0, perContract-1), 1000, "BOB", 127, nil, contractCreated, contractCreated, nil)
+	}
+
+	for _, id := range ids {
+		for d := 0; d < perContract; d++ {
+			dRows.AddRow(uuid.New(), id, start.AddDate(0, 0, d), "Sesame Street", 30, -17.7863, -63.1812, "P", nil, nil, deliveryCreated, deliveryCreated, nil)
+		}
+	}
+
+	return cRows, dRows, ids, contractCreated, deliveryCreated
+}
+
+func TestContractRepository_GetAll(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewContractRepository(db)
+	cRows, dRows, ids, contractCreated, deliveryCreated := contractListRows(3, 30)
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetAllContracts)).WillReturnRows(cRows)
+	mock.ExpectQuery(regexp.QuoteMeta(QueryCountContracts)).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetDeliveriesByContracts)).
+		WithArgs(pq.Array([]string{ids[0].String(), ids[1].String(), ids[2].String()})).
+		WillReturnRows(dRows)
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetContractPrices)).
+		WithArgs(pq.Array([]string{ids[0].String(), ids[1].String(), ids[2].String()})).
+		WillReturnRows(sqlmock.NewRows(append([]string{"contract_id"}, priceColumns...)).
+			AddRow(ids[1], "BOB", 1100, 30, "", 0, "WELCOME10", 100, []byte(`[]`), 1000))
+
+	page, err := repo.GetAll(context.Background(), contracts.ContractFilter{}, abstractions.Pagination{})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 3, page.Total)
+	assert.Empty(t, page.NextCursor())
+
+	cntrcts := page.Items
+	assert.Len(t, cntrcts, 3)
+	for i, c := range cntrcts {
+		assert.Equal(t, ids[i], c.Id())
+		assert.Equal(t, contractCreated, c.CreatedAt())
+		assert.Len(t, c.Deliveries(), 30)
+		if i == 1 {
+			assert.Equal(t, "WELCOME10", c.Price().PromoCode())
+			assert.Equal(t, c.CostValue(), c.Price().Total())
+		} else {
+			assert.Nil(t, c.Price())
+		}
+		for _, d := range c.Deliveries() {
+			assert.Equal(t, c.Id(), d.ContractId())
+			assert.Equal(t, deliveryCreated, d.CreatedAt())
+			assert.Equal(t, deliveryCreated, d.UpdatedAt())
+		}
+	}
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestContractRepository_GetAll_Empty(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewContractRepository(db)
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetAllContracts)).WillReturnRows(sqlmock.NewRows(contractColumns))
+	mock.ExpectQuery(regexp.QuoteMeta(QueryCountContracts)).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+
+	page, err := repo.GetAll(context.Background(), contracts.ContractFilter{}, abstractions.Pagination{})
+
+	assert.NoError(t, err)
+	assert.Empty(t, page.Items)
+	assert.Equal(t, 0, page.Total)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestContractRepository_GetAll_DeliveriesError(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewContractRepository(db)
+	cRows, _, _, _, _ := contractListRows(2, 15)
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetAllContracts)).WillReturnRows(cRows)
+	mock.ExpectQuery(regexp.QuoteMeta(QueryCountContracts)).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetDeliveriesByContracts)).WillReturnError(ErrDatabaseAdministrator)
+
+	cntrcts, err := repo.GetAll(context.Background(), contracts.ContractFilter{}, abstractions.Pagination{})
+
+	assert.ErrorIs(t, err, ErrQueryDelivery)
+	assert.Nil(t, cntrcts)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestContractRepository_GetAll_PricesError(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewContractRepository(db)
+	cRows, dRows, ids, _, _ := contractListRows(2, 15)
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetAllContracts)).WillReturnRows(cRows)
+	mock.ExpectQuery(regexp.QuoteMeta(QueryCountContracts)).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetDeliveriesByContracts)).WillReturnRows(dRows)
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetContractPrices)).WillReturnError(ErrDatabaseAdministrator)
+
+	cntrcts, err := repo.GetAll(context.Background(), contracts.ContractFilter{}, abstractions.Pagination{})
+
+	assert.ErrorIs(t, err, ErrScanPrice)
+	assert.Nil(t, cntrcts)
+
+	cRows, dRows, ids, _, _ = contractListRows(2, 15)
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetAllContracts)).WillReturnRows(cRows)
+	mock.ExpectQuery(regexp.QuoteMeta(QueryCountContracts)).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetDeliveriesByContracts)).WillReturnRows(dRows)
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetContractPrices)).WillReturnRows(sqlmock.NewRows(append([]string{"contract_id"}, priceColumns...)).
+		AddRow(ids[0], "BOB", 900, 15, "", 0, "", 0, []byte(`[]`), 900))
+
+	cntrcts, err = repo.GetAll(context.Background(), contracts.ContractFilter{}, abstractions.Pagination{})
+
+	assert.ErrorIs(t, err, contracts.ErrPriceContract)
+	assert.Nil(t, cntrcts)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func BenchmarkContractRepository_GetAll(b *testing.B) {
+	for _, n := range []int{10, 100, 1000} {
+		b.Run(fmt.Sprintf("contracts=%d", n), func(b *testing.B) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				b.Fatal(err)
+			}
+			defer db.Close()
+
+			repo := NewContractRepository(db)
+
+			b.ReportAllocs()
+			for b.Loop() {
+				b.StopTimer()
+				cRows, dRows, _, _, _ := contractListRows(n, 30)
+				mock.ExpectQuery(regexp.QuoteMeta(QueryGetAllContracts)).WillReturnRows(cRows)
+				mock.ExpectQuery(regexp.QuoteMeta(QueryCountContracts)).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(n))
+				mock.ExpectQuery(regexp.QuoteMeta(QueryGetDeliveriesByContracts)).WillReturnRows(dRows)
+				mock.ExpectQuery(regexp.QuoteMeta(QueryGetContractPrices)).WillReturnRows(sqlmock.NewRows(append([]string{"contract_id"}, priceColumns...)))
+				b.StartTimer()
+
+				if _, err := repo.GetAll(context.Background(), contracts.ContractFilter{}, abstractions.Pagination{Limit: abstractions.MaxPageLimit}); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func TestContractRepository_GetAll_Filters(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewContractRepository(db)
+
+	status, contractType := contracts.Active, contracts.Monthly
+	patientId, adminId := uuid.New(), uuid.New()
+	from, to := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC)
+	filter := contracts.ContractFilter{
+		Status:          &status,
+		Type:            &contractType,
+		PatientId:       &patientId,
+		AdministratorId: &adminId,
+		From:            &from,
+		To:              &to,
+	}
+	pagination := abstractions.Pagination{Page: 3, Limit: 10, Sort: "start", Order: abstractions.Descending}
+
+	where := " WHERE status = $1 AND type = $2 AND patient_id = $3 AND administrator_id = $4 AND finalized >= $5 AND start <= $6"
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetAllContracts+where+" ORDER BY start DESC, id DESC LIMIT $7 OFFSET $8")).
+		WithArgs("A", "M", patientId, adminId, from, to, 10, 20).
+		WillReturnRows(sqlmock.NewRows(contractColumns))
+	mock.ExpectQuery(regexp.QuoteMeta(QueryCountContracts+where)).
+		WithArgs("A", "M", patientId, adminId, from, to).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(25))
+
+	page, err := repo.GetAll(context.Background(), filter, pagination)
+
+	assert.NoError(t, err)
+	assert.Empty(t, page.Items)
+	assert.Equal(t, 25, page.Total)
+	assert.Equal(t, 3, page.Number())
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestContractRepository_GetAll_InvalidSort(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewContractRepository(db)
+
+	page, err := repo.GetAll(context.Background(), contracts.ContractFilter{}, abstractions.Pagination{Sort: "password"})
+
+	assert.ErrorIs(t, err, abstractions.ErrInvalidSortPagination)
+	assert.Nil(t, page)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestContractRepository_ExistsRenewal(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewContractRepository(db)
+	id := uuid.New()
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryExistsRenewalContract)).WithArgs(id).WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+
+	exists, err := repo.ExistsRenewal(context.Background(), id)
+
+	assert.NoError(t, err)
+	assert.True(t, exists)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestContractRepository_GetAutoRenewable(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewContractRepository(db)
+	since, until := time.Date(2025, 2, 28, 0, 0, 0, 0, time.UTC), time.Date(2025, 3, 5, 0, 0, 0, 0, time.UTC)
+	first, second := uuid.New(), uuid.New()
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetAutoRenewableContracts)).
+		WithArgs(since, until).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(first).AddRow(second))
+
+	ids, err := repo.GetAutoRenewable(context.Background(), since, until)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{first, second}, ids)
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetAutoRenewableContracts)).WillReturnError(ErrDatabaseAdministrator)
+
+	ids, err = repo.GetAutoRenewable(context.Background(), since, until)
+
+	assert.Nil(t, ids)
+	assert.ErrorIs(t, err, ErrDatabaseAdministrator)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestContractRepository_GetDue(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewContractRepository(db)
+	before := time.Date(2025, 3, 5, 0, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		name  string
+		query string
+		get   func(ctx context.Context, before time.Time) ([]uuid.UUID, error)
+	}{
+		{"to activate", QueryGetContractsToActivate, repo.GetToActivate},
+		{"to complete", QueryGetContractsToComplete, repo.GetToComplete},
+		{"with past deliveries", QueryGetContractsWithPastDeliveries, repo.GetWithPastDeliveries},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			id := uuid.New()
+
+			mock.ExpectQuery(regexp.QuoteMeta(tc.query)).
+				WithArgs(before).
+				WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(id))
+
+			ids, err := tc.get(context.Background(), before)
+
+			assert.NoError(t, err)
+			assert.Equal(t, []uuid.UUID{id}, ids)
+
+			mock.ExpectQuery(regexp.QuoteMeta(tc.query)).
+				WithArgs(before).
+				WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("not-a-uuid"))
+
+			ids, err = tc.get(context.Background(), before)
+
+			assert.Nil(t, ids)
+			assert.Error(t, err)
+
+			mock.ExpectQuery(regexp.QuoteMeta(tc.query)).WillReturnError(ErrDatabaseAdministrator)
+
+			ids, err = tc.get(context.Background(), before)
+
+			assert.Nil(t, ids)
+			assert.ErrorIs(t, err, ErrDatabaseAdministrator)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestContractRepository_SetAutoRenew(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewContractRepository(db)
+	contract := contracts.NewContract(uuid.New(), uuid.New(), monthlyPlan, time.Now(), tenBolivianos, "Sesame Street", 30, valueobjects.Coordinates{})
+	assert.NoError(t, contract.SetAutoRenew(true))
+
+	mock.ExpectExec(regexp.QuoteMeta(QuerySetAutoRenewContract)).WithArgs(true, contract.Id()).WillReturnResult(sqlmock.NewResult(0, 1))
+	assert.NoError(t, repo.SetAutoRenew(context.Background(), contract))
+
+	mock.ExpectExec(regexp.QuoteMeta(QuerySetAutoRenewContract)).WithArgs(true, contract.Id()).WillReturnResult(sqlmock.NewResult(0, 0))
+	assert.ErrorIs(t, repo.SetAutoRenew(context.Background(), contract), contracts.ErrNotFoundContract)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestContractRepository_GetPendingDeliveriesOn(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewContractRepository(db)
+	day := time.Date(2025, 8, 6, 0, 0, 0, 0, time.UTC)
+	now := time.Now()
+
+	rows := sqlmock.NewRows(deliveryColumns).
+		AddRow(uuid.New(), uuid.New(), day, "Sesame Street", 30, -17.7863, -63.1812, "P", nil, nil, now, now, nil).
+		AddRow(uuid.New(), uuid.New(), day, "Elm Street", 13, -17.7863, -63.1812, "P", nil, nil, now, now, nil)
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetPendingDeliveriesOn)).WithArgs(day, day.AddDate(0, 0, 1)).WillReturnRows(rows)
+
+	dlvrs, err := repo.GetPendingDeliveriesOn(context.Background(), day.Add(15*time.Hour))
+
+	assert.NoError(t, err)
+	assert.Len(t, dlvrs, 2)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestContractRepository_GetPendingDeliveriesOn_Error(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewContractRepository(db)
+	day := time.Date(2025, 8, 6, 0, 0, 0, 0, time.UTC)
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetPendingDeliveriesOn)).WithArgs(day, day.AddDate(0, 0, 1)).WillReturnError(ErrDatabaseAdministrator)
+
+	dlvrs, err := repo.GetPendingDeliveriesOn(context.Background(), day)
+
+	assert.Nil(t, dlvrs)
+	assert.ErrorIs(t, err, ErrQueryDelivery)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,246 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/courier"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/infrastructure/persistence"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"log"
+	"time"
+)
+
+type CourierRepository struct {
+	DB *sql.DB
+}
+
+const (
+	QueryGetAllCouriers = `SELECT id, name, phone, weekdays, max_deliveries, created_at, updated_at, deleted_at
+							FROM courier
+							WHERE deleted_at IS NULL
+							ORDER BY name`
+	QueryGetCourierById = `SELECT id, name, phone, weekdays, max_deliveries, created_at, updated_at, deleted_at
+							FROM courier
+							WHERE id = $1 AND deleted_at IS NULL`
+	QueryLockCouriers = `SELECT id
+							FROM courier
+							WHERE id = ANY($1::uuid[]) AND deleted_at IS NULL
+							ORDER BY id
+							FOR UPDATE`
+	QueryCountAssignedOn = `SELECT courier_id, COUNT(*)
+							FROM delivery
+							WHERE courier_id IS NOT NULL AND status = 'P' AND deleted_at IS NULL AND date >= $1 AND date < $2
+							GROUP BY courier_id`
+	QueryCreateCourier = `INSERT INTO courier(id, name, phone, weekdays, max_deliveries)
+							VALUES($1, $2, $3, $4, $5)
+							RETURNING id, name, phone, weekdays, max_deliveries, created_at, updated_at, deleted_at`
+	QueryUpdateCourier = `UPDATE courier
+							SET name = $1, phone = $2, weekdays = $3, max_deliveries = $4, updated_at = NOW()
+							WHERE id = $5 AND deleted_at IS NULL
+							RETURNING id, name, phone, weekdays, max_deliveries, created_at, updated_at, deleted_at`
+	QueryDeleteCourier = `UPDATE courier
+							SET deleted_at = NOW(), updated_at = NOW()
+							WHERE id = $1 AND deleted_at IS NULL`
+	QueryUnassignCourierDeliveries = `UPDATE delivery
+										SET courier_id = NULL, updated_at = NOW()
+										WHERE courier_id = $1 AND status = 'P'`
+)
+
+var (
+	ErrQueryCourier         = errors.New("query failed")
+	ErrScanCourier          = errors.New("scan failed")
+	ErrConcatenatingCourier = errors.New("error concatenating courier values from DB")
+)
+
+func (r *CourierRepository) GetAll(ctx context.Context) ([]*couriers.Courier, error) {
+	rows, err := r.conn(ctx).QueryContext(ctx, QueryGetAllCouriers)
+	if err != nil {
+		log.Printf("[repository:courier][GetAll] error executing SQL query '%s': %v", QueryGetAllCouriers, err)
+		return nil, fmt.Errorf(got, ErrQueryCourier, err)
+	}
+	defer rows.Close()
+
+	var list []*couriers.Courier
+	for rows.Next() {
+		c, err := scanCourier(rows)
+		if err != nil {
+			log.Printf("[repository:courier][GetAll] error scanning rows: %v", err)
+			return nil, err
+		}
+		list = append(list, c)
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Printf("[repository:courier][GetAll] error iterating rows: %v", err)
+		return nil, fmt.Errorf(got, ErrScanCourier, err)
+	}
+
+	log.Printf("[repository:courier][GetAll] successfully fetched %d couriers", len(list))
+	return list, nil
+}
+
+func (r *CourierRepository) GetById(ctx context.Context, id uuid.UUID) (*couriers.Courier, error) {
+	c, err := scanCourier(r.conn(ctx).QueryRowContext(ctx, QueryGetCourierById, id))
+	if err != nil {
+		log.Printf("[repository:courier][GetById] error reading courier '%s': %v", id, err)
+		return nil, err
+	}
+
+	return c, nil
+}
+
+func (r *CourierRepository) Lock(ctx context.Context, ids []uuid.UUID) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	keys := make([]string, len(ids))
+	for i, id := range ids {
+		keys[i] = id.String()
+	}
+
+	rows, err := r.conn(ctx).QueryContext(ctx, QueryLockCouriers, pq.Array(keys))
+	if err != nil {
+		log.Printf("[repository:courier][Lock] error executing SQL query '%s': %v", QueryLockCouriers, err)
+		return fmt.Errorf(got, ErrQueryCourier, err)
+	}
+	defer rows.Close()
+
+	locked := 0
+	for rows.Next() {
+		locked++
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Printf("[repository:courier][Lock] error iterating rows: %v", err)
+		return fmt.Errorf(got, ErrScanCourier, err)
+	}
+
+	if locked != len(ids) {
+		return fmt.Errorf("%w: %d of %d couriers", couriers.ErrNotFoundCourier, len(ids)-locked, len(ids))
+	}
+	return nil
+}
+
+func (r *CourierRepository) CountAssignedOn(ctx context.Context, day time.Time) (map[uuid.UUID]int, error) {
+	from := time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, day.Location())
+	rows, err := r.conn(ctx).QueryContext(ctx, QueryCountAssignedOn, from, from.AddDate(0, 0, 1))
+	if err != nil {
+		log.Printf("[repository:courier][CountAssignedOn] error executing SQL query '%s': %v", QueryCountAssignedOn, err)
+		return nil, fmt.Errorf(got, ErrQueryCourier, err)
+	}
+	defer rows.Close()
+
+	counts := make(map[uuid.UUID]int)
+	for rows.Next() {
+		var (
+			id    uuid.UUID
+			count int
+		)
+		if err = rows.Scan(&id, &count); err != nil {
+			log.Printf("[repository:courier][CountAssignedOn] error scanning rows: %v", err)
+			return nil, fmt.Errorf(got, ErrScanCourier, err)
+		}
+		counts[id] = count
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Printf("[repository:courier][CountAssignedOn] error iterating rows: %v", err)
+		return nil, fmt.Errorf(got, ErrScanCourier, err)
+	}
+
+	return counts, nil
+}
+
+func (r *CourierRepository) Create(ctx context.Context, c *couriers.Courier) (*couriers.Courier, error) {
+	created, err := scanCourier(r.conn(ctx).QueryRowContext(
+		ctx, QueryCreateCourier,
+		c.Id(), c.Name(), courierPhone(c), int(c.Availability()), c.MaxDeliveries(),
+	))
+	if err != nil {
+		log.Printf("[repository:courier][Create] error executing SQL query '%s': %v", QueryCreateCourier, err)
+		return nil, err
+	}
+
+	return created, nil
+}
+
+func (r *CourierRepository) Update(ctx context.Context, c *couriers.Courier) (*couriers.Courier, error) {
+	updated, err := scanCourier(r.conn(ctx).QueryRowContext(
+		ctx, QueryUpdateCourier,
+		c.Name(), courierPhone(c), int(c.Availability()), c.MaxDeliveries(), c.Id(),
+	))
+	if err != nil {
+		log.Printf("[repository:courier][Update] error executing SQL query '%s': %v", QueryUpdateCourier, err)
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+func (r *CourierRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	res, err := r.conn(ctx).ExecContext(ctx, QueryDeleteCourier, id)
+	if err != nil {
+		log.Printf("[repository:courier][Delete] error executing SQL query '%s': %v", QueryDeleteCourier, err)
+		return fmt.Errorf(got, ErrQueryCourier, err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf(got, ErrQueryCourier, err)
+	}
+
+	if affected == 0 {
+		return fmt.Errorf("%w: %s", couriers.ErrNotFoundCourier, id)
+	}
+
+	if _, err = r.conn(ctx).ExecContext(ctx, QueryUnassignCourierDeliveries, id); err != nil {
+		log.Printf("[repository:courier][Delete] error executing SQL query '%s': %v", QueryUnassignCourierDeliveries, err)
+		return fmt.Errorf(got, ErrQueryCourier, err)
+	}
+
+	return nil
+}
+
+func (r *CourierRepository) conn(ctx context.Context) persistence.DBTX {
+	return persistence.Executor(ctx, r.DB)
+}
+
+func courierPhone(c *couriers.Courier) *string {
+	if c.Phone() == nil {
+		return nil
+	}
+	return c.Phone().String()
+}
+
+func scanCourier(row rowScanner) (*couriers.Courier, error) {
+	var (
+		id                      uuid.UUID
+		name                    string
+		phone                   *string
+		weekdays, maxDeliveries int
+		createdAt, updatedAt    time.Time
+		deletedAt               *time.Time
+	)
+
+	err := row.Scan(&id, &name, &phone, &weekdays, &maxDeliveries, &createdAt, &updatedAt, &deletedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf(got, couriers.ErrNotFoundCourier, err)
+	} else if err != nil {
+		return nil, fmt.Errorf(got, ErrScanCourier, err)
+	}
+
+	c, err := couriers.NewCourierFromDb(id, name, phone, weekdays, maxDeliveries, createdAt, updatedAt, deletedAt)
+	if err != nil {
+		return nil, fmt.Errorf(got, ErrConcatenatingCourier, err)
+	}
+
+	return c, nil
+}
+
+func NewCourierRepository(db *sql.DB) couriers.CourierRepository {
+	return &CourierRepository{DB: db}
+}
@@ -0,0 +1,203 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/contract"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/courier"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"regexp"
+	"testing"
+	"time"
+)
+
+var courierColumns = []string{"id", "name", "phone", "weekdays", "max_deliveries", "created_at", "updated_at", "deleted_at"}
+
+func TestCourierRepository_GetAll(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewCourierRepository(db)
+	now := time.Now()
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetAllCouriers)).
+		WillReturnRows(sqlmock.NewRows(courierColumns).
+			AddRow(uuid.New(), "Ana", "70000000", 31, 20, now, now, nil).
+			AddRow(uuid.New(), "Luis", nil, 127, 15, now, now, nil))
+
+	list, err := repo.GetAll(context.Background())
+
+	assert.NoError(t, err)
+	assert.Len(t, list, 2)
+	assert.Equal(t, "Ana", list[0].Name())
+	assert.Equal(t, "70000000", *list[0].Phone().String())
+	assert.Equal(t, contracts.EveryDay, list[1].Availability())
+	assert.Nil(t, list[1].Phone())
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetAllCouriers)).
+		WillReturnRows(sqlmock.NewRows(courierColumns).AddRow(uuid.New(), "Ana", nil, 0, 20, now, now, nil))
+
+	list, err = repo.GetAll(context.Background())
+
+	assert.Nil(t, list)
+	assert.ErrorIs(t, err, ErrConcatenatingCourier)
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetAllCouriers)).WillReturnError(ErrDatabaseAdministrator)
+
+	list, err = repo.GetAll(context.Background())
+
+	assert.Nil(t, list)
+	assert.ErrorIs(t, err, ErrQueryCourier)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCourierRepository_GetById(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewCourierRepository(db)
+	id, now := uuid.New(), time.Now()
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetCourierById)).
+		WithArgs(id).
+		WillReturnRows(sqlmock.NewRows(courierColumns).AddRow(id, "Ana", nil, 62, 20, now, now, nil))
+
+	c, err := repo.GetById(context.Background(), id)
+
+	assert.NoError(t, err)
+	assert.Equal(t, id, c.Id())
+	assert.Equal(t, contracts.MondayToFriday, c.Availability())
+	assert.Equal(t, 20, c.MaxDeliveries())
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetCourierById)).WithArgs(id).WillReturnError(sql.ErrNoRows)
+
+	c, err = repo.GetById(context.Background(), id)
+
+	assert.Nil(t, c)
+	assert.ErrorIs(t, err, couriers.ErrNotFoundCourier)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCourierRepository_Lock(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewCourierRepository(db)
+	ana, luis := uuid.New(), uuid.New()
+	ids := pq.Array([]string{ana.String(), luis.String()})
+
+	assert.NoError(t, repo.Lock(context.Background(), nil))
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryLockCouriers)).WithArgs(ids).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(ana).AddRow(luis))
+	assert.NoError(t, repo.Lock(context.Background(), []uuid.UUID{ana, luis}))
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryLockCouriers)).WithArgs(ids).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(ana))
+	assert.ErrorIs(t, repo.Lock(context.Background(), []uuid.UUID{ana, luis}), couriers.ErrNotFoundCourier)
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryLockCouriers)).WithArgs(ids).WillReturnError(ErrDatabaseAdministrator)
+	assert.ErrorIs(t, repo.Lock(context.Background(), []uuid.UUID{ana, luis}), ErrQueryCourier)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCourierRepository_CountAssignedOn(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewCourierRepository(db)
+	day := time.Date(2025, 3, 3, 15, 30, 0, 0, time.UTC)
+	from := time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC)
+	ana, luis := uuid.New(), uuid.New()
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryCountAssignedOn)).
+		WithArgs(from, from.AddDate(0, 0, 1)).
+		WillReturnRows(sqlmock.NewRows([]string{"courier_id", "count"}).AddRow(ana, 4).AddRow(luis, 1))
+
+	counts, err := repo.CountAssignedOn(context.Background(), day)
+
+	assert.NoError(t, err)
+	assert.Equal(t, map[uuid.UUID]int{ana: 4, luis: 1}, counts)
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryCountAssignedOn)).WillReturnError(ErrDatabaseAdministrator)
+
+	counts, err = repo.CountAssignedOn(context.Background(), day)
+
+	assert.Nil(t, counts)
+	assert.ErrorIs(t, err, ErrQueryCourier)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCourierRepository_CreateAndUpdate(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewCourierRepository(db)
+	c := couriers.NewCourier("Ana", nil, contracts.MondayToFriday, 20)
+	now := time.Now()
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryCreateCourier)).
+		WithArgs(c.Id(), "Ana", nil, 62, 20).
+		WillReturnRows(sqlmock.NewRows(courierColumns).AddRow(c.Id(), "Ana", nil, 62, 20, now, now, nil))
+
+	created, err := repo.Create(context.Background(), c)
+
+	assert.NoError(t, err)
+	assert.Equal(t, c.Id(), created.Id())
+	assert.Equal(t, now, created.CreatedAt())
+
+	phone := "70000000"
+	p, _ := valueobjects.NewPhone(&phone)
+	assert.NoError(t, c.Update("Ana Vaca", p, contracts.EveryDay, 25))
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryUpdateCourier)).
+		WithArgs("Ana Vaca", &phone, 127, 25, c.Id()).
+		WillReturnRows(sqlmock.NewRows(courierColumns).AddRow(c.Id(), "Ana Vaca", phone, 127, 25, now, now, nil))
+
+	updated, err := repo.Update(context.Background(), c)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Ana Vaca", updated.Name())
+	assert.Equal(t, contracts.EveryDay, updated.Availability())
+	assert.Equal(t, 25, updated.MaxDeliveries())
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryUpdateCourier)).WillReturnError(sql.ErrNoRows)
+
+	updated, err = repo.Update(context.Background(), c)
+
+	assert.Nil(t, updated)
+	assert.ErrorIs(t, err, couriers.ErrNotFoundCourier)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCourierRepository_Delete(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewCourierRepository(db)
+	id := uuid.New()
+
+	mock.ExpectExec(regexp.QuoteMeta(QueryDeleteCourier)).WithArgs(id).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(QueryUnassignCourierDeliveries)).WithArgs(id).WillReturnResult(sqlmock.NewResult(0, 3))
+	assert.NoError(t, repo.Delete(context.Background(), id))
+
+	mock.ExpectExec(regexp.QuoteMeta(QueryDeleteCourier)).WithArgs(id).WillReturnResult(sqlmock.NewResult(0, 0))
+	assert.ErrorIs(t, repo.Delete(context.Background(), id), couriers.ErrNotFoundCourier)
+
+	mock.ExpectExec(regexp.QuoteMeta(QueryDeleteCourier)).WithArgs(id).WillReturnError(ErrDatabaseAdministrator)
+	assert.ErrorIs(t, repo.Delete(context.Background(), id), ErrQueryCourier)
+
+	mock.ExpectExec(regexp.QuoteMeta(QueryDeleteCourier)).WithArgs(id).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(QueryUnassignCourierDeliveries)).WithArgs(id).WillReturnError(ErrDatabaseAdministrator)
+	assert.ErrorIs(t, repo.Delete(context.Background(), id), ErrQueryCourier)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,154 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/holiday"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/infrastructure/persistence"
+	"github.com/google/uuid"
+	"log"
+	"time"
+)
+
+type HolidayRepository struct {
+	DB *sql.DB
+}
+
+const (
+	QueryGetHolidaysBetween = `SELECT id, date, name, area_name, latitude, longitude, radius, policy, created_at, updated_at, deleted_at
+								FROM holiday
+								WHERE deleted_at IS NULL AND date BETWEEN $1 AND $2
+								ORDER BY date, name`
+	QueryGetHolidayById = `SELECT id, date, name, area_name, latitude, longitude, radius, policy, created_at, updated_at, deleted_at
+							FROM holiday
+							WHERE id = $1 AND deleted_at IS NULL`
+	QueryCreateHoliday = `INSERT INTO holiday(id, date, name, area_name, latitude, longitude, radius, policy)
+							VALUES($1, $2, $3, $4, $5, $6, $7, $8)
+							RETURNING id, date, name, area_name, latitude, longitude, radius, policy, created_at, updated_at, deleted_at`
+	QueryDeleteHoliday = `UPDATE holiday
+							SET deleted_at = NOW(), updated_at = NOW()
+							WHERE id = $1 AND deleted_at IS NULL`
+)
+
+var (
+	ErrQueryHoliday         = errors.New("query failed")
+	ErrScanHoliday          = errors.New("scan failed")
+	ErrConcatenatingHoliday = errors.New("error concatenating holiday values from DB")
+)
+
+func (r *HolidayRepository) GetBetween(ctx context.Context, from, to time.Time) ([]*holidays.Holiday, error) {
+	rows, err := r.conn(ctx).QueryContext(ctx, QueryGetHolidaysBetween, from.Format(time.DateOnly), to.Format(time.DateOnly))
+	if err != nil {
+		log.Printf("[repository:holiday][GetBetween] error executing SQL query '%s': %v", QueryGetHolidaysBetween, err)
+		return nil, fmt.Errorf(got, ErrQueryHoliday, err)
+	}
+	defer rows.Close()
+
+	var list []*holidays.Holiday
+	for rows.Next() {
+		h, err := scanHoliday(rows)
+		if err != nil {
+			log.Printf("[repository:holiday][GetBetween] error scanning rows: %v", err)
+			return nil, err
+		}
+		list = append(list, h)
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Printf("[repository:holiday][GetBetween] error iterating rows: %v", err)
+		return nil, fmt.Errorf(got, ErrScanHoliday, err)
+	}
+
+	log.Printf("[repository:holiday][GetBetween] successfully fetched %d holidays", len(list))
+	return list, nil
+}
+
+func (r *HolidayRepository) GetById(ctx context.Context, id uuid.UUID) (*holidays.Holiday, error) {
+	h, err := scanHoliday(r.conn(ctx).QueryRowContext(ctx, QueryGetHolidayById, id))
+	if err != nil {
+		log.Printf("[repository:holiday][GetById] error reading holiday '%s': %v", id, err)
+		return nil, err
+	}
+
+	return h, nil
+}
+
+func (r *HolidayRepository) Create(ctx context.Context, h *holidays.Holiday) (*holidays.Holiday, error) {
+	var (
+		areaName            *string
+		latitude, longitude *float64
+		radius              *int
+	)
+
+	if a := h.Area(); a != nil {
+		name, lat, lon, rad := a.Name(), a.Center().Latitude(), a.Center().Longitude(), a.Radius()
+		areaName, latitude, longitude, radius = &name, &lat, &lon, &rad
+	}
+
+	created, err := scanHoliday(r.conn(ctx).QueryRowContext(
+		ctx, QueryCreateHoliday,
+		h.Id(), h.Date().Format(time.DateOnly), h.Name(), areaName, latitude, longitude, radius, string(h.Policy()),
+	))
+	if err != nil {
+		log.Printf("[repository:holiday][Create] error executing SQL query '%s': %v", QueryCreateHoliday, err)
+		return nil, err
+	}
+
+	return created, nil
+}
+
+func (r *HolidayRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	res, err := r.conn(ctx).ExecContext(ctx, QueryDeleteHoliday, id)
+	if err != nil {
+		log.Printf("[repository:holiday][Delete] error executing SQL query '%s': %v", QueryDeleteHoliday, err)
+		return fmt.Errorf(got, ErrQueryHoliday, err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf(got, ErrQueryHoliday, err)
+	}
+
+	if affected == 0 {
+		return fmt.Errorf("%w: %s", holidays.ErrNotFoundHoliday, id)
+	}
+
+	return nil
+}
+
+func (r *HolidayRepository) conn(ctx context.Context) persistence.DBTX {
+	return persistence.Executor(ctx, r.DB)
+}
+
+func scanHoliday(row rowScanner) (*holidays.Holiday, error) {
+	var (
+		id                   uuid.UUID
+		date                 time.Time
+		name, policy         string
+		areaName             *string
+		latitude, longitude  *float64
+		radius               *int
+		createdAt, updatedAt time.Time
+		deletedAt            *time.Time
+	)
+
+	err := row.Scan(&id, &date, &name, &areaName, &latitude, &longitude, &radius, &policy, &createdAt, &updatedAt, &deletedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf(got, holidays.ErrNotFoundHoliday, err)
+	} else if err != nil {
+		return nil, fmt.Errorf(got, ErrScanHoliday, err)
+	}
+
+	h, err := holidays.NewHolidayFromDb(id, date, name, areaName, latitude, longitude, radius, policy, createdAt, updatedAt, deletedAt)
+	if err != nil {
+		return nil, fmt.Errorf(got, ErrConcatenatingHoliday, err)
+	}
+
+	return h, nil
+}
+
+func NewHolidayRepository(db *sql.DB) holidays.HolidayRepository {
+	return &HolidayRepository{DB: db}
+}
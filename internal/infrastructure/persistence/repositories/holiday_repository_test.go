@@ -0,0 +1,143 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/holiday"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"regexp"
+	"testing"
+	"time"
+)
+
+var holidayColumns = []string{"id", "date", "name", "area_name", "latitude", "longitude", "radius", "policy", "created_at", "updated_at", "deleted_at"}
+
+func TestHolidayRepository_GetBetween(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewHolidayRepository(db)
+	from := time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 1, 0)
+	now := time.Now()
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetHolidaysBetween)).
+		WithArgs("2025-08-01", "2025-09-01").
+		WillReturnRows(sqlmock.NewRows(holidayColumns).
+			AddRow(uuid.New(), from.AddDate(0, 0, 5), "Independence Day", nil, nil, nil, nil, "S", now, now, nil).
+			AddRow(uuid.New(), from.AddDate(0, 0, 20), "Anniversary", "Santa Cruz", -17.7863, -63.1812, 5000, "R", now, now, nil))
+
+	list, err := repo.GetBetween(context.Background(), from, to)
+
+	assert.NoError(t, err)
+	assert.Len(t, list, 2)
+	assert.Nil(t, list[0].Area())
+	assert.Equal(t, holidays.Shift, list[0].Policy())
+	assert.Equal(t, "Santa Cruz", list[1].Area().Name())
+	assert.Equal(t, 5000, list[1].Area().Radius())
+	assert.Equal(t, holidays.Refuse, list[1].Policy())
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetHolidaysBetween)).
+		WillReturnRows(sqlmock.NewRows(holidayColumns).AddRow(uuid.New(), from, "Closure", nil, nil, nil, nil, "X", now, now, nil))
+
+	list, err = repo.GetBetween(context.Background(), from, to)
+
+	assert.Nil(t, list)
+	assert.ErrorIs(t, err, ErrConcatenatingHoliday)
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetHolidaysBetween)).WillReturnError(ErrDatabaseAdministrator)
+
+	list, err = repo.GetBetween(context.Background(), from, to)
+
+	assert.Nil(t, list)
+	assert.ErrorIs(t, err, ErrQueryHoliday)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestHolidayRepository_GetById(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewHolidayRepository(db)
+	id, now := uuid.New(), time.Now()
+	date := time.Date(2025, 8, 6, 0, 0, 0, 0, time.UTC)
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetHolidayById)).
+		WithArgs(id).
+		WillReturnRows(sqlmock.NewRows(holidayColumns).AddRow(id, date, "Independence Day", nil, nil, nil, nil, "S", now, now, nil))
+
+	h, err := repo.GetById(context.Background(), id)
+
+	assert.NoError(t, err)
+	assert.Equal(t, id, h.Id())
+	assert.Equal(t, date, h.Date())
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetHolidayById)).WithArgs(id).WillReturnError(sql.ErrNoRows)
+
+	h, err = repo.GetById(context.Background(), id)
+
+	assert.Nil(t, h)
+	assert.ErrorIs(t, err, holidays.ErrNotFoundHoliday)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestHolidayRepository_Create(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewHolidayRepository(db)
+	now := time.Now()
+	date := time.Date(2025, 9, 24, 0, 0, 0, 0, time.UTC)
+	center, err := valueobjects.NewCoordinates(-17.7863, -63.1812)
+	assert.NoError(t, err)
+	area, err := holidays.NewArea("Santa Cruz", center, 5000)
+	assert.NoError(t, err)
+	h := holidays.NewHoliday(date, "Anniversary", area, holidays.Refuse)
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryCreateHoliday)).
+		WithArgs(h.Id(), "2025-09-24", "Anniversary", "Santa Cruz", -17.7863, -63.1812, 5000, "R").
+		WillReturnRows(sqlmock.NewRows(holidayColumns).AddRow(h.Id(), date, "Anniversary", "Santa Cruz", -17.7863, -63.1812, 5000, "R", now, now, nil))
+
+	created, err := repo.Create(context.Background(), h)
+
+	assert.NoError(t, err)
+	assert.Equal(t, h.Id(), created.Id())
+	assert.Equal(t, now, created.CreatedAt())
+
+	national := holidays.NewHoliday(date, "National", nil, holidays.Shift)
+	mock.ExpectQuery(regexp.QuoteMeta(QueryCreateHoliday)).
+		WithArgs(national.Id(), "2025-09-24", "National", nil, nil, nil, nil, "S").
+		WillReturnError(ErrDatabaseAdministrator)
+
+	created, err = repo.Create(context.Background(), national)
+
+	assert.Nil(t, created)
+	assert.ErrorIs(t, err, ErrScanHoliday)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestHolidayRepository_Delete(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewHolidayRepository(db)
+	id := uuid.New()
+
+	mock.ExpectExec(regexp.QuoteMeta(QueryDeleteHoliday)).WithArgs(id).WillReturnResult(sqlmock.NewResult(0, 1))
+	assert.NoError(t, repo.Delete(context.Background(), id))
+
+	mock.ExpectExec(regexp.QuoteMeta(QueryDeleteHoliday)).WithArgs(id).WillReturnResult(sqlmock.NewResult(0, 0))
+	assert.ErrorIs(t, repo.Delete(context.Background(), id), holidays.ErrNotFoundHoliday)
+
+	mock.ExpectExec(regexp.QuoteMeta(QueryDeleteHoliday)).WithArgs(id).WillReturnError(ErrDatabaseAdministrator)
+	assert.ErrorIs(t, repo.Delete(context.Background(), id), ErrQueryHoliday)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,349 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/invoice"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/infrastructure/persistence"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"log"
+	"time"
+)
+
+type InvoiceRepository struct {
+	DB *sql.DB
+}
+
+const (
+	QueryGetInvoiceById = `SELECT id, series, sequence, contract_id, patient_id, amount, currency, issued_at, created_at, updated_at
+							FROM invoice
+							WHERE id = $1`
+	QueryGetInvoiceByIdForUpdate = `SELECT id, series, sequence, contract_id, patient_id, amount, currency, issued_at, created_at, updated_at
+									FROM invoice
+									WHERE id = $1
+									FOR UPDATE`
+	QueryGetInvoiceByContract = `SELECT id, series, sequence, contract_id, patient_id, amount, currency, issued_at, created_at, updated_at
+								FROM invoice
+								WHERE contract_id = $1`
+	QueryGetInvoicesByPatient = `SELECT id, series, sequence, contract_id, patient_id, amount, currency, issued_at, created_at, updated_at
+								FROM invoice
+								WHERE patient_id = $1
+								ORDER BY issued_at, sequence`
+	QueryGetPaymentsByInvoices = `SELECT id, invoice_id, kind, amount, currency, provider, reference, paid_at, created_at
+								FROM payment
+								WHERE invoice_id = ANY($1::uuid[])
+								ORDER BY paid_at, created_at`
+	QueryGetCreditNotesByInvoices = `SELECT id, invoice_id, amount, currency, reason, issued_at, created_at
+									FROM credit_note
+									WHERE invoice_id = ANY($1::uuid[])
+									ORDER BY issued_at, created_at`
+	QueryNextInvoiceSequence = `INSERT INTO invoice_sequence(series, last_value)
+								VALUES($1, 1)
+								ON CONFLICT (series) DO UPDATE SET last_value = invoice_sequence.last_value + 1
+								RETURNING last_value`
+	QueryCreateInvoice = `INSERT INTO invoice(id, series, sequence, contract_id, patient_id, amount, currency, issued_at)
+							VALUES($1, $2, $3, $4, $5, $6, $7, $8)
+							RETURNING id, series, sequence, contract_id, patient_id, amount, currency, issued_at, created_at, updated_at`
+	QueryCreatePayment = `INSERT INTO payment(id, invoice_id, kind, amount, currency, provider, reference, paid_at)
+							VALUES($1, $2, $3, $4, $5, $6, $7, $8)
+							RETURNING id, invoice_id, kind, amount, currency, provider, reference, paid_at, created_at`
+	QueryCreateCreditNote = `INSERT INTO credit_note(id, invoice_id, amount, currency, reason, issued_at)
+								VALUES($1, $2, $3, $4, $5, $6)
+								RETURNING id, invoice_id, amount, currency, reason, issued_at, created_at`
+	QueryTouchInvoice = `UPDATE invoice
+							SET updated_at = NOW()
+							WHERE id = $1`
+)
+
+var (
+	ErrQueryInvoice         = errors.New("query failed")
+	ErrScanInvoice          = errors.New("scan failed")
+	ErrConcatenatingInvoice = errors.New("error concatenating invoice values from DB")
+	ErrScanPayment          = errors.New("scan failed")
+	ErrScanCreditNote       = errors.New("scan failed")
+)
+
+func (r *InvoiceRepository) GetById(ctx context.Context, id uuid.UUID) (*invoices.Invoice, error) {
+	return r.getOne(ctx, "GetById", QueryGetInvoiceById, id)
+}
+
+func (r *InvoiceRepository) GetByIdForUpdate(ctx context.Context, id uuid.UUID) (*invoices.Invoice, error) {
+	return r.getOne(ctx, "GetByIdForUpdate", QueryGetInvoiceByIdForUpdate, id)
+}
+
+func (r *InvoiceRepository) GetByContract(ctx context.Context, contractId uuid.UUID) (*invoices.Invoice, error) {
+	return r.getOne(ctx, "GetByContract", QueryGetInvoiceByContract, contractId)
+}
+
+func (r *InvoiceRepository) GetByPatient(ctx context.Context, patientId uuid.UUID) ([]*invoices.Invoice, error) {
+	rows, err := r.conn(ctx).QueryContext(ctx, QueryGetInvoicesByPatient, patientId)
+	if err != nil {
+		log.Printf("[repository:invoice][GetByPatient] error executing SQL query '%s': %v", QueryGetInvoicesByPatient, err)
+		return nil, fmt.Errorf(got, ErrQueryInvoice, err)
+	}
+	defer rows.Close()
+
+	var heads []*invoiceRow
+	for rows.Next() {
+		head, err := scanInvoiceRow(rows)
+		if err != nil {
+			log.Printf("[repository:invoice][GetByPatient] error scanning rows: %v", err)
+			return nil, err
+		}
+		heads = append(heads, head)
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Printf("[repository:invoice][GetByPatient] error iterating rows: %v", err)
+		return nil, fmt.Errorf(got, ErrScanInvoice, err)
+	}
+
+	list, err := r.withDetails(ctx, heads)
+	if err != nil {
+		log.Printf("[repository:invoice][GetByPatient] error reading payments and credit notes of patient '%s': %v", patientId, err)
+		return nil, err
+	}
+
+	log.Printf("[repository:invoice][GetByPatient] successfully fetched %d invoices of patient '%s'", len(list), patientId)
+	return list, nil
+}
+
+func (r *InvoiceRepository) NextSequence(ctx context.Context, series string) (int, error) {
+	var sequence int
+	if err := r.conn(ctx).QueryRowContext(ctx, QueryNextInvoiceSequence, series).Scan(&sequence); err != nil {
+		log.Printf("[repository:invoice][NextSequence] error executing SQL query '%s': %v", QueryNextInvoiceSequence, err)
+		return 0, fmt.Errorf(got, ErrQueryInvoice, err)
+	}
+
+	return sequence, nil
+}
+
+func (r *InvoiceRepository) Create(ctx context.Context, i *invoices.Invoice) (*invoices.Invoice, error) {
+	amount := i.Amount()
+	head, err := scanInvoiceRow(r.conn(ctx).QueryRowContext(
+		ctx, QueryCreateInvoice,
+		i.Id(), i.Series(), i.Sequence(), i.ContractId(), i.PatientId(), amount.Amount(), string(amount.Currency()), i.IssuedAt(),
+	))
+	if err != nil {
+		log.Printf("[repository:invoice][Create] error executing SQL query '%s': %v", QueryCreateInvoice, err)
+		return nil, err
+	}
+
+	created, err := head.toInvoice(nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("[repository:invoice][Create] invoice '%s' created", created.Number())
+	return created, nil
+}
+
+func (r *InvoiceRepository) AddPayment(ctx context.Context, p *invoices.Payment) (*invoices.Payment, error) {
+	amount := p.Amount()
+	created, err := scanPayment(r.conn(ctx).QueryRowContext(
+		ctx, QueryCreatePayment,
+		p.Id(), p.InvoiceId(), string(p.Kind()), amount.Amount(), string(amount.Currency()), p.Provider(), p.Reference(), p.PaidAt(),
+	))
+	if err != nil {
+		log.Printf("[repository:invoice][AddPayment] error executing SQL query '%s': %v", QueryCreatePayment, err)
+		return nil, err
+	}
+
+	if _, err = r.conn(ctx).ExecContext(ctx, QueryTouchInvoice, p.InvoiceId()); err != nil {
+		log.Printf("[repository:invoice][AddPayment] error executing SQL query '%s': %v", QueryTouchInvoice, err)
+		return nil, fmt.Errorf(got, ErrQueryInvoice, err)
+	}
+
+	return created, nil
+}
+
+func (r *InvoiceRepository) AddCreditNote(ctx context.Context, c *invoices.CreditNote) (*invoices.CreditNote, error) {
+	amount := c.Amount()
+	created, err := scanCreditNote(r.conn(ctx).QueryRowContext(
+		ctx, QueryCreateCreditNote,
+		c.Id(), c.InvoiceId(), amount.Amount(), string(amount.Currency()), c.Reason(), c.IssuedAt(),
+	))
+	if err != nil {
+		log.Printf("[repository:invoice][AddCreditNote] error executing SQL query '%s': %v", QueryCreateCreditNote, err)
+		return nil, err
+	}
+
+	if _, err = r.conn(ctx).ExecContext(ctx, QueryTouchInvoice, c.InvoiceId()); err != nil {
+		log.Printf("[repository:invoice][AddCreditNote] error executing SQL query '%s': %v", QueryTouchInvoice, err)
+		return nil, fmt.Errorf(got, ErrQueryInvoice, err)
+	}
+
+	return created, nil
+}
+
+func (r *InvoiceRepository) getOne(ctx context.Context, method, query string, id uuid.UUID) (*invoices.Invoice, error) {
+	head, err := scanInvoiceRow(r.conn(ctx).QueryRowContext(ctx, query, id))
+	if err != nil {
+		log.Printf("[repository:invoice][%s] error reading invoice '%s': %v", method, id, err)
+		return nil, err
+	}
+
+	list, err := r.withDetails(ctx, []*invoiceRow{head})
+	if err != nil {
+		log.Printf("[repository:invoice][%s] error reading payments and credit notes of invoice '%s': %v", method, head.id, err)
+		return nil, err
+	}
+
+	return list[0], nil
+}
+
+func (r *InvoiceRepository) withDetails(ctx context.Context, heads []*invoiceRow) ([]*invoices.Invoice, error) {
+	if len(heads) == 0 {
+		return []*invoices.Invoice{}, nil
+	}
+
+	keys := make([]string, len(heads))
+	for i, h := range heads {
+		keys[i] = h.id.String()
+	}
+
+	payments, err := r.payments(ctx, keys)
+	if err != nil {
+		return nil, err
+	}
+
+	credits, err := r.creditNotes(ctx, keys)
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]*invoices.Invoice, 0, len(heads))
+	for _, h := range heads {
+		i, err := h.toInvoice(payments[h.id], credits[h.id])
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, i)
+	}
+
+	return list, nil
+}
+
+func (r *InvoiceRepository) payments(ctx context.Context, keys []string) (map[uuid.UUID][]*invoices.Payment, error) {
+	rows, err := r.conn(ctx).QueryContext(ctx, QueryGetPaymentsByInvoices, pq.Array(keys))
+	if err != nil {
+		return nil, fmt.Errorf(got, ErrQueryInvoice, err)
+	}
+	defer rows.Close()
+
+	grouped := make(map[uuid.UUID][]*invoices.Payment)
+	for rows.Next() {
+		p, err := scanPayment(rows)
+		if err != nil {
+			return nil, err
+		}
+		grouped[p.InvoiceId()] = append(grouped[p.InvoiceId()], p)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf(got, ErrScanPayment, err)
+	}
+
+	return grouped, nil
+}
+
+func (r *InvoiceRepository) creditNotes(ctx context.Context, keys []string) (map[uuid.UUID][]*invoices.CreditNote, error) {
+	rows, err := r.conn(ctx).QueryContext(ctx, QueryGetCreditNotesByInvoices, pq.Array(keys))
+	if err != nil {
+		return nil, fmt.Errorf(got, ErrQueryInvoice, err)
+	}
+	defer rows.Close()
+
+	grouped := make(map[uuid.UUID][]*invoices.CreditNote)
+	for rows.Next() {
+		c, err := scanCreditNote(rows)
+		if err != nil {
+			return nil, err
+		}
+		grouped[c.InvoiceId()] = append(grouped[c.InvoiceId()], c)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf(got, ErrScanCreditNote, err)
+	}
+
+	return grouped, nil
+}
+
+func (r *InvoiceRepository) conn(ctx context.Context) persistence.DBTX {
+	return persistence.Executor(ctx, r.DB)
+}
+
+type invoiceRow struct {
+	id, contractId, patientId      uuid.UUID
+	series, currency               string
+	sequence, amount               int
+	issuedAt, createdAt, updatedAt time.Time
+}
+
+func (h *invoiceRow) toInvoice(payments []*invoices.Payment, credits []*invoices.CreditNote) (*invoices.Invoice, error) {
+	i, err := invoices.NewInvoiceFromDb(h.id, h.series, h.sequence, h.contractId, h.patientId, h.amount, h.currency, h.issuedAt, payments, credits, h.createdAt, h.updatedAt)
+	if err != nil {
+		return nil, fmt.Errorf(got, ErrConcatenatingInvoice, err)
+	}
+	return i, nil
+}
+
+func scanInvoiceRow(row rowScanner) (*invoiceRow, error) {
+	var h invoiceRow
+	err := row.Scan(&h.id, &h.series, &h.sequence, &h.contractId, &h.patientId, &h.amount, &h.currency, &h.issuedAt, &h.createdAt, &h.updatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf(got, invoices.ErrNotFoundInvoice, err)
+	} else if err != nil {
+		return nil, fmt.Errorf(got, ErrScanInvoice, err)
+	}
+
+	return &h, nil
+}
+
+func scanPayment(row rowScanner) (*invoices.Payment, error) {
+	var (
+		id, invoiceId                       uuid.UUID
+		kind, currency, provider, reference string
+		amount                              int
+		paidAt, createdAt                   time.Time
+	)
+
+	if err := row.Scan(&id, &invoiceId, &kind, &amount, &currency, &provider, &reference, &paidAt, &createdAt); err != nil {
+		return nil, fmt.Errorf(got, ErrScanPayment, err)
+	}
+
+	p, err := invoices.NewPaymentFromDb(id, invoiceId, kind, amount, currency, provider, reference, paidAt, createdAt)
+	if err != nil {
+		return nil, fmt.Errorf(got, ErrConcatenatingInvoice, err)
+	}
+
+	return p, nil
+}
+
+func scanCreditNote(row rowScanner) (*invoices.CreditNote, error) {
+	var (
+		id, invoiceId       uuid.UUID
+		currency, reason    string
+		amount              int
+		issuedAt, createdAt time.Time
+	)
+
+	if err := row.Scan(&id, &invoiceId, &amount, &currency, &reason, &issuedAt, &createdAt); err != nil {
+		return nil, fmt.Errorf(got, ErrScanCreditNote, err)
+	}
+
+	c, err := invoices.NewCreditNoteFromDb(id, invoiceId, amount, currency, reason, issuedAt, createdAt)
+	if err != nil {
+		return nil, fmt.Errorf(got, ErrConcatenatingInvoice, err)
+	}
+
+	return c, nil
+}
+
+func NewInvoiceRepository(db *sql.DB) invoices.InvoiceRepository {
+	return &InvoiceRepository{DB: db}
+}
@@ -0,0 +1,315 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/invoice"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"regexp"
+	"testing"
+	"time"
+)
+
+var (
+	invoiceColumns = []string{"id", "series", "sequence", "contract_id", "patient_id", "amount", "currency", "issued_at", "created_at", "updated_at"}
+	paymentColumns = []string{"id", "invoice_id", "kind", "amount", "currency", "provider", "reference", "paid_at", "created_at"}
+	creditColumns  = []string{"id", "invoice_id", "amount", "currency", "reason", "issued_at", "created_at"}
+)
+
+func TestInvoiceRepository_GetById(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewInvoiceRepository(db)
+	id, contractId, patientId, now := uuid.New(), uuid.New(), uuid.New(), time.Now()
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetInvoiceById)).
+		WithArgs(id).
+		WillReturnRows(sqlmock.NewRows(invoiceColumns).AddRow(id, "NC", 42, contractId, patientId, 45000, "BOB", now, now, now))
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetPaymentsByInvoices)).
+		WithArgs(pq.Array([]string{id.String()})).
+		WillReturnRows(sqlmock.NewRows(paymentColumns).
+			AddRow(uuid.New(), id, "P", 20000, "BOB", "fake", "fake_1", now, now).
+			AddRow(uuid.New(), id, "P", 5000, "BOB", "fake", "fake_2", now, now))
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetCreditNotesByInvoices)).
+		WithArgs(pq.Array([]string{id.String()})).
+		WillReturnRows(sqlmock.NewRows(creditColumns).AddRow(uuid.New(), id, 15000, "BOB", "moved abroad", now, now))
+
+	i, err := repo.GetById(context.Background(), id)
+
+	assert.NoError(t, err)
+	assert.Equal(t, id, i.Id())
+	assert.Equal(t, "NC-000042", i.Number())
+	assert.Equal(t, contractId, i.ContractId())
+	assert.Len(t, i.Payments(), 2)
+	assert.Len(t, i.CreditNotes(), 1)
+	assert.Equal(t, 5000, i.Balance().Amount())
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetInvoiceById)).WithArgs(id).WillReturnError(sql.ErrNoRows)
+
+	i, err = repo.GetById(context.Background(), id)
+
+	assert.Nil(t, i)
+	assert.ErrorIs(t, err, invoices.ErrNotFoundInvoice)
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetInvoiceById)).
+		WithArgs(id).
+		WillReturnRows(sqlmock.NewRows(invoiceColumns).AddRow(id, "NC", 42, contractId, patientId, 45000, "BOB", now, now, now))
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetPaymentsByInvoices)).WillReturnError(ErrDatabaseAdministrator)
+
+	i, err = repo.GetById(context.Background(), id)
+
+	assert.Nil(t, i)
+	assert.ErrorIs(t, err, ErrQueryInvoice)
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetInvoiceById)).
+		WithArgs(id).
+		WillReturnRows(sqlmock.NewRows(invoiceColumns).AddRow(id, "NC", 42, contractId, patientId, 45000, "BOB", now, now, now))
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetPaymentsByInvoices)).WillReturnRows(sqlmock.NewRows(paymentColumns))
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetCreditNotesByInvoices)).WillReturnError(ErrDatabaseAdministrator)
+
+	i, err = repo.GetById(context.Background(), id)
+
+	assert.Nil(t, i)
+	assert.ErrorIs(t, err, ErrQueryInvoice)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestInvoiceRepository_GetByIdForUpdate(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewInvoiceRepository(db)
+	id, contractId, patientId, now := uuid.New(), uuid.New(), uuid.New(), time.Now()
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetInvoiceByIdForUpdate)).
+		WithArgs(id).
+		WillReturnRows(sqlmock.NewRows(invoiceColumns).AddRow(id, "NC", 42, contractId, patientId, 45000, "BOB", now, now, now))
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetPaymentsByInvoices)).
+		WithArgs(pq.Array([]string{id.String()})).
+		WillReturnRows(sqlmock.NewRows(paymentColumns).AddRow(uuid.New(), id, "P", 20000, "BOB", "fake", "fake_1", now, now))
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetCreditNotesByInvoices)).
+		WithArgs(pq.Array([]string{id.String()})).
+		WillReturnRows(sqlmock.NewRows(creditColumns))
+
+	i, err := repo.GetByIdForUpdate(context.Background(), id)
+
+	assert.NoError(t, err)
+	assert.Equal(t, id, i.Id())
+	assert.True(t, i.HasPayment("fake", "fake_1"))
+	assert.Equal(t, 25000, i.Balance().Amount())
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetInvoiceByIdForUpdate)).WithArgs(id).WillReturnError(sql.ErrNoRows)
+
+	i, err = repo.GetByIdForUpdate(context.Background(), id)
+
+	assert.Nil(t, i)
+	assert.ErrorIs(t, err, invoices.ErrNotFoundInvoice)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestInvoiceRepository_GetByContract(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewInvoiceRepository(db)
+	id, contractId, now := uuid.New(), uuid.New(), time.Now()
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetInvoiceByContract)).
+		WithArgs(contractId).
+		WillReturnRows(sqlmock.NewRows(invoiceColumns).AddRow(id, "NC", 1, contractId, uuid.New(), 45000, "EUR", now, now, now))
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetPaymentsByInvoices)).
+		WillReturnRows(sqlmock.NewRows(paymentColumns))
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetCreditNotesByInvoices)).
+		WillReturnRows(sqlmock.NewRows(creditColumns))
+
+	i, err := repo.GetByContract(context.Background(), contractId)
+
+	assert.Nil(t, i)
+	assert.ErrorIs(t, err, ErrConcatenatingInvoice)
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetInvoiceByContract)).WithArgs(contractId).WillReturnError(sql.ErrNoRows)
+
+	i, err = repo.GetByContract(context.Background(), contractId)
+
+	assert.Nil(t, i)
+	assert.ErrorIs(t, err, invoices.ErrNotFoundInvoice)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestInvoiceRepository_GetByPatient(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewInvoiceRepository(db)
+	first, second, patientId, now := uuid.New(), uuid.New(), uuid.New(), time.Now()
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetInvoicesByPatient)).
+		WithArgs(patientId).
+		WillReturnRows(sqlmock.NewRows(invoiceColumns).
+			AddRow(first, "NC", 1, uuid.New(), patientId, 45000, "BOB", now, now, now).
+			AddRow(second, "NC", 2, uuid.New(), patientId, 90000, "BOB", now, now, now))
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetPaymentsByInvoices)).
+		WithArgs(pq.Array([]string{first.String(), second.String()})).
+		WillReturnRows(sqlmock.NewRows(paymentColumns).AddRow(uuid.New(), second, "F", 90000, "BOB", "fake", "fake_1", now, now))
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetCreditNotesByInvoices)).
+		WithArgs(pq.Array([]string{first.String(), second.String()})).
+		WillReturnRows(sqlmock.NewRows(creditColumns))
+
+	list, err := repo.GetByPatient(context.Background(), patientId)
+
+	assert.NoError(t, err)
+	assert.Len(t, list, 2)
+	assert.False(t, list[0].IsPaid())
+	assert.True(t, list[1].IsPaid())
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetInvoicesByPatient)).
+		WithArgs(patientId).
+		WillReturnRows(sqlmock.NewRows(invoiceColumns))
+
+	list, err = repo.GetByPatient(context.Background(), patientId)
+
+	assert.NoError(t, err)
+	assert.Empty(t, list)
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetInvoicesByPatient)).WithArgs(patientId).WillReturnError(ErrDatabaseAdministrator)
+
+	list, err = repo.GetByPatient(context.Background(), patientId)
+
+	assert.Nil(t, list)
+	assert.ErrorIs(t, err, ErrQueryInvoice)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestInvoiceRepository_NextSequence(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewInvoiceRepository(db)
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryNextInvoiceSequence)).
+		WithArgs("NC").
+		WillReturnRows(sqlmock.NewRows([]string{"last_value"}).AddRow(43))
+
+	sequence, err := repo.NextSequence(context.Background(), "NC")
+
+	assert.NoError(t, err)
+	assert.Equal(t, 43, sequence)
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryNextInvoiceSequence)).WithArgs("NC").WillReturnError(ErrDatabaseAdministrator)
+
+	sequence, err = repo.NextSequence(context.Background(), "NC")
+
+	assert.Zero(t, sequence)
+	assert.ErrorIs(t, err, ErrQueryInvoice)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestInvoiceRepository_Create(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewInvoiceRepository(db)
+	amount, _ := valueobjects.NewMoney(45000, valueobjects.BOB)
+	now := time.Now()
+	i := invoices.NewInvoice("NC", 42, uuid.New(), uuid.New(), amount, now)
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryCreateInvoice)).
+		WithArgs(i.Id(), "NC", 42, i.ContractId(), i.PatientId(), 45000, "BOB", now).
+		WillReturnRows(sqlmock.NewRows(invoiceColumns).AddRow(i.Id(), "NC", 42, i.ContractId(), i.PatientId(), 45000, "BOB", now, now, now))
+
+	created, err := repo.Create(context.Background(), i)
+
+	assert.NoError(t, err)
+	assert.Equal(t, i.Id(), created.Id())
+	assert.Equal(t, "NC-000042", created.Number())
+	assert.Equal(t, now, created.CreatedAt())
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryCreateInvoice)).WillReturnError(ErrDatabaseAdministrator)
+
+	created, err = repo.Create(context.Background(), i)
+
+	assert.Nil(t, created)
+	assert.ErrorIs(t, err, ErrScanInvoice)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestInvoiceRepository_AddPayment(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewInvoiceRepository(db)
+	amount, _ := valueobjects.NewMoney(20000, valueobjects.BOB)
+	now := time.Now()
+	p := invoices.NewPayment(uuid.New(), invoices.Partial, amount, "fake", "fake_1", now)
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryCreatePayment)).
+		WithArgs(p.Id(), p.InvoiceId(), "P", 20000, "BOB", "fake", "fake_1", now).
+		WillReturnRows(sqlmock.NewRows(paymentColumns).AddRow(p.Id(), p.InvoiceId(), "P", 20000, "BOB", "fake", "fake_1", now, now))
+	mock.ExpectExec(regexp.QuoteMeta(QueryTouchInvoice)).WithArgs(p.InvoiceId()).WillReturnResult(sqlmock.NewResult(0, 1))
+
+	created, err := repo.AddPayment(context.Background(), p)
+
+	assert.NoError(t, err)
+	assert.Equal(t, p.Id(), created.Id())
+	assert.Equal(t, invoices.Partial, created.Kind())
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryCreatePayment)).
+		WillReturnRows(sqlmock.NewRows(paymentColumns).AddRow(p.Id(), p.InvoiceId(), "P", 20000, "BOB", "fake", "fake_1", now, now))
+	mock.ExpectExec(regexp.QuoteMeta(QueryTouchInvoice)).WillReturnError(ErrDatabaseAdministrator)
+
+	created, err = repo.AddPayment(context.Background(), p)
+
+	assert.Nil(t, created)
+	assert.ErrorIs(t, err, ErrQueryInvoice)
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryCreatePayment)).WillReturnError(ErrDatabaseAdministrator)
+
+	created, err = repo.AddPayment(context.Background(), p)
+
+	assert.Nil(t, created)
+	assert.ErrorIs(t, err, ErrScanPayment)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestInvoiceRepository_AddCreditNote(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewInvoiceRepository(db)
+	amount, _ := valueobjects.NewMoney(27000, valueobjects.BOB)
+	now := time.Now()
+	c := invoices.NewCreditNote(uuid.New(), amount, "moved abroad", now)
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryCreateCreditNote)).
+		WithArgs(c.Id(), c.InvoiceId(), 27000, "BOB", "moved abroad", now).
+		WillReturnRows(sqlmock.NewRows(creditColumns).AddRow(c.Id(), c.InvoiceId(), 27000, "BOB", "moved abroad", now, now))
+	mock.ExpectExec(regexp.QuoteMeta(QueryTouchInvoice)).WithArgs(c.InvoiceId()).WillReturnResult(sqlmock.NewResult(0, 1))
+
+	created, err := repo.AddCreditNote(context.Background(), c)
+
+	assert.NoError(t, err)
+	assert.Equal(t, c.Id(), created.Id())
+	assert.Equal(t, amount, created.Amount())
+	assert.Equal(t, "moved abroad", created.Reason())
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryCreateCreditNote)).WillReturnError(ErrDatabaseAdministrator)
+
+	created, err = repo.AddCreditNote(context.Background(), c)
+
+	assert.Nil(t, created)
+	assert.ErrorIs(t, err, ErrScanCreditNote)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
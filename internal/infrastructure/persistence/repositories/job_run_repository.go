@@ -0,0 +1,77 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/infrastructure/jobs"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/infrastructure/persistence"
+	"log"
+	"time"
+)
+
+type JobRunRepository struct {
+	DB *sql.DB
+}
+
+const (
+	jobRunSucceeded = "S"
+	jobRunFailed    = "F"
+)
+
+const (
+	QueryStartJobRun = `INSERT INTO job_run(id, name, instance, started_at, status)
+							VALUES ($1, $2, $3, $4, 'R')`
+	QueryFinishJobRun = `UPDATE job_run
+							SET finished_at = $1, status = $2, processed = $3, error = $4
+							WHERE id = $5`
+	QueryPruneJobRuns = `DELETE FROM job_run
+							WHERE name = $1 AND started_at < $2`
+)
+
+var ErrQueryJobRun = errors.New("query failed")
+
+func (r *JobRunRepository) Start(ctx context.Context, run *jobs.JobRun) error {
+	if _, err := r.conn(ctx).ExecContext(ctx, QueryStartJobRun, run.Id, run.Job, run.Instance, run.StartedAt); err != nil {
+		log.Printf("[repository:job_run][Start] error executing SQL query '%s': %v", QueryStartJobRun, err)
+		return fmt.Errorf(got, ErrQueryJobRun, err)
+	}
+	return nil
+}
+
+func (r *JobRunRepository) Finish(ctx context.Context, run *jobs.JobRun) error {
+	status, reason := jobRunSucceeded, sql.NullString{}
+	if run.Err != nil {
+		status, reason = jobRunFailed, sql.NullString{String: run.Err.Error(), Valid: true}
+	}
+
+	if _, err := r.conn(ctx).ExecContext(ctx, QueryFinishJobRun, run.FinishedAt, status, run.Processed, reason, run.Id); err != nil {
+		log.Printf("[repository:job_run][Finish] error executing SQL query '%s': %v", QueryFinishJobRun, err)
+		return fmt.Errorf(got, ErrQueryJobRun, err)
+	}
+	return nil
+}
+
+func (r *JobRunRepository) Prune(ctx context.Context, job string, before time.Time) (int64, error) {
+	result, err := r.conn(ctx).ExecContext(ctx, QueryPruneJobRuns, job, before)
+	if err != nil {
+		log.Printf("[repository:job_run][Prune] error executing SQL query '%s': %v", QueryPruneJobRuns, err)
+		return 0, fmt.Errorf(got, ErrQueryJobRun, err)
+	}
+
+	pruned, err := result.RowsAffected()
+	if err != nil {
+		log.Printf("[repository:job_run][Prune] error getting rows affected: %v", err)
+		return 0, fmt.Errorf(got, ErrQueryJobRun, err)
+	}
+	return pruned, nil
+}
+
+func (r *JobRunRepository) conn(ctx context.Context) persistence.DBTX {
+	return persistence.Executor(ctx, r.DB)
+}
+
+func NewJobRunRepository(db *sql.DB) jobs.RunStore {
+	return &JobRunRepository{DB: db}
+}
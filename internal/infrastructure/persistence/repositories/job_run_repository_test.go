@@ -0,0 +1,99 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/infrastructure/jobs"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"regexp"
+	"testing"
+	"time"
+)
+
+func newJobRun() *jobs.JobRun {
+	return &jobs.JobRun{
+		Id:        uuid.New(),
+		Job:       "contract:activate",
+		Instance:  "web-1:42",
+		StartedAt: time.Now(),
+	}
+}
+
+func TestJobRunRepository_Start(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewJobRunRepository(db)
+	run := newJobRun()
+
+	mock.ExpectExec(regexp.QuoteMeta(QueryStartJobRun)).
+		WithArgs(run.Id, run.Job, run.Instance, run.StartedAt).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	assert.NoError(t, repo.Start(context.Background(), run))
+
+	mock.ExpectExec(regexp.QuoteMeta(QueryStartJobRun)).WillReturnError(ErrDatabaseAdministrator)
+
+	err = repo.Start(context.Background(), run)
+
+	assert.ErrorIs(t, err, ErrQueryJobRun)
+	assert.ErrorIs(t, err, ErrDatabaseAdministrator)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestJobRunRepository_Finish(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewJobRunRepository(db)
+	finished := time.Now()
+
+	succeeded := newJobRun()
+	succeeded.FinishedAt, succeeded.Processed = &finished, 4
+
+	failed := newJobRun()
+	failed.FinishedAt, failed.Processed, failed.Err = &finished, 1, errors.New("contract x: db failure")
+
+	mock.ExpectExec(regexp.QuoteMeta(QueryFinishJobRun)).
+		WithArgs(&finished, "S", 4, sql.NullString{}, succeeded.Id).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(QueryFinishJobRun)).
+		WithArgs(&finished, "F", 1, sql.NullString{String: "contract x: db failure", Valid: true}, failed.Id).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(QueryFinishJobRun)).WillReturnError(ErrDatabaseAdministrator)
+
+	assert.NoError(t, repo.Finish(context.Background(), succeeded))
+	assert.NoError(t, repo.Finish(context.Background(), failed))
+	assert.ErrorIs(t, repo.Finish(context.Background(), failed), ErrQueryJobRun)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestJobRunRepository_Prune(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewJobRunRepository(db)
+	before := time.Now().AddDate(0, 0, -7)
+
+	mock.ExpectExec(regexp.QuoteMeta(QueryPruneJobRuns)).
+		WithArgs("contract:activate", before).
+		WillReturnResult(sqlmock.NewResult(0, 12))
+	mock.ExpectExec(regexp.QuoteMeta(QueryPruneJobRuns)).WillReturnError(ErrDatabaseAdministrator)
+
+	pruned, err := repo.Prune(context.Background(), "contract:activate", before)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(12), pruned)
+
+	pruned, err = repo.Prune(context.Background(), "contract:activate", before)
+
+	assert.ErrorIs(t, err, ErrQueryJobRun)
+	assert.Zero(t, pruned)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,87 @@
+package repositories
+
+import (
+	"fmt"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/abstractions"
+	"strings"
+)
+
+type listQuery struct {
+	conditions []string
+	args       []any
+}
+
+func (q *listQuery) arg(v any) string {
+	q.args = append(q.args, v)
+	return fmt.Sprintf("$%d", len(q.args))
+}
+
+func (q *listQuery) where(condition string) {
+	q.conditions = append(q.conditions, condition)
+}
+
+func (q *listQuery) whereClause() string {
+	if len(q.conditions) == 0 {
+		return ""
+	}
+	return " WHERE " + strings.Join(q.conditions, " AND ")
+}
+
+func (q *listQuery) count(base string) (string, []any) {
+	return base + q.whereClause(), append([]any(nil), q.args...)
+}
+
+func (q *listQuery) page(base string, sortable map[string]string, fallback string, p abstractions.Pagination) (string, []any, error) {
+	column := sortable[fallback]
+	if p.Sort != "" {
+		c, ok := sortable[p.Sort]
+		if !ok {
+			return "", nil, fmt.Errorf("%w: got %s", abstractions.ErrInvalidSortPagination, p.Sort)
+		}
+		column = c
+	}
+
+	direction := "ASC"
+	if p.Descending() {
+		direction = "DESC"
+	}
+
+	query := base + q.whereClause() + fmt.Sprintf(" ORDER BY %s %s, id %s", column, direction, direction)
+	args := append([]any(nil), q.args...)
+	args = append(args, p.PageLimit(), p.Offset())
+	query += fmt.Sprintf(" LIMIT $%d OFFSET $%d", len(args)-1, len(args))
+
+	return query, args, nil
+}
+
+func peopleListQuery(name string, gender *string, deleted *bool) *listQuery {
+	q := &listQuery{}
+
+	if deleted != nil {
+		if *deleted {
+			q.where("deleted_at IS NOT NULL")
+		} else {
+			q.where("deleted_at IS NULL")
+		}
+	}
+
+	if gender != nil {
+		q.where("gender = " + q.arg(*gender))
+	}
+
+	if name = strings.TrimSpace(name); name != "" {
+		n := q.arg("%" + name + "%")
+		q.where(fmt.Sprintf("(first_name ILIKE %s OR last_name ILIKE %s)", n, n))
+	}
+
+	return q
+}
+
+var peopleSortColumns = map[string]string{
+	"first_name": "first_name",
+	"last_name":  "last_name",
+	"email":      "email",
+	"birth":      "birth",
+	"created_at": "created_at",
+	"updated_at": "updated_at",
+}
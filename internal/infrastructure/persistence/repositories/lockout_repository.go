@@ -0,0 +1,198 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/abstractions"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/lockout"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/infrastructure/persistence"
+	"github.com/google/uuid"
+	"log"
+	"time"
+)
+
+type LockoutRepository struct {
+	DB *sql.DB
+}
+
+const (
+	QueryGetThrottle = `SELECT scope, key, failures, locked_until, last_failure_at
+							FROM login_throttle
+							WHERE scope = $1 AND key = $2
+							FOR UPDATE`
+	QuerySaveThrottle = `INSERT INTO login_throttle(scope, key, failures, locked_until, last_failure_at)
+							VALUES($1, $2, $3, $4, $5)
+							ON CONFLICT (scope, key) DO UPDATE
+							SET failures = EXCLUDED.failures, locked_until = EXCLUDED.locked_until, last_failure_at = EXCLUDED.last_failure_at`
+	QueryDeleteThrottle = `DELETE FROM login_throttle
+							WHERE scope = $1 AND key = $2`
+	QueryCreateLockoutEvent = `INSERT INTO lockout_event(id, scope, key, type, failures, locked_until, actor_id, created_at)
+								VALUES($1, $2, $3, $4, $5, $6, $7, $8)`
+	QueryGetLockoutEvents   = `SELECT id, scope, key, type, failures, locked_until, actor_id, created_at FROM lockout_event`
+	QueryCountLockoutEvents = `SELECT COUNT(*) FROM lockout_event`
+)
+
+var (
+	ErrQueryLockout         = errors.New("query failed")
+	ErrScanLockout          = errors.New("scan failed")
+	ErrConcatenatingLockout = errors.New("error concatenating lockout values from DB")
+)
+
+func (r *LockoutRepository) GetThrottle(ctx context.Context, scope lockouts.Scope, key string) (*lockouts.Throttle, error) {
+	var (
+		s, k                       string
+		failures                   int
+		lockedUntil, lastFailureAt *time.Time
+	)
+
+	err := r.conn(ctx).QueryRowContext(ctx, QueryGetThrottle, string(scope), key).Scan(&s, &k, &failures, &lockedUntil, &lastFailureAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return lockouts.NewThrottle(scope, key), nil
+	} else if err != nil {
+		log.Printf("[repository:lockout][GetThrottle] error executing SQL query '%s': %v", QueryGetThrottle, err)
+		return nil, fmt.Errorf(got, ErrQueryLockout, err)
+	}
+
+	t, err := lockouts.NewThrottleFromDB(s, k, failures, lockedUntil, lastFailureAt)
+	if err != nil {
+		log.Printf("[repository:lockout][GetThrottle] error concatenating throttle values from DB: %v", err)
+		return nil, fmt.Errorf(got, ErrConcatenatingLockout, err)
+	}
+
+	return t, nil
+}
+
+func (r *LockoutRepository) GetEvents(ctx context.Context, filter lockouts.EventFilter, pagination abstractions.Pagination) (*abstractions.Page[*lockouts.Event], error) {
+	var (
+		events []*lockouts.Event
+		total  int
+	)
+
+	q := lockoutEventListQuery(filter)
+	query, args, err := q.page(QueryGetLockoutEvents, lockoutEventSortColumns, "created_at", pagination)
+	if err != nil {
+		log.Printf("[repository:lockout][GetEvents] invalid pagination: %v", err)
+		return nil, err
+	}
+
+	rows, err := r.conn(ctx).QueryContext(ctx, query, args...)
+	if err != nil {
+		log.Printf("[repository:lockout][GetEvents] error executing SQL statement: %v", err)
+		return nil, fmt.Errorf(got, ErrQueryLockout, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			id               uuid.UUID
+			scope, key, kind string
+			failures         int
+			lockedUntil      *time.Time
+			actorId          *uuid.UUID
+			createdAt        time.Time
+		)
+
+		if err = rows.Scan(&id, &scope, &key, &kind, &failures, &lockedUntil, &actorId, &createdAt); err != nil {
+			log.Printf("[repository:lockout][GetEvents] error scanning rows: %v", err)
+			return nil, fmt.Errorf(got, ErrScanLockout, err)
+		}
+
+		e, err := lockouts.NewEventFromDB(id, scope, key, kind, failures, lockedUntil, actorId, createdAt)
+		if err != nil {
+			log.Printf("[repository:lockout][GetEvents] error concatenating event values from DB: %v", err)
+			return nil, fmt.Errorf(got, ErrConcatenatingLockout, err)
+		}
+
+		events = append(events, e)
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Printf("[repository:lockout][GetEvents] error iterating rows: %v", err)
+		return nil, fmt.Errorf(got, ErrScanLockout, err)
+	}
+
+	countQuery, countArgs := q.count(QueryCountLockoutEvents)
+	if err = r.conn(ctx).QueryRowContext(ctx, countQuery, countArgs...).Scan(&total); err != nil {
+		log.Printf("[repository:lockout][GetEvents] error counting events: %v", err)
+		return nil, fmt.Errorf(got, ErrQueryLockout, err)
+	}
+
+	log.Printf("[repository:lockout][GetEvents] successfully fetched %d of %d events", len(events), total)
+	return abstractions.NewPage(events, total, pagination), nil
+}
+
+func (r *LockoutRepository) SaveThrottle(ctx context.Context, t *lockouts.Throttle) error {
+	_, err := r.conn(ctx).ExecContext(ctx, QuerySaveThrottle, string(t.Scope()), t.Key(), t.Failures(), t.LockedUntil(), t.LastFailureAt())
+	if err != nil {
+		log.Printf("[repository:lockout][SaveThrottle] error executing SQL query '%s': %v", QuerySaveThrottle, err)
+		return fmt.Errorf(got, ErrQueryLockout, err)
+	}
+
+	return nil
+}
+
+func (r *LockoutRepository) DeleteThrottle(ctx context.Context, scope lockouts.Scope, key string) error {
+	_, err := r.conn(ctx).ExecContext(ctx, QueryDeleteThrottle, string(scope), key)
+	if err != nil {
+		log.Printf("[repository:lockout][DeleteThrottle] error executing SQL query '%s': %v", QueryDeleteThrottle, err)
+		return fmt.Errorf(got, ErrQueryLockout, err)
+	}
+
+	return nil
+}
+
+func (r *LockoutRepository) CreateEvent(ctx context.Context, e *lockouts.Event) error {
+	_, err := r.conn(ctx).ExecContext(ctx, QueryCreateLockoutEvent,
+		e.Id(), string(e.Scope()), e.Key(), string(e.Type()), e.Failures(), e.LockedUntil(), e.ActorId(), e.CreatedAt(),
+	)
+	if err != nil {
+		log.Printf("[repository:lockout][CreateEvent] error executing SQL query '%s': %v", QueryCreateLockoutEvent, err)
+		return fmt.Errorf(got, ErrQueryLockout, err)
+	}
+
+	log.Printf("[repository:lockout][CreateEvent] %s %s '%s'", e.Scope(), e.Type(), e.Key())
+	return nil
+}
+
+func lockoutEventListQuery(filter lockouts.EventFilter) *listQuery {
+	q := &listQuery{}
+
+	if filter.Scope != nil {
+		q.where("scope = " + q.arg(string(*filter.Scope)))
+	}
+
+	if filter.Key != "" {
+		q.where("key = " + q.arg(filter.Key))
+	}
+
+	if filter.Type != nil {
+		q.where("type = " + q.arg(string(*filter.Type)))
+	}
+
+	if filter.From != nil {
+		q.where("created_at >= " + q.arg(*filter.From))
+	}
+
+	if filter.To != nil {
+		q.where("created_at <= " + q.arg(*filter.To))
+	}
+
+	return q
+}
+
+var lockoutEventSortColumns = map[string]string{
+	"created_at": "created_at",
+	"failures":   "failures",
+}
+
+func (r *LockoutRepository) conn(ctx context.Context) persistence.DBTX {
+	return persistence.Executor(ctx, r.DB)
+}
+
+func NewLockoutRepository(db *sql.DB) lockouts.LockoutRepository {
+	return &LockoutRepository{
+		DB: db,
+	}
+}
@@ -0,0 +1,235 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/abstractions"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/lockout"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"regexp"
+	"testing"
+	"time"
+)
+
+var (
+	throttleColumns     = []string{"scope", "key", "failures", "locked_until", "last_failure_at"}
+	lockoutEventColumns = []string{"id", "scope", "key", "type", "failures", "locked_until", "actor_id", "created_at"}
+)
+
+func TestLockoutRepository_GetThrottle(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewLockoutRepository(db)
+	now := time.Now()
+	until := now.Add(time.Minute)
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetThrottle)).
+		WithArgs("A", "A:john@doe.com").
+		WillReturnRows(sqlmock.NewRows(throttleColumns).AddRow("A", "A:john@doe.com", 5, until, now))
+
+	th, err := repo.GetThrottle(context.Background(), lockouts.Account, "A:john@doe.com")
+
+	assert.NoError(t, err)
+	assert.Equal(t, 5, th.Failures())
+	assert.True(t, th.Locked(now))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestLockoutRepository_GetThrottle_NotFound(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewLockoutRepository(db)
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetThrottle)).
+		WithArgs("I", "10.0.0.1").
+		WillReturnError(sql.ErrNoRows)
+
+	th, err := repo.GetThrottle(context.Background(), lockouts.Address, "10.0.0.1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, lockouts.Address, th.Scope())
+	assert.Equal(t, "10.0.0.1", th.Key())
+	assert.Zero(t, th.Failures())
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestLockoutRepository_GetThrottle_Errors(t *testing.T) {
+	cases := []struct {
+		name string
+		rows *sqlmock.Rows
+		err  error
+		want error
+	}{
+		{"Query", nil, errors.New("connection lost"), ErrQueryLockout},
+		{"Concatenating", sqlmock.NewRows(throttleColumns).AddRow("X", "key", 1, nil, nil), nil, ErrConcatenatingLockout},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			assert.NoError(t, err)
+			defer db.Close()
+
+			repo := NewLockoutRepository(db)
+
+			expect := mock.ExpectQuery(regexp.QuoteMeta(QueryGetThrottle))
+			if tc.rows != nil {
+				expect.WillReturnRows(tc.rows)
+			} else {
+				expect.WillReturnError(tc.err)
+			}
+
+			th, err := repo.GetThrottle(context.Background(), lockouts.Account, "key")
+
+			assert.ErrorIs(t, err, tc.want)
+			assert.Nil(t, th)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestLockoutRepository_SaveThrottle(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewLockoutRepository(db)
+	now := time.Now()
+	th := lockouts.NewThrottle(lockouts.Account, "A:john@doe.com")
+	th.Fail(now, lockouts.AccountPolicy)
+
+	mock.ExpectExec(regexp.QuoteMeta(QuerySaveThrottle)).
+		WithArgs("A", "A:john@doe.com", 1, th.LockedUntil(), th.LastFailureAt()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err = repo.SaveThrottle(context.Background(), th)
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestLockoutRepository_DeleteThrottle(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewLockoutRepository(db)
+
+	mock.ExpectExec(regexp.QuoteMeta(QueryDeleteThrottle)).
+		WithArgs("I", "10.0.0.1").
+		WillReturnError(errors.New("connection lost"))
+
+	err = repo.DeleteThrottle(context.Background(), lockouts.Address, "10.0.0.1")
+
+	assert.ErrorIs(t, err, ErrQueryLockout)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestLockoutRepository_CreateEvent(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewLockoutRepository(db)
+	actorId := uuid.New()
+	e := lockouts.NewUnlockedEvent(lockouts.NewThrottle(lockouts.Account, "P:jane@doe.com"), &actorId)
+
+	mock.ExpectExec(regexp.QuoteMeta(QueryCreateLockoutEvent)).
+		WithArgs(e.Id(), "A", "P:jane@doe.com", "U", 0, e.LockedUntil(), &actorId, e.CreatedAt()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err = repo.CreateEvent(context.Background(), e)
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestLockoutRepository_GetEvents(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewLockoutRepository(db)
+
+	scope, eventType := lockouts.Account, lockouts.Locked
+	from := time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC)
+	filter := lockouts.EventFilter{Scope: &scope, Key: "A:john@doe.com", Type: &eventType, From: &from}
+	pagination := abstractions.Pagination{Limit: 10, Order: abstractions.Descending}
+	until := from.Add(time.Minute)
+
+	where := " WHERE scope = $1 AND key = $2 AND type = $3 AND created_at >= $4"
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetLockoutEvents+where+" ORDER BY created_at DESC, id DESC LIMIT $5 OFFSET $6")).
+		WithArgs("A", "A:john@doe.com", "L", from, 10, 0).
+		WillReturnRows(sqlmock.NewRows(lockoutEventColumns).AddRow(uuid.New(), "A", "A:john@doe.com", "L", 5, until, nil, from))
+	mock.ExpectQuery(regexp.QuoteMeta(QueryCountLockoutEvents+where)).
+		WithArgs("A", "A:john@doe.com", "L", from).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+
+	page, err := repo.GetEvents(context.Background(), filter, pagination)
+
+	assert.NoError(t, err)
+	assert.Len(t, page.Items, 1)
+	assert.Equal(t, lockouts.Locked, page.Items[0].Type())
+	assert.Equal(t, &until, page.Items[0].LockedUntil())
+	assert.Nil(t, page.Items[0].ActorId())
+	assert.Equal(t, 1, page.Total)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestLockoutRepository_GetEvents_Errors(t *testing.T) {
+	cases := []struct {
+		name string
+		rows *sqlmock.Rows
+		err  error
+		want error
+	}{
+		{"Query", nil, errors.New("connection lost"), ErrQueryLockout},
+		{"Scan", sqlmock.NewRows([]string{"id"}).AddRow(uuid.New()), nil, ErrScanLockout},
+		{"Concatenating", sqlmock.NewRows(lockoutEventColumns).AddRow(uuid.New(), "A", "key", "X", 1, nil, nil, time.Now()), nil, ErrConcatenatingLockout},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			assert.NoError(t, err)
+			defer db.Close()
+
+			repo := NewLockoutRepository(db)
+
+			expect := mock.ExpectQuery(regexp.QuoteMeta(QueryGetLockoutEvents))
+			if tc.rows != nil {
+				expect.WillReturnRows(tc.rows)
+			} else {
+				expect.WillReturnError(tc.err)
+			}
+
+			page, err := repo.GetEvents(context.Background(), lockouts.EventFilter{}, abstractions.Pagination{})
+
+			assert.ErrorIs(t, err, tc.want)
+			assert.Nil(t, page)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestLockoutRepository_GetEvents_InvalidSort(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewLockoutRepository(db)
+
+	page, err := repo.GetEvents(context.Background(), lockouts.EventFilter{}, abstractions.Pagination{Sort: "key"})
+
+	assert.ErrorIs(t, err, abstractions.ErrInvalidSortPagination)
+	assert.Nil(t, page)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
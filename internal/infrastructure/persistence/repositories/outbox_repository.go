@@ -0,0 +1,155 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/abstractions"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/infrastructure/messaging"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/infrastructure/persistence"
+	"github.com/google/uuid"
+	"log"
+	"strings"
+)
+
+type OutboxRepository struct {
+	DB *sql.DB
+}
+
+const (
+	QueryCreateOutboxMessages = `INSERT INTO outbox(id, aggregate_id, name, payload, occurred_on)
+									VALUES %s`
+	QueryGetPendingOutboxMessages = `SELECT id, aggregate_id, name, payload, occurred_on, attempts
+										FROM outbox
+										WHERE published_at IS NULL AND attempts < $1
+										ORDER BY occurred_on, id
+										LIMIT $2
+										FOR UPDATE SKIP LOCKED`
+	QueryMarkPublishedOutboxMessage = `UPDATE outbox
+										SET published_at = NOW(), last_error = NULL
+										WHERE id = $1`
+	QueryMarkFailedOutboxMessage = `UPDATE outbox
+										SET attempts = attempts + 1, last_error = $1
+										WHERE id = $2`
+)
+
+var (
+	ErrQueryOutbox     = errors.New("query failed")
+	ErrScanOutbox      = errors.New("scan failed")
+	ErrSerializeOutbox = errors.New("event payload cannot be serialized")
+)
+
+func (r *OutboxRepository) GetPending(ctx context.Context, limit, maxAttempts int) ([]messaging.Message, error) {
+	rows, err := r.conn(ctx).QueryContext(ctx, QueryGetPendingOutboxMessages, maxAttempts, limit)
+	if err != nil {
+		log.Printf("[repository:outbox][GetPending] error executing SQL query '%s': %v", QueryGetPendingOutboxMessages, err)
+		return nil, fmt.Errorf(got, ErrQueryOutbox, err)
+	}
+	defer rows.Close()
+
+	var msgs []messaging.Message
+	for rows.Next() {
+		var (
+			m       messaging.Message
+			payload []byte
+		)
+		if err = rows.Scan(&m.Id, &m.AggregateId, &m.Name, &payload, &m.OccurredOn, &m.Attempts); err != nil {
+			log.Printf("[repository:outbox][GetPending] error scanning rows: %v", err)
+			return nil, fmt.Errorf(got, ErrScanOutbox, err)
+		}
+		m.Payload = payload
+		msgs = append(msgs, m)
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Printf("[repository:outbox][GetPending] error iterating rows: %v", err)
+		return nil, fmt.Errorf(got, ErrScanOutbox, err)
+	}
+
+	return msgs, nil
+}
+
+func (r *OutboxRepository) MarkPublished(ctx context.Context, id uuid.UUID) error {
+	if _, err := r.conn(ctx).ExecContext(ctx, QueryMarkPublishedOutboxMessage, id); err != nil {
+		log.Printf("[repository:outbox][MarkPublished] error executing SQL query '%s': %v", QueryMarkPublishedOutboxMessage, err)
+		return fmt.Errorf(got, ErrQueryOutbox, err)
+	}
+	return nil
+}
+
+func (r *OutboxRepository) MarkFailed(ctx context.Context, id uuid.UUID, reason string) error {
+	if _, err := r.conn(ctx).ExecContext(ctx, QueryMarkFailedOutboxMessage, reason, id); err != nil {
+		log.Printf("[repository:outbox][MarkFailed] error executing SQL query '%s': %v", QueryMarkFailedOutboxMessage, err)
+		return fmt.Errorf(got, ErrQueryOutbox, err)
+	}
+	return nil
+}
+
+func (r *OutboxRepository) conn(ctx context.Context) persistence.DBTX {
+	return persistence.Executor(ctx, r.DB)
+}
+
+func NewOutboxRepository(db *sql.DB) messaging.Store {
+	return &OutboxRepository{DB: db}
+}
+
+type eventSource interface {
+	DomainEvents() []abstractions.DomainEvent
+	ClearDomainEvents()
+}
+
+func saveWithEvents(ctx context.Context, db *sql.DB, save func(ctx context.Context) error, sources ...eventSource) error {
+	var events []abstractions.DomainEvent
+	for _, s := range sources {
+		events = append(events, s.DomainEvents()...)
+	}
+
+	if len(events) == 0 {
+		return save(ctx)
+	}
+
+	err := persistence.NewUnitOfWork(db).Do(ctx, func(ctx context.Context) error {
+		if err := save(ctx); err != nil {
+			return err
+		}
+		return storeEvents(ctx, persistence.Executor(ctx, db), events)
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, s := range sources {
+		s.ClearDomainEvents()
+	}
+	return nil
+}
+
+func storeEvents(ctx context.Context, conn persistence.DBTX, events []abstractions.DomainEvent) error {
+	var (
+		placeholders []string
+		args         []any
+	)
+
+	for i, e := range events {
+		payload, err := json.Marshal(e.Payload())
+		if err != nil {
+			log.Printf("[repository:outbox][storeEvents] error serializing '%s': %v", e.Name(), err)
+			return fmt.Errorf(got, ErrSerializeOutbox, err)
+		}
+
+		base := i * 5
+		placeholders = append(placeholders, fmt.Sprintf("($%d, $%d, $%d, $%d, $%d)", base+1, base+2, base+3, base+4, base+5))
+		args = append(args, e.Id(), e.AggregateId(), e.Name(), payload, e.OccurredOn())
+	}
+
+	query := fmt.Sprintf(QueryCreateOutboxMessages, strings.Join(placeholders, ", "))
+	if _, err := conn.ExecContext(ctx, query, args...); err != nil {
+		log.Printf("[repository:outbox][storeEvents] error executing SQL query '%s': %v", query, err)
+		return fmt.Errorf(got, ErrQueryOutbox, err)
+	}
+
+	log.Printf("[repository:outbox][storeEvents] stored %d events", len(events))
+	return nil
+}
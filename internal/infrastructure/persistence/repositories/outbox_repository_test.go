@@ -0,0 +1,172 @@
+package repositories
+
+import (
+	"context"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/abstractions"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"regexp"
+	"testing"
+	"time"
+)
+
+var outboxColumns = []string{"id", "aggregate_id", "name", "payload", "occurred_on", "attempts"}
+
+type fakeEventSource struct {
+	events  []abstractions.DomainEvent
+	cleared bool
+}
+
+func (f *fakeEventSource) DomainEvents() []abstractions.DomainEvent {
+	return f.events
+}
+
+func (f *fakeEventSource) ClearDomainEvents() {
+	f.events = nil
+	f.cleared = true
+}
+
+func TestOutboxRepository_GetPending(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewOutboxRepository(db)
+	id, aggregateId := uuid.New(), uuid.New()
+	now := time.Now()
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetPendingOutboxMessages)).
+		WithArgs(10, 50).
+		WillReturnRows(sqlmock.NewRows(outboxColumns).AddRow(id, aggregateId, "contract.created", []byte(`{"status":"C"}`), now, 2))
+
+	msgs, err := repo.GetPending(context.Background(), 50, 10)
+
+	assert.NoError(t, err)
+	assert.Len(t, msgs, 1)
+	assert.Equal(t, id, msgs[0].Id)
+	assert.Equal(t, aggregateId, msgs[0].AggregateId)
+	assert.Equal(t, "contract.created", msgs[0].Name)
+	assert.JSONEq(t, `{"status":"C"}`, string(msgs[0].Payload))
+	assert.Equal(t, 2, msgs[0].Attempts)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestOutboxRepository_GetPending_Errors(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewOutboxRepository(db)
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetPendingOutboxMessages)).WillReturnError(ErrDatabaseAdministrator)
+	msgs, err := repo.GetPending(context.Background(), 50, 10)
+	assert.Nil(t, msgs)
+	assert.ErrorIs(t, err, ErrQueryOutbox)
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetPendingOutboxMessages)).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(uuid.New()))
+	msgs, err = repo.GetPending(context.Background(), 50, 10)
+	assert.Nil(t, msgs)
+	assert.ErrorIs(t, err, ErrScanOutbox)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestOutboxRepository_Mark(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewOutboxRepository(db)
+	id := uuid.New()
+
+	mock.ExpectExec(regexp.QuoteMeta(QueryMarkPublishedOutboxMessage)).WithArgs(id).WillReturnResult(sqlmock.NewResult(0, 1))
+	assert.NoError(t, repo.MarkPublished(context.Background(), id))
+
+	mock.ExpectExec(regexp.QuoteMeta(QueryMarkFailedOutboxMessage)).WithArgs("boom", id).WillReturnResult(sqlmock.NewResult(0, 1))
+	assert.NoError(t, repo.MarkFailed(context.Background(), id, "boom"))
+
+	mock.ExpectExec(regexp.QuoteMeta(QueryMarkPublishedOutboxMessage)).WithArgs(id).WillReturnError(ErrDatabaseAdministrator)
+	assert.ErrorIs(t, repo.MarkPublished(context.Background(), id), ErrQueryOutbox)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSaveWithEvents(t *testing.T) {
+	t.Run("without events", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		saved := false
+		err = saveWithEvents(context.Background(), db, func(ctx context.Context) error {
+			saved = true
+			return nil
+		}, &fakeEventSource{})
+
+		assert.NoError(t, err)
+		assert.True(t, saved)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("with events", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		aggregateId := uuid.New()
+		first := abstractions.NewNamedDomainEvent("a.first", aggregateId, map[string]int{"n": 1})
+		second := abstractions.NewNamedDomainEvent("a.second", aggregateId, map[string]int{"n": 2})
+		source := &fakeEventSource{events: []abstractions.DomainEvent{*first, *second}}
+
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta("INSERT INTO outbox(id, aggregate_id, name, payload, occurred_on)")+"(.+)"+regexp.QuoteMeta("($1, $2, $3, $4, $5), ($6, $7, $8, $9, $10)")).
+			WithArgs(
+				first.Id(), aggregateId, "a.first", []byte(`{"n":1}`), first.OccurredOn(),
+				second.Id(), aggregateId, "a.second", []byte(`{"n":2}`), second.OccurredOn(),
+			).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectCommit()
+
+		err = saveWithEvents(context.Background(), db, func(ctx context.Context) error { return nil }, source)
+
+		assert.NoError(t, err)
+		assert.True(t, source.cleared)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("save failure keeps events", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		source := &fakeEventSource{events: []abstractions.DomainEvent{*abstractions.NewNamedDomainEvent("a.first", uuid.New(), nil)}}
+
+		mock.ExpectBegin()
+		mock.ExpectRollback()
+
+		err = saveWithEvents(context.Background(), db, func(ctx context.Context) error { return ErrDatabaseAdministrator }, source)
+
+		assert.ErrorIs(t, err, ErrDatabaseAdministrator)
+		assert.False(t, source.cleared)
+		assert.Len(t, source.events, 1)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("payload cannot be serialized", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		source := &fakeEventSource{events: []abstractions.DomainEvent{*abstractions.NewNamedDomainEvent("a.first", uuid.New(), func() {})}}
+
+		mock.ExpectBegin()
+		mock.ExpectRollback()
+
+		err = saveWithEvents(context.Background(), db, func(ctx context.Context) error { return nil }, source)
+
+		assert.ErrorIs(t, err, ErrSerializeOutbox)
+		assert.False(t, source.cleared)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -5,7 +5,9 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/abstractions"
 	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/patient"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/infrastructure/persistence"
 	"github.com/google/uuid"
 	"log"
 	"time"
@@ -18,9 +20,6 @@ type PatientRepository struct {
 const (
 	QueryGetAllPatients = `SELECT id, first_name, last_name, email, password, gender, birth, phone, last_login_at, created_at, updated_at, deleted_at
 									FROM patient`
-	QueryGetListPatients = `SELECT id, first_name, last_name, email, password, gender, birth, phone, last_login_at, created_at, updated_at, deleted_at 
-									FROM patient 
-									WHERE deleted_at IS NULL`
 	QueryGetPatientById = `SELECT first_name, last_name, email, password, gender, birth, phone, last_login_at, created_at, updated_at, deleted_at
 									FROM patient
 									WHERE id = $1`
@@ -67,55 +66,17 @@ var (
 	ErrIterationRowsPatient = errors.New("rows iteration error")
 )
 
-func (r *PatientRepository) GetAll(ctx context.Context) ([]*patients.Patient, error) {
-	var (
-		ptns                                         []*patients.Patient
-		id                                           uuid.UUID
-		firstName, lastName, email, password, gender string
-		lastLoginAt, createdAt, updatedAt, birth     time.Time
-		deletedAt                                    *time.Time
-		phone                                        *string
-	)
-
-	rows, err := r.Db.QueryContext(ctx, QueryGetAllPatients)
-	if err != nil {
-		log.Printf("[repository:patient][GetAll] error executing SQL query '%s': %v", QueryGetAllPatients, err)
-		return nil, fmt.Errorf(got, ErrQueryPatient, err)
-	}
-
-	defer func(rows *sql.Rows) {
-		if err := rows.Close(); err != nil {
-			log.Printf("[repository:patient][GetAll] failed to close rows: %v", err)
-		}
-	}(rows)
-	for rows.Next() {
-		err := rows.Scan(
-			&id, &firstName, &lastName, &email, &password, &gender, &birth, &phone, &lastLoginAt, &createdAt, &updatedAt, &deletedAt,
-		)
-		if err != nil {
-			log.Printf("[repository:patient][GetAll] error reading patientDTO for a slice of patients: %v", err)
-			return nil, fmt.Errorf(got, ErrScanPatient, err)
-		}
-
-		patient, err := patients.NewPatientFromDB(id, firstName, lastName, email, password, gender, birth, phone, lastLoginAt, createdAt, updatedAt, deletedAt)
-		if err != nil {
-			log.Printf("[repository:patient][GetAll] error concatenating patient values from DB")
-			return nil, fmt.Errorf(got, ErrConcatenatingPatient, err)
-		}
-
-		ptns = append(ptns, patient)
-	}
-
-	if err = rows.Err(); err != nil {
-		log.Printf("[repository:patient][GetAll] error reading patients: %v", err)
-		return nil, fmt.Errorf(got, ErrIterationRowsPatient, err)
-	}
+func (r *PatientRepository) GetAll(ctx context.Context, filter patients.PatientFilter, pagination abstractions.Pagination) (*abstractions.Page[*patients.Patient], error) {
+	return r.list(ctx, "GetAll", filter, pagination)
+}
 
-	log.Printf("[repository:patient][GetAll] successfully fetched %d patients", len(ptns))
-	return ptns, nil
+func (r *PatientRepository) GetList(ctx context.Context, filter patients.PatientFilter, pagination abstractions.Pagination) (*abstractions.Page[*patients.Patient], error) {
+	deleted := false
+	filter.Deleted = &deleted
+	return r.list(ctx, "GetList", filter, pagination)
 }
 
-func (r *PatientRepository) GetList(ctx context.Context) ([]*patients.Patient, error) {
+func (r *PatientRepository) list(ctx context.Context, method string, filter patients.PatientFilter, pagination abstractions.Pagination) (*abstractions.Page[*patients.Patient], error) {
 	var (
 		ptnts                                        []*patients.Patient
 		id                                           uuid.UUID
@@ -123,33 +84,45 @@ func (r *PatientRepository) GetList(ctx context.Context) ([]*patients.Patient, e
 		lastLoginAt, createdAt, updatedAt, birth     time.Time
 		deletedAt                                    *time.Time
 		phone                                        *string
+		total                                        int
+		genderCode                                   *string
 	)
 
-	rows, err := r.Db.QueryContext(ctx, QueryGetListPatients)
+	if filter.Gender != nil {
+		code := string(*filter.Gender)
+		genderCode = &code
+	}
+
+	q := peopleListQuery(filter.Name, genderCode, filter.Deleted)
+	query, args, err := q.page(QueryGetAllPatients, peopleSortColumns, "created_at", pagination)
+	if err != nil {
+		log.Printf("[repository:patient][%s] invalid pagination: %v", method, err)
+		return nil, err
+	}
+
+	rows, err := r.conn(ctx).QueryContext(ctx, query, args...)
 	if err != nil {
-		log.Printf("[repository:patient][GetList] error executing SQL query '%s': %v", QueryGetListPatients, err)
+		log.Printf("[repository:patient][%s] error executing SQL query '%s': %v", method, query, err)
 		return nil, fmt.Errorf(got, ErrQueryPatient, err)
 	}
 
 	defer func(rows *sql.Rows) {
-		err := rows.Close()
-		if err != nil {
-			log.Printf("[repository:patient][GetList] failed to close rows: %v", err)
+		if err := rows.Close(); err != nil {
+			log.Printf("[repository:patient][%s] failed to close rows: %v", method, err)
 		}
 	}(rows)
-
 	for rows.Next() {
 		err = rows.Scan(
 			&id, &firstName, &lastName, &email, &password, &gender, &birth, &phone, &lastLoginAt, &createdAt, &updatedAt, &deletedAt,
 		)
 		if err != nil {
-			log.Printf("[repository:patient][GetList] error reading patientDTO for a slice of patients: %v", err)
+			log.Printf("[repository:patient][%s] error reading patient rows: %v", method, err)
 			return nil, fmt.Errorf(got, ErrScanPatient, err)
 		}
 
 		patient, err := patients.NewPatientFromDB(id, firstName, lastName, email, password, gender, birth, phone, lastLoginAt, createdAt, updatedAt, deletedAt)
 		if err != nil {
-			log.Printf("[repository:patient][GetList] error concatenating patient values from DB")
+			log.Printf("[repository:patient][%s] error concatenating patient values from DB", method)
 			return nil, fmt.Errorf(got, ErrConcatenatingPatient, err)
 		}
 
@@ -157,12 +130,18 @@ func (r *PatientRepository) GetList(ctx context.Context) ([]*patients.Patient, e
 	}
 
 	if err = rows.Err(); err != nil {
-		log.Printf("[repository:patient][GetList] error Reading patients: %v", err)
+		log.Printf("[repository:patient][%s] error reading patients: %v", method, err)
 		return nil, fmt.Errorf(got, ErrIterationRowsPatient, err)
 	}
 
-	log.Printf("[repository:patient][GetList] successfully fetched %d patients", len(ptnts))
-	return ptnts, nil
+	countQuery, countArgs := q.count(QueryCountAllPatients)
+	if err = r.conn(ctx).QueryRowContext(ctx, countQuery, countArgs...).Scan(&total); err != nil {
+		log.Printf("[repository:patient][%s] error executing SQL query '%s': %v", method, countQuery, err)
+		return nil, fmt.Errorf(got, ErrQueryPatient, err)
+	}
+
+	log.Printf("[repository:patient][%s] successfully fetched %d of %d patients", method, len(ptnts), total)
+	return abstractions.NewPage(ptnts, total, pagination), nil
 }
 
 func (r *PatientRepository) GetById(ctx context.Context, id uuid.UUID) (*patients.Patient, error) {
@@ -173,7 +152,7 @@ func (r *PatientRepository) GetById(ctx context.Context, id uuid.UUID) (*patient
 		phone                                        *string
 	)
 
-	err := r.Db.QueryRowContext(ctx, QueryGetPatientById, id).Scan(
+	err := r.conn(ctx).QueryRowContext(ctx, QueryGetPatientById, id).Scan(
 		&firstName, &lastName, &email, &password, &gender, &birth, &phone, &lastLoginAt, &createdAt, &updatedAt, &deletedAt,
 	)
 
@@ -201,7 +180,7 @@ func (r *PatientRepository) GetByEmail(ctx context.Context, email string) (*pati
 		phone                                    *string
 	)
 
-	err := r.Db.QueryRowContext(ctx, QueryGetPatientByEmail, email).Scan(
+	err := r.conn(ctx).QueryRowContext(ctx, QueryGetPatientByEmail, email).Scan(
 		&id, &firstName, &lastName, &password, &gender, &birth, &phone, &lastLoginAt, &createdAt, &updatedAt, &deletedAt,
 	)
 	if err != nil {
@@ -222,7 +201,7 @@ func (r *PatientRepository) GetByEmail(ctx context.Context, email string) (*pati
 func (r *PatientRepository) ExistById(ctx context.Context, id uuid.UUID) (bool, error) {
 	var exist bool
 
-	err := r.Db.QueryRowContext(ctx, QueryExistPatientById, id).Scan(
+	err := r.conn(ctx).QueryRowContext(ctx, QueryExistPatientById, id).Scan(
 		&exist,
 	)
 	if err != nil {
@@ -237,7 +216,7 @@ func (r *PatientRepository) ExistById(ctx context.Context, id uuid.UUID) (bool,
 func (r *PatientRepository) ExistByEmail(ctx context.Context, email string) (bool, error) {
 	var exist bool
 
-	err := r.Db.QueryRowContext(ctx, QueryExistPatientByEmail, email).Scan(
+	err := r.conn(ctx).QueryRowContext(ctx, QueryExistPatientByEmail, email).Scan(
 		&exist,
 	)
 	if err != nil {
@@ -250,6 +229,20 @@ func (r *PatientRepository) ExistByEmail(ctx context.Context, email string) (boo
 }
 
 func (r *PatientRepository) Create(ctx context.Context, ptn *patients.Patient) (*patients.Patient, error) {
+	var created *patients.Patient
+	err := saveWithEvents(ctx, r.Db, func(ctx context.Context) error {
+		var err error
+		created, err = r.create(ctx, ptn)
+		return err
+	}, ptn)
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+func (r *PatientRepository) create(ctx context.Context, ptn *patients.Patient) (*patients.Patient, error) {
 	var (
 		id                                           uuid.UUID
 		firstName, lastName, email, password, gender string
@@ -263,7 +256,7 @@ func (r *PatientRepository) Create(ctx context.Context, ptn *patients.Patient) (
 		phoneVal = s
 	}
 
-	err := r.Db.QueryRowContext(
+	err := r.conn(ctx).QueryRowContext(
 		ctx, QueryCreatePatient, ptn.Id(), ptn.FirstName(), ptn.LastName(), ptn.Email().Value(), ptn.Password().String(), ptn.Gender(), ptn.Birth().Value(), phoneVal,
 	).Scan(
 		&id, &firstName, &lastName, &email, &password, &gender, &birth, &phone, &lastLoginAt, &createdAt, &updatedAt, &deletedAt,
@@ -297,7 +290,7 @@ func (r *PatientRepository) Update(ctx context.Context, ptn *patients.Patient) (
 		phone = ptn.Phone().String()
 	}
 
-	err := r.Db.QueryRowContext(
+	err := r.conn(ctx).QueryRowContext(
 		ctx, QueryUpdatePatient, ptn.FirstName(), ptn.LastName(), ptn.Email().Value(), ptn.Password().String(), ptn.Gender(), ptn.Birth().Value(), phone, ptn.LastLoginAt(), ptn.UpdatedAt(), ptn.Id(),
 	).Scan(
 		&id, &firstName, &lastName, &email, &password, &gender, &birth, &phone, &lastLoginAt, &createdAt, &updatedAt, &deletedAt,
@@ -318,6 +311,26 @@ func (r *PatientRepository) Update(ctx context.Context, ptn *patients.Patient) (
 }
 
 func (r *PatientRepository) Delete(ctx context.Context, id uuid.UUID) (*patients.Patient, error) {
+	var patient *patients.Patient
+	err := persistence.NewUnitOfWork(r.Db).Do(ctx, func(ctx context.Context) error {
+		var err error
+		patient, err = r.delete(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		patient.Deleted()
+		return storeEvents(ctx, r.conn(ctx), patient.DomainEvents())
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	patient.ClearDomainEvents()
+	return patient, nil
+}
+
+func (r *PatientRepository) delete(ctx context.Context, id uuid.UUID) (*patients.Patient, error) {
 	var (
 		firstName, lastName, email, password, gender string
 		lastLoginAt, createdAt, updatedAt, birth     time.Time
@@ -325,7 +338,7 @@ func (r *PatientRepository) Delete(ctx context.Context, id uuid.UUID) (*patients
 		phone                                        *string
 	)
 
-	err := r.Db.QueryRowContext(ctx, QueryDeletePatient, id).Scan(
+	err := r.conn(ctx).QueryRowContext(ctx, QueryDeletePatient, id).Scan(
 		&firstName, &lastName, &email, &password, &gender, &birth, &phone, &lastLoginAt, &createdAt, &updatedAt, &deletedAt,
 	)
 
@@ -352,7 +365,7 @@ func (r *PatientRepository) Restore(ctx context.Context, id uuid.UUID) (*patient
 		phone                                        *string
 	)
 
-	err := r.Db.QueryRowContext(ctx, QueryRestorePatient, id).Scan(
+	err := r.conn(ctx).QueryRowContext(ctx, QueryRestorePatient, id).Scan(
 		&firstName, &lastName, &email, &password, &gender, &birth, &phone, &lastLoginAt, &createdAt, &updatedAt, &deletedAt,
 	)
 
@@ -374,7 +387,7 @@ func (r *PatientRepository) Restore(ctx context.Context, id uuid.UUID) (*patient
 func (r *PatientRepository) CountAll(ctx context.Context) (int, error) {
 	var count int
 
-	err := r.Db.QueryRowContext(ctx, QueryCountAllPatients).Scan(&count)
+	err := r.conn(ctx).QueryRowContext(ctx, QueryCountAllPatients).Scan(&count)
 	if err != nil {
 		log.Printf("[repository:patient][CountAll] error executing SQL query in CountAll: %v", err)
 		return -1, fmt.Errorf(got, ErrQueryPatient, err)
@@ -385,7 +398,7 @@ func (r *PatientRepository) CountAll(ctx context.Context) (int, error) {
 func (r *PatientRepository) CountActive(ctx context.Context) (int, error) {
 	var count int
 
-	err := r.Db.QueryRowContext(ctx, QueryCountActivePatients).Scan(&count)
+	err := r.conn(ctx).QueryRowContext(ctx, QueryCountActivePatients).Scan(&count)
 	if err != nil {
 		log.Printf("[repository:patient][CountActive] error executing SQL query in CountActive: %v", err)
 		return -1, fmt.Errorf(got, ErrQueryPatient, err)
@@ -396,7 +409,7 @@ func (r *PatientRepository) CountActive(ctx context.Context) (int, error) {
 func (r *PatientRepository) CountDeleted(ctx context.Context) (int, error) {
 	var count int
 
-	err := r.Db.QueryRowContext(ctx, QueryCountDeletedPatients).Scan(&count)
+	err := r.conn(ctx).QueryRowContext(ctx, QueryCountDeletedPatients).Scan(&count)
 	if err != nil {
 		log.Printf("[repository:patient][CountDeleted] error executing SQL query in CountDeleted: %v", err)
 		return -1, fmt.Errorf(got, ErrQueryPatient, err)
@@ -404,6 +417,10 @@ func (r *PatientRepository) CountDeleted(ctx context.Context) (int, error) {
 	return count, nil
 }
 
+func (r *PatientRepository) conn(ctx context.Context) persistence.DBTX {
+	return persistence.Executor(ctx, r.Db)
+}
+
 func NewPatientRepository(db *sql.DB) patients.PatientRepository {
 	return &PatientRepository{Db: db}
 }
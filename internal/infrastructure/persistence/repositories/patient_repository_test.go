@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/abstractions"
 	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/patient"
 	vo "github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
 	"github.com/google/uuid"
@@ -35,9 +36,13 @@ func TestPatientRepository_GetAll(t *testing.T) {
 	}
 
 	mock.ExpectQuery(QueryGetAllPatients).WillReturnRows(rows)
+	mock.ExpectQuery(regexp.QuoteMeta(QueryCountAllPatients)).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(len(cases)))
 
-	ptnts, err := repo.GetAll(context.Background())
+	page, err := repo.GetAll(context.Background(), patients.PatientFilter{}, abstractions.Pagination{})
 	assert.NoError(t, err)
+	assert.Equal(t, len(cases), page.Total)
+
+	ptnts := page.Items
 	assert.Len(t, ptnts, len(cases))
 
 	for i, tc := range cases {
@@ -59,7 +64,7 @@ func TestPatientRepository_GetAll_QueryError(t *testing.T) {
 
 	mock.ExpectQuery(QueryGetAllPatients).WillReturnError(ErrDatabasePatient)
 
-	ptnts, err := repo.GetAll(context.Background())
+	ptnts, err := repo.GetAll(context.Background(), patients.PatientFilter{}, abstractions.Pagination{})
 
 	assert.Nil(t, ptnts)
 	assert.Error(t, err)
@@ -82,7 +87,7 @@ func TestPatientRepository_GetAll_ScanError(t *testing.T) {
 
 	mock.ExpectQuery(QueryGetAllPatients).WillReturnRows(rows)
 
-	ptnts, err := repo.GetAll(context.Background())
+	ptnts, err := repo.GetAll(context.Background(), patients.PatientFilter{}, abstractions.Pagination{})
 
 	assert.Nil(t, ptnts)
 	assert.Error(t, err)
@@ -102,7 +107,7 @@ func TestPatientRepository_GetAll_NewPatientError(t *testing.T) {
 
 	mock.ExpectQuery(QueryGetAllPatients).WillReturnRows(rows)
 
-	ptnts, err := repo.GetAll(context.Background())
+	ptnts, err := repo.GetAll(context.Background(), patients.PatientFilter{}, abstractions.Pagination{})
 
 	assert.Nil(t, ptnts)
 	assert.Error(t, err)
@@ -135,10 +140,14 @@ func TestPatientRepository_GetList(t *testing.T) {
 		)
 	}
 
-	mock.ExpectQuery(regexp.QuoteMeta(QueryGetListPatients)).WillReturnRows(filteredRows)
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetAllPatients + " WHERE deleted_at IS NULL")).WillReturnRows(filteredRows)
+	mock.ExpectQuery(regexp.QuoteMeta(QueryCountAllPatients)).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(len(filteredCases)))
 
-	ptnts, err := repo.GetList(context.Background())
+	page, err := repo.GetList(context.Background(), patients.PatientFilter{}, abstractions.Pagination{})
 	assert.NoError(t, err)
+	assert.Equal(t, len(filteredCases), page.Total)
+
+	ptnts := page.Items
 	assert.Len(t, ptnts, len(filteredCases))
 
 	for i, patient := range ptnts {
@@ -159,9 +168,9 @@ func TestPatientRepository_GetList_QueryError(t *testing.T) {
 
 	repo := NewPatientRepository(db)
 
-	mock.ExpectQuery(QueryGetListPatients).WillReturnError(ErrDatabasePatient)
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetAllPatients + " WHERE deleted_at IS NULL")).WillReturnError(ErrDatabasePatient)
 
-	ptnts, err := repo.GetList(context.Background())
+	ptnts, err := repo.GetList(context.Background(), patients.PatientFilter{}, abstractions.Pagination{})
 
 	assert.Nil(t, ptnts)
 	assert.Error(t, err)
@@ -182,9 +191,9 @@ func TestPatientRepository_GetList_ScanError(t *testing.T) {
 	newColumns := []string{"id", "first_name"}
 	rows := sqlmock.NewRows(newColumns).AddRow(uuid.New(), "John")
 
-	mock.ExpectQuery(QueryGetListPatients).WillReturnRows(rows)
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetAllPatients + " WHERE deleted_at IS NULL")).WillReturnRows(rows)
 
-	ptnts, err := repo.GetList(context.Background())
+	ptnts, err := repo.GetList(context.Background(), patients.PatientFilter{}, abstractions.Pagination{})
 
 	assert.Nil(t, ptnts)
 	assert.Error(t, err)
@@ -202,9 +211,9 @@ func TestPatientRepository_GetList_NewPatientError(t *testing.T) {
 	repo := NewPatientRepository(db)
 	rows := sqlmock.NewRows(columns).AddRow(uuid.New(), "Invalid", "User", "invalid-email", "$2a$10$abcdefghijklmnopqrstuvabcdefghijklmnopqrstuvab", "male", time.Now().AddDate(-10, 0, 0), nil, time.Now(), time.Now(), time.Now(), nil)
 
-	mock.ExpectQuery(QueryGetListPatients).WillReturnRows(rows)
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetAllPatients + " WHERE deleted_at IS NULL")).WillReturnRows(rows)
 
-	ptnts, err := repo.GetList(context.Background())
+	ptnts, err := repo.GetList(context.Background(), patients.PatientFilter{}, abstractions.Pagination{})
 
 	assert.Nil(t, ptnts)
 	assert.Error(t, err)
@@ -698,9 +707,14 @@ func TestPatientRepository_Delete(t *testing.T) {
 			ptn.Phone(), now, now, now, now,
 		)
 
+		mock.ExpectBegin()
 		mock.ExpectQuery(regexp.QuoteMeta(QueryDeletePatient)).
 			WithArgs(ptn.Id()).
 			WillReturnRows(rows)
+		mock.ExpectExec("INSERT INTO outbox").
+			WithArgs(sqlmock.AnyArg(), ptn.Id(), patients.PatientDeleted, sqlmock.AnyArg(), sqlmock.AnyArg()).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
 
 		result, err := repo.Delete(context.Background(), ptn.Id())
 		assert.NoError(t, err)
@@ -713,15 +727,18 @@ func TestPatientRepository_Delete(t *testing.T) {
 		assert.Equal(t, ptn.Birth(), result.Birth())
 		assert.Equal(t, ptn.Phone(), result.Phone())
 		assert.NotNil(t, result.DeletedAt())
+		assert.Empty(t, result.DomainEvents())
 
 		err = mock.ExpectationsWereMet()
 		assert.NoError(t, err)
 	})
 
 	t.Run("query error", func(t *testing.T) {
+		mock.ExpectBegin()
 		mock.ExpectQuery(regexp.QuoteMeta(QueryDeletePatient)).
 			WithArgs(ptn.Id()).
 			WillReturnError(ErrDatabasePatient)
+		mock.ExpectRollback()
 
 		result, err := repo.Delete(context.Background(), ptn.Id())
 		assert.Nil(t, result)
@@ -740,9 +757,11 @@ func TestPatientRepository_Delete(t *testing.T) {
 			ptn.Phone(), now, now, now, now,
 		)
 
+		mock.ExpectBegin()
 		mock.ExpectQuery(regexp.QuoteMeta(QueryDeletePatient)).
 			WithArgs(ptn.Id()).
 			WillReturnRows(rows)
+		mock.ExpectRollback()
 
 		result, err := repo.Delete(context.Background(), ptn.Id())
 		assert.Nil(t, result)
@@ -0,0 +1,164 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/plan"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/infrastructure/persistence"
+	"github.com/google/uuid"
+	"log"
+	"time"
+)
+
+type PlanRepository struct {
+	DB *sql.DB
+}
+
+const (
+	QueryGetAllPlans = `SELECT id, code, name, duration_days, pattern, base_price, active, created_at, updated_at, deleted_at
+							FROM plan
+							WHERE deleted_at IS NULL AND (active OR $1)
+							ORDER BY duration_days, code`
+	QueryGetPlanById = `SELECT id, code, name, duration_days, pattern, base_price, active, created_at, updated_at, deleted_at
+							FROM plan
+							WHERE id = $1`
+	QueryGetPlanByCode = `SELECT id, code, name, duration_days, pattern, base_price, active, created_at, updated_at, deleted_at
+							FROM plan
+							WHERE code = $1`
+	QueryExistPlanByCode = `SELECT EXISTS(
+								SELECT 1
+								FROM plan
+								WHERE code = $1
+							)`
+	QueryCreatePlan = `INSERT INTO plan(id, code, name, duration_days, pattern, base_price, active)
+							VALUES($1, $2, $3, $4, $5, $6, $7)
+							RETURNING id, code, name, duration_days, pattern, base_price, active, created_at, updated_at, deleted_at`
+	QueryUpdatePlan = `UPDATE plan
+							SET name = $1, duration_days = $2, pattern = $3, base_price = $4, active = $5, updated_at = NOW()
+							WHERE id = $6
+							RETURNING id, code, name, duration_days, pattern, base_price, active, created_at, updated_at, deleted_at`
+)
+
+var (
+	ErrQueryPlan         = errors.New("query failed")
+	ErrScanPlan          = errors.New("scan failed")
+	ErrConcatenatingPlan = errors.New("error concatenating plan values from DB")
+)
+
+func (r *PlanRepository) GetAll(ctx context.Context, includeInactive bool) ([]*plans.Plan, error) {
+	rows, err := r.conn(ctx).QueryContext(ctx, QueryGetAllPlans, includeInactive)
+	if err != nil {
+		log.Printf("[repository:plan][GetAll] error executing SQL query '%s': %v", QueryGetAllPlans, err)
+		return nil, fmt.Errorf(got, ErrQueryPlan, err)
+	}
+	defer rows.Close()
+
+	var list []*plans.Plan
+	for rows.Next() {
+		p, err := scanPlan(rows)
+		if err != nil {
+			log.Printf("[repository:plan][GetAll] error scanning rows: %v", err)
+			return nil, err
+		}
+		list = append(list, p)
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Printf("[repository:plan][GetAll] error iterating rows: %v", err)
+		return nil, fmt.Errorf(got, ErrScanPlan, err)
+	}
+
+	log.Printf("[repository:plan][GetAll] successfully fetched %d plans", len(list))
+	return list, nil
+}
+
+func (r *PlanRepository) GetById(ctx context.Context, id uuid.UUID) (*plans.Plan, error) {
+	p, err := scanPlan(r.conn(ctx).QueryRowContext(ctx, QueryGetPlanById, id))
+	if err != nil {
+		log.Printf("[repository:plan][GetById] error reading plan '%s': %v", id, err)
+		return nil, err
+	}
+
+	return p, nil
+}
+
+func (r *PlanRepository) GetByCode(ctx context.Context, code string) (*plans.Plan, error) {
+	p, err := scanPlan(r.conn(ctx).QueryRowContext(ctx, QueryGetPlanByCode, code))
+	if err != nil {
+		log.Printf("[repository:plan][GetByCode] error reading plan '%s': %v", code, err)
+		return nil, err
+	}
+
+	return p, nil
+}
+
+func (r *PlanRepository) ExistByCode(ctx context.Context, code string) (bool, error) {
+	var exists bool
+	if err := r.conn(ctx).QueryRowContext(ctx, QueryExistPlanByCode, code).Scan(&exists); err != nil {
+		log.Printf("[repository:plan][ExistByCode] error executing SQL query '%s': %v", QueryExistPlanByCode, err)
+		return false, fmt.Errorf(got, ErrQueryPlan, err)
+	}
+
+	return exists, nil
+}
+
+func (r *PlanRepository) Create(ctx context.Context, p *plans.Plan) (*plans.Plan, error) {
+	created, err := scanPlan(r.conn(ctx).QueryRowContext(
+		ctx, QueryCreatePlan,
+		p.Id(), p.Code(), p.Name(), p.DurationDays(), string(p.Pattern()), p.BasePrice(), p.Active(),
+	))
+	if err != nil {
+		log.Printf("[repository:plan][Create] error executing SQL query '%s': %v", QueryCreatePlan, err)
+		return nil, err
+	}
+
+	return created, nil
+}
+
+func (r *PlanRepository) Update(ctx context.Context, p *plans.Plan) (*plans.Plan, error) {
+	updated, err := scanPlan(r.conn(ctx).QueryRowContext(
+		ctx, QueryUpdatePlan,
+		p.Name(), p.DurationDays(), string(p.Pattern()), p.BasePrice(), p.Active(), p.Id(),
+	))
+	if err != nil {
+		log.Printf("[repository:plan][Update] error executing SQL query '%s': %v", QueryUpdatePlan, err)
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+func (r *PlanRepository) conn(ctx context.Context) persistence.DBTX {
+	return persistence.Executor(ctx, r.DB)
+}
+
+func scanPlan(row rowScanner) (*plans.Plan, error) {
+	var (
+		id                   uuid.UUID
+		code, name, pattern  string
+		duration, basePrice  int
+		active               bool
+		createdAt, updatedAt time.Time
+		deletedAt            *time.Time
+	)
+
+	err := row.Scan(&id, &code, &name, &duration, &pattern, &basePrice, &active, &createdAt, &updatedAt, &deletedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf(got, plans.ErrNotFoundPlan, err)
+	} else if err != nil {
+		return nil, fmt.Errorf(got, ErrScanPlan, err)
+	}
+
+	p, err := plans.NewPlanFromDb(id, code, name, duration, pattern, basePrice, active, createdAt, updatedAt, deletedAt)
+	if err != nil {
+		return nil, fmt.Errorf(got, ErrConcatenatingPlan, err)
+	}
+
+	return p, nil
+}
+
+func NewPlanRepository(db *sql.DB) plans.PlanRepository {
+	return &PlanRepository{DB: db}
+}
@@ -0,0 +1,161 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/plan"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"regexp"
+	"testing"
+	"time"
+)
+
+var (
+	planColumns   = []string{"id", "code", "name", "duration_days", "pattern", "base_price", "active", "created_at", "updated_at", "deleted_at"}
+	halfMonthPlan = plans.NewPlan("H", "Half-month", 15, plans.Daily, 1000)
+	monthlyPlan   = plans.NewPlan("M", "Monthly", 30, plans.Daily, 2000)
+)
+
+func TestPlanRepository_GetAll(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPlanRepository(db)
+	now := time.Now()
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetAllPlans)).
+		WithArgs(false).
+		WillReturnRows(sqlmock.NewRows(planColumns).
+			AddRow(uuid.New(), "TRIAL", "Trial", 3, "D", 300, true, now, now, nil).
+			AddRow(uuid.New(), "WEEKLY", "Weekly", 7, "D", 650, true, now, now, nil))
+
+	list, err := repo.GetAll(context.Background(), false)
+
+	assert.NoError(t, err)
+	assert.Len(t, list, 2)
+	assert.Equal(t, "TRIAL", list[0].Code())
+	assert.Equal(t, 7, list[1].DurationDays())
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetAllPlans)).
+		WithArgs(true).
+		WillReturnRows(sqlmock.NewRows(planColumns).AddRow(uuid.New(), "TRIAL", "Trial", 3, "X", 300, true, now, now, nil))
+
+	list, err = repo.GetAll(context.Background(), true)
+
+	assert.Nil(t, list)
+	assert.ErrorIs(t, err, ErrConcatenatingPlan)
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetAllPlans)).WillReturnError(ErrDatabaseAdministrator)
+
+	list, err = repo.GetAll(context.Background(), true)
+
+	assert.Nil(t, list)
+	assert.ErrorIs(t, err, ErrQueryPlan)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPlanRepository_GetByCode(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPlanRepository(db)
+	id, now := uuid.New(), time.Now()
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetPlanByCode)).
+		WithArgs("SIXTY_DAYS").
+		WillReturnRows(sqlmock.NewRows(planColumns).AddRow(id, "SIXTY_DAYS", "Sixty days", 60, "E", 4000, false, now, now, nil))
+
+	p, err := repo.GetByCode(context.Background(), "SIXTY_DAYS")
+
+	assert.NoError(t, err)
+	assert.Equal(t, id, p.Id())
+	assert.Equal(t, plans.EveryOtherDay, p.Pattern())
+	assert.Equal(t, 4000, p.BasePrice())
+	assert.False(t, p.Active())
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetPlanByCode)).WithArgs("NONE").WillReturnError(sql.ErrNoRows)
+
+	p, err = repo.GetByCode(context.Background(), "NONE")
+
+	assert.Nil(t, p)
+	assert.ErrorIs(t, err, plans.ErrNotFoundPlan)
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetPlanById)).WithArgs(id).WillReturnError(ErrDatabaseAdministrator)
+
+	p, err = repo.GetById(context.Background(), id)
+
+	assert.Nil(t, p)
+	assert.ErrorIs(t, err, ErrScanPlan)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPlanRepository_ExistByCode(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPlanRepository(db)
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryExistPlanByCode)).
+		WithArgs("WEEKLY").
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+
+	exists, err := repo.ExistByCode(context.Background(), "WEEKLY")
+
+	assert.NoError(t, err)
+	assert.True(t, exists)
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryExistPlanByCode)).WillReturnError(ErrDatabaseAdministrator)
+
+	exists, err = repo.ExistByCode(context.Background(), "WEEKLY")
+
+	assert.False(t, exists)
+	assert.ErrorIs(t, err, ErrQueryPlan)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPlanRepository_CreateAndUpdate(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPlanRepository(db)
+	p := plans.NewPlan("WEEKLY", "Weekly", 7, plans.Daily, 650)
+	now := time.Now()
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryCreatePlan)).
+		WithArgs(p.Id(), "WEEKLY", "Weekly", 7, "D", 650, true).
+		WillReturnRows(sqlmock.NewRows(planColumns).AddRow(p.Id(), "WEEKLY", "Weekly", 7, "D", 650, true, now, now, nil))
+
+	created, err := repo.Create(context.Background(), p)
+
+	assert.NoError(t, err)
+	assert.Equal(t, p.Id(), created.Id())
+	assert.Equal(t, now, created.CreatedAt())
+
+	assert.NoError(t, p.Update("Weekly lunch", 7, plans.Weekly, 200))
+	p.Deactivate()
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryUpdatePlan)).
+		WithArgs("Weekly lunch", 7, "W", 200, false, p.Id()).
+		WillReturnRows(sqlmock.NewRows(planColumns).AddRow(p.Id(), "WEEKLY", "Weekly lunch", 7, "W", 200, false, now, now, nil))
+
+	updated, err := repo.Update(context.Background(), p)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Weekly lunch", updated.Name())
+	assert.Equal(t, plans.Weekly, updated.Pattern())
+	assert.False(t, updated.Active())
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryUpdatePlan)).WillReturnError(sql.ErrNoRows)
+
+	updated, err = repo.Update(context.Background(), p)
+
+	assert.Nil(t, updated)
+	assert.ErrorIs(t, err, plans.ErrNotFoundPlan)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
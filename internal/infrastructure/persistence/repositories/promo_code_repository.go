@@ -0,0 +1,182 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/pricing"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/infrastructure/persistence"
+	"github.com/google/uuid"
+	"log"
+	"time"
+)
+
+type PromoCodeRepository struct {
+	DB *sql.DB
+}
+
+const (
+	QueryGetAllPromoCodes = `SELECT id, code, kind, value, currency, valid_from, valid_until, max_uses, uses, created_at, updated_at, deleted_at
+								FROM promo_code
+								WHERE deleted_at IS NULL
+								ORDER BY valid_from DESC, code`
+	QueryGetPromoCodeByCode = `SELECT id, code, kind, value, currency, valid_from, valid_until, max_uses, uses, created_at, updated_at, deleted_at
+								FROM promo_code
+								WHERE code = $1 AND deleted_at IS NULL`
+	QueryExistPromoCodeByCode = `SELECT EXISTS(
+									SELECT 1
+									FROM promo_code
+									WHERE code = $1 AND deleted_at IS NULL
+								)`
+	QueryCreatePromoCode = `INSERT INTO promo_code(id, code, kind, value, currency, valid_from, valid_until, max_uses)
+								VALUES($1, $2, $3, $4, $5, $6, $7, $8)
+								RETURNING id, code, kind, value, currency, valid_from, valid_until, max_uses, uses, created_at, updated_at, deleted_at`
+	QueryRedeemPromoCode = `UPDATE promo_code
+								SET uses = uses + 1, updated_at = NOW()
+								WHERE code = $1 AND deleted_at IS NULL AND (max_uses = 0 OR uses < max_uses)`
+	QueryDeletePromoCode = `UPDATE promo_code
+								SET deleted_at = NOW(), updated_at = NOW()
+								WHERE id = $1 AND deleted_at IS NULL`
+)
+
+var (
+	ErrQueryPromoCode         = errors.New("query failed")
+	ErrScanPromoCode          = errors.New("scan failed")
+	ErrConcatenatingPromoCode = errors.New("error concatenating promo code values from DB")
+)
+
+func (r *PromoCodeRepository) GetAll(ctx context.Context) ([]*pricing.PromoCode, error) {
+	rows, err := r.conn(ctx).QueryContext(ctx, QueryGetAllPromoCodes)
+	if err != nil {
+		log.Printf("[repository:promo_code][GetAll] error executing SQL query '%s': %v", QueryGetAllPromoCodes, err)
+		return nil, fmt.Errorf(got, ErrQueryPromoCode, err)
+	}
+	defer rows.Close()
+
+	var list []*pricing.PromoCode
+	for rows.Next() {
+		p, err := scanPromoCode(rows)
+		if err != nil {
+			log.Printf("[repository:promo_code][GetAll] error scanning rows: %v", err)
+			return nil, err
+		}
+		list = append(list, p)
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Printf("[repository:promo_code][GetAll] error iterating rows: %v", err)
+		return nil, fmt.Errorf(got, ErrScanPromoCode, err)
+	}
+
+	log.Printf("[repository:promo_code][GetAll] successfully fetched %d promo codes", len(list))
+	return list, nil
+}
+
+func (r *PromoCodeRepository) GetByCode(ctx context.Context, code string) (*pricing.PromoCode, error) {
+	p, err := scanPromoCode(r.conn(ctx).QueryRowContext(ctx, QueryGetPromoCodeByCode, code))
+	if err != nil {
+		log.Printf("[repository:promo_code][GetByCode] error reading promo code '%s': %v", code, err)
+		return nil, err
+	}
+
+	return p, nil
+}
+
+func (r *PromoCodeRepository) ExistByCode(ctx context.Context, code string) (bool, error) {
+	var exists bool
+	if err := r.conn(ctx).QueryRowContext(ctx, QueryExistPromoCodeByCode, code).Scan(&exists); err != nil {
+		log.Printf("[repository:promo_code][ExistByCode] error executing SQL query '%s': %v", QueryExistPromoCodeByCode, err)
+		return false, fmt.Errorf(got, ErrQueryPromoCode, err)
+	}
+
+	return exists, nil
+}
+
+func (r *PromoCodeRepository) Create(ctx context.Context, p *pricing.PromoCode) (*pricing.PromoCode, error) {
+	created, err := scanPromoCode(r.conn(ctx).QueryRowContext(
+		ctx, QueryCreatePromoCode,
+		p.Id(), p.Code(), string(p.Kind()), p.Value(), string(p.Currency()), p.ValidFrom(), p.ValidUntil(), p.MaxUses(),
+	))
+	if err != nil {
+		log.Printf("[repository:promo_code][Create] error executing SQL query '%s': %v", QueryCreatePromoCode, err)
+		return nil, err
+	}
+
+	return created, nil
+}
+
+// Redeem counts a use in the same statement that checks the limit, so two
+// contracts cannot take the last use of a code at once.
+func (r *PromoCodeRepository) Redeem(ctx context.Context, code string) error {
+	res, err := r.conn(ctx).ExecContext(ctx, QueryRedeemPromoCode, code)
+	if err != nil {
+		log.Printf("[repository:promo_code][Redeem] error executing SQL query '%s': %v", QueryRedeemPromoCode, err)
+		return fmt.Errorf(got, ErrQueryPromoCode, err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf(got, ErrQueryPromoCode, err)
+	}
+
+	if affected == 0 {
+		log.Printf("[repository:promo_code][Redeem] promo code '%s' has no uses left", code)
+		return fmt.Errorf("%w: %s", pricing.ErrUsedUpPromoCode, code)
+	}
+
+	return nil
+}
+
+func (r *PromoCodeRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	res, err := r.conn(ctx).ExecContext(ctx, QueryDeletePromoCode, id)
+	if err != nil {
+		log.Printf("[repository:promo_code][Delete] error executing SQL query '%s': %v", QueryDeletePromoCode, err)
+		return fmt.Errorf(got, ErrQueryPromoCode, err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf(got, ErrQueryPromoCode, err)
+	}
+
+	if affected == 0 {
+		return fmt.Errorf("%w: %s", pricing.ErrNotFoundPromoCode, id)
+	}
+
+	return nil
+}
+
+func (r *PromoCodeRepository) conn(ctx context.Context) persistence.DBTX {
+	return persistence.Executor(ctx, r.DB)
+}
+
+func scanPromoCode(row rowScanner) (*pricing.PromoCode, error) {
+	var (
+		id                   uuid.UUID
+		code, kind, currency string
+		value, maxUses, uses int
+		validFrom            time.Time
+		validUntil           *time.Time
+		createdAt, updatedAt time.Time
+		deletedAt            *time.Time
+	)
+
+	err := row.Scan(&id, &code, &kind, &value, &currency, &validFrom, &validUntil, &maxUses, &uses, &createdAt, &updatedAt, &deletedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf(got, pricing.ErrNotFoundPromoCode, err)
+	} else if err != nil {
+		return nil, fmt.Errorf(got, ErrScanPromoCode, err)
+	}
+
+	p, err := pricing.NewPromoCodeFromDb(id, code, kind, value, currency, validFrom, validUntil, maxUses, uses, createdAt, updatedAt, deletedAt)
+	if err != nil {
+		return nil, fmt.Errorf(got, ErrConcatenatingPromoCode, err)
+	}
+
+	return p, nil
+}
+
+func NewPromoCodeRepository(db *sql.DB) pricing.PromoCodeRepository {
+	return &PromoCodeRepository{DB: db}
+}
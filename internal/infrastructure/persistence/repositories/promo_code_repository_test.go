@@ -0,0 +1,174 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/pricing"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"regexp"
+	"testing"
+	"time"
+)
+
+var promoCodeColumns = []string{"id", "code", "kind", "value", "currency", "valid_from", "valid_until", "max_uses", "uses", "created_at", "updated_at", "deleted_at"}
+
+func TestPromoCodeRepository_GetAll(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPromoCodeRepository(db)
+	now := time.Now()
+	until := now.AddDate(0, 1, 0)
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetAllPromoCodes)).
+		WillReturnRows(sqlmock.NewRows(promoCodeColumns).
+			AddRow(uuid.New(), "WELCOME10", "P", 1000, "BOB", now, until, 100, 4, now, now, nil).
+			AddRow(uuid.New(), "FIFTY", "F", 5000, "BOB", now, nil, 0, 0, now, now, nil))
+
+	list, err := repo.GetAll(context.Background())
+
+	assert.NoError(t, err)
+	assert.Len(t, list, 2)
+	assert.Equal(t, pricing.Percentage, list[0].Kind())
+	assert.Equal(t, 4, list[0].Uses())
+	assert.Equal(t, until, *list[0].ValidUntil())
+	assert.Equal(t, pricing.Fixed, list[1].Kind())
+	assert.Nil(t, list[1].ValidUntil())
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetAllPromoCodes)).
+		WillReturnRows(sqlmock.NewRows(promoCodeColumns).AddRow(uuid.New(), "FREE", "X", 1000, "BOB", now, nil, 0, 0, now, now, nil))
+
+	list, err = repo.GetAll(context.Background())
+
+	assert.Nil(t, list)
+	assert.ErrorIs(t, err, ErrConcatenatingPromoCode)
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetAllPromoCodes)).WillReturnError(ErrDatabaseAdministrator)
+
+	list, err = repo.GetAll(context.Background())
+
+	assert.Nil(t, list)
+	assert.ErrorIs(t, err, ErrQueryPromoCode)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPromoCodeRepository_GetByCode(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPromoCodeRepository(db)
+	id, now := uuid.New(), time.Now()
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetPromoCodeByCode)).
+		WithArgs("WELCOME10").
+		WillReturnRows(sqlmock.NewRows(promoCodeColumns).AddRow(id, "WELCOME10", "P", 1000, "BOB", now, nil, 0, 0, now, now, nil))
+
+	p, err := repo.GetByCode(context.Background(), "WELCOME10")
+
+	assert.NoError(t, err)
+	assert.Equal(t, id, p.Id())
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetPromoCodeByCode)).WithArgs("MISSING").WillReturnError(sql.ErrNoRows)
+
+	p, err = repo.GetByCode(context.Background(), "MISSING")
+
+	assert.Nil(t, p)
+	assert.ErrorIs(t, err, pricing.ErrNotFoundPromoCode)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPromoCodeRepository_ExistByCode(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPromoCodeRepository(db)
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryExistPromoCodeByCode)).
+		WithArgs("WELCOME10").
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+
+	exists, err := repo.ExistByCode(context.Background(), "WELCOME10")
+
+	assert.NoError(t, err)
+	assert.True(t, exists)
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryExistPromoCodeByCode)).WillReturnError(ErrDatabaseAdministrator)
+
+	exists, err = repo.ExistByCode(context.Background(), "WELCOME10")
+
+	assert.False(t, exists)
+	assert.ErrorIs(t, err, ErrQueryPromoCode)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPromoCodeRepository_Create(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPromoCodeRepository(db)
+	now := time.Now()
+	until := now.AddDate(0, 1, 0)
+	p := pricing.NewPromoCode("WELCOME10", pricing.Percentage, 1000, valueobjects.BOB, now, &until, 100)
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryCreatePromoCode)).
+		WithArgs(p.Id(), "WELCOME10", "P", 1000, "BOB", now, &until, 100).
+		WillReturnRows(sqlmock.NewRows(promoCodeColumns).AddRow(p.Id(), "WELCOME10", "P", 1000, "BOB", now, until, 100, 0, now, now, nil))
+
+	created, err := repo.Create(context.Background(), p)
+
+	assert.NoError(t, err)
+	assert.Equal(t, p.Id(), created.Id())
+	assert.Equal(t, now, created.CreatedAt())
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryCreatePromoCode)).WillReturnError(ErrDatabaseAdministrator)
+
+	created, err = repo.Create(context.Background(), p)
+
+	assert.Nil(t, created)
+	assert.ErrorIs(t, err, ErrScanPromoCode)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPromoCodeRepository_Redeem(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPromoCodeRepository(db)
+
+	mock.ExpectExec(regexp.QuoteMeta(QueryRedeemPromoCode)).WithArgs("WELCOME10").WillReturnResult(sqlmock.NewResult(0, 1))
+	assert.NoError(t, repo.Redeem(context.Background(), "WELCOME10"))
+
+	mock.ExpectExec(regexp.QuoteMeta(QueryRedeemPromoCode)).WithArgs("WELCOME10").WillReturnResult(sqlmock.NewResult(0, 0))
+	assert.ErrorIs(t, repo.Redeem(context.Background(), "WELCOME10"), pricing.ErrUsedUpPromoCode)
+
+	mock.ExpectExec(regexp.QuoteMeta(QueryRedeemPromoCode)).WithArgs("WELCOME10").WillReturnError(ErrDatabaseAdministrator)
+	assert.ErrorIs(t, repo.Redeem(context.Background(), "WELCOME10"), ErrQueryPromoCode)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPromoCodeRepository_Delete(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPromoCodeRepository(db)
+	id := uuid.New()
+
+	mock.ExpectExec(regexp.QuoteMeta(QueryDeletePromoCode)).WithArgs(id).WillReturnResult(sqlmock.NewResult(0, 1))
+	assert.NoError(t, repo.Delete(context.Background(), id))
+
+	mock.ExpectExec(regexp.QuoteMeta(QueryDeletePromoCode)).WithArgs(id).WillReturnResult(sqlmock.NewResult(0, 0))
+	assert.ErrorIs(t, repo.Delete(context.Background(), id), pricing.ErrNotFoundPromoCode)
+
+	mock.ExpectExec(regexp.QuoteMeta(QueryDeletePromoCode)).WithArgs(id).WillReturnError(ErrDatabaseAdministrator)
+	assert.ErrorIs(t, repo.Delete(context.Background(), id), ErrQueryPromoCode)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,91 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/delivery"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/infrastructure/persistence"
+	"github.com/google/uuid"
+	"log"
+	"time"
+)
+
+type ProofRepository struct {
+	DB *sql.DB
+}
+
+const (
+	QueryGetProofByDelivery = `SELECT id, delivery_id, recipient, received_at, latitude, longitude, distance, flagged, photo_key, signature_key, created_at
+								FROM delivery_proof
+								WHERE delivery_id = $1`
+	QueryCreateProof = `INSERT INTO delivery_proof(id, delivery_id, recipient, received_at, latitude, longitude, distance, flagged, photo_key, signature_key)
+							VALUES($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+							RETURNING id, delivery_id, recipient, received_at, latitude, longitude, distance, flagged, photo_key, signature_key, created_at`
+)
+
+var (
+	ErrScanProof          = errors.New("scan failed")
+	ErrConcatenatingProof = errors.New("error concatenating proof values from DB")
+)
+
+func (r *ProofRepository) GetByDelivery(ctx context.Context, deliveryId uuid.UUID) (*deliveries.Proof, error) {
+	p, err := scanProof(r.conn(ctx).QueryRowContext(ctx, QueryGetProofByDelivery, deliveryId))
+	if err != nil {
+		log.Printf("[repository:proof][GetByDelivery] error reading proof of delivery '%s': %v", deliveryId, err)
+		return nil, err
+	}
+
+	return p, nil
+}
+
+func (r *ProofRepository) Create(ctx context.Context, p *deliveries.Proof) (*deliveries.Proof, error) {
+	location := p.Location()
+	created, err := scanProof(r.conn(ctx).QueryRowContext(
+		ctx, QueryCreateProof,
+		p.Id(), p.DeliveryId(), p.Recipient(), p.ReceivedAt(), location.Latitude(), location.Longitude(),
+		p.Distance(), p.Flagged(), p.PhotoKey(), p.SignatureKey(),
+	))
+	if err != nil {
+		log.Printf("[repository:proof][Create] error executing SQL query '%s': %v", QueryCreateProof, err)
+		return nil, err
+	}
+
+	log.Printf("[repository:proof][Create] proof of delivery '%s' stored", p.DeliveryId())
+	return created, nil
+}
+
+func (r *ProofRepository) conn(ctx context.Context) persistence.DBTX {
+	return persistence.Executor(ctx, r.DB)
+}
+
+func scanProof(row rowScanner) (*deliveries.Proof, error) {
+	var (
+		id, deliveryId         uuid.UUID
+		recipient              string
+		receivedAt, createdAt  time.Time
+		latitude, longitude    float64
+		distance               int
+		flagged                bool
+		photoKey, signatureKey *string
+	)
+
+	err := row.Scan(&id, &deliveryId, &recipient, &receivedAt, &latitude, &longitude, &distance, &flagged, &photoKey, &signatureKey, &createdAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf(got, deliveries.ErrNotFoundProof, err)
+	} else if err != nil {
+		return nil, fmt.Errorf(got, ErrScanProof, err)
+	}
+
+	p, err := deliveries.NewProofFromDb(id, deliveryId, recipient, receivedAt, latitude, longitude, distance, flagged, photoKey, signatureKey, createdAt)
+	if err != nil {
+		return nil, fmt.Errorf(got, ErrConcatenatingProof, err)
+	}
+
+	return p, nil
+}
+
+func NewProofRepository(db *sql.DB) deliveries.ProofRepository {
+	return &ProofRepository{DB: db}
+}
@@ -0,0 +1,89 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/delivery"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"regexp"
+	"testing"
+	"time"
+)
+
+var proofColumns = []string{"id", "delivery_id", "recipient", "received_at", "latitude", "longitude", "distance", "flagged", "photo_key", "signature_key", "created_at"}
+
+func TestProofRepository_GetByDelivery(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewProofRepository(db)
+	id, deliveryId, now := uuid.New(), uuid.New(), time.Now()
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetProofByDelivery)).
+		WithArgs(deliveryId).
+		WillReturnRows(sqlmock.NewRows(proofColumns).AddRow(id, deliveryId, "Ana", now, -17.7863, -63.1812, 420, true, "deliveries/photo.jpg", nil, now))
+
+	p, err := repo.GetByDelivery(context.Background(), deliveryId)
+
+	assert.NoError(t, err)
+	assert.Equal(t, id, p.Id())
+	assert.Equal(t, "Ana", p.Recipient())
+	assert.Equal(t, 420, p.Distance())
+	assert.True(t, p.Flagged())
+	assert.Equal(t, "deliveries/photo.jpg", *p.PhotoKey())
+	assert.Nil(t, p.SignatureKey())
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetProofByDelivery)).WithArgs(deliveryId).WillReturnError(sql.ErrNoRows)
+
+	p, err = repo.GetByDelivery(context.Background(), deliveryId)
+
+	assert.Nil(t, p)
+	assert.ErrorIs(t, err, deliveries.ErrNotFoundProof)
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetProofByDelivery)).
+		WithArgs(deliveryId).
+		WillReturnRows(sqlmock.NewRows(proofColumns).AddRow(id, deliveryId, "Ana", now, 91, -63.1812, 0, false, nil, nil, now))
+
+	p, err = repo.GetByDelivery(context.Background(), deliveryId)
+
+	assert.Nil(t, p)
+	assert.ErrorIs(t, err, ErrConcatenatingProof)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestProofRepository_Create(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewProofRepository(db)
+	address, _ := valueobjects.NewCoordinates(-17.7863, -63.1812)
+	d := deliveries.NewDelivery(uuid.New(), time.Now(), "Sesame Street", 30, address)
+	receivedAt := time.Now().Add(-time.Minute)
+	p, err := d.Deliver("Ana", receivedAt, address, 150)
+	assert.NoError(t, err)
+	p.AttachSignature("deliveries/signature.png")
+	now := time.Now()
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryCreateProof)).
+		WithArgs(p.Id(), d.Id(), "Ana", receivedAt, -17.7863, -63.1812, 0, false, nil, p.SignatureKey()).
+		WillReturnRows(sqlmock.NewRows(proofColumns).AddRow(p.Id(), d.Id(), "Ana", receivedAt, -17.7863, -63.1812, 0, false, nil, "deliveries/signature.png", now))
+
+	created, err := repo.Create(context.Background(), p)
+
+	assert.NoError(t, err)
+	assert.Equal(t, p.Id(), created.Id())
+	assert.Equal(t, now, created.CreatedAt())
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryCreateProof)).WillReturnError(ErrDatabaseAdministrator)
+
+	created, err = repo.Create(context.Background(), p)
+
+	assert.Nil(t, created)
+	assert.ErrorIs(t, err, ErrScanProof)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,125 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/token"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/infrastructure/persistence"
+	"github.com/google/uuid"
+	"log"
+	"time"
+)
+
+type ResetTokenRepository struct {
+	DB *sql.DB
+}
+
+const (
+	QueryGetResetTokenByHash = `SELECT id, subject_id, role, hash, expires_at, used_at, created_at
+								FROM reset_token
+								WHERE hash = $1`
+	QueryCreateResetToken = `INSERT INTO reset_token(id, subject_id, role, hash, expires_at)
+								VALUES($1, $2, $3, $4, $5)
+								RETURNING id, subject_id, role, hash, expires_at, used_at, created_at`
+	QueryUseResetToken = `UPDATE reset_token
+							SET used_at = NOW()
+							WHERE id = $1 AND used_at IS NULL`
+	QueryInvalidateResetTokensBySubject = `UPDATE reset_token
+											SET used_at = NOW()
+											WHERE subject_id = $1 AND used_at IS NULL`
+)
+
+var (
+	ErrQueryResetToken         = errors.New("query failed")
+	ErrScanResetToken          = errors.New("scan failed")
+	ErrConcatenatingResetToken = errors.New("error concatenating reset token values from DB")
+)
+
+func (r *ResetTokenRepository) GetByHash(ctx context.Context, hash string) (*tokens.ResetToken, error) {
+	t, err := scanResetToken(r.conn(ctx).QueryRowContext(ctx, QueryGetResetTokenByHash, hash))
+	if err != nil {
+		log.Printf("[repository:reset_token][GetByHash] error reading reset token: %v", err)
+		return nil, err
+	}
+
+	return t, nil
+}
+
+func (r *ResetTokenRepository) Create(ctx context.Context, t *tokens.ResetToken) (*tokens.ResetToken, error) {
+	created, err := scanResetToken(r.conn(ctx).QueryRowContext(ctx, QueryCreateResetToken, t.Id(), t.SubjectId(), string(t.Role()), t.Hash(), t.ExpiresAt()))
+	if err != nil {
+		log.Printf("[repository:reset_token][Create] error executing SQL query '%s': %v", QueryCreateResetToken, err)
+		return nil, err
+	}
+
+	return created, nil
+}
+
+// Use reports false when the token was already used or does not exist, so two
+// concurrent redemptions cannot both succeed.
+func (r *ResetTokenRepository) Use(ctx context.Context, id uuid.UUID) (bool, error) {
+	res, err := r.conn(ctx).ExecContext(ctx, QueryUseResetToken, id)
+	if err != nil {
+		log.Printf("[repository:reset_token][Use] error executing SQL query '%s': %v", QueryUseResetToken, err)
+		return false, fmt.Errorf(got, ErrQueryResetToken, err)
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		log.Printf("[repository:reset_token][Use] error reading affected rows: %v", err)
+		return false, fmt.Errorf(got, ErrQueryResetToken, err)
+	}
+
+	return n > 0, nil
+}
+
+func (r *ResetTokenRepository) InvalidateBySubject(ctx context.Context, subjectId uuid.UUID) (int, error) {
+	res, err := r.conn(ctx).ExecContext(ctx, QueryInvalidateResetTokensBySubject, subjectId)
+	if err != nil {
+		log.Printf("[repository:reset_token][InvalidateBySubject] error executing SQL query '%s': %v", QueryInvalidateResetTokensBySubject, err)
+		return 0, fmt.Errorf(got, ErrQueryResetToken, err)
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		log.Printf("[repository:reset_token][InvalidateBySubject] error reading affected rows: %v", err)
+		return 0, fmt.Errorf(got, ErrQueryResetToken, err)
+	}
+
+	return int(n), nil
+}
+
+func (r *ResetTokenRepository) conn(ctx context.Context) persistence.DBTX {
+	return persistence.Executor(ctx, r.DB)
+}
+
+func scanResetToken(row rowScanner) (*tokens.ResetToken, error) {
+	var (
+		id, subjectId        uuid.UUID
+		role, hash           string
+		expiresAt, createdAt time.Time
+		usedAt               *time.Time
+	)
+
+	err := row.Scan(&id, &subjectId, &role, &hash, &expiresAt, &usedAt, &createdAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf(got, tokens.ErrNotFoundToken, err)
+	} else if err != nil {
+		return nil, fmt.Errorf(got, ErrScanResetToken, err)
+	}
+
+	t, err := tokens.NewResetTokenFromDB(id, subjectId, role, hash, expiresAt, usedAt, createdAt)
+	if err != nil {
+		return nil, fmt.Errorf(got, ErrConcatenatingResetToken, err)
+	}
+
+	return t, nil
+}
+
+func NewResetTokenRepository(db *sql.DB) tokens.ResetTokenRepository {
+	return &ResetTokenRepository{
+		DB: db,
+	}
+}
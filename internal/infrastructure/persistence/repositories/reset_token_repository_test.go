@@ -0,0 +1,139 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/token"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"regexp"
+	"testing"
+	"time"
+)
+
+var resetTokenColumns = []string{"id", "subject_id", "role", "hash", "expires_at", "used_at", "created_at"}
+
+func TestResetTokenRepository_Create(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewResetTokenRepository(db)
+	expiresAt := time.Now().Add(time.Hour)
+	hash := tokens.HashResetSecret("secret")
+	tk := tokens.NewResetToken(uuid.New(), tokens.Patient, hash, expiresAt)
+	now := time.Now()
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryCreateResetToken)).
+		WithArgs(tk.Id(), tk.SubjectId(), "P", hash, expiresAt).
+		WillReturnRows(sqlmock.NewRows(resetTokenColumns).AddRow(tk.Id(), tk.SubjectId(), "P", hash, expiresAt, nil, now))
+
+	created, err := repo.Create(context.Background(), tk)
+
+	assert.NoError(t, err)
+	assert.Equal(t, tk.Id(), created.Id())
+	assert.Equal(t, hash, created.Hash())
+	assert.Equal(t, now, created.CreatedAt())
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestResetTokenRepository_GetByHash(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewResetTokenRepository(db)
+	id, subjectId := uuid.New(), uuid.New()
+	hash := tokens.HashResetSecret("secret")
+	now := time.Now()
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetResetTokenByHash)).
+		WithArgs(hash).
+		WillReturnRows(sqlmock.NewRows(resetTokenColumns).AddRow(id, subjectId, "A", hash, now.Add(time.Minute), now, now))
+
+	tk, err := repo.GetByHash(context.Background(), hash)
+
+	assert.NoError(t, err)
+	assert.Equal(t, subjectId, tk.SubjectId())
+	assert.Equal(t, tokens.Administrator, tk.Role())
+	assert.ErrorIs(t, tk.Validate(now), tokens.ErrUsedResetToken)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestResetTokenRepository_GetByHash_Errors(t *testing.T) {
+	cases := []struct {
+		name string
+		rows *sqlmock.Rows
+		err  error
+		want error
+	}{
+		{"Not found", nil, sql.ErrNoRows, tokens.ErrNotFoundToken},
+		{"Scan", sqlmock.NewRows([]string{"id"}).AddRow(uuid.New()), nil, ErrScanResetToken},
+		{"Concatenating", sqlmock.NewRows(resetTokenColumns).AddRow(uuid.New(), uuid.New(), "A", "", time.Now(), nil, time.Now()), nil, ErrConcatenatingResetToken},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			assert.NoError(t, err)
+			defer db.Close()
+
+			repo := NewResetTokenRepository(db)
+			exp := mock.ExpectQuery(regexp.QuoteMeta(QueryGetResetTokenByHash))
+			if tc.rows != nil {
+				exp.WillReturnRows(tc.rows)
+			} else {
+				exp.WillReturnError(tc.err)
+			}
+
+			tk, err := repo.GetByHash(context.Background(), "hash")
+
+			assert.Nil(t, tk)
+			assert.ErrorIs(t, err, tc.want)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestResetTokenRepository_Use(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewResetTokenRepository(db)
+	id := uuid.New()
+
+	mock.ExpectExec(regexp.QuoteMeta(QueryUseResetToken)).WithArgs(id).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(QueryUseResetToken)).WithArgs(id).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta(QueryUseResetToken)).WithArgs(id).WillReturnError(ErrDatabaseAdministrator)
+
+	used, err := repo.Use(context.Background(), id)
+	assert.NoError(t, err)
+	assert.True(t, used)
+
+	used, err = repo.Use(context.Background(), id)
+	assert.NoError(t, err)
+	assert.False(t, used)
+
+	_, err = repo.Use(context.Background(), id)
+	assert.ErrorIs(t, err, ErrQueryResetToken)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestResetTokenRepository_InvalidateBySubject(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewResetTokenRepository(db)
+	subjectId := uuid.New()
+
+	mock.ExpectExec(regexp.QuoteMeta(QueryInvalidateResetTokensBySubject)).WithArgs(subjectId).WillReturnResult(sqlmock.NewResult(0, 2))
+
+	n, err := repo.InvalidateBySubject(context.Background(), subjectId)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, n)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
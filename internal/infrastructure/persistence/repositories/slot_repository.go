@@ -0,0 +1,236 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/slot"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/infrastructure/persistence"
+	"github.com/google/uuid"
+	"log"
+	"time"
+)
+
+type SlotRepository struct {
+	DB *sql.DB
+}
+
+const (
+	QueryGetAllSlots = `SELECT id, zone_id, name, start_minute, end_minute, capacity, created_at, updated_at, deleted_at
+						FROM delivery_slot
+						WHERE deleted_at IS NULL
+						ORDER BY zone_id, start_minute, name`
+	QueryGetSlotsByZone = `SELECT id, zone_id, name, start_minute, end_minute, capacity, created_at, updated_at, deleted_at
+							FROM delivery_slot
+							WHERE zone_id = $1 AND deleted_at IS NULL
+							ORDER BY start_minute, name`
+	QueryGetSlotById = `SELECT id, zone_id, name, start_minute, end_minute, capacity, created_at, updated_at, deleted_at
+						FROM delivery_slot
+						WHERE id = $1 AND deleted_at IS NULL`
+	QueryLockSlot = `SELECT id
+						FROM delivery_slot
+						WHERE id = $1 AND deleted_at IS NULL
+						FOR UPDATE`
+	QueryCountBookedSlot = `SELECT TO_CHAR(date, 'YYYY-MM-DD'), COUNT(*)
+							FROM delivery
+							WHERE slot_id = $1 AND status <> 'C' AND deleted_at IS NULL AND date >= $2 AND date < $3
+							GROUP BY 1`
+	QueryCreateSlot = `INSERT INTO delivery_slot(id, zone_id, name, start_minute, end_minute, capacity)
+						VALUES($1, $2, $3, $4, $5, $6)
+						RETURNING id, zone_id, name, start_minute, end_minute, capacity, created_at, updated_at, deleted_at`
+	QueryUpdateSlot = `UPDATE delivery_slot
+						SET name = $1, start_minute = $2, end_minute = $3, capacity = $4, updated_at = NOW()
+						WHERE id = $5 AND deleted_at IS NULL
+						RETURNING id, zone_id, name, start_minute, end_minute, capacity, created_at, updated_at, deleted_at`
+	QueryDeleteSlot = `UPDATE delivery_slot
+						SET deleted_at = NOW(), updated_at = NOW()
+						WHERE id = $1 AND deleted_at IS NULL`
+	QueryReleaseSlotDeliveries = `UPDATE delivery
+									SET slot_id = NULL, updated_at = NOW()
+									WHERE slot_id = $1 AND status = 'P'`
+	QueryReleaseSlotContracts = `UPDATE contract
+									SET slot_id = NULL, updated_at = NOW()
+									WHERE slot_id = $1`
+)
+
+var (
+	ErrQuerySlot         = errors.New("query failed")
+	ErrScanSlot          = errors.New("scan failed")
+	ErrConcatenatingSlot = errors.New("error concatenating slot values from DB")
+)
+
+func (r *SlotRepository) GetAll(ctx context.Context) ([]*slots.Slot, error) {
+	return r.getSlots(ctx, "GetAll", QueryGetAllSlots)
+}
+
+func (r *SlotRepository) GetByZone(ctx context.Context, zoneId uuid.UUID) ([]*slots.Slot, error) {
+	return r.getSlots(ctx, "GetByZone", QueryGetSlotsByZone, zoneId)
+}
+
+func (r *SlotRepository) getSlots(ctx context.Context, method, query string, args ...any) ([]*slots.Slot, error) {
+	rows, err := r.conn(ctx).QueryContext(ctx, query, args...)
+	if err != nil {
+		log.Printf("[repository:slot][%s] error executing SQL query '%s': %v", method, query, err)
+		return nil, fmt.Errorf(got, ErrQuerySlot, err)
+	}
+	defer rows.Close()
+
+	var list []*slots.Slot
+	for rows.Next() {
+		s, err := scanSlot(rows)
+		if err != nil {
+			log.Printf("[repository:slot][%s] error scanning rows: %v", method, err)
+			return nil, err
+		}
+		list = append(list, s)
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Printf("[repository:slot][%s] error iterating rows: %v", method, err)
+		return nil, fmt.Errorf(got, ErrScanSlot, err)
+	}
+
+	log.Printf("[repository:slot][%s] successfully fetched %d slots", method, len(list))
+	return list, nil
+}
+
+func (r *SlotRepository) GetById(ctx context.Context, id uuid.UUID) (*slots.Slot, error) {
+	s, err := scanSlot(r.conn(ctx).QueryRowContext(ctx, QueryGetSlotById, id))
+	if err != nil {
+		log.Printf("[repository:slot][GetById] error reading slot '%s': %v", id, err)
+		return nil, err
+	}
+
+	return s, nil
+}
+
+func (r *SlotRepository) Lock(ctx context.Context, id uuid.UUID) error {
+	var locked uuid.UUID
+	err := r.conn(ctx).QueryRowContext(ctx, QueryLockSlot, id).Scan(&locked)
+	if errors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf("%w: %s", slots.ErrNotFoundSlot, id)
+	} else if err != nil {
+		log.Printf("[repository:slot][Lock] error executing SQL query '%s': %v", QueryLockSlot, err)
+		return fmt.Errorf(got, ErrQuerySlot, err)
+	}
+
+	return nil
+}
+
+func (r *SlotRepository) CountBooked(ctx context.Context, id uuid.UUID, from, to time.Time) (map[string]int, error) {
+	rows, err := r.conn(ctx).QueryContext(ctx, QueryCountBookedSlot, id, from, to)
+	if err != nil {
+		log.Printf("[repository:slot][CountBooked] error executing SQL query '%s': %v", QueryCountBookedSlot, err)
+		return nil, fmt.Errorf(got, ErrQuerySlot, err)
+	}
+	defer rows.Close()
+
+	counts := make(map[string]int)
+	for rows.Next() {
+		var (
+			day   string
+			count int
+		)
+		if err = rows.Scan(&day, &count); err != nil {
+			log.Printf("[repository:slot][CountBooked] error scanning rows: %v", err)
+			return nil, fmt.Errorf(got, ErrScanSlot, err)
+		}
+		counts[day] = count
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Printf("[repository:slot][CountBooked] error iterating rows: %v", err)
+		return nil, fmt.Errorf(got, ErrScanSlot, err)
+	}
+
+	return counts, nil
+}
+
+func (r *SlotRepository) Create(ctx context.Context, s *slots.Slot) (*slots.Slot, error) {
+	created, err := scanSlot(r.conn(ctx).QueryRowContext(
+		ctx, QueryCreateSlot,
+		s.Id(), s.ZoneId(), s.Name(), minutes(s.Start()), minutes(s.End()), s.Capacity(),
+	))
+	if err != nil {
+		log.Printf("[repository:slot][Create] error executing SQL query '%s': %v", QueryCreateSlot, err)
+		return nil, err
+	}
+
+	return created, nil
+}
+
+func (r *SlotRepository) Update(ctx context.Context, s *slots.Slot) (*slots.Slot, error) {
+	updated, err := scanSlot(r.conn(ctx).QueryRowContext(
+		ctx, QueryUpdateSlot,
+		s.Name(), minutes(s.Start()), minutes(s.End()), s.Capacity(), s.Id(),
+	))
+	if err != nil {
+		log.Printf("[repository:slot][Update] error executing SQL query '%s': %v", QueryUpdateSlot, err)
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+func (r *SlotRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	res, err := r.conn(ctx).ExecContext(ctx, QueryDeleteSlot, id)
+	if err != nil {
+		log.Printf("[repository:slot][Delete] error executing SQL query '%s': %v", QueryDeleteSlot, err)
+		return fmt.Errorf(got, ErrQuerySlot, err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf(got, ErrQuerySlot, err)
+	}
+
+	if affected == 0 {
+		return fmt.Errorf("%w: %s", slots.ErrNotFoundSlot, id)
+	}
+
+	for _, query := range []string{QueryReleaseSlotDeliveries, QueryReleaseSlotContracts} {
+		if _, err = r.conn(ctx).ExecContext(ctx, query, id); err != nil {
+			log.Printf("[repository:slot][Delete] error executing SQL query '%s': %v", query, err)
+			return fmt.Errorf(got, ErrQuerySlot, err)
+		}
+	}
+
+	return nil
+}
+
+func (r *SlotRepository) conn(ctx context.Context) persistence.DBTX {
+	return persistence.Executor(ctx, r.DB)
+}
+
+func minutes(d time.Duration) int {
+	return int(d / time.Minute)
+}
+
+func scanSlot(row rowScanner) (*slots.Slot, error) {
+	var (
+		id, zoneId           uuid.UUID
+		name                 string
+		start, end, capacity int
+		createdAt, updatedAt time.Time
+		deletedAt            *time.Time
+	)
+
+	err := row.Scan(&id, &zoneId, &name, &start, &end, &capacity, &createdAt, &updatedAt, &deletedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf(got, slots.ErrNotFoundSlot, err)
+	} else if err != nil {
+		return nil, fmt.Errorf(got, ErrScanSlot, err)
+	}
+
+	s, err := slots.NewSlotFromDb(id, zoneId, name, start, end, capacity, createdAt, updatedAt, deletedAt)
+	if err != nil {
+		return nil, fmt.Errorf(got, ErrConcatenatingSlot, err)
+	}
+
+	return s, nil
+}
+
+func NewSlotRepository(db *sql.DB) slots.SlotRepository {
+	return &SlotRepository{DB: db}
+}
@@ -0,0 +1,215 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/slot"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"regexp"
+	"testing"
+	"time"
+)
+
+var slotColumns = []string{"id", "zone_id", "name", "start_minute", "end_minute", "capacity", "created_at", "updated_at", "deleted_at"}
+
+func TestSlotRepository_GetAll(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewSlotRepository(db)
+	zoneId, now := uuid.New(), time.Now()
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetAllSlots)).
+		WillReturnRows(sqlmock.NewRows(slotColumns).
+			AddRow(uuid.New(), zoneId, "Lunch", 690, 840, 30, now, now, nil).
+			AddRow(uuid.New(), zoneId, "Dinner", 1140, 1260, 20, now, now, nil))
+
+	list, err := repo.GetAll(context.Background())
+
+	assert.NoError(t, err)
+	assert.Len(t, list, 2)
+	assert.Equal(t, "Lunch", list[0].Name())
+	assert.Equal(t, 11*time.Hour+30*time.Minute, list[0].Start())
+	assert.Equal(t, 21*time.Hour, list[1].End())
+	assert.Equal(t, 20, list[1].Capacity())
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetAllSlots)).
+		WillReturnRows(sqlmock.NewRows(slotColumns).AddRow(uuid.New(), zoneId, "Lunch", 840, 690, 30, now, now, nil))
+
+	list, err = repo.GetAll(context.Background())
+
+	assert.Nil(t, list)
+	assert.ErrorIs(t, err, ErrConcatenatingSlot)
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetAllSlots)).WillReturnError(ErrDatabaseAdministrator)
+
+	list, err = repo.GetAll(context.Background())
+
+	assert.Nil(t, list)
+	assert.ErrorIs(t, err, ErrQuerySlot)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSlotRepository_GetByZone(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewSlotRepository(db)
+	zoneId, now := uuid.New(), time.Now()
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetSlotsByZone)).
+		WithArgs(zoneId).
+		WillReturnRows(sqlmock.NewRows(slotColumns).AddRow(uuid.New(), zoneId, "Lunch", 690, 840, 30, now, now, nil))
+
+	list, err := repo.GetByZone(context.Background(), zoneId)
+
+	assert.NoError(t, err)
+	assert.Len(t, list, 1)
+	assert.Equal(t, zoneId, list[0].ZoneId())
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSlotRepository_GetById(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewSlotRepository(db)
+	id, zoneId, now := uuid.New(), uuid.New(), time.Now()
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetSlotById)).
+		WithArgs(id).
+		WillReturnRows(sqlmock.NewRows(slotColumns).AddRow(id, zoneId, "Lunch", 690, 840, 30, now, now, nil))
+
+	s, err := repo.GetById(context.Background(), id)
+
+	assert.NoError(t, err)
+	assert.Equal(t, id, s.Id())
+	assert.Equal(t, zoneId, s.ZoneId())
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetSlotById)).WithArgs(id).WillReturnError(sql.ErrNoRows)
+
+	s, err = repo.GetById(context.Background(), id)
+
+	assert.Nil(t, s)
+	assert.ErrorIs(t, err, slots.ErrNotFoundSlot)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSlotRepository_Lock(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewSlotRepository(db)
+	id := uuid.New()
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryLockSlot)).WithArgs(id).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(id))
+	assert.NoError(t, repo.Lock(context.Background(), id))
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryLockSlot)).WithArgs(id).WillReturnError(sql.ErrNoRows)
+	assert.ErrorIs(t, repo.Lock(context.Background(), id), slots.ErrNotFoundSlot)
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryLockSlot)).WithArgs(id).WillReturnError(ErrDatabaseAdministrator)
+	assert.ErrorIs(t, repo.Lock(context.Background(), id), ErrQuerySlot)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSlotRepository_CountBooked(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewSlotRepository(db)
+	id := uuid.New()
+	from := time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 15)
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryCountBookedSlot)).
+		WithArgs(id, from, to).
+		WillReturnRows(sqlmock.NewRows([]string{"day", "count"}).AddRow("2025-03-03", 12).AddRow("2025-03-04", 30))
+
+	counts, err := repo.CountBooked(context.Background(), id, from, to)
+
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]int{"2025-03-03": 12, "2025-03-04": 30}, counts)
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryCountBookedSlot)).WillReturnError(ErrDatabaseAdministrator)
+
+	counts, err = repo.CountBooked(context.Background(), id, from, to)
+
+	assert.Nil(t, counts)
+	assert.ErrorIs(t, err, ErrQuerySlot)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSlotRepository_CreateAndUpdate(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewSlotRepository(db)
+	s := slots.NewSlot(uuid.New(), "Lunch", 11*time.Hour+30*time.Minute, 14*time.Hour, 30)
+	now := time.Now()
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryCreateSlot)).
+		WithArgs(s.Id(), s.ZoneId(), "Lunch", 690, 840, 30).
+		WillReturnRows(sqlmock.NewRows(slotColumns).AddRow(s.Id(), s.ZoneId(), "Lunch", 690, 840, 30, now, now, nil))
+
+	created, err := repo.Create(context.Background(), s)
+
+	assert.NoError(t, err)
+	assert.Equal(t, s.Id(), created.Id())
+	assert.Equal(t, now, created.CreatedAt())
+
+	assert.NoError(t, s.Update("Early lunch", 11*time.Hour, 13*time.Hour, 40))
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryUpdateSlot)).
+		WithArgs("Early lunch", 660, 780, 40, s.Id()).
+		WillReturnRows(sqlmock.NewRows(slotColumns).AddRow(s.Id(), s.ZoneId(), "Early lunch", 660, 780, 40, now, now, nil))
+
+	updated, err := repo.Update(context.Background(), s)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Early lunch", updated.Name())
+	assert.Equal(t, 11*time.Hour, updated.Start())
+	assert.Equal(t, 40, updated.Capacity())
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryUpdateSlot)).WillReturnError(sql.ErrNoRows)
+
+	updated, err = repo.Update(context.Background(), s)
+
+	assert.Nil(t, updated)
+	assert.ErrorIs(t, err, slots.ErrNotFoundSlot)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSlotRepository_Delete(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewSlotRepository(db)
+	id := uuid.New()
+
+	mock.ExpectExec(regexp.QuoteMeta(QueryDeleteSlot)).WithArgs(id).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(QueryReleaseSlotDeliveries)).WithArgs(id).WillReturnResult(sqlmock.NewResult(0, 3))
+	mock.ExpectExec(regexp.QuoteMeta(QueryReleaseSlotContracts)).WithArgs(id).WillReturnResult(sqlmock.NewResult(0, 1))
+	assert.NoError(t, repo.Delete(context.Background(), id))
+
+	mock.ExpectExec(regexp.QuoteMeta(QueryDeleteSlot)).WithArgs(id).WillReturnResult(sqlmock.NewResult(0, 0))
+	assert.ErrorIs(t, repo.Delete(context.Background(), id), slots.ErrNotFoundSlot)
+
+	mock.ExpectExec(regexp.QuoteMeta(QueryDeleteSlot)).WithArgs(id).WillReturnError(ErrDatabaseAdministrator)
+	assert.ErrorIs(t, repo.Delete(context.Background(), id), ErrQuerySlot)
+
+	mock.ExpectExec(regexp.QuoteMeta(QueryDeleteSlot)).WithArgs(id).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(QueryReleaseSlotDeliveries)).WithArgs(id).WillReturnError(ErrDatabaseAdministrator)
+	assert.ErrorIs(t, repo.Delete(context.Background(), id), ErrQuerySlot)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,123 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/token"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/infrastructure/persistence"
+	"github.com/google/uuid"
+	"log"
+	"time"
+)
+
+type TokenRepository struct {
+	DB *sql.DB
+}
+
+const (
+	QueryGetTokenById = `SELECT id, subject_id, role, kind, expires_at, revoked_at, created_at
+							FROM token
+							WHERE id = $1`
+	QueryCreateToken = `INSERT INTO token(id, subject_id, role, kind, expires_at)
+							VALUES($1, $2, $3, $4, $5)
+							RETURNING id, subject_id, role, kind, expires_at, revoked_at, created_at`
+	QueryRevokeToken = `UPDATE token
+							SET revoked_at = NOW()
+							WHERE id = $1 AND revoked_at IS NULL`
+	QueryRevokeTokensBySubject = `UPDATE token
+									SET revoked_at = NOW()
+									WHERE subject_id = $1 AND revoked_at IS NULL`
+)
+
+var (
+	ErrQueryToken         = errors.New("query failed")
+	ErrScanToken          = errors.New("scan failed")
+	ErrConcatenatingToken = errors.New("error concatenating token values from DB")
+)
+
+func (r *TokenRepository) GetById(ctx context.Context, id uuid.UUID) (*tokens.Token, error) {
+	t, err := scanToken(r.conn(ctx).QueryRowContext(ctx, QueryGetTokenById, id))
+	if err != nil {
+		log.Printf("[repository:token][GetById] error reading token '%s': %v", id, err)
+		return nil, err
+	}
+
+	return t, nil
+}
+
+func (r *TokenRepository) Create(ctx context.Context, t *tokens.Token) (*tokens.Token, error) {
+	created, err := scanToken(r.conn(ctx).QueryRowContext(ctx, QueryCreateToken, t.Id(), t.SubjectId(), string(t.Role()), string(t.Kind()), t.ExpiresAt()))
+	if err != nil {
+		log.Printf("[repository:token][Create] error executing SQL query '%s': %v", QueryCreateToken, err)
+		return nil, err
+	}
+
+	return created, nil
+}
+
+func (r *TokenRepository) Revoke(ctx context.Context, id uuid.UUID) (bool, error) {
+	res, err := r.conn(ctx).ExecContext(ctx, QueryRevokeToken, id)
+	if err != nil {
+		log.Printf("[repository:token][Revoke] error executing SQL query '%s': %v", QueryRevokeToken, err)
+		return false, fmt.Errorf(got, ErrQueryToken, err)
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		log.Printf("[repository:token][Revoke] error reading affected rows: %v", err)
+		return false, fmt.Errorf(got, ErrQueryToken, err)
+	}
+
+	return n > 0, nil
+}
+
+func (r *TokenRepository) RevokeBySubject(ctx context.Context, subjectId uuid.UUID) (int, error) {
+	res, err := r.conn(ctx).ExecContext(ctx, QueryRevokeTokensBySubject, subjectId)
+	if err != nil {
+		log.Printf("[repository:token][RevokeBySubject] error executing SQL query '%s': %v", QueryRevokeTokensBySubject, err)
+		return 0, fmt.Errorf(got, ErrQueryToken, err)
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		log.Printf("[repository:token][RevokeBySubject] error reading affected rows: %v", err)
+		return 0, fmt.Errorf(got, ErrQueryToken, err)
+	}
+
+	return int(n), nil
+}
+
+func (r *TokenRepository) conn(ctx context.Context) persistence.DBTX {
+	return persistence.Executor(ctx, r.DB)
+}
+
+func scanToken(row rowScanner) (*tokens.Token, error) {
+	var (
+		id, subjectId        uuid.UUID
+		role, kind           string
+		expiresAt, createdAt time.Time
+		revokedAt            *time.Time
+	)
+
+	err := row.Scan(&id, &subjectId, &role, &kind, &expiresAt, &revokedAt, &createdAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf(got, tokens.ErrNotFoundToken, err)
+	} else if err != nil {
+		return nil, fmt.Errorf(got, ErrScanToken, err)
+	}
+
+	t, err := tokens.NewTokenFromDB(id, subjectId, role, kind, expiresAt, revokedAt, createdAt)
+	if err != nil {
+		return nil, fmt.Errorf(got, ErrConcatenatingToken, err)
+	}
+
+	return t, nil
+}
+
+func NewTokenRepository(db *sql.DB) tokens.TokenRepository {
+	return &TokenRepository{
+		DB: db,
+	}
+}
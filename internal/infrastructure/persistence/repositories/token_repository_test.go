@@ -0,0 +1,137 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/token"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"regexp"
+	"testing"
+	"time"
+)
+
+var tokenColumns = []string{"id", "subject_id", "role", "kind", "expires_at", "revoked_at", "created_at"}
+
+func TestTokenRepository_Create(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewTokenRepository(db)
+	expiresAt := time.Now().Add(time.Hour)
+	tk := tokens.NewToken(uuid.New(), uuid.New(), tokens.Administrator, tokens.Refresh, expiresAt)
+	now := time.Now()
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryCreateToken)).
+		WithArgs(tk.Id(), tk.SubjectId(), "A", "R", expiresAt).
+		WillReturnRows(sqlmock.NewRows(tokenColumns).AddRow(tk.Id(), tk.SubjectId(), "A", "R", expiresAt, nil, now))
+
+	created, err := repo.Create(context.Background(), tk)
+
+	assert.NoError(t, err)
+	assert.Equal(t, tk.Id(), created.Id())
+	assert.Equal(t, tokens.Refresh, created.Kind())
+	assert.Equal(t, now, created.CreatedAt())
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestTokenRepository_GetById(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewTokenRepository(db)
+	id, subjectId := uuid.New(), uuid.New()
+	now := time.Now()
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetTokenById)).
+		WithArgs(id).
+		WillReturnRows(sqlmock.NewRows(tokenColumns).AddRow(id, subjectId, "P", "A", now.Add(time.Minute), now, now))
+
+	tk, err := repo.GetById(context.Background(), id)
+
+	assert.NoError(t, err)
+	assert.Equal(t, subjectId, tk.SubjectId())
+	assert.Equal(t, tokens.Patient, tk.Role())
+	assert.ErrorIs(t, tk.Validate(now), tokens.ErrRevokedToken)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestTokenRepository_GetById_Errors(t *testing.T) {
+	cases := []struct {
+		name string
+		rows *sqlmock.Rows
+		err  error
+		want error
+	}{
+		{"Not found", nil, sql.ErrNoRows, tokens.ErrNotFoundToken},
+		{"Scan", sqlmock.NewRows([]string{"id"}).AddRow(uuid.New()), nil, ErrScanToken},
+		{"Concatenating", sqlmock.NewRows(tokenColumns).AddRow(uuid.New(), uuid.New(), "X", "A", time.Now(), nil, time.Now()), nil, ErrConcatenatingToken},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			assert.NoError(t, err)
+			defer db.Close()
+
+			repo := NewTokenRepository(db)
+			exp := mock.ExpectQuery(regexp.QuoteMeta(QueryGetTokenById))
+			if tc.rows != nil {
+				exp.WillReturnRows(tc.rows)
+			} else {
+				exp.WillReturnError(tc.err)
+			}
+
+			tk, err := repo.GetById(context.Background(), uuid.New())
+
+			assert.Nil(t, tk)
+			assert.ErrorIs(t, err, tc.want)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestTokenRepository_Revoke(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewTokenRepository(db)
+	id := uuid.New()
+
+	mock.ExpectExec(regexp.QuoteMeta(QueryRevokeToken)).WithArgs(id).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(QueryRevokeToken)).WithArgs(id).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta(QueryRevokeToken)).WithArgs(id).WillReturnError(ErrDatabaseAdministrator)
+
+	revoked, err := repo.Revoke(context.Background(), id)
+	assert.NoError(t, err)
+	assert.True(t, revoked)
+
+	revoked, err = repo.Revoke(context.Background(), id)
+	assert.NoError(t, err)
+	assert.False(t, revoked)
+
+	_, err = repo.Revoke(context.Background(), id)
+	assert.ErrorIs(t, err, ErrQueryToken)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestTokenRepository_RevokeBySubject(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewTokenRepository(db)
+	subjectId := uuid.New()
+
+	mock.ExpectExec(regexp.QuoteMeta(QueryRevokeTokensBySubject)).WithArgs(subjectId).WillReturnResult(sqlmock.NewResult(0, 3))
+
+	n, err := repo.RevokeBySubject(context.Background(), subjectId)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 3, n)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,154 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/pricing"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/infrastructure/persistence"
+	"github.com/google/uuid"
+	"log"
+	"time"
+)
+
+type ZoneRepository struct {
+	DB *sql.DB
+}
+
+const (
+	QueryGetAllZones = `SELECT id, name, latitude, longitude, radius, surcharge, currency, boundary, created_at, updated_at, deleted_at
+						FROM zone
+						WHERE deleted_at IS NULL
+						ORDER BY name`
+	QueryGetZoneById = `SELECT id, name, latitude, longitude, radius, surcharge, currency, boundary, created_at, updated_at, deleted_at
+						FROM zone
+						WHERE id = $1 AND deleted_at IS NULL`
+	QueryCreateZone = `INSERT INTO zone(id, name, latitude, longitude, radius, surcharge, currency, boundary)
+						VALUES($1, $2, $3, $4, $5, $6, $7, $8)
+						RETURNING id, name, latitude, longitude, radius, surcharge, currency, boundary, created_at, updated_at, deleted_at`
+	QueryDeleteZone = `UPDATE zone
+						SET deleted_at = NOW(), updated_at = NOW()
+						WHERE id = $1 AND deleted_at IS NULL`
+)
+
+var (
+	ErrQueryZone         = errors.New("query failed")
+	ErrScanZone          = errors.New("scan failed")
+	ErrConcatenatingZone = errors.New("error concatenating zone values from DB")
+)
+
+func (r *ZoneRepository) GetAll(ctx context.Context) ([]*pricing.Zone, error) {
+	rows, err := r.conn(ctx).QueryContext(ctx, QueryGetAllZones)
+	if err != nil {
+		log.Printf("[repository:zone][GetAll] error executing SQL query '%s': %v", QueryGetAllZones, err)
+		return nil, fmt.Errorf(got, ErrQueryZone, err)
+	}
+	defer rows.Close()
+
+	var list []*pricing.Zone
+	for rows.Next() {
+		z, err := scanZone(rows)
+		if err != nil {
+			log.Printf("[repository:zone][GetAll] error scanning rows: %v", err)
+			return nil, err
+		}
+		list = append(list, z)
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Printf("[repository:zone][GetAll] error iterating rows: %v", err)
+		return nil, fmt.Errorf(got, ErrScanZone, err)
+	}
+
+	log.Printf("[repository:zone][GetAll] successfully fetched %d zones", len(list))
+	return list, nil
+}
+
+func (r *ZoneRepository) GetById(ctx context.Context, id uuid.UUID) (*pricing.Zone, error) {
+	z, err := scanZone(r.conn(ctx).QueryRowContext(ctx, QueryGetZoneById, id))
+	if err != nil {
+		log.Printf("[repository:zone][GetById] error reading zone '%s': %v", id, err)
+		return nil, err
+	}
+
+	return z, nil
+}
+
+func (r *ZoneRepository) Create(ctx context.Context, z *pricing.Zone) (*pricing.Zone, error) {
+	var boundary any
+	if b := z.Boundary(); b != nil {
+		data, err := json.Marshal(b)
+		if err != nil {
+			log.Printf("[repository:zone][Create] error encoding boundary of zone '%s': %v", z.Name(), err)
+			return nil, fmt.Errorf(got, ErrConcatenatingZone, err)
+		}
+		boundary = data
+	}
+
+	center, surcharge := z.Center(), z.Surcharge()
+	created, err := scanZone(r.conn(ctx).QueryRowContext(
+		ctx, QueryCreateZone,
+		z.Id(), z.Name(), center.Latitude(), center.Longitude(), z.Radius(), surcharge.Amount(), string(surcharge.Currency()), boundary,
+	))
+	if err != nil {
+		log.Printf("[repository:zone][Create] error executing SQL query '%s': %v", QueryCreateZone, err)
+		return nil, err
+	}
+
+	return created, nil
+}
+
+func (r *ZoneRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	res, err := r.conn(ctx).ExecContext(ctx, QueryDeleteZone, id)
+	if err != nil {
+		log.Printf("[repository:zone][Delete] error executing SQL query '%s': %v", QueryDeleteZone, err)
+		return fmt.Errorf(got, ErrQueryZone, err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf(got, ErrQueryZone, err)
+	}
+
+	if affected == 0 {
+		return fmt.Errorf("%w: %s", pricing.ErrNotFoundZone, id)
+	}
+
+	return nil
+}
+
+func (r *ZoneRepository) conn(ctx context.Context) persistence.DBTX {
+	return persistence.Executor(ctx, r.DB)
+}
+
+func scanZone(row rowScanner) (*pricing.Zone, error) {
+	var (
+		id                   uuid.UUID
+		name, currency       string
+		latitude, longitude  float64
+		radius, surcharge    int
+		boundary             []byte
+		createdAt, updatedAt time.Time
+		deletedAt            *time.Time
+	)
+
+	err := row.Scan(&id, &name, &latitude, &longitude, &radius, &surcharge, &currency, &boundary, &createdAt, &updatedAt, &deletedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf(got, pricing.ErrNotFoundZone, err)
+	} else if err != nil {
+		return nil, fmt.Errorf(got, ErrScanZone, err)
+	}
+
+	z, err := pricing.NewZoneFromDb(id, name, latitude, longitude, radius, surcharge, currency, boundary, createdAt, updatedAt, deletedAt)
+	if err != nil {
+		return nil, fmt.Errorf(got, ErrConcatenatingZone, err)
+	}
+
+	return z, nil
+}
+
+func NewZoneRepository(db *sql.DB) pricing.ZoneRepository {
+	return &ZoneRepository{DB: db}
+}
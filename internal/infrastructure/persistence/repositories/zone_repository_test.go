@@ -0,0 +1,158 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/pricing"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"regexp"
+	"testing"
+	"time"
+)
+
+var zoneColumns = []string{"id", "name", "latitude", "longitude", "radius", "surcharge", "currency", "boundary", "created_at", "updated_at", "deleted_at"}
+
+func TestZoneRepository_GetAll(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewZoneRepository(db)
+	now := time.Now()
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetAllZones)).
+		WillReturnRows(sqlmock.NewRows(zoneColumns).
+			AddRow(uuid.New(), "Downtown", -17.7863, -63.1812, 1000, 200, "BOB", nil, now, now, nil).
+			AddRow(uuid.New(), "Urubo", -17.7500, -63.2500, 3000, 500, "BOB", nil, now, now, nil))
+
+	list, err := repo.GetAll(context.Background())
+
+	assert.NoError(t, err)
+	assert.Len(t, list, 2)
+	assert.Equal(t, "Downtown", list[0].Name())
+	assert.Equal(t, 200, list[0].Surcharge().Amount())
+	assert.Equal(t, 3000, list[1].Radius())
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetAllZones)).
+		WillReturnRows(sqlmock.NewRows(zoneColumns).AddRow(uuid.New(), "Downtown", -17.7863, -63.1812, 1000, 200, "EUR", nil, now, now, nil))
+
+	list, err = repo.GetAll(context.Background())
+
+	assert.Nil(t, list)
+	assert.ErrorIs(t, err, ErrConcatenatingZone)
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetAllZones)).WillReturnError(ErrDatabaseAdministrator)
+
+	list, err = repo.GetAll(context.Background())
+
+	assert.Nil(t, list)
+	assert.ErrorIs(t, err, ErrQueryZone)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestZoneRepository_GetById(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewZoneRepository(db)
+	id, now := uuid.New(), time.Now()
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetZoneById)).
+		WithArgs(id).
+		WillReturnRows(sqlmock.NewRows(zoneColumns).AddRow(id, "Downtown", -17.7863, -63.1812, 1000, 200, "BOB", nil, now, now, nil))
+
+	z, err := repo.GetById(context.Background(), id)
+
+	assert.NoError(t, err)
+	assert.Equal(t, id, z.Id())
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryGetZoneById)).WithArgs(id).WillReturnError(sql.ErrNoRows)
+
+	z, err = repo.GetById(context.Background(), id)
+
+	assert.Nil(t, z)
+	assert.ErrorIs(t, err, pricing.ErrNotFoundZone)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestZoneRepository_Create(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewZoneRepository(db)
+	now := time.Now()
+	center, err := valueobjects.NewCoordinates(-17.7863, -63.1812)
+	assert.NoError(t, err)
+	surcharge, err := valueobjects.NewMoney(200, valueobjects.BOB)
+	assert.NoError(t, err)
+	z := pricing.NewZone("Downtown", center, 1000, surcharge)
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryCreateZone)).
+		WithArgs(z.Id(), "Downtown", -17.7863, -63.1812, 1000, 200, "BOB", nil).
+		WillReturnRows(sqlmock.NewRows(zoneColumns).AddRow(z.Id(), "Downtown", -17.7863, -63.1812, 1000, 200, "BOB", nil, now, now, nil))
+
+	created, err := repo.Create(context.Background(), z)
+
+	assert.NoError(t, err)
+	assert.Equal(t, z.Id(), created.Id())
+	assert.Equal(t, now, created.CreatedAt())
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryCreateZone)).WillReturnError(ErrDatabaseAdministrator)
+
+	created, err = repo.Create(context.Background(), z)
+
+	assert.Nil(t, created)
+	assert.ErrorIs(t, err, ErrScanZone)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestZoneRepository_Create_Boundary(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewZoneRepository(db)
+	now := time.Now()
+	boundary := []byte(`{"type":"Polygon","coordinates":[[[-63.19,-17.79],[-63.17,-17.79],[-63.17,-17.77],[-63.19,-17.77],[-63.19,-17.79]]]}`)
+	polygon, err := valueobjects.ParsePolygon(boundary)
+	assert.NoError(t, err)
+	free, err := valueobjects.NewMoney(0, valueobjects.BOB)
+	assert.NoError(t, err)
+	z := pricing.NewBoundedZone("Downtown", polygon, free)
+	center := z.Center()
+
+	mock.ExpectQuery(regexp.QuoteMeta(QueryCreateZone)).
+		WithArgs(z.Id(), "Downtown", center.Latitude(), center.Longitude(), z.Radius(), 0, "BOB", boundary).
+		WillReturnRows(sqlmock.NewRows(zoneColumns).AddRow(z.Id(), "Downtown", center.Latitude(), center.Longitude(), z.Radius(), 0, "BOB", boundary, now, now, nil))
+
+	created, err := repo.Create(context.Background(), z)
+
+	assert.NoError(t, err)
+	assert.Equal(t, z.Boundary(), created.Boundary())
+	assert.True(t, created.Covers(center))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestZoneRepository_Delete(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewZoneRepository(db)
+	id := uuid.New()
+
+	mock.ExpectExec(regexp.QuoteMeta(QueryDeleteZone)).WithArgs(id).WillReturnResult(sqlmock.NewResult(0, 1))
+	assert.NoError(t, repo.Delete(context.Background(), id))
+
+	mock.ExpectExec(regexp.QuoteMeta(QueryDeleteZone)).WithArgs(id).WillReturnResult(sqlmock.NewResult(0, 0))
+	assert.ErrorIs(t, repo.Delete(context.Background(), id), pricing.ErrNotFoundZone)
+
+	mock.ExpectExec(regexp.QuoteMeta(QueryDeleteZone)).WithArgs(id).WillReturnError(ErrDatabaseAdministrator)
+	assert.ErrorIs(t, repo.Delete(context.Background(), id), ErrQueryZone)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,144 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+var ErrUnitOfWork = errors.New("unit of work failed")
+
+func TestUnitOfWork_Do_Commit(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	uow := NewUnitOfWork(db)
+
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE contract").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	err = uow.Do(context.Background(), func(ctx context.Context) error {
+		exec := Executor(ctx, db)
+		_, isTx := exec.(*sql.Tx)
+		assert.True(t, isTx)
+
+		_, err := exec.ExecContext(ctx, "UPDATE contract SET status = 'A'")
+		return err
+	})
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUnitOfWork_Do_Rollback(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	uow := NewUnitOfWork(db)
+
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE contract").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectRollback()
+
+	err = uow.Do(context.Background(), func(ctx context.Context) error {
+		if _, err := Executor(ctx, db).ExecContext(ctx, "UPDATE contract SET status = 'A'"); err != nil {
+			return err
+		}
+		return ErrUnitOfWork
+	})
+
+	assert.ErrorIs(t, err, ErrUnitOfWork)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUnitOfWork_Do_RollbackOnPanic(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	uow := NewUnitOfWork(db)
+
+	mock.ExpectBegin()
+	mock.ExpectRollback()
+
+	assert.Panics(t, func() {
+		_ = uow.Do(context.Background(), func(ctx context.Context) error {
+			panic("boom")
+		})
+	})
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUnitOfWork_Do_BeginError(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	uow := NewUnitOfWork(db)
+
+	mock.ExpectBegin().WillReturnError(ErrUnitOfWork)
+
+	called := false
+	err = uow.Do(context.Background(), func(ctx context.Context) error {
+		called = true
+		return nil
+	})
+
+	assert.ErrorIs(t, err, ErrUnitOfWork)
+	assert.False(t, called)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUnitOfWork_Do_CommitError(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	uow := NewUnitOfWork(db)
+
+	mock.ExpectBegin()
+	mock.ExpectCommit().WillReturnError(ErrUnitOfWork)
+
+	err = uow.Do(context.Background(), func(ctx context.Context) error {
+		return nil
+	})
+
+	assert.ErrorIs(t, err, ErrUnitOfWork)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUnitOfWork_Do_Nested(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	uow := NewUnitOfWork(db)
+
+	mock.ExpectBegin()
+	mock.ExpectRollback()
+
+	err = uow.Do(context.Background(), func(ctx context.Context) error {
+		outer := Executor(ctx, db)
+		return uow.Do(ctx, func(ctx context.Context) error {
+			assert.Same(t, outer, Executor(ctx, db))
+			return ErrUnitOfWork
+		})
+	})
+
+	assert.ErrorIs(t, err, ErrUnitOfWork)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestExecutor_WithoutTransaction(t *testing.T) {
+	db, _, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	assert.Same(t, db, Executor(context.Background(), db))
+}
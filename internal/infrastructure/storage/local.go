@@ -0,0 +1,110 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/abstractions"
+	"io"
+	"io/fs"
+	"log"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+const DefaultBlobDir = "data/blobs"
+
+type LocalBlobStore struct {
+	root string
+}
+
+func NewLocalBlobStore(root string) *LocalBlobStore {
+	return &LocalBlobStore{root: root}
+}
+
+// Put writes the blob to a temporary file first and moves it in place, so a
+// failed upload never leaves a half-written blob under key.
+func (s *LocalBlobStore) Put(_ context.Context, key string, r io.Reader) error {
+	name, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err = os.MkdirAll(filepath.Dir(name), 0o750); err != nil {
+		log.Printf("[storage:local][Put] error creating directory for '%s': %v", key, err)
+		return fmt.Errorf("create blob directory: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(name), ".upload-*")
+	if err != nil {
+		log.Printf("[storage:local][Put] error creating '%s': %v", key, err)
+		return fmt.Errorf("create blob: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err = io.Copy(tmp, r); err != nil {
+		tmp.Close()
+		log.Printf("[storage:local][Put] error writing '%s': %v", key, err)
+		return fmt.Errorf("write blob: %w", err)
+	}
+	if err = tmp.Close(); err != nil {
+		return fmt.Errorf("write blob: %w", err)
+	}
+
+	if err = os.Rename(tmp.Name(), name); err != nil {
+		log.Printf("[storage:local][Put] error storing '%s': %v", key, err)
+		return fmt.Errorf("store blob: %w", err)
+	}
+
+	log.Printf("[storage:local][Put] blob '%s' stored", key)
+	return nil
+}
+
+func (s *LocalBlobStore) Get(_ context.Context, key string) (io.ReadCloser, error) {
+	name, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := os.Open(name)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("%w: %s", abstractions.ErrNotFoundBlob, key)
+	} else if err != nil {
+		log.Printf("[storage:local][Get] error opening '%s': %v", key, err)
+		return nil, fmt.Errorf("open blob: %w", err)
+	}
+
+	return f, nil
+}
+
+func (s *LocalBlobStore) Delete(_ context.Context, key string) error {
+	name, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err = os.Remove(name); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		log.Printf("[storage:local][Delete] error removing '%s': %v", key, err)
+		return fmt.Errorf("remove blob: %w", err)
+	}
+
+	return nil
+}
+
+func (s *LocalBlobStore) path(key string) (string, error) {
+	clean := path.Clean(key)
+	if key == "" || clean != key || path.IsAbs(clean) || clean == ".." || strings.HasPrefix(clean, "../") {
+		return "", fmt.Errorf("%w: got %q", abstractions.ErrKeyBlob, key)
+	}
+	return filepath.Join(s.root, filepath.FromSlash(clean)), nil
+}
+
+func LoadBlobStore() abstractions.BlobStore {
+	root := strings.TrimSpace(os.Getenv("BLOB_STORE_DIR"))
+	if root == "" {
+		root = DefaultBlobDir
+	}
+	return NewLocalBlobStore(root)
+}
@@ -0,0 +1,73 @@
+package storage
+
+import (
+	"context"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/abstractions"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestLocalBlobStore(t *testing.T) {
+	root := t.TempDir()
+	s := NewLocalBlobStore(root)
+	ctx := context.Background()
+
+	assert.NoError(t, s.Put(ctx, "deliveries/1/photo.jpg", strings.NewReader("first")))
+	assert.NoError(t, s.Put(ctx, "deliveries/1/photo.jpg", strings.NewReader("second")))
+
+	raw, err := os.ReadFile(filepath.Join(root, "deliveries", "1", "photo.jpg"))
+	assert.NoError(t, err)
+	assert.Equal(t, "second", string(raw))
+
+	r, err := s.Get(ctx, "deliveries/1/photo.jpg")
+	assert.NoError(t, err)
+	content, err := io.ReadAll(r)
+	assert.NoError(t, err)
+	assert.NoError(t, r.Close())
+	assert.Equal(t, "second", string(content))
+
+	entries, err := os.ReadDir(filepath.Join(root, "deliveries", "1"))
+	assert.NoError(t, err)
+	assert.Len(t, entries, 1)
+
+	assert.NoError(t, s.Delete(ctx, "deliveries/1/photo.jpg"))
+	assert.NoError(t, s.Delete(ctx, "deliveries/1/photo.jpg"))
+
+	_, err = s.Get(ctx, "deliveries/1/photo.jpg")
+	assert.ErrorIs(t, err, abstractions.ErrNotFoundBlob)
+}
+
+func TestLocalBlobStore_InvalidKeys(t *testing.T) {
+	s := NewLocalBlobStore(t.TempDir())
+	ctx := context.Background()
+
+	for _, key := range []string{"", "/etc/passwd", "../outside", "a/../../outside", "a//b", "a/./b", ".."} {
+		t.Run(key, func(t *testing.T) {
+			assert.ErrorIs(t, s.Put(ctx, key, strings.NewReader("x")), abstractions.ErrKeyBlob)
+
+			_, err := s.Get(ctx, key)
+			assert.ErrorIs(t, err, abstractions.ErrKeyBlob)
+			assert.ErrorIs(t, s.Delete(ctx, key), abstractions.ErrKeyBlob)
+		})
+	}
+}
+
+func TestLocalBlobStore_PutError(t *testing.T) {
+	root := t.TempDir()
+	assert.NoError(t, os.WriteFile(filepath.Join(root, "file"), nil, 0o600))
+	s := NewLocalBlobStore(root)
+
+	assert.Error(t, s.Put(context.Background(), "file/photo.jpg", strings.NewReader("x")))
+}
+
+func TestLoadBlobStore(t *testing.T) {
+	t.Setenv("BLOB_STORE_DIR", "")
+	assert.Equal(t, &LocalBlobStore{root: DefaultBlobDir}, LoadBlobStore())
+
+	t.Setenv("BLOB_STORE_DIR", "/var/lib/nutricenter")
+	assert.Equal(t, &LocalBlobStore{root: "/var/lib/nutricenter"}, LoadBlobStore())
+}
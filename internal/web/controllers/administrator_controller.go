@@ -3,14 +3,21 @@ package controllers
 import (
 	"database/sql"
 	"encoding/json"
+	"errors"
 	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/administrator/commands"
 	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/administrator/dto"
 	command "github.com/carlosclavijo/Nutricenter-Contracting/internal/application/administrator/handlers"
 	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/administrator/queries"
+	lockoutCommands "github.com/carlosclavijo/Nutricenter-Contracting/internal/application/lockout/commands"
+	lockout "github.com/carlosclavijo/Nutricenter-Contracting/internal/application/lockout/handlers"
 	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/administrator"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/lockout"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/token"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/infrastructure/auth"
 	query "github.com/carlosclavijo/Nutricenter-Contracting/internal/infrastructure/handlers/administrator"
 	"github.com/carlosclavijo/Nutricenter-Contracting/internal/infrastructure/persistence/repositories"
 	"github.com/carlosclavijo/Nutricenter-Contracting/internal/web/helpers"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/web/middleware"
 	"github.com/go-chi/chi/v5"
 	"github.com/google/uuid"
 	"log"
@@ -21,26 +28,51 @@ import (
 type AdministratorController struct {
 	cmdHandler command.AdministratorHandler
 	qryHandler query.AdministratorHandler
+	lockout    lockout.LockoutHandler
+	auth       *auth.Service
 }
 
-func NewAdministratorController(db *sql.DB) *AdministratorController {
+func NewAdministratorController(db *sql.DB, a *auth.Service) *AdministratorController {
 	repo := repositories.NewAdministratorRepository(db)
 	factory := administrators.NewAdministratorFactory()
 	cmdHandler := command.NewAdministratorHandler(repo, factory)
 	qryHandler := query.NewAdministratorHandler(repo, factory)
-	return &AdministratorController{*cmdHandler, *qryHandler}
+	return &AdministratorController{*cmdHandler, *qryHandler, *newLockoutHandler(db), a}
 }
 
 func (h *AdministratorController) GetAllAdministrators(w http.ResponseWriter, r *http.Request) {
-	qry := queries.GetAllAdministratorsQuery{}
-	admins, err := h.qryHandler.HandleGetAll(r.Context(), qry)
+	pagination, err := parsePagination(r)
+	if err != nil {
+		log.Printf("[controller:administrator][GetAllAdministrators] invalid query parameters: %v", err)
+		writeInvalidQuery(w, err)
+		return
+	}
+
+	deleted, err := parseBoolParam(r, "deleted")
+	if err != nil {
+		log.Printf("[controller:administrator][GetAllAdministrators] invalid query parameters: %v", err)
+		writeInvalidQuery(w, err)
+		return
+	}
+
+	qry := queries.GetAllAdministratorsQuery{
+		Name:       r.URL.Query().Get("name"),
+		Gender:     r.URL.Query().Get("gender"),
+		Deleted:    deleted,
+		Pagination: pagination,
+	}
+	page, err := h.qryHandler.HandleGetAll(r.Context(), qry)
 
 	if err != nil {
 		log.Printf("[controller:administrator][GetAllAdministrators] failed to fetch administrators: %v", err)
-		writeJSON(w, http.StatusInternalServerError, helpers.Response[any]{
+		status, code := listStatus(err)
+		if code == "" {
+			code = "GET_ALL_FAILED"
+		}
+		writeJSON(w, status, helpers.Response[any]{
 			Success: false,
 			Error: &helpers.Error{
-				Code:    "GET_ALL_FAILED",
+				Code:    code,
 				Message: "Could not fetch administrators",
 			},
 		})
@@ -49,21 +81,37 @@ func (h *AdministratorController) GetAllAdministrators(w http.ResponseWriter, r
 
 	writeJSON(w, http.StatusOK, helpers.Response[[]*dto.AdministratorDTO]{
 		Success: true,
-		Data:    admins,
-		Length:  len(admins),
+		Data:    page.Items,
+		Length:  len(page.Items),
+		Meta:    pageMeta(page),
 	})
 }
 
 func (h *AdministratorController) GetListAdministrators(w http.ResponseWriter, r *http.Request) {
-	qry := queries.GetListAdministratorsQuery{}
-	admins, err := h.qryHandler.HandleGetList(r.Context(), qry)
+	pagination, err := parsePagination(r)
+	if err != nil {
+		log.Printf("[controller:administrator][GetListAdministrators] invalid query parameters: %v", err)
+		writeInvalidQuery(w, err)
+		return
+	}
+
+	qry := queries.GetListAdministratorsQuery{
+		Name:       r.URL.Query().Get("name"),
+		Gender:     r.URL.Query().Get("gender"),
+		Pagination: pagination,
+	}
+	page, err := h.qryHandler.HandleGetList(r.Context(), qry)
 
 	if err != nil {
 		log.Printf("[controller:administrator][GetListAdministrators] failed to fetch administrators: %v", err)
-		writeJSON(w, http.StatusInternalServerError, helpers.Response[any]{
+		status, code := listStatus(err)
+		if code == "" {
+			code = "GET_LIST_FAILED"
+		}
+		writeJSON(w, status, helpers.Response[any]{
 			Success: false,
 			Error: &helpers.Error{
-				Code:    "GET_LIST_FAILED",
+				Code:    code,
 				Message: "Could not fetch administrators",
 			},
 		})
@@ -72,8 +120,9 @@ func (h *AdministratorController) GetListAdministrators(w http.ResponseWriter, r
 
 	writeJSON(w, http.StatusOK, helpers.Response[[]*dto.AdministratorDTO]{
 		Success: true,
-		Data:    admins,
-		Length:  len(admins),
+		Data:    page.Items,
+		Length:  len(page.Items),
+		Meta:    pageMeta(page),
 	})
 }
 
@@ -216,8 +265,36 @@ func (h *AdministratorController) LoginAdministrator(w http.ResponseWriter, r *h
 		Password: req.Password,
 	}
 
+	attempt := lockoutCommands.LoginAttemptCommand{
+		Role:  tokens.Administrator,
+		Email: req.Email,
+		IP:    clientIP(r),
+	}
+
+	wait, err := h.lockout.HandleCheck(r.Context(), attempt)
+	if errors.Is(err, lockouts.ErrLockedOut) {
+		writeTooManyAttempts(w, wait)
+		return
+	} else if err != nil {
+		log.Printf("[controller:administrator][Login] failed to check lockout of '%s': %v", req.Email, err)
+		writeJSON(w, http.StatusInternalServerError, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "LOGIN_FAILED",
+				Message: "Could not verify login attempts",
+			},
+		})
+		return
+	}
+
 	admin, err := h.cmdHandler.HandleLogin(r.Context(), qry)
-	if err != nil {
+	if errors.Is(err, administrators.ErrInvalidCredentialsAdministrator) {
+		if err = h.lockout.HandleFailure(r.Context(), attempt); err != nil {
+			log.Printf("[controller:administrator][Login] failed to record failed login of '%s': %v", req.Email, err)
+		}
+		writeInvalidCredentials(w)
+		return
+	} else if err != nil {
 		log.Printf("[controller:administrator][Login] failed to retrieve administrator with Email '%s': %v", req.Email, err)
 		writeJSON(w, http.StatusBadRequest, helpers.Response[any]{
 			Success: false,
@@ -229,9 +306,60 @@ func (h *AdministratorController) LoginAdministrator(w http.ResponseWriter, r *h
 		return
 	}
 
-	writeJSON(w, http.StatusOK, helpers.Response[dto.AdministratorResponse]{
+	if err = h.lockout.HandleSuccess(r.Context(), attempt); err != nil {
+		log.Printf("[controller:administrator][Login] failed to clear failed logins of '%s': %v", req.Email, err)
+	}
+
+	subjectId, err := uuid.Parse(admin.Id)
+	if err != nil {
+		log.Printf("[controller:administrator][Login] invalid administrator id '%s': %v", admin.Id, err)
+		writeJSON(w, http.StatusInternalServerError, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "LOGIN_FAILED",
+				Message: "Could not issue tokens",
+			},
+		})
+		return
+	}
+
+	super, err := h.qryHandler.HandleIsSuper(r.Context(), queries.IsSuperAdministratorQuery{Id: subjectId})
+	if err != nil {
+		log.Printf("[controller:administrator][Login] failed to resolve role of '%s': %v", subjectId, err)
+		writeJSON(w, http.StatusInternalServerError, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "LOGIN_FAILED",
+				Message: "Could not issue tokens",
+			},
+		})
+		return
+	}
+
+	role := tokens.Administrator
+	if super {
+		role = tokens.SuperAdministrator
+	}
+
+	pair, err := h.auth.Issue(r.Context(), subjectId, role)
+	if err != nil {
+		log.Printf("[controller:administrator][Login] failed to issue tokens for '%s': %v", subjectId, err)
+		writeJSON(w, http.StatusInternalServerError, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "LOGIN_FAILED",
+				Message: "Could not issue tokens",
+			},
+		})
+		return
+	}
+
+	writeJSON(w, http.StatusOK, helpers.Response[loginResponse[dto.AdministratorResponse]]{
 		Success: true,
-		Data:    *admin,
+		Data: loginResponse[dto.AdministratorResponse]{
+			User:   *admin,
+			Tokens: pair,
+		},
 	})
 }
 
@@ -293,7 +421,6 @@ func (h *AdministratorController) UpdateAdministrator(w http.ResponseWriter, r *
 		FirstName string    `json:"first_name,omitempty"`
 		LastName  string    `json:"last_name,omitempty"`
 		Email     string    `json:"email,omitempty"`
-		Password  string    `json:"password,omitempty"`
 		Gender    string    `json:"gender,omitempty"`
 		Birth     time.Time `json:"birth,omitempty"`
 		Phone     *string   `json:"phone,omitempty"`
@@ -329,7 +456,7 @@ func (h *AdministratorController) UpdateAdministrator(w http.ResponseWriter, r *
 		FirstName: req.FirstName,
 		LastName:  req.LastName,
 		Email:     req.Email,
-		Password:  req.Password,
+		Gender:    req.Gender,
 		Birth:     req.Birth,
 		Phone:     req.Phone,
 	}
@@ -494,7 +621,13 @@ func writeJSON(w http.ResponseWriter, status int, payload any) {
 	}
 }
 
+func (h *AdministratorController) RegisterPublicRoutes(r chi.Router) {
+	r.Post("/login", h.LoginAdministrator)
+}
+
 func (h *AdministratorController) RegisterRoutes(r chi.Router) {
+	r.Use(middleware.Allow(middleware.Administrators))
+
 	r.Get("/all", h.GetAllAdministrators)
 	r.Get("/list", h.GetListAdministrators)
 	r.Get("/email/{email}", h.GetAdministratorByEmail)
@@ -505,8 +638,7 @@ func (h *AdministratorController) RegisterRoutes(r chi.Router) {
 	r.Get("/count/deleted", h.CountDeletedAdministrators)
 	r.Get("/{id}", h.GetAdministratorById)
 	r.Post("/", h.CreateAdministrator)
-	r.Post("/login", h.LoginAdministrator)
 	r.Put("/", h.UpdateAdministrator)
-	r.Patch("/", h.RestoreAdministrator)
+	r.Patch("/{id}", h.RestoreAdministrator)
 	r.Delete("/{id}", h.DeleteAdministrator)
 }
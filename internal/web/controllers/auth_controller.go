@@ -0,0 +1,301 @@
+package controllers
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/password/commands"
+	password "github.com/carlosclavijo/Nutricenter-Contracting/internal/application/password/handlers"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/abstractions"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/token"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/infrastructure/auth"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/infrastructure/persistence"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/infrastructure/persistence/repositories"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/web/helpers"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/web/middleware"
+	"github.com/go-chi/chi/v5"
+	"log"
+	"net/http"
+)
+
+type loginResponse[T any] struct {
+	User   T               `json:"user"`
+	Tokens *auth.TokenPair `json:"tokens"`
+}
+
+type AuthController struct {
+	service  *auth.Service
+	password password.PasswordHandler
+}
+
+func NewAuthController(db *sql.DB, s *auth.Service, n abstractions.Notifier) *AuthController {
+	handler := password.NewPasswordHandler(
+		repositories.NewAdministratorRepository(db),
+		repositories.NewPatientRepository(db),
+		repositories.NewResetTokenRepository(db),
+		repositories.NewTokenRepository(db),
+		n,
+		persistence.NewUnitOfWork(db),
+		password.DefaultResetTTL,
+	)
+	return &AuthController{service: s, password: *handler}
+}
+
+func (h *AuthController) Refresh(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		RefreshToken string `json:"refresh_token"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.RefreshToken == "" {
+		log.Printf("[controller:auth][Refresh] failed to decode request body: %v", err)
+		writeJSON(w, http.StatusBadRequest, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "INVALID_REQUEST_BODY",
+				Message: "A refresh_token is required",
+			},
+		})
+		return
+	}
+
+	pair, err := h.service.Refresh(r.Context(), req.RefreshToken)
+	if err != nil {
+		log.Printf("[controller:auth][Refresh] failed to refresh tokens: %v", err)
+		status, code := http.StatusInternalServerError, "REFRESH_FAILED"
+		if errors.Is(err, auth.ErrInvalidTokenAuth) || errors.Is(err, auth.ErrWrongKindAuth) {
+			status, code = http.StatusUnauthorized, "INVALID_TOKEN"
+		}
+		writeJSON(w, status, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    code,
+				Message: "Could not refresh tokens",
+			},
+		})
+		return
+	}
+
+	writeJSON(w, http.StatusOK, helpers.Response[*auth.TokenPair]{
+		Success: true,
+		Data:    pair,
+	})
+}
+
+func (h *AuthController) Logout(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		RefreshToken string `json:"refresh_token"`
+		All          bool   `json:"all"`
+	}
+
+	if r.ContentLength != 0 {
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			log.Printf("[controller:auth][Logout] failed to decode request body: %v", err)
+			writeJSON(w, http.StatusBadRequest, helpers.Response[any]{
+				Success: false,
+				Error: &helpers.Error{
+					Code:    "INVALID_REQUEST_BODY",
+					Message: "Invalid JSON format or fields",
+				},
+			})
+			return
+		}
+	}
+
+	principal, _ := middleware.PrincipalFromContext(r.Context())
+
+	var err error
+	if req.All {
+		_, err = h.service.LogoutAll(r.Context(), principal)
+	} else {
+		err = h.service.Logout(r.Context(), principal, req.RefreshToken)
+	}
+
+	if err != nil {
+		log.Printf("[controller:auth][Logout] failed to revoke tokens of '%s': %v", principal.SubjectId, err)
+		status, code := http.StatusInternalServerError, "LOGOUT_FAILED"
+		if errors.Is(err, auth.ErrInvalidTokenAuth) || errors.Is(err, auth.ErrWrongKindAuth) || errors.Is(err, auth.ErrSubjectAuth) {
+			status, code = http.StatusBadRequest, "INVALID_TOKEN"
+		}
+		writeJSON(w, status, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    code,
+				Message: "Could not revoke tokens",
+			},
+		})
+		return
+	}
+
+	writeJSON(w, http.StatusOK, helpers.Response[any]{
+		Success: true,
+	})
+}
+
+func (h *AuthController) ChangePassword(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		OldPassword string `json:"old_password"`
+		NewPassword string `json:"new_password"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		log.Printf("[controller:auth][ChangePassword] failed to decode request body: %v", err)
+		writeJSON(w, http.StatusBadRequest, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "INVALID_REQUEST_BODY",
+				Message: "Invalid JSON format or fields",
+			},
+		})
+		return
+	}
+
+	principal, _ := middleware.PrincipalFromContext(r.Context())
+
+	cmd := commands.ChangePasswordCommand{
+		SubjectId:   principal.SubjectId,
+		Role:        principal.Role,
+		OldPassword: req.OldPassword,
+		NewPassword: req.NewPassword,
+	}
+
+	if err := h.password.HandleChangePassword(r.Context(), cmd); err != nil {
+		log.Printf("[controller:auth][ChangePassword] failed to change password of '%s': %v", principal.SubjectId, err)
+		status, code := passwordStatus(err)
+		writeJSON(w, status, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    code,
+				Message: "Could not change password",
+			},
+		})
+		return
+	}
+
+	writeJSON(w, http.StatusOK, helpers.Response[any]{
+		Success: true,
+	})
+}
+
+func (h *AuthController) ForgotPassword(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		Email string `json:"email"`
+		Role  string `json:"role"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		log.Printf("[controller:auth][ForgotPassword] failed to decode request body: %v", err)
+		writeJSON(w, http.StatusBadRequest, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "INVALID_REQUEST_BODY",
+				Message: "Invalid JSON format or fields",
+			},
+		})
+		return
+	}
+
+	role, err := tokens.ParseRole(req.Role)
+	if err != nil {
+		log.Printf("[controller:auth][ForgotPassword] invalid role '%s': %v", req.Role, err)
+		writeJSON(w, http.StatusBadRequest, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "INVALID_ROLE",
+				Message: "Role must be 'administrator' or 'patient'",
+			},
+		})
+		return
+	}
+
+	cmd := commands.ForgotPasswordCommand{
+		Role:  role,
+		Email: req.Email,
+	}
+
+	if err = h.password.HandleForgotPassword(r.Context(), cmd); err != nil {
+		log.Printf("[controller:auth][ForgotPassword] failed to issue reset token: %v", err)
+		status, code := passwordStatus(err)
+		writeJSON(w, status, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    code,
+				Message: "Could not start password reset",
+			},
+		})
+		return
+	}
+
+	writeJSON(w, http.StatusAccepted, helpers.Response[any]{
+		Success: true,
+	})
+}
+
+func (h *AuthController) ResetPassword(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		Token    string `json:"token"`
+		Password string `json:"password"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		log.Printf("[controller:auth][ResetPassword] failed to decode request body: %v", err)
+		writeJSON(w, http.StatusBadRequest, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "INVALID_REQUEST_BODY",
+				Message: "Invalid JSON format or fields",
+			},
+		})
+		return
+	}
+
+	cmd := commands.ResetPasswordCommand{
+		Token:       req.Token,
+		NewPassword: req.Password,
+	}
+
+	if err := h.password.HandleResetPassword(r.Context(), cmd); err != nil {
+		log.Printf("[controller:auth][ResetPassword] failed to reset password: %v", err)
+		status, code := passwordStatus(err)
+		writeJSON(w, status, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    code,
+				Message: "Could not reset password",
+			},
+		})
+		return
+	}
+
+	writeJSON(w, http.StatusOK, helpers.Response[any]{
+		Success: true,
+	})
+}
+
+func passwordStatus(err error) (int, string) {
+	switch {
+	case errors.Is(err, password.ErrWrongPassword):
+		return http.StatusBadRequest, "WRONG_PASSWORD"
+	case errors.Is(err, password.ErrSamePassword):
+		return http.StatusBadRequest, "SAME_PASSWORD"
+	case errors.Is(err, password.ErrInvalidResetToken):
+		return http.StatusBadRequest, "INVALID_RESET_TOKEN"
+	case errors.Is(err, valueobjects.ErrEmptyPassword), errors.Is(err, valueobjects.ErrShortPassword),
+		errors.Is(err, valueobjects.ErrLongPassword), errors.Is(err, valueobjects.ErrSoftPassword):
+		return http.StatusBadRequest, "INVALID_PASSWORD"
+	case errors.Is(err, valueobjects.ErrEmptyEmail), errors.Is(err, valueobjects.ErrInvalidEmail), errors.Is(err, valueobjects.ErrLongEmail):
+		return http.StatusBadRequest, "INVALID_EMAIL"
+	}
+	return http.StatusInternalServerError, "PASSWORD_FAILED"
+}
+
+func (h *AuthController) RegisterPublicRoutes(r chi.Router) {
+	r.Post("/refresh", h.Refresh)
+	r.Post("/password/forgot", h.ForgotPassword)
+	r.Post("/password/reset", h.ResetPassword)
+}
+
+func (h *AuthController) RegisterRoutes(r chi.Router) {
+	r.Post("/logout", h.Logout)
+	r.Put("/password", h.ChangePassword)
+}
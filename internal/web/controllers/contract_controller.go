@@ -1,20 +1,50 @@
 package controllers
 
 import (
+	"bytes"
+	"context"
 	"database/sql"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/contract/commands"
 	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/contract/dto"
 	command "github.com/carlosclavijo/Nutricenter-Contracting/internal/application/contract/handlers"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/contract/mappers"
 	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/contract/queries"
+	proofCommands "github.com/carlosclavijo/Nutricenter-Contracting/internal/application/delivery/commands"
+	proofDto "github.com/carlosclavijo/Nutricenter-Contracting/internal/application/delivery/dto"
+	proofCommand "github.com/carlosclavijo/Nutricenter-Contracting/internal/application/delivery/handlers"
+	proofMappers "github.com/carlosclavijo/Nutricenter-Contracting/internal/application/delivery/mappers"
+	proofQueries "github.com/carlosclavijo/Nutricenter-Contracting/internal/application/delivery/queries"
+	invoiceDto "github.com/carlosclavijo/Nutricenter-Contracting/internal/application/invoice/dto"
+	invoiceQueries "github.com/carlosclavijo/Nutricenter-Contracting/internal/application/invoice/queries"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/abstractions"
 	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/contract"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/delivery"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/invoice"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/plan"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/pricing"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/slot"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/token"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/infrastructure/billing"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/infrastructure/dispatch"
 	query "github.com/carlosclavijo/Nutricenter-Contracting/internal/infrastructure/handlers/contract"
+	proofQuery "github.com/carlosclavijo/Nutricenter-Contracting/internal/infrastructure/handlers/delivery"
+	invoiceQuery "github.com/carlosclavijo/Nutricenter-Contracting/internal/infrastructure/handlers/invoice"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/infrastructure/persistence"
 	"github.com/carlosclavijo/Nutricenter-Contracting/internal/infrastructure/persistence/repositories"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/infrastructure/storage"
 	"github.com/carlosclavijo/Nutricenter-Contracting/internal/web/helpers"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/web/middleware"
 	"github.com/go-chi/chi/v5"
 	"github.com/google/uuid"
+	"io"
 	"log"
+	"mime/multipart"
 	"net/http"
+	"strconv"
 	"time"
 )
 
@@ -24,10 +54,17 @@ type contractFull struct {
 	PatientId       uuid.UUID
 	ContractType    contracts.ContractType
 	ContractStatus  contracts.ContractStatus
+	StatusReason    string
+	SuspendedAt     *time.Time
+	AutoRenew       bool
+	RenewedFrom     *uuid.UUID
 	CreationDate    time.Time
 	StartDate       time.Time
 	EndDate         time.Time
-	CostValue       int
+	CostValue       dto.MoneyDTO
+	Price           *dto.PriceDTO
+	Weekdays        []string
+	SlotId          *uuid.UUID
 	CreatedAt       time.Time
 	UpdatedAt       time.Time
 	DeletedAt       *time.Time
@@ -42,6 +79,7 @@ type deliveryFull struct {
 	Number     int
 	Latitude   float64
 	Longitude  float64
+	SlotId     *uuid.UUID
 	CreatedAt  time.Time
 	UpdatedAt  time.Time
 	DeletedAt  *time.Time
@@ -50,6 +88,10 @@ type deliveryFull struct {
 type ContractController struct {
 	cmdHandler command.ContractHandler
 	qryHandler query.ContractHandler
+	repository contracts.ContractRepository
+	invoices   invoiceQuery.InvoiceHandler
+	proofCmd   proofCommand.ProofHandler
+	proofQry   proofQuery.ProofHandler
 }
 
 func NewContractController(db *sql.DB) *ContractController {
@@ -57,21 +99,65 @@ func NewContractController(db *sql.DB) *ContractController {
 	rAdm := repositories.NewAdministratorRepository(db)
 	rPtn := repositories.NewPatientRepository(db)
 	factory := contracts.NewContractFactory()
-	cmdHandler := command.NewContractHandler(repo, factory)
+	uow := persistence.NewUnitOfWork(db)
+	pricer := pricing.NewPricer(repositories.NewZoneRepository(db), repositories.NewPromoCodeRepository(db), billing.LoadTaxRates()...)
+	booker := slots.NewBooker(repositories.NewSlotRepository(db), repositories.NewZoneRepository(db))
+	rInv := repositories.NewInvoiceRepository(db)
+	invoicer := invoices.NewInvoicer(rInv, invoices.NewInvoiceFactory(), billing.LoadInvoiceSeries(), billing.LoadRequirePayment(), billing.LoadCancellationFee())
+	cmdHandler := command.NewContractHandler(repo, repositories.NewPlanRepository(db), repositories.NewHolidayRepository(db), booker, pricer, invoicer, factory, uow)
 	qryHandler := query.NewContractHandler(repo, rAdm, rPtn, factory)
-	return &ContractController{*cmdHandler, *qryHandler}
+	rPrf := repositories.NewProofRepository(db)
+	blobs := storage.LoadBlobStore()
+	proofCmd := proofCommand.NewProofHandler(repo, rPrf, blobs, dispatch.LoadHandOffTolerance(), uow)
+	proofQry := proofQuery.NewProofHandler(repo, rPrf, blobs)
+	return &ContractController{*cmdHandler, *qryHandler, repo, *invoiceQuery.NewInvoiceHandler(rInv), *proofCmd, *proofQry}
+}
+
+func (h *ContractController) contractParties(ctx context.Context, id uuid.UUID) (uuid.UUID, uuid.UUID, error) {
+	c, err := h.repository.GetById(ctx, id)
+	if errors.Is(err, contracts.ErrNotFoundContract) {
+		return uuid.Nil, uuid.Nil, fmt.Errorf("%w: %w", middleware.ErrNotFoundPolicy, err)
+	} else if err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+	return c.AdministratorId(), c.PatientId(), nil
 }
 
 func (h *ContractController) GetAllContracts(w http.ResponseWriter, r *http.Request) {
-	qry := queries.GetAllContractsQuery{}
-	contractlist, err := h.qryHandler.HandleGetAll(r.Context(), qry)
+	qry, err := parseContractListQuery(r)
+	if err != nil {
+		log.Printf("[controller:contract][GetAllContracts] invalid query parameters: %v", err)
+		writeInvalidQuery(w, err)
+		return
+	}
+
+	if p, ok := middleware.PrincipalFromContext(r.Context()); ok && p.Role == tokens.Patient {
+		if qry.PatientId != nil && *qry.PatientId != p.SubjectId {
+			log.Printf("[controller:contract][GetAllContracts] patient '%s' requested contracts of '%s'", p.SubjectId, *qry.PatientId)
+			writeJSON(w, http.StatusForbidden, helpers.Response[any]{
+				Success: false,
+				Error: &helpers.Error{
+					Code:    "FORBIDDEN",
+					Message: "You are not allowed to access this resource",
+				},
+			})
+			return
+		}
+		qry.PatientId = &p.SubjectId
+	}
+
+	page, err := h.qryHandler.HandleGetAll(r.Context(), qry)
 
 	if err != nil {
 		log.Printf("[controller:contract][GetAllContracts] failed to fetch contract: %v", err)
-		writeJSON(w, http.StatusInternalServerError, helpers.Response[any]{
+		status, code := listStatus(err)
+		if code == "" {
+			code = "GET_ALL_FAILED"
+		}
+		writeJSON(w, status, helpers.Response[any]{
 			Success: false,
 			Error: &helpers.Error{
-				Code:    "GET_ALL_FAILED",
+				Code:    code,
 				Message: "Could not fetch contracts",
 			},
 		})
@@ -80,11 +166,39 @@ func (h *ContractController) GetAllContracts(w http.ResponseWriter, r *http.Requ
 
 	writeJSON(w, http.StatusOK, helpers.Response[[]*dto.ContractDTO]{
 		Success: true,
-		Data:    contractlist,
-		Length:  len(contractlist),
+		Data:    page.Items,
+		Length:  len(page.Items),
+		Meta:    pageMeta(page),
 	})
 }
 
+func parseContractListQuery(r *http.Request) (queries.GetAllContractsQuery, error) {
+	var (
+		qry queries.GetAllContractsQuery
+		err error
+	)
+
+	if qry.Pagination, err = parsePagination(r); err != nil {
+		return qry, err
+	}
+	if qry.PatientId, err = parseUUIDParam(r, "patient_id"); err != nil {
+		return qry, err
+	}
+	if qry.AdministratorId, err = parseUUIDParam(r, "administrator_id"); err != nil {
+		return qry, err
+	}
+	if qry.From, err = parseDateParam(r, "from"); err != nil {
+		return qry, err
+	}
+	if qry.To, err = parseDateParam(r, "to"); err != nil {
+		return qry, err
+	}
+
+	qry.Status = r.URL.Query().Get("status")
+	qry.Type = r.URL.Query().Get("type")
+	return qry, nil
+}
+
 func (h *ContractController) GetContractById(w http.ResponseWriter, r *http.Request) {
 	idStr := chi.URLParam(r, "id")
 	id, err := uuid.Parse(idStr)
@@ -122,15 +236,20 @@ func (h *ContractController) GetContractById(w http.ResponseWriter, r *http.Requ
 
 func (h *ContractController) CreateContract(w http.ResponseWriter, r *http.Request) {
 	var req struct {
-		AdministratorId uuid.UUID `json:"administrator_id"`
-		PatientId       uuid.UUID `json:"patient_id"`
-		ContractType    string    `json:"contract_type"`
-		Start           time.Time `json:"start"`
-		Cost            int       `json:"cost"`
-		Street          string    `json:"street"`
-		Number          int       `json:"number"`
-		Latitude        float64   `json:"latitude"`
-		Longitude       float64   `json:"longitude"`
+		AdministratorId uuid.UUID   `json:"administrator_id"`
+		PatientId       uuid.UUID   `json:"patient_id"`
+		ContractType    string      `json:"contract_type"`
+		Start           time.Time   `json:"start"`
+		Weekdays        []string    `json:"weekdays"`
+		ExcludedDates   []time.Time `json:"excluded_dates"`
+		Cost            int         `json:"cost"`
+		Currency        string      `json:"currency"`
+		PromoCode       string      `json:"promo_code"`
+		Street          string      `json:"street"`
+		Number          int         `json:"number"`
+		Latitude        float64     `json:"latitude"`
+		Longitude       float64     `json:"longitude"`
+		SlotId          *uuid.UUID  `json:"slot_id"`
 	}
 
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
@@ -150,30 +269,69 @@ func (h *ContractController) CreateContract(w http.ResponseWriter, r *http.Reque
 		PatientId:       req.PatientId,
 		ContractType:    req.ContractType,
 		StartDate:       req.Start,
+		Weekdays:        req.Weekdays,
+		ExcludedDates:   req.ExcludedDates,
 		Cost:            req.Cost,
+		Currency:        req.Currency,
+		PromoCode:       req.PromoCode,
 		Street:          req.Street,
 		Number:          req.Number,
 		Latitude:        req.Latitude,
 		Longitude:       req.Longitude,
+		SlotId:          req.SlotId,
 	}
 
 	cntrct, err := h.cmdHandler.HandleCreate(r.Context(), cmd)
 	if err != nil {
 		log.Printf("[controller:contract][CreateContract] failed to create contract with command '%v': %v", cntrct, err)
-		writeJSON(w, http.StatusInternalServerError, helpers.Response[any]{
+		writeContractError(w, err, "CREATE_FAILED", "Could not create contract")
+		return
+	}
+
+	cntFull := mapToContractFull(cntrct)
+	writeJSON(w, http.StatusCreated, helpers.Response[contractFull]{
+		Success: true,
+		Data:    cntFull,
+	})
+}
+
+func (h *ContractController) QuoteContract(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		ContractType string  `json:"contract_type"`
+		Latitude     float64 `json:"latitude"`
+		Longitude    float64 `json:"longitude"`
+		PromoCode    string  `json:"promo_code"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		log.Printf("[controller:contract][QuoteContract] failed to decode request body '%v': %v", req, err)
+		writeJSON(w, http.StatusBadRequest, helpers.Response[any]{
 			Success: false,
 			Error: &helpers.Error{
-				Code:    "CREATE_FAILED",
-				Message: "Could not create contract",
+				Code:    "INVALID_REQUEST_BODY",
+				Message: "Invalid JSON format or fields",
 			},
 		})
 		return
 	}
 
-	cntFull := mapToContractFull(cntrct)
-	writeJSON(w, http.StatusCreated, helpers.Response[contractFull]{
+	qry := queries.QuoteContractQuery{
+		ContractType: req.ContractType,
+		Latitude:     req.Latitude,
+		Longitude:    req.Longitude,
+		PromoCode:    req.PromoCode,
+	}
+
+	price, err := h.cmdHandler.HandleQuote(r.Context(), qry)
+	if err != nil {
+		log.Printf("[controller:contract][QuoteContract] failed to quote contract with query '%v': %v", qry, err)
+		writeContractError(w, err, "QUOTE_FAILED", "Could not quote contract")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, helpers.Response[*dto.PriceDTO]{
 		Success: true,
-		Data:    cntFull,
+		Data:    mappers.MapToPriceDTO(price),
 	})
 }
 
@@ -181,6 +339,7 @@ func (h *ContractController) ChangeStatusContract(w http.ResponseWriter, r *http
 	var req struct {
 		Id     uuid.UUID `json:"id"`
 		Status string    `json:"status"`
+		Reason string    `json:"reason"`
 	}
 
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
@@ -198,25 +357,168 @@ func (h *ContractController) ChangeStatusContract(w http.ResponseWriter, r *http
 	cmd := commands.ChangeStatusContractCommand{
 		Id:     req.Id,
 		Status: req.Status,
+		Reason: req.Reason,
 	}
 
 	cntrct, err := h.cmdHandler.HandleChangeStatus(r.Context(), cmd)
 	if err != nil {
-		log.Printf("[controller:contract][ChangeStatusContract] failed to change contract status with command '%v': %v", cntrct, err)
-		writeJSON(w, http.StatusInternalServerError, helpers.Response[any]{
+		log.Printf("[controller:contract][ChangeStatusContract] failed to change contract status with command '%v': %v", cmd, err)
+		writeContractError(w, err, "CHANGE_STATUS_FAILED", "Could not change contract status")
+		return
+	}
+
+	cntFull := mapToContractFull(cntrct)
+	writeJSON(w, http.StatusCreated, helpers.Response[contractFull]{
+		Success: true,
+		Data:    cntFull,
+	})
+}
+
+func (h *ContractController) RenewContract(w http.ResponseWriter, r *http.Request) {
+	id, ok := parseContractPath(w, r)
+	if !ok {
+		return
+	}
+
+	cntrct, err := h.cmdHandler.HandleRenew(r.Context(), commands.RenewContractCommand{ContractId: id})
+	if err != nil {
+		log.Printf("[controller:contract][RenewContract] failed to renew contract '%s': %v", id, err)
+		writeContractError(w, err, "RENEW_FAILED", "Could not renew contract")
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, helpers.Response[contractFull]{
+		Success: true,
+		Data:    mapToContractFull(cntrct),
+	})
+}
+
+func (h *ContractController) GetContractInvoice(w http.ResponseWriter, r *http.Request) {
+	id, ok := parseContractPath(w, r)
+	if !ok {
+		return
+	}
+
+	invoice, err := h.invoices.HandleGetByContract(r.Context(), invoiceQueries.GetInvoiceByContractQuery{ContractId: id})
+	if err != nil {
+		log.Printf("[controller:contract][GetContractInvoice] failed to fetch invoice of contract '%s': %v", id, err)
+		writeInvoiceError(w, err, "GET_INVOICE_FAILED", "Could not fetch invoice")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, helpers.Response[*invoiceDto.InvoiceDTO]{
+		Success: true,
+		Data:    invoice,
+	})
+}
+
+func (h *ContractController) PreviewRefund(w http.ResponseWriter, r *http.Request) {
+	id, ok := parseContractPath(w, r)
+	if !ok {
+		return
+	}
+
+	refund, err := h.cmdHandler.HandleRefundPreview(r.Context(), queries.PreviewRefundQuery{Id: id})
+	if err != nil {
+		log.Printf("[controller:contract][PreviewRefund] failed to work out refund of contract '%s': %v", id, err)
+		writeContractError(w, err, "REFUND_PREVIEW_FAILED", "Could not work out refund")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, helpers.Response[*dto.RefundDTO]{
+		Success: true,
+		Data:    mappers.MapToRefundDTO(refund),
+	})
+}
+
+func (h *ContractController) SetAutoRenew(w http.ResponseWriter, r *http.Request) {
+	id, ok := parseContractPath(w, r)
+	if !ok {
+		return
+	}
+
+	var req struct {
+		Enabled *bool `json:"enabled"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.Enabled == nil {
+		log.Printf("[controller:contract][SetAutoRenew] failed to decode request body '%v': %v", req, err)
+		writeJSON(w, http.StatusBadRequest, helpers.Response[any]{
 			Success: false,
 			Error: &helpers.Error{
-				Code:    "CREATE_FAILED",
-				Message: "Could not create contract",
+				Code:    "INVALID_REQUEST_BODY",
+				Message: "Invalid JSON format or fields",
 			},
 		})
 		return
 	}
 
-	cntFull := mapToContractFull(cntrct)
-	writeJSON(w, http.StatusCreated, helpers.Response[contractFull]{
+	cntrct, err := h.cmdHandler.HandleSetAutoRenew(r.Context(), commands.SetAutoRenewCommand{ContractId: id, Enabled: *req.Enabled})
+	if err != nil {
+		log.Printf("[controller:contract][SetAutoRenew] failed to set auto-renew of contract '%s': %v", id, err)
+		writeContractError(w, err, "AUTO_RENEW_FAILED", "Could not change contract auto-renew")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, helpers.Response[contractFull]{
 		Success: true,
-		Data:    cntFull,
+		Data:    mapToContractFull(cntrct),
+	})
+}
+
+func writeContractError(w http.ResponseWriter, err error, code, message string) {
+	status := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, contracts.ErrNotFoundContract):
+		status, code, message = http.StatusNotFound, "NOT_FOUND", "Contract not found"
+	case errors.Is(err, contracts.ErrStatusContract), errors.Is(err, contracts.ErrReasonContract):
+		status, code, message = http.StatusBadRequest, "INVALID_STATUS", err.Error()
+	case errors.Is(err, contracts.ErrChangeStatusContract):
+		status, code, message = http.StatusConflict, "INVALID_TRANSITION", err.Error()
+	case errors.Is(err, contracts.ErrWeekdaysContract), errors.Is(err, contracts.ErrExcludedDateContract), errors.Is(err, contracts.ErrCalendarContract):
+		status, code, message = http.StatusBadRequest, "INVALID_CALENDAR", err.Error()
+	case errors.Is(err, invoices.ErrUnpaidInvoice):
+		status, code, message = http.StatusConflict, "UNPAID_CONTRACT", err.Error()
+	case errors.Is(err, invoices.ErrDeliveriesRefund), errors.Is(err, invoices.ErrCreditNote):
+		status, code, message = http.StatusConflict, "INVALID_REFUND", err.Error()
+	case errors.Is(err, contracts.ErrHolidayContract):
+		status, code, message = http.StatusConflict, "HOLIDAY", err.Error()
+	case errors.Is(err, contracts.ErrCostNonPositiveNumberContract), errors.Is(err, valueobjects.ErrCurrencyMoney), errors.Is(err, valueobjects.ErrCurrencyMismatchMoney):
+		status, code, message = http.StatusBadRequest, "INVALID_COST", err.Error()
+	case errors.Is(err, contracts.ErrTypeContract):
+		status, code, message = http.StatusBadRequest, "INVALID_PLAN", err.Error()
+	case errors.Is(err, plans.ErrNotFoundPlan):
+		status, code, message = http.StatusNotFound, "PLAN_NOT_FOUND", "Plan not found"
+	case errors.Is(err, plans.ErrInactivePlan) && !errors.Is(err, contracts.ErrNotRenewableContract):
+		status, code, message = http.StatusBadRequest, "INACTIVE_PLAN", err.Error()
+	case errors.Is(err, contracts.ErrNotRenewableContract), errors.Is(err, contracts.ErrAlreadyRenewedContract), errors.Is(err, contracts.ErrAutoRenewContract):
+		status, code, message = http.StatusConflict, "NOT_RENEWABLE", err.Error()
+	case errors.Is(err, pricing.ErrNotFoundPromoCode):
+		status, code, message = http.StatusNotFound, "PROMO_CODE_NOT_FOUND", "Promo code not found"
+	case errors.Is(err, pricing.ErrCodePromoCode):
+		status, code, message = http.StatusBadRequest, "INVALID_PROMO_CODE", err.Error()
+	case errors.Is(err, pricing.ErrNotStartedPromoCode), errors.Is(err, pricing.ErrExpiredPromoCode), errors.Is(err, pricing.ErrUsedUpPromoCode):
+		status, code, message = http.StatusConflict, "UNAVAILABLE_PROMO_CODE", err.Error()
+	case errors.Is(err, pricing.ErrDeliveriesPricing):
+		status, code, message = http.StatusBadRequest, "INVALID_PRICE", err.Error()
+	case errors.Is(err, valueobjects.ErrOutOfBoundariesLatitude), errors.Is(err, valueobjects.ErrOutOfBoundariesLongitude):
+		status, code, message = http.StatusBadRequest, "INVALID_ADDRESS", err.Error()
+	case errors.Is(err, pricing.ErrNotCoveredZone):
+		status, code, message = http.StatusBadRequest, "NOT_COVERED", err.Error()
+	case errors.Is(err, slots.ErrNotFoundSlot):
+		status, code, message = http.StatusNotFound, "SLOT_NOT_FOUND", "Slot not found"
+	case errors.Is(err, slots.ErrZoneSlot):
+		status, code, message = http.StatusBadRequest, "INVALID_SLOT", err.Error()
+	case errors.Is(err, slots.ErrFullSlot):
+		status, code, message = http.StatusConflict, "FULL_SLOT", err.Error()
+	}
+
+	writeJSON(w, status, helpers.Response[any]{
+		Success: false,
+		Error: &helpers.Error{
+			Code:    code,
+			Message: message,
+		},
 	})
 }
 
@@ -232,6 +534,7 @@ func mapToContractFull(c *contracts.Contract) contractFull {
 			Number:     v.Number(),
 			Latitude:   c.Latitude(),
 			Longitude:  c.Longitude(),
+			SlotId:     v.SlotId(),
 			CreatedAt:  v.CreatedAt(),
 			UpdatedAt:  v.UpdatedAt(),
 			DeletedAt:  v.DeletedAt(),
@@ -244,10 +547,17 @@ func mapToContractFull(c *contracts.Contract) contractFull {
 		PatientId:       c.PatientId(),
 		ContractType:    c.ContractType(),
 		ContractStatus:  c.ContractStatus(),
+		StatusReason:    c.StatusReason(),
+		SuspendedAt:     c.SuspendedAt(),
+		AutoRenew:       c.AutoRenew(),
+		RenewedFrom:     c.RenewedFrom(),
 		CreationDate:    c.CreationDate(),
 		StartDate:       c.StartDate(),
 		EndDate:         c.EndDate(),
-		CostValue:       c.CostValue(),
+		CostValue:       mappers.MapToMoneyDTO(c.CostValue()),
+		Price:           mappers.MapToPriceDTO(c.Price()),
+		Weekdays:        c.Weekdays().Weekdays(),
+		SlotId:          c.SlotId(),
 		CreatedAt:       c.CreatedAt(),
 		UpdatedAt:       c.UpdatedAt(),
 		DeletedAt:       c.DeletedAt(),
@@ -255,9 +565,597 @@ func mapToContractFull(c *contracts.Contract) contractFull {
 	}
 }
 
+func (h *ContractController) GetListDeliveries(w http.ResponseWriter, r *http.Request) {
+	idStr := chi.URLParam(r, "id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		log.Printf("[controller:contract][GetListDeliveries] invalid UUID format '%s': %v", idStr, err)
+		writeJSON(w, http.StatusBadRequest, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "INVALID_ID_FORMAT",
+				Message: "The provided ID is not a valid UUID",
+			},
+		})
+		return
+	}
+
+	qry := queries.GetListDeliveriesQuery{ContractId: id}
+	deliveryList, err := h.qryHandler.HandleGetListDeliveries(r.Context(), qry)
+	if err != nil {
+		log.Printf("[controller:contract][GetListDeliveries] failed to fetch deliveries of contract '%s': %v", idStr, err)
+		writeJSON(w, http.StatusInternalServerError, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "GET_LIST_FAILED",
+				Message: "Could not fetch deliveries",
+			},
+		})
+		return
+	}
+
+	writeJSON(w, http.StatusOK, helpers.Response[[]*dto.DeliveryDTO]{
+		Success: true,
+		Data:    deliveryList,
+		Length:  len(deliveryList),
+	})
+}
+
+func (h *ContractController) GetDeliveryById(w http.ResponseWriter, r *http.Request) {
+	contractId, deliveryId, ok := parseDeliveryPath(w, r)
+	if !ok {
+		return
+	}
+
+	qry := queries.GetDeliveryByIdQuery{ContractId: contractId, Id: deliveryId}
+	delivery, err := h.qryHandler.HandleGetDeliveryById(r.Context(), qry)
+	if err != nil {
+		log.Printf("[controller:contract][GetDeliveryById] failed to fetch delivery '%s': %v", deliveryId, err)
+		writeJSON(w, http.StatusInternalServerError, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "GET_BY_ID_FAILED",
+				Message: "Could not fetch delivery by ID",
+			},
+		})
+		return
+	}
+
+	writeJSON(w, http.StatusOK, helpers.Response[dto.DeliveryResponse]{
+		Success: true,
+		Data:    *delivery,
+	})
+}
+
+func (h *ContractController) UpdateDelivery(w http.ResponseWriter, r *http.Request) {
+	contractId, deliveryId, ok := parseDeliveryPath(w, r)
+	if !ok {
+		return
+	}
+
+	var req struct {
+		Street    string  `json:"street"`
+		Number    int     `json:"number"`
+		Latitude  float64 `json:"latitude"`
+		Longitude float64 `json:"longitude"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		log.Printf("[controller:contract][UpdateDelivery] failed to decode request body '%v': %v", req, err)
+		writeJSON(w, http.StatusBadRequest, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "INVALID_REQUEST_BODY",
+				Message: "Invalid JSON format or fields",
+			},
+		})
+		return
+	}
+
+	cmd := commands.UpdateDeliveryDayCommand{
+		ContractId:    contractId,
+		DeliveryDayId: deliveryId,
+		Street:        req.Street,
+		Number:        req.Number,
+		Latitude:      req.Latitude,
+		Longitude:     req.Longitude,
+	}
+
+	delivery, err := h.cmdHandler.HandleUpdateDelivery(r.Context(), cmd)
+	if err != nil {
+		log.Printf("[controller:contract][UpdateDelivery] failed to update delivery '%s': %v", deliveryId, err)
+		writeDeliveryError(w, err, "UPDATE_FAILED", "Could not update delivery")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, helpers.Response[dto.DeliveryResponse]{
+		Success: true,
+		Data:    mapToDeliveryResponse(delivery),
+	})
+}
+
+func (h *ContractController) ChooseDeliverySlot(w http.ResponseWriter, r *http.Request) {
+	contractId, deliveryId, ok := parseDeliveryPath(w, r)
+	if !ok {
+		return
+	}
+
+	var req struct {
+		SlotId uuid.UUID `json:"slot_id"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		log.Printf("[controller:contract][ChooseDeliverySlot] failed to decode request body '%v': %v", req, err)
+		writeJSON(w, http.StatusBadRequest, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "INVALID_REQUEST_BODY",
+				Message: "Invalid JSON format or fields",
+			},
+		})
+		return
+	}
+
+	cmd := commands.ChooseDeliverySlotCommand{
+		ContractId: contractId,
+		DeliveryId: deliveryId,
+		SlotId:     req.SlotId,
+	}
+
+	delivery, err := h.cmdHandler.HandleChooseDeliverySlot(r.Context(), cmd)
+	if err != nil {
+		log.Printf("[controller:contract][ChooseDeliverySlot] failed to book delivery '%s' into slot '%s': %v", deliveryId, req.SlotId, err)
+		writeDeliveryError(w, err, "BOOK_FAILED", "Could not book the delivery into the slot")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, helpers.Response[dto.DeliveryResponse]{
+		Success: true,
+		Data:    mapToDeliveryResponse(delivery),
+	})
+}
+
+func writeDeliveryError(w http.ResponseWriter, err error, code, message string) {
+	var status int
+	switch {
+	case errors.Is(err, deliveries.ErrNotFoundDelivery), errors.Is(err, deliveries.ErrContractMismatchDelivery):
+		status, code, message = http.StatusNotFound, "DELIVERY_NOT_FOUND", "Delivery not found"
+	case errors.Is(err, deliveries.ErrNotPendingDelivery), errors.Is(err, deliveries.ErrCannotChangeDeliveryStatus):
+		status, code, message = http.StatusConflict, "NOT_PENDING", err.Error()
+	case errors.Is(err, deliveries.ErrRequiredProof):
+		status, code, message = http.StatusConflict, "PROOF_REQUIRED", err.Error()
+	case errors.Is(err, deliveries.ErrNotADeliveryStatus):
+		status, code, message = http.StatusBadRequest, "INVALID_STATUS", err.Error()
+	default:
+		writeContractError(w, err, code, message)
+		return
+	}
+
+	writeJSON(w, status, helpers.Response[any]{
+		Success: false,
+		Error: &helpers.Error{
+			Code:    code,
+			Message: message,
+		},
+	})
+}
+
+func (h *ContractController) UpdateDeliveryList(w http.ResponseWriter, r *http.Request) {
+	idStr := chi.URLParam(r, "id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		log.Printf("[controller:contract][UpdateDeliveryList] invalid UUID format '%s': %v", idStr, err)
+		writeJSON(w, http.StatusBadRequest, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "INVALID_ID_FORMAT",
+				Message: "The provided ID is not a valid UUID",
+			},
+		})
+		return
+	}
+
+	var req struct {
+		FirstDate time.Time `json:"first_date"`
+		LastDate  time.Time `json:"last_date"`
+		Street    string    `json:"street"`
+		Number    int       `json:"number"`
+		Latitude  float64   `json:"latitude"`
+		Longitude float64   `json:"longitude"`
+	}
+
+	if err = json.NewDecoder(r.Body).Decode(&req); err != nil {
+		log.Printf("[controller:contract][UpdateDeliveryList] failed to decode request body '%v': %v", req, err)
+		writeJSON(w, http.StatusBadRequest, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "INVALID_REQUEST_BODY",
+				Message: "Invalid JSON format or fields",
+			},
+		})
+		return
+	}
+
+	cmd := commands.UpdateDeliveryDayListCommand{
+		ContractId: id,
+		FirstDate:  req.FirstDate,
+		LastDate:   req.LastDate,
+		Street:     req.Street,
+		Number:     req.Number,
+		Latitude:   req.Latitude,
+		Longitude:  req.Longitude,
+	}
+
+	dlvrs, err := h.cmdHandler.HandleUpdateDeliveryList(r.Context(), cmd)
+	if err != nil {
+		log.Printf("[controller:contract][UpdateDeliveryList] failed to update deliveries of contract '%s': %v", idStr, err)
+		writeDeliveryError(w, err, "UPDATE_LIST_FAILED", "Could not update deliveries")
+		return
+	}
+
+	var responses []dto.DeliveryResponse
+	for _, d := range dlvrs {
+		responses = append(responses, mapToDeliveryResponse(d))
+	}
+
+	writeJSON(w, http.StatusOK, helpers.Response[[]dto.DeliveryResponse]{
+		Success: true,
+		Data:    responses,
+		Length:  len(responses),
+	})
+}
+
+func (h *ContractController) ChangeStatusDelivery(w http.ResponseWriter, r *http.Request) {
+	contractId, deliveryId, ok := parseDeliveryPath(w, r)
+	if !ok {
+		return
+	}
+
+	var req struct {
+		Status string `json:"status"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		log.Printf("[controller:contract][ChangeStatusDelivery] failed to decode request body '%v': %v", req, err)
+		writeJSON(w, http.StatusBadRequest, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "INVALID_REQUEST_BODY",
+				Message: "Invalid JSON format or fields",
+			},
+		})
+		return
+	}
+
+	cmd := commands.ChangeStatusDeliveryCommand{
+		ContractId:    contractId,
+		DeliveryDayId: deliveryId,
+		Status:        req.Status,
+	}
+
+	delivery, err := h.cmdHandler.HandleChangeStatusDelivery(r.Context(), cmd)
+	if err != nil {
+		log.Printf("[controller:contract][ChangeStatusDelivery] failed to change status of delivery '%s': %v", deliveryId, err)
+		writeDeliveryError(w, err, "CHANGE_STATUS_FAILED", "Could not change delivery status")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, helpers.Response[dto.DeliveryResponse]{
+		Success: true,
+		Data:    mapToDeliveryResponse(delivery),
+	})
+}
+
+func (h *ContractController) DeleteDelivery(w http.ResponseWriter, r *http.Request) {
+	contractId, deliveryId, ok := parseDeliveryPath(w, r)
+	if !ok {
+		return
+	}
+
+	cmd := commands.DeleteDeliveryCommand{
+		ContractId:    contractId,
+		DeliveryDayId: deliveryId,
+	}
+
+	delivery, err := h.cmdHandler.HandleDeleteDelivery(r.Context(), cmd)
+	if err != nil {
+		log.Printf("[controller:contract][DeleteDelivery] failed to cancel delivery '%s': %v", deliveryId, err)
+		writeJSON(w, http.StatusInternalServerError, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "DELETE_FAILED",
+				Message: "Could not cancel delivery",
+			},
+		})
+		return
+	}
+
+	writeJSON(w, http.StatusOK, helpers.Response[dto.DeliveryResponse]{
+		Success: true,
+		Data:    mapToDeliveryResponse(delivery),
+	})
+}
+
+const maxProofSize = 10 << 20
+
+func (h *ContractController) DeliverDelivery(w http.ResponseWriter, r *http.Request) {
+	contractId, deliveryId, ok := parseDeliveryPath(w, r)
+	if !ok {
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, maxProofSize)
+	cmd, files, err := parseDeliverForm(r)
+	defer func() {
+		for _, f := range files {
+			_ = f.Close()
+		}
+	}()
+	if err != nil {
+		log.Printf("[controller:contract][DeliverDelivery] failed to parse proof of delivery '%s': %v", deliveryId, err)
+		writeJSON(w, http.StatusBadRequest, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "INVALID_REQUEST_BODY",
+				Message: "Invalid form format or fields",
+			},
+		})
+		return
+	}
+
+	cmd.ContractId, cmd.DeliveryId = contractId, deliveryId
+	proof, err := h.proofCmd.HandleDeliver(r.Context(), cmd)
+	if err != nil {
+		log.Printf("[controller:contract][DeliverDelivery] failed to deliver delivery '%s': %v", deliveryId, err)
+		writeProofError(w, err, "DELIVER_FAILED", "Could not record proof of delivery")
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, helpers.Response[*proofDto.ProofDTO]{
+		Success: true,
+		Data:    proofMappers.MapToProofDTO(proof),
+	})
+}
+
+func parseDeliverForm(r *http.Request) (proofCommands.DeliverCommand, []multipart.File, error) {
+	var cmd proofCommands.DeliverCommand
+	if err := r.ParseMultipartForm(maxProofSize); err != nil {
+		return cmd, nil, err
+	}
+
+	cmd.Recipient = r.FormValue("recipient")
+
+	var err error
+	if cmd.Latitude, err = strconv.ParseFloat(r.FormValue("latitude"), 64); err != nil {
+		return cmd, nil, fmt.Errorf("latitude: %w", err)
+	}
+	if cmd.Longitude, err = strconv.ParseFloat(r.FormValue("longitude"), 64); err != nil {
+		return cmd, nil, fmt.Errorf("longitude: %w", err)
+	}
+	if v := r.FormValue("receivedAt"); v != "" {
+		if cmd.ReceivedAt, err = time.Parse(time.RFC3339, v); err != nil {
+			return cmd, nil, fmt.Errorf("receivedAt: %w", err)
+		}
+	}
+
+	var files []multipart.File
+	for _, field := range []struct {
+		name  string
+		image **proofCommands.Image
+	}{
+		{proofQueries.PhotoImage, &cmd.Photo},
+		{proofQueries.SignatureImage, &cmd.Signature},
+	} {
+		file, _, err := r.FormFile(field.name)
+		if errors.Is(err, http.ErrMissingFile) {
+			continue
+		} else if err != nil {
+			return cmd, files, fmt.Errorf("%s: %w", field.name, err)
+		}
+		files = append(files, file)
+
+		img, err := sniffImage(file)
+		if err != nil {
+			return cmd, files, fmt.Errorf("%s: %w", field.name, err)
+		}
+		*field.image = img
+	}
+
+	return cmd, files, nil
+}
+
+// sniffImage detects the media type of an upload from its first bytes
+// rather than trusting the one the client sent.
+func sniffImage(file io.Reader) (*proofCommands.Image, error) {
+	head := make([]byte, 512)
+	n, err := io.ReadFull(file, head)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return nil, err
+	}
+
+	return &proofCommands.Image{
+		ContentType: http.DetectContentType(head[:n]),
+		Content:     io.MultiReader(bytes.NewReader(head[:n]), file),
+	}, nil
+}
+
+func (h *ContractController) GetDeliveryProof(w http.ResponseWriter, r *http.Request) {
+	contractId, deliveryId, ok := parseDeliveryPath(w, r)
+	if !ok {
+		return
+	}
+
+	qry := proofQueries.GetProofQuery{ContractId: contractId, DeliveryId: deliveryId}
+	proof, err := h.proofQry.HandleGetProof(r.Context(), qry)
+	if err != nil {
+		log.Printf("[controller:contract][GetDeliveryProof] failed to fetch proof of delivery '%s': %v", deliveryId, err)
+		writeProofError(w, err, "GET_PROOF_FAILED", "Could not fetch proof of delivery")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, helpers.Response[*proofDto.ProofDTO]{
+		Success: true,
+		Data:    proof,
+	})
+}
+
+func (h *ContractController) GetDeliveryProofImage(w http.ResponseWriter, r *http.Request) {
+	contractId, deliveryId, ok := parseDeliveryPath(w, r)
+	if !ok {
+		return
+	}
+
+	image := chi.URLParam(r, "image")
+	if image != proofQueries.PhotoImage && image != proofQueries.SignatureImage {
+		log.Printf("[controller:contract][GetDeliveryProofImage] invalid proof image '%s'", image)
+		writeJSON(w, http.StatusNotFound, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "NOT_FOUND",
+				Message: "Proof image not found",
+			},
+		})
+		return
+	}
+
+	qry := proofQueries.GetProofImageQuery{ContractId: contractId, DeliveryId: deliveryId, Image: image}
+	blob, contentType, err := h.proofQry.HandleGetProofImage(r.Context(), qry)
+	if err != nil {
+		log.Printf("[controller:contract][GetDeliveryProofImage] failed to fetch %s of delivery '%s': %v", image, deliveryId, err)
+		writeProofError(w, err, "GET_PROOF_FAILED", "Could not fetch proof image")
+		return
+	}
+	defer blob.Close()
+
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(http.StatusOK)
+	if _, err := io.Copy(w, blob); err != nil {
+		log.Printf("[controller:contract][GetDeliveryProofImage] failed to write %s of delivery '%s': %v", image, deliveryId, err)
+	}
+}
+
+func writeProofError(w http.ResponseWriter, err error, code, message string) {
+	status := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, deliveries.ErrNotFoundDelivery), errors.Is(err, deliveries.ErrContractMismatchDelivery):
+		status, code, message = http.StatusNotFound, "DELIVERY_NOT_FOUND", "Delivery not found"
+	case errors.Is(err, deliveries.ErrNotFoundProof):
+		status, code, message = http.StatusNotFound, "NOT_FOUND", "Proof of delivery not found"
+	case errors.Is(err, abstractions.ErrNotFoundBlob):
+		status, code, message = http.StatusNotFound, "NOT_FOUND", "Proof image not found"
+	case errors.Is(err, deliveries.ErrEmptyRecipientProof), errors.Is(err, deliveries.ErrLongRecipientProof), errors.Is(err, deliveries.ErrReceivedAtProof):
+		status, code, message = http.StatusBadRequest, "INVALID_PROOF", err.Error()
+	case errors.Is(err, deliveries.ErrImageProof):
+		status, code, message = http.StatusBadRequest, "INVALID_IMAGE", err.Error()
+	case errors.Is(err, valueobjects.ErrOutOfBoundariesLatitude), errors.Is(err, valueobjects.ErrOutOfBoundariesLongitude):
+		status, code, message = http.StatusBadRequest, "INVALID_LOCATION", err.Error()
+	case errors.Is(err, deliveries.ErrCannotChangeDeliveryStatus):
+		status, code, message = http.StatusConflict, "INVALID_TRANSITION", err.Error()
+	}
+
+	writeJSON(w, status, helpers.Response[any]{
+		Success: false,
+		Error: &helpers.Error{
+			Code:    code,
+			Message: message,
+		},
+	})
+}
+
+func parseContractPath(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	idStr := chi.URLParam(r, "id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		log.Printf("[controller:contract][parseContractPath] invalid UUID format '%s': %v", idStr, err)
+		writeJSON(w, http.StatusBadRequest, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "INVALID_ID_FORMAT",
+				Message: "The provided ID is not a valid UUID",
+			},
+		})
+		return uuid.Nil, false
+	}
+
+	return id, true
+}
+
+func parseDeliveryPath(w http.ResponseWriter, r *http.Request) (uuid.UUID, uuid.UUID, bool) {
+	contractIdStr := chi.URLParam(r, "id")
+	contractId, err := uuid.Parse(contractIdStr)
+	if err != nil {
+		log.Printf("[controller:contract][parseDeliveryPath] invalid contract UUID format '%s': %v", contractIdStr, err)
+		writeJSON(w, http.StatusBadRequest, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "INVALID_ID_FORMAT",
+				Message: "The provided contract ID is not a valid UUID",
+			},
+		})
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	deliveryIdStr := chi.URLParam(r, "deliveryId")
+	deliveryId, err := uuid.Parse(deliveryIdStr)
+	if err != nil {
+		log.Printf("[controller:contract][parseDeliveryPath] invalid delivery UUID format '%s': %v", deliveryIdStr, err)
+		writeJSON(w, http.StatusBadRequest, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "INVALID_ID_FORMAT",
+				Message: "The provided delivery ID is not a valid UUID",
+			},
+		})
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	return contractId, deliveryId, true
+}
+
+func mapToDeliveryResponse(d *deliveries.Delivery) dto.DeliveryResponse {
+	deliveryDto := mappers.MapToDeliveryDTO(d)
+	return *mappers.MapToDeliveryResposnse(deliveryDto, d.CreatedAt(), d.UpdatedAt(), d.DeletedAt())
+}
+
 func (h *ContractController) RegisterRoutes(r chi.Router) {
-	r.Get("/", h.GetAllContracts)
-	r.Get("/{id}", h.GetAllContracts)
-	r.Post("/", h.CreateContract)
-	r.Post("/status", h.ChangeStatusContract)
+	administrators := middleware.Allow(middleware.Administrators)
+	readers := middleware.Allow(
+		middleware.Administrators,
+		middleware.ContractPatient(h.contractParties, middleware.URLParam("id")),
+	)
+	owners := middleware.Allow(
+		middleware.SuperAdministrators,
+		middleware.ContractOwner(h.contractParties, middleware.BodyField("id")),
+	)
+
+	r.With(middleware.Allow(middleware.Administrators, middleware.Roles(tokens.Patient))).Get("/", h.GetAllContracts)
+	r.With(readers).Get("/{id}", h.GetContractById)
+	r.With(readers).Get("/{id}/invoice", h.GetContractInvoice)
+	r.With(readers).Get("/{id}/refund", h.PreviewRefund)
+	r.With(administrators).Post("/", h.CreateContract)
+	r.With(middleware.Allow(middleware.Administrators, middleware.Roles(tokens.Patient))).Post("/quote", h.QuoteContract)
+	r.With(owners).Post("/status", h.ChangeStatusContract)
+	r.With(middleware.Allow(
+		middleware.SuperAdministrators,
+		middleware.ContractOwner(h.contractParties, middleware.URLParam("id")),
+	)).Post("/{id}/renew", h.RenewContract)
+	r.With(middleware.Allow(
+		middleware.SuperAdministrators,
+		middleware.ContractOwner(h.contractParties, middleware.URLParam("id")),
+		middleware.ContractPatient(h.contractParties, middleware.URLParam("id")),
+	)).Put("/{id}/auto-renew", h.SetAutoRenew)
+
+	r.Route("/{id}/deliveries", func(r chi.Router) {
+		r.With(readers).Get("/", h.GetListDeliveries)
+		r.With(readers).Get("/{deliveryId}", h.GetDeliveryById)
+
+		r.With(administrators).Put("/", h.UpdateDeliveryList)
+		r.With(administrators).Put("/{deliveryId}", h.UpdateDelivery)
+		r.With(readers).Put("/{deliveryId}/slot", h.ChooseDeliverySlot)
+		r.With(administrators).Patch("/{deliveryId}/status", h.ChangeStatusDelivery)
+		r.With(administrators).Post("/{deliveryId}/proof", h.DeliverDelivery)
+		r.With(readers).Get("/{deliveryId}/proof", h.GetDeliveryProof)
+		r.With(readers).Get("/{deliveryId}/proof/{image}", h.GetDeliveryProofImage)
+		r.With(administrators).Delete("/{deliveryId}", h.DeleteDelivery)
+	})
 }
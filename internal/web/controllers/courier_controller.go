@@ -0,0 +1,288 @@
+package controllers
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	contractDto "github.com/carlosclavijo/Nutricenter-Contracting/internal/application/contract/dto"
+	contractMappers "github.com/carlosclavijo/Nutricenter-Contracting/internal/application/contract/mappers"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/courier/commands"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/courier/dto"
+	command "github.com/carlosclavijo/Nutricenter-Contracting/internal/application/courier/handlers"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/courier/mappers"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/courier/queries"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/contract"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/courier"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/delivery"
+	vo "github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	query "github.com/carlosclavijo/Nutricenter-Contracting/internal/infrastructure/handlers/courier"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/infrastructure/persistence"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/infrastructure/persistence/repositories"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/web/helpers"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/web/middleware"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"log"
+	"net/http"
+	"time"
+)
+
+type CourierController struct {
+	cmdHandler command.CourierHandler
+	qryHandler query.CourierHandler
+}
+
+func NewCourierController(db *sql.DB) *CourierController {
+	repo := repositories.NewCourierRepository(db)
+	cmdHandler := command.NewCourierHandler(repo, repositories.NewContractRepository(db), couriers.NewCourierFactory(), persistence.NewUnitOfWork(db))
+	qryHandler := query.NewCourierHandler(repo)
+	return &CourierController{*cmdHandler, *qryHandler}
+}
+
+type courierRequest struct {
+	Name          string   `json:"name"`
+	Phone         *string  `json:"phone"`
+	Weekdays      []string `json:"weekdays"`
+	MaxDeliveries int      `json:"maxDeliveries"`
+}
+
+func (h *CourierController) GetAllCouriers(w http.ResponseWriter, r *http.Request) {
+	list, err := h.qryHandler.HandleGetAll(r.Context(), queries.GetAllCouriersQuery{})
+	if err != nil {
+		log.Printf("[controller:courier][GetAllCouriers] failed to fetch couriers: %v", err)
+		writeCourierError(w, err, "GET_ALL_FAILED", "Could not fetch couriers")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, helpers.Response[[]*dto.CourierDTO]{
+		Success: true,
+		Data:    list,
+		Length:  len(list),
+	})
+}
+
+func (h *CourierController) GetCourierById(w http.ResponseWriter, r *http.Request) {
+	id, ok := parseCourierPath(w, r, "id")
+	if !ok {
+		return
+	}
+
+	courier, err := h.qryHandler.HandleGetById(r.Context(), queries.GetCourierByIdQuery{Id: id})
+	if err != nil {
+		log.Printf("[controller:courier][GetCourierById] failed to fetch courier '%s': %v", id, err)
+		writeCourierError(w, err, "GET_FAILED", "Could not fetch courier")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, helpers.Response[*dto.CourierDTO]{
+		Success: true,
+		Data:    courier,
+	})
+}
+
+func (h *CourierController) CreateCourier(w http.ResponseWriter, r *http.Request) {
+	var req courierRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		log.Printf("[controller:courier][CreateCourier] failed to decode request body '%v': %v", req, err)
+		writeJSON(w, http.StatusBadRequest, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "INVALID_REQUEST_BODY",
+				Message: "Invalid JSON format or fields",
+			},
+		})
+		return
+	}
+
+	courier, err := h.cmdHandler.HandleCreate(r.Context(), commands.CreateCourierCommand{
+		Name:          req.Name,
+		Phone:         req.Phone,
+		Weekdays:      req.Weekdays,
+		MaxDeliveries: req.MaxDeliveries,
+	})
+	if err != nil {
+		log.Printf("[controller:courier][CreateCourier] failed to create courier '%s': %v", req.Name, err)
+		writeCourierError(w, err, "CREATE_FAILED", "Could not create courier")
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, helpers.Response[*dto.CourierDTO]{
+		Success: true,
+		Data:    mappers.MapToCourierDTO(courier),
+	})
+}
+
+func (h *CourierController) UpdateCourier(w http.ResponseWriter, r *http.Request) {
+	id, ok := parseCourierPath(w, r, "id")
+	if !ok {
+		return
+	}
+
+	var req courierRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		log.Printf("[controller:courier][UpdateCourier] failed to decode request body '%v': %v", req, err)
+		writeJSON(w, http.StatusBadRequest, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "INVALID_REQUEST_BODY",
+				Message: "Invalid JSON format or fields",
+			},
+		})
+		return
+	}
+
+	courier, err := h.cmdHandler.HandleUpdate(r.Context(), commands.UpdateCourierCommand{
+		Id:            id,
+		Name:          req.Name,
+		Phone:         req.Phone,
+		Weekdays:      req.Weekdays,
+		MaxDeliveries: req.MaxDeliveries,
+	})
+	if err != nil {
+		log.Printf("[controller:courier][UpdateCourier] failed to update courier '%s': %v", id, err)
+		writeCourierError(w, err, "UPDATE_FAILED", "Could not update courier")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, helpers.Response[*dto.CourierDTO]{
+		Success: true,
+		Data:    mappers.MapToCourierDTO(courier),
+	})
+}
+
+func (h *CourierController) DeleteCourier(w http.ResponseWriter, r *http.Request) {
+	id, ok := parseCourierPath(w, r, "id")
+	if !ok {
+		return
+	}
+
+	if err := h.cmdHandler.HandleDelete(r.Context(), commands.DeleteCourierCommand{Id: id}); err != nil {
+		log.Printf("[controller:courier][DeleteCourier] failed to delete courier '%s': %v", id, err)
+		writeCourierError(w, err, "DELETE_FAILED", "Could not delete courier")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, helpers.Response[any]{
+		Success: true,
+	})
+}
+
+func (h *CourierController) AssignDelivery(w http.ResponseWriter, r *http.Request) {
+	id, ok := parseCourierPath(w, r, "id")
+	if !ok {
+		return
+	}
+
+	deliveryId, ok := parseCourierPath(w, r, "deliveryId")
+	if !ok {
+		return
+	}
+
+	h.assign(w, r, "AssignDelivery", commands.AssignDeliveryCommand{DeliveryId: deliveryId, CourierId: &id})
+}
+
+func (h *CourierController) UnassignDelivery(w http.ResponseWriter, r *http.Request) {
+	deliveryId, ok := parseCourierPath(w, r, "deliveryId")
+	if !ok {
+		return
+	}
+
+	h.assign(w, r, "UnassignDelivery", commands.AssignDeliveryCommand{DeliveryId: deliveryId})
+}
+
+func (h *CourierController) assign(w http.ResponseWriter, r *http.Request, method string, cmd commands.AssignDeliveryCommand) {
+	delivery, err := h.cmdHandler.HandleAssign(r.Context(), cmd)
+	if err != nil {
+		log.Printf("[controller:courier][%s] failed to assign delivery '%s': %v", method, cmd.DeliveryId, err)
+		writeCourierError(w, err, "ASSIGN_FAILED", "Could not assign delivery")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, helpers.Response[*contractDto.DeliveryDTO]{
+		Success: true,
+		Data:    contractMappers.MapToDeliveryDTO(delivery),
+	})
+}
+
+func (h *CourierController) AutoAssign(w http.ResponseWriter, r *http.Request) {
+	dateStr := chi.URLParam(r, "date")
+	date, err := time.Parse(time.DateOnly, dateStr)
+	if err != nil {
+		log.Printf("[controller:courier][AutoAssign] invalid date format '%s': %v", dateStr, err)
+		writeJSON(w, http.StatusBadRequest, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "INVALID_DATE_FORMAT",
+				Message: "The provided date is not in the YYYY-MM-DD format",
+			},
+		})
+		return
+	}
+
+	assigned, left, err := h.cmdHandler.HandleAutoAssign(r.Context(), commands.AutoAssignCommand{Date: date})
+	if err != nil {
+		log.Printf("[controller:courier][AutoAssign] failed to assign deliveries on %s: %v", dateStr, err)
+		writeCourierError(w, err, "ASSIGN_FAILED", "Could not assign deliveries")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, helpers.Response[*dto.AssignmentDTO]{
+		Success: true,
+		Data:    mappers.MapToAssignmentDTO(date, assigned, left),
+	})
+}
+
+func (h *CourierController) RegisterRoutes(r chi.Router) {
+	r.Use(middleware.Allow(middleware.Administrators))
+
+	r.Get("/", h.GetAllCouriers)
+	r.Post("/", h.CreateCourier)
+	r.Post("/assignments/{date}", h.AutoAssign)
+	r.Delete("/deliveries/{deliveryId}", h.UnassignDelivery)
+	r.Get("/{id}", h.GetCourierById)
+	r.Put("/{id}", h.UpdateCourier)
+	r.Delete("/{id}", h.DeleteCourier)
+	r.Put("/{id}/deliveries/{deliveryId}", h.AssignDelivery)
+}
+
+func writeCourierError(w http.ResponseWriter, err error, code, message string) {
+	status := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, couriers.ErrNotFoundCourier):
+		status, code, message = http.StatusNotFound, "NOT_FOUND", "Courier not found"
+	case errors.Is(err, deliveries.ErrNotFoundDelivery):
+		status, code, message = http.StatusNotFound, "DELIVERY_NOT_FOUND", "Delivery not found"
+	case errors.Is(err, couriers.ErrEmptyNameCourier), errors.Is(err, couriers.ErrLongNameCourier), errors.Is(err, couriers.ErrAvailabilityCourier),
+		errors.Is(err, couriers.ErrMaxDeliveriesCourier), errors.Is(err, contracts.ErrWeekdaysContract),
+		errors.Is(err, vo.ErrNotNumericPhoneNumber), errors.Is(err, vo.ErrShortPhoneNumber), errors.Is(err, vo.ErrLongPhoneNumber):
+		status, code, message = http.StatusBadRequest, "INVALID_COURIER", err.Error()
+	case errors.Is(err, couriers.ErrUnavailableCourier), errors.Is(err, couriers.ErrFullCourier), errors.Is(err, deliveries.ErrNotPendingDelivery):
+		status, code, message = http.StatusConflict, "INVALID_ASSIGNMENT", err.Error()
+	}
+
+	writeJSON(w, status, helpers.Response[any]{
+		Success: false,
+		Error: &helpers.Error{
+			Code:    code,
+			Message: message,
+		},
+	})
+}
+
+func parseCourierPath(w http.ResponseWriter, r *http.Request, param string) (uuid.UUID, bool) {
+	idStr := chi.URLParam(r, param)
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		log.Printf("[controller:courier][parseCourierPath] invalid UUID format '%s': %v", idStr, err)
+		writeJSON(w, http.StatusBadRequest, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "INVALID_ID_FORMAT",
+				Message: "The provided ID is not a valid UUID",
+			},
+		})
+		return uuid.Nil, false
+	}
+
+	return id, true
+}
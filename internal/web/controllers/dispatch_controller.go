@@ -0,0 +1,218 @@
+package controllers
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/dispatch/dto"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/dispatch/queries"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/route"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/infrastructure/dispatch"
+	query "github.com/carlosclavijo/Nutricenter-Contracting/internal/infrastructure/handlers/dispatch"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/infrastructure/persistence/repositories"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/web/helpers"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/web/middleware"
+	"github.com/go-chi/chi/v5"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// The manifests are planned in memory on every request, so a fleet is capped
+// well above any real one.
+const (
+	maxManifestDrivers  = 100
+	maxManifestCapacity = 500
+)
+
+type DispatchController struct {
+	qryHandler query.DispatchHandler
+}
+
+func NewDispatchController(db *sql.DB) *DispatchController {
+	qryHandler := query.NewDispatchHandler(repositories.NewContractRepository(db), repositories.NewZoneRepository(db), repositories.NewSlotRepository(db), dispatch.LoadFleet())
+	return &DispatchController{*qryHandler}
+}
+
+func (h *DispatchController) GetManifests(w http.ResponseWriter, r *http.Request) {
+	qry, ok := parseManifestsQuery(w, r)
+	if !ok {
+		return
+	}
+
+	manifests, err := h.qryHandler.HandleGetManifests(r.Context(), qry)
+	if err != nil {
+		log.Printf("[controller:dispatch][GetManifests] failed to plan deliveries with query '%v': %v", qry, err)
+		writeDispatchError(w, err, "GET_MANIFESTS_FAILED", "Could not plan deliveries")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, helpers.Response[[]*dto.ManifestDTO]{
+		Success: true,
+		Data:    manifests,
+		Length:  len(manifests),
+	})
+}
+
+func (h *DispatchController) GetManifest(w http.ResponseWriter, r *http.Request) {
+	qry, ok := parseManifestQuery(w, r)
+	if !ok {
+		return
+	}
+
+	manifest, err := h.qryHandler.HandleGetManifest(r.Context(), qry)
+	if err != nil {
+		log.Printf("[controller:dispatch][GetManifest] failed to plan deliveries with query '%v': %v", qry, err)
+		writeDispatchError(w, err, "GET_MANIFEST_FAILED", "Could not plan deliveries")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, helpers.Response[*dto.ManifestDTO]{
+		Success: true,
+		Data:    manifest,
+	})
+}
+
+func (h *DispatchController) ExportManifests(w http.ResponseWriter, r *http.Request) {
+	qry, ok := parseManifestsQuery(w, r)
+	if !ok {
+		return
+	}
+
+	manifests, err := h.qryHandler.HandleGetManifests(r.Context(), qry)
+	if err != nil {
+		log.Printf("[controller:dispatch][ExportManifests] failed to plan deliveries with query '%v': %v", qry, err)
+		writeDispatchError(w, err, "EXPORT_FAILED", "Could not export manifests")
+		return
+	}
+
+	writeManifestsExport(w, r, fmt.Sprintf("manifests-%s", qry.Date.Format(time.DateOnly)), manifests)
+}
+
+func (h *DispatchController) ExportManifest(w http.ResponseWriter, r *http.Request) {
+	qry, ok := parseManifestQuery(w, r)
+	if !ok {
+		return
+	}
+
+	manifest, err := h.qryHandler.HandleGetManifest(r.Context(), qry)
+	if err != nil {
+		log.Printf("[controller:dispatch][ExportManifest] failed to plan deliveries with query '%v': %v", qry, err)
+		writeDispatchError(w, err, "EXPORT_FAILED", "Could not export manifest")
+		return
+	}
+
+	writeManifestsExport(w, r, fmt.Sprintf("manifest-%s-driver-%d", qry.Date.Format(time.DateOnly), qry.Driver), []*dto.ManifestDTO{manifest})
+}
+
+func (h *DispatchController) RegisterRoutes(r chi.Router) {
+	r.Use(middleware.Allow(middleware.Administrators))
+
+	r.Get("/{date}", h.GetManifests)
+	r.Get("/{date}/export", h.ExportManifests)
+	r.Get("/{date}/drivers/{driver}", h.GetManifest)
+	r.Get("/{date}/drivers/{driver}/export", h.ExportManifest)
+}
+
+func writeManifestsExport(w http.ResponseWriter, r *http.Request, name string, manifests []*dto.ManifestDTO) {
+	switch format := r.URL.Query().Get("format"); format {
+	case "", "csv":
+		w.Header().Set("Content-Type", "text/csv")
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", name+".csv"))
+		w.WriteHeader(http.StatusOK)
+		if err := dispatch.WriteManifestsCSV(w, manifests); err != nil {
+			log.Printf("[controller:dispatch][writeManifestsExport] failed to write CSV export '%s': %v", name, err)
+		}
+	case "json":
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", name+".json"))
+		w.WriteHeader(http.StatusOK)
+		if err := json.NewEncoder(w).Encode(manifests); err != nil {
+			log.Printf("[controller:dispatch][writeManifestsExport] failed to write JSON export '%s': %v", name, err)
+		}
+	default:
+		log.Printf("[controller:dispatch][writeManifestsExport] invalid export format '%s'", format)
+		writeInvalidQuery(w, fmt.Errorf("%w: format got %s", ErrInvalidQueryParam, format))
+	}
+}
+
+func writeDispatchError(w http.ResponseWriter, err error, code, message string) {
+	status := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, routes.ErrNotFoundManifest):
+		status, code, message = http.StatusNotFound, "NOT_FOUND", "Driver not found in the fleet"
+	case errors.Is(err, routes.ErrDriversFleet), errors.Is(err, routes.ErrCapacityFleet):
+		status, code, message = http.StatusBadRequest, "INVALID_FLEET", err.Error()
+	}
+
+	writeJSON(w, status, helpers.Response[any]{
+		Success: false,
+		Error: &helpers.Error{
+			Code:    code,
+			Message: message,
+		},
+	})
+}
+
+func parseManifestsQuery(w http.ResponseWriter, r *http.Request) (queries.GetManifestsQuery, bool) {
+	dateStr := chi.URLParam(r, "date")
+	date, err := time.Parse(time.DateOnly, dateStr)
+	if err != nil {
+		log.Printf("[controller:dispatch][parseManifestsQuery] invalid date format '%s': %v", dateStr, err)
+		writeJSON(w, http.StatusBadRequest, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "INVALID_DATE_FORMAT",
+				Message: "The provided date is not in the YYYY-MM-DD format",
+			},
+		})
+		return queries.GetManifestsQuery{}, false
+	}
+
+	drivers, err := parseIntParam(r, "drivers")
+	if err == nil && drivers > maxManifestDrivers {
+		err = fmt.Errorf("%w: drivers got %d, at most %d", ErrInvalidQueryParam, drivers, maxManifestDrivers)
+	}
+	if err != nil {
+		log.Printf("[controller:dispatch][parseManifestsQuery] invalid query parameters: %v", err)
+		writeInvalidQuery(w, err)
+		return queries.GetManifestsQuery{}, false
+	}
+
+	capacity, err := parseIntParam(r, "capacity")
+	if err == nil && capacity > maxManifestCapacity {
+		err = fmt.Errorf("%w: capacity got %d, at most %d", ErrInvalidQueryParam, capacity, maxManifestCapacity)
+	}
+	if err != nil {
+		log.Printf("[controller:dispatch][parseManifestsQuery] invalid query parameters: %v", err)
+		writeInvalidQuery(w, err)
+		return queries.GetManifestsQuery{}, false
+	}
+
+	return queries.GetManifestsQuery{Date: date, Drivers: drivers, Capacity: capacity}, true
+}
+
+func parseManifestQuery(w http.ResponseWriter, r *http.Request) (queries.GetManifestQuery, bool) {
+	qry, ok := parseManifestsQuery(w, r)
+	if !ok {
+		return queries.GetManifestQuery{}, false
+	}
+
+	driverStr := chi.URLParam(r, "driver")
+	driver, err := strconv.Atoi(driverStr)
+	if err != nil {
+		log.Printf("[controller:dispatch][parseManifestQuery] invalid driver '%s': %v", driverStr, err)
+		writeJSON(w, http.StatusBadRequest, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "INVALID_DRIVER",
+				Message: "The provided driver is not a number",
+			},
+		})
+		return queries.GetManifestQuery{}, false
+	}
+
+	return queries.GetManifestQuery{Date: qry.Date, Drivers: qry.Drivers, Capacity: qry.Capacity, Driver: driver}, true
+}
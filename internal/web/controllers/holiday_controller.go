@@ -0,0 +1,214 @@
+package controllers
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/holiday/commands"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/holiday/dto"
+	command "github.com/carlosclavijo/Nutricenter-Contracting/internal/application/holiday/handlers"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/holiday/queries"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/holiday"
+	vo "github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	query "github.com/carlosclavijo/Nutricenter-Contracting/internal/infrastructure/handlers/holiday"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/infrastructure/persistence"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/infrastructure/persistence/repositories"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/web/helpers"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/web/middleware"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"log"
+	"net/http"
+	"time"
+)
+
+type HolidayController struct {
+	cmdHandler command.HolidayHandler
+	qryHandler query.HolidayHandler
+}
+
+func NewHolidayController(db *sql.DB) *HolidayController {
+	repo := repositories.NewHolidayRepository(db)
+	cmdHandler := command.NewHolidayHandler(repo, holidays.NewHolidayFactory(), persistence.NewUnitOfWork(db))
+	qryHandler := query.NewHolidayHandler(repo, repositories.NewContractRepository(db))
+	return &HolidayController{*cmdHandler, *qryHandler}
+}
+
+type holidayRequest struct {
+	Date   time.Time `json:"date"`
+	Name   string    `json:"name"`
+	Policy string    `json:"policy"`
+	Area   *struct {
+		Name      string  `json:"name"`
+		Latitude  float64 `json:"latitude"`
+		Longitude float64 `json:"longitude"`
+		Radius    int     `json:"radius"`
+	} `json:"area"`
+}
+
+func (h *HolidayController) GetAllHolidays(w http.ResponseWriter, r *http.Request) {
+	from, err := parseDateParam(r, "from")
+	if err != nil {
+		log.Printf("[controller:holiday][GetAllHolidays] invalid query parameters: %v", err)
+		writeInvalidQuery(w, err)
+		return
+	}
+
+	to, err := parseDateParam(r, "to")
+	if err != nil {
+		log.Printf("[controller:holiday][GetAllHolidays] invalid query parameters: %v", err)
+		writeInvalidQuery(w, err)
+		return
+	}
+
+	qry := queries.GetHolidaysQuery{From: time.Now()}
+	if from != nil {
+		qry.From = *from
+	}
+	qry.To = qry.From.AddDate(1, 0, 0)
+	if to != nil {
+		qry.To = *to
+	}
+
+	hols, err := h.qryHandler.HandleGetAll(r.Context(), qry)
+	if err != nil {
+		log.Printf("[controller:holiday][GetAllHolidays] failed to fetch holidays: %v", err)
+		writeHolidayError(w, err, "GET_ALL_FAILED", "Could not fetch holidays")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, helpers.Response[[]*dto.HolidayDTO]{
+		Success: true,
+		Data:    hols,
+		Length:  len(hols),
+	})
+}
+
+func (h *HolidayController) CreateHoliday(w http.ResponseWriter, r *http.Request) {
+	var req holidayRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		log.Printf("[controller:holiday][CreateHoliday] failed to decode request body '%v': %v", req, err)
+		writeJSON(w, http.StatusBadRequest, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "INVALID_REQUEST_BODY",
+				Message: "Invalid JSON format or fields",
+			},
+		})
+		return
+	}
+
+	cmd := commands.CreateHolidayCommand{
+		Date:   req.Date,
+		Name:   req.Name,
+		Policy: req.Policy,
+	}
+	if req.Area != nil {
+		cmd.AreaName = req.Area.Name
+		cmd.Latitude = req.Area.Latitude
+		cmd.Longitude = req.Area.Longitude
+		cmd.Radius = req.Area.Radius
+	}
+
+	hol, err := h.cmdHandler.HandleCreate(r.Context(), cmd)
+	if err != nil {
+		log.Printf("[controller:holiday][CreateHoliday] failed to create holiday '%s': %v", req.Name, err)
+		writeHolidayError(w, err, "CREATE_FAILED", "Could not create holiday")
+		return
+	}
+
+	report, err := h.qryHandler.HandleGetImpact(r.Context(), queries.GetHolidayImpactQuery{Id: hol.Id()})
+	if err != nil {
+		log.Printf("[controller:holiday][CreateHoliday] failed to report impact of holiday '%s': %v", hol.Id(), err)
+		writeHolidayError(w, err, "IMPACT_FAILED", "Holiday created, but its impact could not be reported")
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, helpers.Response[*dto.HolidayImpactDTO]{
+		Success: true,
+		Data:    report,
+	})
+}
+
+func (h *HolidayController) GetHolidayImpact(w http.ResponseWriter, r *http.Request) {
+	id, ok := parseHolidayPath(w, r)
+	if !ok {
+		return
+	}
+
+	report, err := h.qryHandler.HandleGetImpact(r.Context(), queries.GetHolidayImpactQuery{Id: id})
+	if err != nil {
+		log.Printf("[controller:holiday][GetHolidayImpact] failed to report impact of holiday '%s': %v", id, err)
+		writeHolidayError(w, err, "IMPACT_FAILED", "Could not report holiday impact")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, helpers.Response[*dto.HolidayImpactDTO]{
+		Success: true,
+		Data:    report,
+	})
+}
+
+func (h *HolidayController) DeleteHoliday(w http.ResponseWriter, r *http.Request) {
+	id, ok := parseHolidayPath(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.cmdHandler.HandleDelete(r.Context(), commands.DeleteHolidayCommand{Id: id}); err != nil {
+		log.Printf("[controller:holiday][DeleteHoliday] failed to delete holiday '%s': %v", id, err)
+		writeHolidayError(w, err, "DELETE_FAILED", "Could not delete holiday")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, helpers.Response[any]{
+		Success: true,
+	})
+}
+
+func (h *HolidayController) RegisterRoutes(r chi.Router) {
+	administrators := middleware.Allow(middleware.Administrators)
+
+	r.Get("/", h.GetAllHolidays)
+	r.With(administrators).Post("/", h.CreateHoliday)
+	r.With(administrators).Get("/{id}/impact", h.GetHolidayImpact)
+	r.With(administrators).Delete("/{id}", h.DeleteHoliday)
+}
+
+func writeHolidayError(w http.ResponseWriter, err error, code, message string) {
+	status := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, holidays.ErrNotFoundHoliday):
+		status, code, message = http.StatusNotFound, "NOT_FOUND", "Holiday not found"
+	case errors.Is(err, holidays.ErrDateHoliday), errors.Is(err, holidays.ErrEmptyNameHoliday), errors.Is(err, holidays.ErrLongNameHoliday),
+		errors.Is(err, holidays.ErrAreaNameHoliday), errors.Is(err, holidays.ErrRadiusHoliday), errors.Is(err, holidays.ErrPolicyHoliday),
+		errors.Is(err, vo.ErrOutOfBoundariesLatitude), errors.Is(err, vo.ErrOutOfBoundariesLongitude):
+		status, code, message = http.StatusBadRequest, "INVALID_HOLIDAY", err.Error()
+	}
+
+	writeJSON(w, status, helpers.Response[any]{
+		Success: false,
+		Error: &helpers.Error{
+			Code:    code,
+			Message: message,
+		},
+	})
+}
+
+func parseHolidayPath(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	idStr := chi.URLParam(r, "id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		log.Printf("[controller:holiday][parseHolidayPath] invalid UUID format '%s': %v", idStr, err)
+		writeJSON(w, http.StatusBadRequest, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "INVALID_ID_FORMAT",
+				Message: "The provided ID is not a valid UUID",
+			},
+		})
+		return uuid.Nil, false
+	}
+
+	return id, true
+}
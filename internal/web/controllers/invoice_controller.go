@@ -0,0 +1,173 @@
+package controllers
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/invoice/commands"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/invoice/dto"
+	command "github.com/carlosclavijo/Nutricenter-Contracting/internal/application/invoice/handlers"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/invoice/mappers"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/invoice/queries"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/invoice"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/token"
+	vo "github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/infrastructure/billing"
+	query "github.com/carlosclavijo/Nutricenter-Contracting/internal/infrastructure/handlers/invoice"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/infrastructure/persistence"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/infrastructure/persistence/repositories"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/web/helpers"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/web/middleware"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"log"
+	"net/http"
+)
+
+type InvoiceController struct {
+	cmdHandler command.InvoiceHandler
+	qryHandler query.InvoiceHandler
+}
+
+func NewInvoiceController(db *sql.DB) *InvoiceController {
+	repo := repositories.NewInvoiceRepository(db)
+	cmdHandler := command.NewInvoiceHandler(repo, billing.LoadPaymentProvider(), persistence.NewUnitOfWork(db))
+	qryHandler := query.NewInvoiceHandler(repo)
+	return &InvoiceController{*cmdHandler, *qryHandler}
+}
+
+func (h *InvoiceController) GetInvoiceById(w http.ResponseWriter, r *http.Request) {
+	id, ok := parseInvoicePath(w, r, "id")
+	if !ok {
+		return
+	}
+
+	invoice, err := h.qryHandler.HandleGetById(r.Context(), queries.GetInvoiceByIdQuery{Id: id})
+	if err != nil {
+		log.Printf("[controller:invoice][GetInvoiceById] failed to fetch invoice '%s': %v", id, err)
+		writeInvoiceError(w, err, "GET_FAILED", "Could not fetch invoice")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, helpers.Response[*dto.InvoiceDTO]{
+		Success: true,
+		Data:    invoice,
+	})
+}
+
+func (h *InvoiceController) RecordPayment(w http.ResponseWriter, r *http.Request) {
+	id, ok := parseInvoicePath(w, r, "id")
+	if !ok {
+		return
+	}
+
+	var req struct {
+		Kind     string `json:"kind"`
+		Amount   int    `json:"amount"`
+		Currency string `json:"currency"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		log.Printf("[controller:invoice][RecordPayment] failed to decode request body '%v': %v", req, err)
+		writeJSON(w, http.StatusBadRequest, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "INVALID_REQUEST_BODY",
+				Message: "Invalid JSON format or fields",
+			},
+		})
+		return
+	}
+
+	cmd := commands.RecordPaymentCommand{
+		InvoiceId:      id,
+		Kind:           req.Kind,
+		Amount:         req.Amount,
+		Currency:       req.Currency,
+		IdempotencyKey: r.Header.Get("Idempotency-Key"),
+	}
+
+	invoice, err := h.cmdHandler.HandleRecordPayment(r.Context(), cmd)
+	if err != nil {
+		log.Printf("[controller:invoice][RecordPayment] failed to record payment of invoice '%s': %v", id, err)
+		writeInvoiceError(w, err, "PAYMENT_FAILED", "Could not record payment")
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, helpers.Response[*dto.InvoiceDTO]{
+		Success: true,
+		Data:    mappers.MapToInvoiceDTO(invoice),
+	})
+}
+
+func (h *InvoiceController) GetLedger(w http.ResponseWriter, r *http.Request) {
+	patientId, ok := parseInvoicePath(w, r, "patientId")
+	if !ok {
+		return
+	}
+
+	ledger, err := h.qryHandler.HandleGetLedger(r.Context(), queries.GetLedgerQuery{PatientId: patientId})
+	if err != nil {
+		log.Printf("[controller:invoice][GetLedger] failed to fetch ledger of patient '%s': %v", patientId, err)
+		writeInvoiceError(w, err, "GET_LEDGER_FAILED", "Could not fetch ledger")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, helpers.Response[*dto.LedgerDTO]{
+		Success: true,
+		Data:    ledger,
+		Length:  len(ledger.Entries),
+	})
+}
+
+func (h *InvoiceController) RegisterRoutes(r chi.Router) {
+	administrators := middleware.Allow(middleware.Administrators)
+
+	r.With(middleware.Allow(
+		middleware.Administrators,
+		middleware.Self(tokens.Patient, middleware.URLParam("patientId")),
+	)).Get("/ledger/{patientId}", h.GetLedger)
+	r.With(administrators).Get("/{id}", h.GetInvoiceById)
+	r.With(administrators).Post("/{id}/payments", h.RecordPayment)
+}
+
+func writeInvoiceError(w http.ResponseWriter, err error, code, message string) {
+	status := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, invoices.ErrNotFoundInvoice):
+		status, code, message = http.StatusNotFound, "NOT_FOUND", "Invoice not found"
+	case errors.Is(err, invoices.ErrKindPayment), errors.Is(err, invoices.ErrAmountPayment), errors.Is(err, vo.ErrCurrencyMoney):
+		status, code, message = http.StatusBadRequest, "INVALID_PAYMENT", err.Error()
+	case errors.Is(err, invoices.ErrSettledInvoice), errors.Is(err, invoices.ErrFullPayment), errors.Is(err, invoices.ErrPartialPayment),
+		errors.Is(err, invoices.ErrRefundPayment), errors.Is(err, vo.ErrCurrencyMismatchMoney):
+		status, code, message = http.StatusConflict, "PAYMENT_NOT_ALLOWED", err.Error()
+	case errors.Is(err, invoices.ErrDeclinedPayment):
+		status, code, message = http.StatusPaymentRequired, "PAYMENT_DECLINED", err.Error()
+	}
+
+	writeJSON(w, status, helpers.Response[any]{
+		Success: false,
+		Error: &helpers.Error{
+			Code:    code,
+			Message: message,
+		},
+	})
+}
+
+func parseInvoicePath(w http.ResponseWriter, r *http.Request, param string) (uuid.UUID, bool) {
+	idStr := chi.URLParam(r, param)
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		log.Printf("[controller:invoice][parseInvoicePath] invalid UUID format '%s': %v", idStr, err)
+		writeJSON(w, http.StatusBadRequest, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "INVALID_ID_FORMAT",
+				Message: "The provided ID is not a valid UUID",
+			},
+		})
+		return uuid.Nil, false
+	}
+
+	return id, true
+}
@@ -0,0 +1,134 @@
+package controllers
+
+import (
+	"errors"
+	"fmt"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/abstractions"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/contract"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/lockout"
+	vo "github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/web/helpers"
+	"github.com/google/uuid"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+var ErrInvalidQueryParam = errors.New("invalid query parameter")
+
+func parsePagination(r *http.Request) (abstractions.Pagination, error) {
+	q := r.URL.Query()
+
+	page, err := parseIntParam(r, "page")
+	if err != nil {
+		return abstractions.Pagination{}, err
+	}
+
+	limit, err := parseIntParam(r, "limit")
+	if err != nil {
+		return abstractions.Pagination{}, err
+	}
+
+	return abstractions.NewPagination(page, limit, q.Get("cursor"), q.Get("sort"), q.Get("order"))
+}
+
+func parseIntParam(r *http.Request, name string) (int, error) {
+	value := r.URL.Query().Get(name)
+	if value == "" {
+		return 0, nil
+	}
+
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %s got %s", ErrInvalidQueryParam, name, value)
+	}
+	return n, nil
+}
+
+func parseFloatParam(r *http.Request, name string) (float64, error) {
+	value := r.URL.Query().Get(name)
+	if value == "" {
+		return 0, fmt.Errorf("%w: %s is required", ErrInvalidQueryParam, name)
+	}
+
+	f, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %s got %s", ErrInvalidQueryParam, name, value)
+	}
+	return f, nil
+}
+
+func parseBoolParam(r *http.Request, name string) (*bool, error) {
+	value := r.URL.Query().Get(name)
+	if value == "" {
+		return nil, nil
+	}
+
+	b, err := strconv.ParseBool(value)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s got %s", ErrInvalidQueryParam, name, value)
+	}
+	return &b, nil
+}
+
+func parseUUIDParam(r *http.Request, name string) (*uuid.UUID, error) {
+	value := r.URL.Query().Get(name)
+	if value == "" {
+		return nil, nil
+	}
+
+	id, err := uuid.Parse(value)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s got %s", ErrInvalidQueryParam, name, value)
+	}
+	return &id, nil
+}
+
+func parseDateParam(r *http.Request, name string) (*time.Time, error) {
+	value := r.URL.Query().Get(name)
+	if value == "" {
+		return nil, nil
+	}
+
+	for _, layout := range []string{time.DateOnly, time.RFC3339} {
+		if t, err := time.Parse(layout, value); err == nil {
+			return &t, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %s got %s", ErrInvalidQueryParam, name, value)
+}
+
+func listStatus(err error) (int, string) {
+	switch {
+	case errors.Is(err, abstractions.ErrInvalidSortPagination),
+		errors.Is(err, vo.ErrNotAGender),
+		errors.Is(err, contracts.ErrStatusContract),
+		errors.Is(err, contracts.ErrTypeContract),
+		errors.Is(err, contracts.ErrDateRangeContract),
+		errors.Is(err, lockouts.ErrNotAScopeLockout),
+		errors.Is(err, lockouts.ErrNotAnEventTypeLockout),
+		errors.Is(err, lockouts.ErrDateRangeLockout):
+		return http.StatusBadRequest, "INVALID_QUERY_PARAMS"
+	default:
+		return http.StatusInternalServerError, ""
+	}
+}
+
+func writeInvalidQuery(w http.ResponseWriter, err error) {
+	writeJSON(w, http.StatusBadRequest, helpers.Response[any]{
+		Success: false,
+		Error: &helpers.Error{
+			Code:    "INVALID_QUERY_PARAMS",
+			Message: err.Error(),
+		},
+	})
+}
+
+func pageMeta[T any](p *abstractions.Page[T]) *helpers.Meta {
+	return &helpers.Meta{
+		Total:      p.Total,
+		Page:       p.Number(),
+		Limit:      p.Limit,
+		NextCursor: p.NextCursor(),
+	}
+}
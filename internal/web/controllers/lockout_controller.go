@@ -0,0 +1,183 @@
+package controllers
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/lockout/commands"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/lockout/dto"
+	command "github.com/carlosclavijo/Nutricenter-Contracting/internal/application/lockout/handlers"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/lockout/queries"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/lockout"
+	query "github.com/carlosclavijo/Nutricenter-Contracting/internal/infrastructure/handlers/lockout"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/infrastructure/persistence"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/infrastructure/persistence/repositories"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/web/helpers"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/web/middleware"
+	"github.com/go-chi/chi/v5"
+	"log"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+type LockoutController struct {
+	cmdHandler command.LockoutHandler
+	qryHandler query.LockoutHandler
+}
+
+func NewLockoutController(db *sql.DB) *LockoutController {
+	repo := repositories.NewLockoutRepository(db)
+	cmdHandler := newLockoutHandler(db)
+	qryHandler := query.NewLockoutHandler(repo)
+	return &LockoutController{*cmdHandler, *qryHandler}
+}
+
+func newLockoutHandler(db *sql.DB) *command.LockoutHandler {
+	return command.NewLockoutHandler(repositories.NewLockoutRepository(db), persistence.NewUnitOfWork(db))
+}
+
+func (h *LockoutController) GetLockoutEvents(w http.ResponseWriter, r *http.Request) {
+	qry, err := parseLockoutEventsQuery(r)
+	if err != nil {
+		log.Printf("[controller:lockout][GetLockoutEvents] invalid query parameters: %v", err)
+		writeInvalidQuery(w, err)
+		return
+	}
+
+	page, err := h.qryHandler.HandleGetEvents(r.Context(), qry)
+	if err != nil {
+		log.Printf("[controller:lockout][GetLockoutEvents] failed to fetch lockout events: %v", err)
+		status, code := listStatus(err)
+		if code == "" {
+			code = "GET_ALL_FAILED"
+		}
+		writeJSON(w, status, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    code,
+				Message: "Could not fetch lockout events",
+			},
+		})
+		return
+	}
+
+	writeJSON(w, http.StatusOK, helpers.Response[[]*dto.LockoutEventDTO]{
+		Success: true,
+		Data:    page.Items,
+		Length:  len(page.Items),
+		Meta:    pageMeta(page),
+	})
+}
+
+func parseLockoutEventsQuery(r *http.Request) (queries.GetLockoutEventsQuery, error) {
+	var (
+		qry queries.GetLockoutEventsQuery
+		err error
+	)
+
+	if qry.Pagination, err = parsePagination(r); err != nil {
+		return qry, err
+	}
+	if qry.From, err = parseDateParam(r, "from"); err != nil {
+		return qry, err
+	}
+	if qry.To, err = parseDateParam(r, "to"); err != nil {
+		return qry, err
+	}
+
+	q := r.URL.Query()
+	qry.Scope = q.Get("scope")
+	qry.Key = q.Get("key")
+	qry.Type = q.Get("type")
+
+	return qry, nil
+}
+
+func (h *LockoutController) Unlock(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		Scope string `json:"scope"`
+		Key   string `json:"key"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		log.Printf("[controller:lockout][Unlock] failed to decode request body: %v", err)
+		writeJSON(w, http.StatusBadRequest, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "INVALID_REQUEST_BODY",
+				Message: "Invalid JSON format or fields",
+			},
+		})
+		return
+	}
+
+	p, _ := middleware.PrincipalFromContext(r.Context())
+	cmd := commands.UnlockCommand{
+		Scope:   req.Scope,
+		Key:     req.Key,
+		ActorId: p.SubjectId,
+	}
+
+	if err := h.cmdHandler.HandleUnlock(r.Context(), cmd); err != nil {
+		log.Printf("[controller:lockout][Unlock] failed to unlock %s '%s': %v", req.Scope, req.Key, err)
+		status, code, message := http.StatusInternalServerError, "UNLOCK_FAILED", "Could not unlock"
+		switch {
+		case errors.Is(err, lockouts.ErrNotLockedLockout):
+			status, code, message = http.StatusNotFound, "NOT_LOCKED", "The key has no failed attempts"
+		case errors.Is(err, lockouts.ErrNotAScopeLockout), errors.Is(err, lockouts.ErrEmptyKeyLockout):
+			status, code, message = http.StatusBadRequest, "INVALID_REQUEST_BODY", "A valid scope and key are required"
+		}
+		writeJSON(w, status, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    code,
+				Message: message,
+			},
+		})
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// clientIP returns the address of the peer. Forwarding headers are ignored on
+// purpose since any client can set them to dodge the per-address lockout.
+func clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+func writeTooManyAttempts(w http.ResponseWriter, wait time.Duration) {
+	w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(wait.Seconds()))))
+	writeJSON(w, http.StatusTooManyRequests, helpers.Response[any]{
+		Success: false,
+		Error: &helpers.Error{
+			Code:    "TOO_MANY_ATTEMPTS",
+			Message: "Too many failed login attempts, try again later",
+		},
+	})
+}
+
+// writeInvalidCredentials is the single answer to a wrong email or password, so
+// the response does not tell which accounts exist.
+func writeInvalidCredentials(w http.ResponseWriter) {
+	writeJSON(w, http.StatusUnauthorized, helpers.Response[any]{
+		Success: false,
+		Error: &helpers.Error{
+			Code:    "INVALID_CREDENTIALS",
+			Message: "Invalid email or password",
+		},
+	})
+}
+
+func (h *LockoutController) RegisterRoutes(r chi.Router) {
+	r.Use(middleware.Allow(middleware.Administrators))
+	r.Get("/events", h.GetLockoutEvents)
+	r.Post("/unlock", h.Unlock)
+}
@@ -3,14 +3,21 @@ package controllers
 import (
 	"database/sql"
 	"encoding/json"
+	"errors"
+	lockoutCommands "github.com/carlosclavijo/Nutricenter-Contracting/internal/application/lockout/commands"
+	lockout "github.com/carlosclavijo/Nutricenter-Contracting/internal/application/lockout/handlers"
 	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/patient/commands"
 	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/patient/dto"
 	command "github.com/carlosclavijo/Nutricenter-Contracting/internal/application/patient/handlers"
 	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/patient/queries"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/lockout"
 	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/patient"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/token"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/infrastructure/auth"
 	query "github.com/carlosclavijo/Nutricenter-Contracting/internal/infrastructure/handlers/patient"
 	"github.com/carlosclavijo/Nutricenter-Contracting/internal/infrastructure/persistence/repositories"
 	"github.com/carlosclavijo/Nutricenter-Contracting/internal/web/helpers"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/web/middleware"
 	"github.com/go-chi/chi/v5"
 	"github.com/google/uuid"
 	"log"
@@ -21,26 +28,51 @@ import (
 type PatientController struct {
 	cmdHandler command.PatientHandler
 	qryHandler query.PatientHandler
+	lockout    lockout.LockoutHandler
+	auth       *auth.Service
 }
 
-func NewPatientController(db *sql.DB) *PatientController {
+func NewPatientController(db *sql.DB, a *auth.Service) *PatientController {
 	repo := repositories.NewPatientRepository(db)
 	factory := patients.NewPatientFactory()
 	cmdHandler := command.NewPatientHandler(repo, factory)
 	qryHandler := query.NewPatientHandler(repo, factory)
-	return &PatientController{*cmdHandler, *qryHandler}
+	return &PatientController{*cmdHandler, *qryHandler, *newLockoutHandler(db), a}
 }
 
 func (h *PatientController) GetAllPatients(w http.ResponseWriter, r *http.Request) {
-	qry := queries.GetAllPatientsQuery{}
-	ptnts, err := h.qryHandler.HandleGetAll(r.Context(), qry)
+	pagination, err := parsePagination(r)
+	if err != nil {
+		log.Printf("[controller:patient][GetAllPatients] invalid query parameters: %v", err)
+		writeInvalidQuery(w, err)
+		return
+	}
+
+	deleted, err := parseBoolParam(r, "deleted")
+	if err != nil {
+		log.Printf("[controller:patient][GetAllPatients] invalid query parameters: %v", err)
+		writeInvalidQuery(w, err)
+		return
+	}
+
+	qry := queries.GetAllPatientsQuery{
+		Name:       r.URL.Query().Get("name"),
+		Gender:     r.URL.Query().Get("gender"),
+		Deleted:    deleted,
+		Pagination: pagination,
+	}
+	page, err := h.qryHandler.HandleGetAll(r.Context(), qry)
 
 	if err != nil {
 		log.Printf("[controller:patient][GetAllPatients] failed to fetch patients: %v", err)
-		writeJSON(w, http.StatusInternalServerError, helpers.Response[any]{
+		status, code := listStatus(err)
+		if code == "" {
+			code = "GET_ALL_FAILED"
+		}
+		writeJSON(w, status, helpers.Response[any]{
 			Success: false,
 			Error: &helpers.Error{
-				Code:    "GET_ALL_FAILED",
+				Code:    code,
 				Message: "Could not fetch patients",
 			},
 		})
@@ -49,21 +81,37 @@ func (h *PatientController) GetAllPatients(w http.ResponseWriter, r *http.Reques
 
 	writeJSON(w, http.StatusOK, helpers.Response[[]*dto.PatientDTO]{
 		Success: true,
-		Data:    ptnts,
-		Length:  len(ptnts),
+		Data:    page.Items,
+		Length:  len(page.Items),
+		Meta:    pageMeta(page),
 	})
 }
 
 func (h *PatientController) GetListPatients(w http.ResponseWriter, r *http.Request) {
-	qry := queries.GetListPatientsQuery{}
-	ptnts, err := h.qryHandler.HandleGetList(r.Context(), qry)
+	pagination, err := parsePagination(r)
+	if err != nil {
+		log.Printf("[controller:patient][GetListPatients] invalid query parameters: %v", err)
+		writeInvalidQuery(w, err)
+		return
+	}
+
+	qry := queries.GetListPatientsQuery{
+		Name:       r.URL.Query().Get("name"),
+		Gender:     r.URL.Query().Get("gender"),
+		Pagination: pagination,
+	}
+	page, err := h.qryHandler.HandleGetList(r.Context(), qry)
 
 	if err != nil {
 		log.Printf("[controller:patient][GetListPatients] failed to fetch patients: %v", err)
-		writeJSON(w, http.StatusInternalServerError, helpers.Response[any]{
+		status, code := listStatus(err)
+		if code == "" {
+			code = "GET_LIST_FAILED"
+		}
+		writeJSON(w, status, helpers.Response[any]{
 			Success: false,
 			Error: &helpers.Error{
-				Code:    "GET_LIST_FAILED",
+				Code:    code,
 				Message: "Could not fetch patients",
 			},
 		})
@@ -72,8 +120,9 @@ func (h *PatientController) GetListPatients(w http.ResponseWriter, r *http.Reque
 
 	writeJSON(w, http.StatusOK, helpers.Response[[]*dto.PatientDTO]{
 		Success: true,
-		Data:    ptnts,
-		Length:  len(ptnts),
+		Data:    page.Items,
+		Length:  len(page.Items),
+		Meta:    pageMeta(page),
 	})
 }
 
@@ -216,8 +265,36 @@ func (h *PatientController) LoginPatient(w http.ResponseWriter, r *http.Request)
 		Password: req.Password,
 	}
 
+	attempt := lockoutCommands.LoginAttemptCommand{
+		Role:  tokens.Patient,
+		Email: req.Email,
+		IP:    clientIP(r),
+	}
+
+	wait, err := h.lockout.HandleCheck(r.Context(), attempt)
+	if errors.Is(err, lockouts.ErrLockedOut) {
+		writeTooManyAttempts(w, wait)
+		return
+	} else if err != nil {
+		log.Printf("[controller:patient][Login] failed to check lockout of '%s': %v", req.Email, err)
+		writeJSON(w, http.StatusInternalServerError, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "LOGIN_FAILED",
+				Message: "Could not verify login attempts",
+			},
+		})
+		return
+	}
+
 	patient, err := h.cmdHandler.HandleLogin(r.Context(), qry)
-	if err != nil {
+	if errors.Is(err, patients.ErrInvalidCredentialsPatient) {
+		if err = h.lockout.HandleFailure(r.Context(), attempt); err != nil {
+			log.Printf("[controller:patient][Login] failed to record failed login of '%s': %v", req.Email, err)
+		}
+		writeInvalidCredentials(w)
+		return
+	} else if err != nil {
 		log.Printf("[controller:patient][Login] failed to retrieve patient with Email '%s': %v", req.Email, err)
 		writeJSON(w, http.StatusBadRequest, helpers.Response[any]{
 			Success: false,
@@ -229,9 +306,42 @@ func (h *PatientController) LoginPatient(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	writeJSON(w, http.StatusOK, helpers.Response[dto.PatientResponse]{
+	if err = h.lockout.HandleSuccess(r.Context(), attempt); err != nil {
+		log.Printf("[controller:patient][Login] failed to clear failed logins of '%s': %v", req.Email, err)
+	}
+
+	subjectId, err := uuid.Parse(patient.Id)
+	if err != nil {
+		log.Printf("[controller:patient][Login] invalid patient id '%s': %v", patient.Id, err)
+		writeJSON(w, http.StatusInternalServerError, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "LOGIN_FAILED",
+				Message: "Could not issue tokens",
+			},
+		})
+		return
+	}
+
+	pair, err := h.auth.Issue(r.Context(), subjectId, tokens.Patient)
+	if err != nil {
+		log.Printf("[controller:patient][Login] failed to issue tokens for '%s': %v", subjectId, err)
+		writeJSON(w, http.StatusInternalServerError, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "LOGIN_FAILED",
+				Message: "Could not issue tokens",
+			},
+		})
+		return
+	}
+
+	writeJSON(w, http.StatusOK, helpers.Response[loginResponse[dto.PatientResponse]]{
 		Success: true,
-		Data:    *patient,
+		Data: loginResponse[dto.PatientResponse]{
+			User:   *patient,
+			Tokens: pair,
+		},
 	})
 }
 
@@ -293,7 +403,6 @@ func (h *PatientController) UpdatePatient(w http.ResponseWriter, r *http.Request
 		FirstName string    `json:"first_name,omitempty"`
 		LastName  string    `json:"last_name,omitempty"`
 		Email     string    `json:"email,omitempty"`
-		Password  string    `json:"password,omitempty"`
 		Gender    string    `json:"gender,omitempty"`
 		Birth     time.Time `json:"birth,omitempty"`
 		Phone     *string   `json:"phone,omitempty"`
@@ -329,7 +438,7 @@ func (h *PatientController) UpdatePatient(w http.ResponseWriter, r *http.Request
 		FirstName: req.FirstName,
 		LastName:  req.LastName,
 		Email:     req.Email,
-		Password:  req.Password,
+		Gender:    req.Gender,
 		Birth:     req.Birth,
 		Phone:     req.Phone,
 	}
@@ -485,19 +594,30 @@ func (h *PatientController) CountDeletedPatients(w http.ResponseWriter, r *http.
 	})
 }
 
-func (h *PatientController) RegisterRoutes(r chi.Router) {
-	r.Get("/all", h.GetAllPatients)
-	r.Get("/list", h.GetListPatients)
-	r.Get("/email/{email}", h.GetPatientByEmail)
-	r.Get("/exist/id/{id}", h.ExistPatientById)
-	r.Get("/exist/email/{email}", h.ExistPatientByEmail)
-	r.Get("/count/all", h.CountAllPatients)
-	r.Get("/count/active", h.CountActivePatients)
-	r.Get("/count/deleted", h.CountDeletedPatients)
-	r.Get("/{id}", h.GetPatientById)
-	r.Post("/", h.CreatePatient)
+func (h *PatientController) RegisterPublicRoutes(r chi.Router) {
 	r.Post("/login", h.LoginPatient)
-	r.Put("/", h.UpdatePatient)
-	r.Patch("/", h.RestorePatient)
-	r.Delete("/{id}", h.DeletePatient)
+}
+
+func (h *PatientController) RegisterRoutes(r chi.Router) {
+	self := middleware.Self(tokens.Patient, middleware.URLParam("id"))
+	selfBody := middleware.Self(tokens.Patient, middleware.BodyField("id"))
+
+	r.With(middleware.Allow(middleware.Administrators, self)).Get("/{id}", h.GetPatientById)
+	r.With(middleware.Allow(middleware.Administrators, selfBody)).Put("/", h.UpdatePatient)
+
+	r.Group(func(r chi.Router) {
+		r.Use(middleware.Allow(middleware.Administrators))
+
+		r.Get("/all", h.GetAllPatients)
+		r.Get("/list", h.GetListPatients)
+		r.Get("/email/{email}", h.GetPatientByEmail)
+		r.Get("/exist/id/{id}", h.ExistPatientById)
+		r.Get("/exist/email/{email}", h.ExistPatientByEmail)
+		r.Get("/count/all", h.CountAllPatients)
+		r.Get("/count/active", h.CountActivePatients)
+		r.Get("/count/deleted", h.CountDeletedPatients)
+		r.Post("/", h.CreatePatient)
+		r.Patch("/{id}", h.RestorePatient)
+		r.Delete("/{id}", h.DeletePatient)
+	})
 }
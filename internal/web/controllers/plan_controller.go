@@ -0,0 +1,241 @@
+package controllers
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/plan/commands"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/plan/dto"
+	command "github.com/carlosclavijo/Nutricenter-Contracting/internal/application/plan/handlers"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/plan/mappers"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/plan/queries"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/plan"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/token"
+	query "github.com/carlosclavijo/Nutricenter-Contracting/internal/infrastructure/handlers/plan"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/infrastructure/persistence"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/infrastructure/persistence/repositories"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/web/helpers"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/web/middleware"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"log"
+	"net/http"
+)
+
+type PlanController struct {
+	cmdHandler command.PlanHandler
+	qryHandler query.PlanHandler
+}
+
+func NewPlanController(db *sql.DB) *PlanController {
+	repo := repositories.NewPlanRepository(db)
+	cmdHandler := command.NewPlanHandler(repo, plans.NewPlanFactory(), persistence.NewUnitOfWork(db))
+	qryHandler := query.NewPlanHandler(repo)
+	return &PlanController{*cmdHandler, *qryHandler}
+}
+
+type planRequest struct {
+	Code         string `json:"code"`
+	Name         string `json:"name"`
+	DurationDays int    `json:"duration_days"`
+	Pattern      string `json:"pattern"`
+	BasePrice    int    `json:"base_price"`
+}
+
+func (h *PlanController) GetAllPlans(w http.ResponseWriter, r *http.Request) {
+	includeInactive, err := parseBoolParam(r, "include_inactive")
+	if err != nil {
+		log.Printf("[controller:plan][GetAllPlans] invalid query parameters: %v", err)
+		writeInvalidQuery(w, err)
+		return
+	}
+
+	qry := queries.GetAllPlansQuery{}
+	if p, ok := middleware.PrincipalFromContext(r.Context()); ok && p.Role != tokens.Patient && includeInactive != nil {
+		qry.IncludeInactive = *includeInactive
+	}
+
+	plns, err := h.qryHandler.HandleGetAll(r.Context(), qry)
+	if err != nil {
+		log.Printf("[controller:plan][GetAllPlans] failed to fetch plans: %v", err)
+		writePlanError(w, err, "GET_ALL_FAILED", "Could not fetch plans")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, helpers.Response[[]*dto.PlanDTO]{
+		Success: true,
+		Data:    plns,
+		Length:  len(plns),
+	})
+}
+
+func (h *PlanController) GetPlanByCode(w http.ResponseWriter, r *http.Request) {
+	code := chi.URLParam(r, "code")
+
+	pln, err := h.qryHandler.HandleGetByCode(r.Context(), queries.GetPlanByCodeQuery{Code: code})
+	if err != nil {
+		log.Printf("[controller:plan][GetPlanByCode] failed to fetch plan '%s': %v", code, err)
+		writePlanError(w, err, "GET_FAILED", "Could not fetch plan")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, helpers.Response[*dto.PlanDTO]{
+		Success: true,
+		Data:    pln,
+	})
+}
+
+func (h *PlanController) CreatePlan(w http.ResponseWriter, r *http.Request) {
+	var req planRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		log.Printf("[controller:plan][CreatePlan] failed to decode request body '%v': %v", req, err)
+		writeJSON(w, http.StatusBadRequest, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "INVALID_REQUEST_BODY",
+				Message: "Invalid JSON format or fields",
+			},
+		})
+		return
+	}
+
+	pln, err := h.cmdHandler.HandleCreate(r.Context(), commands.CreatePlanCommand{
+		Code:         req.Code,
+		Name:         req.Name,
+		DurationDays: req.DurationDays,
+		Pattern:      req.Pattern,
+		BasePrice:    req.BasePrice,
+	})
+	if err != nil {
+		log.Printf("[controller:plan][CreatePlan] failed to create plan '%s': %v", req.Code, err)
+		writePlanError(w, err, "CREATE_FAILED", "Could not create plan")
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, helpers.Response[*dto.PlanDTO]{
+		Success: true,
+		Data:    mappers.MapToPlanDTO(pln),
+	})
+}
+
+func (h *PlanController) UpdatePlan(w http.ResponseWriter, r *http.Request) {
+	id, ok := parsePlanPath(w, r)
+	if !ok {
+		return
+	}
+
+	var req planRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		log.Printf("[controller:plan][UpdatePlan] failed to decode request body '%v': %v", req, err)
+		writeJSON(w, http.StatusBadRequest, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "INVALID_REQUEST_BODY",
+				Message: "Invalid JSON format or fields",
+			},
+		})
+		return
+	}
+
+	pln, err := h.cmdHandler.HandleUpdate(r.Context(), commands.UpdatePlanCommand{
+		Id:           id,
+		Name:         req.Name,
+		DurationDays: req.DurationDays,
+		Pattern:      req.Pattern,
+		BasePrice:    req.BasePrice,
+	})
+	if err != nil {
+		log.Printf("[controller:plan][UpdatePlan] failed to update plan '%s': %v", id, err)
+		writePlanError(w, err, "UPDATE_FAILED", "Could not update plan")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, helpers.Response[*dto.PlanDTO]{
+		Success: true,
+		Data:    mappers.MapToPlanDTO(pln),
+	})
+}
+
+func (h *PlanController) SetPlanActive(w http.ResponseWriter, r *http.Request) {
+	id, ok := parsePlanPath(w, r)
+	if !ok {
+		return
+	}
+
+	var req struct {
+		Active *bool `json:"active"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.Active == nil {
+		log.Printf("[controller:plan][SetPlanActive] failed to decode request body '%v': %v", req, err)
+		writeJSON(w, http.StatusBadRequest, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "INVALID_REQUEST_BODY",
+				Message: "Invalid JSON format or fields",
+			},
+		})
+		return
+	}
+
+	pln, err := h.cmdHandler.HandleSetActive(r.Context(), commands.SetPlanActiveCommand{Id: id, Active: *req.Active})
+	if err != nil {
+		log.Printf("[controller:plan][SetPlanActive] failed to change plan '%s': %v", id, err)
+		writePlanError(w, err, "UPDATE_FAILED", "Could not change plan")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, helpers.Response[*dto.PlanDTO]{
+		Success: true,
+		Data:    mappers.MapToPlanDTO(pln),
+	})
+}
+
+func (h *PlanController) RegisterRoutes(r chi.Router) {
+	administrators := middleware.Allow(middleware.Administrators)
+
+	r.Get("/", h.GetAllPlans)
+	r.Get("/{code}", h.GetPlanByCode)
+	r.With(administrators).Post("/", h.CreatePlan)
+	r.With(administrators).Put("/{id}", h.UpdatePlan)
+	r.With(administrators).Put("/{id}/active", h.SetPlanActive)
+}
+
+func writePlanError(w http.ResponseWriter, err error, code, message string) {
+	status := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, plans.ErrNotFoundPlan):
+		status, code, message = http.StatusNotFound, "NOT_FOUND", "Plan not found"
+	case errors.Is(err, plans.ErrExistPlan):
+		status, code, message = http.StatusConflict, "PLAN_EXISTS", err.Error()
+	case errors.Is(err, plans.ErrCodePlan), errors.Is(err, plans.ErrEmptyNamePlan), errors.Is(err, plans.ErrLongNamePlan),
+		errors.Is(err, plans.ErrDurationPlan), errors.Is(err, plans.ErrPatternPlan), errors.Is(err, plans.ErrBasePricePlan):
+		status, code, message = http.StatusBadRequest, "INVALID_PLAN", err.Error()
+	}
+
+	writeJSON(w, status, helpers.Response[any]{
+		Success: false,
+		Error: &helpers.Error{
+			Code:    code,
+			Message: message,
+		},
+	})
+}
+
+func parsePlanPath(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	idStr := chi.URLParam(r, "id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		log.Printf("[controller:plan][parsePlanPath] invalid UUID format '%s': %v", idStr, err)
+		writeJSON(w, http.StatusBadRequest, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "INVALID_ID_FORMAT",
+				Message: "The provided ID is not a valid UUID",
+			},
+		})
+		return uuid.Nil, false
+	}
+
+	return id, true
+}
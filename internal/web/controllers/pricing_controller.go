@@ -0,0 +1,290 @@
+package controllers
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	contractDto "github.com/carlosclavijo/Nutricenter-Contracting/internal/application/contract/dto"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/pricing/commands"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/pricing/dto"
+	command "github.com/carlosclavijo/Nutricenter-Contracting/internal/application/pricing/handlers"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/pricing/mappers"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/pricing/queries"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/pricing"
+	vo "github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/valueobjects"
+	query "github.com/carlosclavijo/Nutricenter-Contracting/internal/infrastructure/handlers/pricing"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/infrastructure/persistence"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/infrastructure/persistence/repositories"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/web/helpers"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/web/middleware"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"log"
+	"net/http"
+	"time"
+)
+
+type PricingController struct {
+	cmdHandler command.PricingHandler
+	qryHandler query.PricingHandler
+}
+
+func NewPricingController(db *sql.DB) *PricingController {
+	zones := repositories.NewZoneRepository(db)
+	codes := repositories.NewPromoCodeRepository(db)
+	cmdHandler := command.NewPricingHandler(zones, codes, pricing.NewZoneFactory(), pricing.NewPromoCodeFactory(), persistence.NewUnitOfWork(db))
+	qryHandler := query.NewPricingHandler(zones, codes)
+	return &PricingController{*cmdHandler, *qryHandler}
+}
+
+type zoneRequest struct {
+	Name      string               `json:"name"`
+	Latitude  float64              `json:"latitude"`
+	Longitude float64              `json:"longitude"`
+	Radius    int                  `json:"radius"`
+	Boundary  *json.RawMessage     `json:"boundary"`
+	Surcharge contractDto.MoneyDTO `json:"surcharge"`
+}
+
+type promoCodeRequest struct {
+	Code       string     `json:"code"`
+	Kind       string     `json:"kind"`
+	Value      int        `json:"value"`
+	Currency   string     `json:"currency"`
+	ValidFrom  time.Time  `json:"valid_from"`
+	ValidUntil *time.Time `json:"valid_until"`
+	MaxUses    int        `json:"max_uses"`
+}
+
+func (h *PricingController) GetAllZones(w http.ResponseWriter, r *http.Request) {
+	zones, err := h.qryHandler.HandleGetZones(r.Context(), queries.GetZonesQuery{})
+	if err != nil {
+		log.Printf("[controller:pricing][GetAllZones] failed to fetch zones: %v", err)
+		writePricingError(w, err, "GET_ALL_FAILED", "Could not fetch zones")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, helpers.Response[[]*dto.ZoneDTO]{
+		Success: true,
+		Data:    zones,
+		Length:  len(zones),
+	})
+}
+
+func (h *PricingController) CreateZone(w http.ResponseWriter, r *http.Request) {
+	var req zoneRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		log.Printf("[controller:pricing][CreateZone] failed to decode request body '%v': %v", req, err)
+		writeJSON(w, http.StatusBadRequest, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "INVALID_REQUEST_BODY",
+				Message: "Invalid JSON format or fields",
+			},
+		})
+		return
+	}
+
+	cmd := commands.CreateZoneCommand{
+		Name:      req.Name,
+		Latitude:  req.Latitude,
+		Longitude: req.Longitude,
+		Radius:    req.Radius,
+		Surcharge: req.Surcharge.Amount,
+		Currency:  req.Surcharge.Currency,
+	}
+	if req.Boundary != nil {
+		cmd.Boundary = *req.Boundary
+	}
+
+	zone, err := h.cmdHandler.HandleCreateZone(r.Context(), cmd)
+	if err != nil {
+		log.Printf("[controller:pricing][CreateZone] failed to create zone '%s': %v", req.Name, err)
+		writePricingError(w, err, "CREATE_FAILED", "Could not create zone")
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, helpers.Response[*dto.ZoneDTO]{
+		Success: true,
+		Data:    mappers.MapToZoneDTO(zone),
+	})
+}
+
+func (h *PricingController) DeleteZone(w http.ResponseWriter, r *http.Request) {
+	id, ok := parsePricingPath(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.cmdHandler.HandleDeleteZone(r.Context(), commands.DeleteZoneCommand{Id: id}); err != nil {
+		log.Printf("[controller:pricing][DeleteZone] failed to delete zone '%s': %v", id, err)
+		writePricingError(w, err, "DELETE_FAILED", "Could not delete zone")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, helpers.Response[any]{
+		Success: true,
+	})
+}
+
+func (h *PricingController) CheckCoverage(w http.ResponseWriter, r *http.Request) {
+	latitude, err := parseFloatParam(r, "latitude")
+	if err != nil {
+		log.Printf("[controller:pricing][CheckCoverage] invalid query params: %v", err)
+		writeInvalidQuery(w, err)
+		return
+	}
+
+	longitude, err := parseFloatParam(r, "longitude")
+	if err != nil {
+		log.Printf("[controller:pricing][CheckCoverage] invalid query params: %v", err)
+		writeInvalidQuery(w, err)
+		return
+	}
+
+	qry := queries.CheckCoverageQuery{Latitude: latitude, Longitude: longitude}
+	coverage, err := h.qryHandler.HandleCheckCoverage(r.Context(), qry)
+	if errors.Is(err, vo.ErrOutOfBoundariesLatitude) || errors.Is(err, vo.ErrOutOfBoundariesLongitude) {
+		log.Printf("[controller:pricing][CheckCoverage] invalid coordinates: %v", err)
+		writeInvalidQuery(w, err)
+		return
+	} else if err != nil {
+		log.Printf("[controller:pricing][CheckCoverage] failed to check coverage of %.6f, %.6f: %v", qry.Latitude, qry.Longitude, err)
+		writePricingError(w, err, "COVERAGE_FAILED", "Could not check the address coverage")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, helpers.Response[*dto.CoverageDTO]{
+		Success: true,
+		Data:    coverage,
+	})
+}
+
+func (h *PricingController) GetAllPromoCodes(w http.ResponseWriter, r *http.Request) {
+	codes, err := h.qryHandler.HandleGetPromoCodes(r.Context(), queries.GetPromoCodesQuery{})
+	if err != nil {
+		log.Printf("[controller:pricing][GetAllPromoCodes] failed to fetch promo codes: %v", err)
+		writePricingError(w, err, "GET_ALL_FAILED", "Could not fetch promo codes")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, helpers.Response[[]*dto.PromoCodeDTO]{
+		Success: true,
+		Data:    codes,
+		Length:  len(codes),
+	})
+}
+
+func (h *PricingController) CreatePromoCode(w http.ResponseWriter, r *http.Request) {
+	var req promoCodeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		log.Printf("[controller:pricing][CreatePromoCode] failed to decode request body '%v': %v", req, err)
+		writeJSON(w, http.StatusBadRequest, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "INVALID_REQUEST_BODY",
+				Message: "Invalid JSON format or fields",
+			},
+		})
+		return
+	}
+
+	cmd := commands.CreatePromoCodeCommand{
+		Code:       req.Code,
+		Kind:       req.Kind,
+		Value:      req.Value,
+		Currency:   req.Currency,
+		ValidFrom:  req.ValidFrom,
+		ValidUntil: req.ValidUntil,
+		MaxUses:    req.MaxUses,
+	}
+
+	promo, err := h.cmdHandler.HandleCreatePromoCode(r.Context(), cmd)
+	if err != nil {
+		log.Printf("[controller:pricing][CreatePromoCode] failed to create promo code '%s': %v", req.Code, err)
+		writePricingError(w, err, "CREATE_FAILED", "Could not create promo code")
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, helpers.Response[*dto.PromoCodeDTO]{
+		Success: true,
+		Data:    mappers.MapToPromoCodeDTO(promo),
+	})
+}
+
+func (h *PricingController) DeletePromoCode(w http.ResponseWriter, r *http.Request) {
+	id, ok := parsePricingPath(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.cmdHandler.HandleDeletePromoCode(r.Context(), commands.DeletePromoCodeCommand{Id: id}); err != nil {
+		log.Printf("[controller:pricing][DeletePromoCode] failed to delete promo code '%s': %v", id, err)
+		writePricingError(w, err, "DELETE_FAILED", "Could not delete promo code")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, helpers.Response[any]{
+		Success: true,
+	})
+}
+
+func (h *PricingController) RegisterRoutes(r chi.Router) {
+	r.Use(middleware.Allow(middleware.Administrators))
+
+	r.Get("/zones", h.GetAllZones)
+	r.Post("/zones", h.CreateZone)
+	r.Delete("/zones/{id}", h.DeleteZone)
+	r.Get("/coverage", h.CheckCoverage)
+
+	r.Get("/promo-codes", h.GetAllPromoCodes)
+	r.Post("/promo-codes", h.CreatePromoCode)
+	r.Delete("/promo-codes/{id}", h.DeletePromoCode)
+}
+
+func writePricingError(w http.ResponseWriter, err error, code, message string) {
+	status := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, pricing.ErrNotFoundZone):
+		status, code, message = http.StatusNotFound, "NOT_FOUND", "Zone not found"
+	case errors.Is(err, pricing.ErrNotFoundPromoCode):
+		status, code, message = http.StatusNotFound, "NOT_FOUND", "Promo code not found"
+	case errors.Is(err, pricing.ErrExistPromoCode):
+		status, code, message = http.StatusConflict, "ALREADY_EXISTS", err.Error()
+	case errors.Is(err, pricing.ErrEmptyNameZone), errors.Is(err, pricing.ErrLongNameZone), errors.Is(err, pricing.ErrRadiusZone),
+		errors.Is(err, pricing.ErrSurchargeZone), errors.Is(err, vo.ErrOutOfBoundariesLatitude), errors.Is(err, vo.ErrOutOfBoundariesLongitude),
+		errors.Is(err, vo.ErrEmptyPolygon), errors.Is(err, vo.ErrRingPolygon), errors.Is(err, vo.ErrGeoJSONPolygon):
+		status, code, message = http.StatusBadRequest, "INVALID_ZONE", err.Error()
+	case errors.Is(err, pricing.ErrCodePromoCode), errors.Is(err, pricing.ErrKindPromoCode), errors.Is(err, pricing.ErrValuePromoCode),
+		errors.Is(err, pricing.ErrValidityPromoCode), errors.Is(err, pricing.ErrMaxUsesPromoCode):
+		status, code, message = http.StatusBadRequest, "INVALID_PROMO_CODE", err.Error()
+	case errors.Is(err, vo.ErrCurrencyMoney):
+		status, code, message = http.StatusBadRequest, "INVALID_CURRENCY", err.Error()
+	}
+
+	writeJSON(w, status, helpers.Response[any]{
+		Success: false,
+		Error: &helpers.Error{
+			Code:    code,
+			Message: message,
+		},
+	})
+}
+
+func parsePricingPath(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	idStr := chi.URLParam(r, "id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		log.Printf("[controller:pricing][parsePricingPath] invalid UUID format '%s': %v", idStr, err)
+		writeJSON(w, http.StatusBadRequest, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "INVALID_ID_FORMAT",
+				Message: "The provided ID is not a valid UUID",
+			},
+		})
+		return uuid.Nil, false
+	}
+
+	return id, true
+}
@@ -0,0 +1,224 @@
+package controllers
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/slot/commands"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/slot/dto"
+	command "github.com/carlosclavijo/Nutricenter-Contracting/internal/application/slot/handlers"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/slot/mappers"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/application/slot/queries"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/pricing"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/slot"
+	query "github.com/carlosclavijo/Nutricenter-Contracting/internal/infrastructure/handlers/slot"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/infrastructure/persistence"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/infrastructure/persistence/repositories"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/web/helpers"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/web/middleware"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"log"
+	"net/http"
+)
+
+type SlotController struct {
+	cmdHandler command.SlotHandler
+	qryHandler query.SlotHandler
+}
+
+func NewSlotController(db *sql.DB) *SlotController {
+	repo := repositories.NewSlotRepository(db)
+	cmdHandler := command.NewSlotHandler(repo, repositories.NewZoneRepository(db), slots.NewSlotFactory(), persistence.NewUnitOfWork(db))
+	qryHandler := query.NewSlotHandler(repo)
+	return &SlotController{*cmdHandler, *qryHandler}
+}
+
+type slotRequest struct {
+	Name     string `json:"name"`
+	Start    string `json:"start"`
+	End      string `json:"end"`
+	Capacity int    `json:"capacity"`
+}
+
+func (h *SlotController) GetAllSlots(w http.ResponseWriter, r *http.Request) {
+	zoneId, err := parseUUIDParam(r, "zone_id")
+	if err != nil {
+		log.Printf("[controller:slot][GetAllSlots] invalid query parameters: %v", err)
+		writeInvalidQuery(w, err)
+		return
+	}
+
+	list, err := h.qryHandler.HandleGetAll(r.Context(), queries.GetAllSlotsQuery{ZoneId: zoneId})
+	if err != nil {
+		log.Printf("[controller:slot][GetAllSlots] failed to fetch slots: %v", err)
+		writeSlotError(w, err, "GET_ALL_FAILED", "Could not fetch slots")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, helpers.Response[[]*dto.SlotDTO]{
+		Success: true,
+		Data:    list,
+		Length:  len(list),
+	})
+}
+
+func (h *SlotController) GetSlotById(w http.ResponseWriter, r *http.Request) {
+	id, ok := parseSlotPath(w, r)
+	if !ok {
+		return
+	}
+
+	slot, err := h.qryHandler.HandleGetById(r.Context(), queries.GetSlotByIdQuery{Id: id})
+	if err != nil {
+		log.Printf("[controller:slot][GetSlotById] failed to fetch slot '%s': %v", id, err)
+		writeSlotError(w, err, "GET_FAILED", "Could not fetch slot")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, helpers.Response[*dto.SlotDTO]{
+		Success: true,
+		Data:    slot,
+	})
+}
+
+func (h *SlotController) CreateSlot(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		ZoneId uuid.UUID `json:"zone_id"`
+		slotRequest
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		log.Printf("[controller:slot][CreateSlot] failed to decode request body '%v': %v", req, err)
+		writeJSON(w, http.StatusBadRequest, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "INVALID_REQUEST_BODY",
+				Message: "Invalid JSON format or fields",
+			},
+		})
+		return
+	}
+
+	slot, err := h.cmdHandler.HandleCreate(r.Context(), commands.CreateSlotCommand{
+		ZoneId:   req.ZoneId,
+		Name:     req.Name,
+		Start:    req.Start,
+		End:      req.End,
+		Capacity: req.Capacity,
+	})
+	if err != nil {
+		log.Printf("[controller:slot][CreateSlot] failed to create slot '%s': %v", req.Name, err)
+		writeSlotError(w, err, "CREATE_FAILED", "Could not create slot")
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, helpers.Response[*dto.SlotDTO]{
+		Success: true,
+		Data:    mappers.MapToSlotDTO(slot),
+	})
+}
+
+func (h *SlotController) UpdateSlot(w http.ResponseWriter, r *http.Request) {
+	id, ok := parseSlotPath(w, r)
+	if !ok {
+		return
+	}
+
+	var req slotRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		log.Printf("[controller:slot][UpdateSlot] failed to decode request body '%v': %v", req, err)
+		writeJSON(w, http.StatusBadRequest, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "INVALID_REQUEST_BODY",
+				Message: "Invalid JSON format or fields",
+			},
+		})
+		return
+	}
+
+	slot, err := h.cmdHandler.HandleUpdate(r.Context(), commands.UpdateSlotCommand{
+		Id:       id,
+		Name:     req.Name,
+		Start:    req.Start,
+		End:      req.End,
+		Capacity: req.Capacity,
+	})
+	if err != nil {
+		log.Printf("[controller:slot][UpdateSlot] failed to update slot '%s': %v", id, err)
+		writeSlotError(w, err, "UPDATE_FAILED", "Could not update slot")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, helpers.Response[*dto.SlotDTO]{
+		Success: true,
+		Data:    mappers.MapToSlotDTO(slot),
+	})
+}
+
+func (h *SlotController) DeleteSlot(w http.ResponseWriter, r *http.Request) {
+	id, ok := parseSlotPath(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.cmdHandler.HandleDelete(r.Context(), commands.DeleteSlotCommand{Id: id}); err != nil {
+		log.Printf("[controller:slot][DeleteSlot] failed to delete slot '%s': %v", id, err)
+		writeSlotError(w, err, "DELETE_FAILED", "Could not delete slot")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, helpers.Response[any]{
+		Success: true,
+	})
+}
+
+func (h *SlotController) RegisterRoutes(r chi.Router) {
+	administrators := middleware.Allow(middleware.Administrators)
+
+	r.Get("/", h.GetAllSlots)
+	r.Get("/{id}", h.GetSlotById)
+	r.With(administrators).Post("/", h.CreateSlot)
+	r.With(administrators).Put("/{id}", h.UpdateSlot)
+	r.With(administrators).Delete("/{id}", h.DeleteSlot)
+}
+
+func writeSlotError(w http.ResponseWriter, err error, code, message string) {
+	status := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, slots.ErrNotFoundSlot):
+		status, code, message = http.StatusNotFound, "NOT_FOUND", "Slot not found"
+	case errors.Is(err, pricing.ErrNotFoundZone):
+		status, code, message = http.StatusNotFound, "ZONE_NOT_FOUND", "Zone not found"
+	case errors.Is(err, slots.ErrZoneIdSlot), errors.Is(err, slots.ErrEmptyNameSlot), errors.Is(err, slots.ErrLongNameSlot),
+		errors.Is(err, slots.ErrClockSlot), errors.Is(err, slots.ErrWindowSlot), errors.Is(err, slots.ErrCapacitySlot):
+		status, code, message = http.StatusBadRequest, "INVALID_SLOT", err.Error()
+	}
+
+	writeJSON(w, status, helpers.Response[any]{
+		Success: false,
+		Error: &helpers.Error{
+			Code:    code,
+			Message: message,
+		},
+	})
+}
+
+func parseSlotPath(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	idStr := chi.URLParam(r, "id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		log.Printf("[controller:slot][parseSlotPath] invalid UUID format '%s': %v", idStr, err)
+		writeJSON(w, http.StatusBadRequest, helpers.Response[any]{
+			Success: false,
+			Error: &helpers.Error{
+				Code:    "INVALID_ID_FORMAT",
+				Message: "The provided ID is not a valid UUID",
+			},
+		})
+		return uuid.Nil, false
+	}
+
+	return id, true
+}
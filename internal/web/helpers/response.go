@@ -4,6 +4,7 @@ type Response[T any] struct {
 	Success bool   `json:"success"`
 	Length  int    `json:"length,omitempty"`
 	Data    T      `json:"data,omitempty"`
+	Meta    *Meta  `json:"meta,omitempty"`
 	Error   *Error `json:"error,omitempty"`
 }
 
@@ -11,3 +12,10 @@ type Error struct {
 	Code    string `json:"code"`
 	Message string `json:"message"`
 }
+
+type Meta struct {
+	Total      int    `json:"total"`
+	Page       int    `json:"page"`
+	Limit      int    `json:"limit"`
+	NextCursor string `json:"next_cursor,omitempty"`
+}
@@ -0,0 +1,68 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/infrastructure/auth"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/web/helpers"
+	"log"
+	"net/http"
+	"strings"
+)
+
+type principalKey struct{}
+
+type Authenticator interface {
+	Authenticate(ctx context.Context, raw string) (*auth.Principal, error)
+}
+
+func Authenticate(a Authenticator) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			raw, ok := bearerToken(r)
+			if !ok {
+				unauthorized(w, "MISSING_TOKEN", "Authorization bearer token is required")
+				return
+			}
+
+			principal, err := a.Authenticate(r.Context(), raw)
+			if err != nil {
+				log.Printf("[middleware:auth][Authenticate] rejected token for %s %s: %v", r.Method, r.URL.Path, err)
+				unauthorized(w, "INVALID_TOKEN", "Token is invalid, expired or revoked")
+				return
+			}
+
+			next.ServeHTTP(w, r.WithContext(WithPrincipal(r.Context(), principal)))
+		})
+	}
+}
+
+func WithPrincipal(ctx context.Context, p *auth.Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, p)
+}
+
+func PrincipalFromContext(ctx context.Context) (*auth.Principal, bool) {
+	p, ok := ctx.Value(principalKey{}).(*auth.Principal)
+	return p, ok
+}
+
+func bearerToken(r *http.Request) (string, bool) {
+	scheme, token, ok := strings.Cut(r.Header.Get("Authorization"), " ")
+	if !ok || !strings.EqualFold(scheme, auth.TokenType) || strings.TrimSpace(token) == "" {
+		return "", false
+	}
+	return strings.TrimSpace(token), true
+}
+
+func unauthorized(w http.ResponseWriter, code, message string) {
+	w.Header().Set("WWW-Authenticate", auth.TokenType)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusUnauthorized)
+	_ = json.NewEncoder(w).Encode(helpers.Response[any]{
+		Success: false,
+		Error: &helpers.Error{
+			Code:    code,
+			Message: message,
+		},
+	})
+}
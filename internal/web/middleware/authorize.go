@@ -0,0 +1,194 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/token"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/infrastructure/auth"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/web/helpers"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"io"
+	"log"
+	"net/http"
+)
+
+const maxPolicyBodySize = 1 << 20
+
+var (
+	ErrForbidden          = errors.New("principal is not allowed to access the resource")
+	ErrInvalidIdPolicy    = errors.New("resource id is not a valid UUID")
+	ErrNotFoundPolicy     = errors.New("resource not found")
+	ErrMissingFieldPolicy = errors.New("resource id field is missing")
+)
+
+type Rule func(r *http.Request, p *auth.Principal) (bool, error)
+
+type IDSource func(r *http.Request) (uuid.UUID, error)
+
+type ContractLookup func(ctx context.Context, id uuid.UUID) (administratorId, patientId uuid.UUID, err error)
+
+func Allow(rules ...Rule) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			p, ok := PrincipalFromContext(r.Context())
+			if !ok {
+				unauthorized(w, "MISSING_TOKEN", "Authorization bearer token is required")
+				return
+			}
+
+			for _, rule := range rules {
+				granted, err := rule(r, p)
+				if err != nil {
+					log.Printf("[middleware:authorize][Allow] policy evaluation failed for %s %s: %v", r.Method, r.URL.Path, err)
+					policyError(w, err)
+					return
+				}
+				if granted {
+					next.ServeHTTP(w, r)
+					return
+				}
+			}
+
+			log.Printf("[middleware:authorize][Allow] %s '%s' denied on %s %s", p.Role, p.SubjectId, r.Method, r.URL.Path)
+			policyError(w, ErrForbidden)
+		})
+	}
+}
+
+func Roles(roles ...tokens.Role) Rule {
+	return func(_ *http.Request, p *auth.Principal) (bool, error) {
+		for _, role := range roles {
+			if p.Role == role {
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+}
+
+var (
+	Administrators      = Roles(tokens.Administrator, tokens.SuperAdministrator)
+	SuperAdministrators = Roles(tokens.SuperAdministrator)
+)
+
+func Self(role tokens.Role, src IDSource) Rule {
+	return func(r *http.Request, p *auth.Principal) (bool, error) {
+		if p.Role != role {
+			return false, nil
+		}
+
+		id, err := src(r)
+		if err != nil {
+			return false, err
+		}
+		return id == p.SubjectId, nil
+	}
+}
+
+func ContractOwner(lookup ContractLookup, src IDSource) Rule {
+	return func(r *http.Request, p *auth.Principal) (bool, error) {
+		if !p.Role.IsAdministrator() {
+			return false, nil
+		}
+
+		id, err := src(r)
+		if err != nil {
+			return false, err
+		}
+
+		administratorId, _, err := lookup(r.Context(), id)
+		if err != nil {
+			return false, err
+		}
+		return administratorId == p.SubjectId, nil
+	}
+}
+
+func ContractPatient(lookup ContractLookup, src IDSource) Rule {
+	return func(r *http.Request, p *auth.Principal) (bool, error) {
+		if p.Role != tokens.Patient {
+			return false, nil
+		}
+
+		id, err := src(r)
+		if err != nil {
+			return false, err
+		}
+
+		_, patientId, err := lookup(r.Context(), id)
+		if err != nil {
+			return false, err
+		}
+		return patientId == p.SubjectId, nil
+	}
+}
+
+func URLParam(name string) IDSource {
+	return func(r *http.Request) (uuid.UUID, error) {
+		raw := chi.URLParam(r, name)
+		id, err := uuid.Parse(raw)
+		if err != nil {
+			return uuid.Nil, fmt.Errorf("%w: got %s", ErrInvalidIdPolicy, raw)
+		}
+		return id, nil
+	}
+}
+
+func BodyField(name string) IDSource {
+	return func(r *http.Request) (uuid.UUID, error) {
+		body, err := io.ReadAll(io.LimitReader(r.Body, maxPolicyBodySize))
+		if err != nil {
+			return uuid.Nil, fmt.Errorf("%w: %w", ErrMissingFieldPolicy, err)
+		}
+		_ = r.Body.Close()
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		var fields map[string]json.RawMessage
+		if err = json.Unmarshal(body, &fields); err != nil {
+			return uuid.Nil, fmt.Errorf("%w: %w", ErrMissingFieldPolicy, err)
+		}
+
+		raw, ok := fields[name]
+		if !ok {
+			return uuid.Nil, fmt.Errorf("%w: got %s", ErrMissingFieldPolicy, name)
+		}
+
+		var value string
+		if err = json.Unmarshal(raw, &value); err != nil {
+			return uuid.Nil, fmt.Errorf("%w: got %s", ErrInvalidIdPolicy, raw)
+		}
+
+		id, err := uuid.Parse(value)
+		if err != nil {
+			return uuid.Nil, fmt.Errorf("%w: got %s", ErrInvalidIdPolicy, value)
+		}
+		return id, nil
+	}
+}
+
+func policyError(w http.ResponseWriter, err error) {
+	status, code, message := http.StatusInternalServerError, "AUTHORIZATION_FAILED", "Could not evaluate access policy"
+
+	switch {
+	case errors.Is(err, ErrForbidden):
+		status, code, message = http.StatusForbidden, "FORBIDDEN", "You are not allowed to access this resource"
+	case errors.Is(err, ErrNotFoundPolicy):
+		status, code, message = http.StatusNotFound, "NOT_FOUND", "The requested resource does not exist"
+	case errors.Is(err, ErrInvalidIdPolicy), errors.Is(err, ErrMissingFieldPolicy):
+		status, code, message = http.StatusBadRequest, "INVALID_ID_FORMAT", "The provided ID is not a valid UUID"
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(helpers.Response[any]{
+		Success: false,
+		Error: &helpers.Error{
+			Code:    code,
+			Message: message,
+		},
+	})
+}
@@ -2,30 +2,99 @@ package web
 
 import (
 	"database/sql"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/domain/abstractions"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/infrastructure/auth"
 	"github.com/carlosclavijo/Nutricenter-Contracting/internal/web/controllers"
+	"github.com/carlosclavijo/Nutricenter-Contracting/internal/web/middleware"
 	"github.com/go-chi/chi/v5"
+	"net/http"
 )
 
 type Routes struct {
+	AuthController          *controllers.AuthController
 	AdministratorController *controllers.AdministratorController
 	PatientController       *controllers.PatientController
 	ContractController      *controllers.ContractController
+	PlanController          *controllers.PlanController
+	HolidayController       *controllers.HolidayController
+	PricingController       *controllers.PricingController
+	InvoiceController       *controllers.InvoiceController
+	LockoutController       *controllers.LockoutController
+	DispatchController      *controllers.DispatchController
+	CourierController       *controllers.CourierController
+	SlotController          *controllers.SlotController
+	authenticate            func(http.Handler) http.Handler
 }
 
-func NewRoutes(db *sql.DB) *Routes {
+func NewRoutes(db *sql.DB, a *auth.Service, n abstractions.Notifier) *Routes {
 	return &Routes{
-		AdministratorController: controllers.NewAdministratorController(db),
-		PatientController:       controllers.NewPatientController(db),
+		AuthController:          controllers.NewAuthController(db, a, n),
+		AdministratorController: controllers.NewAdministratorController(db, a),
+		PatientController:       controllers.NewPatientController(db, a),
 		ContractController:      controllers.NewContractController(db),
+		PlanController:          controllers.NewPlanController(db),
+		HolidayController:       controllers.NewHolidayController(db),
+		PricingController:       controllers.NewPricingController(db),
+		InvoiceController:       controllers.NewInvoiceController(db),
+		LockoutController:       controllers.NewLockoutController(db),
+		DispatchController:      controllers.NewDispatchController(db),
+		CourierController:       controllers.NewCourierController(db),
+		SlotController:          controllers.NewSlotController(db),
+		authenticate:            middleware.Authenticate(a),
 	}
 }
 
 func (r *Routes) Router() chi.Router {
 	mux := chi.NewRouter()
 
-	mux.Route("/administrators", r.AdministratorController.RegisterRoutes)
-	mux.Route("/patients", r.PatientController.RegisterRoutes)
-	mux.Route("/contracts", r.ContractController.RegisterRoutes)
+	mux.Route("/auth", func(m chi.Router) {
+		r.AuthController.RegisterPublicRoutes(m)
+		m.With(r.authenticate).Group(r.AuthController.RegisterRoutes)
+	})
+	mux.Route("/administrators", func(m chi.Router) {
+		r.AdministratorController.RegisterPublicRoutes(m)
+		m.With(r.authenticate).Group(r.AdministratorController.RegisterRoutes)
+	})
+	mux.Route("/patients", func(m chi.Router) {
+		r.PatientController.RegisterPublicRoutes(m)
+		m.With(r.authenticate).Group(r.PatientController.RegisterRoutes)
+	})
+	mux.Route("/contracts", func(m chi.Router) {
+		m.Use(r.authenticate)
+		r.ContractController.RegisterRoutes(m)
+	})
+	mux.Route("/plans", func(m chi.Router) {
+		m.Use(r.authenticate)
+		r.PlanController.RegisterRoutes(m)
+	})
+	mux.Route("/holidays", func(m chi.Router) {
+		m.Use(r.authenticate)
+		r.HolidayController.RegisterRoutes(m)
+	})
+	mux.Route("/pricing", func(m chi.Router) {
+		m.Use(r.authenticate)
+		r.PricingController.RegisterRoutes(m)
+	})
+	mux.Route("/invoices", func(m chi.Router) {
+		m.Use(r.authenticate)
+		r.InvoiceController.RegisterRoutes(m)
+	})
+	mux.Route("/lockouts", func(m chi.Router) {
+		m.Use(r.authenticate)
+		r.LockoutController.RegisterRoutes(m)
+	})
+	mux.Route("/dispatch", func(m chi.Router) {
+		m.Use(r.authenticate)
+		r.DispatchController.RegisterRoutes(m)
+	})
+	mux.Route("/couriers", func(m chi.Router) {
+		m.Use(r.authenticate)
+		r.CourierController.RegisterRoutes(m)
+	})
+	mux.Route("/slots", func(m chi.Router) {
+		m.Use(r.authenticate)
+		r.SlotController.RegisterRoutes(m)
+	})
 
 	return mux
 }
@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE token
+(
+    id         UUID PRIMARY KEY,
+    subject_id UUID      NOT NULL,
+    role       CHAR(1)   NOT NULL CHECK (role IN ('A', 'P')),
+    kind       CHAR(1)   NOT NULL CHECK (kind IN ('A', 'R')),
+    expires_at TIMESTAMP NOT NULL,
+    revoked_at TIMESTAMP          DEFAULT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+-- Role A = Administrator, P = Patient
+-- Kind A = Access, R = Refresh
+
+CREATE INDEX IF NOT EXISTS idx_token_subject_id ON token (subject_id) WHERE revoked_at IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS token;
+-- +goose StatementEnd
@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE super_administrator
+(
+    administrator_id UUID PRIMARY KEY REFERENCES administrator (id) ON DELETE CASCADE,
+    created_at       TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+ALTER TABLE token DROP CONSTRAINT IF EXISTS token_role_check;
+ALTER TABLE token ADD CONSTRAINT token_role_check CHECK (role IN ('A', 'S', 'P'));
+-- Role S = Super Administrator
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE token DROP CONSTRAINT IF EXISTS token_role_check;
+ALTER TABLE token ADD CONSTRAINT token_role_check CHECK (role IN ('A', 'P'));
+
+DROP TABLE IF EXISTS super_administrator;
+-- +goose StatementEnd
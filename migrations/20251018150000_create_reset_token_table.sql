@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE reset_token
+(
+    id         UUID PRIMARY KEY,
+    subject_id UUID      NOT NULL,
+    role       CHAR(1)   NOT NULL CHECK (role IN ('A', 'S', 'P')),
+    hash       CHAR(64)  NOT NULL UNIQUE,
+    expires_at TIMESTAMP NOT NULL,
+    used_at    TIMESTAMP          DEFAULT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+-- Hash is the hex encoded SHA-256 of the secret sent to the user
+
+CREATE INDEX IF NOT EXISTS idx_reset_token_subject_id ON reset_token (subject_id) WHERE used_at IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS reset_token;
+-- +goose StatementEnd
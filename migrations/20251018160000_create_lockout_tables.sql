@@ -0,0 +1,35 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE login_throttle
+(
+    scope           CHAR(1)      NOT NULL CHECK (scope IN ('A', 'I')),
+    key             VARCHAR(255) NOT NULL,
+    failures        INT          NOT NULL DEFAULT 0,
+    locked_until    TIMESTAMP             DEFAULT NULL,
+    last_failure_at TIMESTAMP             DEFAULT NULL,
+    PRIMARY KEY (scope, key)
+);
+-- Scope A = Account (role:email), I = IP address
+
+CREATE TABLE lockout_event
+(
+    id           UUID PRIMARY KEY,
+    scope        CHAR(1)      NOT NULL CHECK (scope IN ('A', 'I')),
+    key          VARCHAR(255) NOT NULL,
+    type         CHAR(1)      NOT NULL CHECK (type IN ('L', 'U')),
+    failures     INT          NOT NULL,
+    locked_until TIMESTAMP             DEFAULT NULL,
+    actor_id     UUID                  DEFAULT NULL REFERENCES administrator (id),
+    created_at   TIMESTAMP    NOT NULL DEFAULT NOW()
+);
+-- Type L = Locked, U = Unlocked
+
+CREATE INDEX IF NOT EXISTS idx_lockout_event_key ON lockout_event (scope, key, created_at);
+CREATE INDEX IF NOT EXISTS idx_lockout_event_created_at ON lockout_event (created_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS lockout_event;
+DROP TABLE IF EXISTS login_throttle;
+-- +goose StatementEnd
@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE outbox
+(
+    id           UUID PRIMARY KEY,
+    aggregate_id UUID         NOT NULL,
+    name         VARCHAR(100) NOT NULL,
+    payload      JSONB        NOT NULL,
+    occurred_on  TIMESTAMP    NOT NULL,
+    published_at TIMESTAMP             DEFAULT NULL,
+    attempts     INT          NOT NULL DEFAULT 0,
+    last_error   TEXT                  DEFAULT NULL,
+    created_at   TIMESTAMP    NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_outbox_pending ON outbox (occurred_on) WHERE published_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_outbox_aggregate_id ON outbox (aggregate_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS outbox;
+-- +goose StatementEnd
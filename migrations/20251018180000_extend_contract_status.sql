@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE contract DROP CONSTRAINT IF EXISTS contract_status_check;
+ALTER TABLE contract
+    ADD CONSTRAINT contract_status_check CHECK (status IN ('C', 'A', 'F', 'S', 'N')),
+    ADD COLUMN status_reason VARCHAR(255) DEFAULT NULL,
+    ADD COLUMN suspended_at  TIMESTAMP    DEFAULT NULL;
+-- Status C = Created, A = Active, F = Finalized, S = Suspended, N = Cancelled
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE contract DROP CONSTRAINT IF EXISTS contract_status_check;
+ALTER TABLE contract
+    DROP COLUMN IF EXISTS suspended_at,
+    DROP COLUMN IF EXISTS status_reason,
+    ADD CONSTRAINT contract_status_check CHECK (status IN ('C', 'A', 'F'));
+-- +goose StatementEnd
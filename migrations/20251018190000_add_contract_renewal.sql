@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE contract
+    ADD COLUMN auto_renew   BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD COLUMN renewed_from UUID             DEFAULT NULL REFERENCES contract (id);
+
+-- A contract can only be renewed once.
+CREATE UNIQUE INDEX IF NOT EXISTS idx_contract_renewed_from ON contract (renewed_from) WHERE renewed_from IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_contract_auto_renew ON contract (finalized) WHERE auto_renew;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_contract_auto_renew;
+DROP INDEX IF EXISTS idx_contract_renewed_from;
+ALTER TABLE contract
+    DROP COLUMN IF EXISTS renewed_from,
+    DROP COLUMN IF EXISTS auto_renew;
+-- +goose StatementEnd
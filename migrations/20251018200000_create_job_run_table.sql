@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE job_run
+(
+    id          UUID PRIMARY KEY,
+    name        VARCHAR(100) NOT NULL,
+    instance    VARCHAR(255) NOT NULL,
+    started_at  TIMESTAMP    NOT NULL,
+    finished_at TIMESTAMP             DEFAULT NULL,
+    status      CHAR(1)      NOT NULL DEFAULT 'R' CHECK (status IN ('R', 'S', 'F')),
+    processed   INT          NOT NULL DEFAULT 0,
+    error       TEXT                  DEFAULT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_job_run_name_started_at ON job_run (name, started_at DESC);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS job_run;
+-- +goose StatementEnd
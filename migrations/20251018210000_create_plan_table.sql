@@ -0,0 +1,36 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE plan
+(
+    id            UUID PRIMARY KEY,
+    code          VARCHAR(20)  NOT NULL UNIQUE,
+    name          VARCHAR(100) NOT NULL,
+    duration_days INT          NOT NULL CHECK (duration_days BETWEEN 1 AND 365),
+    pattern       CHAR(1)      NOT NULL CHECK (pattern IN ('D', 'E', 'W')),
+    base_price    INT          NOT NULL DEFAULT 0 CHECK (base_price >= 0),
+    active        BOOLEAN      NOT NULL DEFAULT TRUE,
+    created_at    TIMESTAMP    NOT NULL DEFAULT NOW(),
+    updated_at    TIMESTAMP    NOT NULL DEFAULT NOW(),
+    deleted_at    TIMESTAMP             DEFAULT NULL
+);
+-- Pattern D = Daily, E = Every other day, W = Weekly
+
+-- The plans contracts were sold under before the catalog existed.
+INSERT INTO plan (id, code, name, duration_days, pattern)
+VALUES (gen_random_uuid(), 'H', 'Half-month', 15, 'D'),
+       (gen_random_uuid(), 'M', 'Monthly', 30, 'D');
+
+ALTER TABLE contract DROP CONSTRAINT IF EXISTS contract_type_check;
+ALTER TABLE contract
+    ALTER COLUMN type TYPE VARCHAR(20),
+    ADD CONSTRAINT contract_type_fkey FOREIGN KEY (type) REFERENCES plan (code);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE contract DROP CONSTRAINT IF EXISTS contract_type_fkey;
+ALTER TABLE contract
+    ALTER COLUMN type TYPE CHAR(1),
+    ADD CONSTRAINT contract_type_check CHECK (type IN ('H', 'M'));
+DROP TABLE IF EXISTS plan;
+-- +goose StatementEnd
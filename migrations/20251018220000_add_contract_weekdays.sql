@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE contract
+    ADD COLUMN weekdays SMALLINT NOT NULL DEFAULT 127 CHECK (weekdays BETWEEN 1 AND 127);
+-- Weekdays is a bit mask from Sunday (1) to Saturday (64), 127 = every day
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE contract
+    DROP COLUMN IF EXISTS weekdays;
+-- +goose StatementEnd
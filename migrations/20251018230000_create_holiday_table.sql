@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE holiday
+(
+    id         UUID PRIMARY KEY,
+    date       DATE             NOT NULL,
+    name       VARCHAR(100)     NOT NULL,
+    area_name  VARCHAR(100)              DEFAULT NULL,
+    latitude   DOUBLE PRECISION          DEFAULT NULL,
+    longitude  DOUBLE PRECISION          DEFAULT NULL,
+    radius     INT                       DEFAULT NULL CHECK (radius > 0),
+    policy     CHAR(1)          NOT NULL DEFAULT 'S' CHECK (policy IN ('S', 'R')),
+    created_at TIMESTAMP        NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP        NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMP                 DEFAULT NULL,
+    CHECK ((area_name IS NULL) = (radius IS NULL))
+);
+-- Policy S = Shift to the next open day, R = Refuse the date
+-- A holiday without area closes every address, otherwise the ones within radius metres of the center
+CREATE INDEX IF NOT EXISTS idx_holiday_date ON holiday (date) WHERE deleted_at IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_holiday_date;
+DROP TABLE IF EXISTS holiday;
+-- +goose StatementEnd
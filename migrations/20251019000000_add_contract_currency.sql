@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE contract
+    ADD COLUMN currency CHAR(3) NOT NULL DEFAULT 'BOB' CHECK (currency IN ('BOB', 'USD'));
+-- Cost is kept in the minor units (cents) of the currency, amounts stored so far are whole units
+UPDATE contract
+SET cost = cost * 100;
+UPDATE plan
+SET base_price = base_price * 100;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+UPDATE plan
+SET base_price = base_price / 100;
+UPDATE contract
+SET cost = cost / 100;
+ALTER TABLE contract
+    DROP COLUMN IF EXISTS currency;
+-- +goose StatementEnd
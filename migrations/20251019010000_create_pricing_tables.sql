@@ -0,0 +1,62 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE zone
+(
+    id         UUID PRIMARY KEY,
+    name       VARCHAR(100)     NOT NULL,
+    latitude   DOUBLE PRECISION NOT NULL,
+    longitude  DOUBLE PRECISION NOT NULL,
+    radius     INT              NOT NULL CHECK (radius > 0),
+    surcharge  INT              NOT NULL CHECK (surcharge > 0),
+    currency   CHAR(3)          NOT NULL DEFAULT 'BOB' CHECK (currency IN ('BOB', 'USD')),
+    created_at TIMESTAMP        NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP        NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMP                 DEFAULT NULL
+);
+-- The surcharge is charged on every delivery within radius metres of the center
+
+CREATE TABLE promo_code
+(
+    id          UUID PRIMARY KEY,
+    code        VARCHAR(30) NOT NULL,
+    kind        CHAR(1)     NOT NULL CHECK (kind IN ('P', 'F')),
+    value       INT         NOT NULL CHECK (value > 0),
+    currency    CHAR(3)     NOT NULL DEFAULT 'BOB' CHECK (currency IN ('BOB', 'USD')),
+    valid_from  TIMESTAMP   NOT NULL DEFAULT NOW(),
+    valid_until TIMESTAMP            DEFAULT NULL,
+    max_uses    INT         NOT NULL DEFAULT 0 CHECK (max_uses >= 0),
+    uses        INT         NOT NULL DEFAULT 0 CHECK (uses >= 0),
+    created_at  TIMESTAMP   NOT NULL DEFAULT NOW(),
+    updated_at  TIMESTAMP   NOT NULL DEFAULT NOW(),
+    deleted_at  TIMESTAMP            DEFAULT NULL,
+    CHECK (kind = 'F' OR value <= 10000),
+    CHECK (valid_until IS NULL OR valid_until >= valid_from)
+);
+-- Kind P = Percentage, value in basis points; F = Fixed, value in minor units of currency
+-- A max_uses of 0 means the code can be used without limit
+CREATE UNIQUE INDEX IF NOT EXISTS idx_promo_code_code ON promo_code (code) WHERE deleted_at IS NULL;
+
+CREATE TABLE contract_price
+(
+    contract_id UUID PRIMARY KEY REFERENCES contract (id),
+    currency    CHAR(3)   NOT NULL CHECK (currency IN ('BOB', 'USD')),
+    base        INT       NOT NULL CHECK (base >= 0),
+    deliveries  INT       NOT NULL CHECK (deliveries > 0),
+    zone        TEXT      NOT NULL DEFAULT '',
+    surcharge   INT       NOT NULL DEFAULT 0 CHECK (surcharge >= 0),
+    promo_code  TEXT      NOT NULL DEFAULT '',
+    discount    INT       NOT NULL DEFAULT 0 CHECK (discount >= 0),
+    taxes       JSONB     NOT NULL DEFAULT '[]',
+    total       INT       NOT NULL CHECK (total >= 0),
+    created_at  TIMESTAMP NOT NULL DEFAULT NOW()
+);
+-- Zone and promo code keep the names the contract was priced with, they survive both being deleted
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS contract_price;
+DROP INDEX IF EXISTS idx_promo_code_code;
+DROP TABLE IF EXISTS promo_code;
+DROP TABLE IF EXISTS zone;
+-- +goose StatementEnd
@@ -0,0 +1,49 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE invoice_sequence
+(
+    series     VARCHAR(10) PRIMARY KEY,
+    last_value INT NOT NULL CHECK (last_value > 0)
+);
+-- Holds the last number handed out per series, bumped in the transaction that issues the invoice
+
+CREATE TABLE invoice
+(
+    id          UUID PRIMARY KEY,
+    series      VARCHAR(10) NOT NULL REFERENCES invoice_sequence (series),
+    sequence    INT         NOT NULL CHECK (sequence > 0),
+    contract_id UUID        NOT NULL UNIQUE REFERENCES contract (id),
+    patient_id  UUID        NOT NULL REFERENCES patient (id),
+    amount      INT         NOT NULL CHECK (amount > 0),
+    currency    CHAR(3)     NOT NULL CHECK (currency IN ('BOB', 'USD')),
+    issued_at   TIMESTAMP   NOT NULL DEFAULT NOW(),
+    created_at  TIMESTAMP   NOT NULL DEFAULT NOW(),
+    updated_at  TIMESTAMP   NOT NULL DEFAULT NOW(),
+    UNIQUE (series, sequence)
+);
+CREATE INDEX IF NOT EXISTS idx_invoice_patient ON invoice (patient_id, issued_at);
+
+CREATE TABLE payment
+(
+    id         UUID PRIMARY KEY,
+    invoice_id UUID         NOT NULL REFERENCES invoice (id),
+    kind       CHAR(1)      NOT NULL CHECK (kind IN ('F', 'P', 'R')),
+    amount     INT          NOT NULL CHECK (amount > 0),
+    currency   CHAR(3)      NOT NULL CHECK (currency IN ('BOB', 'USD')),
+    provider   VARCHAR(30)  NOT NULL,
+    reference  VARCHAR(100) NOT NULL,
+    paid_at    TIMESTAMP    NOT NULL DEFAULT NOW(),
+    created_at TIMESTAMP    NOT NULL DEFAULT NOW()
+);
+-- Kind F = Full, P = Partial, R = Refund; the amount of a refund is given back to the patient
+CREATE INDEX IF NOT EXISTS idx_payment_invoice ON payment (invoice_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_payment_invoice;
+DROP TABLE IF EXISTS payment;
+DROP INDEX IF EXISTS idx_invoice_patient;
+DROP TABLE IF EXISTS invoice;
+DROP TABLE IF EXISTS invoice_sequence;
+-- +goose StatementEnd
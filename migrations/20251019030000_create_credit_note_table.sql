@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE credit_note
+(
+    id         UUID PRIMARY KEY,
+    invoice_id UUID      NOT NULL REFERENCES invoice (id),
+    amount     INT       NOT NULL CHECK (amount > 0),
+    currency   CHAR(3)   NOT NULL CHECK (currency IN ('BOB', 'USD')),
+    reason     TEXT      NOT NULL DEFAULT '',
+    issued_at  TIMESTAMP NOT NULL DEFAULT NOW(),
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+-- Issued when a contract is cancelled, for the prorated cost of the deliveries left less the cancellation fee
+CREATE INDEX IF NOT EXISTS idx_credit_note_invoice ON credit_note (invoice_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_credit_note_invoice;
+DROP TABLE IF EXISTS credit_note;
+-- +goose StatementEnd
@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE courier
+(
+    id             UUID PRIMARY KEY,
+    name           VARCHAR(100) NOT NULL,
+    phone          VARCHAR(10),
+    weekdays       SMALLINT     NOT NULL DEFAULT 127 CHECK (weekdays BETWEEN 1 AND 127),
+    max_deliveries INT          NOT NULL CHECK (max_deliveries > 0),
+    created_at     TIMESTAMP    NOT NULL DEFAULT NOW(),
+    updated_at     TIMESTAMP    NOT NULL DEFAULT NOW(),
+    deleted_at     TIMESTAMP             DEFAULT NULL
+);
+-- Pending deliveries go back to the unassigned pool when their courier is deleted
+ALTER TABLE delivery
+    ADD COLUMN courier_id UUID REFERENCES courier (id);
+CREATE INDEX IF NOT EXISTS idx_delivery_courier_date ON delivery (courier_id, date) WHERE courier_id IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_delivery_courier_date;
+ALTER TABLE delivery
+    DROP COLUMN IF EXISTS courier_id;
+DROP TABLE IF EXISTS courier;
+-- +goose StatementEnd
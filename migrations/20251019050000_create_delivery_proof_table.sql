@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE delivery_proof
+(
+    id            UUID PRIMARY KEY,
+    delivery_id   UUID             NOT NULL UNIQUE REFERENCES delivery (id),
+    recipient     VARCHAR(100)     NOT NULL,
+    received_at   TIMESTAMP        NOT NULL,
+    latitude      DOUBLE PRECISION NOT NULL,
+    longitude     DOUBLE PRECISION NOT NULL,
+    distance      INT              NOT NULL CHECK (distance >= 0),
+    flagged       BOOLEAN          NOT NULL DEFAULT FALSE,
+    photo_key     TEXT                      DEFAULT NULL,
+    signature_key TEXT                      DEFAULT NULL,
+    created_at    TIMESTAMP        NOT NULL DEFAULT NOW()
+);
+-- Hand-offs too far from the delivery address, reviewed on disputes
+CREATE INDEX IF NOT EXISTS idx_delivery_proof_flagged ON delivery_proof (received_at) WHERE flagged;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_delivery_proof_flagged;
+DROP TABLE IF EXISTS delivery_proof;
+-- +goose StatementEnd
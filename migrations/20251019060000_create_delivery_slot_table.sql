@@ -0,0 +1,33 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE delivery_slot
+(
+    id           UUID PRIMARY KEY,
+    zone_id      UUID        NOT NULL REFERENCES zone (id),
+    name         VARCHAR(50) NOT NULL,
+    start_minute SMALLINT    NOT NULL CHECK (start_minute BETWEEN 0 AND 1439),
+    end_minute   SMALLINT    NOT NULL CHECK (end_minute BETWEEN 1 AND 1440),
+    capacity     INT         NOT NULL CHECK (capacity > 0),
+    created_at   TIMESTAMP   NOT NULL DEFAULT NOW(),
+    updated_at   TIMESTAMP   NOT NULL DEFAULT NOW(),
+    deleted_at   TIMESTAMP            DEFAULT NULL,
+    CHECK (end_minute > start_minute)
+);
+CREATE INDEX IF NOT EXISTS idx_delivery_slot_zone ON delivery_slot (zone_id) WHERE deleted_at IS NULL;
+-- The default slot of the contract, and the slot each delivery is booked into
+ALTER TABLE contract
+    ADD COLUMN slot_id UUID REFERENCES delivery_slot (id);
+ALTER TABLE delivery
+    ADD COLUMN slot_id UUID REFERENCES delivery_slot (id);
+CREATE INDEX IF NOT EXISTS idx_delivery_slot_date ON delivery (slot_id, date) WHERE slot_id IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_delivery_slot_date;
+ALTER TABLE delivery
+    DROP COLUMN IF EXISTS slot_id;
+ALTER TABLE contract
+    DROP COLUMN IF EXISTS slot_id;
+DROP TABLE IF EXISTS delivery_slot;
+-- +goose StatementEnd
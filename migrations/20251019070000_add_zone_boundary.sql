@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE zone
+    ADD COLUMN boundary JSONB DEFAULT NULL;
+ALTER TABLE zone
+    DROP CONSTRAINT IF EXISTS zone_surcharge_check,
+    ADD CONSTRAINT zone_surcharge_check CHECK (surcharge >= 0);
+-- A zone with a boundary covers that GeoJSON Polygon, latitude, longitude and radius then circle it
+-- Zones now make up the service area: once one exists, addresses outside all of them are refused
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+-- Zones without a surcharge are left in place, the older check is not restored
+UPDATE zone
+SET deleted_at = NOW(),
+    updated_at = NOW()
+WHERE boundary IS NOT NULL
+  AND deleted_at IS NULL;
+ALTER TABLE zone
+    DROP COLUMN IF EXISTS boundary;
+-- +goose StatementEnd